@@ -23,6 +23,7 @@ import (
 	"github.com/openimsdk/chat/internal/api/util"
 	"github.com/openimsdk/chat/pkg/common/apistruct"
 	"github.com/openimsdk/chat/pkg/common/config"
+	chatconstant "github.com/openimsdk/chat/pkg/common/constant"
 	"github.com/openimsdk/chat/pkg/common/imapi"
 	"github.com/openimsdk/chat/pkg/common/mctx"
 	"github.com/openimsdk/chat/pkg/common/xlsx"
@@ -72,22 +73,206 @@ func (o *Api) AdminLogin(c *gin.Context) {
 		apiresp.GinError(c, err)
 		return
 	}
-	imAdminUserID := o.GetDefaultIMAdminUserID()
-	imToken, err := o.imApiCaller.UserToken(c, imAdminUserID, constant.AdminPlatformID)
+	resp, err := o.adminLoginResp(c, loginResp)
 	if err != nil {
 		apiresp.GinError(c, err)
 		return
 	}
-	var resp apistruct.AdminLoginResp
-	if err := datautil.CopyStructFields(&resp, loginResp); err != nil {
+	apiresp.GinSuccess(c, resp)
+}
+
+// AdminRefreshToken exchanges the refresh token of an admin login for a new admin token and refresh token.
+func (o *Api) AdminRefreshToken(c *gin.Context) {
+	req, err := a2r.ParseRequest[struct {
+		RefreshToken string `json:"refreshToken" binding:"required"`
+	}](c)
+	if err != nil {
 		apiresp.GinError(c, err)
 		return
 	}
+	ip, err := o.GetClientIP(c)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	resp, err := o.adminClient.RefreshToken(c, &admin.RefreshTokenReq{
+		RefreshToken: req.RefreshToken,
+		UserType:     chatconstant.AdminUser,
+		Ip:           ip,
+		UserAgent:    c.Request.UserAgent(),
+	})
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	apiresp.GinSuccess(c, &apistruct.AdminRefreshTokenResp{
+		AdminToken:   resp.Token,
+		RefreshToken: resp.RefreshToken,
+		AdminUserID:  resp.UserID,
+	})
+}
+
+// adminLoginResp adds the IM token to a completed login, a login waiting for the second factor only carries the challenge.
+func (o *Api) adminLoginResp(c *gin.Context, loginResp *admin.LoginResp) (*apistruct.AdminLoginResp, error) {
+	var resp apistruct.AdminLoginResp
+	if err := datautil.CopyStructFields(&resp, loginResp); err != nil {
+		return nil, err
+	}
+	if loginResp.ChallengeToken != "" {
+		return &resp, nil
+	}
+	imAdminUserID := o.GetDefaultIMAdminUserID()
+	imToken, err := o.imApiCaller.UserToken(c, imAdminUserID, constant.AdminPlatformID)
+	if err != nil {
+		return nil, err
+	}
 	resp.ImToken = imToken
 	resp.ImUserID = imAdminUserID
+	return &resp, nil
+}
+
+func (o *Api) SetAdminRoles(c *gin.Context) {
+	a2r.Call(admin.AdminClient.SetAdminRoles, o.adminClient, c)
+}
+
+func (o *Api) AddAdminRole(c *gin.Context) {
+	a2r.Call(admin.AdminClient.AddAdminRole, o.adminClient, c)
+}
+
+func (o *Api) UpdateAdminRole(c *gin.Context) {
+	a2r.Call(admin.AdminClient.UpdateAdminRole, o.adminClient, c)
+}
+
+func (o *Api) DelAdminRole(c *gin.Context) {
+	a2r.Call(admin.AdminClient.DelAdminRole, o.adminClient, c)
+}
+
+func (o *Api) SearchAdminRole(c *gin.Context) {
+	a2r.Call(admin.AdminClient.SearchAdminRole, o.adminClient, c)
+}
+
+func (o *Api) SearchAuditLog(c *gin.Context) {
+	a2r.Call(admin.AdminClient.SearchAuditLog, o.adminClient, c)
+}
+
+// auditExportLimit is the maximum number of audit logs in an export, narrow the time range to export more.
+const auditExportLimit = 10000
+
+func (o *Api) ExportAuditLog(c *gin.Context) {
+	req, err := a2r.ParseRequest[apistruct.ExportAuditLogReq](c)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	if req.Format != "csv" && req.Format != "xlsx" {
+		apiresp.GinError(c, errs.ErrArgs.WrapMsg("format must be csv or xlsx"))
+		return
+	}
+	const showNumber = 500
+	var logs []model.AuditLog
+	for pageNumber := int32(1); len(logs) < auditExportLimit; pageNumber++ {
+		resp, err := o.adminClient.SearchAuditLog(c, &admin.SearchAuditLogReq{
+			OperatorUserID: req.OperatorUserID,
+			Method:         req.Method,
+			Start:          req.Start,
+			End:            req.End,
+			Pagination:     &sdkwss.RequestPagination{PageNumber: pageNumber, ShowNumber: showNumber},
+		})
+		if err != nil {
+			apiresp.GinError(c, err)
+			return
+		}
+		for _, l := range resp.Logs {
+			logs = append(logs, model.AuditLog{
+				CreateTime:     time.UnixMilli(l.CreateTime).UTC().Format(time.RFC3339),
+				OperatorUserID: l.OperatorUserID,
+				Method:         l.Method,
+				Permission:     l.Permission,
+				Request:        l.Request,
+				ResultCode:     l.ResultCode,
+				ResultMsg:      l.ResultMsg,
+				IP:             l.Ip,
+			})
+		}
+		if len(resp.Logs) < showNumber {
+			break
+		}
+	}
+	if len(logs) > auditExportLimit {
+		logs = logs[:auditExportLimit]
+	}
+	filename := "audit_log_" + time.Now().UTC().Format("20060102150405") + "." + req.Format
+	c.Header("Content-Disposition", "attachment; filename="+filename)
+	c.Header("Content-Transfer-Encoding", "binary")
+	if req.Format == "csv" {
+		c.Header("Content-Type", "text/csv; charset=utf-8")
+		err = xlsx.WriteCSV(c.Writer, logs)
+	} else {
+		c.Header("Content-Type", "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet")
+		err = xlsx.WriteAll(c.Writer, logs)
+	}
+	if err != nil {
+		log.ZError(c, "write audit log export", err, "format", req.Format)
+	}
+}
+
+func (o *Api) VerifyLoginTOTP(c *gin.Context) {
+	req, err := a2r.ParseRequest[admin.VerifyLoginTOTPReq](c)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	loginResp, err := o.adminClient.VerifyLoginTOTP(c, req)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	resp, err := o.adminLoginResp(c, loginResp)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
 	apiresp.GinSuccess(c, resp)
 }
 
+func (o *Api) EnrollTOTP(c *gin.Context) {
+	a2r.Call(admin.AdminClient.EnrollTOTP, o.adminClient, c)
+}
+
+func (o *Api) ConfirmTOTP(c *gin.Context) {
+	req, err := a2r.ParseRequest[admin.ConfirmTOTPReq](c)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	confirmResp, err := o.adminClient.ConfirmTOTP(c, req)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	resp := apistruct.ConfirmTOTPResp{RecoveryCodes: confirmResp.RecoveryCodes}
+	if confirmResp.Login != nil {
+		resp.Login, err = o.adminLoginResp(c, confirmResp.Login)
+		if err != nil {
+			apiresp.GinError(c, err)
+			return
+		}
+	}
+	apiresp.GinSuccess(c, resp)
+}
+
+func (o *Api) DisableTOTP(c *gin.Context) {
+	a2r.Call(admin.AdminClient.DisableTOTP, o.adminClient, c)
+}
+
+func (o *Api) RegenerateRecoveryCodes(c *gin.Context) {
+	a2r.Call(admin.AdminClient.RegenerateRecoveryCodes, o.adminClient, c)
+}
+
+func (o *Api) SetTOTPRequired(c *gin.Context) {
+	a2r.Call(admin.AdminClient.SetTOTPRequired, o.adminClient, c)
+}
+
 func (o *Api) ResetUserPassword(c *gin.Context) {
 	a2r.Call(chat.ChatClient.ChangePassword, o.chatClient, c)
 }
@@ -276,6 +461,14 @@ func (o *Api) SearchInvitationCode(c *gin.Context) {
 	a2r.Call(admin.AdminClient.SearchInvitationCode, o.adminClient, c)
 }
 
+func (o *Api) SearchInvitationUse(c *gin.Context) {
+	a2r.Call(admin.AdminClient.SearchInvitationUse, o.adminClient, c)
+}
+
+func (o *Api) GetInvitationReport(c *gin.Context) {
+	a2r.Call(admin.AdminClient.GetInvitationReport, o.adminClient, c)
+}
+
 func (o *Api) AddUserIPLimitLogin(c *gin.Context) {
 	a2r.Call(admin.AdminClient.AddUserIPLimitLogin, o.adminClient, c)
 }
@@ -305,27 +498,7 @@ func (o *Api) ParseToken(c *gin.Context) {
 }
 
 func (o *Api) BlockUser(c *gin.Context) {
-	req, err := a2r.ParseRequest[admin.BlockUserReq](c)
-	if err != nil {
-		apiresp.GinError(c, err)
-		return
-	}
-	resp, err := o.adminClient.BlockUser(c, req)
-	if err != nil {
-		apiresp.GinError(c, err)
-		return
-	}
-	imToken, err := o.imApiCaller.UserToken(c, o.GetDefaultIMAdminUserID(), constant.AdminPlatformID)
-	if err != nil {
-		apiresp.GinError(c, err)
-		return
-	}
-	err = o.imApiCaller.ForceOffLine(mctx.WithApiToken(c, imToken), req.UserID)
-	if err != nil {
-		apiresp.GinError(c, err)
-		return
-	}
-	apiresp.GinSuccess(c, resp)
+	a2r.Call(admin.AdminClient.BlockUser, o.adminClient, c)
 }
 
 func (o *Api) UnblockUser(c *gin.Context) {
@@ -336,6 +509,18 @@ func (o *Api) SearchBlockUser(c *gin.Context) {
 	a2r.Call(admin.AdminClient.SearchBlockUser, o.adminClient, c)
 }
 
+func (o *Api) SearchBlockHistory(c *gin.Context) {
+	a2r.Call(admin.AdminClient.SearchBlockHistory, o.adminClient, c)
+}
+
+func (o *Api) ListSessions(c *gin.Context) {
+	a2r.Call(admin.AdminClient.ListSessions, o.adminClient, c)
+}
+
+func (o *Api) RevokeSession(c *gin.Context) {
+	a2r.Call(admin.AdminClient.RevokeSession, o.adminClient, c)
+}
+
 func (o *Api) SetClientConfig(c *gin.Context) {
 	a2r.Call(admin.AdminClient.SetClientConfig, o.adminClient, c)
 }
@@ -368,6 +553,22 @@ func (o *Api) LoginUserCount(c *gin.Context) {
 	a2r.Call(chat.ChatClient.UserLoginCount, o.chatClient, c)
 }
 
+func (o *Api) ReconcilePostCounts(c *gin.Context) {
+	a2r.Call(chat.ChatClient.ReconcilePostCounts, o.chatClient, c)
+}
+
+func (o *Api) CancellationUser(c *gin.Context) {
+	a2r.Call(admin.AdminClient.CancellationUser, o.adminClient, c)
+}
+
+func (o *Api) CancelAccountDeletion(c *gin.Context) {
+	a2r.Call(chat.ChatClient.CancelAccountDeletion, o.chatClient, c)
+}
+
+func (o *Api) GetAccountDeletion(c *gin.Context) {
+	a2r.Call(chat.ChatClient.GetAccountDeletion, o.chatClient, c)
+}
+
 func (o *Api) NewUserCount(c *gin.Context) {
 	req, err := a2r.ParseRequest[user.UserRegisterCountReq](c)
 	if err != nil {
@@ -423,8 +624,7 @@ func (o *Api) ImportUserByXlsx(c *gin.Context) {
 		return
 	}
 
-	ctx := o.WithAdminUser(mctx.WithApiToken(c, imToken))
-	apiresp.GinError(c, o.registerChatUser(ctx, ip, us))
+	apiresp.GinError(c, o.registerChatUser(mctx.WithApiToken(c, imToken), ip, us))
 }
 
 func (o *Api) ImportUserByJson(c *gin.Context) {
@@ -445,8 +645,7 @@ func (o *Api) ImportUserByJson(c *gin.Context) {
 		apiresp.GinError(c, err)
 		return
 	}
-	ctx := o.WithAdminUser(mctx.WithApiToken(c, imToken))
-	apiresp.GinError(c, o.registerChatUser(ctx, ip, req.Users))
+	apiresp.GinError(c, o.registerChatUser(mctx.WithApiToken(c, imToken), ip, req.Users))
 }
 
 func (o *Api) xlsx2user(users []model.User) ([]*chat.RegisterUserInfo, error) {
@@ -508,10 +707,13 @@ func (o *Api) xlsxBirth(s string) time.Time {
 	return t
 }
 
+// registerChatUser registers the users as the importing admin, so chat-rpc audits each registration.
+// The default friends and groups are read with the service identity, the admin may not hold that permission.
 func (o *Api) registerChatUser(ctx context.Context, ip string, users []*chat.RegisterUserInfo) error {
 	if len(users) == 0 {
 		return errs.ErrArgs.WrapMsg("users is empty")
 	}
+	serviceCtx := o.WithAdminUser(ctx)
 	for _, info := range users {
 		respRegisterUser, err := o.chatClient.RegisterUser(ctx, &chat.RegisterUserReq{Ip: ip, User: info, Platform: constant.AdminPlatformID})
 		if err != nil {
@@ -525,10 +727,10 @@ func (o *Api) registerChatUser(ctx context.Context, ip string, users []*chat.Reg
 		if err = o.imApiCaller.RegisterUser(ctx, []*sdkwss.UserInfo{userInfo}); err != nil {
 			return err
 		}
-		if resp, err := o.adminClient.FindDefaultFriend(ctx, &admin.FindDefaultFriendReq{}); err == nil {
+		if resp, err := o.adminClient.FindDefaultFriend(serviceCtx, &admin.FindDefaultFriendReq{}); err == nil {
 			_ = o.imApiCaller.ImportFriend(ctx, respRegisterUser.UserID, resp.UserIDs)
 		}
-		if resp, err := o.adminClient.FindDefaultGroup(ctx, &admin.FindDefaultGroupReq{}); err == nil {
+		if resp, err := o.adminClient.FindDefaultGroup(serviceCtx, &admin.FindDefaultGroupReq{}); err == nil {
 			_ = o.imApiCaller.InviteToGroup(ctx, respRegisterUser.UserID, resp.GroupIDs)
 		}
 	}
@@ -7,6 +7,7 @@ import (
 	chatmw "github.com/openimsdk/chat/internal/api/mw"
 	"github.com/openimsdk/chat/internal/api/util"
 	"github.com/openimsdk/chat/pkg/common/config"
+	"github.com/openimsdk/chat/pkg/common/constant"
 	"github.com/openimsdk/chat/pkg/common/imapi"
 	"github.com/openimsdk/chat/pkg/common/kdisc"
 	adminclient "github.com/openimsdk/chat/pkg/protocol/admin"
@@ -19,7 +20,8 @@ import (
 )
 
 type Config struct {
-	ApiConfig config.API
+	ApiConfig   config.API
+	RedisConfig config.Redis
 
 	Discovery config.Discovery
 	Share     config.Share
@@ -55,33 +57,55 @@ func Start(ctx context.Context, index int, config *Config) error {
 		ChatAdminUserID: config.Share.ChatAdmin[0],
 	}
 	adminApi := New(chatClient, adminClient, im, &base)
-	mwApi := chatmw.New(adminClient)
+	tokenCache, err := chatmw.StartTokenCache(ctx, adminClient, &config.ApiConfig, &config.RedisConfig)
+	if err != nil {
+		return err
+	}
+	mwApi := chatmw.New(adminClient, tokenCache, &base)
 	gin.SetMode(gin.ReleaseMode)
 	engine := gin.New()
-	engine.Use(gin.Recovery(), mw.CorsHandler(), mw.GinParseOperationID())
+	engine.Use(gin.Recovery(), mw.CorsHandler(), mw.GinParseOperationID(), mwApi.ForwardClientIP)
 	SetAdminRoute(engine, adminApi, mwApi)
 	return engine.Run(fmt.Sprintf(":%d", apiPort))
 }
 
 func SetAdminRoute(router gin.IRouter, admin *Api, mw *chatmw.MW) {
 
+	userManage := mw.CheckAdminPermission(constant.PermUserManage)
+
 	adminRouterGroup := router.Group("/account")
 	adminRouterGroup.POST("/login", admin.AdminLogin)                                   // Login
+	adminRouterGroup.POST("/refresh", admin.AdminRefreshToken)                          // Exchange the refresh token for a new admin token
 	adminRouterGroup.POST("/update", mw.CheckAdmin, admin.AdminUpdateInfo)              // Modify information
 	adminRouterGroup.POST("/info", mw.CheckAdmin, admin.AdminInfo)                      // Get information
 	adminRouterGroup.POST("/change_password", mw.CheckAdmin, admin.ChangeAdminPassword) // Change admin account's password
 	adminRouterGroup.POST("/add_admin", mw.CheckAdmin, admin.AddAdminAccount)           // Add admin account
-	adminRouterGroup.POST("/add_user", mw.CheckAdmin, admin.AddUserAccount)             // Add user account
+	adminRouterGroup.POST("/add_user", userManage, admin.AddUserAccount)                // Add user account
 	adminRouterGroup.POST("/del_admin", mw.CheckAdmin, admin.DelAdminAccount)           // Delete admin
 	adminRouterGroup.POST("/search", mw.CheckAdmin, admin.SearchAdminAccount)           // Get admin list
+
+	adminRouterGroup.POST("/login/2fa", admin.VerifyLoginTOTP)                                 // Complete the login with a TOTP or recovery code
+	adminRouterGroup.POST("/2fa/enroll", mw.CheckAdminOrNil, admin.EnrollTOTP)                 // Start TOTP enrolment, with an admin or challenge token
+	adminRouterGroup.POST("/2fa/confirm", mw.CheckAdminOrNil, admin.ConfirmTOTP)               // Confirm TOTP enrolment and get recovery codes
+	adminRouterGroup.POST("/2fa/disable", mw.CheckAdmin, admin.DisableTOTP)                    // Disable TOTP
+	adminRouterGroup.POST("/2fa/recovery_codes", mw.CheckAdmin, admin.RegenerateRecoveryCodes) // Replace recovery codes
+	adminRouterGroup.POST("/2fa/require", mw.CheckAdmin, admin.SetTOTPRequired)                // Require 2FA for an admin account
+
+	adminRouterGroup.POST("/set_roles", mw.CheckAdmin, admin.SetAdminRoles) // Assign roles to an admin account
+	roleRouter := router.Group("/role", mw.CheckAdmin)
+	roleRouter.POST("/add", admin.AddAdminRole)       // Add role
+	roleRouter.POST("/update", admin.UpdateAdminRole) // Replace the name and permissions of a role
+	roleRouter.POST("/del", admin.DelAdminRole)       // Delete role and remove it from admins
+	roleRouter.POST("/search", admin.SearchAdminRole) // Search roles
+
 	//account.POST("/add_notification_account")
 
 	importGroup := router.Group("/user/import")
-	importGroup.POST("/json", mw.CheckAdmin, admin.ImportUserByJson)
-	importGroup.POST("/xlsx", mw.CheckAdmin, admin.ImportUserByXlsx)
+	importGroup.POST("/json", userManage, admin.ImportUserByJson)
+	importGroup.POST("/xlsx", userManage, admin.ImportUserByXlsx)
 	importGroup.GET("/xlsx", admin.BatchImportTemplate)
 
-	defaultRouter := router.Group("/default", mw.CheckAdmin)
+	defaultRouter := router.Group("/default", mw.CheckAdminPermission(constant.PermRegisterDefault))
 	defaultUserRouter := defaultRouter.Group("/user")
 	defaultUserRouter.POST("/add", admin.AddDefaultFriend)       // Add default friend at registration
 	defaultUserRouter.POST("/del", admin.DelDefaultFriend)       // Delete default friend at registration
@@ -93,13 +117,15 @@ func SetAdminRoute(router gin.IRouter, admin *Api, mw *chatmw.MW) {
 	defaultGroupRouter.POST("/find", admin.FindDefaultGroup)     // Get default group list at registration
 	defaultGroupRouter.POST("/search", admin.SearchDefaultGroup) // Search default group list at registration
 
-	invitationCodeRouter := router.Group("/invitation_code", mw.CheckAdmin)
-	invitationCodeRouter.POST("/add", admin.AddInvitationCode)       // Add invitation code
-	invitationCodeRouter.POST("/gen", admin.GenInvitationCode)       // Generate invitation code
-	invitationCodeRouter.POST("/del", admin.DelInvitationCode)       // Delete invitation code
-	invitationCodeRouter.POST("/search", admin.SearchInvitationCode) // Search invitation code
+	invitationCodeRouter := router.Group("/invitation_code", mw.CheckAdminPermission(constant.PermInvitationCode))
+	invitationCodeRouter.POST("/add", admin.AddInvitationCode)          // Add invitation code
+	invitationCodeRouter.POST("/gen", admin.GenInvitationCode)          // Generate invitation code
+	invitationCodeRouter.POST("/del", admin.DelInvitationCode)          // Delete invitation code
+	invitationCodeRouter.POST("/search", admin.SearchInvitationCode)    // Search invitation code
+	invitationCodeRouter.POST("/use/search", admin.SearchInvitationUse) // Search registrations with invitation codes
+	invitationCodeRouter.POST("/report", admin.GetInvitationReport)     // Referral report by inviter or campaign
 
-	forbiddenRouter := router.Group("/forbidden", mw.CheckAdmin)
+	forbiddenRouter := router.Group("/forbidden", mw.CheckAdminPermission(constant.PermForbidden))
 	ipForbiddenRouter := forbiddenRouter.Group("/ip")
 	ipForbiddenRouter.POST("/add", admin.AddIPForbidden)       // Add forbidden IP for registration/login
 	ipForbiddenRouter.POST("/del", admin.DelIPForbidden)       // Delete forbidden IP for registration/login
@@ -109,26 +135,39 @@ func SetAdminRoute(router gin.IRouter, admin *Api, mw *chatmw.MW) {
 	userForbiddenRouter.POST("/del", admin.DelUserIPLimitLogin)       // Delete user limit on specific IP for login
 	userForbiddenRouter.POST("/search", admin.SearchUserIPLimitLogin) // Search limit for user login on specific IP
 
-	appletRouterGroup := router.Group("/applet", mw.CheckAdmin)
+	appletRouterGroup := router.Group("/applet", mw.CheckAdminPermission(constant.PermApplet))
 	appletRouterGroup.POST("/add", admin.AddApplet)       // Add applet
 	appletRouterGroup.POST("/del", admin.DelApplet)       // Delete applet
 	appletRouterGroup.POST("/update", admin.UpdateApplet) // Modify applet
 	appletRouterGroup.POST("/search", admin.SearchApplet) // Search applet
 
-	blockRouter := router.Group("/block", mw.CheckAdmin)
-	blockRouter.POST("/add", admin.BlockUser)          // Block user
-	blockRouter.POST("/del", admin.UnblockUser)        // Unblock user
-	blockRouter.POST("/search", admin.SearchBlockUser) // Search blocked users
-
-	userRouter := router.Group("/user", mw.CheckAdmin)
-	userRouter.POST("/password/reset", admin.ResetUserPassword) // Reset user password
-
-	initGroup := router.Group("/client_config", mw.CheckAdmin)
+	blockRouter := router.Group("/block", mw.CheckAdminPermission(constant.PermUserBlock))
+	blockRouter.POST("/add", admin.BlockUser)              // Block user
+	blockRouter.POST("/del", admin.UnblockUser)            // Unblock user
+	blockRouter.POST("/search", admin.SearchBlockUser)     // Search blocked users
+	blockRouter.POST("/history", admin.SearchBlockHistory) // Search ended blocks
+
+	userRouter := router.Group("/user")
+	userRouter.POST("/password/reset", mw.CheckAdminPermission(constant.PermUserPassword), admin.ResetUserPassword) // Reset user password
+	userRouter.POST("/cancellation", userManage, admin.CancellationUser)                                            // Delete user account, immediately or after the grace period
+	userRouter.POST("/cancellation/cancel", userManage, admin.CancelAccountDeletion)                                // Cancel a pending account deletion
+	userRouter.POST("/cancellation/receipt", userManage, admin.GetAccountDeletion)                                  // Get the account deletion or its receipt
+	userRouter.POST("/sessions/list", mw.CheckAdminPermission(constant.PermUserSession), admin.ListSessions)        // List the logins of a user
+	userRouter.POST("/sessions/revoke", mw.CheckAdminPermission(constant.PermUserSession), admin.RevokeSession)     // Log out one login of a user
+
+	initGroup := router.Group("/client_config", mw.CheckAdminPermission(constant.PermClientConfig))
 	initGroup.POST("/get", admin.GetClientConfig) // Get client initialization configuration
 	initGroup.POST("/set", admin.SetClientConfig) // Set client initialization configuration
 	initGroup.POST("/del", admin.DelClientConfig) // Delete client initialization configuration
 
-	statistic := router.Group("/statistic", mw.CheckAdmin)
+	postRouter := router.Group("/post", mw.CheckAdminPermission(constant.PermPost))
+	postRouter.POST("/reconcile_counts", admin.ReconcilePostCounts) // Recompute post counters from user relations
+
+	auditRouter := router.Group("/audit", mw.CheckAdminPermission(constant.PermAuditLog))
+	auditRouter.POST("/search", admin.SearchAuditLog) // Search audit logs by operator, method and time range
+	auditRouter.POST("/export", admin.ExportAuditLog) // Export audit logs as csv or xlsx
+
+	statistic := router.Group("/statistic", mw.CheckAdminPermission(constant.PermStatistic))
 	statistic.POST("/new_user_count", admin.NewUserCount)
 	statistic.POST("/login_user_count", admin.LoginUserCount)
 }
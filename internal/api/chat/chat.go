@@ -15,8 +15,10 @@
 package chat
 
 import (
+	"context"
 	"io"
 	"net/http"
+	"strconv"
 	"time"
 
 	"github.com/openimsdk/chat/internal/api/util"
@@ -30,18 +32,21 @@ import (
 	chatpb "github.com/openimsdk/chat/pkg/protocol/chat"
 	constantpb "github.com/openimsdk/chat/pkg/protocol/constant"
 	"github.com/openimsdk/chat/pkg/protocol/sdkwss"
+	"github.com/openimsdk/chat/pkg/storage"
 	"github.com/openimsdk/tools/a2r"
 	"github.com/openimsdk/tools/apiresp"
 	"github.com/openimsdk/tools/errs"
 	"github.com/openimsdk/tools/log"
 )
 
-func New(chatClient chatpb.ChatClient, adminClient admin.AdminClient, imApiCaller imapi.CallerInterface, api *util.Api) *Api {
+func New(chatClient chatpb.ChatClient, adminClient admin.AdminClient, imApiCaller imapi.CallerInterface, storage storage.Storage, api *util.Api) *Api {
 	return &Api{
 		Api:         api,
 		chatClient:  chatClient,
 		adminClient: adminClient,
 		imApiCaller: imApiCaller,
+		storage:     storage,
+		jwks:        &jwksCache{},
 	}
 }
 
@@ -50,6 +55,8 @@ type Api struct {
 	chatClient  chatpb.ChatClient
 	adminClient admin.AdminClient
 	imApiCaller imapi.CallerInterface
+	storage     storage.Storage
+	jwks        *jwksCache
 }
 
 // ################## ACCOUNT ##################
@@ -66,6 +73,9 @@ func (o *Api) SendVerifyCode(c *gin.Context) {
 		return
 	}
 	req.Ip = ip
+	if req.Language == "" {
+		req.Language = o.GetLanguage(c)
+	}
 	resp, err := o.chatClient.SendVerifyCode(c, req)
 	if err != nil {
 		apiresp.GinError(c, err)
@@ -78,6 +88,10 @@ func (o *Api) VerifyCode(c *gin.Context) {
 	a2r.Call(chatpb.ChatClient.VerifyCode, o.chatClient, c)
 }
 
+func (o *Api) ChallengeNonce(c *gin.Context) {
+	a2r.Call(chatpb.ChatClient.ChallengeNonce, o.chatClient, c)
+}
+
 func (o *Api) RegisterUser(c *gin.Context) {
 	req, err := a2r.ParseRequest[chatpb.RegisterUserReq](c)
 	if err != nil {
@@ -90,6 +104,7 @@ func (o *Api) RegisterUser(c *gin.Context) {
 		return
 	}
 	req.Ip = ip
+	req.UserAgent = c.Request.UserAgent()
 
 	imToken, err := o.imApiCaller.ImAdminTokenWithDefaultAdmin(c)
 	if err != nil {
@@ -157,6 +172,7 @@ func (o *Api) RegisterUser(c *gin.Context) {
 		}
 	}
 	resp.ChatToken = respRegisterUser.ChatToken
+	resp.RefreshToken = respRegisterUser.RefreshToken
 	resp.UserID = respRegisterUser.UserID
 	apiresp.GinSuccess(c, &resp)
 }
@@ -173,6 +189,7 @@ func (o *Api) Login(c *gin.Context) {
 		return
 	}
 	req.Ip = ip
+	req.UserAgent = c.Request.UserAgent()
 	resp, err := o.chatClient.Login(c, req)
 	if err != nil {
 		apiresp.GinError(c, err)
@@ -184,10 +201,163 @@ func (o *Api) Login(c *gin.Context) {
 		return
 	}
 	apiresp.GinSuccess(c, &apistruct.LoginResp{
-		ImToken:   imToken,
-		UserID:    resp.UserID,
-		ChatToken: resp.ChatToken,
+		ImToken:      imToken,
+		UserID:       resp.UserID,
+		ChatToken:    resp.ChatToken,
+		RefreshToken: resp.RefreshToken,
+	})
+}
+
+// RefreshToken exchanges the refresh token of a login for a new chat token and refresh token,
+// it needs no chat token as the one of the client may have expired.
+func (o *Api) RefreshToken(c *gin.Context) {
+	req, err := a2r.ParseRequest[struct {
+		RefreshToken string `json:"refreshToken" binding:"required"`
+	}](c)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	ip, err := o.GetClientIP(c)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	resp, err := o.adminClient.RefreshToken(c, &admin.RefreshTokenReq{
+		RefreshToken: req.RefreshToken,
+		UserType:     constant.NormalUser,
+		Ip:           ip,
+		UserAgent:    c.Request.UserAgent(),
+	})
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	apiresp.GinSuccess(c, &apistruct.RefreshTokenResp{
+		ChatToken:    resp.Token,
+		RefreshToken: resp.RefreshToken,
+		UserID:       resp.UserID,
+	})
+}
+
+func (o *Api) AddWallet(c *gin.Context) {
+	a2r.Call(chatpb.ChatClient.AddWallet, o.chatClient, c)
+}
+
+func (o *Api) ListWallets(c *gin.Context) {
+	a2r.Call(chatpb.ChatClient.ListWallets, o.chatClient, c)
+}
+
+func (o *Api) RemoveWallet(c *gin.Context) {
+	a2r.Call(chatpb.ChatClient.RemoveWallet, o.chatClient, c)
+}
+
+// ListSessions lists the logins of the user, marking the one of this request as current.
+func (o *Api) ListSessions(c *gin.Context) {
+	resp, err := o.adminClient.ListSessions(c, &admin.ListSessionsReq{
+		UserID:           mctx.GetOpUserID(c),
+		CurrentSessionID: c.GetString(constant.CtxSessionID),
+		CurrentToken:     c.GetHeader("token"),
+	})
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	apiresp.GinSuccess(c, resp)
+}
+
+func (o *Api) RevokeSession(c *gin.Context) {
+	req, err := a2r.ParseRequest[struct {
+		SessionID string `json:"sessionID" binding:"required"`
+	}](c)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	resp, err := o.adminClient.RevokeSession(c, &admin.RevokeSessionReq{
+		UserID:    mctx.GetOpUserID(c),
+		SessionID: req.SessionID,
 	})
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	apiresp.GinSuccess(c, resp)
+}
+
+// RevokeOtherSessions logs out every login of the user except the one of this request.
+func (o *Api) RevokeOtherSessions(c *gin.Context) {
+	resp, err := o.adminClient.RevokeOtherSessions(c, &admin.RevokeOtherSessionsReq{
+		UserID:           mctx.GetOpUserID(c),
+		CurrentSessionID: c.GetString(constant.CtxSessionID),
+		CurrentToken:     c.GetHeader("token"),
+	})
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	apiresp.GinSuccess(c, resp)
+}
+
+func (o *Api) RequestAccountDeletion(c *gin.Context) {
+	a2r.Call(chatpb.ChatClient.RequestAccountDeletion, o.chatClient, c)
+}
+
+func (o *Api) CancelAccountDeletion(c *gin.Context) {
+	a2r.Call(chatpb.ChatClient.CancelAccountDeletion, o.chatClient, c)
+}
+
+func (o *Api) GetAccountDeletion(c *gin.Context) {
+	a2r.Call(chatpb.ChatClient.GetAccountDeletion, o.chatClient, c)
+}
+
+func (o *Api) RequestDataExport(c *gin.Context) {
+	a2r.Call(chatpb.ChatClient.RequestDataExport, o.chatClient, c)
+}
+
+func (o *Api) GetDataExport(c *gin.Context) {
+	a2r.Call(chatpb.ChatClient.GetDataExport, o.chatClient, c)
+}
+
+// DownloadDataExport is opened directly from the signed link, so it has no token and no operationID header.
+// The export is consumed only after the whole archive was written, an interrupted download can use the link again.
+func (o *Api) DownloadDataExport(c *gin.Context) {
+	c.Set(constantpb.OperationID, "data_export_download_"+strconv.FormatInt(time.Now().UnixMilli(), 10))
+	token := c.Query("token")
+	resp, err := o.chatClient.OpenDataExport(c, &chatpb.OpenDataExportReq{Token: token})
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	r, err := o.storage.Get(c, resp.Key)
+	if err != nil {
+		log.ZError(c, "open data export failed", err, "key", resp.Key)
+		o.releaseDataExport(c, token, resp.Claim)
+		apiresp.GinError(c, err)
+		return
+	}
+	defer r.Close()
+	c.Header("Content-Disposition", `attachment; filename="`+resp.FileName+`"`)
+	c.Header("Content-Type", "application/zip")
+	c.Header("Content-Length", strconv.FormatInt(resp.Size, 10))
+	c.Status(http.StatusOK)
+	n, err := io.Copy(c.Writer, r)
+	if err != nil || n != resp.Size {
+		log.ZWarn(c, "data export download interrupted", err, "key", resp.Key, "written", n, "size", resp.Size)
+		o.releaseDataExport(c, token, resp.Claim)
+		return
+	}
+	if _, err := o.chatClient.ConsumeDataExport(c, &chatpb.ConsumeDataExportReq{Token: token, Claim: resp.Claim}); err != nil {
+		log.ZError(c, "consume data export failed", err, "key", resp.Key)
+	}
+}
+
+// releaseDataExport gives the download claim back so the link can be retried, the claim lease ends anyway if this fails.
+// The request context is usually canceled here because the client went away.
+func (o *Api) releaseDataExport(c *gin.Context, token string, claim string) {
+	if _, err := o.chatClient.ReleaseDataExport(context.WithoutCancel(c), &chatpb.ReleaseDataExportReq{Token: token, Claim: claim}); err != nil {
+		log.ZError(c, "release data export failed", err, "claim", claim)
+	}
 }
 
 func (o *Api) ResetPassword(c *gin.Context) {
@@ -448,6 +618,24 @@ func (o *Api) DeletMyGroupApplicationFromAll(c *gin.Context) {
 
 }
 
+// ################## Notification ##################
+
+func (o *Api) GetNotifications(c *gin.Context) {
+	a2r.Call(chatpb.ChatClient.GetNotifications, o.chatClient, c)
+}
+
+func (o *Api) GetUnreadNotificationCount(c *gin.Context) {
+	a2r.Call(chatpb.ChatClient.GetUnreadNotificationCount, o.chatClient, c)
+}
+
+func (o *Api) MarkNotificationsRead(c *gin.Context) {
+	a2r.Call(chatpb.ChatClient.MarkNotificationsRead, o.chatClient, c)
+}
+
+func (o *Api) MarkAllNotificationsRead(c *gin.Context) {
+	a2r.Call(chatpb.ChatClient.MarkAllNotificationsRead, o.chatClient, c)
+}
+
 // ################## Post ##################
 
 func (o *Api) PublishPost(c *gin.Context) {
@@ -470,6 +658,26 @@ func (o *Api) ReferencePost(c *gin.Context) {
 	a2r.Call(chatpb.ChatClient.ReferencePost, o.chatClient, c)
 }
 
+func (o *Api) EditPost(c *gin.Context) {
+	a2r.Call(chatpb.ChatClient.EditPost, o.chatClient, c)
+}
+
+func (o *Api) SaveDraft(c *gin.Context) {
+	a2r.Call(chatpb.ChatClient.SaveDraft, o.chatClient, c)
+}
+
+func (o *Api) ListDrafts(c *gin.Context) {
+	a2r.Call(chatpb.ChatClient.ListDrafts, o.chatClient, c)
+}
+
+func (o *Api) DeleteDraft(c *gin.Context) {
+	a2r.Call(chatpb.ChatClient.DeleteDraft, o.chatClient, c)
+}
+
+func (o *Api) GetPostRevisions(c *gin.Context) {
+	a2r.Call(chatpb.ChatClient.GetPostRevisions, o.chatClient, c)
+}
+
 func (o *Api) ChangeLikePost(c *gin.Context) {
 	a2r.Call(chatpb.ChatClient.ChangeLikePost, o.chatClient, c)
 }
@@ -0,0 +1,73 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chat
+
+import (
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/openimsdk/tools/log"
+
+	"github.com/openimsdk/chat/pkg/protocol/admin"
+)
+
+// jwksMaxAge is how long the JWKS is cached, here and by the verifiers.
+// A new signing key must be published at least this long before it signs.
+const jwksMaxAge = 5 * time.Minute
+
+// jwksCache keeps the JWKS of the admin rpc, a stale JWKS is served while the rpc fails.
+type jwksCache struct {
+	lock   sync.Mutex
+	keys   []*admin.JWK
+	expire time.Time
+}
+
+func (j *jwksCache) get(c *gin.Context, client admin.AdminClient) ([]*admin.JWK, error) {
+	j.lock.Lock()
+	defer j.lock.Unlock()
+	if j.keys != nil && time.Now().Before(j.expire) {
+		return j.keys, nil
+	}
+	resp, err := client.GetJWKS(c, &admin.GetJWKSReq{})
+	if err != nil {
+		if j.keys != nil {
+			log.ZWarn(c, "get jwks failed, serving the cached keys", err)
+			return j.keys, nil
+		}
+		return nil, err
+	}
+	j.keys = resp.Keys
+	if j.keys == nil {
+		j.keys = []*admin.JWK{}
+	}
+	j.expire = time.Now().Add(jwksMaxAge)
+	return j.keys, nil
+}
+
+// JWKS serves the public keys of the chat tokens so other backends verify them without calling ParseToken.
+// Verifiers still have to check the expiry themselves and miss revocations until the token expires.
+func (o *Api) JWKS(c *gin.Context) {
+	keys, err := o.jwks.get(c, o.adminClient)
+	if err != nil {
+		log.ZError(c, "get jwks failed", err)
+		c.AbortWithStatus(http.StatusServiceUnavailable)
+		return
+	}
+	c.Header("Cache-Control", "public, max-age="+strconv.Itoa(int(jwksMaxAge/time.Second)))
+	c.JSON(http.StatusOK, gin.H{"keys": keys})
+}
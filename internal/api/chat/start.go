@@ -12,6 +12,7 @@ import (
 	"github.com/openimsdk/chat/pkg/common/kdisc"
 	adminclient "github.com/openimsdk/chat/pkg/protocol/admin"
 	chatclient "github.com/openimsdk/chat/pkg/protocol/chat"
+	"github.com/openimsdk/chat/pkg/storage"
 	"github.com/openimsdk/tools/errs"
 	"github.com/openimsdk/tools/mw"
 	"github.com/openimsdk/tools/utils/datautil"
@@ -20,9 +21,10 @@ import (
 )
 
 type Config struct {
-	ApiConfig config.API
-	Discovery config.Discovery
-	Share     config.Share
+	ApiConfig   config.API
+	RedisConfig config.Redis
+	Discovery   config.Discovery
+	Share       config.Share
 }
 
 func Start(ctx context.Context, index int, config *Config) error {
@@ -54,8 +56,16 @@ func Start(ctx context.Context, index int, config *Config) error {
 		ProxyHeader:     config.Share.ProxyHeader,
 		ChatAdminUserID: config.Share.ChatAdmin[0],
 	}
-	adminApi := New(chatClient, adminClient, im, &base)
-	mwApi := chatmw.New(adminClient)
+	store, err := storage.New(config.Share.Storage.Build())
+	if err != nil {
+		return err
+	}
+	adminApi := New(chatClient, adminClient, im, store, &base)
+	tokenCache, err := chatmw.StartTokenCache(ctx, adminClient, &config.ApiConfig, &config.RedisConfig)
+	if err != nil {
+		return err
+	}
+	mwApi := chatmw.New(adminClient, tokenCache, &base)
 	gin.SetMode(gin.ReleaseMode)
 	engine := gin.New()
 	engine.Use(gin.Recovery(), mw.CorsHandler(), mw.GinParseOperationID())
@@ -64,14 +74,17 @@ func Start(ctx context.Context, index int, config *Config) error {
 }
 
 func SetChatRoute(router gin.IRouter, chat *Api, mw *chatmw.MW) {
+	router.GET("/.well-known/jwks.json", chat.JWKS) // Public keys to verify chat tokens locally
 
 	config := router.Group("/config")
 	config.POST("/checkVersion", chat.CheckVersion)
 	config.POST("/fakeUser", chat.GetFakeUser)
 
 	account := router.Group("/account")
+	account.POST("/challenge_nonce", chat.ChallengeNonce)            // Get a one-time nonce to sign
 	account.POST("/register", mw.CheckAdminOrNil, chat.RegisterUser) // Register
 	account.POST("/login", chat.Login)                               // Login
+	account.POST("/refresh", chat.RefreshToken)                      // Exchange the refresh token for a new chat token
 
 	post := router.Group("/post", mw.CheckToken)
 	post.POST("/publish", chat.PublishPost)
@@ -82,6 +95,11 @@ func SetChatRoute(router gin.IRouter, chat *Api, mw *chatmw.MW) {
 	post.POST("/pin", chat.PinPost)
 	post.POST("/reference", chat.ReferencePost)
 	post.POST("/delete", chat.DeletePost)
+	post.POST("/edit", chat.EditPost)
+	post.POST("/revisions", chat.GetPostRevisions)
+	post.POST("/save_draft", chat.SaveDraft)
+	post.POST("/draft_list", chat.ListDrafts)
+	post.POST("/delete_draft", chat.DeleteDraft)
 	post.POST("/:postID", chat.GetPostByID)
 	post.POST("/list_by_user", chat.GetPostListByUser)
 	post.POST("/list", chat.GetPostList)
@@ -90,6 +108,12 @@ func SetChatRoute(router gin.IRouter, chat *Api, mw *chatmw.MW) {
 	post.POST("/change_allow_comment", chat.ChangeAllowCommentPost)
 	post.POST("/change_allow_forward", chat.ChangeAllowForwardPost)
 
+	notification := router.Group("/notification", mw.CheckToken)
+	notification.POST("/list", chat.GetNotifications)
+	notification.POST("/unread_count", chat.GetUnreadNotificationCount)
+	notification.POST("/mark_read", chat.MarkNotificationsRead)
+	notification.POST("/mark_all_read", chat.MarkAllNotificationsRead)
+
 	user := router.Group("/user", mw.CheckToken)
 	user.POST("/update", chat.UpdateUserInfo)              // Edit personal information
 	user.POST("/find/public", chat.FindUserPublicInfo)     // Get user's public information
@@ -100,6 +124,18 @@ func SetChatRoute(router gin.IRouter, chat *Api, mw *chatmw.MW) {
 	user.POST("/rtc/get_token", chat.GetTokenForVideoMeeting) // Get token for video meeting for the user
 	user.POST("/statistic", chat.GetStatistic)
 	user.POST("/online_time", chat.GetUsersOnlineTime)
+	user.POST("/wallet/add", chat.AddWallet)                        // Link another wallet, signed by a current and the new key
+	user.POST("/wallet/list", chat.ListWallets)                     // List the wallets that can login to the account
+	user.POST("/wallet/remove", chat.RemoveWallet)                  // Unlink a wallet
+	user.POST("/delete_account", chat.RequestAccountDeletion)       // Request account deletion, executed after the grace period
+	user.POST("/delete_account/cancel", chat.CancelAccountDeletion) // Cancel the pending account deletion
+	user.POST("/delete_account/get", chat.GetAccountDeletion)       // Get the pending account deletion
+	user.POST("/export/request", chat.RequestDataExport)            // Request a zip of all personal data, packed in the background
+	user.POST("/export/status", chat.GetDataExport)                 // Get the latest export and its download link once packed
+	user.POST("/sessions/list", chat.ListSessions)                  // List the logins of the user with device, IP and last seen
+	user.POST("/sessions/revoke", chat.RevokeSession)               // Log out one login
+	user.POST("/sessions/revoke_others", chat.RevokeOtherSessions)  // Log out every login except the current one
+	router.GET("/user/export/download", chat.DownloadDataExport)    // Download the export with the signed link until one download completes, no login needed
 
 	group := router.Group("/group", mw.CheckToken)
 	group.POST("/contact/get", chat.GetGroupFromContact)
@@ -18,78 +18,80 @@ import (
 	"strconv"
 
 	"github.com/gin-gonic/gin"
+	"github.com/openimsdk/chat/internal/api/util"
 	"github.com/openimsdk/chat/pkg/common/constant"
 	"github.com/openimsdk/chat/pkg/protocol/admin"
-	constantpb "github.com/openimsdk/chat/pkg/protocol/constant"
 	"github.com/openimsdk/tools/apiresp"
 	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
 )
 
-func New(client admin.AdminClient) *MW {
-	return &MW{client: client}
+// New returns the middleware, tokens are checked with admin-rpc on every request if tokens is nil.
+// The client IP passed to the rpc calls is resolved like api resolves it, honouring its proxy header.
+func New(client admin.AdminClient, tokens *TokenCache, api *util.Api) *MW {
+	return &MW{client: client, tokens: tokens, api: api}
 }
 
 type MW struct {
 	client admin.AdminClient
+	tokens *TokenCache
+	api    *util.Api
 }
 
-func (o *MW) parseToken(c *gin.Context) (string, int32, string, error) {
+func (o *MW) parseToken(c *gin.Context) (string, int32, error) {
 	token := c.GetHeader("token")
 	if token == "" {
-		return "", 0, "", errs.ErrArgs.WrapMsg("token is empty")
+		return "", 0, errs.ErrArgs.WrapMsg("token is empty")
 	}
-	resp, err := o.client.ParseToken(c, &admin.ParseTokenReq{Token: token})
-	if err != nil {
-		return "", 0, "", err
+	var (
+		resp *admin.ParseTokenResp
+		err  error
+	)
+	if o.tokens == nil {
+		resp, err = o.client.ParseToken(c, &admin.ParseTokenReq{Token: token})
+	} else {
+		resp, err = o.tokens.Parse(c, token)
 	}
-	return resp.UserID, resp.UserType, token, nil
-}
-
-func (o *MW) parseTokenType(c *gin.Context, userType int32) (string, string, error) {
-	userID, t, token, err := o.parseToken(c)
 	if err != nil {
-		return "", "", err
+		return "", 0, err
 	}
-	if t != userType {
-		return "", "", errs.ErrArgs.WrapMsg("token type error")
+	c.Set(constant.CtxSessionID, resp.SessionID)
+	if resp.Legacy {
+		// the client should log in again to get a refresh token before legacy tokens are rejected
+		c.Header(constant.LegacyTokenHeader, "1")
 	}
-	return userID, token, nil
+	return resp.UserID, resp.UserType, nil
 }
 
-func (o *MW) isValidToken(c *gin.Context, userID string, token string) error {
-	resp, err := o.client.GetUserToken(c, &admin.GetUserTokenReq{UserID: userID})
+func (o *MW) parseTokenType(c *gin.Context, userType int32) (string, error) {
+	userID, t, err := o.parseToken(c)
 	if err != nil {
-		return err
-	}
-	if len(resp.TokensMap) == 0 {
-		return errs.ErrTokenExpired.Wrap()
+		return "", err
 	}
-	if v, ok := resp.TokensMap[token]; ok {
-		switch v {
-		case constantpb.NormalToken:
-		case constantpb.KickedToken:
-			return errs.ErrTokenExpired.Wrap()
-		default:
-			return errs.ErrTokenUnknown.Wrap()
-		}
-	} else {
-		return errs.ErrTokenExpired.Wrap()
+	if t != userType {
+		return "", errs.ErrArgs.WrapMsg("token type error")
 	}
-	return nil
+	return userID, nil
 }
 
 func (o *MW) setToken(c *gin.Context, userID string, userType int32) {
-	SetToken(c, userID, userType)
+	SetToken(c, userID, userType, o.clientIP(c))
 }
 
-func (o *MW) CheckToken(c *gin.Context) {
-	userID, userType, token, err := o.parseToken(c)
+// clientIP returns the client IP of the request, it is empty if the proxy header is missing or invalid.
+func (o *MW) clientIP(c *gin.Context) string {
+	ip, err := o.api.GetClientIP(c)
 	if err != nil {
-		c.Abort()
-		apiresp.GinError(c, err)
-		return
+		log.ZWarn(c, "get client ip failed", err, "remoteAddr", c.Request.RemoteAddr)
+		return ""
 	}
-	if err := o.isValidToken(c, userID, token); err != nil {
+	return ip
+}
+
+// CheckToken accepts the token if admin-rpc knows it and its flag is normal, ParseToken checks both.
+func (o *MW) CheckToken(c *gin.Context) {
+	userID, userType, err := o.parseToken(c)
+	if err != nil {
 		c.Abort()
 		apiresp.GinError(c, err)
 		return
@@ -98,38 +100,43 @@ func (o *MW) CheckToken(c *gin.Context) {
 }
 
 func (o *MW) CheckAdmin(c *gin.Context) {
-	userID, token, err := o.parseTokenType(c, constant.AdminUser)
+	userID, err := o.parseTokenType(c, constant.AdminUser)
 	if err != nil {
 		c.Abort()
 		apiresp.GinError(c, err)
 		return
 	}
-	if err := o.isValidToken(c, userID, token); err != nil {
-		c.Abort()
-		apiresp.GinError(c, err)
-		return
-	}
 	o.setToken(c, userID, constant.AdminUser)
 }
 
+// CheckAdminPermission returns a handler that checks the admin token and that the admin holds the permission.
+func (o *MW) CheckAdminPermission(permission string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		o.CheckAdmin(c)
+		if c.IsAborted() {
+			return
+		}
+		if _, err := o.client.CheckAdminPermission(c, &admin.CheckAdminPermissionReq{Permission: permission, Path: c.FullPath()}); err != nil {
+			c.Abort()
+			apiresp.GinError(c, err)
+			return
+		}
+	}
+}
+
 func (o *MW) CheckUser(c *gin.Context) {
-	userID, token, err := o.parseTokenType(c, constant.NormalUser)
+	userID, err := o.parseTokenType(c, constant.NormalUser)
 	if err != nil {
 		c.Abort()
 		apiresp.GinError(c, err)
 		return
 	}
-	if err := o.isValidToken(c, userID, token); err != nil {
-		c.Abort()
-		apiresp.GinError(c, err)
-		return
-	}
 	o.setToken(c, userID, constant.NormalUser)
 }
 
 func (o *MW) CheckAdminOrNil(c *gin.Context) {
 	defer c.Next()
-	userID, userType, _, err := o.parseToken(c)
+	userID, userType, err := o.parseToken(c)
 	if err != nil {
 		return
 	}
@@ -138,8 +145,16 @@ func (o *MW) CheckAdminOrNil(c *gin.Context) {
 	}
 }
 
-func SetToken(c *gin.Context, userID string, userType int32) {
+// ForwardClientIP passes the client IP to the rpc calls of routes without a token, such as the admin login.
+// SetToken replaces it on routes that check a token.
+func (o *MW) ForwardClientIP(c *gin.Context) {
+	c.Set(constant.RpcOpClientIP, []string{o.clientIP(c)})
+	c.Set(constant.RpcCustomHeader, []string{constant.RpcOpClientIP})
+}
+
+func SetToken(c *gin.Context, userID string, userType int32, clientIP string) {
 	c.Set(constant.RpcOpUserID, userID)
 	c.Set(constant.RpcOpUserType, []string{strconv.Itoa(int(userType))})
-	c.Set(constant.RpcCustomHeader, []string{constant.RpcOpUserType})
+	c.Set(constant.RpcOpClientIP, []string{clientIP})
+	c.Set(constant.RpcCustomHeader, []string{constant.RpcOpUserType, constant.RpcOpClientIP})
 }
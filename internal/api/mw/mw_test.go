@@ -0,0 +1,78 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mw
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc"
+
+	"github.com/openimsdk/chat/internal/api/util"
+	"github.com/openimsdk/chat/pkg/common/constant"
+	"github.com/openimsdk/chat/pkg/common/mctx"
+	"github.com/openimsdk/chat/pkg/protocol/admin"
+)
+
+// loginAdmin records the client IP admin-rpc receives on login.
+type loginAdmin struct {
+	admin.AdminClient
+	ip string
+}
+
+func (a *loginAdmin) Login(ctx context.Context, _ *admin.LoginReq, _ ...grpc.CallOption) (*admin.LoginResp, error) {
+	a.ip = mctx.GetOpClientIP(ctx)
+	return &admin.LoginResp{}, nil
+}
+
+func TestForwardClientIP(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	for _, test := range []struct {
+		name        string
+		proxyHeader string
+		want        string
+	}{
+		{name: "remote address", want: "203.0.113.7"},
+		{name: "proxy header", proxyHeader: "X-Real-IP", want: "198.51.100.9"},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			client := &loginAdmin{}
+			o := New(client, nil, &util.Api{ProxyHeader: test.proxyHeader})
+			engine := gin.New()
+			engine.Use(o.ForwardClientIP)
+			engine.POST("/account/login", func(c *gin.Context) {
+				if _, err := client.Login(c, &admin.LoginReq{}); err != nil {
+					t.Error(err)
+				}
+				if headers, _ := c.Value(constant.RpcCustomHeader).([]string); !reflect.DeepEqual(headers, []string{constant.RpcOpClientIP}) {
+					t.Errorf("forwarded headers %v", headers)
+				}
+			})
+			req := httptest.NewRequest(http.MethodPost, "/account/login", nil)
+			req.RemoteAddr = "203.0.113.7:52000"
+			// gin trusts X-Forwarded-For by default, only the configured proxy header may set the IP
+			req.Header.Set("X-Forwarded-For", "192.0.2.1")
+			req.Header.Set("X-Real-IP", "198.51.100.9")
+			engine.ServeHTTP(httptest.NewRecorder(), req)
+			if client.ip != test.want {
+				t.Fatalf("login ip %q, want %q", client.ip, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,298 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mw
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/openimsdk/tools/db/redisutil"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
+	"github.com/redis/go-redis/v9"
+
+	"github.com/openimsdk/chat/pkg/common/config"
+	"github.com/openimsdk/chat/pkg/common/db/cache"
+	"github.com/openimsdk/chat/pkg/common/tokenverify"
+	"github.com/openimsdk/chat/pkg/protocol/admin"
+)
+
+const (
+	// tokenKeysRefresh is how often the verification keys are reloaded from admin-rpc.
+	tokenKeysRefresh = time.Minute
+	// tokenResubscribe is the wait before subscribing to the revocations again after the subscription was lost.
+	tokenResubscribe = 5 * time.Second
+)
+
+// TokenCache verifies the signature of tokens locally and keeps what admin-rpc answered for them for expire,
+// so most requests need no call to admin-rpc. Revoked tokens are dropped when admin-rpc publishes their
+// revocation, a lost revocation keeps a token working for expire at most.
+type TokenCache struct {
+	client admin.AdminClient
+	expire time.Duration
+	size   int
+
+	// verifier holds the public keys of admin-rpc, tokens signed with another key are verified by admin-rpc only
+	verifier atomic.Pointer[tokenverify.Token]
+	// revoked counts the revocations, an answer is not cached if a revocation arrived while it was asked for
+	revoked atomic.Uint64
+	// paused is set while the revocations are not followed, every token is asked of admin-rpc then
+	paused atomic.Bool
+
+	lock   sync.Mutex
+	tokens map[string]*tokenEntry
+	users  map[string]map[string]struct{}
+}
+
+type tokenEntry struct {
+	resp   *admin.ParseTokenResp
+	expire time.Time
+}
+
+// StartTokenCache starts the token cache configured for the API, it returns nil if the cache is disabled.
+func StartTokenCache(ctx context.Context, client admin.AdminClient, conf *config.API, redisConf *config.Redis) (*TokenCache, error) {
+	if conf.TokenCache.Expire <= 0 {
+		return nil, nil
+	}
+	if conf.TokenCache.Size <= 0 {
+		return nil, errs.New("api tokenCache size not configured")
+	}
+	rdb, err := redisutil.NewRedisClient(ctx, redisConf.Build())
+	if err != nil {
+		return nil, err
+	}
+	t := NewTokenCache(client, time.Duration(conf.TokenCache.Expire)*time.Second, conf.TokenCache.Size)
+	if err := t.Start(ctx, rdb); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+func NewTokenCache(client admin.AdminClient, expire time.Duration, size int) *TokenCache {
+	t := &TokenCache{
+		client: client,
+		expire: expire,
+		size:   size,
+		tokens: make(map[string]*tokenEntry),
+		users:  make(map[string]map[string]struct{}),
+	}
+	t.verifier.Store(&tokenverify.Token{})
+	return t
+}
+
+// Start loads the verification keys and follows the revocations until ctx is done.
+func (t *TokenCache) Start(ctx context.Context, rdb redis.UniversalClient) error {
+	sub, err := subscribeRevoke(ctx, rdb)
+	if err != nil {
+		return err
+	}
+	if err := t.loadKeys(ctx); err != nil {
+		log.ZWarn(ctx, "load token keys failed, tokens are verified by admin-rpc", err)
+	}
+	go t.follow(ctx, rdb, sub)
+	return nil
+}
+
+func subscribeRevoke(ctx context.Context, rdb redis.UniversalClient) (*redis.PubSub, error) {
+	sub := rdb.Subscribe(ctx, cache.TokenRevokeChannel)
+	if _, err := sub.Receive(ctx); err != nil {
+		_ = sub.Close()
+		return nil, errs.WrapMsg(err, "subscribe token revocations")
+	}
+	return sub, nil
+}
+
+// follow reloads the keys and applies the revocations until ctx is done.
+// While the subscription is lost the cache is paused, revocations published meanwhile can't be missed then.
+func (t *TokenCache) follow(ctx context.Context, rdb redis.UniversalClient, sub *redis.PubSub) {
+	defer func() {
+		if sub != nil {
+			_ = sub.Close()
+		}
+	}()
+	ticker := time.NewTicker(tokenKeysRefresh)
+	defer ticker.Stop()
+	messages := sub.Channel()
+	// resubscribe is nil while subscribed
+	var resubscribe <-chan time.Time
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := t.loadKeys(ctx); err != nil {
+				log.ZWarn(ctx, "reload token keys failed", err)
+			}
+		case <-resubscribe:
+			s, err := subscribeRevoke(ctx, rdb)
+			if err != nil {
+				log.ZWarn(ctx, "resubscribe token revocations failed", err)
+				resubscribe = time.After(tokenResubscribe)
+				continue
+			}
+			sub, messages, resubscribe = s, s.Channel(), nil
+			t.pause(false)
+		case msg, ok := <-messages:
+			if !ok {
+				log.ZWarn(ctx, "token revocations unsubscribed, tokens are asked of admin-rpc until resubscribed", nil)
+				t.pause(true)
+				_ = sub.Close()
+				sub, messages, resubscribe = nil, nil, time.After(tokenResubscribe)
+				continue
+			}
+			var revoke cache.TokenRevoke
+			if err := json.Unmarshal([]byte(msg.Payload), &revoke); err != nil {
+				log.ZWarn(ctx, "invalid token revocation", err, "payload", msg.Payload)
+				continue
+			}
+			t.Revoke(revoke.UserID, revoke.Tokens)
+		}
+	}
+}
+
+// pause stops or resumes caching, the cached tokens are dropped either way.
+func (t *TokenCache) pause(paused bool) {
+	t.paused.Store(paused)
+	t.revoked.Add(1)
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	t.tokens = make(map[string]*tokenEntry)
+	t.users = make(map[string]map[string]struct{})
+}
+
+func (t *TokenCache) loadKeys(ctx context.Context) error {
+	resp, err := t.client.GetJWKS(ctx, &admin.GetJWKSReq{})
+	if err != nil {
+		return err
+	}
+	keys := make(map[string]*tokenverify.Key, len(resp.Keys))
+	for _, jwk := range resp.Keys {
+		key, err := tokenverify.KeyFromJWK(tokenverify.JWK{Kty: jwk.Kty, Crv: jwk.Crv, X: jwk.X, Y: jwk.Y, Kid: jwk.Kid, Alg: jwk.Alg, Use: jwk.Use})
+		if err != nil {
+			return err
+		}
+		keys[key.ID] = key
+	}
+	t.verifier.Store(&tokenverify.Token{Keys: keys})
+	return nil
+}
+
+// Parse returns what admin-rpc answers to ParseToken for the token, from the cache if it was asked for recently.
+func (t *TokenCache) Parse(ctx context.Context, token string) (*admin.ParseTokenResp, error) {
+	if resp := t.get(token); resp != nil {
+		return resp, nil
+	}
+	kid, expire, err := tokenverify.Peek(token)
+	if err != nil {
+		return nil, err
+	}
+	// a forged or expired token signed with a published key is rejected without asking admin-rpc,
+	// HS256 tokens have no kid and are verified by admin-rpc
+	if verifier := t.verifier.Load(); kid != "" && verifier.Keys[kid] != nil {
+		if _, _, _, err := verifier.GetTokenSession(token); err != nil {
+			return nil, err
+		}
+	}
+	revoked := t.revoked.Load()
+	resp, err := t.client.ParseToken(ctx, &admin.ParseTokenReq{Token: token})
+	if err != nil {
+		return nil, err
+	}
+	t.set(token, resp, expire, revoked)
+	return resp, nil
+}
+
+// Revoke drops the tokens of the user, all of them if tokens is empty.
+func (t *TokenCache) Revoke(userID string, tokens []string) {
+	t.revoked.Add(1)
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	if len(tokens) == 0 {
+		for token := range t.users[userID] {
+			delete(t.tokens, token)
+		}
+		delete(t.users, userID)
+		return
+	}
+	for _, token := range tokens {
+		t.delete(token, userID)
+	}
+}
+
+func (t *TokenCache) get(token string) *admin.ParseTokenResp {
+	if t.paused.Load() {
+		return nil
+	}
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	entry, ok := t.tokens[token]
+	if !ok {
+		return nil
+	}
+	if time.Now().After(entry.expire) {
+		t.delete(token, entry.resp.UserID)
+		return nil
+	}
+	return entry.resp
+}
+
+func (t *TokenCache) set(token string, resp *admin.ParseTokenResp, tokenExpire time.Time, revoked uint64) {
+	expire := time.Now().Add(t.expire)
+	if !tokenExpire.IsZero() && tokenExpire.Before(expire) {
+		expire = tokenExpire
+	}
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	if t.revoked.Load() != revoked || t.paused.Load() {
+		return
+	}
+	if len(t.tokens) >= t.size {
+		t.evict()
+	}
+	t.tokens[token] = &tokenEntry{resp: resp, expire: expire}
+	tokens, ok := t.users[resp.UserID]
+	if !ok {
+		tokens = make(map[string]struct{})
+		t.users[resp.UserID] = tokens
+	}
+	tokens[token] = struct{}{}
+}
+
+// evict drops the expired tokens, and all tokens if the cache is still full.
+func (t *TokenCache) evict() {
+	now := time.Now()
+	for token, entry := range t.tokens {
+		if now.After(entry.expire) {
+			t.delete(token, entry.resp.UserID)
+		}
+	}
+	if len(t.tokens) >= t.size {
+		t.tokens = make(map[string]*tokenEntry)
+		t.users = make(map[string]map[string]struct{})
+	}
+}
+
+func (t *TokenCache) delete(token string, userID string) {
+	delete(t.tokens, token)
+	if tokens, ok := t.users[userID]; ok {
+		delete(tokens, token)
+		if len(tokens) == 0 {
+			delete(t.users, userID)
+		}
+	}
+}
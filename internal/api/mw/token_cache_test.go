@@ -0,0 +1,233 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mw
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc"
+
+	"github.com/openimsdk/chat/internal/api/util"
+	"github.com/openimsdk/chat/pkg/common/constant"
+	"github.com/openimsdk/chat/pkg/common/tokenverify"
+	"github.com/openimsdk/chat/pkg/protocol/admin"
+)
+
+// rpcLatency is the round trip to admin-rpc and Redis the benchmark assumes.
+const rpcLatency = 200 * time.Microsecond
+
+// fakeAdmin answers ParseToken like admin-rpc for the tokens it signed and counts the calls.
+type fakeAdmin struct {
+	admin.AdminClient
+	token   *tokenverify.Token
+	latency time.Duration
+	calls   atomic.Int64
+	// onParse runs while ParseToken is answered
+	onParse func()
+}
+
+func (f *fakeAdmin) ParseToken(_ context.Context, req *admin.ParseTokenReq, _ ...grpc.CallOption) (*admin.ParseTokenResp, error) {
+	f.calls.Add(1)
+	time.Sleep(f.latency)
+	if f.onParse != nil {
+		f.onParse()
+	}
+	userID, userType, sessionID, err := f.token.GetTokenSession(req.Token)
+	if err != nil {
+		return nil, err
+	}
+	return &admin.ParseTokenResp{UserID: userID, UserType: userType, SessionID: sessionID}, nil
+}
+
+func (f *fakeAdmin) GetJWKS(context.Context, *admin.GetJWKSReq, ...grpc.CallOption) (*admin.GetJWKSResp, error) {
+	resp := &admin.GetJWKSResp{}
+	for _, jwk := range f.token.JWKS() {
+		resp.Keys = append(resp.Keys, &admin.JWK{Kty: jwk.Kty, Crv: jwk.Crv, X: jwk.X, Y: jwk.Y, Kid: jwk.Kid, Alg: jwk.Alg, Use: jwk.Use})
+	}
+	return resp, nil
+}
+
+func newFakeAdmin(t testing.TB) *fakeAdmin {
+	private, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	der, err := x509.MarshalPKCS8PrivateKey(private)
+	if err != nil {
+		t.Fatal(err)
+	}
+	key, err := tokenverify.ParseKey("k1", pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &fakeAdmin{token: &tokenverify.Token{Expires: time.Hour, SigningKey: key, Keys: map[string]*tokenverify.Key{"k1": key}}}
+}
+
+func (f *fakeAdmin) login(t testing.TB, userID string) string {
+	token, err := f.token.CreateToken(userID, constant.NormalUser, "s-"+userID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return token
+}
+
+func newTestTokenCache(t testing.TB, client *fakeAdmin) *TokenCache {
+	tokens := NewTokenCache(client, time.Minute, 100)
+	if err := tokens.loadKeys(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	return tokens
+}
+
+func TestTokenCache(t *testing.T) {
+	client := newFakeAdmin(t)
+	tokens := newTestTokenCache(t, client)
+	ctx := context.Background()
+	u1, u2 := client.login(t, "u1"), client.login(t, "u2")
+
+	for i := 0; i < 3; i++ {
+		resp, err := tokens.Parse(ctx, u1)
+		if err != nil || resp.UserID != "u1" || resp.SessionID != "s-u1" {
+			t.Fatalf("parse = %v, %v", resp, err)
+		}
+	}
+	if calls := client.calls.Load(); calls != 1 {
+		t.Fatalf("ParseToken called %d times, want once", calls)
+	}
+
+	// a token with a broken signature is rejected locally
+	if _, err := tokens.Parse(ctx, u1[:len(u1)-4]+"AAAA"); err == nil {
+		t.Fatal("forged token accepted")
+	}
+	if calls := client.calls.Load(); calls != 1 {
+		t.Fatalf("forged token sent to admin-rpc")
+	}
+
+	// revocations drop the tokens at once
+	if _, err := tokens.Parse(ctx, u2); err != nil {
+		t.Fatal(err)
+	}
+	tokens.Revoke("u1", nil)
+	tokens.Revoke("u2", []string{u2})
+	if len(tokens.tokens) != 0 || len(tokens.users) != 0 {
+		t.Fatalf("cache after revoke: %v %v", tokens.tokens, tokens.users)
+	}
+
+	// an answer racing a revocation is not cached, it may be stale
+	client.onParse = func() { tokens.Revoke("u1", nil) }
+	if _, err := tokens.Parse(ctx, u1); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := tokens.tokens[u1]; ok {
+		t.Fatal("answer racing a revocation cached")
+	}
+	client.onParse = nil
+
+	// entries never outlive the token
+	client.token.Expires = time.Second
+	short := client.login(t, "u3")
+	if _, err := tokens.Parse(ctx, short); err != nil {
+		t.Fatal(err)
+	}
+	if expire := tokens.tokens[short].expire; time.Until(expire) > time.Second {
+		t.Fatalf("entry expires %v, after the token", expire)
+	}
+}
+
+func TestTokenCacheSize(t *testing.T) {
+	client := newFakeAdmin(t)
+	tokens := NewTokenCache(client, time.Minute, 2)
+	for _, userID := range []string{"u1", "u2", "u3"} {
+		if _, err := tokens.Parse(context.Background(), client.login(t, userID)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if len(tokens.tokens) > 2 {
+		t.Fatalf("%d tokens cached, limit 2", len(tokens.tokens))
+	}
+}
+
+// BenchmarkCheckToken compares a request through CheckToken asking admin-rpc every time with one using the cache.
+func BenchmarkCheckToken(b *testing.B) {
+	gin.SetMode(gin.ReleaseMode)
+	for _, bench := range []struct {
+		name  string
+		cache bool
+	}{{"rpc", false}, {"cache", true}} {
+		b.Run(bench.name, func(b *testing.B) {
+			client := newFakeAdmin(b)
+			client.latency = rpcLatency
+			var tokens *TokenCache
+			if bench.cache {
+				tokens = newTestTokenCache(b, client)
+			}
+			o := New(client, tokens, &util.Api{})
+			token := client.login(b, "u1")
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				c, _ := gin.CreateTestContext(httptest.NewRecorder())
+				c.Request = httptest.NewRequest(http.MethodPost, "/", nil)
+				c.Request.Header.Set("token", token)
+				o.CheckToken(c)
+				if c.IsAborted() {
+					b.Fatal("token rejected")
+				}
+			}
+			b.ReportMetric(float64(client.calls.Load())/float64(b.N), "rpcs/op")
+		})
+	}
+}
+
+func TestTokenCachePaused(t *testing.T) {
+	client := newFakeAdmin(t)
+	tokens := newTestTokenCache(t, client)
+	ctx := context.Background()
+	u1 := client.login(t, "u1")
+	if _, err := tokens.Parse(ctx, u1); err != nil {
+		t.Fatal(err)
+	}
+
+	// while the revocations are not followed every token is asked of admin-rpc
+	tokens.pause(true)
+	for i := 0; i < 2; i++ {
+		if _, err := tokens.Parse(ctx, u1); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if calls := client.calls.Load(); calls != 3 || len(tokens.tokens) != 0 {
+		t.Fatalf("paused cache: %d calls, %d tokens cached", calls, len(tokens.tokens))
+	}
+
+	tokens.pause(false)
+	for i := 0; i < 2; i++ {
+		if _, err := tokens.Parse(ctx, u1); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if calls := client.calls.Load(); calls != 4 {
+		t.Fatalf("resumed cache: %d calls, want 4", calls)
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/openimsdk/chat/pkg/common/mctx"
 	"github.com/openimsdk/tools/errs"
 	"net"
+	"strings"
 )
 
 type Api struct {
@@ -34,6 +35,17 @@ func (o *Api) GetClientIP(c *gin.Context) (string, error) {
 	return ip, nil
 }
 
+// GetLanguage returns the preferred language of the Accept-Language header, e.g. zh-CN of "zh-CN,zh;q=0.9,en;q=0.8".
+func (o *Api) GetLanguage(c *gin.Context) string {
+	language, _, _ := strings.Cut(c.GetHeader("Accept-Language"), ",")
+	language, _, _ = strings.Cut(language, ";")
+	language = strings.TrimSpace(language)
+	if language == "*" {
+		return ""
+	}
+	return language
+}
+
 func (o *Api) GetDefaultIMAdminUserID() string {
 	return o.ImUserID
 }
@@ -21,14 +21,17 @@ import (
 	"time"
 
 	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
 	"github.com/openimsdk/tools/mcontext"
 	"github.com/openimsdk/tools/utils/datautil"
+	"google.golang.org/grpc"
 
 	"github.com/openimsdk/chat/pkg/common/constant"
 	"github.com/openimsdk/chat/pkg/common/db/dbutil"
 	admindb "github.com/openimsdk/chat/pkg/common/db/table/admin"
 	"github.com/openimsdk/chat/pkg/common/mctx"
 	"github.com/openimsdk/chat/pkg/eerrs"
+	"github.com/openimsdk/chat/pkg/passwd"
 	"github.com/openimsdk/chat/pkg/protocol/admin"
 )
 
@@ -41,14 +44,21 @@ func (o *adminServer) GetAdminInfo(ctx context.Context, req *admin.GetAdminInfoR
 	if err != nil {
 		return nil, err
 	}
+	permissions, err := o.adminPermissions(ctx, a)
+	if err != nil {
+		return nil, err
+	}
 	return &admin.GetAdminInfoResp{
-		Account:    a.Account,
-		Password:   a.Password,
-		FaceURL:    a.FaceURL,
-		Nickname:   a.Nickname,
-		UserID:     a.UserID,
-		Level:      a.Level,
-		CreateTime: a.CreateTime.UnixMilli(),
+		Account:      a.Account,
+		FaceURL:      a.FaceURL,
+		Nickname:     a.Nickname,
+		UserID:       a.UserID,
+		Level:        a.Level,
+		CreateTime:   a.CreateTime.UnixMilli(),
+		TotpEnabled:  a.TOTPEnabled,
+		TotpRequired: a.TOTPRequired,
+		Roles:        a.Roles,
+		Permissions:  permissions,
 	}, nil
 }
 
@@ -58,11 +68,17 @@ func (o *adminServer) ChangeAdminPassword(ctx context.Context, req *admin.Change
 		return nil, err
 	}
 
-	if user.Password != req.CurrentPassword {
+	if ok, _ := passwd.Verify(user.Password, req.CurrentPassword); !ok {
 		return nil, errs.ErrInternalServer.WrapMsg("password error")
 	}
-
-	if err := o.Database.ChangePassword(ctx, req.UserID, req.NewPassword); err != nil {
+	hash, err := passwd.Hash(req.NewPassword)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	if err := o.Database.ChangePassword(ctx, req.UserID, hash); err != nil {
+		return nil, err
+	}
+	if _, err := o.InvalidateToken(ctx, &admin.InvalidateTokenReq{UserID: req.UserID}); err != nil {
 		return nil, err
 	}
 	return &admin.ChangeAdminPasswordResp{}, nil
@@ -78,14 +94,22 @@ func (o *adminServer) AddAdminAccount(ctx context.Context, req *admin.AddAdminAc
 		return nil, errs.ErrDuplicateKey.WrapMsg("the account is registered")
 	}
 
+	if err := o.checkRoles(ctx, req.Roles); err != nil {
+		return nil, err
+	}
+	hash, err := passwd.Hash(req.Password)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
 	adm := &admindb.Admin{
 		Account:    req.Account,
-		Password:   req.Password,
+		Password:   hash,
 		FaceURL:    req.FaceURL,
 		Nickname:   req.Nickname,
 		UserID:     o.genUserID(),
 		Level:      80,
 		CreateTime: time.Now(),
+		Roles:      datautil.Distinct(req.Roles),
 	}
 	if err = o.Database.AddAdminAccount(ctx, []*admindb.Admin{adm}); err != nil {
 		return nil, err
@@ -130,12 +154,15 @@ func (o *adminServer) SearchAdminAccount(ctx context.Context, req *admin.SearchA
 	accounts := make([]*admin.GetAdminInfoResp, 0, len(adminAccounts))
 	for _, v := range adminAccounts {
 		temp := &admin.GetAdminInfoResp{
-			Account:    v.Account,
-			FaceURL:    v.FaceURL,
-			Nickname:   v.Nickname,
-			UserID:     v.UserID,
-			Level:      v.Level,
-			CreateTime: v.CreateTime.Unix(),
+			Account:      v.Account,
+			FaceURL:      v.FaceURL,
+			Nickname:     v.Nickname,
+			UserID:       v.UserID,
+			Level:        v.Level,
+			CreateTime:   v.CreateTime.Unix(),
+			TotpEnabled:  v.TOTPEnabled,
+			TotpRequired: v.TOTPRequired,
+			Roles:        v.Roles,
 		}
 		accounts = append(accounts, temp)
 	}
@@ -147,6 +174,12 @@ func (o *adminServer) AdminUpdateInfo(ctx context.Context, req *admin.AdminUpdat
 	if err != nil {
 		return nil, err
 	}
+	// the advanced level grants every permission, only a super admin may set it
+	if req.Level != nil {
+		if err := o.CheckSuperAdmin(ctx); err != nil {
+			return nil, err
+		}
+	}
 	update, err := ToDBAdminUpdate(req)
 	if err != nil {
 		return nil, err
@@ -180,21 +213,19 @@ func (o *adminServer) Login(ctx context.Context, req *admin.LoginReq) (*admin.Lo
 		}
 		return nil, err
 	}
-	if a.Password != req.Password {
+	ok, rehash := passwd.Verify(a.Password, req.Password)
+	if !ok {
 		return nil, eerrs.ErrPassword.Wrap()
 	}
-	adminToken, err := o.CreateToken(ctx, &admin.CreateTokenReq{UserID: a.UserID, UserType: constant.AdminUser})
-	if err != nil {
-		return nil, err
+	// legacy or outdated hashes are replaced after a successful login, a failure does not block the login
+	if rehash {
+		if hash, err := passwd.Hash(req.Password); err != nil {
+			log.ZError(ctx, "hash admin password failed", err, "account", a.Account)
+		} else if err := o.Database.RehashPassword(ctx, a.Account, a.Password, hash); err != nil {
+			log.ZError(ctx, "rehash admin password failed", err, "account", a.Account)
+		}
 	}
-	return &admin.LoginResp{
-		AdminUserID:  a.UserID,
-		AdminAccount: a.Account,
-		AdminToken:   adminToken.Token,
-		Nickname:     a.Nickname,
-		FaceURL:      a.FaceURL,
-		Level:        a.Level,
-	}, nil
+	return o.loginResp(ctx, a)
 }
 
 func (o *adminServer) ChangePassword(ctx context.Context, req *admin.ChangePasswordReq) (*admin.ChangePasswordResp, error) {
@@ -213,6 +244,9 @@ func (o *adminServer) ChangePassword(ctx context.Context, req *admin.ChangePassw
 	if err := o.Database.UpdateAdmin(ctx, a.UserID, update); err != nil {
 		return nil, err
 	}
+	if _, err := o.InvalidateToken(ctx, &admin.InvalidateTokenReq{UserID: a.UserID}); err != nil {
+		return nil, err
+	}
 	return &admin.ChangePasswordResp{}, nil
 }
 
@@ -243,6 +277,8 @@ func (o *adminServer) CheckSuperAdmin(ctx context.Context) error {
 	}
 
 	if adminUser.Level != constant.AdvancedUserLevel {
+		method, _ := grpc.Method(ctx)
+		o.auditDenied(ctx, superAdminPermission, method)
 		return errs.ErrNoPermission.Wrap()
 	}
 	return nil
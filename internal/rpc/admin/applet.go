@@ -32,7 +32,7 @@ import (
 )
 
 func (o *adminServer) AddApplet(ctx context.Context, req *admin.AddAppletReq) (*admin.AddAppletResp, error) {
-	if _, err := mctx.CheckAdmin(ctx); err != nil {
+	if _, err := o.checkPermission(ctx, constant.PermApplet); err != nil {
 		return nil, err
 	}
 	if req.Name == "" {
@@ -67,7 +67,7 @@ func (o *adminServer) AddApplet(ctx context.Context, req *admin.AddAppletReq) (*
 }
 
 func (o *adminServer) DelApplet(ctx context.Context, req *admin.DelAppletReq) (*admin.DelAppletResp, error) {
-	if _, err := mctx.CheckAdmin(ctx); err != nil {
+	if _, err := o.checkPermission(ctx, constant.PermApplet); err != nil {
 		return nil, err
 	}
 	if len(req.AppletIds) == 0 {
@@ -87,7 +87,7 @@ func (o *adminServer) DelApplet(ctx context.Context, req *admin.DelAppletReq) (*
 }
 
 func (o *adminServer) UpdateApplet(ctx context.Context, req *admin.UpdateAppletReq) (*admin.UpdateAppletResp, error) {
-	if _, err := mctx.CheckAdmin(ctx); err != nil {
+	if _, err := o.checkPermission(ctx, constant.PermApplet); err != nil {
 		return nil, err
 	}
 	_, err := o.Database.GetApplet(ctx, req.Id)
@@ -132,7 +132,7 @@ func (o *adminServer) FindApplet(ctx context.Context, req *admin.FindAppletReq)
 }
 
 func (o *adminServer) SearchApplet(ctx context.Context, req *admin.SearchAppletReq) (*admin.SearchAppletResp, error) {
-	if _, err := mctx.CheckAdmin(ctx); err != nil {
+	if _, err := o.checkPermission(ctx, constant.PermApplet); err != nil {
 		return nil, err
 	}
 	total, applets, err := o.Database.SearchApplet(ctx, req.Keyword, req.Pagination)
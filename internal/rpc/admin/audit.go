@@ -0,0 +1,135 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admin
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
+	"github.com/openimsdk/tools/utils/datautil"
+	"google.golang.org/grpc"
+
+	"github.com/openimsdk/chat/pkg/common/audit"
+	"github.com/openimsdk/chat/pkg/common/constant"
+	admindb "github.com/openimsdk/chat/pkg/common/db/table/admin"
+	"github.com/openimsdk/chat/pkg/common/mctx"
+	"github.com/openimsdk/chat/pkg/protocol/admin"
+)
+
+// auditLoginMethods are audited although the caller is not an admin yet.
+var auditLoginMethods = []string{"Login", "VerifyLoginTOTP"}
+
+type auditEntryKey struct{}
+
+// AuditInterceptor records the operations of admins and the admin logins in the audit log.
+// Calls the chat services make with their own admin identity are not audited.
+// It must run after the interceptor that reads the operator from the metadata.
+func AuditInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	srv, ok := info.Server.(*adminServer)
+	if !ok {
+		return handler(ctx, req)
+	}
+	name := audit.MethodName(info.FullMethod)
+	login := datautil.Contain(name, auditLoginMethods...)
+	if !login {
+		if audit.IsRead(name) {
+			return handler(ctx, req)
+		}
+		opUserID, err := mctx.CheckAdmin(ctx)
+		if err != nil || datautil.Contain(opUserID, srv.ServiceUserIDs...) {
+			return handler(ctx, req)
+		}
+	}
+	entry := &admindb.AuditLog{
+		OperatorUserID: mctx.GetOpUserID(ctx),
+		Method:         info.FullMethod,
+		Request:        audit.Request(req),
+		IP:             mctx.GetOpClientIP(ctx),
+		CreateTime:     time.Now(),
+	}
+	resp, err := handler(context.WithValue(ctx, auditEntryKey{}, entry), req)
+	if err != nil {
+		entry.ResultCode, entry.ResultMsg = audit.Result(err)
+	} else if r, ok := resp.(*admin.LoginResp); ok && login && r.AdminToken != "" {
+		entry.OperatorUserID = r.AdminUserID
+	}
+	if err := srv.Database.CreateAuditLog(ctx, []*admindb.AuditLog{entry}); err != nil {
+		log.ZError(ctx, "write audit log", err, "method", info.FullMethod)
+	}
+	return resp, err
+}
+
+// auditEntryFrom returns the audit log the interceptor writes for the current call, nil if it is not audited.
+func auditEntryFrom(ctx context.Context) *admindb.AuditLog {
+	entry, _ := ctx.Value(auditEntryKey{}).(*admindb.AuditLog)
+	return entry
+}
+
+func (o *adminServer) SearchAuditLog(ctx context.Context, req *admin.SearchAuditLogReq) (*admin.SearchAuditLogResp, error) {
+	if _, err := o.checkPermission(ctx, constant.PermAuditLog); err != nil {
+		return nil, err
+	}
+	var start, end time.Time
+	if req.Start > 0 {
+		start = time.UnixMilli(req.Start)
+	}
+	if req.End > 0 {
+		end = time.UnixMilli(req.End)
+	}
+	total, logs, err := o.Database.SearchAuditLog(ctx, req.OperatorUserID, req.Method, start, end, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	resp := &admin.SearchAuditLogResp{Total: uint32(total), Logs: make([]*admin.AuditLog, 0, len(logs))}
+	for _, l := range logs {
+		resp.Logs = append(resp.Logs, &admin.AuditLog{
+			OperatorUserID: l.OperatorUserID,
+			Method:         l.Method,
+			Permission:     l.Permission,
+			Request:        l.Request,
+			ResultCode:     l.ResultCode,
+			ResultMsg:      l.ResultMsg,
+			Ip:             l.IP,
+			CreateTime:     l.CreateTime.UnixMilli(),
+		})
+	}
+	return resp, nil
+}
+
+func (o *adminServer) AddAuditLog(ctx context.Context, req *admin.AddAuditLogReq) (*admin.AddAuditLogResp, error) {
+	opUserID, err := mctx.CheckAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !datautil.Contain(opUserID, o.ServiceUserIDs...) {
+		return nil, errs.ErrNoPermission.WrapMsg("only the chat services write audit logs")
+	}
+	entry := &admindb.AuditLog{
+		OperatorUserID: req.Log.OperatorUserID,
+		Method:         req.Log.Method,
+		Permission:     req.Log.Permission,
+		Request:        req.Log.Request,
+		ResultCode:     req.Log.ResultCode,
+		ResultMsg:      req.Log.ResultMsg,
+		IP:             req.Log.Ip,
+		CreateTime:     time.UnixMilli(req.Log.CreateTime),
+	}
+	if err := o.Database.CreateAuditLog(ctx, []*admindb.AuditLog{entry}); err != nil {
+		return nil, err
+	}
+	return &admin.AddAuditLogResp{}, nil
+}
@@ -0,0 +1,95 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admin
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/openimsdk/tools/errs"
+	"google.golang.org/grpc"
+
+	"github.com/openimsdk/chat/pkg/common/constant"
+	"github.com/openimsdk/chat/pkg/common/mctx"
+	"github.com/openimsdk/chat/pkg/protocol/admin"
+)
+
+func TestAuditInterceptor(t *testing.T) {
+	svr, db := newTestSvr(t)
+	info := func(method string) *grpc.UnaryServerInfo {
+		return &grpc.UnaryServerInfo{Server: svr, FullMethod: "/openim.chat.admin.admin/" + method}
+	}
+	staffCtx := mctx.WithAdminUser(context.Background(), "u-staff")
+
+	// a denied operation is written once, with the missing permission
+	denied := func(ctx context.Context, req any) (any, error) {
+		_, err := svr.checkPermission(ctx, constant.PermClientConfig)
+		return nil, err
+	}
+	if _, err := AuditInterceptor(staffCtx, &admin.SetClientConfigReq{Config: map[string]string{"k": "v"}}, info("SetClientConfig"), denied); !isCode(err, errs.ErrNoPermission) {
+		t.Fatalf("want NoPermission, got %v", err)
+	}
+	if len(db.audits) != 1 {
+		t.Fatalf("audits = %+v", db.audits)
+	}
+	if a := db.audits[0]; a.OperatorUserID != "u-staff" || a.Method != "/openim.chat.admin.admin/SetClientConfig" ||
+		a.Permission != constant.PermClientConfig || a.ResultCode != int32(errs.ErrNoPermission.Code()) || a.Request != `{"config":{"k":"v"}}` {
+		t.Fatalf("audit = %+v", a)
+	}
+
+	// reads and calls without an admin are not audited
+	ok := func(ctx context.Context, req any) (any, error) { return &admin.SearchAdminRoleResp{}, nil }
+	if _, err := AuditInterceptor(staffCtx, &admin.SearchAdminRoleReq{}, info("SearchAdminRole"), ok); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := AuditInterceptor(context.Background(), &admin.SetClientConfigReq{}, info("SetClientConfig"), ok); err != nil {
+		t.Fatal(err)
+	}
+	// nor calls of the chat services with their own admin identity
+	serviceCtx := mctx.WithAdminUser(context.Background(), "u-service")
+	if _, err := AuditInterceptor(serviceCtx, &admin.UseInvitationCodeReq{UserID: "u1", Code: "c"}, info("UseInvitationCode"), ok); err != nil {
+		t.Fatal(err)
+	}
+	if len(db.audits) != 1 {
+		t.Fatalf("unexpected audits %+v", db.audits[1:])
+	}
+
+	// a login is audited with the admin that logged in and without the password
+	login := func(ctx context.Context, req any) (any, error) { return svr.Login(ctx, req.(*admin.LoginReq)) }
+	if _, err := AuditInterceptor(context.Background(), &admin.LoginReq{Account: "root", Password: "pw"}, info("Login"), login); err != nil {
+		t.Fatal(err)
+	}
+	if len(db.audits) != 2 {
+		t.Fatalf("login not audited: %+v", db.audits)
+	}
+	if a := db.audits[1]; a.OperatorUserID != "u-root" || a.ResultCode != 0 || strings.Contains(a.Request, `"pw"`) {
+		t.Fatalf("login audit = %+v", a)
+	}
+}
+
+func TestAddAuditLog(t *testing.T) {
+	svr, db := newTestSvr(t)
+	req := &admin.AddAuditLogReq{Log: &admin.AuditLog{OperatorUserID: "u-staff", Method: "/openim.chat.chat/ResetPassword", CreateTime: 1}}
+	if _, err := svr.AddAuditLog(mctx.WithAdminUser(context.Background(), "u-staff"), req); !isCode(err, errs.ErrNoPermission) {
+		t.Fatalf("want NoPermission for a regular admin, got %v", err)
+	}
+	if _, err := svr.AddAuditLog(mctx.WithAdminUser(context.Background(), "u-service"), req); err != nil {
+		t.Fatal(err)
+	}
+	if len(db.audits) != 1 || db.audits[0].OperatorUserID != "u-staff" || db.audits[0].Method != req.Log.Method {
+		t.Fatalf("audits = %+v", db.audits)
+	}
+}
@@ -0,0 +1,211 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admin
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/openimsdk/tools/errs"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/openimsdk/chat/pkg/common/constant"
+	admindb "github.com/openimsdk/chat/pkg/common/db/table/admin"
+	"github.com/openimsdk/chat/pkg/common/imapi"
+	"github.com/openimsdk/chat/pkg/common/mctx"
+	"github.com/openimsdk/chat/pkg/eerrs"
+	"github.com/openimsdk/chat/pkg/protocol/admin"
+)
+
+func (d *memDatabase) GetBlockInfo(_ context.Context, userID string) (*admindb.ForbiddenAccount, error) {
+	block, ok := d.blocks[userID]
+	if !ok {
+		return nil, errs.Wrap(mongo.ErrNoDocuments)
+	}
+	b := *block
+	return &b, nil
+}
+
+func (d *memDatabase) FindBlockInfo(_ context.Context, userIDs []string) ([]*admindb.ForbiddenAccount, error) {
+	var blocks []*admindb.ForbiddenAccount
+	for _, userID := range userIDs {
+		if block, ok := d.blocks[userID]; ok {
+			blocks = append(blocks, block)
+		}
+	}
+	return blocks, nil
+}
+
+func (d *memDatabase) BlockUser(_ context.Context, blocks []*admindb.ForbiddenAccount) error {
+	for _, block := range blocks {
+		d.blocks[block.UserID] = block
+	}
+	return nil
+}
+
+func (d *memDatabase) FindExpiredBlockUser(_ context.Context, now time.Time, limit int64) ([]*admindb.ForbiddenAccount, error) {
+	var blocks []*admindb.ForbiddenAccount
+	for _, block := range d.blocks {
+		if block.Expired(now) && int64(len(blocks)) < limit {
+			blocks = append(blocks, block)
+		}
+	}
+	return blocks, nil
+}
+
+func (d *memDatabase) EndBlockUser(_ context.Context, block *admindb.ForbiddenAccount, endReason string, endOperatorUserID string) (bool, error) {
+	current, ok := d.blocks[block.UserID]
+	if !ok || !current.CreateTime.Equal(block.CreateTime) {
+		return false, nil
+	}
+	delete(d.blocks, block.UserID)
+	d.ended = append(d.ended, &admindb.ForbiddenAccountHistory{
+		UserID:            block.UserID,
+		Reason:            block.Reason,
+		ExpireTime:        block.ExpireTime,
+		EndTime:           time.Now(),
+		EndReason:         endReason,
+		EndOperatorUserID: endOperatorUserID,
+	})
+	return true, nil
+}
+
+func (d *memDatabase) DeleteToken(_ context.Context, userID string) error {
+	delete(d.tokens, userID)
+	for sessionID := range d.sessions[userID] {
+		delete(d.refreshCurrent, sessionID)
+	}
+	delete(d.sessions, userID)
+	return nil
+}
+
+type offlineIM struct {
+	imapi.CallerInterface
+	offline   []string
+	platforms []int32
+}
+
+func (im *offlineIM) ImAdminTokenWithDefaultAdmin(context.Context) (string, error) {
+	return "token", nil
+}
+
+func (im *offlineIM) ForceOffLine(_ context.Context, userID string) error {
+	im.offline = append(im.offline, userID)
+	return nil
+}
+
+func TestBlockUserExpiry(t *testing.T) {
+	svr, db := newTestSvr(t)
+	im := &offlineIM{}
+	svr.IM = im
+	rootCtx := mctx.WithAdminUser(context.Background(), "u-root")
+	db.tokens["u1"] = "t1"
+
+	if _, err := svr.BlockUser(rootCtx, &admin.BlockUserReq{UserID: "u1", ExpireTime: time.Now().Add(-time.Minute).UnixMilli()}); !isCode(err, errs.ErrArgs) {
+		t.Fatalf("past expireTime: want ErrArgs, got %v", err)
+	}
+	expire := time.Now().Add(7 * 24 * time.Hour)
+	if _, err := svr.BlockUser(rootCtx, &admin.BlockUserReq{UserID: "u1", Reason: "spam", ExpireTime: expire.UnixMilli()}); err != nil {
+		t.Fatal(err)
+	}
+	// the user is logged out of chat and OpenIM at once
+	if _, ok := db.tokens["u1"]; ok || len(im.offline) != 1 || im.offline[0] != "u1" {
+		t.Fatalf("tokens %v, offline %v", db.tokens, im.offline)
+	}
+	if _, err := svr.CheckLoginForbidden(context.Background(), &admin.CheckLoginForbiddenReq{UserID: "u1"}); !isCode(err, eerrs.ErrForbidden) {
+		t.Fatalf("blocked login: want ErrForbidden, got %v", err)
+	}
+	if _, err := svr.BlockUser(rootCtx, &admin.BlockUserReq{UserID: "u1"}); !isCode(err, errs.ErrArgs) {
+		t.Fatalf("block twice: want ErrArgs, got %v", err)
+	}
+
+	// an expired block no longer forbids the login, even before the sweeper lifts it
+	db.blocks["u1"].ExpireTime = time.Now().Add(-time.Second)
+	if _, err := svr.CheckLoginForbidden(context.Background(), &admin.CheckLoginForbiddenReq{UserID: "u1"}); err != nil {
+		t.Fatalf("expired block: %v", err)
+	}
+	if _, err := svr.BlockUser(rootCtx, &admin.BlockUserReq{UserID: "u2"}); err != nil {
+		t.Fatal(err)
+	}
+	svr.liftExpiredBlocks(context.Background())
+	if _, ok := db.blocks["u1"]; ok {
+		t.Fatal("expired block not lifted")
+	}
+	if _, ok := db.blocks["u2"]; !ok {
+		t.Fatal("permanent block lifted")
+	}
+	if len(db.ended) != 1 || db.ended[0].UserID != "u1" || db.ended[0].EndReason != constant.BlockEndExpired || db.ended[0].EndOperatorUserID != "" {
+		t.Fatalf("history %+v", db.ended)
+	}
+
+	if _, err := svr.UnblockUser(rootCtx, &admin.UnblockUserReq{UserIDs: []string{"u2"}}); err != nil {
+		t.Fatal(err)
+	}
+	if len(db.ended) != 2 || db.ended[1].EndReason != constant.BlockEndUnblocked || db.ended[1].EndOperatorUserID != "u-root" {
+		t.Fatalf("history %+v", db.ended[1:])
+	}
+}
+
+type unreachableIM struct {
+	imapi.CallerInterface
+}
+
+func (unreachableIM) ImAdminTokenWithDefaultAdmin(context.Context) (string, error) {
+	return "", errs.ErrInternalServer.WrapMsg("openim unreachable")
+}
+
+func TestBlockUserKickFailed(t *testing.T) {
+	svr, db := newTestSvr(t)
+	svr.IM = unreachableIM{}
+	rootCtx := mctx.WithAdminUser(context.Background(), "u-root")
+
+	// the tokens are revoked, so the call succeeds even though the user could not be forced offline in OpenIM
+	if _, err := svr.BlockUser(rootCtx, &admin.BlockUserReq{UserID: "u1", Reason: "spam"}); err != nil {
+		t.Fatalf("kick failure reported: %v", err)
+	}
+	if _, ok := db.blocks["u1"]; !ok {
+		t.Fatal("block not stored")
+	}
+}
+
+// tokenFailDatabase fails to delete tokens.
+type tokenFailDatabase struct {
+	*memDatabase
+}
+
+func (tokenFailDatabase) DeleteToken(context.Context, string) error {
+	return errs.ErrInternalServer.WrapMsg("redis unreachable")
+}
+
+func TestBlockUserInvalidateFailed(t *testing.T) {
+	svr, db := newTestSvr(t)
+	svr.IM = &offlineIM{}
+	rootCtx := mctx.WithAdminUser(context.Background(), "u-root")
+	db.tokens["u1"] = "t1"
+
+	svr.Database = tokenFailDatabase{db}
+	if _, err := svr.BlockUser(rootCtx, &admin.BlockUserReq{UserID: "u1", Reason: "spam"}); !isCode(err, errs.ErrInternalServer) {
+		t.Fatalf("failed revocation: want ErrInternalServer, got %v", err)
+	}
+	// blocking again revokes the tokens the first call left
+	svr.Database = db
+	if _, err := svr.BlockUser(rootCtx, &admin.BlockUserReq{UserID: "u1", Reason: "spam"}); !isCode(err, errs.ErrArgs) {
+		t.Fatalf("block again: want ErrArgs, got %v", err)
+	}
+	if _, ok := db.tokens["u1"]; ok {
+		t.Fatal("tokens not revoked by the retry")
+	}
+}
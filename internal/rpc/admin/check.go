@@ -16,6 +16,8 @@ package admin
 
 import (
 	"context"
+	"net/netip"
+	"time"
 
 	"github.com/openimsdk/chat/pkg/common/db/dbutil"
 	"github.com/openimsdk/chat/pkg/eerrs"
@@ -23,42 +25,49 @@ import (
 )
 
 func (o *adminServer) CheckRegisterForbidden(ctx context.Context, req *admin.CheckRegisterForbiddenReq) (*admin.CheckRegisterForbiddenResp, error) {
-	forbiddens, err := o.Database.FindIPForbidden(ctx, []string{req.Ip})
+	addr, err := parseClientIP(req.Ip)
 	if err != nil {
 		return nil, err
 	}
-	for _, forbidden := range forbiddens {
+	rules, err := o.currentIPRules(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, forbidden := range rules.matchForbidden(addr) {
 		if forbidden.LimitRegister {
-			return nil, eerrs.ErrForbidden.Wrap()
+			return nil, eerrs.ErrForbidden.WrapMsg("ip forbidden", "rule", forbidden.IP)
 		}
 	}
 	return &admin.CheckRegisterForbiddenResp{}, nil
 }
 
 func (o *adminServer) CheckLoginForbidden(ctx context.Context, req *admin.CheckLoginForbiddenReq) (*admin.CheckLoginForbiddenResp, error) {
-	forbiddens, err := o.Database.FindIPForbidden(ctx, []string{req.Ip})
+	// without an ip only the account is checked, and a user with login IP limits is refused
+	var addr netip.Addr
+	if req.Ip != "" {
+		var err error
+		if addr, err = parseClientIP(req.Ip); err != nil {
+			return nil, err
+		}
+	}
+	rules, err := o.currentIPRules(ctx)
 	if err != nil {
 		return nil, err
 	}
-	for _, forbidden := range forbiddens {
+	for _, forbidden := range rules.matchForbidden(addr) {
 		if forbidden.LimitLogin {
-			return nil, eerrs.ErrForbidden.WrapMsg("ip forbidden")
+			return nil, eerrs.ErrForbidden.WrapMsg("ip forbidden", "rule", forbidden.IP)
 		}
 	}
-	if _, err := o.Database.GetLimitUserLoginIP(ctx, req.UserID, req.Ip); err != nil {
-		if !dbutil.IsDBNotFound(err) {
-			return nil, err
-		}
-		count, err := o.Database.CountLimitUserLoginIP(ctx, req.UserID)
-		if err != nil {
-			return nil, err
-		}
-		if count > 0 {
-			return nil, eerrs.ErrForbidden.WrapMsg("user ip forbidden")
-		}
+	// a user with login IP limits may only log in from them
+	if _, limited := rules.limits[req.UserID]; limited && len(rules.matchLimit(req.UserID, addr)) == 0 {
+		return nil, eerrs.ErrForbidden.WrapMsg("user ip forbidden")
 	}
+	// an expired block is ignored until it is lifted
 	if forbiddenAccount, err := o.Database.GetBlockInfo(ctx, req.UserID); err == nil {
-		return nil, eerrs.ErrForbidden.WrapMsg("account forbidden", "reason", forbiddenAccount.Reason)
+		if !forbiddenAccount.Expired(time.Now()) {
+			return nil, eerrs.ErrForbidden.WrapMsg("account forbidden", "reason", forbiddenAccount.Reason)
+		}
 	} else if !dbutil.IsDBNotFound(err) {
 		return nil, err
 	}
@@ -19,7 +19,7 @@ import (
 
 	"github.com/openimsdk/tools/errs"
 
-	"github.com/openimsdk/chat/pkg/common/mctx"
+	"github.com/openimsdk/chat/pkg/common/constant"
 	"github.com/openimsdk/chat/pkg/protocol/admin"
 )
 
@@ -32,7 +32,7 @@ func (o *adminServer) GetClientConfig(ctx context.Context, req *admin.GetClientC
 }
 
 func (o *adminServer) SetClientConfig(ctx context.Context, req *admin.SetClientConfigReq) (*admin.SetClientConfigResp, error) {
-	if _, err := mctx.CheckAdmin(ctx); err != nil {
+	if _, err := o.checkPermission(ctx, constant.PermClientConfig); err != nil {
 		return nil, err
 	}
 	if len(req.Config) == 0 {
@@ -45,7 +45,7 @@ func (o *adminServer) SetClientConfig(ctx context.Context, req *admin.SetClientC
 }
 
 func (o *adminServer) DelClientConfig(ctx context.Context, req *admin.DelClientConfigReq) (*admin.DelClientConfigResp, error) {
-	if _, err := mctx.CheckAdmin(ctx); err != nil {
+	if _, err := o.checkPermission(ctx, constant.PermClientConfig); err != nil {
 		return nil, err
 	}
 	if err := o.Database.DelConfig(ctx, req.Keys); err != nil {
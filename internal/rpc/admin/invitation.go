@@ -23,15 +23,104 @@ import (
 	"github.com/openimsdk/tools/utils/datautil"
 
 	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
 
+	"github.com/openimsdk/chat/pkg/common/constant"
 	admindb "github.com/openimsdk/chat/pkg/common/db/table/admin"
 	"github.com/openimsdk/chat/pkg/common/mctx"
 	"github.com/openimsdk/chat/pkg/eerrs"
 	"github.com/openimsdk/chat/pkg/protocol/admin"
+	"github.com/openimsdk/chat/pkg/protocol/common"
 )
 
+// invitationTemplate is the settings shared by the codes of an add or gen request.
+type invitationTemplate struct {
+	MaxUses       int32
+	ExpireTime    int64
+	InviterUserID string
+	Campaign      string
+}
+
+func (o *adminServer) checkInvitationTemplate(ctx context.Context, tpl invitationTemplate, now time.Time) error {
+	if tpl.ExpireTime > 0 && tpl.ExpireTime <= now.UnixMilli() {
+		return errs.ErrArgs.WrapMsg("expireTime has passed")
+	}
+	if tpl.InviterUserID != "" {
+		if _, err := o.Chat.GetUserPublicInfo(ctx, tpl.InviterUserID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (tpl invitationTemplate) register(code string, now time.Time) *admindb.InvitationRegister {
+	register := &admindb.InvitationRegister{
+		InvitationCode: code,
+		UsedByUserID:   "",
+		MaxUses:        tpl.MaxUses,
+		InviterUserID:  tpl.InviterUserID,
+		Campaign:       tpl.Campaign,
+		CreateTime:     now,
+	}
+	if register.MaxUses < 1 {
+		register.MaxUses = 1
+	}
+	if tpl.ExpireTime > 0 {
+		register.ExpireTime = time.UnixMilli(tpl.ExpireTime)
+	}
+	return register
+}
+
+func checkInvitationUsable(register *admindb.InvitationRegister, now time.Time) error {
+	if register.Expired(now) {
+		return eerrs.ErrInvitationCodeExpired.Wrap()
+	}
+	if register.Uses() >= register.Limit() {
+		return eerrs.ErrInvitationCodeUsed.Wrap()
+	}
+	return nil
+}
+
+func convertInvitationRegister(register *admindb.InvitationRegister, userMap map[string]*common.UserPublicInfo) *admin.InvitationRegister {
+	var expireTime int64
+	if !register.ExpireTime.IsZero() {
+		expireTime = register.ExpireTime.UnixMilli()
+	}
+	return &admin.InvitationRegister{
+		InvitationCode: register.InvitationCode,
+		CreateTime:     register.CreateTime.UnixMilli(),
+		UsedUserID:     register.UsedByUserID,
+		UsedUser:       userMap[register.UsedByUserID],
+		MaxUses:        register.Limit(),
+		UsedCount:      register.Uses(),
+		ExpireTime:     expireTime,
+		InviterUserID:  register.InviterUserID,
+		Inviter:        userMap[register.InviterUserID],
+		Campaign:       register.Campaign,
+	}
+}
+
+func (o *adminServer) convertInvitationRegisters(ctx context.Context, registers []*admindb.InvitationRegister) ([]*admin.InvitationRegister, error) {
+	userIDs := make([]string, 0, len(registers))
+	for _, register := range registers {
+		if register.UsedByUserID != "" {
+			userIDs = append(userIDs, register.UsedByUserID)
+		}
+		if register.InviterUserID != "" {
+			userIDs = append(userIDs, register.InviterUserID)
+		}
+	}
+	userMap, err := o.Chat.MapUserPublicInfo(ctx, datautil.Distinct(userIDs))
+	if err != nil {
+		return nil, err
+	}
+	return datautil.Slice(registers, func(register *admindb.InvitationRegister) *admin.InvitationRegister {
+		return convertInvitationRegister(register, userMap)
+	}), nil
+}
+
 func (o *adminServer) AddInvitationCode(ctx context.Context, req *admin.AddInvitationCodeReq) (*admin.AddInvitationCodeResp, error) {
-	if _, err := mctx.CheckAdmin(ctx); err != nil {
+	if _, err := o.checkPermission(ctx, constant.PermInvitationCode); err != nil {
 		return nil, err
 	}
 	if len(req.Codes) == 0 {
@@ -40,6 +129,11 @@ func (o *adminServer) AddInvitationCode(ctx context.Context, req *admin.AddInvit
 	if datautil.Duplicate(req.Codes) {
 		return nil, errs.ErrArgs.WrapMsg("codes is duplicate")
 	}
+	now := time.Now()
+	tpl := invitationTemplate{MaxUses: req.MaxUses, ExpireTime: req.ExpireTime, InviterUserID: req.InviterUserID, Campaign: req.Campaign}
+	if err := o.checkInvitationTemplate(ctx, tpl, now); err != nil {
+		return nil, err
+	}
 	irs, err := o.Database.FindInvitationRegister(ctx, req.Codes)
 	if err != nil {
 		return nil, err
@@ -48,14 +142,9 @@ func (o *adminServer) AddInvitationCode(ctx context.Context, req *admin.AddInvit
 		ids := datautil.Slice(irs, func(info *admindb.InvitationRegister) string { return info.InvitationCode })
 		return nil, errs.ErrArgs.WrapMsg("code existed", "ids", ids)
 	}
-	now := time.Now()
 	codes := make([]*admindb.InvitationRegister, 0, len(req.Codes))
 	for _, code := range req.Codes {
-		codes = append(codes, &admindb.InvitationRegister{
-			InvitationCode: code,
-			UsedByUserID:   "",
-			CreateTime:     now,
-		})
+		codes = append(codes, tpl.register(code, now))
 	}
 	if err := o.Database.CreatInvitationRegister(ctx, codes); err != nil {
 		return nil, err
@@ -64,7 +153,7 @@ func (o *adminServer) AddInvitationCode(ctx context.Context, req *admin.AddInvit
 }
 
 func (o *adminServer) GenInvitationCode(ctx context.Context, req *admin.GenInvitationCodeReq) (*admin.GenInvitationCodeResp, error) {
-	if _, err := mctx.CheckAdmin(ctx); err != nil {
+	if _, err := o.checkPermission(ctx, constant.PermInvitationCode); err != nil {
 		return nil, err
 	}
 	if req.Num <= 0 || req.Len <= 0 {
@@ -74,6 +163,10 @@ func (o *adminServer) GenInvitationCode(ctx context.Context, req *admin.GenInvit
 		req.Chars = "0123456789abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ"
 	}
 	now := time.Now()
+	tpl := invitationTemplate{MaxUses: req.MaxUses, ExpireTime: req.ExpireTime, InviterUserID: req.InviterUserID, Campaign: req.Campaign}
+	if err := o.checkInvitationTemplate(ctx, tpl, now); err != nil {
+		return nil, err
+	}
 	invitationRegisters := make([]*admindb.InvitationRegister, 0, req.Num)
 	codes := make([]string, 0, req.Num)
 	for i := int32(0); i < req.Num; i++ {
@@ -83,11 +176,7 @@ func (o *adminServer) GenInvitationCode(ctx context.Context, req *admin.GenInvit
 			buf[i] = req.Chars[b%byte(len(req.Chars))]
 		}
 		codes = append(codes, string(buf))
-		invitationRegisters = append(invitationRegisters, &admindb.InvitationRegister{
-			InvitationCode: string(buf),
-			UsedByUserID:   "",
-			CreateTime:     now,
-		})
+		invitationRegisters = append(invitationRegisters, tpl.register(string(buf), now))
 	}
 	if datautil.Duplicate(codes) {
 		return nil, errs.ErrArgs.WrapMsg("gen duplicate codes")
@@ -103,7 +192,7 @@ func (o *adminServer) GenInvitationCode(ctx context.Context, req *admin.GenInvit
 	if err := o.Database.CreatInvitationRegister(ctx, invitationRegisters); err != nil {
 		return nil, err
 	}
-	return &admin.GenInvitationCodeResp{}, nil
+	return &admin.GenInvitationCodeResp{Codes: codes}, nil
 }
 
 func (o *adminServer) FindInvitationCode(ctx context.Context, req *admin.FindInvitationCodeReq) (*admin.FindInvitationCodeResp, error) {
@@ -117,30 +206,15 @@ func (o *adminServer) FindInvitationCode(ctx context.Context, req *admin.FindInv
 	if err != nil {
 		return nil, err
 	}
-	userIDs := make([]string, 0, len(invitationRegisters))
-	for _, register := range invitationRegisters {
-		if register.UsedByUserID != "" {
-			userIDs = append(userIDs, register.UsedByUserID)
-		}
-	}
-	userMap, err := o.Chat.MapUserPublicInfo(ctx, userIDs)
+	codes, err := o.convertInvitationRegisters(ctx, invitationRegisters)
 	if err != nil {
 		return nil, err
 	}
-	resp := &admin.FindInvitationCodeResp{Codes: make([]*admin.InvitationRegister, 0, len(invitationRegisters))}
-	for _, register := range invitationRegisters {
-		resp.Codes = append(resp.Codes, &admin.InvitationRegister{
-			InvitationCode: register.InvitationCode,
-			CreateTime:     register.CreateTime.UnixMilli(),
-			UsedUserID:     register.UsedByUserID,
-			UsedUser:       userMap[register.UsedByUserID],
-		})
-	}
-	return resp, nil
+	return &admin.FindInvitationCodeResp{Codes: codes}, nil
 }
 
 func (o *adminServer) UseInvitationCode(ctx context.Context, req *admin.UseInvitationCodeReq) (*admin.UseInvitationCodeResp, error) {
-	if _, _, err := mctx.Check(ctx); err != nil {
+	if _, err := mctx.CheckAdmin(ctx); err != nil {
 		return nil, err
 	}
 	codes, err := o.Database.FindInvitationRegister(ctx, []string{req.Code})
@@ -150,19 +224,38 @@ func (o *adminServer) UseInvitationCode(ctx context.Context, req *admin.UseInvit
 	if len(codes) == 0 {
 		return nil, eerrs.ErrInvitationNotFound.Wrap()
 	}
-	if codes[0].UsedByUserID != "" {
-		return nil, eerrs.ErrInvitationCodeUsed.Wrap()
+	if err := checkInvitationUsable(codes[0], time.Now()); err != nil {
+		return nil, err
 	}
-	if err := o.Database.UpdateInvitationRegister(ctx, req.Code, ToDBInvitationRegisterUpdate(req.UserID)); err != nil {
+	used, err := o.Database.UseInvitationRegister(ctx, codes[0], req.UserID)
+	if err != nil {
 		return nil, err
 	}
+	if !used {
+		// another registration took the last use after the check
+		return nil, eerrs.ErrInvitationCodeUsed.Wrap()
+	}
 	return &admin.UseInvitationCodeResp{}, nil
 }
 
-func (o *adminServer) DelInvitationCode(ctx context.Context, req *admin.DelInvitationCodeReq) (*admin.DelInvitationCodeResp, error) {
+func (o *adminServer) ReleaseInvitationCode(ctx context.Context, req *admin.ReleaseInvitationCodeReq) (*admin.ReleaseInvitationCodeResp, error) {
 	if _, err := mctx.CheckAdmin(ctx); err != nil {
 		return nil, err
 	}
+	released, err := o.Database.ReleaseInvitationRegister(ctx, req.Code, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if !released {
+		log.ZWarn(ctx, "invitation code use not found", nil, "code", req.Code, "userID", req.UserID)
+	}
+	return &admin.ReleaseInvitationCodeResp{}, nil
+}
+
+func (o *adminServer) DelInvitationCode(ctx context.Context, req *admin.DelInvitationCodeReq) (*admin.DelInvitationCodeResp, error) {
+	if _, err := o.checkPermission(ctx, constant.PermInvitationCode); err != nil {
+		return nil, err
+	}
 	if len(req.Codes) == 0 {
 		return nil, errs.ErrArgs.WrapMsg("codes is empty")
 	}
@@ -184,34 +277,82 @@ func (o *adminServer) DelInvitationCode(ctx context.Context, req *admin.DelInvit
 }
 
 func (o *adminServer) SearchInvitationCode(ctx context.Context, req *admin.SearchInvitationCodeReq) (*admin.SearchInvitationCodeResp, error) {
-	if _, err := mctx.CheckAdmin(ctx); err != nil {
+	if _, err := o.checkPermission(ctx, constant.PermInvitationCode); err != nil {
 		return nil, err
 	}
-	total, list, err := o.Database.SearchInvitationRegister(ctx, req.Keyword, req.Status, req.UserIDs, req.Codes, req.Pagination)
+	total, list, err := o.Database.SearchInvitationRegister(ctx, req.Keyword, req.Status, req.UserIDs, req.Codes, req.InviterUserID, req.Campaign, req.Pagination)
 	if err != nil {
 		return nil, err
 	}
-	userIDs := make([]string, 0, len(list))
-	for _, register := range list {
-		if register.UsedByUserID != "" {
-			userIDs = append(userIDs, register.UsedByUserID)
-		}
-	}
-	userMap, err := o.Chat.MapUserPublicInfo(ctx, userIDs)
+	invitationRegisters, err := o.convertInvitationRegisters(ctx, list)
 	if err != nil {
 		return nil, err
 	}
-	invitationRegisters := make([]*admin.InvitationRegister, 0, len(list))
-	for _, register := range list {
-		invitationRegisters = append(invitationRegisters, &admin.InvitationRegister{
-			InvitationCode: register.InvitationCode,
-			CreateTime:     register.CreateTime.UnixMilli(),
-			UsedUserID:     register.UsedByUserID,
-			UsedUser:       userMap[register.UsedByUserID],
-		})
-	}
 	return &admin.SearchInvitationCodeResp{
 		Total: uint32(total),
 		List:  invitationRegisters,
 	}, nil
 }
+
+func (o *adminServer) SearchInvitationUse(ctx context.Context, req *admin.SearchInvitationUseReq) (*admin.SearchInvitationUseResp, error) {
+	if _, err := o.checkPermission(ctx, constant.PermInvitationCode); err != nil {
+		return nil, err
+	}
+	total, uses, err := o.Database.SearchInvitationUse(ctx, req.Code, req.UserID, req.InviterUserID, req.Campaign, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	userIDs := datautil.Slice(uses, func(use *admindb.InvitationUse) string { return use.UserID })
+	userMap, err := o.Chat.MapUserPublicInfo(ctx, datautil.Distinct(userIDs))
+	if err != nil {
+		return nil, err
+	}
+	list := make([]*admin.InvitationUse, 0, len(uses))
+	for _, use := range uses {
+		list = append(list, &admin.InvitationUse{
+			InvitationCode: use.InvitationCode,
+			UserID:         use.UserID,
+			User:           userMap[use.UserID],
+			InviterUserID:  use.InviterUserID,
+			Campaign:       use.Campaign,
+			CreateTime:     use.CreateTime.UnixMilli(),
+		})
+	}
+	return &admin.SearchInvitationUseResp{Total: uint32(total), List: list}, nil
+}
+
+func (o *adminServer) GetInvitationReport(ctx context.Context, req *admin.GetInvitationReportReq) (*admin.GetInvitationReportResp, error) {
+	if _, err := o.checkPermission(ctx, constant.PermInvitationCode); err != nil {
+		return nil, err
+	}
+	var start, end time.Time
+	if req.StartTime > 0 {
+		start = time.UnixMilli(req.StartTime)
+	}
+	if req.EndTime > 0 {
+		end = time.UnixMilli(req.EndTime)
+	}
+	total, reports, err := o.Database.InvitationReport(ctx, req.GroupBy, req.Keys, start, end, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	userMap := map[string]*common.UserPublicInfo{}
+	if req.GroupBy == constant.InvitationReportByInviter {
+		inviterIDs := datautil.Slice(reports, func(report *admindb.InvitationReport) string { return report.Key })
+		if userMap, err = o.Chat.MapUserPublicInfo(ctx, inviterIDs); err != nil {
+			return nil, err
+		}
+	}
+	list := make([]*admin.InvitationReport, 0, len(reports))
+	for _, report := range reports {
+		list = append(list, &admin.InvitationReport{
+			Key:        report.Key,
+			Inviter:    userMap[report.Key],
+			Registered: report.Registered,
+			Codes:      report.Codes,
+			FirstTime:  report.FirstTime.UnixMilli(),
+			LastTime:   report.LastTime.UnixMilli(),
+		})
+	}
+	return &admin.GetInvitationReportResp{Total: uint32(total), List: list}, nil
+}
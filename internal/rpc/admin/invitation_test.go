@@ -0,0 +1,257 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admin
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/openimsdk/tools/db/pagination"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/utils/datautil"
+	"google.golang.org/grpc"
+
+	"github.com/openimsdk/chat/pkg/common/constant"
+	admindb "github.com/openimsdk/chat/pkg/common/db/table/admin"
+	"github.com/openimsdk/chat/pkg/common/mctx"
+	"github.com/openimsdk/chat/pkg/eerrs"
+	"github.com/openimsdk/chat/pkg/protocol/admin"
+	"github.com/openimsdk/chat/pkg/protocol/chat"
+	"github.com/openimsdk/chat/pkg/protocol/common"
+	"github.com/openimsdk/chat/pkg/protocol/sdkwss"
+	chatClient "github.com/openimsdk/chat/pkg/rpclient/chat"
+)
+
+func (d *memDatabase) FindInvitationRegister(_ context.Context, codes []string) ([]*admindb.InvitationRegister, error) {
+	var res []*admindb.InvitationRegister
+	for _, code := range codes {
+		if r, ok := d.invitations[code]; ok {
+			clone := *r
+			res = append(res, &clone)
+		}
+	}
+	return res, nil
+}
+
+func (d *memDatabase) CreatInvitationRegister(_ context.Context, registers []*admindb.InvitationRegister) error {
+	for _, r := range registers {
+		d.invitations[r.InvitationCode] = r
+	}
+	return nil
+}
+
+func (d *memDatabase) UseInvitationRegister(_ context.Context, register *admindb.InvitationRegister, userID string) (bool, error) {
+	r, ok := d.invitations[register.InvitationCode]
+	if !ok || r.Expired(time.Now()) || r.Uses() >= r.Limit() {
+		return false, nil
+	}
+	r.UsedCount = r.Uses() + 1
+	if r.UsedByUserID == "" {
+		r.UsedByUserID = userID
+	}
+	d.invitationUses = append(d.invitationUses, &admindb.InvitationUse{
+		InvitationCode: r.InvitationCode,
+		UserID:         userID,
+		InviterUserID:  r.InviterUserID,
+		Campaign:       r.Campaign,
+		CreateTime:     time.Now(),
+	})
+	return true, nil
+}
+
+func (d *memDatabase) ReleaseInvitationRegister(_ context.Context, code string, userID string) (bool, error) {
+	for i, use := range d.invitationUses {
+		if use.InvitationCode != code || use.UserID != userID {
+			continue
+		}
+		d.invitationUses = append(d.invitationUses[:i], d.invitationUses[i+1:]...)
+		r := d.invitations[code]
+		r.UsedCount--
+		if r.UsedByUserID == userID {
+			r.UsedByUserID = ""
+		}
+		return true, nil
+	}
+	return false, nil
+}
+
+func (d *memDatabase) SearchInvitationUse(_ context.Context, code string, userID string, inviterUserID string, campaign string, _ pagination.Pagination) (int64, []*admindb.InvitationUse, error) {
+	var res []*admindb.InvitationUse
+	for _, use := range d.invitationUses {
+		if (code == "" || use.InvitationCode == code) && (userID == "" || use.UserID == userID) &&
+			(inviterUserID == "" || use.InviterUserID == inviterUserID) && (campaign == "" || use.Campaign == campaign) {
+			res = append(res, use)
+		}
+	}
+	return int64(len(res)), res, nil
+}
+
+func (d *memDatabase) InvitationReport(_ context.Context, groupBy int32, _ []string, _ time.Time, _ time.Time, _ pagination.Pagination) (int64, []*admindb.InvitationReport, error) {
+	var res []*admindb.InvitationReport
+	codes := map[string][]string{}
+	for _, use := range d.invitationUses {
+		key := use.InviterUserID
+		if groupBy == constant.InvitationReportByCampaign {
+			key = use.Campaign
+		}
+		if key == "" {
+			continue
+		}
+		i := datautil.IndexOf(key, datautil.Slice(res, func(r *admindb.InvitationReport) string { return r.Key })...)
+		if i < 0 {
+			res = append(res, &admindb.InvitationReport{Key: key, FirstTime: use.CreateTime})
+			i = len(res) - 1
+		}
+		res[i].Registered++
+		res[i].LastTime = use.CreateTime
+		if !datautil.Contain(use.InvitationCode, codes[key]...) {
+			codes[key] = append(codes[key], use.InvitationCode)
+			res[i].Codes++
+		}
+	}
+	return int64(len(res)), res, nil
+}
+
+// userChat answers public info for a fixed set of users.
+type userChat struct {
+	chat.ChatClient
+	users []string
+}
+
+func (c *userChat) FindUserPublicInfo(_ context.Context, req *chat.FindUserPublicInfoReq, _ ...grpc.CallOption) (*chat.FindUserPublicInfoResp, error) {
+	resp := &chat.FindUserPublicInfoResp{}
+	for _, userID := range req.UserIDs {
+		if datautil.Contain(userID, c.users...) {
+			resp.Users = append(resp.Users, &common.UserPublicInfo{UserID: userID})
+		}
+	}
+	return resp, nil
+}
+
+func TestInvitationCodeLimits(t *testing.T) {
+	svr, db := newTestSvr(t)
+	svr.Chat = chatClient.NewChatClient(&userChat{users: []string{"u-inviter", "u1", "u2"}})
+	rootCtx := mctx.WithAdminUser(context.Background(), "u-root")
+
+	if _, err := svr.AddInvitationCode(rootCtx, &admin.AddInvitationCodeReq{Codes: []string{"x"}, InviterUserID: "u-nobody"}); !isCode(err, errs.ErrRecordNotFound) {
+		t.Fatalf("unknown inviter: want ErrRecordNotFound, got %v", err)
+	}
+	if _, err := svr.AddInvitationCode(rootCtx, &admin.AddInvitationCodeReq{Codes: []string{"x"}, ExpireTime: time.Now().Add(-time.Minute).UnixMilli()}); !isCode(err, errs.ErrArgs) {
+		t.Fatalf("expired on add: want ErrArgs, got %v", err)
+	}
+	_, err := svr.AddInvitationCode(rootCtx, &admin.AddInvitationCodeReq{
+		Codes:         []string{"multi"},
+		MaxUses:       2,
+		ExpireTime:    time.Now().Add(time.Hour).UnixMilli(),
+		InviterUserID: "u-inviter",
+		Campaign:      "spring",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	db.invitations["old"] = &admindb.InvitationRegister{InvitationCode: "old", MaxUses: 5, ExpireTime: time.Now().Add(-time.Hour)}
+	// stored before usage limits, single use and already taken
+	db.invitations["legacy"] = &admindb.InvitationRegister{InvitationCode: "legacy", UsedByUserID: "u0"}
+
+	userCtx := mctx.WithOpUserID(context.Background(), "u1", constant.NormalUser)
+	if _, err := svr.UseInvitationCode(userCtx, &admin.UseInvitationCodeReq{Code: "multi", UserID: "u1"}); !isCode(err, errs.ErrNoPermission) {
+		t.Fatalf("use as user: want ErrNoPermission, got %v", err)
+	}
+	for _, userID := range []string{"u1", "u2"} {
+		if _, err := svr.UseInvitationCode(rootCtx, &admin.UseInvitationCodeReq{Code: "multi", UserID: userID}); err != nil {
+			t.Fatalf("use by %s: %v", userID, err)
+		}
+	}
+	for code, want := range map[string]errs.CodeError{
+		"multi":   eerrs.ErrInvitationCodeUsed,
+		"legacy":  eerrs.ErrInvitationCodeUsed,
+		"old":     eerrs.ErrInvitationCodeExpired,
+		"missing": eerrs.ErrInvitationNotFound,
+	} {
+		if _, err := svr.UseInvitationCode(rootCtx, &admin.UseInvitationCodeReq{Code: code, UserID: "u3"}); !isCode(err, want) {
+			t.Fatalf("use %s: want %v, got %v", code, want, err)
+		}
+	}
+
+	found, err := svr.FindInvitationCode(rootCtx, &admin.FindInvitationCodeReq{Codes: []string{"multi", "legacy"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	multi, legacy := found.Codes[0], found.Codes[1]
+	if multi.UsedCount != 2 || multi.MaxUses != 2 || multi.UsedUserID != "u1" || multi.Inviter == nil || multi.Campaign != "spring" {
+		t.Fatalf("multi code: %v", multi)
+	}
+	if legacy.UsedCount != 1 || legacy.MaxUses != 1 || legacy.ExpireTime != 0 {
+		t.Fatalf("legacy code: %v", legacy)
+	}
+
+	uses, err := svr.SearchInvitationUse(rootCtx, &admin.SearchInvitationUseReq{InviterUserID: "u-inviter", Pagination: &sdkwss.RequestPagination{PageNumber: 1, ShowNumber: 10}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if uses.Total != 2 || uses.List[0].UserID != "u1" || uses.List[0].User == nil || uses.List[1].Campaign != "spring" {
+		t.Fatalf("uses: %v", uses)
+	}
+
+	report, err := svr.GetInvitationReport(rootCtx, &admin.GetInvitationReportReq{GroupBy: constant.InvitationReportByInviter, Pagination: &sdkwss.RequestPagination{PageNumber: 1, ShowNumber: 10}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if report.Total != 1 || report.List[0].Key != "u-inviter" || report.List[0].Inviter == nil || report.List[0].Registered != 2 || report.List[0].Codes != 1 {
+		t.Fatalf("report: %v", report)
+	}
+}
+
+func TestReleaseInvitationCode(t *testing.T) {
+	svr, db := newTestSvr(t)
+	rootCtx := mctx.WithAdminUser(context.Background(), "u-root")
+	db.invitations["once"] = &admindb.InvitationRegister{InvitationCode: "once", MaxUses: 1}
+
+	if _, err := svr.UseInvitationCode(rootCtx, &admin.UseInvitationCodeReq{Code: "once", UserID: "u1"}); err != nil {
+		t.Fatal(err)
+	}
+	// the registration of u1 failed, its use is given back once
+	for i := 0; i < 2; i++ {
+		if _, err := svr.ReleaseInvitationCode(rootCtx, &admin.ReleaseInvitationCodeReq{Code: "once", UserID: "u1"}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if r := db.invitations["once"]; r.UsedCount != 0 || r.UsedByUserID != "" || len(db.invitationUses) != 0 {
+		t.Fatalf("code after release: %+v, uses %v", r, db.invitationUses)
+	}
+	if _, err := svr.UseInvitationCode(rootCtx, &admin.UseInvitationCodeReq{Code: "once", UserID: "u2"}); err != nil {
+		t.Fatalf("use after release: %v", err)
+	}
+}
+
+func TestGenInvitationCode(t *testing.T) {
+	svr, db := newTestSvr(t)
+	rootCtx := mctx.WithAdminUser(context.Background(), "u-root")
+
+	resp, err := svr.GenInvitationCode(rootCtx, &admin.GenInvitationCodeReq{Len: 8, Num: 3, Campaign: "autumn"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.Codes) != 3 {
+		t.Fatalf("codes: %v", resp.Codes)
+	}
+	for _, code := range resp.Codes {
+		r := db.invitations[code]
+		if r == nil || len(code) != 8 || r.MaxUses != 1 || !r.ExpireTime.IsZero() || r.Campaign != "autumn" {
+			t.Fatalf("code %q stored as %v", code, r)
+		}
+	}
+}
@@ -16,18 +16,30 @@ package admin
 
 import (
 	"context"
+	"strings"
 	"time"
 
+	"github.com/openimsdk/tools/utils/datautil"
+
+	"github.com/openimsdk/chat/pkg/common/constant"
 	admindb "github.com/openimsdk/chat/pkg/common/db/table/admin"
-	"github.com/openimsdk/chat/pkg/common/mctx"
 	"github.com/openimsdk/chat/pkg/protocol/admin"
 )
 
 func (o *adminServer) SearchIPForbidden(ctx context.Context, req *admin.SearchIPForbiddenReq) (*admin.SearchIPForbiddenResp, error) {
-	if _, err := mctx.CheckAdmin(ctx); err != nil {
+	if _, err := o.checkPermission(ctx, constant.PermForbidden); err != nil {
 		return nil, err
 	}
-	total, forbiddens, err := o.Database.SearchIPForbidden(ctx, req.Keyword, req.Status, req.Pagination)
+	var (
+		total      int64
+		forbiddens []*admindb.IPForbidden
+		err        error
+	)
+	if req.Ip == "" {
+		total, forbiddens, err = o.Database.SearchIPForbidden(ctx, req.Keyword, req.Status, req.Pagination)
+	} else {
+		total, forbiddens, err = o.searchIPForbiddenMatch(ctx, req)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -47,14 +59,18 @@ func (o *adminServer) SearchIPForbidden(ctx context.Context, req *admin.SearchIP
 }
 
 func (o *adminServer) AddIPForbidden(ctx context.Context, req *admin.AddIPForbiddenReq) (*admin.AddIPForbiddenResp, error) {
-	if _, err := mctx.CheckAdmin(ctx); err != nil {
+	if _, err := o.checkPermission(ctx, constant.PermForbidden); err != nil {
 		return nil, err
 	}
 	now := time.Now()
 	tables := make([]*admindb.IPForbidden, 0, len(req.Forbiddens))
 	for _, forbidden := range req.Forbiddens {
+		rule, err := parseIPRule(forbidden.Ip)
+		if err != nil {
+			return nil, err
+		}
 		tables = append(tables, &admindb.IPForbidden{
-			IP:            forbidden.Ip,
+			IP:            rule,
 			LimitLogin:    forbidden.LimitLogin,
 			LimitRegister: forbidden.LimitRegister,
 			CreateTime:    now,
@@ -63,15 +79,64 @@ func (o *adminServer) AddIPForbidden(ctx context.Context, req *admin.AddIPForbid
 	if err := o.Database.AddIPForbidden(ctx, tables); err != nil {
 		return nil, err
 	}
+	o.reloadIPRules(ctx)
 	return &admin.AddIPForbiddenResp{}, nil
 }
 
 func (o *adminServer) DelIPForbidden(ctx context.Context, req *admin.DelIPForbiddenReq) (*admin.DelIPForbiddenResp, error) {
-	if _, err := mctx.CheckAdmin(ctx); err != nil {
+	if _, err := o.checkPermission(ctx, constant.PermForbidden); err != nil {
 		return nil, err
 	}
-	if err := o.Database.DelIPForbidden(ctx, req.Ips); err != nil {
+	// rules are stored in canonical form, rows stored before that are deleted as given
+	ips := make([]string, 0, len(req.Ips))
+	for _, ip := range req.Ips {
+		ips = append(ips, ip)
+		if rule, err := parseIPRule(ip); err == nil && rule != ip {
+			ips = append(ips, rule)
+		}
+	}
+	if err := o.Database.DelIPForbidden(ctx, ips); err != nil {
 		return nil, err
 	}
+	o.reloadIPRules(ctx)
 	return &admin.DelIPForbiddenResp{}, nil
 }
+
+// searchIPForbiddenMatch returns the rules containing the ip of the request, the most specific first.
+func (o *adminServer) searchIPForbiddenMatch(ctx context.Context, req *admin.SearchIPForbiddenReq) (int64, []*admindb.IPForbidden, error) {
+	addr, err := parseClientIP(req.Ip)
+	if err != nil {
+		return 0, nil, err
+	}
+	rules, err := o.currentIPRules(ctx)
+	if err != nil {
+		return 0, nil, err
+	}
+	var forbiddens []*admindb.IPForbidden
+	for _, forbidden := range rules.matchForbidden(addr) {
+		if matchLimitStatus(forbidden, req.Status) && strings.Contains(strings.ToLower(forbidden.IP), strings.ToLower(req.Keyword)) {
+			forbiddens = append(forbiddens, forbidden)
+		}
+	}
+	return int64(len(forbiddens)), datautil.Paginate(forbiddens, int(req.Pagination.GetPageNumber()), int(req.Pagination.GetShowNumber())), nil
+}
+
+// matchLimitStatus filters like the status of the IP forbidden search.
+func matchLimitStatus(forbidden *admindb.IPForbidden, status int32) bool {
+	switch status {
+	case constant.LimitEmpty:
+		return !forbidden.LimitRegister && !forbidden.LimitLogin
+	case constant.LimitOnlyRegisterIP:
+		return forbidden.LimitRegister && !forbidden.LimitLogin
+	case constant.LimitOnlyLoginIP:
+		return !forbidden.LimitRegister && forbidden.LimitLogin
+	case constant.LimitRegisterIP:
+		return forbidden.LimitRegister
+	case constant.LimitLoginIP:
+		return forbidden.LimitLogin
+	case constant.LimitLoginRegisterIP:
+		return forbidden.LimitRegister && forbidden.LimitLogin
+	default:
+		return true
+	}
+}
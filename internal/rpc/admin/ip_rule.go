@@ -0,0 +1,182 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admin
+
+import (
+	"context"
+	"net/netip"
+	"strconv"
+	"time"
+
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
+	"github.com/openimsdk/tools/mcontext"
+
+	admindb "github.com/openimsdk/chat/pkg/common/db/table/admin"
+	"github.com/openimsdk/chat/pkg/iptree"
+)
+
+// ipRuleRefreshInterval is how often an instance checks whether another instance changed the IP rules
+const ipRuleRefreshInterval = 5 * time.Second
+
+// ipRuleSet holds the IP forbidden list and the user login IP limits in prefix trees.
+// It is replaced as a whole when the rules change.
+type ipRuleSet struct {
+	version   int64
+	forbidden *iptree.Tree[[]*admindb.IPForbidden]
+	limits    map[string]*iptree.Tree[[]*admindb.LimitUserLoginIP]
+}
+
+// matchForbidden returns the forbidden rules containing addr, the most specific first.
+func (r *ipRuleSet) matchForbidden(addr netip.Addr) []*admindb.IPForbidden {
+	return flatten(r.forbidden.MatchAll(addr))
+}
+
+// matchLimit returns the login IP limits of the user containing addr, the most specific first.
+func (r *ipRuleSet) matchLimit(userID string, addr netip.Addr) []*admindb.LimitUserLoginIP {
+	tree, ok := r.limits[userID]
+	if !ok {
+		return nil
+	}
+	return flatten(tree.MatchAll(addr))
+}
+
+func flatten[V any](values [][]V) []V {
+	var res []V
+	for i := len(values) - 1; i >= 0; i-- {
+		res = append(res, values[i]...)
+	}
+	return res
+}
+
+func insertRule[V any](tree *iptree.Tree[[]V], rule string, value V) error {
+	prefixes, _, err := iptree.ParseRule(rule)
+	if err != nil {
+		return err
+	}
+	for _, prefix := range prefixes {
+		values, _ := tree.Get(prefix)
+		tree.Insert(prefix, append(values, value))
+	}
+	return nil
+}
+
+// loadIPRules reads all rules, rules stored before CIDR support that do not parse are skipped.
+// A user whose login IP limits all fail to parse is not limited.
+func (o *adminServer) loadIPRules(ctx context.Context) error {
+	// the version is read first, a change while loading is picked up by the next refresh
+	version, err := o.Database.GetIPRuleVersion(ctx)
+	if err != nil {
+		return err
+	}
+	forbiddens, err := o.Database.FindAllIPForbidden(ctx)
+	if err != nil {
+		return err
+	}
+	limits, err := o.Database.FindAllUserLimitLogin(ctx)
+	if err != nil {
+		return err
+	}
+	rules := &ipRuleSet{
+		version:   version,
+		forbidden: iptree.New[[]*admindb.IPForbidden](),
+		limits:    make(map[string]*iptree.Tree[[]*admindb.LimitUserLoginIP]),
+	}
+	for _, forbidden := range forbiddens {
+		if err := insertRule(rules.forbidden, forbidden.IP, forbidden); err != nil {
+			log.ZWarn(ctx, "skip invalid ip forbidden rule", err, "ip", forbidden.IP)
+		}
+	}
+	invalid := make(map[string][]string)
+	for _, limit := range limits {
+		tree, ok := rules.limits[limit.UserID]
+		if !ok {
+			tree = iptree.New[[]*admindb.LimitUserLoginIP]()
+		}
+		if err := insertRule(tree, limit.IP, limit); err != nil {
+			log.ZWarn(ctx, "skip invalid user login ip limit", err, "userID", limit.UserID, "ip", limit.IP)
+			invalid[limit.UserID] = append(invalid[limit.UserID], limit.IP)
+			continue
+		}
+		rules.limits[limit.UserID] = tree
+	}
+	// a user is limited only by rules that parse, none at all would lock it out
+	for userID, ips := range invalid {
+		if _, ok := rules.limits[userID]; !ok {
+			log.ZWarn(ctx, "user login ip limits ignored, no rule is valid", nil, "userID", userID, "ips", ips)
+		}
+	}
+	o.ipRules.Store(rules)
+	return nil
+}
+
+// currentIPRules returns the rules in memory, loading them if there are none yet.
+func (o *adminServer) currentIPRules(ctx context.Context) (*ipRuleSet, error) {
+	if rules := o.ipRules.Load(); rules != nil {
+		return rules, nil
+	}
+	if err := o.loadIPRules(ctx); err != nil {
+		return nil, err
+	}
+	return o.ipRules.Load(), nil
+}
+
+// reloadIPRules is called after this instance changed the rules, a failure is fixed by the next refresh.
+func (o *adminServer) reloadIPRules(ctx context.Context) {
+	if err := o.loadIPRules(ctx); err != nil {
+		log.ZError(ctx, "reload ip rules failed", err)
+	}
+}
+
+// runIPRuleRefresh reloads the rules when another instance changed them.
+func (o *adminServer) runIPRuleRefresh(ctx context.Context) {
+	ticker := time.NewTicker(ipRuleRefreshInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			ctx := mcontext.SetOperationID(ctx, "ip_rule_refresh_"+strconv.FormatInt(time.Now().UnixMilli(), 10))
+			version, err := o.Database.GetIPRuleVersion(ctx)
+			if err != nil {
+				log.ZError(ctx, "get ip rule version failed", err)
+				continue
+			}
+			if rules := o.ipRules.Load(); rules != nil && rules.version == version {
+				continue
+			}
+			o.reloadIPRules(ctx)
+		}
+	}
+}
+
+// parseClientIP parses the ip of a request checked against the rules.
+func parseClientIP(ip string) (netip.Addr, error) {
+	addr, err := iptree.ParseAddr(ip)
+	if err != nil {
+		return netip.Addr{}, errs.ErrArgs.WrapMsg("invalid ip " + ip)
+	}
+	return addr, nil
+}
+
+// parseIPRule returns the canonical form of a rule given by an admin.
+func parseIPRule(rule string) (string, error) {
+	_, canonical, err := iptree.ParseRule(rule)
+	if err != nil {
+		return "", errs.ErrArgs.WrapMsg("invalid ip rule " + rule + ", want an address, a CIDR prefix or a range start-end")
+	}
+	return canonical, nil
+}
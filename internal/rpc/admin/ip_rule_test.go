@@ -0,0 +1,163 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admin
+
+import (
+	"context"
+	"testing"
+
+	"github.com/openimsdk/tools/errs"
+
+	admindb "github.com/openimsdk/chat/pkg/common/db/table/admin"
+	"github.com/openimsdk/chat/pkg/common/mctx"
+	"github.com/openimsdk/chat/pkg/eerrs"
+	"github.com/openimsdk/chat/pkg/protocol/admin"
+	"github.com/openimsdk/chat/pkg/protocol/sdkwss"
+)
+
+func (d *memDatabase) GetIPRuleVersion(context.Context) (int64, error) {
+	return d.ipVersion, nil
+}
+
+func (d *memDatabase) FindAllIPForbidden(context.Context) ([]*admindb.IPForbidden, error) {
+	return d.forbiddens, nil
+}
+
+func (d *memDatabase) FindAllUserLimitLogin(context.Context) ([]*admindb.LimitUserLoginIP, error) {
+	return d.limits, nil
+}
+
+func (d *memDatabase) AddIPForbidden(_ context.Context, ms []*admindb.IPForbidden) error {
+	d.forbiddens = append(d.forbiddens, ms...)
+	d.ipVersion++
+	return nil
+}
+
+func (d *memDatabase) DelIPForbidden(_ context.Context, ips []string) error {
+	var forbiddens []*admindb.IPForbidden
+	for _, forbidden := range d.forbiddens {
+		del := false
+		for _, ip := range ips {
+			del = del || forbidden.IP == ip
+		}
+		if !del {
+			forbiddens = append(forbiddens, forbidden)
+		}
+	}
+	d.forbiddens = forbiddens
+	d.ipVersion++
+	return nil
+}
+
+func (d *memDatabase) AddUserLimitLogin(_ context.Context, ms []*admindb.LimitUserLoginIP) error {
+	d.limits = append(d.limits, ms...)
+	d.ipVersion++
+	return nil
+}
+
+func TestIPForbiddenRules(t *testing.T) {
+	svr, db := newTestSvr(t)
+	rootCtx := mctx.WithAdminUser(context.Background(), "u-root")
+	// a row stored before CIDR support is skipped instead of failing the load
+	db.forbiddens = []*admindb.IPForbidden{{IP: "not an ip", LimitLogin: true}}
+
+	if _, err := svr.AddIPForbidden(rootCtx, &admin.AddIPForbiddenReq{Forbiddens: []*admin.IPForbiddenAdd{{Ip: "10.1.2.3/16", LimitRegister: true}}}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := svr.AddIPForbidden(rootCtx, &admin.AddIPForbiddenReq{Forbiddens: []*admin.IPForbiddenAdd{{Ip: "10.1.0.10-10.1.0.20", LimitLogin: true}}}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := svr.AddIPForbidden(rootCtx, &admin.AddIPForbiddenReq{Forbiddens: []*admin.IPForbiddenAdd{{Ip: "10.1.0.0/33"}}}); !isCode(err, errs.ErrArgs) {
+		t.Fatalf("invalid rule: want ErrArgs, got %v", err)
+	}
+	if db.forbiddens[1].IP != "10.1.0.0/16" {
+		t.Fatalf("rule stored as %q", db.forbiddens[1].IP)
+	}
+
+	if _, err := svr.CheckRegisterForbidden(context.Background(), &admin.CheckRegisterForbiddenReq{Ip: "10.1.200.1"}); !isCode(err, eerrs.ErrForbidden) {
+		t.Fatalf("register in prefix: want ErrForbidden, got %v", err)
+	}
+	if _, err := svr.CheckRegisterForbidden(context.Background(), &admin.CheckRegisterForbiddenReq{Ip: "10.2.0.1"}); err != nil {
+		t.Fatalf("register outside prefix: %v", err)
+	}
+	if _, err := svr.CheckLoginForbidden(context.Background(), &admin.CheckLoginForbiddenReq{UserID: "u1", Ip: "10.1.0.15"}); !isCode(err, eerrs.ErrForbidden) {
+		t.Fatalf("login in range: want ErrForbidden, got %v", err)
+	}
+	if _, err := svr.CheckLoginForbidden(context.Background(), &admin.CheckLoginForbiddenReq{UserID: "u1", Ip: "10.1.0.21"}); err != nil {
+		t.Fatalf("login outside range: %v", err)
+	}
+
+	resp, err := svr.SearchIPForbidden(rootCtx, &admin.SearchIPForbiddenReq{Ip: "10.1.0.16", Pagination: &sdkwss.RequestPagination{PageNumber: 1, ShowNumber: 10}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the range is split into 10.1.0.10/31, 10.1.0.12/30, 10.1.0.16/30 and 10.1.0.20/32
+	if resp.Total != 2 || resp.Forbiddens[0].Ip != "10.1.0.10-10.1.0.20" || resp.Forbiddens[1].Ip != "10.1.0.0/16" {
+		t.Fatalf("search by ip: %v", resp)
+	}
+
+	// the rule is deleted as the admin typed it
+	if _, err := svr.DelIPForbidden(rootCtx, &admin.DelIPForbiddenReq{Ips: []string{"10.1.9.9/16"}}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := svr.CheckRegisterForbidden(context.Background(), &admin.CheckRegisterForbiddenReq{Ip: "10.1.200.1"}); err != nil {
+		t.Fatalf("register after delete: %v", err)
+	}
+}
+
+func TestUserIPLimitRules(t *testing.T) {
+	svr, _ := newTestSvr(t)
+	rootCtx := mctx.WithAdminUser(context.Background(), "u-root")
+	limits := []*admin.UserIPLimitLogin{{UserID: "u1", Ip: "192.168.1.0/24"}, {UserID: "u1", Ip: "2001:db8::/32"}}
+	if _, err := svr.AddUserIPLimitLogin(rootCtx, &admin.AddUserIPLimitLoginReq{Limits: limits}); err != nil {
+		t.Fatal(err)
+	}
+	for _, c := range []struct {
+		userID string
+		ip     string
+		ok     bool
+	}{
+		{"u1", "192.168.1.77", true},
+		{"u1", "::ffff:192.168.1.77", true},
+		{"u1", "2001:db8:1::1", true},
+		{"u1", "192.168.2.1", false},
+		{"u1", "", false},
+		{"u2", "192.168.2.1", true},
+	} {
+		_, err := svr.CheckLoginForbidden(context.Background(), &admin.CheckLoginForbiddenReq{UserID: c.userID, Ip: c.ip})
+		if c.ok && err != nil || !c.ok && !isCode(err, eerrs.ErrForbidden) {
+			t.Errorf("%s from %q: ok %v, got %v", c.userID, c.ip, c.ok, err)
+		}
+	}
+}
+
+func TestInvalidUserIPLimitRules(t *testing.T) {
+	svr, db := newTestSvr(t)
+	// rows stored before CIDR support, none of u1's parse, one of u2's does
+	db.limits = []*admindb.LimitUserLoginIP{
+		{UserID: "u1", IP: "not an ip"},
+		{UserID: "u2", IP: "bad"},
+		{UserID: "u2", IP: "192.168.1.0/24"},
+	}
+	if _, err := svr.CheckLoginForbidden(context.Background(), &admin.CheckLoginForbiddenReq{UserID: "u1", Ip: "10.0.0.1"}); err != nil {
+		t.Fatalf("user without a valid limit: %v", err)
+	}
+	if _, err := svr.CheckLoginForbidden(context.Background(), &admin.CheckLoginForbiddenReq{UserID: "u2", Ip: "10.0.0.1"}); !isCode(err, eerrs.ErrForbidden) {
+		t.Fatalf("user with a valid limit: want ErrForbidden, got %v", err)
+	}
+	if _, err := svr.CheckLoginForbidden(context.Background(), &admin.CheckLoginForbiddenReq{UserID: "u2", Ip: "192.168.1.5"}); err != nil {
+		t.Fatalf("user inside its valid limit: %v", err)
+	}
+}
@@ -0,0 +1,152 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admin
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/openimsdk/tools/errs"
+
+	"github.com/openimsdk/chat/pkg/common/constant"
+	"github.com/openimsdk/chat/pkg/common/db/cache"
+	"github.com/openimsdk/chat/pkg/eerrs"
+	"github.com/openimsdk/chat/pkg/protocol/admin"
+)
+
+func (d *memDatabase) SetSessionToken(_ context.Context, userID string, session *cache.Session, oldToken string) error {
+	if d.tokens[userID] == oldToken {
+		delete(d.tokens, userID)
+	}
+	clone := *session
+	d.sessions[userID][session.SessionID] = &clone
+	return nil
+}
+
+func (d *memDatabase) AddRefreshToken(_ context.Context, tokenHash string, refresh *cache.RefreshToken, _ time.Duration) error {
+	d.refreshTokens[tokenHash] = refresh
+	return nil
+}
+
+func (d *memDatabase) GetRefreshToken(_ context.Context, tokenHash string) (*cache.RefreshToken, error) {
+	return d.refreshTokens[tokenHash], nil
+}
+
+func (d *memDatabase) SwapRefreshToken(_ context.Context, _ string, sessionID string, oldHash string, newHash string) (string, error) {
+	current := d.refreshCurrent[sessionID]
+	if current == oldHash {
+		d.refreshCurrent[sessionID] = newHash
+	}
+	return current, nil
+}
+
+func TestRefreshToken(t *testing.T) {
+	svr, db := newTestSvr(t)
+	svr.Token.AccessExpires = time.Minute
+	im := &offlineIM{}
+	svr.IM = im
+	ctx := context.Background()
+
+	login, err := svr.CreateToken(ctx, &admin.CreateTokenReq{UserID: "u1", UserType: constant.NormalUser, DeviceID: "phone", Platform: 1, Ip: "10.0.0.1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if login.RefreshToken == "" {
+		t.Fatal("no refresh token issued")
+	}
+	if parsed, err := svr.ParseToken(ctx, &admin.ParseTokenReq{Token: login.Token}); err != nil || parsed.Legacy || parsed.SessionID != login.SessionID {
+		t.Fatalf("parse = %v, %v", parsed, err)
+	}
+
+	// a user refresh token is not accepted by the admin API
+	if _, err := svr.RefreshToken(ctx, &admin.RefreshTokenReq{RefreshToken: login.RefreshToken, UserType: constant.AdminUser}); !isCode(err, eerrs.ErrTokenNotExist) {
+		t.Fatalf("wrong user type: want TokenNotExist, got %v", err)
+	}
+
+	refreshed, err := svr.RefreshToken(ctx, &admin.RefreshTokenReq{RefreshToken: login.RefreshToken, UserType: constant.NormalUser, Ip: "10.0.0.2"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if refreshed.UserID != "u1" || refreshed.SessionID != login.SessionID || refreshed.Token == login.Token || refreshed.RefreshToken == login.RefreshToken {
+		t.Fatalf("refreshed = %v", refreshed)
+	}
+	if _, err := svr.ParseToken(ctx, &admin.ParseTokenReq{Token: login.Token}); err == nil {
+		t.Fatal("the rotated token still parses")
+	}
+	if _, err := svr.ParseToken(ctx, &admin.ParseTokenReq{Token: refreshed.Token}); err != nil {
+		t.Fatal(err)
+	}
+	if session := db.sessions["u1"][login.SessionID]; session.IP != "10.0.0.2" || session.DeviceID != "phone" {
+		t.Fatalf("session = %+v", session)
+	}
+
+	// presenting the used refresh token again revokes the session and its newest tokens
+	if _, err := svr.RefreshToken(ctx, &admin.RefreshTokenReq{RefreshToken: login.RefreshToken, UserType: constant.NormalUser}); !isCode(err, eerrs.ErrRefreshTokenReused) {
+		t.Fatalf("reuse: want RefreshTokenReused, got %v", err)
+	}
+	if _, err := svr.ParseToken(ctx, &admin.ParseTokenReq{Token: refreshed.Token}); err == nil {
+		t.Fatal("token of the revoked session still parses")
+	}
+	if _, err := svr.RefreshToken(ctx, &admin.RefreshTokenReq{RefreshToken: refreshed.RefreshToken, UserType: constant.NormalUser}); !isCode(err, errs.ErrTokenExpired) {
+		t.Fatalf("refresh of the revoked session: want TokenExpired, got %v", err)
+	}
+	if len(im.platforms) != 1 || im.platforms[0] != 1 {
+		t.Fatalf("offline platforms %v", im.platforms)
+	}
+
+	// logging the user out everywhere revokes the refresh tokens too
+	login, err = svr.CreateToken(ctx, &admin.CreateTokenReq{UserID: "u1", UserType: constant.NormalUser})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := svr.InvalidateToken(ctx, &admin.InvalidateTokenReq{UserID: "u1"}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := svr.RefreshToken(ctx, &admin.RefreshTokenReq{RefreshToken: login.RefreshToken, UserType: constant.NormalUser}); !isCode(err, errs.ErrTokenExpired) {
+		t.Fatalf("refresh after logout: want TokenExpired, got %v", err)
+	}
+}
+
+func TestLegacyToken(t *testing.T) {
+	svr, db := newTestSvr(t)
+	ctx := context.Background()
+
+	// tokens issued before refresh tokens carry no session
+	token, err := svr.Token.CreateToken("u1", constant.NormalUser, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	db.tokens["u1"] = token
+	parsed, err := svr.ParseToken(ctx, &admin.ParseTokenReq{Token: token})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !parsed.Legacy || parsed.SessionID != cache.SessionID(token) {
+		t.Fatalf("parse = %v", parsed)
+	}
+
+	svr.Token.LegacyUntil = time.Now().Add(-time.Second)
+	if _, err := svr.ParseToken(ctx, &admin.ParseTokenReq{Token: token}); !isCode(err, errs.ErrTokenExpired) {
+		t.Fatalf("after the migration window: want TokenExpired, got %v", err)
+	}
+	login, err := svr.CreateToken(ctx, &admin.CreateTokenReq{UserID: "u1", UserType: constant.NormalUser})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := svr.ParseToken(ctx, &admin.ParseTokenReq{Token: login.Token}); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,259 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admin
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
+	"github.com/openimsdk/tools/utils/datautil"
+	"google.golang.org/grpc"
+
+	"github.com/openimsdk/chat/pkg/common/constant"
+	"github.com/openimsdk/chat/pkg/common/db/dbutil"
+	admindb "github.com/openimsdk/chat/pkg/common/db/table/admin"
+	"github.com/openimsdk/chat/pkg/common/mctx"
+	"github.com/openimsdk/chat/pkg/protocol/admin"
+)
+
+// superAdminPermission is recorded in the audit log when an operation reserved to the super admin is denied,
+// it cannot be granted through a role.
+const superAdminPermission = "super_admin"
+
+// legacyRoleID is given to admins created before roles existed, it keeps what they could do back then.
+const legacyRoleID = "legacy_admin"
+
+// defaultRoles are created at startup if missing and can be changed afterwards.
+var defaultRoles = []*admindb.AdminRole{
+	{RoleID: "moderator", Name: "Moderator", Permissions: []string{constant.PermUserBlock}},
+	{RoleID: "support", Name: "Support", Permissions: []string{constant.PermUserPassword, constant.PermStatistic}},
+	{RoleID: "ops", Name: "Ops", Permissions: []string{constant.PermClientConfig, constant.PermApplet}},
+	{RoleID: legacyRoleID, Name: "Legacy admin", Permissions: []string{
+		constant.PermUserBlock, constant.PermUserPassword, constant.PermUserManage, constant.PermStatistic, constant.PermClientConfig,
+		constant.PermApplet, constant.PermRegisterDefault, constant.PermInvitationCode, constant.PermForbidden, constant.PermPost,
+	}},
+}
+
+func (o *adminServer) initRoles(ctx context.Context) error {
+	for _, role := range defaultRoles {
+		if _, err := o.Database.GetAdminRole(ctx, role.RoleID); err == nil {
+			continue
+		} else if !dbutil.IsDBNotFound(err) {
+			return err
+		}
+		r := *role
+		r.CreateTime = time.Now()
+		r.UpdateTime = r.CreateTime
+		if err := o.Database.CreateAdminRole(ctx, []*admindb.AdminRole{&r}); err != nil {
+			return err
+		}
+	}
+	migrated, err := o.Database.SetLegacyAdminRoles(ctx, []string{legacyRoleID})
+	if err != nil {
+		return err
+	}
+	if migrated > 0 {
+		log.ZInfo(ctx, "legacy admins given the legacy role", "count", migrated, "roleID", legacyRoleID)
+	}
+	return nil
+}
+
+// adminPermissions returns the permissions of the admin, the union of its roles.
+func (o *adminServer) adminPermissions(ctx context.Context, a *admindb.Admin) ([]string, error) {
+	if a.Level == constant.AdvancedUserLevel {
+		return constant.AdminPermissions, nil
+	}
+	roles, err := o.Database.FindAdminRole(ctx, a.Roles)
+	if err != nil {
+		return nil, err
+	}
+	var permissions []string
+	for _, role := range roles {
+		permissions = append(permissions, role.Permissions...)
+	}
+	return datautil.Distinct(permissions), nil
+}
+
+// checkPermission returns the admin user id of ctx if the admin has the permission.
+func (o *adminServer) checkPermission(ctx context.Context, permission string) (string, error) {
+	method, _ := grpc.Method(ctx)
+	return o.authorize(ctx, permission, method)
+}
+
+func (o *adminServer) authorize(ctx context.Context, permission string, method string) (string, error) {
+	userID, err := mctx.CheckAdmin(ctx)
+	if err != nil {
+		return "", err
+	}
+	a, err := o.Database.GetAdminUserID(ctx, userID)
+	if err != nil {
+		return "", err
+	}
+	permissions, err := o.adminPermissions(ctx, a)
+	if err != nil {
+		return "", err
+	}
+	if datautil.Contain(permission, permissions...) {
+		return userID, nil
+	}
+	o.auditDenied(ctx, permission, method)
+	return "", errs.ErrNoPermission.WrapMsg("missing admin permission " + permission)
+}
+
+// auditDenied records a denied admin operation, a failure to write it does not change the response.
+// If the call is audited by the interceptor the permission is added to its entry instead.
+func (o *adminServer) auditDenied(ctx context.Context, permission string, method string) {
+	if entry := auditEntryFrom(ctx); entry != nil {
+		entry.Permission = permission
+		return
+	}
+	entry := &admindb.AuditLog{
+		OperatorUserID: mctx.GetOpUserID(ctx),
+		Method:         method,
+		Permission:     permission,
+		ResultCode:     int32(errs.ErrNoPermission.Code()),
+		ResultMsg:      errs.ErrNoPermission.Msg(),
+		IP:             mctx.GetOpClientIP(ctx),
+		CreateTime:     time.Now(),
+	}
+	if err := o.Database.CreateAuditLog(ctx, []*admindb.AuditLog{entry}); err != nil {
+		log.ZError(ctx, "write permission denied audit log", err, "permission", permission, "method", method)
+	}
+}
+
+// checkRoles returns an error if a role does not exist.
+func (o *adminServer) checkRoles(ctx context.Context, roleIDs []string) error {
+	if len(roleIDs) == 0 {
+		return nil
+	}
+	roles, err := o.Database.FindAdminRole(ctx, roleIDs)
+	if err != nil {
+		return err
+	}
+	if len(roles) != len(datautil.Distinct(roleIDs)) {
+		exist := datautil.Slice(roles, func(r *admindb.AdminRole) string { return r.RoleID })
+		return errs.ErrArgs.WrapMsg("role not found", "roleIDs", datautil.SliceSub(roleIDs, exist))
+	}
+	return nil
+}
+
+func (o *adminServer) CheckAdminPermission(ctx context.Context, req *admin.CheckAdminPermissionReq) (*admin.CheckAdminPermissionResp, error) {
+	method := req.Path
+	if method == "" {
+		method, _ = grpc.Method(ctx)
+	}
+	if _, err := o.authorize(ctx, req.Permission, method); err != nil {
+		return nil, err
+	}
+	return &admin.CheckAdminPermissionResp{}, nil
+}
+
+func (o *adminServer) AddAdminRole(ctx context.Context, req *admin.AddAdminRoleReq) (*admin.AddAdminRoleResp, error) {
+	if err := o.CheckSuperAdmin(ctx); err != nil {
+		return nil, err
+	}
+	if _, err := o.Database.GetAdminRole(ctx, req.RoleID); err == nil {
+		return nil, errs.ErrDuplicateKey.WrapMsg("role already exists")
+	} else if !dbutil.IsDBNotFound(err) {
+		return nil, err
+	}
+	now := time.Now()
+	role := &admindb.AdminRole{
+		RoleID:      req.RoleID,
+		Name:        req.Name,
+		Permissions: datautil.Distinct(req.Permissions),
+		CreateTime:  now,
+		UpdateTime:  now,
+	}
+	if role.Permissions == nil {
+		role.Permissions = []string{}
+	}
+	if err := o.Database.CreateAdminRole(ctx, []*admindb.AdminRole{role}); err != nil {
+		return nil, err
+	}
+	return &admin.AddAdminRoleResp{}, nil
+}
+
+func (o *adminServer) UpdateAdminRole(ctx context.Context, req *admin.UpdateAdminRoleReq) (*admin.UpdateAdminRoleResp, error) {
+	if err := o.CheckSuperAdmin(ctx); err != nil {
+		return nil, err
+	}
+	permissions := req.Permissions
+	if permissions == nil {
+		permissions = []string{}
+	}
+	update := map[string]any{
+		"name":        req.Name,
+		"permissions": permissions,
+		"update_time": time.Now(),
+	}
+	if err := o.Database.UpdateAdminRole(ctx, req.RoleID, update); err != nil {
+		return nil, err
+	}
+	return &admin.UpdateAdminRoleResp{}, nil
+}
+
+func (o *adminServer) DelAdminRole(ctx context.Context, req *admin.DelAdminRoleReq) (*admin.DelAdminRoleResp, error) {
+	if err := o.CheckSuperAdmin(ctx); err != nil {
+		return nil, err
+	}
+	if err := o.Database.DelAdminRole(ctx, req.RoleIDs); err != nil {
+		return nil, err
+	}
+	return &admin.DelAdminRoleResp{}, nil
+}
+
+func (o *adminServer) SearchAdminRole(ctx context.Context, req *admin.SearchAdminRoleReq) (*admin.SearchAdminRoleResp, error) {
+	if err := o.CheckSuperAdmin(ctx); err != nil {
+		return nil, err
+	}
+	total, roles, err := o.Database.SearchAdminRole(ctx, req.Keyword, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	resp := &admin.SearchAdminRoleResp{Total: uint32(total), Roles: make([]*admin.AdminRole, 0, len(roles))}
+	for _, role := range roles {
+		resp.Roles = append(resp.Roles, &admin.AdminRole{
+			RoleID:      role.RoleID,
+			Name:        role.Name,
+			Permissions: role.Permissions,
+			CreateTime:  role.CreateTime.UnixMilli(),
+			UpdateTime:  role.UpdateTime.UnixMilli(),
+		})
+	}
+	return resp, nil
+}
+
+func (o *adminServer) SetAdminRoles(ctx context.Context, req *admin.SetAdminRolesReq) (*admin.SetAdminRolesResp, error) {
+	if err := o.CheckSuperAdmin(ctx); err != nil {
+		return nil, err
+	}
+	if _, err := o.Database.GetAdmin(ctx, req.Account); err != nil {
+		return nil, err
+	}
+	if err := o.checkRoles(ctx, req.RoleIDs); err != nil {
+		return nil, err
+	}
+	roleIDs := req.RoleIDs
+	if roleIDs == nil {
+		roleIDs = []string{}
+	}
+	if err := o.Database.UpdateAdminByAccount(ctx, req.Account, map[string]any{"roles": roleIDs}); err != nil {
+		return nil, err
+	}
+	return &admin.SetAdminRolesResp{}, nil
+}
@@ -0,0 +1,146 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admin
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/openimsdk/tools/errs"
+
+	"github.com/openimsdk/chat/pkg/common/constant"
+	admindb "github.com/openimsdk/chat/pkg/common/db/table/admin"
+	"github.com/openimsdk/chat/pkg/common/mctx"
+	"github.com/openimsdk/chat/pkg/protocol/admin"
+	"github.com/openimsdk/protocol/wrapperspb"
+)
+
+func TestAdminRoles(t *testing.T) {
+	svr, db := newTestSvr(t)
+	if err := svr.initRoles(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	rootCtx := mctx.WithAdminUser(context.Background(), "u-root")
+	staffCtx := mctx.WithAdminUser(context.Background(), "u-staff")
+
+	// an admin without roles has no permission, the denial is audited
+	if _, err := svr.SetClientConfig(staffCtx, &admin.SetClientConfigReq{Config: map[string]string{"k": "v"}}); !isCode(err, errs.ErrNoPermission) {
+		t.Fatalf("no role: want NoPermission, got %v", err)
+	}
+	if len(db.audits) != 1 || db.audits[0].OperatorUserID != "u-staff" || db.audits[0].Permission != constant.PermClientConfig ||
+		db.audits[0].ResultCode != int32(errs.ErrNoPermission.Code()) {
+		t.Fatalf("audits = %+v", db.audits)
+	}
+
+	// only the super admin assigns roles, and only existing ones
+	if _, err := svr.SetAdminRoles(staffCtx, &admin.SetAdminRolesReq{Account: "staff", RoleIDs: []string{"ops"}}); !isCode(err, errs.ErrNoPermission) {
+		t.Fatalf("self assign: want NoPermission, got %v", err)
+	}
+	if last := db.audits[len(db.audits)-1]; last.Permission != superAdminPermission {
+		t.Fatalf("super admin denial audited as %q", last.Permission)
+	}
+	if _, err := svr.SetAdminRoles(rootCtx, &admin.SetAdminRolesReq{Account: "staff", RoleIDs: []string{"moderator", "missing"}}); !isCode(err, errs.ErrArgs) {
+		t.Fatalf("unknown role: want ErrArgs, got %v", err)
+	}
+	if _, err := svr.SetAdminRoles(rootCtx, &admin.SetAdminRolesReq{Account: "staff", RoleIDs: []string{"moderator"}}); err != nil {
+		t.Fatal(err)
+	}
+
+	info, err := svr.GetAdminInfo(staffCtx, &admin.GetAdminInfoReq{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(info.Roles, []string{"moderator"}) || !reflect.DeepEqual(info.Permissions, []string{constant.PermUserBlock}) {
+		t.Fatalf("info roles %v, permissions %v", info.Roles, info.Permissions)
+	}
+	if _, err := svr.CheckAdminPermission(staffCtx, &admin.CheckAdminPermissionReq{Permission: constant.PermUserBlock}); err != nil {
+		t.Fatal(err)
+	}
+	audits := len(db.audits)
+	if _, err := svr.CheckAdminPermission(staffCtx, &admin.CheckAdminPermissionReq{Permission: constant.PermApplet, Path: "/applet/add"}); !isCode(err, errs.ErrNoPermission) {
+		t.Fatalf("moderator applet: want NoPermission, got %v", err)
+	}
+	if len(db.audits) != audits+1 || db.audits[audits].Method != "/applet/add" {
+		t.Fatalf("route denial audits = %+v", db.audits[audits:])
+	}
+
+	// the super admin holds every permission without roles
+	for _, permission := range constant.AdminPermissions {
+		if _, err := svr.CheckAdminPermission(rootCtx, &admin.CheckAdminPermissionReq{Permission: permission}); err != nil {
+			t.Fatalf("super admin %s: %v", permission, err)
+		}
+	}
+}
+
+func TestLegacyAdminRole(t *testing.T) {
+	svr, db := newTestSvr(t)
+	db.admins["old"] = &admindb.Admin{Account: "old", UserID: "u-old", Level: constant.NormalAdmin}
+	if err := svr.initRoles(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(db.admins["old"].Roles, []string{legacyRoleID}) {
+		t.Fatalf("legacy admin roles %v", db.admins["old"].Roles)
+	}
+	if len(db.admins["staff"].Roles) != 0 || len(db.admins["root"].Roles) != 0 {
+		t.Fatalf("admins with a roles field changed: staff %v, root %v", db.admins["staff"].Roles, db.admins["root"].Roles)
+	}
+
+	// the admin keeps what it could do before roles, but not permissions added since
+	oldCtx := mctx.WithAdminUser(context.Background(), "u-old")
+	for _, permission := range []string{constant.PermUserManage, constant.PermClientConfig, constant.PermInvitationCode} {
+		if _, err := svr.CheckAdminPermission(oldCtx, &admin.CheckAdminPermissionReq{Permission: permission}); err != nil {
+			t.Fatalf("legacy admin %s: %v", permission, err)
+		}
+	}
+	if _, err := svr.CheckAdminPermission(oldCtx, &admin.CheckAdminPermissionReq{Permission: constant.PermAuditLog}); !isCode(err, errs.ErrNoPermission) {
+		t.Fatalf("legacy admin audit log: want NoPermission, got %v", err)
+	}
+}
+
+func (d *memDatabase) UpdateAdmin(_ context.Context, userID string, update map[string]any) error {
+	for _, a := range d.admins {
+		if a.UserID != userID {
+			continue
+		}
+		for k, v := range update {
+			switch k {
+			case "level":
+				a.Level = v.(int32)
+			case "nickname":
+				a.Nickname = v.(string)
+			}
+		}
+	}
+	return nil
+}
+
+func TestAdminUpdateInfoLevel(t *testing.T) {
+	svr, db := newTestSvr(t)
+	staffCtx := mctx.WithAdminUser(context.Background(), "u-staff")
+	// an admin with roles only cannot make itself a super admin
+	if _, err := svr.AdminUpdateInfo(staffCtx, &admin.AdminUpdateInfoReq{Level: wrapperspb.Int32(constant.AdvancedUserLevel)}); !isCode(err, errs.ErrNoPermission) {
+		t.Fatalf("raise own level: want NoPermission, got %v", err)
+	}
+	if db.admins["staff"].Level != constant.NormalAdmin {
+		t.Fatalf("level changed to %d", db.admins["staff"].Level)
+	}
+	if _, err := svr.AdminUpdateInfo(staffCtx, &admin.AdminUpdateInfoReq{Nickname: wrapperspb.String("staff")}); err != nil {
+		t.Fatalf("update own nickname: %v", err)
+	}
+	if _, err := svr.AdminUpdateInfo(mctx.WithAdminUser(context.Background(), "u-root"), &admin.AdminUpdateInfoReq{Level: wrapperspb.Int32(constant.AdvancedUserLevel)}); err != nil {
+		t.Fatalf("super admin sets level: %v", err)
+	}
+}
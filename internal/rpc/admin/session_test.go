@@ -0,0 +1,242 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admin
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/openimsdk/tools/errs"
+
+	"github.com/openimsdk/chat/pkg/common/constant"
+	"github.com/openimsdk/chat/pkg/common/db/cache"
+	"github.com/openimsdk/chat/pkg/common/mctx"
+	"github.com/openimsdk/chat/pkg/protocol/admin"
+	constantpb "github.com/openimsdk/chat/pkg/protocol/constant"
+)
+
+func (d *memDatabase) CacheSession(_ context.Context, userID string, session *cache.Session, _ time.Duration) error {
+	if d.sessions[userID] == nil {
+		d.sessions[userID] = map[string]*cache.Session{}
+	}
+	clone := *session
+	d.sessions[userID][session.SessionID] = &clone
+	d.tokens[userID] = session.Token
+	return nil
+}
+
+func (d *memDatabase) GetTokens(_ context.Context, userID string) (map[string]int32, error) {
+	tokens := map[string]int32{}
+	for _, session := range d.sessions[userID] {
+		tokens[session.Token] = constantpb.NormalToken
+	}
+	if token, ok := d.tokens[userID]; ok {
+		tokens[token] = constantpb.NormalToken
+	}
+	return tokens, nil
+}
+
+func (d *memDatabase) GetSessions(_ context.Context, userID string) ([]*cache.Session, error) {
+	sessions := make([]*cache.Session, 0, len(d.sessions[userID]))
+	for _, session := range d.sessions[userID] {
+		clone := *session
+		sessions = append(sessions, &clone)
+	}
+	return sessions, nil
+}
+
+func (d *memDatabase) SetSessionSeen(_ context.Context, userID string, sessionID string, seen time.Time) error {
+	if session, ok := d.sessions[userID][sessionID]; ok {
+		session.LastSeen = seen.UnixMilli()
+	}
+	return nil
+}
+
+func (d *memDatabase) DeleteSessions(_ context.Context, userID string, tokens []string, sessionIDs []string) error {
+	for _, token := range tokens {
+		if d.tokens[userID] == token {
+			delete(d.tokens, userID)
+		}
+	}
+	for _, sessionID := range sessionIDs {
+		delete(d.sessions[userID], sessionID)
+		delete(d.refreshCurrent, sessionID)
+	}
+	return nil
+}
+
+func (im *offlineIM) ForceOffLinePlatform(_ context.Context, userID string, platformID int32) error {
+	im.platforms = append(im.platforms, platformID)
+	return nil
+}
+
+func TestUserSessions(t *testing.T) {
+	svr, db := newTestSvr(t)
+	im := &offlineIM{}
+	svr.IM = im
+	ctx := context.Background()
+	userCtx := mctx.WithOpUserID(ctx, "u1", constant.NormalUser)
+
+	login := func(deviceID string, platform int32) *admin.CreateTokenResp {
+		resp, err := svr.CreateToken(ctx, &admin.CreateTokenReq{UserID: "u1", UserType: constant.NormalUser, DeviceID: deviceID, Platform: platform, Ip: "10.0.0.1", UserAgent: "test"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if resp.SessionID == "" || resp.RefreshToken != "" {
+			t.Fatalf("login = %v", resp)
+		}
+		return resp
+	}
+	phone := login("phone", 1)
+	web := login("browser", 5)
+	tablet := login("tablet", 1)
+
+	parsed, err := svr.ParseToken(ctx, &admin.ParseTokenReq{Token: web.Token})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if parsed.SessionID != web.SessionID {
+		t.Fatalf("parsed sessionID %s, want %s", parsed.SessionID, web.SessionID)
+	}
+
+	// a token issued before sessions existed is dropped once it no longer parses
+	db.tokens["u1"] = "legacy"
+	list, err := svr.ListSessions(userCtx, &admin.ListSessionsReq{UserID: "u1", CurrentSessionID: phone.SessionID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(list.Sessions) != 3 || list.Sessions[0].SessionID != web.SessionID || list.Sessions[0].LastSeen == 0 {
+		t.Fatalf("sessions = %v", list.Sessions)
+	}
+	if _, ok := db.tokens["u1"]; ok {
+		t.Fatal("legacy token was not pruned")
+	}
+	for _, session := range list.Sessions {
+		if session.Current != (session.SessionID == phone.SessionID) {
+			t.Fatalf("session %s current = %v", session.SessionID, session.Current)
+		}
+		if session.SessionID == tablet.SessionID && (session.DeviceID != "tablet" || session.Platform != 1 || session.Ip != "10.0.0.1") {
+			t.Fatalf("tablet session = %v", session)
+		}
+	}
+
+	// users only see their own sessions, admins need the permission
+	if _, err := svr.ListSessions(mctx.WithOpUserID(ctx, "u2", constant.NormalUser), &admin.ListSessionsReq{UserID: "u1"}); !isCode(err, errs.ErrNoPermission) {
+		t.Fatalf("other user: want NoPermission, got %v", err)
+	}
+	if _, err := svr.ListSessions(mctx.WithAdminUser(ctx, "u-staff"), &admin.ListSessionsReq{UserID: "u1"}); !isCode(err, errs.ErrNoPermission) {
+		t.Fatalf("staff without permission: want NoPermission, got %v", err)
+	}
+	if _, err := svr.ListSessions(mctx.WithAdminUser(ctx, "u-root"), &admin.ListSessionsReq{UserID: "u1"}); err != nil {
+		t.Fatal(err)
+	}
+
+	// the tablet still uses platform 1, so OpenIM keeps the user online there
+	if _, err := svr.RevokeSession(userCtx, &admin.RevokeSessionReq{UserID: "u1", SessionID: phone.SessionID}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := svr.ParseToken(ctx, &admin.ParseTokenReq{Token: phone.Token}); err == nil {
+		t.Fatal("revoked token still parses")
+	}
+	if len(im.platforms) != 0 {
+		t.Fatalf("offline platforms %v", im.platforms)
+	}
+	if _, err := svr.RevokeSession(userCtx, &admin.RevokeSessionReq{UserID: "u1", SessionID: phone.SessionID}); !isCode(err, errs.ErrRecordNotFound) {
+		t.Fatalf("revoke twice: want RecordNotFound, got %v", err)
+	}
+
+	other, err := svr.RevokeOtherSessions(userCtx, &admin.RevokeOtherSessionsReq{UserID: "u1", CurrentSessionID: web.SessionID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if other.Revoked != 1 || len(im.platforms) != 1 || im.platforms[0] != 1 {
+		t.Fatalf("revoked %d, offline platforms %v", other.Revoked, im.platforms)
+	}
+	if _, err := svr.ParseToken(ctx, &admin.ParseTokenReq{Token: web.Token}); err != nil {
+		t.Fatalf("current session revoked: %v", err)
+	}
+
+	// expired sessions are pruned when listed
+	db.sessions["u1"][web.SessionID].ExpireTime = time.Now().Add(-time.Second).UnixMilli()
+	list, err = svr.ListSessions(userCtx, &admin.ListSessionsReq{UserID: "u1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(list.Sessions) != 0 || len(db.sessions["u1"]) != 0 {
+		t.Fatalf("expired sessions = %v", list.Sessions)
+	}
+}
+
+func TestLegacyTokenSessions(t *testing.T) {
+	svr, db := newTestSvr(t)
+	svr.IM = &offlineIM{}
+	ctx := context.Background()
+	userCtx := mctx.WithOpUserID(ctx, "u1", constant.NormalUser)
+
+	phone, err := svr.CreateToken(ctx, &admin.CreateTokenReq{UserID: "u1", UserType: constant.NormalUser, DeviceID: "phone", Platform: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	legacy, err := svr.Token.CreateToken("u1", constant.NormalUser, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	db.tokens["u1"] = legacy
+
+	// the legacy token is listed without a session and marked current when it made the request
+	list, err := svr.ListSessions(userCtx, &admin.ListSessionsReq{UserID: "u1", CurrentToken: legacy})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(list.Sessions) != 2 || list.Sessions[0].SessionID != phone.SessionID || list.Sessions[0].Current {
+		t.Fatalf("sessions = %v", list.Sessions)
+	}
+	if entry := list.Sessions[1]; !entry.Legacy || !entry.Current || entry.SessionID != "" || entry.ExpireTime <= time.Now().UnixMilli() {
+		t.Fatalf("legacy entry = %v", entry)
+	}
+
+	// a legacy caller keeps its own token
+	other, err := svr.RevokeOtherSessions(userCtx, &admin.RevokeOtherSessionsReq{UserID: "u1", CurrentToken: legacy})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if other.Revoked != 1 {
+		t.Fatalf("revoked %d, want 1", other.Revoked)
+	}
+	if _, err := svr.ParseToken(ctx, &admin.ParseTokenReq{Token: legacy}); err != nil {
+		t.Fatalf("current legacy token revoked: %v", err)
+	}
+
+	// the legacy token is revoked along with the other sessions
+	web, err := svr.CreateToken(ctx, &admin.CreateTokenReq{UserID: "u1", UserType: constant.NormalUser, DeviceID: "browser", Platform: 5})
+	if err != nil {
+		t.Fatal(err)
+	}
+	db.tokens["u1"] = legacy
+	other, err = svr.RevokeOtherSessions(userCtx, &admin.RevokeOtherSessionsReq{UserID: "u1", CurrentSessionID: web.SessionID, CurrentToken: web.Token})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if other.Revoked != 1 {
+		t.Fatalf("revoked %d, want 1", other.Revoked)
+	}
+	if _, err := svr.ParseToken(ctx, &admin.ParseTokenReq{Token: legacy}); err == nil {
+		t.Fatal("legacy token still parses")
+	}
+	if _, err := svr.ParseToken(ctx, &admin.ParseTokenReq{Token: web.Token}); err != nil {
+		t.Fatalf("current session revoked: %v", err)
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"crypto/md5"
 	"encoding/hex"
 	"math/rand"
+	"sync/atomic"
 	"time"
 
 	"github.com/openimsdk/chat/pkg/common/config"
@@ -12,7 +13,9 @@ import (
 	"github.com/openimsdk/chat/pkg/common/db/database"
 	"github.com/openimsdk/chat/pkg/common/db/dbutil"
 	"github.com/openimsdk/chat/pkg/common/db/table/admin"
+	"github.com/openimsdk/chat/pkg/common/imapi"
 	"github.com/openimsdk/chat/pkg/common/tokenverify"
+	"github.com/openimsdk/chat/pkg/passwd"
 	adminpb "github.com/openimsdk/chat/pkg/protocol/admin"
 	"github.com/openimsdk/chat/pkg/protocol/chat"
 	chatClient "github.com/openimsdk/chat/pkg/rpclient/chat"
@@ -56,21 +59,85 @@ func Start(ctx context.Context, config *Config, client discovery.SvcDiscoveryReg
 		return err
 	}
 	srv.Chat = chatClient.NewChatClient(chat.NewChatClient(conn))
+	srv.IM = imapi.New(config.Share.OpenIM.ApiURL, config.Share.OpenIM.Secret, config.Share.OpenIM.AdminUserID)
 	srv.Token = &tokenverify.Token{
-		Expires: time.Duration(config.RpcConfig.TokenPolicy.Expire) * time.Hour * 24,
-		Secret:  config.RpcConfig.Secret,
+		Expires:       time.Duration(config.RpcConfig.TokenPolicy.Expire) * time.Hour * 24,
+		AccessExpires: time.Duration(config.RpcConfig.TokenPolicy.AccessExpire) * time.Minute,
+		Secret:        config.RpcConfig.Secret,
+	}
+	if err := loadSigningKeys(srv.Token, config); err != nil {
+		return err
+	}
+	if legacyUntil := config.RpcConfig.TokenPolicy.LegacyUntil; legacyUntil != "" {
+		srv.Token.LegacyUntil, err = time.Parse(time.RFC3339, legacyUntil)
+		if err != nil {
+			return errs.WrapMsg(err, "invalid tokenPolicy legacyUntil", "legacyUntil", legacyUntil)
+		}
+	}
+	srv.TwoFactor = TwoFactor{
+		Issuer:          config.RpcConfig.TwoFactor.Issuer,
+		ChallengeExpire: time.Duration(config.RpcConfig.TwoFactor.ChallengeExpire) * time.Second,
+	}
+	if srv.TwoFactor.ChallengeExpire <= 0 {
+		return errs.New("admin two factor challengeExpire not configured")
+	}
+	srv.ServiceUserIDs = config.Share.ChatAdmin
+	srv.BlockExpiryInterval = time.Duration(config.RpcConfig.BlockExpiry.Interval) * time.Second
+	if srv.BlockExpiryInterval <= 0 {
+		srv.BlockExpiryInterval = time.Minute
 	}
 	if err := srv.initAdmin(ctx, config.Share.ChatAdmin, config.Share.OpenIM.AdminUserID); err != nil {
 		return err
 	}
+	if err := srv.initRoles(ctx); err != nil {
+		return err
+	}
+	if err := srv.loadIPRules(ctx); err != nil {
+		return err
+	}
 	adminpb.RegisterAdminServer(server, &srv)
+	go srv.runBlockExpiry(ctx)
+	go srv.runIPRuleRefresh(ctx)
+	return nil
+}
+
+// loadSigningKeys loads the keys tokens are verified with and the key signing them.
+func loadSigningKeys(token *tokenverify.Token, config *Config) error {
+	signing := config.RpcConfig.Signing
+	token.Keys = make(map[string]*tokenverify.Key, len(signing.Keys))
+	for _, k := range signing.Keys {
+		if _, ok := token.Keys[k.Kid]; ok {
+			return errs.New("duplicate signing key kid", "kid", k.Kid)
+		}
+		key, err := tokenverify.LoadKey(k.Kid, k.PrivateKeyFile, k.PublicKeyFile)
+		if err != nil {
+			return err
+		}
+		token.Keys[k.Kid] = key
+	}
+	if signing.SigningKey != "" {
+		key, ok := token.Keys[signing.SigningKey]
+		if !ok || !key.CanSign() {
+			return errs.New("signingKey must be a configured key with a private key", "kid", signing.SigningKey)
+		}
+		token.SigningKey = key
+	} else if token.Secret == "" {
+		return errs.New("admin secret or signing signingKey must be configured")
+	}
 	return nil
 }
 
 type adminServer struct {
-	Database database.AdminDatabaseInterface
-	Chat     *chatClient.ChatClient
-	Token    *tokenverify.Token
+	Database            database.AdminDatabaseInterface
+	Chat                *chatClient.ChatClient
+	IM                  imapi.CallerInterface
+	Token               *tokenverify.Token
+	TwoFactor           TwoFactor
+	BlockExpiryInterval time.Duration
+	// ServiceUserIDs are the admin identities the chat services call admin-rpc with
+	ServiceUserIDs []string
+
+	ipRules atomic.Pointer[ipRuleSet]
 }
 
 func (o *adminServer) initAdmin(ctx context.Context, admins []string, imUserID string) error {
@@ -81,10 +148,14 @@ func (o *adminServer) initAdmin(ctx context.Context, admins []string, imUserID s
 			return err
 		}
 		sum := md5.Sum([]byte(account))
+		password, err := passwd.Hash(hex.EncodeToString(sum[:]))
+		if err != nil {
+			return errs.Wrap(err)
+		}
 		a := admin.Admin{
 			Account:    account,
 			UserID:     imUserID,
-			Password:   hex.EncodeToString(sum[:]),
+			Password:   password,
 			Level:      constant.DefaultAdminLevel,
 			CreateTime: time.Now(),
 		}
@@ -16,29 +16,72 @@ package admin
 
 import (
 	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+	"sort"
+	"time"
 
-	"github.com/openimsdk/chat/pkg/eerrs"
-	adminpb "github.com/openimsdk/chat/pkg/protocol/admin"
+	"github.com/openimsdk/tools/errs"
 	"github.com/openimsdk/tools/log"
 	"github.com/redis/go-redis/v9"
+
+	"github.com/openimsdk/chat/pkg/common/constant"
+	"github.com/openimsdk/chat/pkg/common/db/cache"
+	"github.com/openimsdk/chat/pkg/common/mctx"
+	"github.com/openimsdk/chat/pkg/eerrs"
+	adminpb "github.com/openimsdk/chat/pkg/protocol/admin"
+	constantpb "github.com/openimsdk/chat/pkg/protocol/constant"
 )
 
 func (o *adminServer) CreateToken(ctx context.Context, req *adminpb.CreateTokenReq) (*adminpb.CreateTokenResp, error) {
-	token, err := o.Token.CreateToken(req.UserID, req.UserType)
+	sessionID, err := randomToken(16, hex.EncodeToString)
 	if err != nil {
 		return nil, err
 	}
-	err = o.Database.CacheToken(ctx, req.UserID, token)
+	token, err := o.Token.CreateToken(req.UserID, req.UserType, sessionID)
 	if err != nil {
 		return nil, err
 	}
-	return &adminpb.CreateTokenResp{
-		Token: token,
-	}, nil
+	// expired and revoked sessions are dropped on each login instead of piling up
+	if _, _, err := o.pruneSessions(ctx, req.UserID); err != nil {
+		log.ZWarn(ctx, "prune sessions failed", err, "userID", req.UserID)
+	}
+	now := time.Now()
+	session := &cache.Session{
+		SessionID:  sessionID,
+		Token:      token,
+		UserType:   req.UserType,
+		DeviceID:   req.DeviceID,
+		Platform:   req.Platform,
+		IP:         req.Ip,
+		UserAgent:  req.UserAgent,
+		CreateTime: now.UnixMilli(),
+		ExpireTime: now.Add(o.Token.Expires).UnixMilli(),
+	}
+	resp := &adminpb.CreateTokenResp{
+		Token:     token,
+		SessionID: session.SessionID,
+	}
+	// the refresh token is made current before the session is stored, so the session keys expire together
+	if o.Token.AccessExpires > 0 {
+		refreshToken, refreshHash, err := o.addRefreshToken(ctx, req.UserID, req.UserType, session)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := o.Database.SwapRefreshToken(ctx, req.UserID, sessionID, "", refreshHash); err != nil {
+			return nil, err
+		}
+		resp.RefreshToken = refreshToken
+	}
+	if err := o.Database.CacheSession(ctx, req.UserID, session, o.Token.Expires); err != nil {
+		return nil, err
+	}
+	return resp, nil
 }
 
 func (o *adminServer) ParseToken(ctx context.Context, req *adminpb.ParseTokenReq) (*adminpb.ParseTokenResp, error) {
-	userID, userType, err := o.Token.GetToken(req.Token)
+	userID, userType, sessionID, err := o.Token.GetTokenSession(req.Token)
 	if err != nil {
 		return nil, err
 	}
@@ -49,16 +92,144 @@ func (o *adminServer) ParseToken(ctx context.Context, req *adminpb.ParseTokenReq
 	if len(m) == 0 {
 		return nil, eerrs.ErrTokenNotExist.Wrap()
 	}
-	if _, ok := m[req.Token]; !ok {
+	// the flag is checked here so the APIs need no second call to GetUserToken
+	switch flag, ok := m[req.Token]; {
+	case !ok:
 		return nil, eerrs.ErrTokenNotExist.Wrap()
+	case flag == constantpb.KickedToken:
+		return nil, errs.ErrTokenExpired.Wrap()
+	case flag != constantpb.NormalToken:
+		return nil, errs.ErrTokenUnknown.Wrap()
+	}
+	legacy := sessionID == ""
+	if legacy {
+		sessionID = cache.SessionID(req.Token)
+	}
+	if err := o.Database.SetSessionSeen(ctx, userID, sessionID, time.Now()); err != nil {
+		log.ZWarn(ctx, "set session seen failed", err, "userID", userID)
 	}
-
 	return &adminpb.ParseTokenResp{
-		UserID:   userID,
-		UserType: userType,
+		UserID:    userID,
+		UserType:  userType,
+		SessionID: sessionID,
+		Legacy:    legacy,
+	}, nil
+}
+
+// RefreshToken issues a new token and refresh token for the session of the refresh token, which is used up.
+// A refresh token presented again after it was rotated was stolen or leaked, the whole session is revoked.
+func (o *adminServer) RefreshToken(ctx context.Context, req *adminpb.RefreshTokenReq) (*adminpb.RefreshTokenResp, error) {
+	if o.Token.AccessExpires <= 0 {
+		return nil, errs.ErrArgs.WrapMsg("refresh tokens are disabled")
+	}
+	refreshHash := cache.RefreshTokenHash(req.RefreshToken)
+	refresh, err := o.Database.GetRefreshToken(ctx, refreshHash)
+	if err != nil {
+		return nil, err
+	}
+	if refresh == nil || refresh.UserType != req.UserType {
+		return nil, eerrs.ErrTokenNotExist.WrapMsg("refresh token not found")
+	}
+	sessions, _, err := o.pruneSessions(ctx, refresh.UserID)
+	if err != nil {
+		return nil, err
+	}
+	var (
+		session *cache.Session
+		others  []*cache.Session
+	)
+	for _, s := range sessions {
+		if s.SessionID == refresh.SessionID {
+			session = s
+		} else {
+			others = append(others, s)
+		}
+	}
+	if session == nil {
+		return nil, errs.ErrTokenExpired.WrapMsg("session revoked or expired")
+	}
+	token, err := o.Token.CreateToken(refresh.UserID, refresh.UserType, session.SessionID)
+	if err != nil {
+		return nil, err
+	}
+	refreshToken, newHash, err := o.addRefreshToken(ctx, refresh.UserID, refresh.UserType, session)
+	if err != nil {
+		return nil, err
+	}
+	current, err := o.Database.SwapRefreshToken(ctx, refresh.UserID, session.SessionID, refreshHash, newHash)
+	if err != nil {
+		return nil, err
+	}
+	if current != refreshHash {
+		if current == "" {
+			return nil, errs.ErrTokenExpired.WrapMsg("session revoked or expired")
+		}
+		log.ZWarn(ctx, "refresh token reused, revoking the session", nil, "userID", refresh.UserID, "sessionID", session.SessionID)
+		if err := o.revokeSessions(ctx, refresh.UserID, []*cache.Session{session}, others); err != nil {
+			return nil, err
+		}
+		return nil, eerrs.ErrRefreshTokenReused.Wrap()
+	}
+	oldToken := session.Token
+	session.Token = token
+	if req.Ip != "" {
+		session.IP = req.Ip
+	}
+	if req.UserAgent != "" {
+		session.UserAgent = req.UserAgent
+	}
+	if err := o.Database.SetSessionToken(ctx, refresh.UserID, session, oldToken); err != nil {
+		return nil, err
+	}
+	return &adminpb.RefreshTokenResp{
+		UserID:       refresh.UserID,
+		Token:        token,
+		RefreshToken: refreshToken,
+		SessionID:    session.SessionID,
 	}, nil
 }
 
+// GetJWKS returns the public keys other backends verify tokens with.
+func (o *adminServer) GetJWKS(ctx context.Context, req *adminpb.GetJWKSReq) (*adminpb.GetJWKSResp, error) {
+	jwks := o.Token.JWKS()
+	resp := &adminpb.GetJWKSResp{Keys: make([]*adminpb.JWK, 0, len(jwks))}
+	for _, jwk := range jwks {
+		resp.Keys = append(resp.Keys, &adminpb.JWK{
+			Kty: jwk.Kty,
+			Crv: jwk.Crv,
+			X:   jwk.X,
+			Y:   jwk.Y,
+			Kid: jwk.Kid,
+			Alg: jwk.Alg,
+			Use: jwk.Use,
+		})
+	}
+	return resp, nil
+}
+
+// addRefreshToken records a new refresh token of the session until the session expires and returns it with its hash.
+func (o *adminServer) addRefreshToken(ctx context.Context, userID string, userType int32, session *cache.Session) (string, string, error) {
+	token, err := randomToken(32, base64.RawURLEncoding.EncodeToString)
+	if err != nil {
+		return "", "", err
+	}
+	hash := cache.RefreshTokenHash(token)
+	refresh := &cache.RefreshToken{UserID: userID, UserType: userType, SessionID: session.SessionID}
+	expire := time.Until(time.UnixMilli(session.ExpireTime))
+	if err := o.Database.AddRefreshToken(ctx, hash, refresh, expire); err != nil {
+		return "", "", err
+	}
+	return token, hash, nil
+}
+
+func randomToken(size int, encode func([]byte) string) (string, error) {
+	b := make([]byte, size)
+	if _, err := rand.Read(b); err != nil {
+		return "", errs.Wrap(err)
+	}
+	return encode(b), nil
+}
+
 func (o *adminServer) GetUserToken(ctx context.Context, req *adminpb.GetUserTokenReq) (*adminpb.GetUserTokenResp, error) {
 	tokensMap, err := o.Database.GetTokens(ctx, req.UserID)
 	if err != nil {
@@ -75,3 +246,210 @@ func (o *adminServer) InvalidateToken(ctx context.Context, req *adminpb.Invalida
 	log.ZDebug(ctx, "delete token from redis", "userID", req.UserID)
 	return &adminpb.InvalidateTokenResp{}, nil
 }
+
+func (o *adminServer) ListSessions(ctx context.Context, req *adminpb.ListSessionsReq) (*adminpb.ListSessionsResp, error) {
+	if err := o.checkSessionAccess(ctx, req.UserID); err != nil {
+		return nil, err
+	}
+	sessions, legacy, err := o.pruneSessions(ctx, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(sessions, func(i, j int) bool {
+		return sessions[i].LastSeen > sessions[j].LastSeen
+	})
+	resp := &adminpb.ListSessionsResp{Sessions: make([]*adminpb.Session, 0, len(sessions)+len(legacy))}
+	for _, session := range sessions {
+		resp.Sessions = append(resp.Sessions, &adminpb.Session{
+			SessionID:  session.SessionID,
+			DeviceID:   session.DeviceID,
+			Platform:   session.Platform,
+			Ip:         session.IP,
+			UserAgent:  session.UserAgent,
+			CreateTime: session.CreateTime,
+			LastSeen:   session.LastSeen,
+			ExpireTime: session.ExpireTime,
+			Current:    session.SessionID == req.CurrentSessionID,
+		})
+	}
+	for _, token := range legacy {
+		resp.Sessions = append(resp.Sessions, &adminpb.Session{
+			ExpireTime: token.ExpireTime,
+			Current:    token.Token == req.CurrentToken,
+			Legacy:     true,
+		})
+	}
+	return resp, nil
+}
+
+func (o *adminServer) RevokeSession(ctx context.Context, req *adminpb.RevokeSessionReq) (*adminpb.RevokeSessionResp, error) {
+	if err := o.checkSessionAccess(ctx, req.UserID); err != nil {
+		return nil, err
+	}
+	sessions, _, err := o.pruneSessions(ctx, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+	var revoked, remaining []*cache.Session
+	for _, session := range sessions {
+		if session.SessionID == req.SessionID {
+			revoked = append(revoked, session)
+		} else {
+			remaining = append(remaining, session)
+		}
+	}
+	if len(revoked) == 0 {
+		return nil, errs.ErrRecordNotFound.WrapMsg("session not found", "sessionID", req.SessionID)
+	}
+	if err := o.revokeSessions(ctx, req.UserID, revoked, remaining); err != nil {
+		return nil, err
+	}
+	return &adminpb.RevokeSessionResp{}, nil
+}
+
+func (o *adminServer) RevokeOtherSessions(ctx context.Context, req *adminpb.RevokeOtherSessionsReq) (*adminpb.RevokeOtherSessionsResp, error) {
+	if err := o.checkSessionAccess(ctx, req.UserID); err != nil {
+		return nil, err
+	}
+	sessions, legacy, err := o.pruneSessions(ctx, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+	var revoked, remaining []*cache.Session
+	for _, session := range sessions {
+		if session.SessionID == req.CurrentSessionID {
+			remaining = append(remaining, session)
+		} else {
+			revoked = append(revoked, session)
+		}
+	}
+	// legacy tokens have no platform, they are revoked without logging the user out of OpenIM
+	var legacyTokens []string
+	for _, token := range legacy {
+		if token.Token != req.CurrentToken {
+			legacyTokens = append(legacyTokens, token.Token)
+		}
+	}
+	if len(legacyTokens) > 0 {
+		if err := o.Database.DeleteSessions(ctx, req.UserID, legacyTokens, nil); err != nil {
+			return nil, err
+		}
+	}
+	if len(revoked) > 0 {
+		if err := o.revokeSessions(ctx, req.UserID, revoked, remaining); err != nil {
+			return nil, err
+		}
+	}
+	return &adminpb.RevokeOtherSessionsResp{Revoked: int32(len(revoked) + len(legacyTokens))}, nil
+}
+
+// checkSessionAccess allows users their own sessions and admins holding PermUserSession those of any user.
+func (o *adminServer) checkSessionAccess(ctx context.Context, userID string) error {
+	opUserID, userType, err := mctx.Check(ctx)
+	if err != nil {
+		return err
+	}
+	if userType == constant.AdminUser {
+		_, err := o.checkPermission(ctx, constant.PermUserSession)
+		return err
+	}
+	if opUserID != userID {
+		return errs.ErrNoPermission.WrapMsg("not the sessions of the user")
+	}
+	return nil
+}
+
+// legacyToken is a token without a session record, such as the tokens issued before sessions were recorded.
+type legacyToken struct {
+	Token      string
+	ExpireTime int64
+}
+
+// pruneSessions deletes the expired sessions and the tokens that are gone or no longer parse,
+// and returns the other sessions and the valid tokens that have no session.
+func (o *adminServer) pruneSessions(ctx context.Context, userID string) ([]*cache.Session, []legacyToken, error) {
+	tokens, err := o.Database.GetTokens(ctx, userID)
+	if err != nil {
+		return nil, nil, err
+	}
+	sessions, err := o.Database.GetSessions(ctx, userID)
+	if err != nil {
+		return nil, nil, err
+	}
+	now := time.Now().UnixMilli()
+	var (
+		live         []*cache.Session
+		legacy       []legacyToken
+		delTokens    []string
+		delSessions  []string
+		tokenSession = make(map[string]struct{}, len(sessions))
+	)
+	for _, session := range sessions {
+		tokenSession[session.Token] = struct{}{}
+		if flag, ok := tokens[session.Token]; !ok || flag != constantpb.NormalToken || session.ExpireTime <= now {
+			delTokens = append(delTokens, session.Token)
+			delSessions = append(delSessions, session.SessionID)
+			continue
+		}
+		live = append(live, session)
+	}
+	for token, flag := range tokens {
+		if _, ok := tokenSession[token]; ok {
+			continue
+		}
+		expire, err := o.Token.GetTokenExpire(token)
+		if err != nil {
+			delTokens = append(delTokens, token)
+			continue
+		}
+		if flag == constantpb.NormalToken {
+			legacy = append(legacy, legacyToken{Token: token, ExpireTime: expire.UnixMilli()})
+		}
+	}
+	if len(delTokens) > 0 || len(delSessions) > 0 {
+		if err := o.Database.DeleteSessions(ctx, userID, delTokens, delSessions); err != nil {
+			return nil, nil, err
+		}
+	}
+	return live, legacy, nil
+}
+
+// revokeSessions deletes the tokens of the revoked sessions and logs the user out of OpenIM
+// on the platforms none of the remaining sessions uses.
+func (o *adminServer) revokeSessions(ctx context.Context, userID string, revoked []*cache.Session, remaining []*cache.Session) error {
+	tokens := make([]string, 0, len(revoked))
+	sessionIDs := make([]string, 0, len(revoked))
+	for _, session := range revoked {
+		tokens = append(tokens, session.Token)
+		sessionIDs = append(sessionIDs, session.SessionID)
+	}
+	if err := o.Database.DeleteSessions(ctx, userID, tokens, sessionIDs); err != nil {
+		return err
+	}
+	inUse := make(map[int32]struct{})
+	for _, session := range remaining {
+		inUse[session.Platform] = struct{}{}
+	}
+	platforms := make(map[int32]struct{})
+	for _, session := range revoked {
+		if _, ok := inUse[session.Platform]; !ok && session.UserType == constant.NormalUser && session.Platform != 0 {
+			platforms[session.Platform] = struct{}{}
+		}
+	}
+	if len(platforms) == 0 {
+		return nil
+	}
+	// the chat tokens are already revoked, an OpenIM failure only leaves the connection until it reconnects
+	imToken, err := o.IM.ImAdminTokenWithDefaultAdmin(ctx)
+	if err != nil {
+		log.ZWarn(ctx, "get im admin token failed", err, "userID", userID)
+		return nil
+	}
+	imCtx := mctx.WithApiToken(ctx, imToken)
+	for platform := range platforms {
+		if err := o.IM.ForceOffLinePlatform(imCtx, userID, platform); err != nil {
+			log.ZWarn(ctx, "force offline failed", err, "userID", userID, "platform", platform)
+		}
+	}
+	return nil
+}
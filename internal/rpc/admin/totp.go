@@ -0,0 +1,312 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admin
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"time"
+
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
+
+	"github.com/openimsdk/chat/pkg/common/constant"
+	admindb "github.com/openimsdk/chat/pkg/common/db/table/admin"
+	"github.com/openimsdk/chat/pkg/common/mctx"
+	"github.com/openimsdk/chat/pkg/eerrs"
+	"github.com/openimsdk/chat/pkg/protocol/admin"
+	"github.com/openimsdk/chat/pkg/totp"
+)
+
+const (
+	// totpSkew accepts the codes of the previous and the next period to allow for clock drift
+	totpSkew = 1
+	// totpFailureLimit wrong codes in a row lock the second factor for totpLockDuration
+	totpFailureLimit = 5
+	totpLockDuration = 15 * time.Minute
+
+	recoveryCodeCount = 10
+	recoveryCodeLen   = 10
+)
+
+// recoveryCodeChars has 32 characters so every random byte maps without bias
+const recoveryCodeChars = "abcdefghijklmnopqrstuvwxyz234567"
+
+type TwoFactor struct {
+	Issuer          string
+	ChallengeExpire time.Duration
+}
+
+// loginResp returns the admin token, or a challenge token if the second factor is still missing.
+func (o *adminServer) loginResp(ctx context.Context, a *admindb.Admin) (*admin.LoginResp, error) {
+	resp := &admin.LoginResp{
+		AdminUserID:  a.UserID,
+		AdminAccount: a.Account,
+		Nickname:     a.Nickname,
+		FaceURL:      a.FaceURL,
+		Level:        a.Level,
+	}
+	switch {
+	case a.TOTPEnabled:
+		resp.TwoFactor = constant.TwoFactorVerify
+	case a.TOTPRequired:
+		resp.TwoFactor = constant.TwoFactorEnroll
+	}
+	if resp.TwoFactor != constant.TwoFactorNone {
+		challenge, err := o.Token.CreateChallengeToken(a.Account, o.TwoFactor.ChallengeExpire)
+		if err != nil {
+			return nil, err
+		}
+		resp.ChallengeToken = challenge
+		return resp, nil
+	}
+	return o.issueLogin(ctx, a)
+}
+
+func (o *adminServer) issueLogin(ctx context.Context, a *admindb.Admin) (*admin.LoginResp, error) {
+	adminToken, err := o.CreateToken(ctx, &admin.CreateTokenReq{UserID: a.UserID, UserType: constant.AdminUser})
+	if err != nil {
+		return nil, err
+	}
+	return &admin.LoginResp{
+		AdminUserID:  a.UserID,
+		AdminAccount: a.Account,
+		AdminToken:   adminToken.Token,
+		RefreshToken: adminToken.RefreshToken,
+		Nickname:     a.Nickname,
+		FaceURL:      a.FaceURL,
+		Level:        a.Level,
+	}, nil
+}
+
+// twoFactorAdmin returns the admin of the challenge token, or of the admin token in ctx if challengeToken is empty.
+func (o *adminServer) twoFactorAdmin(ctx context.Context, challengeToken string) (*admindb.Admin, error) {
+	if challengeToken != "" {
+		account, err := o.Token.GetChallengeToken(challengeToken)
+		if err != nil {
+			return nil, err
+		}
+		return o.Database.GetAdmin(ctx, account)
+	}
+	userID, err := mctx.CheckAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return o.Database.GetAdminUserID(ctx, userID)
+}
+
+// verifySecondFactor checks a TOTP code or a recovery code of an admin with TOTP enabled.
+// Each code is accepted once, wrong codes count towards the lockout.
+func (o *adminServer) verifySecondFactor(ctx context.Context, a *admindb.Admin, code string, recoveryCode string) error {
+	if !a.TOTPEnabled {
+		return errs.ErrArgs.WrapMsg("two-factor authentication is not enabled")
+	}
+	if time.Now().Before(a.TOTPLockedUntil) {
+		return eerrs.ErrTwoFactorLocked.WrapMsg("too many wrong codes", "lockedUntil", a.TOTPLockedUntil.UnixMilli())
+	}
+	var (
+		ok  bool
+		err error
+	)
+	if recoveryCode != "" {
+		ok, err = o.Database.UseRecoveryCode(ctx, a.Account, hashRecoveryCode(recoveryCode))
+	} else if step, valid := totp.Validate(a.TOTPSecret, code, time.Now(), totpSkew); valid {
+		ok, err = o.Database.UseTOTPStep(ctx, a.Account, step)
+	}
+	if err != nil {
+		return err
+	}
+	if !ok {
+		locked, err := o.Database.AddTOTPFailure(ctx, a.Account, totpFailureLimit, time.Now().Add(totpLockDuration))
+		if err != nil {
+			return err
+		}
+		if locked {
+			return eerrs.ErrTwoFactorLocked.WrapMsg("too many wrong codes")
+		}
+		return eerrs.ErrTwoFactorCodeInvalid.Wrap()
+	}
+	if a.TOTPFailedCount > 0 {
+		if err := o.Database.UpdateAdminByAccount(ctx, a.Account, map[string]any{"totp_failed_count": 0}); err != nil {
+			log.ZError(ctx, "reset totp failed count", err, "account", a.Account)
+		}
+	}
+	return nil
+}
+
+func (o *adminServer) VerifyLoginTOTP(ctx context.Context, req *admin.VerifyLoginTOTPReq) (*admin.LoginResp, error) {
+	account, err := o.Token.GetChallengeToken(req.ChallengeToken)
+	if err != nil {
+		return nil, err
+	}
+	a, err := o.Database.GetAdmin(ctx, account)
+	if err != nil {
+		return nil, err
+	}
+	if err := o.verifySecondFactor(ctx, a, req.Code, req.RecoveryCode); err != nil {
+		return nil, err
+	}
+	return o.issueLogin(ctx, a)
+}
+
+func (o *adminServer) EnrollTOTP(ctx context.Context, req *admin.EnrollTOTPReq) (*admin.EnrollTOTPResp, error) {
+	a, err := o.twoFactorAdmin(ctx, req.ChallengeToken)
+	if err != nil {
+		return nil, err
+	}
+	// an enabled TOTP is only replaced after it was disabled with a valid code,
+	// otherwise the password alone would be enough to take over the second factor
+	if a.TOTPEnabled {
+		return nil, errs.ErrArgs.WrapMsg("two-factor authentication is already enabled")
+	}
+	secret, err := totp.GenerateSecret()
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	if err := o.Database.UpdateAdminByAccount(ctx, a.Account, map[string]any{"totp_pending_secret": secret}); err != nil {
+		return nil, err
+	}
+	return &admin.EnrollTOTPResp{
+		Secret:          secret,
+		ProvisioningURI: totp.ProvisioningURI(o.TwoFactor.Issuer, a.Account, secret),
+	}, nil
+}
+
+func (o *adminServer) ConfirmTOTP(ctx context.Context, req *admin.ConfirmTOTPReq) (*admin.ConfirmTOTPResp, error) {
+	a, err := o.twoFactorAdmin(ctx, req.ChallengeToken)
+	if err != nil {
+		return nil, err
+	}
+	if a.TOTPEnabled {
+		return nil, errs.ErrArgs.WrapMsg("two-factor authentication is already enabled")
+	}
+	if a.TOTPPendingSecret == "" {
+		return nil, errs.ErrArgs.WrapMsg("two-factor authentication is not enrolled")
+	}
+	step, ok := totp.Validate(a.TOTPPendingSecret, req.Code, time.Now(), totpSkew)
+	if !ok {
+		return nil, eerrs.ErrTwoFactorCodeInvalid.Wrap()
+	}
+	codes, hashes, err := genRecoveryCodes()
+	if err != nil {
+		return nil, err
+	}
+	update := map[string]any{
+		"totp_secret":         a.TOTPPendingSecret,
+		"totp_pending_secret": "",
+		"totp_enabled":        true,
+		"totp_last_step":      step,
+		"recovery_codes":      hashes,
+		"totp_failed_count":   0,
+	}
+	if err := o.Database.UpdateAdminByAccount(ctx, a.Account, update); err != nil {
+		return nil, err
+	}
+	resp := &admin.ConfirmTOTPResp{RecoveryCodes: codes}
+	// the enrolment required by the policy is the second factor of this login
+	if req.ChallengeToken != "" {
+		resp.Login, err = o.issueLogin(ctx, a)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return resp, nil
+}
+
+func (o *adminServer) DisableTOTP(ctx context.Context, req *admin.DisableTOTPReq) (*admin.DisableTOTPResp, error) {
+	a, err := o.twoFactorAdmin(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+	if a.TOTPRequired {
+		return nil, errs.ErrNoPermission.WrapMsg("two-factor authentication is required for this account")
+	}
+	if err := o.verifySecondFactor(ctx, a, req.Code, ""); err != nil {
+		return nil, err
+	}
+	update := map[string]any{
+		"totp_secret":         "",
+		"totp_pending_secret": "",
+		"totp_enabled":        false,
+		"recovery_codes":      []string{},
+	}
+	if err := o.Database.UpdateAdminByAccount(ctx, a.Account, update); err != nil {
+		return nil, err
+	}
+	return &admin.DisableTOTPResp{}, nil
+}
+
+func (o *adminServer) RegenerateRecoveryCodes(ctx context.Context, req *admin.RegenerateRecoveryCodesReq) (*admin.RegenerateRecoveryCodesResp, error) {
+	a, err := o.twoFactorAdmin(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+	if err := o.verifySecondFactor(ctx, a, req.Code, ""); err != nil {
+		return nil, err
+	}
+	codes, hashes, err := genRecoveryCodes()
+	if err != nil {
+		return nil, err
+	}
+	if err := o.Database.UpdateAdminByAccount(ctx, a.Account, map[string]any{"recovery_codes": hashes}); err != nil {
+		return nil, err
+	}
+	return &admin.RegenerateRecoveryCodesResp{RecoveryCodes: codes}, nil
+}
+
+func (o *adminServer) SetTOTPRequired(ctx context.Context, req *admin.SetTOTPRequiredReq) (*admin.SetTOTPRequiredResp, error) {
+	if err := o.CheckSuperAdmin(ctx); err != nil {
+		return nil, err
+	}
+	if _, err := o.Database.GetAdmin(ctx, req.Account); err != nil {
+		return nil, err
+	}
+	if err := o.Database.UpdateAdminByAccount(ctx, req.Account, map[string]any{"totp_required": req.Required}); err != nil {
+		return nil, err
+	}
+	return &admin.SetTOTPRequiredResp{}, nil
+}
+
+// genRecoveryCodes returns the recovery codes shown to the admin once and the hashes stored instead.
+func genRecoveryCodes() ([]string, []string, error) {
+	codes := make([]string, 0, recoveryCodeCount)
+	hashes := make([]string, 0, recoveryCodeCount)
+	buf := make([]byte, recoveryCodeLen)
+	for i := 0; i < recoveryCodeCount; i++ {
+		if _, err := rand.Read(buf); err != nil {
+			return nil, nil, errs.Wrap(err)
+		}
+		code := make([]byte, 0, recoveryCodeLen+1)
+		for j, b := range buf {
+			if j == recoveryCodeLen/2 {
+				code = append(code, '-')
+			}
+			code = append(code, recoveryCodeChars[int(b)%len(recoveryCodeChars)])
+		}
+		codes = append(codes, string(code))
+		hashes = append(hashes, hashRecoveryCode(string(code)))
+	}
+	return codes, hashes, nil
+}
+
+// hashRecoveryCode ignores case, spaces and dashes the admin may type differently.
+func hashRecoveryCode(code string) string {
+	code = strings.ToLower(strings.NewReplacer("-", "", " ", "").Replace(code))
+	sum := sha256.Sum256([]byte(code))
+	return hex.EncodeToString(sum[:])
+}
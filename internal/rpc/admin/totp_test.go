@@ -0,0 +1,342 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admin
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/openimsdk/tools/errs"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/openimsdk/chat/pkg/common/constant"
+	"github.com/openimsdk/chat/pkg/common/db/cache"
+	"github.com/openimsdk/chat/pkg/common/db/database"
+	admindb "github.com/openimsdk/chat/pkg/common/db/table/admin"
+	"github.com/openimsdk/chat/pkg/common/mctx"
+	"github.com/openimsdk/chat/pkg/common/tokenverify"
+	"github.com/openimsdk/chat/pkg/eerrs"
+	"github.com/openimsdk/chat/pkg/passwd"
+	"github.com/openimsdk/chat/pkg/protocol/admin"
+	"github.com/openimsdk/chat/pkg/totp"
+)
+
+// memDatabase keeps admins in memory by account.
+type memDatabase struct {
+	database.AdminDatabaseInterface
+	admins map[string]*admindb.Admin
+	tokens map[string]string
+	roles  map[string]*admindb.AdminRole
+	audits []*admindb.AuditLog
+	blocks map[string]*admindb.ForbiddenAccount
+	ended  []*admindb.ForbiddenAccountHistory
+	// sessions by userID and sessionID, tokens holds the latest token of each user
+	sessions map[string]map[string]*cache.Session
+	// refreshTokens by hash, refreshCurrent the current refresh token hash by sessionID
+	refreshTokens  map[string]*cache.RefreshToken
+	refreshCurrent map[string]string
+
+	forbiddens []*admindb.IPForbidden
+	limits     []*admindb.LimitUserLoginIP
+	ipVersion  int64
+
+	invitations    map[string]*admindb.InvitationRegister
+	invitationUses []*admindb.InvitationUse
+}
+
+func (d *memDatabase) GetAdmin(_ context.Context, account string) (*admindb.Admin, error) {
+	a, ok := d.admins[account]
+	if !ok {
+		return nil, errs.Wrap(mongo.ErrNoDocuments)
+	}
+	clone := *a
+	clone.RecoveryCodes = append([]string(nil), a.RecoveryCodes...)
+	return &clone, nil
+}
+
+func (d *memDatabase) GetAdminUserID(ctx context.Context, userID string) (*admindb.Admin, error) {
+	for account, a := range d.admins {
+		if a.UserID == userID {
+			return d.GetAdmin(ctx, account)
+		}
+	}
+	return nil, errs.Wrap(mongo.ErrNoDocuments)
+}
+
+func (d *memDatabase) UpdateAdminByAccount(_ context.Context, account string, update map[string]any) error {
+	a := d.admins[account]
+	for k, v := range update {
+		switch k {
+		case "totp_secret":
+			a.TOTPSecret = v.(string)
+		case "totp_pending_secret":
+			a.TOTPPendingSecret = v.(string)
+		case "totp_enabled":
+			a.TOTPEnabled = v.(bool)
+		case "totp_required":
+			a.TOTPRequired = v.(bool)
+		case "totp_last_step":
+			a.TOTPLastStep = v.(int64)
+		case "recovery_codes":
+			a.RecoveryCodes = v.([]string)
+		case "totp_failed_count":
+			a.TOTPFailedCount = int32(v.(int))
+		case "roles":
+			a.Roles = v.([]string)
+		default:
+			return errors.New("unexpected field " + k)
+		}
+	}
+	return nil
+}
+
+func (d *memDatabase) UseTOTPStep(_ context.Context, account string, step int64) (bool, error) {
+	a := d.admins[account]
+	if a.TOTPLastStep >= step {
+		return false, nil
+	}
+	a.TOTPLastStep = step
+	return true, nil
+}
+
+func (d *memDatabase) UseRecoveryCode(_ context.Context, account string, codeHash string) (bool, error) {
+	a := d.admins[account]
+	for i, h := range a.RecoveryCodes {
+		if h == codeHash {
+			a.RecoveryCodes = append(a.RecoveryCodes[:i], a.RecoveryCodes[i+1:]...)
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (d *memDatabase) AddTOTPFailure(_ context.Context, account string, limit int32, lockUntil time.Time) (bool, error) {
+	a := d.admins[account]
+	a.TOTPFailedCount++
+	if a.TOTPFailedCount < limit {
+		return false, nil
+	}
+	a.TOTPFailedCount = 0
+	a.TOTPLockedUntil = lockUntil
+	return true, nil
+}
+
+func (d *memDatabase) CreateAdminRole(_ context.Context, roles []*admindb.AdminRole) error {
+	for _, role := range roles {
+		d.roles[role.RoleID] = role
+	}
+	return nil
+}
+
+func (d *memDatabase) GetAdminRole(_ context.Context, roleID string) (*admindb.AdminRole, error) {
+	role, ok := d.roles[roleID]
+	if !ok {
+		return nil, errs.Wrap(mongo.ErrNoDocuments)
+	}
+	return role, nil
+}
+
+func (d *memDatabase) FindAdminRole(_ context.Context, roleIDs []string) ([]*admindb.AdminRole, error) {
+	var roles []*admindb.AdminRole
+	for _, roleID := range roleIDs {
+		if role, ok := d.roles[roleID]; ok {
+			roles = append(roles, role)
+		}
+	}
+	return roles, nil
+}
+
+// SetLegacyAdminRoles treats a nil Roles as a document stored before roles existed.
+func (d *memDatabase) SetLegacyAdminRoles(_ context.Context, roleIDs []string) (int64, error) {
+	var n int64
+	for _, a := range d.admins {
+		if a.Roles == nil && a.Level != constant.AdvancedUserLevel {
+			a.Roles = roleIDs
+			n++
+		}
+	}
+	return n, nil
+}
+
+func (d *memDatabase) CreateAuditLog(_ context.Context, logs []*admindb.AuditLog) error {
+	d.audits = append(d.audits, logs...)
+	return nil
+}
+
+func newTestSvr(t *testing.T) (*adminServer, *memDatabase) {
+	hash, err := passwd.Hash("pw")
+	if err != nil {
+		t.Fatal(err)
+	}
+	db := &memDatabase{
+		admins: map[string]*admindb.Admin{
+			"root":  {Account: "root", UserID: "u-root", Password: hash, Level: constant.AdvancedUserLevel},
+			"staff": {Account: "staff", UserID: "u-staff", Password: hash, Level: constant.NormalAdmin, Roles: []string{}},
+		},
+		tokens:   map[string]string{},
+		roles:    map[string]*admindb.AdminRole{},
+		blocks:   map[string]*admindb.ForbiddenAccount{},
+		sessions: map[string]map[string]*cache.Session{},
+
+		refreshTokens:  map[string]*cache.RefreshToken{},
+		refreshCurrent: map[string]string{},
+
+		invitations: map[string]*admindb.InvitationRegister{},
+	}
+	return &adminServer{
+		Database:       db,
+		Token:          &tokenverify.Token{Expires: time.Hour, Secret: "test"},
+		TwoFactor:      TwoFactor{Issuer: "Test", ChallengeExpire: time.Minute},
+		ServiceUserIDs: []string{"u-service"},
+	}, db
+}
+
+func isCode(err error, code errs.CodeError) bool {
+	var codeErr errs.CodeError
+	return errors.As(errs.Unwrap(err), &codeErr) && codeErr.Code() == code.Code()
+}
+
+// wrongCode returns a code that is not valid for secret right now.
+func wrongCode(secret string) string {
+	for _, c := range []string{"111111", "222222", "333333", "444444"} {
+		if _, ok := totp.Validate(secret, c, time.Now(), totpSkew); !ok {
+			return c
+		}
+	}
+	panic("no wrong code")
+}
+
+func currentCode(t *testing.T, secret string, offset int64) string {
+	code, err := totp.Code(secret, totp.Step(time.Now())+offset)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return code
+}
+
+func TestLoginTOTP(t *testing.T) {
+	svr, db := newTestSvr(t)
+	ctx := context.Background()
+
+	// the super admin requires 2FA for staff, the next login must enrol before a token is issued
+	rootCtx := mctx.WithAdminUser(ctx, "u-root")
+	if _, err := svr.SetTOTPRequired(mctx.WithAdminUser(ctx, "u-staff"), &admin.SetTOTPRequiredReq{Account: "staff", Required: true}); !isCode(err, errs.ErrNoPermission) {
+		t.Fatalf("normal admin set required: want NoPermission, got %v", err)
+	}
+	if _, err := svr.SetTOTPRequired(rootCtx, &admin.SetTOTPRequiredReq{Account: "staff", Required: true}); err != nil {
+		t.Fatal(err)
+	}
+	login, err := svr.Login(ctx, &admin.LoginReq{Account: "staff", Password: "pw"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if login.AdminToken != "" || login.ChallengeToken == "" || login.TwoFactor != constant.TwoFactorEnroll {
+		t.Fatalf("required 2FA login = %+v", login)
+	}
+	if _, _, err := svr.Token.GetToken(login.ChallengeToken); err == nil {
+		t.Fatal("challenge token accepted as admin token")
+	}
+	enroll, err := svr.EnrollTOTP(ctx, &admin.EnrollTOTPReq{ChallengeToken: login.ChallengeToken})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := svr.ConfirmTOTP(ctx, &admin.ConfirmTOTPReq{ChallengeToken: login.ChallengeToken, Code: wrongCode(enroll.Secret)}); !isCode(err, eerrs.ErrTwoFactorCodeInvalid) {
+		t.Fatalf("wrong confirm code: want TwoFactorCodeInvalid, got %v", err)
+	}
+	confirm, err := svr.ConfirmTOTP(ctx, &admin.ConfirmTOTPReq{ChallengeToken: login.ChallengeToken, Code: currentCode(t, enroll.Secret, 0)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(confirm.RecoveryCodes) != recoveryCodeCount || confirm.Login == nil || confirm.Login.AdminToken == "" {
+		t.Fatalf("confirm = %+v", confirm)
+	}
+	// the password alone cannot replace the enrolled secret
+	login, err = svr.Login(ctx, &admin.LoginReq{Account: "staff", Password: "pw"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if login.TwoFactor != constant.TwoFactorVerify {
+		t.Fatalf("enabled 2FA login = %+v", login)
+	}
+	if _, err := svr.EnrollTOTP(ctx, &admin.EnrollTOTPReq{ChallengeToken: login.ChallengeToken}); !isCode(err, errs.ErrArgs) {
+		t.Fatalf("re-enrol with challenge: want ErrArgs, got %v", err)
+	}
+
+	// the code used to confirm cannot be replayed, the next one logs in once
+	if _, err := svr.VerifyLoginTOTP(ctx, &admin.VerifyLoginTOTPReq{ChallengeToken: login.ChallengeToken, Code: currentCode(t, enroll.Secret, 0)}); !isCode(err, eerrs.ErrTwoFactorCodeInvalid) {
+		t.Fatalf("replayed code: want TwoFactorCodeInvalid, got %v", err)
+	}
+	next := currentCode(t, enroll.Secret, 1)
+	resp, err := svr.VerifyLoginTOTP(ctx, &admin.VerifyLoginTOTPReq{ChallengeToken: login.ChallengeToken, Code: next})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.AdminToken == "" || db.tokens["u-staff"] != resp.AdminToken {
+		t.Fatalf("verify = %+v", resp)
+	}
+	if _, err := svr.VerifyLoginTOTP(ctx, &admin.VerifyLoginTOTPReq{ChallengeToken: login.ChallengeToken, Code: next}); !isCode(err, eerrs.ErrTwoFactorCodeInvalid) {
+		t.Fatalf("reused code: want TwoFactorCodeInvalid, got %v", err)
+	}
+
+	// recovery codes are single use and accepted in any case and dash layout
+	recovery := confirm.RecoveryCodes[0]
+	if _, err := svr.VerifyLoginTOTP(ctx, &admin.VerifyLoginTOTPReq{ChallengeToken: login.ChallengeToken, RecoveryCode: " " + recovery[:5] + recovery[6:] + " "}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := svr.VerifyLoginTOTP(ctx, &admin.VerifyLoginTOTPReq{ChallengeToken: login.ChallengeToken, RecoveryCode: recovery}); !isCode(err, eerrs.ErrTwoFactorCodeInvalid) {
+		t.Fatalf("used recovery code: want TwoFactorCodeInvalid, got %v", err)
+	}
+
+	// a required 2FA cannot be disabled by the admin
+	if _, err := svr.DisableTOTP(mctx.WithAdminUser(ctx, "u-staff"), &admin.DisableTOTPReq{Code: "123456"}); !isCode(err, errs.ErrNoPermission) {
+		t.Fatalf("disable required 2FA: want NoPermission, got %v", err)
+	}
+}
+
+func TestLoginTOTPLockout(t *testing.T) {
+	svr, db := newTestSvr(t)
+	ctx := mctx.WithAdminUser(context.Background(), "u-root")
+	enroll, err := svr.EnrollTOTP(ctx, &admin.EnrollTOTPReq{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := svr.ConfirmTOTP(ctx, &admin.ConfirmTOTPReq{Code: currentCode(t, enroll.Secret, -1)}); err != nil {
+		t.Fatal(err)
+	}
+	login, err := svr.Login(context.Background(), &admin.LoginReq{Account: "root", Password: "pw"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	wrong := wrongCode(enroll.Secret)
+	for i := 1; i <= totpFailureLimit; i++ {
+		_, err := svr.VerifyLoginTOTP(ctx, &admin.VerifyLoginTOTPReq{ChallengeToken: login.ChallengeToken, Code: wrong})
+		if i < totpFailureLimit && !isCode(err, eerrs.ErrTwoFactorCodeInvalid) {
+			t.Fatalf("attempt %d: want TwoFactorCodeInvalid, got %v", i, err)
+		}
+		if i == totpFailureLimit && !isCode(err, eerrs.ErrTwoFactorLocked) {
+			t.Fatalf("attempt %d: want TwoFactorLocked, got %v", i, err)
+		}
+	}
+	// a valid code is refused while locked
+	if _, err := svr.VerifyLoginTOTP(ctx, &admin.VerifyLoginTOTPReq{ChallengeToken: login.ChallengeToken, Code: currentCode(t, enroll.Secret, 0)}); !isCode(err, eerrs.ErrTwoFactorLocked) {
+		t.Fatalf("locked: want TwoFactorLocked, got %v", err)
+	}
+	db.admins["root"].TOTPLockedUntil = time.Now().Add(-time.Second)
+	if _, err := svr.VerifyLoginTOTP(ctx, &admin.VerifyLoginTOTPReq{ChallengeToken: login.ChallengeToken, Code: currentCode(t, enroll.Secret, 0)}); err != nil {
+		t.Fatal(err)
+	}
+}
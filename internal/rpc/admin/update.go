@@ -21,6 +21,7 @@ import (
 
 	"github.com/openimsdk/tools/errs"
 
+	"github.com/openimsdk/chat/pkg/passwd"
 	"github.com/openimsdk/chat/pkg/protocol/admin"
 )
 
@@ -46,7 +47,11 @@ func ToDBAdminUpdate(req *admin.AdminUpdateInfoReq) (map[string]any, error) {
 		if req.Password.Value == "" {
 			return nil, errs.ErrArgs.WrapMsg("password is empty")
 		}
-		update["password"] = req.Password.Value
+		hash, err := passwd.Hash(req.Password.Value)
+		if err != nil {
+			return nil, errs.Wrap(err)
+		}
+		update["password"] = hash
 	}
 	if req.FaceURL != nil {
 		update["face_url"] = req.FaceURL.Value
@@ -73,7 +78,11 @@ func ToDBAdminUpdatePassword(password string) (map[string]any, error) {
 	if password == "" {
 		return nil, errs.ErrArgs.WrapMsg("password is empty")
 	}
-	return map[string]any{"password": password}, nil
+	hash, err := passwd.Hash(password)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return map[string]any{"password": hash}, nil
 }
 
 func ToDBAppletUpdate(req *admin.UpdateAppletReq) (map[string]any, error) {
@@ -128,7 +137,3 @@ func ToDBAppletUpdate(req *admin.UpdateAppletReq) (map[string]any, error) {
 	}
 	return update, nil
 }
-
-func ToDBInvitationRegisterUpdate(userID string) map[string]any {
-	return map[string]any{"user_id": userID}
-}
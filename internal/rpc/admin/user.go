@@ -18,38 +18,65 @@ import (
 	"context"
 	// "github.com/openimsdk/protocol/wrapperspb"
 	"github.com/openimsdk/tools/utils/datautil"
+	"strconv"
 	"strings"
 	"time"
 
+	"github.com/openimsdk/chat/pkg/common/constant"
 	"github.com/openimsdk/chat/pkg/common/db/dbutil"
 	admindb "github.com/openimsdk/chat/pkg/common/db/table/admin"
 	"github.com/openimsdk/chat/pkg/common/mctx"
 	"github.com/openimsdk/chat/pkg/protocol/admin"
-	// "github.com/openimsdk/chat/pkg/protocol/chat"
+	"github.com/openimsdk/chat/pkg/protocol/chat"
 	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
 	"github.com/openimsdk/tools/mcontext"
 )
 
+// blockExpiryBatch is the number of expired blocks lifted per query
+const blockExpiryBatch = 100
+
 func (o *adminServer) CancellationUser(ctx context.Context, req *admin.CancellationUserReq) (*admin.CancellationUserResp, error) {
-	if _, err := mctx.CheckAdmin(ctx); err != nil {
+	if _, err := o.checkPermission(ctx, constant.PermUserManage); err != nil {
+		return nil, err
+	}
+	deletion, err := o.Chat.RequestAccountDeletion(ctx, &chat.RequestAccountDeletionReq{
+		UserID:    req.UserID,
+		Reason:    req.Reason,
+		Immediate: req.Immediate,
+	})
+	if err != nil {
 		return nil, err
 	}
-	// empty := wrapperspb.String("")
-	// update := &chat.UpdateUserInfoReq{UserID: req.UserID, Account: empty, AreaCode: empty, PhoneNumber: empty, Email: empty}
-	// if err := o.Chat.UpdateUser(ctx, update); err != nil {
-	// 	return nil, err
-	// }
-	return &admin.CancellationUserResp{}, nil
+	return &admin.CancellationUserResp{ExecuteAt: deletion.ExecuteAt}, nil
 }
 
 func (o *adminServer) BlockUser(ctx context.Context, req *admin.BlockUserReq) (*admin.BlockUserResp, error) {
-	_, err := mctx.CheckAdmin(ctx)
+	_, err := o.checkPermission(ctx, constant.PermUserBlock)
 	if err != nil {
 		return nil, err
 	}
-	_, err = o.Database.GetBlockInfo(ctx, req.UserID)
+	now := time.Now()
+	var expireTime time.Time
+	if req.ExpireTime > 0 {
+		expireTime = time.UnixMilli(req.ExpireTime)
+		if !expireTime.After(now) {
+			return nil, errs.ErrArgs.WrapMsg("expireTime is not in the future")
+		}
+	}
+	block, err := o.Database.GetBlockInfo(ctx, req.UserID)
 	if err == nil {
-		return nil, errs.ErrArgs.WrapMsg("user already blocked")
+		if !block.Expired(now) {
+			// blocking again completes a block whose token revocation failed
+			if err := o.kickUser(ctx, req.UserID); err != nil {
+				return nil, err
+			}
+			return nil, errs.ErrArgs.WrapMsg("user already blocked")
+		}
+		// an expired block not lifted yet is replaced
+		if _, err := o.Database.EndBlockUser(ctx, block, constant.BlockEndExpired, ""); err != nil {
+			return nil, err
+		}
 	} else if !dbutil.IsDBNotFound(err) {
 		return nil, err
 	}
@@ -58,16 +85,21 @@ func (o *adminServer) BlockUser(ctx context.Context, req *admin.BlockUserReq) (*
 		UserID:         req.UserID,
 		Reason:         req.Reason,
 		OperatorUserID: mcontext.GetOpUserID(ctx),
-		CreateTime:     time.Now(),
+		CreateTime:     now,
+		ExpireTime:     expireTime,
 	}
 	if err := o.Database.BlockUser(ctx, []*admindb.ForbiddenAccount{t}); err != nil {
 		return nil, err
 	}
+	// the block only stops new logins, the tokens must be revoked too
+	if err := o.kickUser(ctx, req.UserID); err != nil {
+		return nil, err
+	}
 	return &admin.BlockUserResp{}, nil
 }
 
 func (o *adminServer) UnblockUser(ctx context.Context, req *admin.UnblockUserReq) (*admin.UnblockUserResp, error) {
-	if _, err := mctx.CheckAdmin(ctx); err != nil {
+	if _, err := o.checkPermission(ctx, constant.PermUserBlock); err != nil {
 		return nil, err
 	}
 	if len(req.UserIDs) == 0 {
@@ -84,14 +116,17 @@ func (o *adminServer) UnblockUser(ctx context.Context, req *admin.UnblockUserReq
 		ids := datautil.Single(req.UserIDs, datautil.Slice(bs, func(info *admindb.ForbiddenAccount) string { return info.UserID }))
 		return nil, errs.ErrArgs.WrapMsg("user not blocked " + strings.Join(ids, ", "))
 	}
-	if err := o.Database.DelBlockUser(ctx, req.UserIDs); err != nil {
-		return nil, err
+	opUserID := mcontext.GetOpUserID(ctx)
+	for _, block := range bs {
+		if _, err := o.Database.EndBlockUser(ctx, block, constant.BlockEndUnblocked, opUserID); err != nil {
+			return nil, err
+		}
 	}
 	return &admin.UnblockUserResp{}, nil
 }
 
 func (o *adminServer) SearchBlockUser(ctx context.Context, req *admin.SearchBlockUserReq) (*admin.SearchBlockUserResp, error) {
-	if _, err := mctx.CheckAdmin(ctx); err != nil {
+	if _, err := o.checkPermission(ctx, constant.PermUserBlock); err != nil {
 		return nil, err
 	}
 	total, infos, err := o.Database.SearchBlockUser(ctx, req.Keyword, req.Pagination)
@@ -110,6 +145,7 @@ func (o *adminServer) SearchBlockUser(ctx context.Context, req *admin.SearchBloc
 			Reason:     info.Reason,
 			OpUserID:   info.OperatorUserID,
 			CreateTime: info.CreateTime.UnixMilli(),
+			ExpireTime: unixMilli(info.ExpireTime),
 		}
 		if userFull := userMap[info.UserID]; userFull != nil {
 			user.Account = userFull.Account
@@ -126,7 +162,7 @@ func (o *adminServer) SearchBlockUser(ctx context.Context, req *admin.SearchBloc
 }
 
 func (o *adminServer) FindUserBlockInfo(ctx context.Context, req *admin.FindUserBlockInfoReq) (*admin.FindUserBlockInfoResp, error) {
-	if _, err := mctx.CheckAdmin(ctx); err != nil {
+	if _, err := o.checkPermission(ctx, constant.PermUserBlock); err != nil {
 		return nil, err
 	}
 	list, err := o.Database.FindBlockUser(ctx, req.UserIDs)
@@ -140,7 +176,90 @@ func (o *adminServer) FindUserBlockInfo(ctx context.Context, req *admin.FindUser
 			Reason:     info.Reason,
 			OpUserID:   info.OperatorUserID,
 			CreateTime: info.CreateTime.UnixMilli(),
+			ExpireTime: unixMilli(info.ExpireTime),
 		})
 	}
 	return &admin.FindUserBlockInfoResp{Blocks: blocks}, nil
 }
+
+func (o *adminServer) SearchBlockHistory(ctx context.Context, req *admin.SearchBlockHistoryReq) (*admin.SearchBlockHistoryResp, error) {
+	if _, err := o.checkPermission(ctx, constant.PermUserBlock); err != nil {
+		return nil, err
+	}
+	total, histories, err := o.Database.SearchBlockHistory(ctx, req.UserID, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	resp := &admin.SearchBlockHistoryResp{Total: uint32(total), Histories: make([]*admin.BlockHistory, 0, len(histories))}
+	for _, h := range histories {
+		resp.Histories = append(resp.Histories, &admin.BlockHistory{
+			UserID:      h.UserID,
+			Reason:      h.Reason,
+			OpUserID:    h.OperatorUserID,
+			CreateTime:  h.CreateTime.UnixMilli(),
+			ExpireTime:  unixMilli(h.ExpireTime),
+			EndTime:     h.EndTime.UnixMilli(),
+			EndReason:   h.EndReason,
+			EndOpUserID: h.EndOperatorUserID,
+		})
+	}
+	return resp, nil
+}
+
+// kickUser revokes the chat tokens of the user and forces it offline in OpenIM.
+// Only a failed revocation is returned, forcing the user offline is logged if it fails.
+func (o *adminServer) kickUser(ctx context.Context, userID string) error {
+	if _, err := o.InvalidateToken(ctx, &admin.InvalidateTokenReq{UserID: userID}); err != nil {
+		return err
+	}
+	imToken, err := o.IM.ImAdminTokenWithDefaultAdmin(ctx)
+	if err == nil {
+		err = o.IM.ForceOffLine(mctx.WithApiToken(ctx, imToken), userID)
+	}
+	if err != nil {
+		log.ZError(ctx, "force blocked user offline failed", err, "userID", userID)
+	}
+	return nil
+}
+
+// runBlockExpiry periodically lifts the temporary blocks that have expired and records them in the history.
+func (o *adminServer) runBlockExpiry(ctx context.Context) {
+	ticker := time.NewTicker(o.BlockExpiryInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			o.liftExpiredBlocks(mcontext.SetOperationID(ctx, "block_expiry_"+strconv.FormatInt(time.Now().UnixMilli(), 10)))
+		}
+	}
+}
+
+func (o *adminServer) liftExpiredBlocks(ctx context.Context) {
+	for {
+		blocks, err := o.Database.FindExpiredBlockUser(ctx, time.Now(), blockExpiryBatch)
+		if err != nil {
+			log.ZError(ctx, "find expired blocks failed", err)
+			return
+		}
+		for _, block := range blocks {
+			// another instance may have lifted it already
+			if _, err := o.Database.EndBlockUser(ctx, block, constant.BlockEndExpired, ""); err != nil {
+				log.ZError(ctx, "lift expired block failed", err, "userID", block.UserID)
+				return
+			}
+		}
+		if len(blocks) < blockExpiryBatch {
+			return
+		}
+	}
+}
+
+// unixMilli returns 0 for the zero time.
+func unixMilli(t time.Time) int64 {
+	if t.IsZero() {
+		return 0
+	}
+	return t.UnixMilli()
+}
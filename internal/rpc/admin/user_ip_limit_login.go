@@ -16,21 +16,32 @@ package admin
 
 import (
 	"context"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/openimsdk/tools/utils/datautil"
 
+	"github.com/openimsdk/chat/pkg/common/constant"
 	admindb "github.com/openimsdk/chat/pkg/common/db/table/admin"
-	"github.com/openimsdk/chat/pkg/common/mctx"
 	"github.com/openimsdk/chat/pkg/protocol/admin"
 	"github.com/openimsdk/tools/errs"
 )
 
 func (o *adminServer) SearchUserIPLimitLogin(ctx context.Context, req *admin.SearchUserIPLimitLoginReq) (*admin.SearchUserIPLimitLoginResp, error) {
-	if _, err := mctx.CheckAdmin(ctx); err != nil {
+	if _, err := o.checkPermission(ctx, constant.PermForbidden); err != nil {
 		return nil, err
 	}
-	total, list, err := o.Database.SearchUserLimitLogin(ctx, req.Keyword, req.Pagination)
+	var (
+		total int64
+		list  []*admindb.LimitUserLoginIP
+		err   error
+	)
+	if req.Ip == "" {
+		total, list, err = o.Database.SearchUserLimitLogin(ctx, req.Keyword, req.Pagination)
+	} else {
+		total, list, err = o.searchUserIPLimitMatch(ctx, req)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -52,7 +63,7 @@ func (o *adminServer) SearchUserIPLimitLogin(ctx context.Context, req *admin.Sea
 }
 
 func (o *adminServer) AddUserIPLimitLogin(ctx context.Context, req *admin.AddUserIPLimitLoginReq) (*admin.AddUserIPLimitLoginResp, error) {
-	if _, err := mctx.CheckAdmin(ctx); err != nil {
+	if _, err := o.checkPermission(ctx, constant.PermForbidden); err != nil {
 		return nil, err
 	}
 	if len(req.Limits) == 0 {
@@ -61,20 +72,25 @@ func (o *adminServer) AddUserIPLimitLogin(ctx context.Context, req *admin.AddUse
 	now := time.Now()
 	ts := make([]*admindb.LimitUserLoginIP, 0, len(req.Limits))
 	for _, limit := range req.Limits {
+		rule, err := parseIPRule(limit.Ip)
+		if err != nil {
+			return nil, err
+		}
 		ts = append(ts, &admindb.LimitUserLoginIP{
 			UserID:     limit.UserID,
-			IP:         limit.Ip,
+			IP:         rule,
 			CreateTime: now,
 		})
 	}
 	if err := o.Database.AddUserLimitLogin(ctx, ts); err != nil {
 		return nil, err
 	}
+	o.reloadIPRules(ctx)
 	return &admin.AddUserIPLimitLoginResp{}, nil
 }
 
 func (o *adminServer) DelUserIPLimitLogin(ctx context.Context, req *admin.DelUserIPLimitLoginReq) (*admin.DelUserIPLimitLoginResp, error) {
-	if _, err := mctx.CheckAdmin(ctx); err != nil {
+	if _, err := o.checkPermission(ctx, constant.PermForbidden); err != nil {
 		return nil, err
 	}
 	if len(req.Limits) == 0 {
@@ -89,9 +105,42 @@ func (o *adminServer) DelUserIPLimitLogin(ctx context.Context, req *admin.DelUse
 			UserID: limit.UserID,
 			IP:     limit.Ip,
 		})
+		// rules are stored in canonical form, rows stored before that are deleted as given
+		if rule, err := parseIPRule(limit.Ip); err == nil && rule != limit.Ip {
+			ts = append(ts, &admindb.LimitUserLoginIP{
+				UserID: limit.UserID,
+				IP:     rule,
+			})
+		}
 	}
 	if err := o.Database.DelUserLimitLogin(ctx, ts); err != nil {
 		return nil, err
 	}
+	o.reloadIPRules(ctx)
 	return &admin.DelUserIPLimitLoginResp{}, nil
 }
+
+// searchUserIPLimitMatch returns the login IP limits containing the ip of the request,
+// by user and the most specific first, the keyword matches the userID.
+func (o *adminServer) searchUserIPLimitMatch(ctx context.Context, req *admin.SearchUserIPLimitLoginReq) (int64, []*admindb.LimitUserLoginIP, error) {
+	addr, err := parseClientIP(req.Ip)
+	if err != nil {
+		return 0, nil, err
+	}
+	rules, err := o.currentIPRules(ctx)
+	if err != nil {
+		return 0, nil, err
+	}
+	userIDs := make([]string, 0, len(rules.limits))
+	for userID := range rules.limits {
+		if strings.Contains(strings.ToLower(userID), strings.ToLower(req.Keyword)) {
+			userIDs = append(userIDs, userID)
+		}
+	}
+	sort.Strings(userIDs)
+	var list []*admindb.LimitUserLoginIP
+	for _, userID := range userIDs {
+		list = append(list, rules.matchLimit(userID, addr)...)
+	}
+	return int64(len(list)), datautil.Paginate(list, int(req.Pagination.GetPageNumber()), int(req.Pagination.GetShowNumber())), nil
+}
@@ -0,0 +1,198 @@
+package chat
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
+	"github.com/openimsdk/tools/mcontext"
+
+	"github.com/openimsdk/chat/pkg/common/constant"
+	"github.com/openimsdk/chat/pkg/common/convert"
+	"github.com/openimsdk/chat/pkg/common/db/dbutil"
+	"github.com/openimsdk/chat/pkg/common/db/table/chat"
+	"github.com/openimsdk/chat/pkg/common/mctx"
+	"github.com/openimsdk/chat/pkg/eerrs"
+	chatpb "github.com/openimsdk/chat/pkg/protocol/chat"
+)
+
+// 每轮定时任务最多注销的账号数，剩下的下一轮注销
+const accountDeletionBatch = 20
+
+type accountDeletion struct {
+	// GracePeriod 申请后到清除数据的冷静期，期间用户可以撤销
+	GracePeriod time.Duration
+	Interval    time.Duration
+	// Lease 领取申请后独占的时间，超时未完成的申请会被重新领取
+	Lease time.Duration
+	// Owner 区分不同实例的租约
+	Owner string
+}
+
+// deletionUserID 普通用户只能操作自己的账号，管理员需要指定用户，返回用户ID和是否是管理员
+func deletionUserID(ctx context.Context, userID string) (string, bool, error) {
+	opUserID, userType, err := mctx.Check(ctx)
+	if err != nil {
+		return "", false, err
+	}
+	switch userType {
+	case constant.NormalUser:
+		if userID != "" && userID != opUserID {
+			return "", false, errs.ErrNoPermission.WrapMsg("no permission to delete other user account")
+		}
+		return opUserID, false, nil
+	case constant.AdminUser:
+		if userID == "" {
+			return "", false, errs.ErrArgs.WrapMsg("user id must be set")
+		}
+		return userID, true, nil
+	default:
+		return "", false, errs.ErrNoPermission.WrapMsg("user type error")
+	}
+}
+
+func (o *chatSvr) RequestAccountDeletion(ctx context.Context, req *chatpb.RequestAccountDeletionReq) (*chatpb.RequestAccountDeletionResp, error) {
+	userID, admin, err := deletionUserID(ctx, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if req.Immediate && !admin {
+		return nil, errs.ErrNoPermission.WrapMsg("only admin can skip the grace period")
+	}
+	now := time.Now()
+	deletion := &chat.AccountDeletion{
+		UserID:      userID,
+		Reason:      req.Reason,
+		Status:      constant.AccountDeletionPending,
+		RequestTime: now,
+		ExecuteAt:   now.Add(o.AccountDeletion.GracePeriod),
+	}
+	if admin {
+		deletion.OperatorUserID = mcontext.GetOpUserID(ctx)
+	}
+	if req.Immediate {
+		deletion.ExecuteAt = now
+	}
+	if _, err := o.Database.GetUser(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	old, err := o.Database.GetAccountDeletion(ctx, userID)
+	if err != nil {
+		if !dbutil.IsDBNotFound(err) {
+			return nil, err
+		}
+		if err := o.Database.CreateAccountDeletion(ctx, deletion); err != nil {
+			return nil, err
+		}
+		return &chatpb.RequestAccountDeletionResp{Deletion: convert.AccountDeletionDB2Pb(deletion)}, nil
+	}
+	// 已经申请过，只有管理员能提前执行时间
+	if !deletion.ExecuteAt.Before(old.ExecuteAt) {
+		return &chatpb.RequestAccountDeletionResp{Deletion: convert.AccountDeletionDB2Pb(old)}, nil
+	}
+	updated, err := o.Database.UpdateAccountDeletion(ctx, userID, map[string]any{
+		"reason":           deletion.Reason,
+		"operator_user_id": deletion.OperatorUserID,
+		"execute_at":       deletion.ExecuteAt,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !updated {
+		return nil, eerrs.ErrAccountDeletionExecuting.WrapMsg("account is being deleted")
+	}
+	deletion.RequestTime = old.RequestTime
+	return &chatpb.RequestAccountDeletionResp{Deletion: convert.AccountDeletionDB2Pb(deletion)}, nil
+}
+
+func (o *chatSvr) CancelAccountDeletion(ctx context.Context, req *chatpb.CancelAccountDeletionReq) (*chatpb.CancelAccountDeletionResp, error) {
+	userID, _, err := deletionUserID(ctx, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+	cancelled, err := o.Database.CancelAccountDeletion(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if !cancelled {
+		deletion, err := o.Database.GetAccountDeletion(ctx, userID)
+		if err != nil {
+			return nil, err
+		}
+		if deletion.Status == constant.AccountDeletionDone {
+			return nil, errs.ErrArgs.WrapMsg("account already deleted")
+		}
+		return nil, eerrs.ErrAccountDeletionExecuting.WrapMsg("account is being deleted")
+	}
+	return &chatpb.CancelAccountDeletionResp{}, nil
+}
+
+func (o *chatSvr) GetAccountDeletion(ctx context.Context, req *chatpb.GetAccountDeletionReq) (*chatpb.GetAccountDeletionResp, error) {
+	userID, _, err := deletionUserID(ctx, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+	deletion, err := o.Database.GetAccountDeletion(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return &chatpb.GetAccountDeletionResp{Deletion: convert.AccountDeletionDB2Pb(deletion)}, nil
+}
+
+// runAccountDeletion 定期注销冷静期已结束的账号，多个实例通过租约各自领取不同的申请
+func (o *chatSvr) runAccountDeletion(ctx context.Context) {
+	ticker := time.NewTicker(o.AccountDeletion.Interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			o.eraseDueAccounts(mcontext.SetOperationID(ctx, "account_deletion_"+strconv.FormatInt(time.Now().UnixMilli(), 10)))
+		}
+	}
+}
+
+func (o *chatSvr) eraseDueAccounts(ctx context.Context) {
+	for i := 0; i < accountDeletionBatch; i++ {
+		deletion, err := o.Database.ClaimDueAccountDeletion(ctx, o.AccountDeletion.Owner, o.AccountDeletion.Lease)
+		if err != nil {
+			if !dbutil.IsDBNotFound(err) {
+				log.ZError(ctx, "claim account deletion failed", err)
+			}
+			return
+		}
+		// 失败的申请等租约过期后重试
+		if err := o.eraseAccount(ctx, deletion.UserID); err != nil {
+			log.ZError(ctx, "erase account failed", err, "userID", deletion.UserID)
+		}
+	}
+}
+
+// eraseAccount 先让令牌失效并强制下线，再清除数据并写入回执，每一步都可以重复执行
+func (o *chatSvr) eraseAccount(ctx context.Context, userID string) error {
+	if err := o.Admin.InvalidateToken(ctx, userID); err != nil {
+		return err
+	}
+	imToken, err := o.IM.ImAdminTokenWithDefaultAdmin(ctx)
+	if err != nil {
+		return err
+	}
+	imCtx := mctx.WithApiToken(ctx, imToken)
+	if err := o.IM.ForceOffLine(imCtx, userID); err != nil {
+		return err
+	}
+	// OpenIM 不能删除用户，只清除资料
+	if err := o.IM.UpdateUserInfo(imCtx, userID, constant.DeletedUserNickname, "", "", "", ""); err != nil {
+		return err
+	}
+	records, err := o.Database.EraseUser(ctx, userID, o.AccountDeletion.Owner, o.Storage.Delete)
+	if err != nil {
+		return err
+	}
+	log.ZInfo(ctx, "account erased", "userID", userID, "records", records)
+	return nil
+}
@@ -0,0 +1,259 @@
+package chat
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/openimsdk/tools/errs"
+	"go.mongodb.org/mongo-driver/mongo"
+	"google.golang.org/grpc"
+
+	"github.com/openimsdk/chat/pkg/common/constant"
+	chatdb "github.com/openimsdk/chat/pkg/common/db/table/chat"
+	"github.com/openimsdk/chat/pkg/common/imapi"
+	"github.com/openimsdk/chat/pkg/common/mctx"
+	"github.com/openimsdk/chat/pkg/eerrs"
+	"github.com/openimsdk/chat/pkg/protocol/admin"
+	"github.com/openimsdk/chat/pkg/protocol/chat"
+	chatClient "github.com/openimsdk/chat/pkg/rpclient/chat"
+)
+
+func (d *memDatabase) GetUser(_ context.Context, userID string) (*chatdb.Account, error) {
+	a, ok := d.accounts[userID]
+	if !ok {
+		return nil, errs.Wrap(mongo.ErrNoDocuments)
+	}
+	c := *a
+	return &c, nil
+}
+
+func (d *memDatabase) CreateAccountDeletion(_ context.Context, deletion *chatdb.AccountDeletion) error {
+	c := *deletion
+	d.deletions[deletion.UserID] = &c
+	return nil
+}
+
+func (d *memDatabase) GetAccountDeletion(_ context.Context, userID string) (*chatdb.AccountDeletion, error) {
+	deletion, ok := d.deletions[userID]
+	if !ok {
+		return nil, errs.Wrap(mongo.ErrNoDocuments)
+	}
+	c := *deletion
+	return &c, nil
+}
+
+func (d *memDatabase) pending(userID string) *chatdb.AccountDeletion {
+	deletion, ok := d.deletions[userID]
+	if !ok || deletion.Status != constant.AccountDeletionPending || deletion.LeaseUntil.After(time.Now()) {
+		return nil
+	}
+	return deletion
+}
+
+func (d *memDatabase) UpdateAccountDeletion(_ context.Context, userID string, data map[string]any) (bool, error) {
+	deletion := d.pending(userID)
+	if deletion == nil {
+		return false, nil
+	}
+	deletion.ExecuteAt = data["execute_at"].(time.Time)
+	return true, nil
+}
+
+func (d *memDatabase) CancelAccountDeletion(_ context.Context, userID string) (bool, error) {
+	if d.pending(userID) == nil {
+		return false, nil
+	}
+	delete(d.deletions, userID)
+	return true, nil
+}
+
+func (d *memDatabase) ClaimDueAccountDeletion(_ context.Context, owner string, lease time.Duration) (*chatdb.AccountDeletion, error) {
+	now := time.Now()
+	for userID := range d.deletions {
+		deletion := d.pending(userID)
+		if deletion == nil || deletion.ExecuteAt.After(now) {
+			continue
+		}
+		deletion.LeaseOwner, deletion.LeaseUntil = owner, now.Add(lease)
+		c := *deletion
+		return &c, nil
+	}
+	return nil, errs.Wrap(mongo.ErrNoDocuments)
+}
+
+func (d *memDatabase) EraseUser(ctx context.Context, userID string, owner string, deleteFile func(context.Context, string) error) ([]*chatdb.ErasedRecord, error) {
+	deletion := d.deletions[userID]
+	if deletion == nil || deletion.LeaseOwner != owner {
+		return nil, errs.ErrNoPermission.WrapMsg("account deletion lease lost")
+	}
+	var keys []string
+	exports := d.exports[:0]
+	for _, export := range d.exports {
+		switch {
+		case export.UserID != userID:
+			exports = append(exports, export)
+		case export.Key != "":
+			keys = append(keys, export.Key)
+		}
+	}
+	d.exports = exports
+	delete(d.accounts, userID)
+	records := []*chatdb.ErasedRecord{{Collection: "account", Deleted: 1}}
+	deletion.Status, deletion.Records = constant.AccountDeletionDone, records
+	// archives go once the erasure is stored, like after the commit
+	for _, key := range keys {
+		if err := deleteFile(ctx, key); err != nil {
+			return records, err
+		}
+	}
+	return records, nil
+}
+
+type tokenAdmin struct {
+	admin.AdminClient
+	invalidated []string
+}
+
+func (a *tokenAdmin) InvalidateToken(_ context.Context, in *admin.InvalidateTokenReq, _ ...grpc.CallOption) (*admin.InvalidateTokenResp, error) {
+	a.invalidated = append(a.invalidated, in.UserID)
+	return &admin.InvalidateTokenResp{}, nil
+}
+
+type offlineIM struct {
+	imapi.CallerInterface
+	err     error
+	offline []string
+	renamed map[string]string
+}
+
+func (im *offlineIM) ImAdminTokenWithDefaultAdmin(context.Context) (string, error) {
+	return "token", nil
+}
+
+func (im *offlineIM) ForceOffLine(_ context.Context, userID string) error {
+	if im.err != nil {
+		return im.err
+	}
+	im.offline = append(im.offline, userID)
+	return nil
+}
+
+func (im *offlineIM) UpdateUserInfo(_ context.Context, userID string, nickName string, _ string, _ string, _ string, _ string) error {
+	im.renamed[userID] = nickName
+	return nil
+}
+
+// newDeletionSvr returns a server with the given accounts and the admin and IM clients that sign them out.
+func newDeletionSvr(t *testing.T, userIDs ...string) (*chatSvr, *memDatabase, *tokenAdmin, *offlineIM) {
+	svr, db := newTestSvr(t)
+	for _, userID := range userIDs {
+		db.accounts[userID] = &chatdb.Account{UserID: userID}
+	}
+	adminClient := &tokenAdmin{}
+	im := &offlineIM{renamed: make(map[string]string)}
+	svr.Admin = chatClient.NewAdminClient(adminClient)
+	svr.IM = im
+	return svr, db, adminClient, im
+}
+
+func TestAccountDeletionGracePeriod(t *testing.T) {
+	svr, db, adminClient, im := newDeletionSvr(t, "u1")
+	user := mctx.WithOpUserID(context.Background(), "u1", constant.NormalUser)
+	archive := "data_export/u1/e1.zip"
+	if err := svr.Storage.Put(context.Background(), archive, strings.NewReader("zip"), 3); err != nil {
+		t.Fatal(err)
+	}
+	db.exports = append(db.exports, &chatdb.DataExport{ExportID: "e1", UserID: "u1", Key: archive})
+
+	resp, err := svr.RequestAccountDeletion(user, &chat.RequestAccountDeletionReq{Reason: "bye"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.Deletion.UserID != "u1" || resp.Deletion.OperatorUserID != "" || resp.Deletion.ExecuteAt < time.Now().Add(59*time.Minute).UnixMilli() {
+		t.Fatalf("unexpected deletion %+v", resp.Deletion)
+	}
+	svr.eraseDueAccounts(context.Background())
+	if db.accounts["u1"] == nil || len(adminClient.invalidated) != 0 {
+		t.Fatal("account erased during the grace period")
+	}
+
+	if _, err := svr.CancelAccountDeletion(user, &chat.CancelAccountDeletionReq{}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := svr.GetAccountDeletion(user, &chat.GetAccountDeletionReq{}); !IsNotFound(err) {
+		t.Fatalf("want cancelled deletion gone, got %v", err)
+	}
+
+	// the user asks again and an admin skips the grace period
+	if _, err := svr.RequestAccountDeletion(user, &chat.RequestAccountDeletionReq{}); err != nil {
+		t.Fatal(err)
+	}
+	adminCtx := mctx.WithAdminUser(context.Background(), "admin")
+	if _, err := svr.RequestAccountDeletion(adminCtx, &chat.RequestAccountDeletionReq{UserID: "u1", Immediate: true}); err != nil {
+		t.Fatal(err)
+	}
+	svr.eraseDueAccounts(context.Background())
+
+	if db.accounts["u1"] != nil {
+		t.Fatal("account not erased")
+	}
+	if _, err := svr.Storage.Get(context.Background(), archive); err == nil {
+		t.Fatal("data export archive kept after erasure")
+	}
+	if len(adminClient.invalidated) != 1 || len(im.offline) != 1 || im.renamed["u1"] != constant.DeletedUserNickname {
+		t.Fatalf("user not signed out: tokens %v, offline %v, renamed %v", adminClient.invalidated, im.offline, im.renamed)
+	}
+	receipt, err := svr.GetAccountDeletion(adminCtx, &chat.GetAccountDeletionReq{UserID: "u1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if receipt.Deletion.Status != constant.AccountDeletionDone || len(receipt.Deletion.Records) != 1 {
+		t.Fatalf("unexpected receipt %+v", receipt.Deletion)
+	}
+	if _, err := svr.CancelAccountDeletion(adminCtx, &chat.CancelAccountDeletionReq{UserID: "u1"}); !isCode(err, errs.ErrArgs) {
+		t.Fatalf("cancel after erasure: want ErrArgs, got %v", err)
+	}
+}
+
+func TestAccountDeletionPermission(t *testing.T) {
+	svr, _, _, _ := newDeletionSvr(t, "u1", "u2")
+	user := mctx.WithOpUserID(context.Background(), "u1", constant.NormalUser)
+
+	if _, err := svr.RequestAccountDeletion(user, &chat.RequestAccountDeletionReq{UserID: "u2"}); !isCode(err, errs.ErrNoPermission) {
+		t.Fatalf("other user: want NoPermission, got %v", err)
+	}
+	if _, err := svr.RequestAccountDeletion(user, &chat.RequestAccountDeletionReq{Immediate: true}); !isCode(err, errs.ErrNoPermission) {
+		t.Fatalf("immediate: want NoPermission, got %v", err)
+	}
+	if _, err := svr.CancelAccountDeletion(user, &chat.CancelAccountDeletionReq{UserID: "u2"}); !isCode(err, errs.ErrNoPermission) {
+		t.Fatalf("cancel other user: want NoPermission, got %v", err)
+	}
+}
+
+func TestAccountDeletionRetry(t *testing.T) {
+	svr, db, _, im := newDeletionSvr(t, "u1")
+	adminCtx := mctx.WithAdminUser(context.Background(), "admin")
+	if _, err := svr.RequestAccountDeletion(adminCtx, &chat.RequestAccountDeletionReq{UserID: "u1", Immediate: true}); err != nil {
+		t.Fatal(err)
+	}
+
+	im.err = errs.New("openim unavailable")
+	svr.eraseDueAccounts(context.Background())
+	if db.accounts["u1"] == nil {
+		t.Fatal("account erased before the user was forced offline")
+	}
+	if _, err := svr.CancelAccountDeletion(adminCtx, &chat.CancelAccountDeletionReq{UserID: "u1"}); !isCode(err, eerrs.ErrAccountDeletionExecuting) {
+		t.Fatalf("cancel under lease: want AccountDeletionExecuting, got %v", err)
+	}
+
+	// another instance picks it up after the lease expired
+	im.err = nil
+	db.deletions["u1"].LeaseUntil = time.Now().Add(-time.Second)
+	svr.AccountDeletion.Owner = "instance-b"
+	svr.eraseDueAccounts(context.Background())
+	if db.accounts["u1"] != nil || db.deletions["u1"].Status != constant.AccountDeletionDone {
+		t.Fatal("account not erased on retry")
+	}
+}
@@ -0,0 +1,56 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chat
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/tools/log"
+	"github.com/openimsdk/tools/utils/datautil"
+	"google.golang.org/grpc"
+
+	"github.com/openimsdk/chat/pkg/common/audit"
+	"github.com/openimsdk/chat/pkg/common/mctx"
+	"github.com/openimsdk/chat/pkg/protocol/admin"
+)
+
+// AuditInterceptor sends the operations admins make through chat-rpc to the admin audit log.
+// Reads and calls with the service admin identity are not audited. A failure to write the log does not change the response.
+func AuditInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	srv, ok := info.Server.(*chatSvr)
+	if !ok || audit.IsRead(audit.MethodName(info.FullMethod)) {
+		return handler(ctx, req)
+	}
+	opUserID, err := mctx.CheckAdmin(ctx)
+	if err != nil || datautil.Contain(opUserID, srv.Share.ChatAdmin...) {
+		return handler(ctx, req)
+	}
+	entry := &admin.AuditLog{
+		OperatorUserID: opUserID,
+		Method:         info.FullMethod,
+		Request:        audit.Request(req),
+		Ip:             mctx.GetOpClientIP(ctx),
+		CreateTime:     time.Now().UnixMilli(),
+	}
+	resp, err := handler(ctx, req)
+	if err != nil {
+		entry.ResultCode, entry.ResultMsg = audit.Result(err)
+	}
+	if err := srv.Admin.AddAuditLog(srv.WithAdminUser(ctx), entry); err != nil {
+		log.ZError(ctx, "write audit log", err, "method", info.FullMethod)
+	}
+	return resp, err
+}
@@ -0,0 +1,102 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chat
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/openimsdk/tools/errs"
+	"google.golang.org/grpc"
+
+	"github.com/openimsdk/chat/pkg/common/config"
+	"github.com/openimsdk/chat/pkg/common/constant"
+	"github.com/openimsdk/chat/pkg/common/mctx"
+	"github.com/openimsdk/chat/pkg/protocol/admin"
+	"github.com/openimsdk/chat/pkg/protocol/chat"
+	chatClient "github.com/openimsdk/chat/pkg/rpclient/chat"
+)
+
+// auditAdmin keeps the audit logs chat-rpc sends and the identity they were sent with.
+type auditAdmin struct {
+	admin.AdminClient
+	logs    []*admin.AuditLog
+	senders []string
+}
+
+func (a *auditAdmin) AddAuditLog(ctx context.Context, in *admin.AddAuditLogReq, _ ...grpc.CallOption) (*admin.AddAuditLogResp, error) {
+	a.logs = append(a.logs, in.Log)
+	a.senders = append(a.senders, mctx.GetOpUserID(ctx))
+	return &admin.AddAuditLogResp{}, nil
+}
+
+func TestAuditInterceptor(t *testing.T) {
+	adminClient := &auditAdmin{}
+	svr := &chatSvr{
+		Admin:           chatClient.NewAdminClient(adminClient),
+		ChatAdminUserID: "u-service",
+		Share:           config.Share{ChatAdmin: []string{"u-service"}},
+	}
+	info := func(method string) *grpc.UnaryServerInfo {
+		return &grpc.UnaryServerInfo{Server: svr, FullMethod: "/openim.chat.chat/" + method}
+	}
+	ok := func(ctx context.Context, req any) (any, error) { return &chat.ResetPasswordResp{}, nil }
+	failed := func(ctx context.Context, req any) (any, error) { return nil, errs.ErrArgs.WrapMsg("bad") }
+	adminCtx := mctx.WithAdminUser(context.Background(), "u-admin")
+
+	req := &chat.ChangePasswordReq{UserID: "u1", NewPassword: "secret"}
+	if _, err := AuditInterceptor(adminCtx, req, info("ChangePassword"), ok); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := AuditInterceptor(adminCtx, &chat.DelUserAccountReq{UserIDs: []string{"u1"}}, info("DelUserAccount"), failed); err == nil {
+		t.Fatal("handler error lost")
+	}
+	if len(adminClient.logs) != 2 {
+		t.Fatalf("logs = %+v", adminClient.logs)
+	}
+	if l := adminClient.logs[0]; l.OperatorUserID != "u-admin" || l.Method != "/openim.chat.chat/ChangePassword" || l.ResultCode != 0 ||
+		strings.Contains(l.Request, "secret") || !strings.Contains(l.Request, `"u1"`) {
+		t.Fatalf("audit = %+v", l)
+	}
+	if l := adminClient.logs[1]; l.ResultCode != int32(errs.ErrArgs.Code()) {
+		t.Fatalf("failed call audit = %+v", l)
+	}
+	for _, sender := range adminClient.senders {
+		if sender != "u-service" {
+			t.Fatalf("audit log sent as %q", sender)
+		}
+	}
+
+	// reads, users and the service identity are not audited
+	userCtx := mctx.WithOpUserID(context.Background(), "u1", constant.NormalUser)
+	serviceCtx := mctx.WithAdminUser(context.Background(), "u-service")
+	for _, call := range []struct {
+		ctx    context.Context
+		method string
+	}{
+		{adminCtx, "FindUserFullInfo"},
+		{adminCtx, "SearchUserFullInfo"},
+		{userCtx, "ChangePassword"},
+		{serviceCtx, "RegisterUser"},
+	} {
+		if _, err := AuditInterceptor(call.ctx, req, info(call.method), ok); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if len(adminClient.logs) != 2 {
+		t.Fatalf("unexpected logs %+v", adminClient.logs[2:])
+	}
+}
@@ -0,0 +1,405 @@
+package chat
+
+import (
+	"archive/zip"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
+	"github.com/openimsdk/tools/mcontext"
+	"go.mongodb.org/mongo-driver/bson"
+
+	"github.com/openimsdk/chat/pkg/common/constant"
+	"github.com/openimsdk/chat/pkg/common/convert"
+	"github.com/openimsdk/chat/pkg/common/db/dbutil"
+	"github.com/openimsdk/chat/pkg/common/db/table/chat"
+	"github.com/openimsdk/chat/pkg/common/mctx"
+	"github.com/openimsdk/chat/pkg/eerrs"
+	chatpb "github.com/openimsdk/chat/pkg/protocol/chat"
+)
+
+// 每轮定时任务最多打包的导出任务数和清理的过期压缩包数
+const (
+	dataExportBatch = 5
+	dataExportPurge = 100
+)
+
+type dataExport struct {
+	// Secret 下载链接的签名密钥
+	Secret []byte
+	// LinkExpire 打包完成后可以下载的时间
+	LinkExpire  time.Duration
+	DownloadURL string
+	Interval    time.Duration
+	Lease       time.Duration
+	Owner       string
+}
+
+// enabled 没有配置签名密钥时不提供数据导出
+func (d *dataExport) enabled() bool {
+	return len(d.Secret) > 0
+}
+
+// 下载令牌为 exportID.过期时间.签名，过期时间和打包任务的一致
+func (d *dataExport) sign(exportID string, expireAt int64) string {
+	payload := exportID + "." + strconv.FormatInt(expireAt, 10)
+	mac := hmac.New(sha256.New, d.Secret)
+	mac.Write([]byte(payload))
+	return payload + "." + base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// verify 校验签名和过期时间，返回 exportID
+func (d *dataExport) verify(token string, now time.Time) (string, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return "", eerrs.ErrDataExportLinkInvalid.WrapMsg("malformed download token")
+	}
+	expireAt, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		return "", eerrs.ErrDataExportLinkInvalid.WrapMsg("malformed download token")
+	}
+	if !hmac.Equal([]byte(d.sign(parts[0], expireAt)), []byte(token)) {
+		return "", eerrs.ErrDataExportLinkInvalid.WrapMsg("download token signature mismatch")
+	}
+	if now.UnixMilli() >= expireAt {
+		return "", eerrs.ErrDataExportLinkInvalid.WrapMsg("download link expired")
+	}
+	return parts[0], nil
+}
+
+// downloadURL 只有可以下载的任务才有链接
+func (d *dataExport) downloadURL(export *chat.DataExport) string {
+	if export.Status != constant.DataExportReady || !export.ExpireAt.After(time.Now()) {
+		return ""
+	}
+	return d.DownloadURL + "?token=" + url.QueryEscape(d.sign(export.ExportID, export.ExpireAt.UnixMilli()))
+}
+
+func (o *chatSvr) RequestDataExport(ctx context.Context, req *chatpb.RequestDataExportReq) (*chatpb.RequestDataExportResp, error) {
+	if !o.DataExport.enabled() {
+		return nil, errs.ErrInternalServer.WrapMsg("data export is not enabled")
+	}
+	userID, err := mctx.CheckUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	latest, err := o.Database.GetLatestDataExport(ctx, userID)
+	if err != nil && !dbutil.IsDBNotFound(err) {
+		return nil, err
+	}
+	// 正在打包或者还能下载时不重复导出
+	if latest != nil && (latest.Status == constant.DataExportPending || o.DataExport.downloadURL(latest) != "") {
+		return &chatpb.RequestDataExportResp{Export: convert.DataExportDB2Pb(latest, o.DataExport.downloadURL(latest))}, nil
+	}
+	export := &chat.DataExport{
+		ExportID:   uuid.New().String(),
+		UserID:     userID,
+		Status:     constant.DataExportPending,
+		CreateTime: time.Now(),
+	}
+	if err := o.Database.CreateDataExport(ctx, export); err != nil {
+		return nil, err
+	}
+	return &chatpb.RequestDataExportResp{Export: convert.DataExportDB2Pb(export, "")}, nil
+}
+
+func (o *chatSvr) GetDataExport(ctx context.Context, req *chatpb.GetDataExportReq) (*chatpb.GetDataExportResp, error) {
+	if !o.DataExport.enabled() {
+		return nil, errs.ErrInternalServer.WrapMsg("data export is not enabled")
+	}
+	userID, err := mctx.CheckUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	export, err := o.Database.GetLatestDataExport(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return &chatpb.GetDataExportResp{Export: convert.DataExportDB2Pb(export, o.DataExport.downloadURL(export))}, nil
+}
+
+// OpenDataExport 下载链接不需要登录，凭签名领取压缩包的下载，同一时间只有一个下载，传输完成前任务仍然可以下载
+func (o *chatSvr) OpenDataExport(ctx context.Context, req *chatpb.OpenDataExportReq) (*chatpb.OpenDataExportResp, error) {
+	if !o.DataExport.enabled() {
+		return nil, errs.ErrInternalServer.WrapMsg("data export is not enabled")
+	}
+	exportID, err := o.DataExport.verify(req.Token, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	claim := uuid.New().String()
+	export, err := o.Database.ClaimDataExportDownload(ctx, exportID, claim, o.DataExport.Lease)
+	if err != nil {
+		if !dbutil.IsDBNotFound(err) {
+			return nil, err
+		}
+		return nil, o.notDownloadable(ctx, exportID, "data export is being downloaded")
+	}
+	return &chatpb.OpenDataExportResp{
+		Key:      export.Key,
+		FileName: "data_export_" + export.FinishTime.Format("20060102") + ".zip",
+		Size:     export.Size,
+		Claim:    claim,
+	}, nil
+}
+
+// ConsumeDataExport 压缩包完整发送后标记为已下载，链接只能成功下载一次
+func (o *chatSvr) ConsumeDataExport(ctx context.Context, req *chatpb.ConsumeDataExportReq) (*chatpb.ConsumeDataExportResp, error) {
+	if !o.DataExport.enabled() {
+		return nil, errs.ErrInternalServer.WrapMsg("data export is not enabled")
+	}
+	exportID, err := o.DataExport.verify(req.Token, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	if _, err := o.Database.ConsumeDataExport(ctx, exportID, req.Claim); err != nil {
+		if !dbutil.IsDBNotFound(err) {
+			return nil, err
+		}
+		return nil, o.notDownloadable(ctx, exportID, "data export not claimed")
+	}
+	return &chatpb.ConsumeDataExportResp{}, nil
+}
+
+// ReleaseDataExport 传输失败后释放下载，链接可以重新下载
+func (o *chatSvr) ReleaseDataExport(ctx context.Context, req *chatpb.ReleaseDataExportReq) (*chatpb.ReleaseDataExportResp, error) {
+	if !o.DataExport.enabled() {
+		return nil, errs.ErrInternalServer.WrapMsg("data export is not enabled")
+	}
+	exportID, err := o.DataExport.verify(req.Token, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	if err := o.Database.ReleaseDataExportDownload(ctx, exportID, req.Claim); err != nil {
+		return nil, err
+	}
+	return &chatpb.ReleaseDataExportResp{}, nil
+}
+
+// notDownloadable 领取或者标记失败时说明原因
+func (o *chatSvr) notDownloadable(ctx context.Context, exportID string, msg string) error {
+	export, err := o.Database.GetDataExport(ctx, exportID)
+	if err != nil {
+		return err
+	}
+	if err := checkDownloadable(export); err != nil {
+		return err
+	}
+	return eerrs.ErrDataExportLinkInvalid.WrapMsg(msg)
+}
+
+func checkDownloadable(export *chat.DataExport) error {
+	if export.Status == constant.DataExportDownloaded {
+		return eerrs.ErrDataExportLinkUsed.WrapMsg("data export already downloaded")
+	}
+	if export.Status != constant.DataExportReady || !export.ExpireAt.After(time.Now()) {
+		return eerrs.ErrDataExportLinkInvalid.WrapMsg("data export not downloadable")
+	}
+	return nil
+}
+
+// runDataExport 定期打包待导出的数据，并删除下载链接已过期的压缩包
+func (o *chatSvr) runDataExport(ctx context.Context) {
+	ticker := time.NewTicker(o.DataExport.Interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			ctx := mcontext.SetOperationID(ctx, "data_export_"+strconv.FormatInt(time.Now().UnixMilli(), 10))
+			o.packPendingExports(ctx)
+			o.purgeExpiredExports(ctx)
+		}
+	}
+}
+
+func (o *chatSvr) packPendingExports(ctx context.Context) {
+	for i := 0; i < dataExportBatch; i++ {
+		export, err := o.Database.ClaimPendingDataExport(ctx, o.DataExport.Owner, o.DataExport.Lease)
+		if err != nil {
+			if !dbutil.IsDBNotFound(err) {
+				log.ZError(ctx, "claim data export failed", err)
+			}
+			return
+		}
+		// 失败的任务等租约过期后重试
+		if err := o.packExport(ctx, export); err != nil {
+			log.ZError(ctx, "pack data export failed", err, "exportID", export.ExportID, "userID", export.UserID)
+		}
+	}
+}
+
+func (o *chatSvr) packExport(ctx context.Context, export *chat.DataExport) error {
+	f, err := os.CreateTemp("", "data_export_*.zip")
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	defer os.Remove(f.Name())
+	defer f.Close()
+	if err := o.writeUserData(ctx, export.UserID, f); err != nil {
+		return err
+	}
+	size, err := f.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		return errs.Wrap(err)
+	}
+	// 路径由任务决定，租约过期后重试会覆盖同一个文件
+	key := "data_export/" + export.UserID + "/" + export.ExportID + ".zip"
+	if err := o.Storage.Put(ctx, key, f, size); err != nil {
+		return err
+	}
+	finished, err := o.Database.FinishDataExport(ctx, export.ExportID, o.DataExport.Owner, key, size, time.Now().Add(o.DataExport.LinkExpire))
+	if err != nil {
+		return err
+	}
+	if !finished {
+		// 打包期间账号被注销时任务已经删除，压缩包不会再被清理
+		if _, err := o.Database.GetDataExport(ctx, export.ExportID); dbutil.IsDBNotFound(err) {
+			if err := o.Storage.Delete(ctx, key); err != nil {
+				log.ZError(ctx, "delete orphan data export failed", err, "exportID", export.ExportID, "key", key)
+			}
+		}
+		return errs.ErrNoPermission.WrapMsg("data export lease lost", "exportID", export.ExportID)
+	}
+	log.ZInfo(ctx, "data export packed", "exportID", export.ExportID, "userID", export.UserID, "size", size)
+	return nil
+}
+
+// exportMedia 媒体清单中的一项，压缩包里只有地址不包含文件
+type exportMedia struct {
+	PostID string `bson:"post_id,omitempty"`
+	Type   string `bson:"type"`
+	URL    string `bson:"url"`
+}
+
+// writeUserData 把用户资料、帖子和评论、互动、保存的群和登录记录写成 zip 中的 JSON 文件
+func (o *chatSvr) writeUserData(ctx context.Context, userID string, w io.Writer) error {
+	attribute, err := o.Database.GetAttribute(ctx, userID)
+	if err != nil {
+		return err
+	}
+	posts, err := o.Database.FindUserPosts(ctx, userID)
+	if err != nil {
+		return err
+	}
+	relations, err := o.Database.FindUserPostRelations(ctx, userID)
+	if err != nil {
+		return err
+	}
+	groupIDs := []string{}
+	contact, err := o.Database.GetGroupFromContact(ctx, userID)
+	if err == nil {
+		groupIDs = contact.GroupIDs
+	} else if !dbutil.IsDBNotFound(err) {
+		return err
+	}
+	loginRecords, err := o.Database.FindUserLoginRecords(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	var (
+		published = make([]*chat.PostDB, 0, len(posts))
+		comments  = make([]*chat.PostDB, 0)
+		media     = make([]*exportMedia, 0)
+	)
+	addMedia := func(postID, typ, url string) {
+		if url != "" {
+			media = append(media, &exportMedia{PostID: postID, Type: typ, URL: url})
+		}
+	}
+	addMedia("", "face", attribute.FaceURL)
+	addMedia("", "cover", attribute.CoverURL)
+	for _, post := range posts {
+		if post.CommentPostID == "" {
+			published = append(published, post)
+		} else {
+			comments = append(comments, post)
+		}
+		for _, m := range post.MediaMsgs {
+			switch m.MediaType {
+			case constant.PostMediaTypePicture:
+				addMedia(post.PostID, "picture", m.PostPicture.SourcePicture.URL)
+			case constant.PostMediaTypeVideo:
+				addMedia(post.PostID, "video", m.PostVideo.VideoURL)
+				addMedia(post.PostID, "video_snapshot", m.PostVideo.SnapshotURL)
+			}
+		}
+	}
+
+	zw := zip.NewWriter(w)
+	for _, file := range []struct {
+		name string
+		data any
+	}{
+		{"profile.json", attribute},
+		{"posts.json", published},
+		{"comments.json", comments},
+		{"interactions.json", relations},
+		{"group_contacts.json", groupIDs},
+		{"login_records.json", loginRecords},
+		{"media_manifest.json", media},
+	} {
+		data, err := exportJSON(file.data)
+		if err != nil {
+			return err
+		}
+		fw, err := zw.Create(file.name)
+		if err != nil {
+			return errs.Wrap(err)
+		}
+		if _, err := fw.Write(data); err != nil {
+			return errs.Wrap(err)
+		}
+	}
+	return errs.Wrap(zw.Close())
+}
+
+// exportJSON 按 bson 标签转成 JSON，导出的字段名和数据库里的一致
+func exportJSON(v any) ([]byte, error) {
+	raw, err := bson.Marshal(bson.M{"data": v})
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	var doc bson.M
+	if err := bson.Unmarshal(raw, &doc); err != nil {
+		return nil, errs.Wrap(err)
+	}
+	data, err := json.MarshalIndent(doc["data"], "", "  ")
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return data, nil
+}
+
+func (o *chatSvr) purgeExpiredExports(ctx context.Context) {
+	exports, err := o.Database.FindExpiredDataExports(ctx, dataExportPurge)
+	if err != nil {
+		log.ZError(ctx, "find expired data exports failed", err)
+		return
+	}
+	for _, export := range exports {
+		if err := o.Storage.Delete(ctx, export.Key); err != nil {
+			log.ZError(ctx, "delete data export failed", err, "exportID", export.ExportID, "key", export.Key)
+			continue
+		}
+		if err := o.Database.ExpireDataExport(ctx, export.ExportID); err != nil {
+			log.ZError(ctx, "expire data export failed", err, "exportID", export.ExportID)
+		}
+	}
+}
@@ -0,0 +1,356 @@
+package chat
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/url"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/openimsdk/tools/errs"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/openimsdk/chat/pkg/common/constant"
+	chatdb "github.com/openimsdk/chat/pkg/common/db/table/chat"
+	"github.com/openimsdk/chat/pkg/common/mctx"
+	"github.com/openimsdk/chat/pkg/eerrs"
+	"github.com/openimsdk/chat/pkg/protocol/chat"
+)
+
+func (d *memDatabase) find(exportID string) *chatdb.DataExport {
+	for _, export := range d.exports {
+		if export.ExportID == exportID {
+			return export
+		}
+	}
+	return nil
+}
+
+func (d *memDatabase) CreateDataExport(_ context.Context, export *chatdb.DataExport) error {
+	c := *export
+	d.exports = append(d.exports, &c)
+	return nil
+}
+
+func (d *memDatabase) GetDataExport(_ context.Context, exportID string) (*chatdb.DataExport, error) {
+	if export := d.find(exportID); export != nil {
+		c := *export
+		return &c, nil
+	}
+	return nil, errs.Wrap(mongo.ErrNoDocuments)
+}
+
+func (d *memDatabase) GetLatestDataExport(_ context.Context, userID string) (*chatdb.DataExport, error) {
+	for i := len(d.exports) - 1; i >= 0; i-- {
+		if d.exports[i].UserID == userID {
+			c := *d.exports[i]
+			return &c, nil
+		}
+	}
+	return nil, errs.Wrap(mongo.ErrNoDocuments)
+}
+
+func (d *memDatabase) ClaimPendingDataExport(_ context.Context, owner string, lease time.Duration) (*chatdb.DataExport, error) {
+	now := time.Now()
+	for _, export := range d.exports {
+		if export.Status == constant.DataExportPending && !export.LeaseUntil.After(now) {
+			export.LeaseOwner, export.LeaseUntil = owner, now.Add(lease)
+			c := *export
+			return &c, nil
+		}
+	}
+	return nil, errs.Wrap(mongo.ErrNoDocuments)
+}
+
+func (d *memDatabase) FinishDataExport(_ context.Context, exportID string, owner string, key string, size int64, expireAt time.Time) (bool, error) {
+	export := d.find(exportID)
+	if export == nil || export.LeaseOwner != owner || export.Status != constant.DataExportPending {
+		return false, nil
+	}
+	export.Status, export.Key, export.Size, export.ExpireAt, export.FinishTime, export.LeaseUntil = constant.DataExportReady, key, size, expireAt, time.Now(), time.Time{}
+	return true, nil
+}
+
+func (d *memDatabase) ClaimDataExportDownload(_ context.Context, exportID string, owner string, lease time.Duration) (*chatdb.DataExport, error) {
+	now := time.Now()
+	export := d.find(exportID)
+	if export == nil || export.Status != constant.DataExportReady || !export.ExpireAt.After(now) || export.LeaseUntil.After(now) {
+		return nil, errs.Wrap(mongo.ErrNoDocuments)
+	}
+	export.LeaseOwner, export.LeaseUntil = owner, now.Add(lease)
+	c := *export
+	return &c, nil
+}
+
+func (d *memDatabase) ConsumeDataExport(_ context.Context, exportID string, owner string) (*chatdb.DataExport, error) {
+	export := d.find(exportID)
+	if export == nil || export.LeaseOwner != owner || export.Status != constant.DataExportReady || !export.ExpireAt.After(time.Now()) {
+		return nil, errs.Wrap(mongo.ErrNoDocuments)
+	}
+	export.Status, export.LeaseUntil = constant.DataExportDownloaded, time.Time{}
+	c := *export
+	return &c, nil
+}
+
+func (d *memDatabase) ReleaseDataExportDownload(_ context.Context, exportID string, owner string) error {
+	if export := d.find(exportID); export != nil && export.LeaseOwner == owner && export.Status == constant.DataExportReady {
+		export.LeaseUntil = time.Time{}
+	}
+	return nil
+}
+
+func (d *memDatabase) FindExpiredDataExports(_ context.Context, limit int64) ([]*chatdb.DataExport, error) {
+	var res []*chatdb.DataExport
+	for _, export := range d.exports {
+		if (export.Status == constant.DataExportReady || export.Status == constant.DataExportDownloaded) && !export.ExpireAt.After(time.Now()) {
+			res = append(res, export)
+		}
+	}
+	return res, nil
+}
+
+func (d *memDatabase) ExpireDataExport(_ context.Context, exportID string) error {
+	export := d.find(exportID)
+	export.Status, export.Key = constant.DataExportExpired, ""
+	return nil
+}
+
+func (d *memDatabase) FindUserPosts(_ context.Context, userID string) ([]*chatdb.PostDB, error) {
+	var posts []*chatdb.PostDB
+	for _, p := range d.posts {
+		if p.UserID == userID {
+			posts = append(posts, &chatdb.PostDB{PostID: p.PostID, UserID: p.UserID, CommentPostID: p.CommentPostID, Content: p.Content, MediaMsgs: p.MediaMsgs})
+		}
+	}
+	sort.Slice(posts, func(i, j int) bool { return posts[i].PostID < posts[j].PostID })
+	return posts, nil
+}
+
+func (d *memDatabase) FindUserPostRelations(_ context.Context, userID string) ([]*chatdb.UserPostRelation, error) {
+	var relations []*chatdb.UserPostRelation
+	for key, value := range d.flags {
+		if parts := strings.Split(key, "/"); parts[0] == userID && parts[2] == "is_liked" {
+			relations = append(relations, &chatdb.UserPostRelation{UserID: userID, PostID: parts[1], IsLiked: value})
+		}
+	}
+	return relations, nil
+}
+
+func (d *memDatabase) GetGroupFromContact(context.Context, string) (*chatdb.Contact, error) {
+	return nil, errs.Wrap(mongo.ErrNoDocuments)
+}
+
+func (d *memDatabase) FindUserLoginRecords(_ context.Context, userID string) ([]*chatdb.UserLoginRecord, error) {
+	var records []*chatdb.UserLoginRecord
+	for _, record := range d.logins {
+		if record.UserID == userID {
+			records = append(records, record)
+		}
+	}
+	return records, nil
+}
+
+// newExportSvr returns a server holding the profile, posts, likes and logins of u1.
+func newExportSvr(t *testing.T) (*chatSvr, *memDatabase) {
+	svr, db := newTestSvr(t)
+	db.attributes["u1"] = &chatdb.Attribute{UserID: "u1", Nickname: "owl", FaceURL: "https://cdn/face.png"}
+	addPosts(db,
+		&chatdb.Post{PostID: "p1", UserID: "u1", Content: "hello", MediaMsgs: []*chatdb.PostMedia{{
+			MediaType:   constant.PostMediaTypePicture,
+			PostPicture: chatdb.PostPicture{SourcePicture: chatdb.PictureBaseInfo{URL: "https://cdn/p1.png"}},
+		}}},
+		&chatdb.Post{PostID: "c1", UserID: "u1", CommentPostID: "p0", Content: "nice"},
+	)
+	db.flags["u1/p0/is_liked"] = 1
+	db.logins = append(db.logins, &chatdb.UserLoginRecord{UserID: "u1", IP: "10.0.0.1", LoginTime: time.Now()})
+	return svr, db
+}
+
+func downloadToken(t *testing.T, link string) string {
+	u, err := url.Parse(link)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return u.Query().Get("token")
+}
+
+func TestDataExportDownloadOnce(t *testing.T) {
+	svr, _ := newExportSvr(t)
+	ctx := mctx.WithOpUserID(context.Background(), "u1", constant.NormalUser)
+
+	requested, err := svr.RequestDataExport(ctx, &chat.RequestDataExportReq{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	again, err := svr.RequestDataExport(ctx, &chat.RequestDataExportReq{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if again.Export.ExportID != requested.Export.ExportID {
+		t.Fatal("second request while packing created another export")
+	}
+
+	svr.packPendingExports(context.Background())
+	status, err := svr.GetDataExport(ctx, &chat.GetDataExportReq{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if status.Export.Status != constant.DataExportReady || !strings.HasPrefix(status.Export.DownloadURL, svr.DataExport.DownloadURL+"?token=") {
+		t.Fatalf("unexpected status %+v", status.Export)
+	}
+	token := downloadToken(t, status.Export.DownloadURL)
+
+	if _, err := svr.OpenDataExport(context.Background(), &chat.OpenDataExportReq{Token: token + "x"}); !isCode(err, eerrs.ErrDataExportLinkInvalid) {
+		t.Fatalf("tampered token: want DataExportLinkInvalid, got %v", err)
+	}
+	interrupted, err := svr.OpenDataExport(context.Background(), &chat.OpenDataExportReq{Token: token})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// only one download holds the export at a time
+	if _, err := svr.OpenDataExport(context.Background(), &chat.OpenDataExportReq{Token: token}); !isCode(err, eerrs.ErrDataExportLinkInvalid) {
+		t.Fatalf("concurrent download: want DataExportLinkInvalid, got %v", err)
+	}
+	// the first download was interrupted, the link works again once its claim is released
+	if _, err := svr.ReleaseDataExport(context.Background(), &chat.ReleaseDataExportReq{Token: token, Claim: interrupted.Claim}); err != nil {
+		t.Fatal(err)
+	}
+	opened, err := svr.OpenDataExport(context.Background(), &chat.OpenDataExportReq{Token: token})
+	if err != nil {
+		t.Fatalf("retry after interrupted download: %v", err)
+	}
+	// a released claim can neither complete the download nor release the new one
+	if _, err := svr.ConsumeDataExport(context.Background(), &chat.ConsumeDataExportReq{Token: token, Claim: interrupted.Claim}); !isCode(err, eerrs.ErrDataExportLinkInvalid) {
+		t.Fatalf("consume with released claim: want DataExportLinkInvalid, got %v", err)
+	}
+	if _, err := svr.ReleaseDataExport(context.Background(), &chat.ReleaseDataExportReq{Token: token, Claim: interrupted.Claim}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := svr.OpenDataExport(context.Background(), &chat.OpenDataExportReq{Token: token}); !isCode(err, eerrs.ErrDataExportLinkInvalid) {
+		t.Fatalf("stale release freed the claim: want DataExportLinkInvalid, got %v", err)
+	}
+	if _, err := svr.ConsumeDataExport(context.Background(), &chat.ConsumeDataExportReq{Token: token, Claim: opened.Claim}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := svr.OpenDataExport(context.Background(), &chat.OpenDataExportReq{Token: token}); !isCode(err, eerrs.ErrDataExportLinkUsed) {
+		t.Fatalf("download after completion: want DataExportLinkUsed, got %v", err)
+	}
+	if _, err := svr.ConsumeDataExport(context.Background(), &chat.ConsumeDataExportReq{Token: token, Claim: opened.Claim}); !isCode(err, eerrs.ErrDataExportLinkUsed) {
+		t.Fatalf("second consume: want DataExportLinkUsed, got %v", err)
+	}
+
+	r, err := svr.Storage.Get(context.Background(), opened.Key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := io.ReadAll(r)
+	r.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if int64(len(data)) != opened.Size {
+		t.Fatalf("size %d, archive has %d bytes", opened.Size, len(data))
+	}
+	zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	files := make(map[string][]map[string]any)
+	for _, f := range zr.File {
+		rc, err := f.Open()
+		if err != nil {
+			t.Fatal(err)
+		}
+		var v any
+		if err := json.NewDecoder(rc).Decode(&v); err != nil {
+			t.Fatalf("%s: %v", f.Name, err)
+		}
+		rc.Close()
+		switch v := v.(type) {
+		case map[string]any:
+			files[f.Name] = []map[string]any{v}
+		case []any:
+			for _, item := range v {
+				if m, ok := item.(map[string]any); ok {
+					files[f.Name] = append(files[f.Name], m)
+				}
+			}
+			if files[f.Name] == nil {
+				files[f.Name] = []map[string]any{}
+			}
+		}
+	}
+	if files["profile.json"][0]["nickname"] != "owl" {
+		t.Fatalf("profile not exported with stored field names: %v", files["profile.json"])
+	}
+	if len(files["posts.json"]) != 1 || len(files["comments.json"]) != 1 || len(files["interactions.json"]) != 1 || len(files["login_records.json"]) != 1 {
+		t.Fatalf("unexpected archive content: %v", files)
+	}
+	if _, ok := files["group_contacts.json"]; !ok {
+		t.Fatal("group contacts missing")
+	}
+	if len(files["media_manifest.json"]) != 2 {
+		t.Fatalf("want face and post picture in the media manifest, got %v", files["media_manifest.json"])
+	}
+}
+
+func TestDataExportExpire(t *testing.T) {
+	svr, db := newExportSvr(t)
+	ctx := mctx.WithOpUserID(context.Background(), "u1", constant.NormalUser)
+	if _, err := svr.RequestDataExport(ctx, &chat.RequestDataExportReq{}); err != nil {
+		t.Fatal(err)
+	}
+	svr.packPendingExports(context.Background())
+	status, err := svr.GetDataExport(ctx, &chat.GetDataExportReq{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	token := downloadToken(t, status.Export.DownloadURL)
+	key := db.exports[0].Key
+
+	// the token carries the expiry it was signed with
+	if _, err := svr.DataExport.verify(token, time.Now().Add(2*time.Hour)); !isCode(err, eerrs.ErrDataExportLinkInvalid) {
+		t.Fatalf("expired token: want DataExportLinkInvalid, got %v", err)
+	}
+
+	db.exports[0].ExpireAt = time.Now().Add(-time.Second)
+	svr.purgeExpiredExports(context.Background())
+	if db.exports[0].Status != constant.DataExportExpired {
+		t.Fatalf("export status %d after purge", db.exports[0].Status)
+	}
+	if _, err := svr.Storage.Get(context.Background(), key); err == nil {
+		t.Fatal("archive kept after the link expired")
+	}
+
+	// an expired export does not block a new one
+	requested, err := svr.RequestDataExport(ctx, &chat.RequestDataExportReq{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if requested.Export.ExportID == db.exports[0].ExportID || requested.Export.Status != constant.DataExportPending {
+		t.Fatalf("unexpected export %+v", requested.Export)
+	}
+}
+
+func TestDataExportDisabledWithoutSecret(t *testing.T) {
+	svr, db := newExportSvr(t)
+	svr.DataExport.Secret = nil
+	ctx := mctx.WithOpUserID(context.Background(), "u1", constant.NormalUser)
+
+	if _, err := svr.RequestDataExport(ctx, &chat.RequestDataExportReq{}); !isCode(err, errs.ErrInternalServer) {
+		t.Fatalf("request: want InternalServer, got %v", err)
+	}
+	if len(db.exports) != 0 {
+		t.Fatal("export created while disabled")
+	}
+	// a token signed with the empty key is not accepted either
+	token := svr.DataExport.sign("e1", time.Now().Add(time.Hour).UnixMilli())
+	if _, err := svr.ConsumeDataExport(context.Background(), &chat.ConsumeDataExportReq{Token: token}); !isCode(err, errs.ErrInternalServer) {
+		t.Fatalf("consume: want InternalServer, got %v", err)
+	}
+}
@@ -16,12 +16,17 @@ package chat
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"math/rand"
 	"strconv"
 	"strings"
 	"time"
 
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+
 	constantpb "github.com/openimsdk/chat/pkg/protocol/constant"
 	"github.com/openimsdk/tools/utils/datautil"
 
@@ -33,7 +38,9 @@ import (
 	"github.com/openimsdk/chat/pkg/common/db/dbutil"
 	chatdb "github.com/openimsdk/chat/pkg/common/db/table/chat"
 	"github.com/openimsdk/chat/pkg/eerrs"
+	"github.com/openimsdk/chat/pkg/protocol/admin"
 	"github.com/openimsdk/chat/pkg/protocol/chat"
+	"github.com/openimsdk/chat/pkg/siwe"
 )
 
 func (o *chatSvr) verifyCodeJoin(areaCode, phoneNumber string) string {
@@ -122,7 +129,7 @@ func (o *chatSvr) SendVerifyCode(ctx context.Context, req *chat.SendVerifyCodeRe
 			return nil, errs.ErrInternalServer.WrapMsg("email verification code is not enabled")
 		}
 		sendCode = func() error {
-			return o.Mail.SendMail(ctx, req.Email, code)
+			return o.Mail.SendMail(ctx, req.Email, code, req.UsedFor, req.Language)
 		}
 		account = req.Email
 	} else {
@@ -240,40 +247,165 @@ func (o *chatSvr) genVerifyCode() string {
 }
 
 func (o *chatSvr) ChallengeNonce(ctx context.Context, req *chat.ChallengeNonceReq) (*chat.ChallengeNonceResp, error) {
-	resp := &chat.ChallengeNonceResp{}
-	nonce, err := generateNonce(16)
+	address := req.Address
+	if address == "" {
+		if req.PublicKey == "" {
+			return nil, errs.ErrArgs.WrapMsg("address or public key must be set")
+		}
+		var err error
+		if address, err = publicKeyToAddress(req.PublicKey); err != nil {
+			return nil, err
+		}
+	} else if !common.IsHexAddress(address) {
+		return nil, errs.ErrArgs.WrapMsg("address is invalid")
+	}
+	// the nonce is signed directly as a secp256k1 digest, so it must be 32 bytes
+	nonce, err := generateNonce(crypto.DigestLength)
 	if err != nil {
 		return nil, errs.ErrInternalServer.WrapMsg("nonce generate failed!")
 	}
-	resp.Nonce = nonce
-
+	now := time.Now()
+	loginNonce := &chatdb.LoginNonce{
+		Nonce:      nonce,
+		Address:    address,
+		DeviceID:   req.DeviceID,
+		Used:       false,
+		CreateTime: now,
+		ExpireTime: now.Add(o.NonceExpire),
+	}
+	if err := o.Database.AddLoginNonce(ctx, loginNonce); err != nil {
+		return nil, err
+	}
+	resp := &chat.ChallengeNonceResp{Nonce: nonce, ExpireTime: loginNonce.ExpireTime.UnixMilli()}
+	if o.SignIn.Enable {
+		msg := siwe.Message{
+			Domain:         o.SignIn.Domain,
+			Address:        address,
+			Statement:      o.SignIn.Statement,
+			URI:            o.SignIn.URI,
+			ChainID:        o.SignIn.ChainID,
+			Nonce:          nonce,
+			IssuedAt:       now,
+			ExpirationTime: loginNonce.ExpireTime,
+		}
+		resp.Message = msg.String()
+	}
 	return resp, nil
 }
 
+// useNonce checks that nonce was issued to address (and deviceID) and is still valid,
+// runs fn to verify the signature over it, then consumes the nonce so it cannot be replayed.
+func (o *chatSvr) useNonce(ctx context.Context, address string, deviceID string, nonce string, fn func() error) error {
+	if nonce == "" {
+		return errs.ErrArgs.WrapMsg("nonce is empty")
+	}
+	loginNonce, err := o.Database.TakeLoginNonce(ctx, nonce)
+	if err != nil {
+		if dbutil.IsDBNotFound(err) {
+			return eerrs.ErrNonceNotFound.Wrap()
+		}
+		return err
+	}
+	if !sameAddress(loginNonce.Address, address) {
+		return eerrs.ErrNonceNotMatch.WrapMsg("nonce was issued to another address")
+	}
+	if loginNonce.DeviceID != "" && loginNonce.DeviceID != deviceID {
+		return eerrs.ErrNonceNotMatch.WrapMsg("nonce was issued to another device")
+	}
+	if loginNonce.Used {
+		return eerrs.ErrNonceUsed.Wrap()
+	}
+	if !loginNonce.ExpireTime.After(time.Now()) {
+		return eerrs.ErrNonceExpired.Wrap()
+	}
+	if fn != nil {
+		if err := fn(); err != nil {
+			return err
+		}
+	}
+	used, err := o.Database.UseLoginNonce(ctx, nonce)
+	if err != nil {
+		return err
+	}
+	if !used {
+		return eerrs.ErrNonceUsed.Wrap()
+	}
+	return nil
+}
+
+// signedDigest returns the nonce a login proof is bound to and the digest that was signed.
+// An EIP-4361 message is validated and hashed with the personal_sign prefix, otherwise
+// the raw hex nonce itself is the signed digest.
+func (o *chatSvr) signedDigest(address, nonce, message string) (string, []byte, error) {
+	if message == "" {
+		digest, err := hexutil.Decode(nonce)
+		if err != nil {
+			return "", nil, errs.ErrArgs.WrapMsg("nonce is invalid")
+		}
+		return nonce, digest, nil
+	}
+	if !o.SignIn.Enable {
+		return "", nil, errs.ErrArgs.WrapMsg("sign-in with ethereum is not enabled")
+	}
+	msg, err := siwe.Parse(message)
+	if err != nil {
+		return "", nil, errs.ErrArgs.WrapMsg(err.Error())
+	}
+	if err := msg.Validate(o.SignIn.Domain, address, o.SignIn.ChainID, time.Now()); err != nil {
+		if errors.Is(err, siwe.ErrExpired) {
+			return "", nil, eerrs.ErrNonceExpired.WrapMsg(err.Error())
+		}
+		return "", nil, errs.ErrArgs.WrapMsg(err.Error())
+	}
+	return msg.Nonce, siwe.Hash(message), nil
+}
+
+// verifySignature checks that signature over digest was produced by the key behind address.
+// publicKey is optional, without it the signer is recovered from the signature.
+func (o *chatSvr) verifySignature(address, publicKey string, digest []byte, signature string) func() error {
+	return func() error {
+		signer, err := signerAddress(publicKey, digest, signature)
+		if err != nil {
+			return err
+		}
+		if !sameAddress(signer, address) {
+			return errs.ErrNoPermission.WrapMsg("signature does not belong to address")
+		}
+		return nil
+	}
+}
+
 func (o *chatSvr) RegisterUser(ctx context.Context, req *chat.RegisterUserReq) (*chat.RegisterUserResp, error) {
 	resp := &chat.RegisterUserResp{}
-	var validSig bool
-	var err error
-	if validSig, err = validateSignature(req.User.PublicKey, req.Nonce, req.Signature); err != nil {
+	if req.User == nil {
+		return nil, errs.ErrArgs.WrapMsg("user is nil")
+	}
+	if !common.IsHexAddress(req.User.Address) {
+		return nil, errs.ErrArgs.WrapMsg("address is invalid")
+	}
+	req.User.Address = checksumAddress(req.User.Address)
+	if _, _, err := o.takeWallet(ctx, req.User.Address); err == nil {
+		return nil, eerrs.ErrWalletAlreadyLinked.WrapMsg("address already registered")
+	} else if !dbutil.IsDBNotFound(err) {
 		return nil, err
 	}
-	if !validSig {
-		return nil, errs.ErrArgs.WrapMsg("signature is wrong!")
+	nonce, digest, err := o.signedDigest(req.User.Address, req.Nonce, req.Message)
+	if err != nil {
+		return nil, err
+	}
+	if err := o.useNonce(ctx, req.User.Address, req.DeviceID, nonce, o.verifySignature(req.User.Address, req.User.PublicKey, digest, req.Signature)); err != nil {
+		return nil, err
 	}
 	isAdmin, err := o.Admin.CheckNilOrAdmin(ctx)
 	ctx = o.WithAdminUser(ctx)
 	if err != nil {
 		return nil, err
 	}
-	if req.User == nil {
-		return nil, errs.ErrArgs.WrapMsg("user is nil")
-	}
 	// if req.User.Email == "" {
 	// 	if (req.User.AreaCode == "" && req.User.PhoneNumber != "") || (req.User.AreaCode != "" && req.User.PhoneNumber == "") {
 	// 		return nil, errs.ErrArgs.WrapMsg("area code or phone number error, no email provide")
 	// 	}
 	// }
-	// var usedInvitationCode bool
 	if !isAdmin {
 		if req.User.UserID != "" {
 			return nil, errs.ErrNoPermission.WrapMsg("only admin can set user id")
@@ -281,19 +413,13 @@ func (o *chatSvr) RegisterUser(ctx context.Context, req *chat.RegisterUserReq) (
 		if err := o.Admin.CheckRegister(ctx, req.Ip); err != nil {
 			return nil, err
 		}
-		// conf, err := o.Admin.GetConfig(ctx)
-		// if err != nil {
-		// 	return nil, err
-		// }
-		// if val := conf[constant.NeedInvitationCodeRegisterConfigKey]; datautil.Contain(strings.ToLower(val), "1", "true", "yes") {
-		// 	usedInvitationCode = true
-		// 	if req.InvitationCode == "" {
-		// 		return nil, errs.ErrArgs.WrapMsg("invitation code is empty")
-		// 	}
-		// 	if err := o.Admin.CheckInvitationCode(ctx, req.InvitationCode); err != nil {
-		// 		return nil, err
-		// 	}
-		// }
+		conf, err := o.Admin.GetConfig(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if val := conf[constant.NeedInvitationCodeRegisterConfigKey]; datautil.Contain(strings.ToLower(val), "1", "true", "yes") && req.InvitationCode == "" {
+			return nil, errs.ErrArgs.WrapMsg("invitation code is empty")
+		}
 		// if req.User.Email == "" {
 		// 	if _, err := o.verifyCode(ctx, o.verifyCodeJoin(req.User.AreaCode, req.User.PhoneNumber), req.VerifyCode); err != nil {
 		// 		return nil, err
@@ -401,18 +527,33 @@ func (o *chatSvr) RegisterUser(ctx context.Context, req *chat.RegisterUserReq) (
 		AllowAddFriend: constant.DefaultAllowAddFriend,
 		RegisterType:   registerType,
 	}
+	if req.InvitationCode != "" {
+		// the use is taken before the user is created, so concurrent registrations cannot go over the code's limit
+		if err := o.Admin.UseInvitationCode(ctx, req.User.UserID, req.InvitationCode); err != nil {
+			return nil, err
+		}
+	}
 	if err := o.Database.RegisterUser(ctx, register, account, attribute); err != nil {
+		if req.InvitationCode != "" {
+			// the user was not created, the use must not count for it
+			if err := o.Admin.ReleaseInvitationCode(context.WithoutCancel(ctx), req.User.UserID, req.InvitationCode); err != nil {
+				log.ZError(ctx, "release invitation code failed", err, "userID", req.User.UserID, "code", req.InvitationCode)
+			}
+		}
 		return nil, err
 	}
-	// if usedInvitationCode {
-	// 	if err := o.Admin.UseInvitationCode(ctx, req.User.UserID, req.InvitationCode); err != nil {
-	// 		log.ZError(ctx, "UseInvitationCode", err, "userID", req.User.UserID, "invitationCode", req.InvitationCode)
-	// 	}
-	// }
 	if req.AutoLogin {
-		chatToken, err := o.Admin.CreateToken(ctx, req.User.UserID, constant.NormalUser)
+		chatToken, err := o.Admin.CreateToken(ctx, &admin.CreateTokenReq{
+			UserID:    req.User.UserID,
+			UserType:  constant.NormalUser,
+			DeviceID:  req.DeviceID,
+			Platform:  req.Platform,
+			Ip:        req.Ip,
+			UserAgent: req.UserAgent,
+		})
 		if err == nil {
 			resp.ChatToken = chatToken.Token
+			resp.RefreshToken = chatToken.RefreshToken
 		} else {
 			log.ZError(ctx, "Admin CreateToken Failed", err, "userID", req.User.UserID, "platform", req.Platform)
 		}
@@ -423,11 +564,7 @@ func (o *chatSvr) RegisterUser(ctx context.Context, req *chat.RegisterUserReq) (
 
 func (o *chatSvr) Login(ctx context.Context, req *chat.LoginReq) (*chat.LoginResp, error) {
 	resp := &chat.LoginResp{}
-	var err error
-	var attribute *chatdb.Attribute
-	if req.Address != "" {
-		attribute, err = o.Database.GetAttributeByAddress(ctx, req.Address)
-	}
+	attribute, wallet, err := o.takeWallet(ctx, req.Address)
 	if err != nil {
 		if dbutil.IsDBNotFound(err) {
 			return nil, eerrs.ErrAccountNotFound.WrapMsg("user unregistered")
@@ -437,15 +574,27 @@ func (o *chatSvr) Login(ctx context.Context, req *chat.LoginReq) (*chat.LoginRes
 	if err := o.Admin.CheckLogin(ctx, attribute.UserID, req.Ip); err != nil {
 		return nil, err
 	}
-
-	var validSig bool
-	if validSig, err = validateSignature(req.PublicKey, req.Nonce, req.Signature); err != nil {
+	if wallet.PublicKey != "" {
+		stored, err := publicKeyToAddress(wallet.PublicKey)
+		if err != nil || !sameAddress(stored, wallet.Address) {
+			return nil, errs.ErrNoPermission.WrapMsg("stored public key does not match address")
+		}
+	}
+	nonce, digest, err := o.signedDigest(wallet.Address, req.Nonce, req.Message)
+	if err != nil {
 		return nil, err
 	}
-	if !validSig {
-		return nil, errs.ErrArgs.WrapMsg("signature is wrong!")
+	if err := o.useNonce(ctx, wallet.Address, req.DeviceID, nonce, o.verifySignature(wallet.Address, req.PublicKey, digest, req.Signature)); err != nil {
+		return nil, err
 	}
-	chatToken, err := o.Admin.CreateToken(ctx, attribute.UserID, constant.NormalUser)
+	chatToken, err := o.Admin.CreateToken(ctx, &admin.CreateTokenReq{
+		UserID:    attribute.UserID,
+		UserType:  constant.NormalUser,
+		DeviceID:  req.DeviceID,
+		Platform:  req.Platform,
+		Ip:        req.Ip,
+		UserAgent: req.UserAgent,
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -461,5 +610,6 @@ func (o *chatSvr) Login(ctx context.Context, req *chat.LoginReq) (*chat.LoginRes
 	}
 	resp.UserID = attribute.UserID
 	resp.ChatToken = chatToken.Token
+	resp.RefreshToken = chatToken.RefreshToken
 	return resp, nil
 }
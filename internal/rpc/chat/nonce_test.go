@@ -0,0 +1,248 @@
+package chat
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/openimsdk/tools/errs"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/openimsdk/chat/pkg/common/db/database"
+	chatdb "github.com/openimsdk/chat/pkg/common/db/table/chat"
+	"github.com/openimsdk/chat/pkg/eerrs"
+	"github.com/openimsdk/chat/pkg/protocol/chat"
+	chatClient "github.com/openimsdk/chat/pkg/rpclient/chat"
+	"github.com/openimsdk/chat/pkg/siwe"
+	"github.com/openimsdk/chat/pkg/storage"
+)
+
+// memDatabase is the in-memory ChatDatabaseInterface shared by the chat-rpc tests.
+type memDatabase struct {
+	database.ChatDatabaseInterface
+	// mu guards the posts and drafts, shared by several chat-rpc instances in the scheduler tests
+	mu sync.Mutex
+
+	accounts   map[string]*chatdb.Account
+	attributes map[string]*chatdb.Attribute
+	nonces     map[string]*chatdb.LoginNonce
+	linked     map[string]*chatdb.LinkedWallet
+	logins     []*chatdb.UserLoginRecord
+
+	posts     map[string]*chatdb.Post
+	revisions map[string][]*chatdb.PostRevision
+	// flags by "userID/postID/field"
+	flags map[string]int32
+
+	notifications []*chatdb.Notification
+	aggregated    map[string][]string
+
+	drafts map[string]*chatdb.PostDraft
+	// creates counts how often each post ID was inserted
+	creates map[string]int
+
+	deletions map[string]*chatdb.AccountDeletion
+	exports   []*chatdb.DataExport
+}
+
+func (d *memDatabase) AddLoginNonce(_ context.Context, nonce *chatdb.LoginNonce) error {
+	n := *nonce
+	d.nonces[nonce.Nonce] = &n
+	return nil
+}
+
+func (d *memDatabase) TakeLoginNonce(_ context.Context, nonce string) (*chatdb.LoginNonce, error) {
+	n, ok := d.nonces[nonce]
+	if !ok {
+		return nil, errs.Wrap(mongo.ErrNoDocuments)
+	}
+	c := *n
+	return &c, nil
+}
+
+func (d *memDatabase) UseLoginNonce(_ context.Context, nonce string) (bool, error) {
+	n, ok := d.nonces[nonce]
+	if !ok || n.Used {
+		return false, nil
+	}
+	n.Used = true
+	return true, nil
+}
+
+// directTx runs the function without a transaction, like a standalone mongo does.
+type directTx struct{}
+
+func (directTx) Transaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+func newTestSvr(t *testing.T) (*chatSvr, *memDatabase) {
+	store, err := storage.NewLocal(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	db := &memDatabase{
+		accounts:   map[string]*chatdb.Account{},
+		attributes: map[string]*chatdb.Attribute{},
+		nonces:     map[string]*chatdb.LoginNonce{},
+		linked:     map[string]*chatdb.LinkedWallet{},
+
+		posts:     map[string]*chatdb.Post{},
+		revisions: map[string][]*chatdb.PostRevision{},
+		flags:     map[string]int32{},
+
+		aggregated: map[string][]string{},
+
+		drafts:  map[string]*chatdb.PostDraft{},
+		creates: map[string]int{},
+
+		deletions: map[string]*chatdb.AccountDeletion{},
+	}
+	return &chatSvr{
+		tx:             directTx{},
+		Database:       db,
+		Admin:          chatClient.NewAdminClient(loginAdmin{}),
+		Storage:        store,
+		NonceExpire:    time.Minute,
+		PostEditWindow: time.Minute,
+		PostSchedule:   postSchedule{Interval: time.Second, Lease: time.Minute, Owner: "instance-a"},
+		AccountDeletion: accountDeletion{
+			GracePeriod: time.Hour,
+			Interval:    time.Second,
+			Lease:       time.Minute,
+			Owner:       "instance-a",
+		},
+		DataExport: dataExport{
+			Secret:      []byte("secret"),
+			LinkExpire:  time.Hour,
+			DownloadURL: "https://chat.example.com/user/export/download",
+			Lease:       time.Minute,
+			Owner:       "instance-a",
+		},
+	}, db
+}
+
+func isCode(err error, code errs.CodeError) bool {
+	var codeErr errs.CodeError
+	return errors.As(errs.Unwrap(err), &codeErr) && codeErr.Code() == code.Code()
+}
+
+const testAddress = "0x71C7656EC7ab88b098defB751B7401B5f6d8976F"
+
+func TestUseNonce(t *testing.T) {
+	ctx := context.Background()
+	svr, _ := newTestSvr(t)
+	resp, err := svr.ChallengeNonce(ctx, &chat.ChallengeNonceReq{Address: testAddress, DeviceID: "dev1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := svr.useNonce(ctx, testAddress, "dev1", resp.Nonce, nil); err != nil {
+		t.Fatalf("first use: %v", err)
+	}
+	if err := svr.useNonce(ctx, testAddress, "dev1", resp.Nonce, nil); !isCode(err, eerrs.ErrNonceUsed) {
+		t.Fatalf("replay: want NonceUsed, got %v", err)
+	}
+}
+
+func TestUseNonceUnknown(t *testing.T) {
+	svr, _ := newTestSvr(t)
+	if err := svr.useNonce(context.Background(), testAddress, "", "deadbeef", nil); !isCode(err, eerrs.ErrNonceNotFound) {
+		t.Fatalf("want NonceNotFound, got %v", err)
+	}
+}
+
+func TestUseNonceExpired(t *testing.T) {
+	ctx := context.Background()
+	svr, db := newTestSvr(t)
+	resp, err := svr.ChallengeNonce(ctx, &chat.ChallengeNonceReq{Address: testAddress})
+	if err != nil {
+		t.Fatal(err)
+	}
+	db.nonces[resp.Nonce].ExpireTime = time.Now().Add(-time.Second)
+	if err := svr.useNonce(ctx, testAddress, "", resp.Nonce, nil); !isCode(err, eerrs.ErrNonceExpired) {
+		t.Fatalf("want NonceExpired, got %v", err)
+	}
+}
+
+func TestUseNonceCrossAddress(t *testing.T) {
+	ctx := context.Background()
+	svr, db := newTestSvr(t)
+	resp, err := svr.ChallengeNonce(ctx, &chat.ChallengeNonceReq{Address: testAddress, DeviceID: "dev1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	other := "0x0000000000000000000000000000000000000001"
+	if err := svr.useNonce(ctx, other, "dev1", resp.Nonce, nil); !isCode(err, eerrs.ErrNonceNotMatch) {
+		t.Fatalf("other address: want NonceNotMatch, got %v", err)
+	}
+	if err := svr.useNonce(ctx, testAddress, "dev2", resp.Nonce, nil); !isCode(err, eerrs.ErrNonceNotMatch) {
+		t.Fatalf("other device: want NonceNotMatch, got %v", err)
+	}
+	if db.nonces[resp.Nonce].Used {
+		t.Fatal("rejected attempts must not consume the nonce")
+	}
+}
+
+func TestUseNonceBadSignature(t *testing.T) {
+	ctx := context.Background()
+	svr, db := newTestSvr(t)
+	resp, err := svr.ChallengeNonce(ctx, &chat.ChallengeNonceReq{Address: testAddress})
+	if err != nil {
+		t.Fatal(err)
+	}
+	sigErr := errs.ErrArgs.WrapMsg("signature is wrong!")
+	if err := svr.useNonce(ctx, testAddress, "", resp.Nonce, func() error { return sigErr }); err != sigErr {
+		t.Fatalf("want signature error, got %v", err)
+	}
+	if db.nonces[resp.Nonce].Used {
+		t.Fatal("failed signature must not consume the nonce")
+	}
+}
+
+func TestSignInWithEthereum(t *testing.T) {
+	ctx := context.Background()
+	svr, _ := newTestSvr(t)
+	svr.SignIn = signIn{Enable: true, Domain: "chat.example.com", URI: "https://chat.example.com", ChainID: 1}
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	address := crypto.PubkeyToAddress(key.PublicKey).Hex()
+	resp, err := svr.ChallengeNonce(ctx, &chat.ChallengeNonceReq{Address: address})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.Message == "" {
+		t.Fatal("expected a SIWE message")
+	}
+	sig, err := crypto.Sign(siwe.Hash(resp.Message), key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// a message for another domain is rejected before the nonce is touched
+	msg, _ := siwe.Parse(resp.Message)
+	msg.Domain = "evil.example.com"
+	if _, _, err := svr.signedDigest(address, "", msg.String()); err == nil {
+		t.Fatal("message for another domain accepted")
+	}
+
+	nonce, digest, err := svr.signedDigest(address, "", resp.Message)
+	if err != nil {
+		t.Fatal(err)
+	}
+	verify := svr.verifySignature(address, "", digest, hexutil.Encode(sig))
+	if nonce != resp.Nonce {
+		t.Fatalf("nonce mismatch: want %s have %s", resp.Nonce, nonce)
+	}
+	if err := svr.useNonce(ctx, address, "", nonce, verify); err != nil {
+		t.Fatalf("login: %v", err)
+	}
+	if err := svr.useNonce(ctx, address, "", nonce, verify); !isCode(err, eerrs.ErrNonceUsed) {
+		t.Fatalf("replay: want NonceUsed, got %v", err)
+	}
+}
@@ -0,0 +1,172 @@
+package chat
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/openimsdk/tools/log"
+	"github.com/openimsdk/tools/utils/datautil"
+
+	"github.com/openimsdk/chat/pkg/common/constant"
+	"github.com/openimsdk/chat/pkg/common/convert"
+	"github.com/openimsdk/chat/pkg/common/db/table/chat"
+	"github.com/openimsdk/chat/pkg/common/mctx"
+	chatpb "github.com/openimsdk/chat/pkg/protocol/chat"
+)
+
+// 通知里返回的操作人数量，其余的只体现在 opCount 里
+const notificationOpUsers = 3
+
+// postEvent 实时推送的互动事件，客户端收到后刷新通知列表和未读数
+type postEvent struct {
+	Type         int32  `json:"type"`
+	PostID       string `json:"postID"`
+	SourcePostID string `json:"sourcePostID"`
+	OpUserID     string `json:"opUserID"`
+	CreateTime   int64  `json:"createTime"`
+}
+
+// push 把通知推送给在线的接收人，推送是尽力而为的，通知已经入库
+func (o *chatSvr) push(ctx context.Context, notification *chat.Notification, opUserID string) {
+	if o.Pusher == nil {
+		return
+	}
+	event := &postEvent{
+		Type:         notification.Type,
+		PostID:       notification.PostID,
+		SourcePostID: notification.SourcePostID,
+		OpUserID:     opUserID,
+		CreateTime:   time.Now().UnixMilli(),
+	}
+	if err := o.Pusher.Push(ctx, opUserID, notification.UserID, constant.PostNotificationKey, event); err != nil {
+		log.ZWarn(ctx, "push notification dropped", err, "type", notification.Type, "userID", notification.UserID)
+	}
+}
+
+// notify 给帖子作者记录一条互动通知，点赞按帖子聚合
+// 通知失败不影响帖子操作，只记录日志
+func (o *chatSvr) notify(ctx context.Context, notificationType int32, opUserID, userID, postID, sourcePostID string) {
+	if userID == "" || userID == opUserID {
+		return
+	}
+	notification := &chat.Notification{
+		NotificationID: uuid.New().String(),
+		UserID:         userID,
+		Type:           notificationType,
+		PostID:         postID,
+		SourcePostID:   sourcePostID,
+		OpUserIDs:      []string{opUserID},
+		OpCount:        1,
+	}
+	var err error
+	if notificationType == constant.NotificationLike {
+		notification.GroupKey = "like:" + postID
+		err = o.Database.AggregateNotification(ctx, notification, opUserID)
+	} else {
+		err = o.Database.AddNotifications(ctx, []*chat.Notification{notification})
+	}
+	if err != nil {
+		log.ZError(ctx, "notify failed", err, "type", notificationType, "userID", userID, "postID", postID)
+		return
+	}
+	o.push(ctx, notification, opUserID)
+}
+
+// notifyMention 通知帖子中 @ 的用户
+func (o *chatSvr) notifyMention(ctx context.Context, opUserID, postID string, atUserIDs []string) {
+	var notifications []*chat.Notification
+	for _, userID := range datautil.Distinct(atUserIDs) {
+		if userID == "" || userID == opUserID {
+			continue
+		}
+		notifications = append(notifications, &chat.Notification{
+			NotificationID: uuid.New().String(),
+			UserID:         userID,
+			Type:           constant.NotificationMention,
+			PostID:         postID,
+			SourcePostID:   postID,
+			OpUserIDs:      []string{opUserID},
+			OpCount:        1,
+		})
+	}
+	if len(notifications) == 0 {
+		return
+	}
+	if err := o.Database.AddNotifications(ctx, notifications); err != nil {
+		log.ZError(ctx, "notify mention failed", err, "postID", postID, "atUserIDs", atUserIDs)
+		return
+	}
+	for _, notification := range notifications {
+		o.push(ctx, notification, opUserID)
+	}
+}
+
+func (o *chatSvr) GetNotifications(ctx context.Context, req *chatpb.GetNotificationsReq) (*chatpb.GetNotificationsResp, error) {
+	userID, err := mctx.CheckUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	notifications, nextCursor, err := o.Database.GetNotificationsByCursor(ctx, userID, req.NextCursor, int64(req.Count))
+	if err != nil {
+		return nil, err
+	}
+	var opUserIDs []string
+	for _, notification := range notifications {
+		opUserIDs = append(opUserIDs, notification.OpUserIDs[:min(len(notification.OpUserIDs), notificationOpUsers)]...)
+	}
+	attributes, err := o.Database.FindAttribute(ctx, datautil.Distinct(opUserIDs))
+	if err != nil {
+		return nil, err
+	}
+	attributeMap := datautil.SliceToMap(attributes, func(attribute *chat.Attribute) string {
+		return attribute.UserID
+	})
+	resp := &chatpb.GetNotificationsResp{}
+	for _, notification := range notifications {
+		resp.Notifications = append(resp.Notifications, convert.NotificationDB2Pb(notification, attributeMap, notificationOpUsers))
+	}
+	if nextCursor != "" {
+		nextCursorInt, err := strconv.ParseInt(nextCursor, 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		resp.NextCursor = nextCursorInt
+	}
+	return resp, nil
+}
+
+func (o *chatSvr) GetUnreadNotificationCount(ctx context.Context, req *chatpb.GetUnreadNotificationCountReq) (*chatpb.GetUnreadNotificationCountResp, error) {
+	userID, err := mctx.CheckUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	count, err := o.Database.CountUnreadNotifications(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return &chatpb.GetUnreadNotificationCountResp{Count: count}, nil
+}
+
+func (o *chatSvr) MarkNotificationsRead(ctx context.Context, req *chatpb.MarkNotificationsReadReq) (*chatpb.MarkNotificationsReadResp, error) {
+	userID, err := mctx.CheckUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := o.Database.MarkNotificationsRead(ctx, userID, req.NotificationIDs); err != nil {
+		return nil, err
+	}
+	return &chatpb.MarkNotificationsReadResp{}, nil
+}
+
+func (o *chatSvr) MarkAllNotificationsRead(ctx context.Context, req *chatpb.MarkAllNotificationsReadReq) (*chatpb.MarkAllNotificationsReadResp, error) {
+	userID, err := mctx.CheckUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := o.Database.MarkNotificationsRead(ctx, userID, nil); err != nil {
+		return nil, err
+	}
+	return &chatpb.MarkAllNotificationsReadResp{}, nil
+}
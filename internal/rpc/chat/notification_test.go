@@ -0,0 +1,59 @@
+package chat
+
+import (
+	"context"
+	"testing"
+
+	"github.com/openimsdk/chat/pkg/common/constant"
+	chatdb "github.com/openimsdk/chat/pkg/common/db/table/chat"
+	"github.com/openimsdk/chat/pkg/common/mctx"
+	"github.com/openimsdk/chat/pkg/protocol/chat"
+)
+
+func (d *memDatabase) AddNotifications(_ context.Context, notifications []*chatdb.Notification) error {
+	d.notifications = append(d.notifications, notifications...)
+	return nil
+}
+
+func (d *memDatabase) AggregateNotification(_ context.Context, notification *chatdb.Notification, opUserID string) error {
+	key := notification.UserID + "/" + notification.GroupKey
+	d.aggregated[key] = append(d.aggregated[key], opUserID)
+	return nil
+}
+
+func TestLikeNotifiesAuthor(t *testing.T) {
+	svr, db := newTestSvr(t)
+	addPosts(db, &chatdb.Post{PostID: "p1", UserID: "author"})
+	like := func(userID string, isLiked int32) {
+		ctx := mctx.WithOpUserID(context.Background(), userID, constant.NormalUser)
+		if _, err := svr.ChangeLikePost(ctx, &chat.LikePostReq{PostID: "p1", IsLiked: isLiked}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	like("a", constant.Liked)
+	like("a", constant.Liked)
+	like("b", constant.Liked)
+	like("b", constant.NotLiked)
+	like("author", constant.Liked)
+
+	got := db.aggregated["author/like:p1"]
+	if len(got) != 2 || got[0] != "a" || got[1] != "b" {
+		t.Fatalf("unexpected like notifications: %v", got)
+	}
+	if len(db.aggregated) != 1 {
+		t.Fatalf("self like notified: %v", db.aggregated)
+	}
+}
+
+func TestNotifyMention(t *testing.T) {
+	svr, db := newTestSvr(t)
+	svr.notifyMention(context.Background(), "a", "p1", []string{"b", "a", "c", "b", ""})
+	if len(db.notifications) != 2 {
+		t.Fatalf("want 2 mentions, have %d", len(db.notifications))
+	}
+	for _, n := range db.notifications {
+		if n.Type != constant.NotificationMention || n.PostID != "p1" || n.UserID == "a" {
+			t.Fatalf("unexpected mention: %+v", n)
+		}
+	}
+}
@@ -21,6 +21,7 @@ import (
 
 	"github.com/openimsdk/chat/pkg/common/constant"
 	"github.com/openimsdk/chat/pkg/common/mctx"
+	"github.com/openimsdk/chat/pkg/passwd"
 	"github.com/openimsdk/chat/pkg/protocol/chat"
 )
 
@@ -39,19 +40,23 @@ func (o *chatSvr) ResetPassword(ctx context.Context, req *chat.ResetPasswordReq)
 	if err != nil {
 		return nil, err
 	}
+	password, err := passwd.Hash(req.Password)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
 
 	if req.Email == "" {
 		attribute, err := o.Database.GetAttributeByPhone(ctx, req.AreaCode, req.PhoneNumber)
 		if err != nil {
 			return nil, err
 		}
-		err = o.Database.UpdatePasswordAndDeleteVerifyCode(ctx, attribute.UserID, req.Password, verifyCodeID)
+		err = o.Database.UpdatePasswordAndDeleteVerifyCode(ctx, attribute.UserID, password, verifyCodeID)
 	} else {
 		attribute, err := o.Database.GetAttributeByEmail(ctx, req.Email)
 		if err != nil {
 			return nil, err
 		}
-		err = o.Database.UpdatePasswordAndDeleteVerifyCode(ctx, attribute.UserID, req.Password, verifyCodeID)
+		err = o.Database.UpdatePasswordAndDeleteVerifyCode(ctx, attribute.UserID, password, verifyCodeID)
 		if err != nil {
 			return nil, err
 		}
@@ -90,14 +95,17 @@ func (o *chatSvr) ChangePassword(ctx context.Context, req *chat.ChangePasswordRe
 		return nil, err
 	}
 	if userType != constant.AdminUser {
-		if user.Password != req.CurrentPassword {
+		if ok, _ := passwd.Verify(user.Password, req.CurrentPassword); !ok {
 			return nil, errs.ErrNoPermission.WrapMsg("current password is wrong")
 		}
 	}
-	if user.Password != req.NewPassword {
-		if err := o.Database.UpdatePassword(ctx, req.UserID, req.NewPassword); err != nil {
-			return nil, err
-		}
+	// the new password is always hashed with the current algorithm, which also migrates legacy rows
+	password, err := passwd.Hash(req.NewPassword)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	if err := o.Database.UpdatePassword(ctx, req.UserID, password); err != nil {
+		return nil, err
 	}
 	if err := o.Admin.InvalidateToken(ctx, req.UserID); err != nil {
 		return nil, err
@@ -0,0 +1,56 @@
+package chat
+
+import (
+	"context"
+	"testing"
+
+	"github.com/openimsdk/tools/errs"
+
+	"github.com/openimsdk/chat/pkg/common/constant"
+	chatdb "github.com/openimsdk/chat/pkg/common/db/table/chat"
+	"github.com/openimsdk/chat/pkg/common/mctx"
+	"github.com/openimsdk/chat/pkg/passwd"
+	"github.com/openimsdk/chat/pkg/protocol/chat"
+	chatClient "github.com/openimsdk/chat/pkg/rpclient/chat"
+)
+
+func (d *memDatabase) UpdatePassword(_ context.Context, userID string, password string) error {
+	if a, ok := d.accounts[userID]; ok {
+		a.Password = password
+	}
+	return nil
+}
+
+func TestChangePasswordMixedRows(t *testing.T) {
+	hashed, err := passwd.Hash("hashed-pw")
+	if err != nil {
+		t.Fatal(err)
+	}
+	svr, db := newTestSvr(t)
+	svr.Admin = chatClient.NewAdminClient(&tokenAdmin{})
+	db.accounts["legacy"] = &chatdb.Account{UserID: "legacy", Password: "legacy-pw"}
+	db.accounts["hashed"] = &chatdb.Account{UserID: "hashed", Password: hashed}
+
+	for userID, current := range map[string]string{"legacy": "legacy-pw", "hashed": "hashed-pw"} {
+		ctx := mctx.WithOpUserID(context.Background(), userID, constant.NormalUser)
+		if _, err := svr.ChangePassword(ctx, &chat.ChangePasswordReq{CurrentPassword: "wrong", NewPassword: "new-pw"}); !isCode(err, errs.ErrNoPermission) {
+			t.Fatalf("%s: wrong current password: want NoPermission, got %v", userID, err)
+		}
+		if _, err := svr.ChangePassword(ctx, &chat.ChangePasswordReq{CurrentPassword: current, NewPassword: "new-pw"}); err != nil {
+			t.Fatalf("%s: %v", userID, err)
+		}
+		stored := db.accounts[userID].Password
+		if passwd.Algorithm(stored) != passwd.Argon2id {
+			t.Fatalf("%s: stored %q is not an argon2id hash", userID, stored)
+		}
+		if ok, rehash := passwd.Verify(stored, "new-pw"); !ok || rehash {
+			t.Fatalf("%s: new password verify = %v, %v", userID, ok, rehash)
+		}
+	}
+
+	// the stored hash itself is not accepted as the password
+	ctx := mctx.WithOpUserID(context.Background(), "hashed", constant.NormalUser)
+	if _, err := svr.ChangePassword(ctx, &chat.ChangePasswordReq{CurrentPassword: db.accounts["hashed"].Password, NewPassword: "other"}); !isCode(err, errs.ErrNoPermission) {
+		t.Fatalf("hash as password: want NoPermission, got %v", err)
+	}
+}
@@ -9,9 +9,12 @@ import (
 	"strings"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/openimsdk/chat/pkg/redpacket/servererrs"
 	"github.com/openimsdk/tools/db/tx"
+	"github.com/openimsdk/tools/log"
 	"github.com/openimsdk/tools/mcontext"
+	"github.com/openimsdk/tools/utils/datautil"
 	"github.com/openimsdk/tools/utils/encrypt"
 	"go.mongodb.org/mongo-driver/mongo"
 
@@ -19,6 +22,7 @@ import (
 	"github.com/openimsdk/chat/pkg/common/convert"
 	"github.com/openimsdk/chat/pkg/common/db/table/chat"
 	"github.com/openimsdk/chat/pkg/common/mctx"
+	"github.com/openimsdk/chat/pkg/eerrs"
 	chatpb "github.com/openimsdk/chat/pkg/protocol/chat"
 	"github.com/openimsdk/tools/errs"
 )
@@ -28,6 +32,24 @@ func (o *chatSvr) PublishPost(ctx context.Context, req *chatpb.PublishPostReq) (
 	if err != nil {
 		return nil, err
 	}
+	if req.PublishAt > time.Now().UnixMilli() {
+		draft := &chat.PostDraft{
+			DraftID:      uuid.New().String(),
+			UserID:       userID,
+			Content:      req.Content.Value,
+			AllowComment: req.AllowComment,
+			AllowForward: req.AllowForward,
+			AtUserIds:    req.AtUserIds,
+			MediaMsgs:    convert.PostMediasPb2DB(req.MediaMsgs),
+			Visibility:   req.Visibility,
+			AllowUserIDs: datautil.Distinct(req.AllowUserIDs),
+		}
+		setDraftSchedule(draft, req.PublishAt)
+		if err := o.Database.CreatePostDraft(ctx, draft); err != nil {
+			return nil, err
+		}
+		return &chatpb.PublishPostResp{DraftID: draft.DraftID}, nil
+	}
 	postDB := &chat.PostDB{
 		UserID:       userID,
 		AllowComment: req.AllowComment,
@@ -35,6 +57,8 @@ func (o *chatSvr) PublishPost(ctx context.Context, req *chatpb.PublishPostReq) (
 		Content:      req.Content.Value,
 		AtUserIds:    req.AtUserIds,
 		MediaMsgs:    convert.PostMediasPb2DB(req.MediaMsgs),
+		Visibility:   req.Visibility,
+		AllowUserIDs: datautil.Distinct(req.AllowUserIDs),
 	}
 	if err := o.GenPostID(ctx, &postDB.PostID); err != nil {
 		return nil, err
@@ -43,7 +67,8 @@ func (o *chatSvr) PublishPost(ctx context.Context, req *chatpb.PublishPostReq) (
 	if err != nil {
 		return nil, err
 	}
-	post, err := o.Database.GetPostByID(ctx, postDB.PostID)
+	o.notifyMention(ctx, userID, postDB.PostID, req.AtUserIds)
+	post, err := o.Database.GetVisiblePostByID(ctx, postDB.PostID)
 	if err != nil {
 		return nil, err
 	}
@@ -58,6 +83,15 @@ func (o *chatSvr) ForwardPost(ctx context.Context, req *chatpb.ForwardPostReq) (
 		return nil, err
 	}
 
+	// 只能转发自己看得到的帖子，取消转发不受限制
+	var forwardPost *chat.Post
+	if req.IsForwarded == constant.Forwarded {
+		forwardPost, err = o.Database.GetVisiblePostByID(ctx, req.ForwardPostID)
+		if err != nil {
+			return nil, err
+		}
+	}
+	var forwarded string
 	if err := o.tx.Transaction(ctx, func(ctx context.Context) error {
 
 		if req.IsForwarded == constant.Forwarded {
@@ -71,31 +105,15 @@ func (o *chatSvr) ForwardPost(ctx context.Context, req *chatpb.ForwardPostReq) (
 			if err := o.Database.CreatePost(ctx, []*chat.PostDB{postDB}); err != nil {
 				return err
 			}
-
-			relation, err := o.Database.GetUserPostRelation(ctx, userID, req.ForwardPostID)
-			switch {
-			case err == nil:
-				relation.IsForwarded = constant.Forwarded
-				err = o.Database.UpdateUserPostRelation(ctx, userID, req.ForwardPostID, map[string]any{"is_forwarded": relation.IsForwarded})
-				if err != nil {
-					return err
-				}
-			case errors.Is(err, mongo.ErrNoDocuments):
-				relation = &chat.UserPostRelation{
-					UserID:      userID,
-					PostID:      req.ForwardPostID,
-					IsForwarded: constant.Forwarded,
-				}
-				err = o.Database.CreateUserPostRelation(ctx, []*chat.UserPostRelation{relation})
-				if err != nil {
-					return err
-				}
-			default:
+			changed, err := o.Database.ChangeUserPostRelation(ctx, userID, req.ForwardPostID, "is_forwarded", constant.Forwarded)
+			if err != nil {
 				return err
 			}
-
+			if changed {
+				forwarded = postDB.PostID
+			}
 		} else {
-			if err := o.Database.UpdateUserPostRelation(ctx, userID, req.ForwardPostID, map[string]any{"is_forwarded": constant.NotForwarded}); err != nil {
+			if _, err := o.Database.ChangeUserPostRelation(ctx, userID, req.ForwardPostID, "is_forwarded", constant.NotForwarded); err != nil {
 				return err
 			}
 			post, err := o.Database.GetPostByForwardPostID(ctx, userID, req.ForwardPostID)
@@ -111,6 +129,9 @@ func (o *chatSvr) ForwardPost(ctx context.Context, req *chatpb.ForwardPostReq) (
 	}); err != nil {
 		return nil, err
 	}
+	if forwarded != "" {
+		o.notify(ctx, constant.NotificationForward, userID, forwardPost.UserID, forwardPost.PostID, forwarded)
+	}
 
 	return &chatpb.ForwardPostResp{
 		IsForwarded: req.IsForwarded,
@@ -130,48 +151,32 @@ func (o *chatSvr) CommentPost(ctx context.Context, req *chatpb.CommentPostReq) (
 		Content:       req.Content.Value,
 		AtUserIds:     req.AtUserIds,
 		MediaMsgs:     convert.PostMediasPb2DB(req.MediaMsgs),
+		Visibility:    req.Visibility,
+		AllowUserIDs:  datautil.Distinct(req.AllowUserIDs),
 	}
 	if err := o.GenPostID(ctx, &postDB.PostID); err != nil {
 		return nil, err
 	}
-	relation, getErr := o.Database.GetUserPostRelation(ctx, userID, req.CommentPostID)
+	commentPost, err := o.Database.GetVisiblePostByID(ctx, req.CommentPostID)
+	if err != nil {
+		return nil, err
+	}
 
 	if err := tx.Tx.Transaction(o.tx, ctx, func(ctx context.Context) error {
-		switch {
-		case getErr == nil:
-			relation.IsCommented = constant.Commented
-			if err := o.Database.CreatePost(ctx, []*chat.PostDB{postDB}); err != nil {
-				return err
-			}
-			if err := o.Database.UpdateUserPostRelation(ctx, userID, req.CommentPostID, map[string]any{"is_commented": relation.IsCommented}); err != nil {
-				return err
-			}
-
-		case errors.Is(getErr, mongo.ErrNoDocuments):
-			relation = &chat.UserPostRelation{
-				UserID:      userID,
-				PostID:      req.CommentPostID,
-				IsCommented: constant.Commented,
-			}
-			if err := o.Database.CreatePost(ctx, []*chat.PostDB{postDB}); err != nil {
-				return err
-			}
-			if err := o.Database.CreateUserPostRelation(ctx, []*chat.UserPostRelation{relation}); err != nil {
-				return err
-			}
-
-			return nil
-		default:
-			return getErr
+		if err := o.Database.CreatePost(ctx, []*chat.PostDB{postDB}); err != nil {
+			return err
 		}
-
-		return nil
-
+		if _, err := o.Database.ChangeUserPostRelation(ctx, userID, req.CommentPostID, "is_commented", constant.Commented); err != nil {
+			return err
+		}
+		return o.Database.IncrPostCount(ctx, req.CommentPostID, "comment_count", 1)
 	}); err != nil {
 		return nil, err
 	}
+	o.notify(ctx, constant.NotificationComment, userID, commentPost.UserID, commentPost.PostID, postDB.PostID)
+	o.notifyMention(ctx, userID, postDB.PostID, req.AtUserIds)
 
-	post, err := o.Database.GetPostByID(ctx, postDB.PostID)
+	post, err := o.Database.GetVisiblePostByID(ctx, postDB.PostID)
 	if err != nil {
 		return nil, err
 	}
@@ -234,23 +239,91 @@ func (o *chatSvr) ReferencePost(ctx context.Context, req *chatpb.ReferencePostRe
 		AllowForward: req.AllowForward,
 		AtUserIds:    req.AtUserIds,
 		MediaMsgs:    convert.PostMediasPb2DB(req.MediaMsgs),
+		Visibility:   req.Visibility,
+		AllowUserIDs: datautil.Distinct(req.AllowUserIDs),
 	}
 	if err := o.GenPostID(ctx, &postDB.PostID); err != nil {
 		return nil, err
 	}
+	refPost, err := o.Database.GetVisiblePostByID(ctx, req.RefPostID)
+	if err != nil {
+		return nil, err
+	}
 	err = o.Database.CreatePost(ctx, []*chat.PostDB{postDB})
 	if err != nil {
 		return nil, err
 	}
+	o.notify(ctx, constant.NotificationReference, userID, refPost.UserID, refPost.PostID, postDB.PostID)
+	o.notifyMention(ctx, userID, postDB.PostID, req.AtUserIds)
 	return &chatpb.ReferencePostResp{}, nil
 }
 
+func (o *chatSvr) EditPost(ctx context.Context, req *chatpb.EditPostReq) (*chatpb.EditPostResp, error) {
+	userID, err := mctx.CheckUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	post, err := o.Database.GetPostByID(ctx, req.PostID)
+	if err != nil {
+		return nil, err
+	}
+	if post.UserID != userID {
+		return nil, errs.ErrNoPermission.WrapMsg("permission denied")
+	}
+	if post.ForwardPostID != "" {
+		return nil, errs.ErrArgs.WrapMsg("forwarded post cannot be edited")
+	}
+	if time.Since(post.CreateTime) > o.PostEditWindow {
+		return nil, eerrs.ErrPostEditExpired.WrapMsg("post can no longer be edited")
+	}
+	// 当前内容存为历史版本，版本号从 1 开始
+	revision := &chat.PostRevision{
+		PostID:    post.PostID,
+		Revision:  post.RevisionCount + 1,
+		UserID:    post.UserID,
+		Content:   post.Content,
+		AtUserIds: post.AtUserIds,
+		MediaMsgs: post.MediaMsgs,
+	}
+	data := map[string]any{
+		"at_user_ids": req.AtUserIds,
+		"media_msgs":  convert.PostMediasPb2DB(req.MediaMsgs),
+	}
+	// 没有传内容时只修改媒体，保留原来的内容
+	if req.Content != nil {
+		data["content"] = req.Content.GetValue()
+	}
+	if err := o.Database.EditPost(ctx, revision, data); err != nil {
+		return nil, err
+	}
+	post, err = o.Database.GetVisiblePostByID(ctx, req.PostID)
+	if err != nil {
+		return nil, err
+	}
+	return &chatpb.EditPostResp{
+		Post: convert.PostDB2Pb(post),
+	}, nil
+}
+
+func (o *chatSvr) GetPostRevisions(ctx context.Context, req *chatpb.GetPostRevisionsReq) (*chatpb.GetPostRevisionsResp, error) {
+	if _, err := o.Database.GetVisiblePostByID(ctx, req.PostID); err != nil {
+		return nil, err
+	}
+	revisions, err := o.Database.GetPostRevisions(ctx, req.PostID)
+	if err != nil {
+		return nil, err
+	}
+	return &chatpb.GetPostRevisionsResp{
+		Revisions: convert.PostRevisionsDB2Pb(revisions),
+	}, nil
+}
+
 func (o *chatSvr) ChangeLikePost(ctx context.Context, req *chatpb.LikePostReq) (*chatpb.LikePostResp, error) {
 	opUserID, err := mctx.CheckUser(ctx)
 	if err != nil {
 		return nil, err
 	}
-	post, err := o.Database.GetPostByID(ctx, req.PostID)
+	post, err := o.Database.GetVisiblePostByID(ctx, req.PostID)
 	if err != nil {
 		return nil, err
 	}
@@ -260,32 +333,19 @@ func (o *chatSvr) ChangeLikePost(ctx context.Context, req *chatpb.LikePostReq) (
 		isLiked = constant.Liked
 	}
 
-	relation, err := o.Database.GetUserPostRelation(ctx, opUserID, post.PostID)
-	if err != nil {
-		if errors.Is(err, mongo.ErrNoDocuments) {
-			relation = &chat.UserPostRelation{
-				UserID:  opUserID,
-				PostID:  post.PostID,
-				IsLiked: int32(isLiked),
-			}
-			err = o.Database.CreateUserPostRelation(ctx, []*chat.UserPostRelation{relation})
-			if err != nil {
-				return nil, err
-			}
-		} else {
-			return nil, err
-		}
-
-	} else {
-		relation.IsLiked = int32(isLiked)
-		err = o.Database.UpdateUserPostRelation(ctx, opUserID, post.PostID, map[string]any{"is_liked": relation.IsLiked})
-		if err != nil {
-			return nil, err
-		}
+	var changed bool
+	if err := o.tx.Transaction(ctx, func(ctx context.Context) error {
+		changed, err = o.Database.ChangeUserPostRelation(ctx, opUserID, post.PostID, "is_liked", int32(isLiked))
+		return err
+	}); err != nil {
+		return nil, err
+	}
+	if changed && isLiked == constant.Liked {
+		o.notify(ctx, constant.NotificationLike, opUserID, post.UserID, post.PostID, "")
 	}
 
 	return &chatpb.LikePostResp{
-		IsLiked: relation.IsLiked,
+		IsLiked: int32(isLiked),
 	}, nil
 }
 
@@ -294,7 +354,7 @@ func (o *chatSvr) ChangeCollectPost(ctx context.Context, req *chatpb.CollectPost
 	if err != nil {
 		return nil, err
 	}
-	post, err := o.Database.GetPostByID(ctx, req.PostID)
+	post, err := o.Database.GetVisiblePostByID(ctx, req.PostID)
 	if err != nil {
 		return nil, err
 	}
@@ -304,33 +364,15 @@ func (o *chatSvr) ChangeCollectPost(ctx context.Context, req *chatpb.CollectPost
 		isCollected = constant.Collected
 	}
 
-	relation, err := o.Database.GetUserPostRelation(ctx, opUserID, post.PostID)
-	if err != nil {
-		if errors.Is(err, mongo.ErrNoDocuments) {
-			relation = &chat.UserPostRelation{
-				UserID:      opUserID,
-				PostID:      post.PostID,
-				IsCollected: int32(isCollected),
-			}
-			err = o.Database.CreateUserPostRelation(ctx, []*chat.UserPostRelation{relation})
-			if err != nil {
-				return nil, err
-			}
-
-		} else {
-			return nil, err
-		}
-
-	} else {
-		relation.IsCollected = int32(isCollected)
-		err = o.Database.UpdateUserPostRelation(ctx, opUserID, post.PostID, map[string]any{"is_collected": relation.IsCollected})
-		if err != nil {
-			return nil, err
-		}
+	if err := o.tx.Transaction(ctx, func(ctx context.Context) error {
+		_, err := o.Database.ChangeUserPostRelation(ctx, opUserID, post.PostID, "is_collected", int32(isCollected))
+		return err
+	}); err != nil {
+		return nil, err
 	}
 
 	return &chatpb.CollectPostResp{
-		IsCollected: relation.IsCollected,
+		IsCollected: int32(isCollected),
 	}, nil
 }
 
@@ -405,16 +447,54 @@ func (o *chatSvr) DeletePost(ctx context.Context, req *chatpb.DeletePostReq) (*c
 		return nil, errs.ErrNoPermission.WrapMsg("permission denied")
 	}
 
-	err = o.Database.DeletePost(ctx, []string{req.PostID})
-	if err != nil {
+	if err := o.tx.Transaction(ctx, func(ctx context.Context) error {
+		if err := o.Database.DeletePost(ctx, []string{req.PostID}); err != nil {
+			return err
+		}
+		if post.CommentPostID != "" {
+			if err := o.Database.IncrPostCount(ctx, post.CommentPostID, "comment_count", -1); err != nil {
+				return err
+			}
+		}
+		if post.ForwardPostID != "" {
+			if _, err := o.Database.ChangeUserPostRelation(ctx, opUserID, post.ForwardPostID, "is_forwarded", constant.NotForwarded); err != nil {
+				return err
+			}
+		}
+		return nil
+	}); err != nil {
 		return nil, err
 	}
 
 	return &chatpb.DeletePostResp{}, nil
 }
 
+func (o *chatSvr) ReconcilePostCounts(ctx context.Context, req *chatpb.ReconcilePostCountsReq) (*chatpb.ReconcilePostCountsResp, error) {
+	if _, err := mctx.CheckAdmin(ctx); err != nil {
+		return nil, err
+	}
+	updated, err := o.Database.ReconcilePostCounts(ctx, req.PostIDs)
+	if err != nil {
+		return nil, err
+	}
+	return &chatpb.ReconcilePostCountsResp{Updated: updated}, nil
+}
+
+// backfillPostCounts 启动时补齐计数字段加入之前创建的帖子的计数，已经补齐的帖子不会再计算
+func (o *chatSvr) backfillPostCounts(ctx context.Context) {
+	ctx = mcontext.SetOperationID(ctx, "post_count_backfill_"+strconv.FormatInt(time.Now().UnixMilli(), 10))
+	updated, err := o.Database.BackfillPostCounts(ctx)
+	if err != nil {
+		log.ZError(ctx, "backfill post counts failed", err, "updated", updated)
+		return
+	}
+	if updated > 0 {
+		log.ZInfo(ctx, "post counts backfilled", "updated", updated)
+	}
+}
+
 func (o *chatSvr) GetPostByID(ctx context.Context, req *chatpb.GetPostByIDReq) (*chatpb.GetPostByIDResp, error) {
-	post, err := o.Database.GetPostByID(ctx, req.PostID)
+	post, err := o.Database.GetVisiblePostByID(ctx, req.PostID)
 	if err != nil {
 		return nil, err
 	}
@@ -554,6 +634,10 @@ func (o *chatSvr) GetPostList(ctx context.Context, req *chatpb.GetPostListReq) (
 }
 
 func (o *chatSvr) GetCommentPostListByPostID(ctx context.Context, req *chatpb.GetCommentPostListByPostIDReq) (*chatpb.GetCommentPostListByPostIDResp, error) {
+	// 看不到的帖子也看不到它的评论
+	if _, err := o.Database.GetVisiblePostByID(ctx, req.PostID); err != nil {
+		return nil, err
+	}
 	resp := &chatpb.GetCommentPostListByPostIDResp{}
 	postsDB, nextCursor, err := o.Database.GetCommentPostsByPostID(ctx, req.NextCursor, req.PostID, int64(req.Count))
 	if err != nil {
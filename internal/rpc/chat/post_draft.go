@@ -0,0 +1,193 @@
+package chat
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
+	"github.com/openimsdk/tools/mcontext"
+	"github.com/openimsdk/tools/utils/datautil"
+
+	"github.com/openimsdk/chat/pkg/common/constant"
+	"github.com/openimsdk/chat/pkg/common/convert"
+	"github.com/openimsdk/chat/pkg/common/db/dbutil"
+	"github.com/openimsdk/chat/pkg/common/db/table/chat"
+	"github.com/openimsdk/chat/pkg/common/mctx"
+	"github.com/openimsdk/chat/pkg/eerrs"
+	chatpb "github.com/openimsdk/chat/pkg/protocol/chat"
+)
+
+// 每轮定时任务最多发布的草稿数，剩下的下一轮发布
+const scheduledPostBatch = 100
+
+type postSchedule struct {
+	Interval time.Duration
+	// Lease 领取草稿后独占的时间，超时未发布完成的草稿会被重新领取
+	Lease time.Duration
+	// Owner 区分不同实例的租约
+	Owner string
+}
+
+// setDraftSchedule publishAt 为 0 时只保存草稿
+func setDraftSchedule(draft *chat.PostDraft, publishAt int64) {
+	if publishAt > 0 {
+		draft.Status = constant.DraftScheduled
+		draft.PublishAt = time.UnixMilli(publishAt)
+	} else {
+		draft.Status = constant.DraftSaved
+		draft.PublishAt = time.Time{}
+	}
+}
+
+func (o *chatSvr) SaveDraft(ctx context.Context, req *chatpb.SaveDraftReq) (*chatpb.SaveDraftResp, error) {
+	userID, err := mctx.CheckUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	draft := &chat.PostDraft{
+		UserID:       userID,
+		Content:      req.Content.GetValue(),
+		AllowComment: req.AllowComment,
+		AllowForward: req.AllowForward,
+		AtUserIds:    req.AtUserIds,
+		MediaMsgs:    convert.PostMediasPb2DB(req.MediaMsgs),
+		Visibility:   req.Visibility,
+		AllowUserIDs: datautil.Distinct(req.AllowUserIDs),
+	}
+	setDraftSchedule(draft, req.PublishAt)
+	if req.DraftID == "" {
+		draft.DraftID = uuid.New().String()
+		if err := o.Database.CreatePostDraft(ctx, draft); err != nil {
+			return nil, err
+		}
+		return &chatpb.SaveDraftResp{Draft: convert.PostDraftDB2Pb(draft)}, nil
+	}
+
+	old, err := o.Database.GetPostDraft(ctx, req.DraftID)
+	if err != nil {
+		return nil, err
+	}
+	if old.UserID != userID {
+		return nil, errs.ErrNoPermission.WrapMsg("permission denied")
+	}
+	updated, err := o.Database.UpdatePostDraft(ctx, req.DraftID, map[string]any{
+		"content":        draft.Content,
+		"allow_comment":  draft.AllowComment,
+		"allow_forward":  draft.AllowForward,
+		"at_user_ids":    draft.AtUserIds,
+		"media_msgs":     draft.MediaMsgs,
+		"visibility":     draft.Visibility,
+		"allow_user_ids": draft.AllowUserIDs,
+		"status":         draft.Status,
+		"publish_at":     draft.PublishAt,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !updated {
+		return nil, eerrs.ErrPostDraftPublishing.WrapMsg("draft is being published")
+	}
+	draft, err = o.Database.GetPostDraft(ctx, req.DraftID)
+	if err != nil {
+		return nil, err
+	}
+	return &chatpb.SaveDraftResp{Draft: convert.PostDraftDB2Pb(draft)}, nil
+}
+
+func (o *chatSvr) ListDrafts(ctx context.Context, req *chatpb.ListDraftsReq) (*chatpb.ListDraftsResp, error) {
+	userID, err := mctx.CheckUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	total, drafts, err := o.Database.FindPostDrafts(ctx, userID, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	return &chatpb.ListDraftsResp{
+		Total:  uint32(total),
+		Drafts: convert.PostDraftsDB2Pb(drafts),
+	}, nil
+}
+
+func (o *chatSvr) DeleteDraft(ctx context.Context, req *chatpb.DeleteDraftReq) (*chatpb.DeleteDraftResp, error) {
+	userID, err := mctx.CheckUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := o.Database.DeletePostDrafts(ctx, userID, req.DraftIDs); err != nil {
+		return nil, err
+	}
+	return &chatpb.DeleteDraftResp{}, nil
+}
+
+// runPostScheduler 定期发布到期的定时草稿，多个实例通过租约各自领取不同的草稿
+func (o *chatSvr) runPostScheduler(ctx context.Context) {
+	ticker := time.NewTicker(o.PostSchedule.Interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			o.publishDueDrafts(mcontext.SetOperationID(ctx, "post_schedule_"+strconv.FormatInt(time.Now().UnixMilli(), 10)))
+		}
+	}
+}
+
+func (o *chatSvr) publishDueDrafts(ctx context.Context) {
+	for i := 0; i < scheduledPostBatch; i++ {
+		draft, err := o.Database.ClaimDuePostDraft(ctx, o.PostSchedule.Owner, o.PostSchedule.Lease)
+		if err != nil {
+			if !dbutil.IsDBNotFound(err) {
+				log.ZError(ctx, "claim scheduled post failed", err)
+			}
+			return
+		}
+		// 失败的草稿等租约过期后重试
+		if err := o.publishDraft(ctx, draft); err != nil {
+			log.ZError(ctx, "publish scheduled post failed", err, "draftID", draft.DraftID)
+		}
+	}
+}
+
+// publishDraft 发布已领取的草稿，帖子ID第一次发布时生成并保存在草稿上，重试时不会重复发布
+func (o *chatSvr) publishDraft(ctx context.Context, draft *chat.PostDraft) error {
+	owner := o.PostSchedule.Owner
+	if draft.PostID == "" {
+		var postID string
+		if err := o.GenPostID(ctx, &postID); err != nil {
+			return err
+		}
+		saved, err := o.Database.SetPostDraftPostID(ctx, draft.DraftID, owner, postID)
+		if err != nil {
+			return err
+		}
+		if !saved {
+			// 租约已过期，由领取到的实例发布
+			return nil
+		}
+		draft.PostID = postID
+	}
+	post := &chat.PostDB{
+		PostID:       draft.PostID,
+		UserID:       draft.UserID,
+		Content:      draft.Content,
+		AllowComment: draft.AllowComment,
+		AllowForward: draft.AllowForward,
+		AtUserIds:    draft.AtUserIds,
+		MediaMsgs:    draft.MediaMsgs,
+		Visibility:   draft.Visibility,
+		AllowUserIDs: draft.AllowUserIDs,
+	}
+	created, err := o.Database.PublishPostDraft(ctx, draft.DraftID, owner, post)
+	if err != nil {
+		return err
+	}
+	if created {
+		o.notifyMention(ctx, draft.UserID, post.PostID, draft.AtUserIds)
+	}
+	return nil
+}
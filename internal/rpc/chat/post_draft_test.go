@@ -0,0 +1,161 @@
+package chat
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/openimsdk/tools/errs"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/openimsdk/chat/pkg/common/constant"
+	chatdb "github.com/openimsdk/chat/pkg/common/db/table/chat"
+	"github.com/openimsdk/chat/pkg/common/mctx"
+	"github.com/openimsdk/chat/pkg/protocol/chat"
+	"github.com/openimsdk/protocol/wrapperspb"
+)
+
+func (d *memDatabase) CreatePostDraft(_ context.Context, draft *chatdb.PostDraft) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	c := *draft
+	d.drafts[draft.DraftID] = &c
+	return nil
+}
+
+// ClaimDuePostDraft hands out each due draft once per lease so the tests below cover the scheduler flow
+// across instances; the mongo filters themselves are tested in the model package.
+func (d *memDatabase) ClaimDuePostDraft(_ context.Context, owner string, lease time.Duration) (*chatdb.PostDraft, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	now := time.Now()
+	for _, draft := range d.drafts {
+		if draft.Status != constant.DraftScheduled || draft.PublishAt.After(now) || draft.LeaseUntil.After(now) {
+			continue
+		}
+		draft.LeaseOwner, draft.LeaseUntil = owner, now.Add(lease)
+		c := *draft
+		return &c, nil
+	}
+	return nil, errs.Wrap(mongo.ErrNoDocuments)
+}
+
+func (d *memDatabase) SetPostDraftPostID(_ context.Context, draftID string, owner string, postID string) (bool, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	draft, ok := d.drafts[draftID]
+	if !ok || draft.LeaseOwner != owner || draft.PostID != "" {
+		return false, nil
+	}
+	draft.PostID = postID
+	return true, nil
+}
+
+func (d *memDatabase) PublishPostDraft(_ context.Context, draftID string, owner string, post *chatdb.PostDB) (bool, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.creates[post.PostID]++
+	_, exists := d.posts[post.PostID]
+	if !exists {
+		d.posts[post.PostID] = &chatdb.Post{PostID: post.PostID, UserID: post.UserID, Content: post.Content}
+	}
+	if draft, ok := d.drafts[draftID]; ok && draft.LeaseOwner == owner {
+		delete(d.drafts, draftID)
+	}
+	return !exists, nil
+}
+
+// newDraftSvrs returns one chat-rpc instance per owner, all publishing from the same database.
+func newDraftSvrs(t *testing.T, owners ...string) ([]*chatSvr, *memDatabase) {
+	svr, db := newTestSvr(t)
+	var svrs []*chatSvr
+	for _, owner := range owners {
+		s := *svr
+		s.PostSchedule.Owner = owner
+		svrs = append(svrs, &s)
+	}
+	return svrs, db
+}
+
+func TestScheduledPostsPublishOnce(t *testing.T) {
+	svrs, db := newDraftSvrs(t, "instance-a", "instance-b", "instance-c")
+	for i := 0; i < 50; i++ {
+		draftID := "d" + strconv.Itoa(i)
+		db.drafts[draftID] = &chatdb.PostDraft{
+			DraftID:   draftID,
+			UserID:    "u1",
+			Content:   draftID,
+			Status:    constant.DraftScheduled,
+			PublishAt: time.Now().Add(-time.Second),
+		}
+	}
+	// not due yet
+	db.drafts["later"] = &chatdb.PostDraft{DraftID: "later", UserID: "u1", Status: constant.DraftScheduled, PublishAt: time.Now().Add(time.Hour)}
+
+	ctx := context.Background()
+	var wg sync.WaitGroup
+	for _, svr := range svrs {
+		wg.Add(1)
+		go func(svr *chatSvr) {
+			defer wg.Done()
+			svr.publishDueDrafts(ctx)
+		}(svr)
+	}
+	wg.Wait()
+
+	if len(db.posts) != 50 {
+		t.Fatalf("want 50 posts, have %d", len(db.posts))
+	}
+	for postID, n := range db.creates {
+		if n != 1 {
+			t.Fatalf("post %s created %d times", postID, n)
+		}
+	}
+	if len(db.drafts) != 1 || db.drafts["later"] == nil {
+		t.Fatalf("unexpected drafts left: %d", len(db.drafts))
+	}
+}
+
+func TestScheduledPostRetryAfterCrash(t *testing.T) {
+	svrs, db := newDraftSvrs(t, "instance-b")
+	// instance-a created the post and died before removing the draft, its lease has expired
+	db.posts["p1"] = &chatdb.Post{PostID: "p1", UserID: "u1"}
+	db.drafts["d1"] = &chatdb.PostDraft{
+		DraftID:    "d1",
+		UserID:     "u1",
+		Status:     constant.DraftScheduled,
+		PublishAt:  time.Now().Add(-time.Minute),
+		PostID:     "p1",
+		LeaseOwner: "instance-a",
+		LeaseUntil: time.Now().Add(-time.Second),
+	}
+
+	svrs[0].publishDueDrafts(context.Background())
+
+	if len(db.posts) != 1 || len(db.drafts) != 0 {
+		t.Fatalf("want the existing post and no draft, have %d posts and %d drafts", len(db.posts), len(db.drafts))
+	}
+}
+
+func TestPublishPostAt(t *testing.T) {
+	svrs, db := newDraftSvrs(t, "instance-a")
+	ctx := mctx.WithOpUserID(context.Background(), "u1", constant.NormalUser)
+	publishAt := time.Now().Add(time.Hour).UnixMilli()
+
+	resp, err := svrs[0].PublishPost(ctx, &chat.PublishPostReq{Content: wrapperspb.String("later"), PublishAt: publishAt})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.Post != nil || resp.DraftID == "" {
+		t.Fatalf("want a scheduled draft, got %+v", resp)
+	}
+	draft := db.drafts[resp.DraftID]
+	if draft == nil || draft.Status != constant.DraftScheduled || draft.PublishAt.UnixMilli() != publishAt {
+		t.Fatalf("unexpected draft %+v", draft)
+	}
+	if len(db.posts) != 0 {
+		t.Fatal("scheduled post published immediately")
+	}
+}
@@ -0,0 +1,196 @@
+package chat
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/openimsdk/tools/errs"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/openimsdk/chat/pkg/common/constant"
+	chatdb "github.com/openimsdk/chat/pkg/common/db/table/chat"
+	"github.com/openimsdk/chat/pkg/common/mctx"
+	"github.com/openimsdk/chat/pkg/eerrs"
+	"github.com/openimsdk/chat/pkg/protocol/chat"
+	"github.com/openimsdk/chat/pkg/protocol/common"
+	"github.com/openimsdk/protocol/wrapperspb"
+)
+
+func (d *memDatabase) DeletePost(_ context.Context, postIDs []string) error {
+	for _, postID := range postIDs {
+		delete(d.posts, postID)
+	}
+	return nil
+}
+
+func (d *memDatabase) IncrPostCount(_ context.Context, postID string, field string, delta int64) error {
+	if p, ok := d.posts[postID]; ok && field == "comment_count" {
+		p.CommentCount += delta
+	}
+	return nil
+}
+
+func (d *memDatabase) ChangeUserPostRelation(_ context.Context, userID, postID string, field string, value int32) (bool, error) {
+	key := userID + "/" + postID + "/" + field
+	if d.flags[key] == value {
+		return false, nil
+	}
+	d.flags[key] = value
+	if p, ok := d.posts[postID]; ok && field == "is_forwarded" {
+		p.ForwardCount += int64(value*2 - 1)
+	}
+	return true, nil
+}
+
+func (d *memDatabase) GetPostByID(_ context.Context, postID string) (*chatdb.Post, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	p, ok := d.posts[postID]
+	if !ok {
+		return nil, errs.Wrap(mongo.ErrNoDocuments)
+	}
+	c := *p
+	return &c, nil
+}
+
+// GetVisiblePostByID only hides private posts, the follow based scopes are filtered by mongo.
+func (d *memDatabase) GetVisiblePostByID(ctx context.Context, postID string) (*chatdb.Post, error) {
+	p, err := d.GetPostByID(ctx, postID)
+	if err != nil {
+		return nil, err
+	}
+	if opUserID, _ := mctx.CheckUser(ctx); p.Visibility == constant.VisibilityPrivate && p.UserID != opUserID {
+		return nil, errs.Wrap(mongo.ErrNoDocuments)
+	}
+	return p, nil
+}
+
+func (d *memDatabase) EditPost(_ context.Context, revision *chatdb.PostRevision, data map[string]any) error {
+	for _, r := range d.revisions[revision.PostID] {
+		if r.Revision == revision.Revision {
+			return errs.New("duplicate revision")
+		}
+	}
+	d.revisions[revision.PostID] = append([]*chatdb.PostRevision{revision}, d.revisions[revision.PostID]...)
+	p := d.posts[revision.PostID]
+	if content, ok := data["content"]; ok {
+		p.Content = content.(string)
+	}
+	p.MediaMsgs = data["media_msgs"].([]*chatdb.PostMedia)
+	p.RevisionCount = revision.Revision
+	return nil
+}
+
+func (d *memDatabase) GetPostRevisions(_ context.Context, postID string) ([]*chatdb.PostRevision, error) {
+	return d.revisions[postID], nil
+}
+
+func addPosts(db *memDatabase, posts ...*chatdb.Post) {
+	for _, post := range posts {
+		db.posts[post.PostID] = post
+	}
+}
+
+func TestEditPost(t *testing.T) {
+	svr, db := newTestSvr(t)
+	addPosts(db, &chatdb.Post{PostID: "p1", UserID: "u1", Content: "v1", CreateTime: time.Now()})
+	ctx := mctx.WithOpUserID(context.Background(), "u1", constant.NormalUser)
+
+	for _, content := range []string{"v2", "v3"} {
+		resp, err := svr.EditPost(ctx, &chat.EditPostReq{PostID: "p1", Content: wrapperspb.String(content)})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if resp.Post.Content != content || resp.Post.IsEdited != constant.Edited {
+			t.Fatalf("unexpected post: %+v", resp.Post)
+		}
+	}
+
+	resp, err := svr.GetPostRevisions(ctx, &chat.GetPostRevisionsReq{PostID: "p1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.Revisions) != 2 || resp.Revisions[0].Revision != 2 || resp.Revisions[0].Content != "v2" || resp.Revisions[1].Content != "v1" {
+		t.Fatalf("unexpected revisions: %+v", resp.Revisions)
+	}
+}
+
+func TestEditPostMediaOnly(t *testing.T) {
+	svr, db := newTestSvr(t)
+	addPosts(db, &chatdb.Post{PostID: "p1", UserID: "u1", Content: "v1", CreateTime: time.Now()})
+	ctx := mctx.WithOpUserID(context.Background(), "u1", constant.NormalUser)
+
+	picture := &common.PictureBaseInfo{Url: "https://example.com/a.png"}
+	media := []*common.PostMedia{{
+		MediaType:   constant.PostMediaTypePicture,
+		PostPicture: &common.PictureElem{SourcePicture: picture, BigPicture: picture, SnapshotPicture: picture},
+	}}
+	resp, err := svr.EditPost(ctx, &chat.EditPostReq{PostID: "p1", MediaMsgs: media})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.Post.Content != "v1" || len(resp.Post.MediaMsgs) != 1 {
+		t.Fatalf("media only edit changed the content: %+v", resp.Post)
+	}
+}
+
+func TestEditPostRejected(t *testing.T) {
+	svr, db := newTestSvr(t)
+	addPosts(db, &chatdb.Post{PostID: "p1", UserID: "u1", Content: "v1", CreateTime: time.Now().Add(-time.Hour)})
+	req := &chat.EditPostReq{PostID: "p1", Content: wrapperspb.String("v2")}
+
+	other := mctx.WithOpUserID(context.Background(), "u2", constant.NormalUser)
+	if _, err := svr.EditPost(other, req); !isCode(err, errs.ErrNoPermission) {
+		t.Fatalf("other user: want NoPermission, got %v", err)
+	}
+	author := mctx.WithOpUserID(context.Background(), "u1", constant.NormalUser)
+	if _, err := svr.EditPost(author, req); !isCode(err, eerrs.ErrPostEditExpired) {
+		t.Fatalf("outside window: want PostEditExpired, got %v", err)
+	}
+}
+
+func TestDeletePostUpdatesCounts(t *testing.T) {
+	parent := &chatdb.Post{PostID: "p1", UserID: "u1", CommentCount: 1, ForwardCount: 1}
+	svr, db := newTestSvr(t)
+	addPosts(db,
+		parent,
+		&chatdb.Post{PostID: "c1", UserID: "u2", CommentPostID: "p1"},
+		&chatdb.Post{PostID: "f1", UserID: "u2", ForwardPostID: "p1"},
+	)
+	db.flags["u2/p1/is_forwarded"] = constant.Forwarded
+	ctx := mctx.WithOpUserID(context.Background(), "u2", constant.NormalUser)
+
+	for _, postID := range []string{"c1", "f1"} {
+		if _, err := svr.DeletePost(ctx, &chat.DeletePostReq{PostID: postID}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if parent.CommentCount != 0 || parent.ForwardCount != 0 {
+		t.Fatalf("counts not released: comments %d, forwards %d", parent.CommentCount, parent.ForwardCount)
+	}
+}
+
+func TestPrivatePostHidden(t *testing.T) {
+	svr, db := newTestSvr(t)
+	addPosts(db, &chatdb.Post{PostID: "p1", UserID: "u1", Visibility: constant.VisibilityPrivate})
+	other := mctx.WithOpUserID(context.Background(), "u2", constant.NormalUser)
+
+	if _, err := svr.GetPostByID(other, &chat.GetPostByIDReq{PostID: "p1"}); !IsNotFound(err) {
+		t.Fatalf("get: want not found, got %v", err)
+	}
+	if _, err := svr.GetCommentPostListByPostID(other, &chat.GetCommentPostListByPostIDReq{PostID: "p1", Count: 10}); !IsNotFound(err) {
+		t.Fatalf("comments: want not found, got %v", err)
+	}
+	if _, err := svr.ChangeLikePost(other, &chat.LikePostReq{PostID: "p1", IsLiked: constant.Liked}); !IsNotFound(err) {
+		t.Fatalf("like: want not found, got %v", err)
+	}
+	if _, err := svr.ForwardPost(other, &chat.ForwardPostReq{ForwardPostID: "p1", IsForwarded: constant.Forwarded}); !IsNotFound(err) {
+		t.Fatalf("forward: want not found, got %v", err)
+	}
+
+	author := mctx.WithOpUserID(context.Background(), "u1", constant.NormalUser)
+	if _, err := svr.GetPostByID(author, &chat.GetPostByIDReq{PostID: "p1"}); err != nil {
+		t.Fatalf("author: %v", err)
+	}
+}
@@ -0,0 +1,103 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chat
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/openimsdk/tools/errs"
+	"go.mongodb.org/mongo-driver/mongo"
+	"google.golang.org/grpc"
+
+	chatdb "github.com/openimsdk/chat/pkg/common/db/table/chat"
+	"github.com/openimsdk/chat/pkg/protocol/admin"
+	"github.com/openimsdk/chat/pkg/protocol/chat"
+	chatClient "github.com/openimsdk/chat/pkg/rpclient/chat"
+)
+
+// registerDatabase fails to create the user, like a concurrent registration of the same account would.
+type registerDatabase struct {
+	*memDatabase
+}
+
+func (d *registerDatabase) GetUser(context.Context, string) (*chatdb.Account, error) {
+	return nil, errs.Wrap(mongo.ErrNoDocuments)
+}
+
+func (d *registerDatabase) RegisterUser(context.Context, *chatdb.Register, *chatdb.Account, *chatdb.Attribute) error {
+	return errs.Wrap(mongo.WriteException{WriteErrors: mongo.WriteErrors{{Code: 11000, Message: "duplicate key"}}})
+}
+
+// invitationAdmin lets the registration through and records the invitation code uses taken and released.
+type invitationAdmin struct {
+	loginAdmin
+	used     []string
+	released []string
+}
+
+func (*invitationAdmin) CheckRegisterForbidden(context.Context, *admin.CheckRegisterForbiddenReq, ...grpc.CallOption) (*admin.CheckRegisterForbiddenResp, error) {
+	return &admin.CheckRegisterForbiddenResp{}, nil
+}
+
+func (*invitationAdmin) GetClientConfig(context.Context, *admin.GetClientConfigReq, ...grpc.CallOption) (*admin.GetClientConfigResp, error) {
+	return &admin.GetClientConfigResp{}, nil
+}
+
+func (a *invitationAdmin) UseInvitationCode(_ context.Context, req *admin.UseInvitationCodeReq, _ ...grpc.CallOption) (*admin.UseInvitationCodeResp, error) {
+	a.used = append(a.used, req.UserID+"/"+req.Code)
+	return &admin.UseInvitationCodeResp{}, nil
+}
+
+func (a *invitationAdmin) ReleaseInvitationCode(_ context.Context, req *admin.ReleaseInvitationCodeReq, _ ...grpc.CallOption) (*admin.ReleaseInvitationCodeResp, error) {
+	a.released = append(a.released, req.UserID+"/"+req.Code)
+	return &admin.ReleaseInvitationCodeResp{}, nil
+}
+
+func TestRegisterUserReleasesInvitationCode(t *testing.T) {
+	owner, _ := crypto.GenerateKey()
+	key, _ := crypto.GenerateKey()
+	svr, db := newTestSvr(t)
+	addWalletUser(db, "u1", owner)
+	adminClient := &invitationAdmin{}
+	svr.Admin = chatClient.NewAdminClient(adminClient)
+	svr.Database = &registerDatabase{memDatabase: db}
+	ctx := context.Background()
+
+	address := crypto.PubkeyToAddress(key.PublicKey).Hex()
+	challenge, err := svr.ChallengeNonce(ctx, &chat.ChallengeNonceReq{Address: address})
+	if err != nil {
+		t.Fatal(err)
+	}
+	sig, err := crypto.Sign(hexutil.MustDecode(challenge.Nonce), key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req := &chat.RegisterUserReq{
+		InvitationCode: "c1",
+		Nonce:          challenge.Nonce,
+		Signature:      hexutil.Encode(sig),
+		User:           &chat.RegisterUserInfo{Address: address, Nickname: "n"},
+	}
+	if _, err := svr.RegisterUser(ctx, req); err == nil {
+		t.Fatal("registration succeeded")
+	}
+	// the use taken before the failed registration is given back for the same user
+	if len(adminClient.used) != 1 || len(adminClient.released) != 1 || adminClient.used[0] != adminClient.released[0] {
+		t.Fatalf("used %v, released %v", adminClient.used, adminClient.released)
+	}
+}
@@ -2,6 +2,7 @@ package chat
 
 import (
 	"bytes"
+	"crypto/ecdsa"
 	_ "encoding/hex"
 	"github.com/ethereum/go-ethereum/crypto"
 	"testing"
@@ -87,3 +88,79 @@ func TestVerifySignatureMalleable(t *testing.T) {
 		t.Error("VerifySignature returned true for malleable signature")
 	}
 }
+
+func TestSignerAddress(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	address := crypto.PubkeyToAddress(key.PublicKey).Hex()
+	digest := crypto.Keccak256([]byte("nonce"))
+	sig, err := crypto.Sign(digest, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pubKey := hexutil.Encode(crypto.FromECDSAPub(&key.PublicKey))
+
+	// declared public key, with and without the recovery id
+	for _, s := range [][]byte{sig, sig[:crypto.RecoveryIDOffset]} {
+		signer, err := signerAddress(pubKey, digest, hexutil.Encode(s))
+		if err != nil {
+			t.Fatalf("verify with public key: %v", err)
+		}
+		if !sameAddress(signer, address) {
+			t.Errorf("signer mismatch: want %s have %s", address, signer)
+		}
+	}
+
+	// recovered public key, with V as 0/1 and as 27/28
+	eip155 := common.CopyBytes(sig)
+	eip155[crypto.RecoveryIDOffset] += 27
+	for _, s := range [][]byte{sig, eip155} {
+		signer, err := signerAddress("", digest, hexutil.Encode(s))
+		if err != nil {
+			t.Fatalf("ecrecover: %v", err)
+		}
+		if !sameAddress(signer, address) {
+			t.Errorf("recovered signer mismatch: want %s have %s", address, signer)
+		}
+	}
+
+	other, _ := crypto.GenerateKey()
+	otherPubKey := hexutil.Encode(crypto.FromECDSAPub(&other.PublicKey))
+	if _, err := signerAddress(otherPubKey, digest, hexutil.Encode(sig)); err == nil {
+		t.Error("signature valid with another public key")
+	}
+	if _, err := signerAddress("", digest, hexutil.Encode(sig[:crypto.RecoveryIDOffset])); err == nil {
+		t.Error("recovered signer without recovery id")
+	}
+}
+
+func TestVerifySignatureBindsAddress(t *testing.T) {
+	key, _ := crypto.GenerateKey()
+	other, _ := crypto.GenerateKey()
+	address := crypto.PubkeyToAddress(key.PublicKey).Hex()
+	nonce, err := generateNonce(crypto.DigestLength)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sign := func(k *ecdsa.PrivateKey) string {
+		sig, err := crypto.Sign(hexutil.MustDecode(nonce), k)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return hexutil.Encode(sig)
+	}
+	var o chatSvr
+	if err := o.verifySignature(address, "", hexutil.MustDecode(nonce), sign(key))(); err != nil {
+		t.Fatalf("own signature rejected: %v", err)
+	}
+	// a valid signature and matching public key that belong to someone else
+	otherPubKey := hexutil.Encode(crypto.FromECDSAPub(&other.PublicKey))
+	if err := o.verifySignature(address, otherPubKey, hexutil.MustDecode(nonce), sign(other))(); err == nil {
+		t.Fatal("signature of another key accepted for address")
+	}
+	if err := o.verifySignature(address, "", hexutil.MustDecode(nonce), sign(other))(); err == nil {
+		t.Fatal("recovered signature of another key accepted for address")
+	}
+}
@@ -0,0 +1,89 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chat
+
+import (
+	"strings"
+
+	"github.com/openimsdk/tools/errs"
+
+	"github.com/openimsdk/chat/pkg/common/config"
+	"github.com/openimsdk/chat/pkg/sms"
+)
+
+// newSMS builds the providers of verifyCode.phone, nil if none is configured.
+func newSMS(conf *config.Phone) (sms.SMS, error) {
+	providers := make(map[string]sms.SMS)
+	chain := func(use string) (sms.SMS, error) {
+		var list []sms.SMS
+		for _, name := range strings.Split(use, ",") {
+			name = strings.TrimSpace(name)
+			if name == "" {
+				continue
+			}
+			provider, ok := providers[name]
+			if !ok {
+				var err error
+				if provider, err = newSMSProvider(conf, name); err != nil {
+					return nil, err
+				}
+				providers[name] = provider
+			}
+			list = append(list, provider)
+		}
+		if len(list) == 0 {
+			return nil, nil
+		}
+		return sms.NewFallback(list...), nil
+	}
+	def, err := chain(conf.Use)
+	if err != nil {
+		return nil, err
+	}
+	if len(conf.Routes) == 0 {
+		return def, nil
+	}
+	routes := make(map[string]sms.SMS)
+	for _, route := range conf.Routes {
+		provider, err := chain(route.Use)
+		if err != nil {
+			return nil, err
+		}
+		if provider == nil {
+			return nil, errs.New("verifyCode.phone.routes: use is empty", "areaCodes", route.AreaCodes).Wrap()
+		}
+		for _, areaCode := range route.AreaCodes {
+			routes[areaCode] = provider
+		}
+	}
+	return sms.NewRouter(def, routes), nil
+}
+
+func newSMSProvider(conf *config.Phone, name string) (sms.SMS, error) {
+	switch name {
+	case "ali":
+		return sms.NewAli(conf.Ali.Endpoint, conf.Ali.AccessKeyID, conf.Ali.AccessKeySecret, conf.Ali.SignName, conf.Ali.VerificationCodeTemplateCode)
+	case "twilio":
+		return sms.NewTwilio(conf.Twilio.Endpoint, conf.Twilio.AccountSID, conf.Twilio.AuthToken, conf.Twilio.From, conf.Twilio.Message)
+	case "vonage":
+		return sms.NewVonage(conf.Vonage.Endpoint, conf.Vonage.APIKey, conf.Vonage.APISecret, conf.Vonage.From, conf.Vonage.Message)
+	case "sns":
+		return sms.NewSNS(conf.SNS.Endpoint, conf.SNS.Region, conf.SNS.AccessKeyID, conf.SNS.SecretAccessKey, conf.SNS.SenderID, conf.SNS.SMSType, conf.SNS.Message)
+	case "http":
+		return sms.NewHTTP(conf.HTTP.Method, conf.HTTP.URL, conf.HTTP.Header, conf.HTTP.Body)
+	default:
+		return nil, errs.New("unknown sms provider " + name).Wrap()
+	}
+}
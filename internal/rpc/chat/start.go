@@ -4,6 +4,8 @@ import (
 	"context"
 	"time"
 
+	"github.com/google/uuid"
+
 	"github.com/openimsdk/chat/pkg/redpacket"
 
 	"github.com/openimsdk/chat/pkg/common/mctx"
@@ -14,15 +16,20 @@ import (
 	"github.com/openimsdk/tools/db/tx"
 	"github.com/openimsdk/tools/discovery"
 	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
 	"github.com/openimsdk/tools/mw"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 
 	"github.com/openimsdk/chat/pkg/common/config"
 	"github.com/openimsdk/chat/pkg/common/db/database"
+	"github.com/openimsdk/chat/pkg/common/imapi"
+	"github.com/openimsdk/chat/pkg/common/startrpc"
 	"github.com/openimsdk/chat/pkg/email"
+	"github.com/openimsdk/chat/pkg/imnotify"
 	chatClient "github.com/openimsdk/chat/pkg/rpclient/chat"
 	"github.com/openimsdk/chat/pkg/sms"
+	"github.com/openimsdk/chat/pkg/storage"
 )
 
 type Config struct {
@@ -46,16 +53,32 @@ func Start(ctx context.Context, config *Config, client discovery.SvcDiscoveryReg
 		return err
 	}
 	var srv chatSvr
-	switch config.RpcConfig.VerifyCode.Phone.Use {
-	case "ali":
-		ali := config.RpcConfig.VerifyCode.Phone.Ali
-		srv.SMS, err = sms.NewAli(ali.Endpoint, ali.AccessKeyID, ali.AccessKeySecret, ali.SignName, ali.VerificationCodeTemplateCode)
+	srv.SMS, err = newSMS(&config.RpcConfig.VerifyCode.Phone)
+	if err != nil {
+		return err
+	}
+	if mail := config.RpcConfig.VerifyCode.Mail; mail.Enable {
+		srv.Mail, err = email.NewMail(email.Config{
+			SMTPAddr:        mail.SMTPAddr,
+			SMTPPort:        mail.SMTPPort,
+			Username:        mail.Username,
+			Password:        mail.SenderAuthorizationCode,
+			SenderMail:      mail.SenderMail,
+			Title:           mail.Title,
+			Security:        mail.Security,
+			TemplateDir:     mail.TemplateDir,
+			DefaultLanguage: mail.DefaultLanguage,
+			ValidTime:       time.Duration(config.RpcConfig.VerifyCode.ValidTime) * time.Second,
+			Queue:           mail.Queue.Enable,
+			Workers:         mail.Queue.Workers,
+			BufferSize:      mail.Queue.BufferSize,
+			MaxRetry:        mail.Queue.MaxRetry,
+			RetryInterval:   time.Duration(mail.Queue.RetryInterval) * time.Millisecond,
+		})
 		if err != nil {
 			return err
 		}
-	}
-	if mail := config.RpcConfig.VerifyCode.Mail; mail.Enable {
-		srv.Mail = email.NewMail(mail.SMTPAddr, mail.SMTPPort, mail.SenderMail, mail.SenderAuthorizationCode, mail.Title)
+		startrpc.OnStop(ctx, srv.Mail.Stop)
 	}
 	srv.Database, err = database.NewChatDatabase(mgocli)
 	if err != nil {
@@ -74,21 +97,114 @@ func Start(ctx context.Context, config *Config, client discovery.SvcDiscoveryReg
 		ValidTime:  time.Duration(config.RpcConfig.VerifyCode.ValidTime) * time.Second,
 		Len:        config.RpcConfig.VerifyCode.Len,
 	}
+	srv.NonceExpire = time.Duration(config.RpcConfig.LoginNonce.Expire) * time.Second
+	if srv.NonceExpire <= 0 {
+		srv.NonceExpire = 5 * time.Minute
+	}
+	srv.SignIn = signIn{
+		Enable:    config.RpcConfig.LoginNonce.SIWE.Enable,
+		Domain:    config.RpcConfig.LoginNonce.SIWE.Domain,
+		URI:       config.RpcConfig.LoginNonce.SIWE.URI,
+		ChainID:   config.RpcConfig.LoginNonce.SIWE.ChainID,
+		Statement: config.RpcConfig.LoginNonce.SIWE.Statement,
+	}
+	if srv.SignIn.Enable && srv.SignIn.Domain == "" {
+		return errs.New("loginNonce.siwe.domain must be set when siwe is enabled")
+	}
+	srv.PostEditWindow = time.Duration(config.RpcConfig.Post.EditWindow) * time.Second
+	if srv.PostEditWindow <= 0 {
+		srv.PostEditWindow = 15 * time.Minute
+	}
+	srv.IM = imapi.New(config.Share.OpenIM.ApiURL, config.Share.OpenIM.Secret, config.Share.OpenIM.AdminUserID)
+	if push := config.RpcConfig.Push; push.Enable {
+		srv.Pusher = imnotify.New(srv.IM, imnotify.Config{
+			Workers:          push.Workers,
+			BufferSize:       push.BufferSize,
+			MaxRetry:         push.MaxRetry,
+			RetryInterval:    time.Duration(push.RetryInterval) * time.Millisecond,
+			Timeout:          time.Duration(push.Timeout) * time.Millisecond,
+			BreakerThreshold: push.BreakerThreshold,
+			BreakerCooldown:  time.Duration(push.BreakerCooldown) * time.Second,
+		})
+		startrpc.OnStop(ctx, srv.Pusher.Stop)
+	}
+	srv.PostSchedule = postSchedule{
+		Interval: time.Duration(config.RpcConfig.Post.ScheduleInterval) * time.Second,
+		Lease:    time.Duration(config.RpcConfig.Post.ScheduleLease) * time.Second,
+		Owner:    uuid.New().String(),
+	}
+	if srv.PostSchedule.Interval <= 0 {
+		srv.PostSchedule.Interval = 10 * time.Second
+	}
+	if srv.PostSchedule.Lease <= 0 {
+		srv.PostSchedule.Lease = time.Minute
+	}
+	srv.AccountDeletion = accountDeletion{
+		GracePeriod: time.Duration(config.RpcConfig.AccountDeletion.GracePeriod) * time.Second,
+		Interval:    time.Duration(config.RpcConfig.AccountDeletion.Interval) * time.Second,
+		Lease:       time.Duration(config.RpcConfig.AccountDeletion.Lease) * time.Second,
+		Owner:       srv.PostSchedule.Owner,
+	}
+	if srv.AccountDeletion.Interval <= 0 {
+		srv.AccountDeletion.Interval = time.Minute
+	}
+	if srv.AccountDeletion.Lease <= 0 {
+		srv.AccountDeletion.Lease = 5 * time.Minute
+	}
+	srv.Storage, err = storage.New(config.Share.Storage.Build())
+	if err != nil {
+		return err
+	}
+	srv.DataExport = dataExport{
+		Secret:      []byte(config.RpcConfig.DataExport.Secret),
+		LinkExpire:  time.Duration(config.RpcConfig.DataExport.LinkExpire) * time.Second,
+		DownloadURL: config.RpcConfig.DataExport.DownloadURL,
+		Interval:    time.Duration(config.RpcConfig.DataExport.Interval) * time.Second,
+		Lease:       time.Duration(config.RpcConfig.DataExport.Lease) * time.Second,
+		Owner:       srv.PostSchedule.Owner,
+	}
+	if !srv.DataExport.enabled() {
+		log.ZWarn(ctx, "dataExport.secret is not set, data export is disabled", nil)
+	}
+	if srv.DataExport.LinkExpire <= 0 {
+		srv.DataExport.LinkExpire = 24 * time.Hour
+	}
+	if srv.DataExport.Interval <= 0 {
+		srv.DataExport.Interval = 10 * time.Second
+	}
+	if srv.DataExport.Lease <= 0 {
+		srv.DataExport.Lease = 5 * time.Minute
+	}
 	srv.Livekit = rtc.NewLiveKit(config.RpcConfig.LiveKit.Key, config.RpcConfig.LiveKit.Secret, config.RpcConfig.LiveKit.URL)
 	srv.RedPacketClient = redpacket.NewRedPacketClient(config.Share.RedPacket.ApiURL)
 	srv.Share = config.Share
 	srv.tx = mgocli.GetTx()
 	chat.RegisterChatServer(server, &srv)
+	go srv.backfillPostCounts(ctx)
+	go srv.runPostScheduler(ctx)
+	go srv.runAccountDeletion(ctx)
+	if srv.DataExport.enabled() {
+		go srv.runDataExport(ctx)
+	}
 	return nil
 }
 
 type chatSvr struct {
-	tx 							tx.Tx
+	tx              tx.Tx
 	Database        database.ChatDatabaseInterface
 	Admin           *chatClient.AdminClient
 	SMS             sms.SMS
 	Mail            email.Mail
 	Code            verifyCode
+	NonceExpire     time.Duration
+	SignIn          signIn
+	PostEditWindow  time.Duration
+	PostSchedule    postSchedule
+	AccountDeletion accountDeletion
+	DataExport      dataExport
+	Storage         storage.Storage
+	IM              imapi.CallerInterface
+	Pusher          *imnotify.Pusher
 	Livekit         *rtc.LiveKit
 	ChatAdminUserID string
 	RedPacketClient *redpacket.Client
@@ -107,3 +223,12 @@ type verifyCode struct {
 	ValidTime  time.Duration
 	Len        int
 }
+
+// signIn configures EIP-4361 Sign-In with Ethereum messages.
+type signIn struct {
+	Enable    bool
+	Domain    string
+	URI       string
+	ChainID   int64
+	Statement string
+}
@@ -15,12 +15,13 @@
 package chat
 
 import (
+	"crypto/ecdsa"
 	"crypto/rand"
-	"encoding/hex"
 
 	"github.com/openimsdk/tools/errs"
 	"github.com/openimsdk/tools/mw/specialerror"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/crypto"
 )
@@ -30,24 +31,72 @@ func generateNonce(size int) (string, error) {
 	if _, err := rand.Read(nonce); err != nil {
 		return "", err
 	}
-	return hex.EncodeToString(nonce), nil
+	return hexutil.Encode(nonce), nil
 }
 
-func validateSignature(publicKey, nonce, signature string) (b bool, err error) {
-	pubKey, err := hexutil.Decode(publicKey)
+// signerAddress returns the address whose key produced signature over digest.
+// When publicKey is given the signature is verified against it, otherwise the
+// key is recovered from the 65-byte [R || S || V] signature.
+func signerAddress(publicKey string, digest []byte, signature string) (string, error) {
+	sig, err := hexutil.Decode(signature)
 	if err != nil {
-		return false, err
+		return "", errs.ErrArgs.WrapMsg("signature is invalid")
+	}
+	if len(digest) != crypto.DigestLength {
+		return "", errs.ErrArgs.WrapMsg("signed message must be 32 bytes")
+	}
+	if publicKey != "" {
+		pubKey, err := hexutil.Decode(publicKey)
+		if err != nil {
+			return "", errs.ErrArgs.WrapMsg("public key is invalid")
+		}
+		if len(sig) == crypto.SignatureLength {
+			sig = sig[:crypto.RecoveryIDOffset]
+		}
+		if !crypto.VerifySignature(pubKey, digest, sig) {
+			return "", errs.ErrArgs.WrapMsg("signature is wrong!")
+		}
+		return publicKeyToAddress(publicKey)
+	}
+	if len(sig) != crypto.SignatureLength {
+		return "", errs.ErrArgs.WrapMsg("signature must be 65 bytes when public key is not provided")
 	}
-	message, err := hexutil.Decode(nonce)
+	// wallets commonly emit V as 27/28
+	if sig[crypto.RecoveryIDOffset] >= 27 {
+		sig = append([]byte(nil), sig...)
+		sig[crypto.RecoveryIDOffset] -= 27
+	}
+	pubKey, err := crypto.SigToPub(digest, sig)
 	if err != nil {
-		return false, err
+		return "", errs.ErrArgs.WrapMsg("signature is wrong!")
+	}
+	return crypto.PubkeyToAddress(*pubKey).Hex(), nil
+}
+
+func publicKeyToAddress(publicKey string) (string, error) {
+	pubKey, err := hexutil.Decode(publicKey)
+	if err != nil {
+		return "", errs.ErrArgs.WrapMsg("public key is invalid")
+	}
+	var key *ecdsa.PublicKey
+	if len(pubKey) == 33 {
+		key, err = crypto.DecompressPubkey(pubKey)
+	} else {
+		key, err = crypto.UnmarshalPubkey(pubKey)
 	}
-	sig, err := hexutil.Decode(signature)
 	if err != nil {
-		return false, err
+		return "", errs.ErrArgs.WrapMsg("public key is invalid")
 	}
-	b = crypto.VerifySignature(pubKey, message, sig)
-	return b, nil
+	return crypto.PubkeyToAddress(*key).Hex(), nil
+}
+
+// checksumAddress is the EIP-55 form wallet addresses are stored in, address must be a hex address.
+func checksumAddress(address string) string {
+	return common.HexToAddress(address).Hex()
+}
+
+func sameAddress(a, b string) bool {
+	return common.IsHexAddress(a) && common.IsHexAddress(b) && common.HexToAddress(a) == common.HexToAddress(b)
 }
 
 func IsNotFound(err error) bool {
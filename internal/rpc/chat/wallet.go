@@ -0,0 +1,213 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chat
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/utils/datautil"
+
+	"github.com/openimsdk/chat/pkg/common/constant"
+	"github.com/openimsdk/chat/pkg/common/db/dbutil"
+	chatdb "github.com/openimsdk/chat/pkg/common/db/table/chat"
+	"github.com/openimsdk/chat/pkg/common/mctx"
+	"github.com/openimsdk/chat/pkg/eerrs"
+	"github.com/openimsdk/chat/pkg/protocol/chat"
+)
+
+// takeWallet resolves a wallet address to its account. The primary wallet in the
+// attribute is checked first, then the linked wallets.
+func (o *chatSvr) takeWallet(ctx context.Context, address string) (*chatdb.Attribute, *chatdb.LinkedWallet, error) {
+	candidates := []string{address}
+	if common.IsHexAddress(address) {
+		// wallets are stored checksummed, primary wallets registered before that as the client sent them
+		candidates = datautil.Distinct([]string{checksumAddress(address), address, strings.ToLower(address)})
+	}
+	var err error
+	for _, candidate := range candidates {
+		var attribute *chatdb.Attribute
+		attribute, err = o.Database.GetAttributeByAddress(ctx, candidate)
+		if err == nil {
+			return attribute, primaryWallet(attribute), nil
+		} else if !dbutil.IsDBNotFound(err) {
+			return nil, nil, err
+		}
+	}
+	if !common.IsHexAddress(address) {
+		return nil, nil, err
+	}
+	wallet, err := o.Database.GetLinkedWallet(ctx, checksumAddress(address))
+	if err != nil {
+		return nil, nil, err
+	}
+	attribute, err := o.Database.GetAttribute(ctx, wallet.UserID)
+	if err != nil {
+		return nil, nil, err
+	}
+	return attribute, wallet, nil
+}
+
+func primaryWallet(attribute *chatdb.Attribute) *chatdb.LinkedWallet {
+	return &chatdb.LinkedWallet{
+		UserID:     attribute.UserID,
+		Address:    attribute.Address,
+		PublicKey:  attribute.PublicKey,
+		CreateTime: attribute.CreateTime,
+	}
+}
+
+// walletOwner returns the user whose wallets are operated on, normal users may only touch their own.
+func (o *chatSvr) walletOwner(ctx context.Context, userID string) (string, error) {
+	opUserID, userType, err := mctx.Check(ctx)
+	if err != nil {
+		return "", err
+	}
+	switch userType {
+	case constant.NormalUser:
+		if userID == "" {
+			userID = opUserID
+		}
+		if userID != opUserID {
+			return "", errs.ErrNoPermission.WrapMsg("no permission for other user's wallets")
+		}
+	case constant.AdminUser:
+		if userID == "" {
+			return "", errs.ErrArgs.WrapMsg("user id must be set")
+		}
+	default:
+		return "", errs.ErrInternalServer.WrapMsg("invalid user type")
+	}
+	return userID, nil
+}
+
+func (o *chatSvr) AddWallet(ctx context.Context, req *chat.AddWalletReq) (*chat.AddWalletResp, error) {
+	opUserID, err := mctx.CheckUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	attribute, current, err := o.takeWallet(ctx, req.CurrentAddress)
+	if err != nil {
+		if dbutil.IsDBNotFound(err) {
+			return nil, eerrs.ErrAccountNotFound.WrapMsg("current wallet not found")
+		}
+		return nil, err
+	}
+	if attribute.UserID != opUserID {
+		return nil, errs.ErrNoPermission.WrapMsg("current wallet does not belong to user")
+	}
+	if _, _, err := o.takeWallet(ctx, req.Address); err == nil {
+		return nil, eerrs.ErrWalletAlreadyLinked.Wrap()
+	} else if !dbutil.IsDBNotFound(err) {
+		return nil, err
+	}
+	nonce, digest, err := o.signedDigest(req.Address, req.Nonce, req.Message)
+	if err != nil {
+		return nil, err
+	}
+	// both keys sign the same challenge, which is bound to the new address
+	verifyCurrent := o.verifySignature(current.Address, req.CurrentPublicKey, digest, req.CurrentSignature)
+	verifyNew := o.verifySignature(req.Address, req.PublicKey, digest, req.Signature)
+	err = o.useNonce(ctx, req.Address, req.DeviceID, nonce, func() error {
+		if err := verifyCurrent(); err != nil {
+			return err
+		}
+		return verifyNew()
+	})
+	if err != nil {
+		return nil, err
+	}
+	wallet := &chatdb.LinkedWallet{
+		UserID:     opUserID,
+		Address:    checksumAddress(req.Address),
+		PublicKey:  req.PublicKey,
+		CreateTime: time.Now(),
+	}
+	if err := o.Database.AddLinkedWallet(ctx, wallet); err != nil {
+		return nil, err
+	}
+	return &chat.AddWalletResp{}, nil
+}
+
+func (o *chatSvr) ListWallets(ctx context.Context, req *chat.ListWalletsReq) (*chat.ListWalletsResp, error) {
+	userID, err := o.walletOwner(ctx, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+	attribute, err := o.Database.GetAttribute(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	linked, err := o.Database.FindLinkedWallets(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	resp := &chat.ListWalletsResp{Wallets: make([]*chat.WalletInfo, 0, len(linked)+1)}
+	if attribute.Address != "" {
+		resp.Wallets = append(resp.Wallets, &chat.WalletInfo{
+			Address:    attribute.Address,
+			PublicKey:  attribute.PublicKey,
+			Primary:    true,
+			CreateTime: attribute.CreateTime.UnixMilli(),
+		})
+	}
+	for _, wallet := range linked {
+		resp.Wallets = append(resp.Wallets, &chat.WalletInfo{
+			Address:    wallet.Address,
+			PublicKey:  wallet.PublicKey,
+			CreateTime: wallet.CreateTime.UnixMilli(),
+		})
+	}
+	return resp, nil
+}
+
+// RemoveWallet unlinks a wallet. Removing the primary wallet promotes the oldest linked
+// one in its place, so an account always keeps at least one wallet it can login with.
+func (o *chatSvr) RemoveWallet(ctx context.Context, req *chat.RemoveWalletReq) (*chat.RemoveWalletResp, error) {
+	userID, err := o.walletOwner(ctx, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+	attribute, wallet, err := o.takeWallet(ctx, req.Address)
+	if err != nil {
+		if dbutil.IsDBNotFound(err) {
+			return nil, errs.ErrArgs.WrapMsg("wallet not found")
+		}
+		return nil, err
+	}
+	if attribute.UserID != userID {
+		return nil, errs.ErrNoPermission.WrapMsg("wallet does not belong to user")
+	}
+	if wallet.Address != attribute.Address {
+		if err := o.Database.RemoveLinkedWallet(ctx, userID, wallet.Address); err != nil {
+			return nil, err
+		}
+		return &chat.RemoveWalletResp{}, nil
+	}
+	linked, err := o.Database.FindLinkedWallets(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if len(linked) == 0 {
+		return nil, errs.ErrArgs.WrapMsg("cannot remove the only wallet of an account")
+	}
+	if err := o.Database.PromoteLinkedWallet(ctx, linked[0]); err != nil {
+		return nil, err
+	}
+	return &chat.RemoveWalletResp{}, nil
+}
@@ -0,0 +1,277 @@
+package chat
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/openimsdk/tools/errs"
+	"go.mongodb.org/mongo-driver/mongo"
+	"google.golang.org/grpc"
+
+	"github.com/openimsdk/chat/pkg/common/constant"
+	chatdb "github.com/openimsdk/chat/pkg/common/db/table/chat"
+	"github.com/openimsdk/chat/pkg/common/mctx"
+	"github.com/openimsdk/chat/pkg/protocol/admin"
+	"github.com/openimsdk/chat/pkg/protocol/chat"
+)
+
+func (d *memDatabase) GetAttributeByAddress(_ context.Context, address string) (*chatdb.Attribute, error) {
+	for _, a := range d.attributes {
+		if a.Address == address {
+			return a, nil
+		}
+	}
+	return nil, errs.Wrap(mongo.ErrNoDocuments)
+}
+
+func (d *memDatabase) GetAttribute(_ context.Context, userID string) (*chatdb.Attribute, error) {
+	if a, ok := d.attributes[userID]; ok {
+		return a, nil
+	}
+	return nil, errs.Wrap(mongo.ErrNoDocuments)
+}
+
+func (d *memDatabase) GetLinkedWallet(_ context.Context, address string) (*chatdb.LinkedWallet, error) {
+	if w, ok := d.linked[address]; ok {
+		return w, nil
+	}
+	return nil, errs.Wrap(mongo.ErrNoDocuments)
+}
+
+func (d *memDatabase) AddLinkedWallet(_ context.Context, wallet *chatdb.LinkedWallet) error {
+	d.linked[wallet.Address] = wallet
+	return nil
+}
+
+func (d *memDatabase) FindLinkedWallets(_ context.Context, userID string) ([]*chatdb.LinkedWallet, error) {
+	var wallets []*chatdb.LinkedWallet
+	for _, w := range d.linked {
+		if w.UserID == userID {
+			wallets = append(wallets, w)
+		}
+	}
+	sort.Slice(wallets, func(i, j int) bool { return wallets[i].CreateTime.Before(wallets[j].CreateTime) })
+	return wallets, nil
+}
+
+func (d *memDatabase) RemoveLinkedWallet(_ context.Context, userID string, address string) error {
+	if w, ok := d.linked[address]; ok && w.UserID == userID {
+		delete(d.linked, address)
+	}
+	return nil
+}
+
+func (d *memDatabase) PromoteLinkedWallet(_ context.Context, wallet *chatdb.LinkedWallet) error {
+	delete(d.linked, wallet.Address)
+	a := d.attributes[wallet.UserID]
+	a.Address, a.PublicKey = wallet.Address, wallet.PublicKey
+	return nil
+}
+
+func (d *memDatabase) LoginRecord(_ context.Context, record *chatdb.UserLoginRecord) error {
+	d.logins = append(d.logins, record)
+	return nil
+}
+
+// loginAdmin lets every login through and issues the user ID as the token.
+type loginAdmin struct {
+	admin.AdminClient
+}
+
+func (loginAdmin) CheckLoginForbidden(context.Context, *admin.CheckLoginForbiddenReq, ...grpc.CallOption) (*admin.CheckLoginForbiddenResp, error) {
+	return &admin.CheckLoginForbiddenResp{}, nil
+}
+
+func (loginAdmin) CreateToken(_ context.Context, req *admin.CreateTokenReq, _ ...grpc.CallOption) (*admin.CreateTokenResp, error) {
+	return &admin.CreateTokenResp{Token: req.UserID}, nil
+}
+
+// addWalletUser stores userID with the address of key as its login wallet.
+func addWalletUser(db *memDatabase, userID string, key *ecdsa.PrivateKey) {
+	address := crypto.PubkeyToAddress(key.PublicKey).Hex()
+	db.attributes[userID] = &chatdb.Attribute{UserID: userID, Address: address, CreateTime: time.Now()}
+}
+
+// linkWallet stores a linked wallet for key directly, created at the given time.
+func linkWallet(db *memDatabase, userID string, key *ecdsa.PrivateKey, createTime time.Time) string {
+	address := crypto.PubkeyToAddress(key.PublicKey).Hex()
+	db.linked[address] = &chatdb.LinkedWallet{UserID: userID, Address: address, CreateTime: createTime}
+	return address
+}
+
+func TestAddWalletRequiresBothKeys(t *testing.T) {
+	owner, _ := crypto.GenerateKey()
+	newKey, _ := crypto.GenerateKey()
+	stranger, _ := crypto.GenerateKey()
+	svr, db := newTestSvr(t)
+	addWalletUser(db, "u1", owner)
+	ctx := mctx.WithOpUserID(context.Background(), "u1", constant.NormalUser)
+	current := crypto.PubkeyToAddress(owner.PublicKey).Hex()
+	address := crypto.PubkeyToAddress(newKey.PublicKey).Hex()
+
+	challenge := func() (string, func(*ecdsa.PrivateKey) string) {
+		resp, err := svr.ChallengeNonce(ctx, &chat.ChallengeNonceReq{Address: address})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return resp.Nonce, func(k *ecdsa.PrivateKey) string {
+			sig, err := crypto.Sign(hexutil.MustDecode(resp.Nonce), k)
+			if err != nil {
+				t.Fatal(err)
+			}
+			return hexutil.Encode(sig)
+		}
+	}
+
+	nonce, sign := challenge()
+	_, err := svr.AddWallet(ctx, &chat.AddWalletReq{
+		CurrentAddress: current, CurrentSignature: sign(stranger),
+		Address: address, Signature: sign(newKey), Nonce: nonce,
+	})
+	if err == nil {
+		t.Fatal("wallet linked without the current key's signature")
+	}
+
+	nonce, sign = challenge()
+	_, err = svr.AddWallet(ctx, &chat.AddWalletReq{
+		CurrentAddress: current, CurrentSignature: sign(owner),
+		Address: address, Signature: sign(owner), Nonce: nonce,
+	})
+	if err == nil {
+		t.Fatal("wallet linked without the new key's signature")
+	}
+
+	nonce, sign = challenge()
+	_, err = svr.AddWallet(ctx, &chat.AddWalletReq{
+		CurrentAddress: current, CurrentSignature: sign(owner),
+		Address: address, Signature: sign(newKey), Nonce: nonce,
+	})
+	if err != nil {
+		t.Fatalf("link wallet: %v", err)
+	}
+
+	attribute, wallet, err := svr.takeWallet(ctx, address)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if attribute.UserID != "u1" || wallet.Address != address {
+		t.Fatalf("linked address resolved to %s/%s", attribute.UserID, wallet.Address)
+	}
+	if len(db.linked) != 1 {
+		t.Fatalf("want 1 linked wallet, have %d", len(db.linked))
+	}
+}
+
+func TestRemoveWalletPromotesOldestLinked(t *testing.T) {
+	owner, _ := crypto.GenerateKey()
+	older, _ := crypto.GenerateKey()
+	newer, _ := crypto.GenerateKey()
+	svr, db := newTestSvr(t)
+	addWalletUser(db, "u1", owner)
+	ctx := mctx.WithOpUserID(context.Background(), "u1", constant.NormalUser)
+	primary := db.attributes["u1"].Address
+	olderAddress := linkWallet(db, "u1", older, time.Now().Add(-time.Hour))
+	newerAddress := linkWallet(db, "u1", newer, time.Now())
+
+	other := mctx.WithOpUserID(context.Background(), "u2", constant.NormalUser)
+	if _, err := svr.RemoveWallet(other, &chat.RemoveWalletReq{Address: newerAddress}); !isCode(err, errs.ErrNoPermission) {
+		t.Fatalf("remove other user's wallet: want NoPermission, got %v", err)
+	}
+
+	// a linked wallet is only unlinked, in any letter case
+	if _, err := svr.RemoveWallet(ctx, &chat.RemoveWalletReq{Address: strings.ToLower(newerAddress)}); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := db.linked[newerAddress]; ok || db.attributes["u1"].Address != primary {
+		t.Fatalf("linked wallet not removed: %v, primary %s", db.linked, db.attributes["u1"].Address)
+	}
+
+	// removing the primary wallet promotes the oldest linked one
+	if _, err := svr.RemoveWallet(ctx, &chat.RemoveWalletReq{Address: primary}); err != nil {
+		t.Fatal(err)
+	}
+	if db.attributes["u1"].Address != olderAddress || len(db.linked) != 0 {
+		t.Fatalf("after removing primary: primary %s, linked %v", db.attributes["u1"].Address, db.linked)
+	}
+	if _, err := svr.RemoveWallet(ctx, &chat.RemoveWalletReq{Address: olderAddress}); !isCode(err, errs.ErrArgs) {
+		t.Fatalf("remove the only wallet: want ErrArgs, got %v", err)
+	}
+}
+
+func TestListWallets(t *testing.T) {
+	owner, _ := crypto.GenerateKey()
+	linked, _ := crypto.GenerateKey()
+	svr, db := newTestSvr(t)
+	addWalletUser(db, "u1", owner)
+	address := linkWallet(db, "u1", linked, time.Now())
+
+	resp, err := svr.ListWallets(mctx.WithOpUserID(context.Background(), "u1", constant.NormalUser), &chat.ListWalletsReq{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.Wallets) != 2 || !resp.Wallets[0].Primary || resp.Wallets[0].Address != db.attributes["u1"].Address ||
+		resp.Wallets[1].Primary || resp.Wallets[1].Address != address {
+		t.Fatalf("wallets: %v", resp.Wallets)
+	}
+	if _, err := svr.ListWallets(mctx.WithOpUserID(context.Background(), "u2", constant.NormalUser), &chat.ListWalletsReq{UserID: "u1"}); !isCode(err, errs.ErrNoPermission) {
+		t.Fatalf("list other user's wallets: want NoPermission, got %v", err)
+	}
+	if _, err := svr.ListWallets(mctx.WithAdminUser(context.Background(), "admin"), &chat.ListWalletsReq{UserID: "u1"}); err != nil {
+		t.Fatalf("list as admin: %v", err)
+	}
+}
+
+func TestLoginWithLinkedWallet(t *testing.T) {
+	owner, _ := crypto.GenerateKey()
+	linked, _ := crypto.GenerateKey()
+	svr, db := newTestSvr(t)
+	addWalletUser(db, "u1", owner)
+	address := linkWallet(db, "u1", linked, time.Now())
+	ctx := context.Background()
+
+	login := func(address string, key *ecdsa.PrivateKey) (*chat.LoginResp, error) {
+		challenge, err := svr.ChallengeNonce(ctx, &chat.ChallengeNonceReq{Address: address})
+		if err != nil {
+			t.Fatal(err)
+		}
+		sig, err := crypto.Sign(hexutil.MustDecode(challenge.Nonce), key)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return svr.Login(ctx, &chat.LoginReq{Address: address, Nonce: challenge.Nonce, Signature: hexutil.Encode(sig)})
+	}
+
+	resp, err := login(strings.ToLower(address), linked)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.UserID != "u1" || resp.ChatToken != "u1" {
+		t.Fatalf("login with linked wallet: %v", resp)
+	}
+	if _, err := login(address, owner); err == nil {
+		t.Fatal("linked wallet logged in with the primary wallet's key")
+	}
+}
+
+func TestTakeWalletLegacyPrimaryCase(t *testing.T) {
+	owner, _ := crypto.GenerateKey()
+	svr, db := newTestSvr(t)
+	addWalletUser(db, "u1", owner)
+	// stored as the client sent it, before addresses were checksummed
+	checksummed := db.attributes["u1"].Address
+	db.attributes["u1"].Address = strings.ToLower(checksummed)
+
+	attribute, wallet, err := svr.takeWallet(context.Background(), checksummed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if attribute.UserID != "u1" || wallet.Address != db.attributes["u1"].Address {
+		t.Fatalf("resolved to %s/%s", attribute.UserID, wallet.Address)
+	}
+}
@@ -19,14 +19,36 @@ import (
 )
 
 type AdminLoginResp struct {
-	AdminAccount string `json:"adminAccount"`
+	AdminAccount   string `json:"adminAccount"`
+	AdminToken     string `json:"adminToken"`
+	RefreshToken   string `json:"refreshToken"`
+	Nickname       string `json:"nickname"`
+	FaceURL        string `json:"faceURL"`
+	Level          int32  `json:"level"`
+	AdminUserID    string `json:"adminUserID"`
+	ImUserID       string `json:"imUserID"`
+	ImToken        string `json:"imToken"`
+	ChallengeToken string `json:"challengeToken,omitempty"`
+	TwoFactor      int32  `json:"twoFactor"`
+}
+
+type AdminRefreshTokenResp struct {
 	AdminToken   string `json:"adminToken"`
-	Nickname     string `json:"nickname"`
-	FaceURL      string `json:"faceURL"`
-	Level        int32  `json:"level"`
+	RefreshToken string `json:"refreshToken"`
 	AdminUserID  string `json:"adminUserID"`
-	ImUserID     string `json:"imUserID"`
-	ImToken      string `json:"imToken"`
+}
+
+type ConfirmTOTPResp struct {
+	RecoveryCodes []string        `json:"recoveryCodes"`
+	Login         *AdminLoginResp `json:"login,omitempty"`
+}
+
+type ExportAuditLogReq struct {
+	OperatorUserID string `json:"operatorUserID"`
+	Method         string `json:"method"`
+	Start          int64  `json:"start"`
+	End            int64  `json:"end"`
+	Format         string `json:"format"` // csv or xlsx
 }
 
 type SearchDefaultGroupResp struct {
@@ -17,9 +17,10 @@ package apistruct
 import "github.com/openimsdk/chat/pkg/protocol/sdkwss"
 
 type UserRegisterResp struct {
-	ImToken   string `json:"imToken"`
-	ChatToken string `json:"chatToken"`
-	UserID    string `json:"userID"`
+	ImToken      string `json:"imToken"`
+	ChatToken    string `json:"chatToken"`
+	RefreshToken string `json:"refreshToken"`
+	UserID       string `json:"userID"`
 }
 
 type ChallengeNonceResp struct {
@@ -27,9 +28,16 @@ type ChallengeNonceResp struct {
 }
 
 type LoginResp struct {
-	ImToken   string `json:"imToken"`
-	ChatToken string `json:"chatToken"`
-	UserID    string `json:"userID"`
+	ImToken      string `json:"imToken"`
+	ChatToken    string `json:"chatToken"`
+	RefreshToken string `json:"refreshToken"`
+	UserID       string `json:"userID"`
+}
+
+type RefreshTokenResp struct {
+	ChatToken    string `json:"chatToken"`
+	RefreshToken string `json:"refreshToken"`
+	UserID       string `json:"userID"`
 }
 
 type UpdateUserInfoResp struct{}
@@ -135,3 +143,22 @@ type SendMsg struct {
 	// OfflinePushInfo contains information for offline push notifications.
 	OfflinePushInfo *sdkwss.OfflinePushInfo `json:"offlinePushInfo"`
 }
+
+// SendBusinessNotificationReq is the body of OpenIM's /msg/send_business_notification.
+type SendBusinessNotificationReq struct {
+	// Key lets clients tell business notifications apart.
+	Key string `json:"key"`
+
+	// Data is the notification payload, usually JSON.
+	Data string `json:"data"`
+
+	// SendUserID and RecvUserID are OpenIM user IDs.
+	SendUserID string `json:"sendUserID"`
+	RecvUserID string `json:"recvUserID"`
+}
+
+type SendBusinessNotificationResp struct {
+	ServerMsgID string `json:"serverMsgID"`
+	ClientMsgID string `json:"clientMsgID"`
+	SendTime    int64  `json:"sendTime"`
+}
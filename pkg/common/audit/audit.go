@@ -0,0 +1,108 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package audit formats the operations written to the admin audit log.
+package audit
+
+import (
+	"encoding/json"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/openimsdk/tools/errs"
+)
+
+const (
+	// RequestLimit is the maximum length of the request summary stored in an audit log
+	RequestLimit = 4096
+	Redacted     = "***"
+)
+
+// readPrefixes are the method prefixes of read-only operations, they are not audited.
+var readPrefixes = []string{"Get", "Find", "Search", "Check", "Parse", "List"}
+
+// MethodName returns the method name of a full grpc method.
+func MethodName(fullMethod string) string {
+	return fullMethod[strings.LastIndex(fullMethod, "/")+1:]
+}
+
+// IsRead reports whether the method name is a read-only operation.
+func IsRead(method string) bool {
+	for _, prefix := range readPrefixes {
+		if strings.HasPrefix(method, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// Result returns the code and message of the error a call ended with.
+func Result(err error) (int32, string) {
+	if codeErr, ok := errs.Unwrap(err).(errs.CodeError); ok {
+		return int32(codeErr.Code()), codeErr.Msg()
+	}
+	return int32(errs.ErrInternalServer.Code()), errs.ErrInternalServer.Msg()
+}
+
+// Request returns the request as json with the values of secret fields replaced, truncated to RequestLimit.
+func Request(req any) string {
+	data, err := json.Marshal(req)
+	if err != nil {
+		return ""
+	}
+	var v any
+	if err := json.Unmarshal(data, &v); err != nil {
+		return ""
+	}
+	data, err = json.Marshal(redact(v))
+	if err != nil {
+		return ""
+	}
+	if len(data) <= RequestLimit {
+		return string(data)
+	}
+	data = data[:RequestLimit]
+	for len(data) > 0 && !utf8.Valid(data) {
+		data = data[:len(data)-1]
+	}
+	return string(data) + "..."
+}
+
+func redact(v any) any {
+	switch val := v.(type) {
+	case map[string]any:
+		for key, item := range val {
+			if isSecretField(key) {
+				val[key] = Redacted
+			} else {
+				val[key] = redact(item)
+			}
+		}
+	case []any:
+		for i, item := range val {
+			val[i] = redact(item)
+		}
+	}
+	return v
+}
+
+// isSecretField reports whether a request field holds a password, a token, a secret or a verification code.
+func isSecretField(key string) bool {
+	key = strings.ToLower(key)
+	switch key {
+	case "code", "verifycode", "recoverycode":
+		return true
+	}
+	return strings.Contains(key, "password") || strings.Contains(key, "secret") || strings.Contains(key, "token")
+}
@@ -0,0 +1,50 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package audit
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/openimsdk/protocol/wrapperspb"
+
+	"github.com/openimsdk/chat/pkg/protocol/admin"
+)
+
+func TestRequest(t *testing.T) {
+	var v map[string]any
+	req := &admin.AdminUpdateInfoReq{Account: wrapperspb.String("owl"), Password: wrapperspb.String("pw")}
+	if err := json.Unmarshal([]byte(Request(req)), &v); err != nil {
+		t.Fatal(err)
+	}
+	if v["password"] != Redacted || v["account"] != "owl" {
+		t.Fatalf("update info summary %v", v)
+	}
+	v = nil
+	if err := json.Unmarshal([]byte(Request(&admin.VerifyLoginTOTPReq{ChallengeToken: "t", Code: "123456", RecoveryCode: "r"})), &v); err != nil {
+		t.Fatal(err)
+	}
+	for _, key := range []string{"challengeToken", "code", "recoveryCode"} {
+		if v[key] != Redacted {
+			t.Fatalf("%s not redacted: %v", key, v)
+		}
+	}
+
+	long := Request(&admin.SetClientConfigReq{Config: map[string]string{"k": strings.Repeat("界", RequestLimit)}})
+	if len(long) > RequestLimit+3 || !strings.HasSuffix(long, "...") {
+		t.Fatalf("request not truncated, length %d", len(long))
+	}
+}
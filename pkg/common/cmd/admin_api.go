@@ -21,6 +21,7 @@ func NewAdminApiCmd() *AdminApiCmd {
 		ShareFileName:           &ret.apiConfig.Share,
 		ChatAPIAdminCfgFileName: &ret.apiConfig.ApiConfig,
 		DiscoveryConfigFileName: &ret.apiConfig.Discovery,
+		RedisConfigFileName:     &ret.apiConfig.RedisConfig,
 	}
 	ret.RootCmd = NewRootCmd(program.GetProcessName(), WithConfigMap(ret.configMap))
 	ret.ctx = context.WithValue(context.Background(), "version", config.Version)
@@ -21,6 +21,7 @@ import (
 	"github.com/openimsdk/chat/pkg/common/startrpc"
 	"github.com/openimsdk/tools/system/program"
 	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
 )
 
 type AdminRpcCmd struct {
@@ -54,5 +55,6 @@ func (a *AdminRpcCmd) Exec() error {
 func (a *AdminRpcCmd) runE() error {
 	return startrpc.Start(a.ctx, &a.adminConfig.Discovery, a.adminConfig.RpcConfig.RPC.ListenIP,
 		a.adminConfig.RpcConfig.RPC.RegisterIP, a.adminConfig.RpcConfig.RPC.Ports,
-		a.Index(), a.adminConfig.Share.RpcRegisterName.Admin, &a.adminConfig.Share, &a.adminConfig, admin.Start)
+		a.Index(), a.adminConfig.Share.RpcRegisterName.Admin, &a.adminConfig.Share, &a.adminConfig, admin.Start,
+		grpc.ChainUnaryInterceptor(admin.AuditInterceptor))
 }
@@ -21,6 +21,7 @@ func NewChatApiCmd() *ChatApiCmd {
 		ShareFileName:           &ret.apiConfig.Share,
 		ChatAPIChatCfgFileName:  &ret.apiConfig.ApiConfig,  
 		DiscoveryConfigFileName: &ret.apiConfig.Discovery,
+		RedisConfigFileName:     &ret.apiConfig.RedisConfig,
 	}
 	ret.RootCmd = NewRootCmd(program.GetProcessName(), WithConfigMap(ret.configMap))
 	ret.ctx = context.WithValue(context.Background(), "version", config.Version)
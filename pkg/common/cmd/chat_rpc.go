@@ -21,6 +21,7 @@ import (
 	"github.com/openimsdk/chat/pkg/common/startrpc"
 	"github.com/openimsdk/tools/system/program"
 	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
 )
 
 type ChatRpcCmd struct {
@@ -54,5 +55,6 @@ func (a *ChatRpcCmd) Exec() error {
 func (a *ChatRpcCmd) runE() error {
 	return startrpc.Start(a.ctx, &a.chatConfig.Discovery, a.chatConfig.RpcConfig.RPC.ListenIP,
 		a.chatConfig.RpcConfig.RPC.RegisterIP, a.chatConfig.RpcConfig.RPC.Ports,
-		a.Index(), a.chatConfig.Share.RpcRegisterName.Chat, &a.chatConfig.Share, &a.chatConfig, chat.Start)
+		a.Index(), a.chatConfig.Share.RpcRegisterName.Chat, &a.chatConfig.Share, &a.chatConfig, chat.Start,
+		grpc.ChainUnaryInterceptor(chat.AuditInterceptor))
 }
@@ -2,6 +2,8 @@ package config
 
 import (
 	_ "embed"
+
+	"github.com/openimsdk/chat/pkg/storage"
 	"github.com/openimsdk/tools/db/mongoutil"
 	"github.com/openimsdk/tools/db/redisutil"
 )
@@ -24,6 +26,37 @@ type Share struct {
 	ChatAdmin   []string     `mapstructure:"chatAdmin"`
 	ProxyHeader string       `mapstructure:"proxyHeader"`
 	RedPacket   RpcRedPacket `mapstructure:"redPacket"`
+	Storage     Storage      `mapstructure:"storage"`
+}
+
+type Storage struct {
+	Use   string `mapstructure:"use"`
+	Local struct {
+		Dir string `mapstructure:"dir"`
+	} `mapstructure:"local"`
+	S3 struct {
+		Endpoint        string `mapstructure:"endpoint"`
+		Region          string `mapstructure:"region"`
+		Bucket          string `mapstructure:"bucket"`
+		AccessKeyID     string `mapstructure:"accessKeyID"`
+		SecretAccessKey string `mapstructure:"secretAccessKey"`
+		PathStyle       bool   `mapstructure:"pathStyle"`
+	} `mapstructure:"s3"`
+}
+
+func (s *Storage) Build() *storage.Config {
+	return &storage.Config{
+		Use: s.Use,
+		Dir: s.Local.Dir,
+		S3: storage.S3Config{
+			Endpoint:        s.S3.Endpoint,
+			Region:          s.S3.Region,
+			Bucket:          s.S3.Bucket,
+			AccessKeyID:     s.S3.AccessKeyID,
+			SecretAccessKey: s.S3.SecretAccessKey,
+			PathStyle:       s.S3.PathStyle,
+		},
+	}
 }
 
 type RpcRedPacket struct {
@@ -48,6 +81,10 @@ type API struct {
 		ListenIP string `mapstructure:"listenIP"`
 		Ports    []int  `mapstructure:"ports"`
 	} `mapstructure:"api"`
+	TokenCache struct {
+		Expire int `mapstructure:"expire"`
+		Size   int `mapstructure:"size"`
+	} `mapstructure:"tokenCache"`
 }
 
 type Mongo struct {
@@ -126,25 +163,64 @@ type Chat struct {
 		MaxCount   int    `mapstructure:"maxCount"`
 		SuperCode  string `mapstructure:"superCode"`
 		Len        int    `mapstructure:"len"`
-		Phone      struct {
-			Use string `mapstructure:"use"`
-			Ali struct {
-				Endpoint                     string `mapstructure:"endpoint"`
-				AccessKeyID                  string `mapstructure:"accessKeyId"`
-				AccessKeySecret              string `mapstructure:"accessKeySecret"`
-				SignName                     string `mapstructure:"signName"`
-				VerificationCodeTemplateCode string `mapstructure:"verificationCodeTemplateCode"`
-			} `mapstructure:"ali"`
-		} `mapstructure:"phone"`
-		Mail struct {
+		Phone      Phone  `mapstructure:"phone"`
+		Mail       struct {
 			Enable                  bool   `mapstructure:"enable"`
 			Title                   string `mapstructure:"title"`
 			SenderMail              string `mapstructure:"senderMail"`
+			Username                string `mapstructure:"username"`
 			SenderAuthorizationCode string `mapstructure:"senderAuthorizationCode"`
 			SMTPAddr                string `mapstructure:"smtpAddr"`
 			SMTPPort                int    `mapstructure:"smtpPort"`
+			Security                string `mapstructure:"security"`
+			TemplateDir             string `mapstructure:"templateDir"`
+			DefaultLanguage         string `mapstructure:"defaultLanguage"`
+			Queue                   struct {
+				Enable        bool `mapstructure:"enable"`
+				Workers       int  `mapstructure:"workers"`
+				BufferSize    int  `mapstructure:"bufferSize"`
+				MaxRetry      int  `mapstructure:"maxRetry"`
+				RetryInterval int  `mapstructure:"retryInterval"`
+			} `mapstructure:"queue"`
 		} `mapstructure:"mail"`
 	} `mapstructure:"verifyCode"`
+	LoginNonce struct {
+		Expire int `mapstructure:"expire"`
+		SIWE   struct {
+			Enable    bool   `mapstructure:"enable"`
+			Domain    string `mapstructure:"domain"`
+			URI       string `mapstructure:"uri"`
+			ChainID   int64  `mapstructure:"chainID"`
+			Statement string `mapstructure:"statement"`
+		} `mapstructure:"siwe"`
+	} `mapstructure:"loginNonce"`
+	Post struct {
+		EditWindow       int `mapstructure:"editWindow"`
+		ScheduleInterval int `mapstructure:"scheduleInterval"`
+		ScheduleLease    int `mapstructure:"scheduleLease"`
+	} `mapstructure:"post"`
+	Push struct {
+		Enable           bool `mapstructure:"enable"`
+		Workers          int  `mapstructure:"workers"`
+		BufferSize       int  `mapstructure:"bufferSize"`
+		MaxRetry         int  `mapstructure:"maxRetry"`
+		RetryInterval    int  `mapstructure:"retryInterval"`
+		Timeout          int  `mapstructure:"timeout"`
+		BreakerThreshold int  `mapstructure:"breakerThreshold"`
+		BreakerCooldown  int  `mapstructure:"breakerCooldown"`
+	} `mapstructure:"push"`
+	AccountDeletion struct {
+		GracePeriod int `mapstructure:"gracePeriod"`
+		Interval    int `mapstructure:"interval"`
+		Lease       int `mapstructure:"lease"`
+	} `mapstructure:"accountDeletion"`
+	DataExport struct {
+		Secret      string `mapstructure:"secret"`
+		LinkExpire  int    `mapstructure:"linkExpire"`
+		DownloadURL string `mapstructure:"downloadURL"`
+		Interval    int    `mapstructure:"interval"`
+		Lease       int    `mapstructure:"lease"`
+	} `mapstructure:"dataExport"`
 	LiveKit struct {
 		URL    string `mapstructure:"url"`
 		Key    string `mapstructure:"key"`
@@ -152,6 +228,50 @@ type Chat struct {
 	} `mapstructure:"liveKit"`
 }
 
+type Phone struct {
+	Use    string `mapstructure:"use"`
+	Routes []struct {
+		AreaCodes []string `mapstructure:"areaCodes"`
+		Use       string   `mapstructure:"use"`
+	} `mapstructure:"routes"`
+	Ali struct {
+		Endpoint                     string `mapstructure:"endpoint"`
+		AccessKeyID                  string `mapstructure:"accessKeyId"`
+		AccessKeySecret              string `mapstructure:"accessKeySecret"`
+		SignName                     string `mapstructure:"signName"`
+		VerificationCodeTemplateCode string `mapstructure:"verificationCodeTemplateCode"`
+	} `mapstructure:"ali"`
+	Twilio struct {
+		Endpoint   string `mapstructure:"endpoint"`
+		AccountSID string `mapstructure:"accountSid"`
+		AuthToken  string `mapstructure:"authToken"`
+		From       string `mapstructure:"from"`
+		Message    string `mapstructure:"message"`
+	} `mapstructure:"twilio"`
+	Vonage struct {
+		Endpoint  string `mapstructure:"endpoint"`
+		APIKey    string `mapstructure:"apiKey"`
+		APISecret string `mapstructure:"apiSecret"`
+		From      string `mapstructure:"from"`
+		Message   string `mapstructure:"message"`
+	} `mapstructure:"vonage"`
+	SNS struct {
+		Endpoint        string `mapstructure:"endpoint"`
+		Region          string `mapstructure:"region"`
+		AccessKeyID     string `mapstructure:"accessKeyId"`
+		SecretAccessKey string `mapstructure:"secretAccessKey"`
+		SenderID        string `mapstructure:"senderId"`
+		SMSType         string `mapstructure:"smsType"`
+		Message         string `mapstructure:"message"`
+	} `mapstructure:"sns"`
+	HTTP struct {
+		Method string            `mapstructure:"method"`
+		URL    string            `mapstructure:"url"`
+		Header map[string]string `mapstructure:"header"`
+		Body   string            `mapstructure:"body"`
+	} `mapstructure:"http"`
+}
+
 type Admin struct {
 	RPC struct {
 		RegisterIP string `mapstructure:"registerIP"`
@@ -159,9 +279,26 @@ type Admin struct {
 		Ports      []int  `mapstructure:"ports"`
 	} `mapstructure:"rpc"`
 	TokenPolicy struct {
-		Expire int `mapstructure:"expire"`
+		Expire       int    `mapstructure:"expire"`
+		AccessExpire int    `mapstructure:"accessExpire"`
+		LegacyUntil  string `mapstructure:"legacyUntil"`
 	} `mapstructure:"tokenPolicy"`
-	Secret string `mapstructure:"secret"`
+	Secret  string `mapstructure:"secret"`
+	Signing struct {
+		SigningKey string `mapstructure:"signingKey"`
+		Keys       []struct {
+			Kid            string `mapstructure:"kid"`
+			PrivateKeyFile string `mapstructure:"privateKeyFile"`
+			PublicKeyFile  string `mapstructure:"publicKeyFile"`
+		} `mapstructure:"keys"`
+	} `mapstructure:"signing"`
+	TwoFactor struct {
+		Issuer          string `mapstructure:"issuer"`
+		ChallengeExpire int    `mapstructure:"challengeExpire"`
+	} `mapstructure:"twoFactor"`
+	BlockExpiry struct {
+		Interval int `mapstructure:"interval"`
+	} `mapstructure:"blockExpiry"`
 }
 
 type Log struct {
@@ -83,11 +83,18 @@ const (
 	InvitationCodeAll    = 0 // All
 	InvitationCodeUsed   = 1 // Used
 	InvitationCodeUnused = 2 // Unused
+	InvitationCodeUsable = 3 // Not used up and not expired
+)
+
+const (
+	InvitationReportByInviter  = 1 // Group by inviter
+	InvitationReportByCampaign = 2 // Group by campaign
 )
 
 const (
 	RpcOpUserID   = constant.OpUserID
 	RpcOpUserType = "opUserType"
+	RpcOpClientIP = "opClientIP"
 )
 
 const RpcCustomHeader = constant.RpcCustomHeader
@@ -107,6 +114,12 @@ const (
 
 const CtxApiToken = "api-token"
 
+// CtxSessionID is the gin context key of the session of the token of the request
+const CtxSessionID = "session-id"
+
+// LegacyTokenHeader is set on the responses to requests with a token issued before refresh tokens
+const LegacyTokenHeader = "X-Token-Legacy"
+
 const (
 	EmailRegister = 1
 	PhoneRegister = 2
@@ -164,3 +177,104 @@ const (
 	Pinned   = 1
 	UnPinned = 0
 )
+
+const (
+	NotEdited = 0
+	Edited    = 1
+)
+
+// 帖子可见范围，互关的用户为好友
+const (
+	VisibilityPublic    = 0
+	VisibilityFollowers = 1
+	VisibilityFriends   = 2
+	VisibilityPrivate   = 3
+	VisibilityCustom    = 4
+)
+
+// 草稿状态，定时的草稿到期自动发布
+const (
+	DraftSaved     = 0
+	DraftScheduled = 1
+)
+
+// 账号注销状态，冷静期结束后清除用户数据
+const (
+	AccountDeletionPending = 0
+	AccountDeletionDone    = 1
+)
+
+// 账号封禁结束的原因
+const (
+	BlockEndExpired   = "expired"   // 临时封禁到期
+	BlockEndUnblocked = "unblocked" // 管理员解封
+)
+
+// 管理员权限，超级管理员拥有全部权限，其他管理员的权限来自分配的角色
+const (
+	PermUserBlock       = "user_block"       // 封禁、解封用户
+	PermUserPassword    = "user_password"    // 重置用户密码
+	PermUserManage      = "user_manage"      // 添加、导入、注销用户
+	PermStatistic       = "statistic"        // 查看统计数据
+	PermClientConfig    = "client_config"    // 客户端配置
+	PermApplet          = "applet"           // 小程序
+	PermRegisterDefault = "register_default" // 注册默认好友和群
+	PermInvitationCode  = "invitation_code"  // 邀请码
+	PermForbidden       = "forbidden"        // IP 禁用和用户登录 IP 限制
+	PermPost            = "post"             // 帖子维护
+	PermAuditLog        = "audit_log"        // 查看、导出操作审计日志
+	PermUserSession     = "user_session"     // 查看、下线用户的登录会话
+)
+
+var AdminPermissions = []string{
+	PermUserBlock,
+	PermUserPassword,
+	PermUserManage,
+	PermStatistic,
+	PermClientConfig,
+	PermApplet,
+	PermRegisterDefault,
+	PermInvitationCode,
+	PermForbidden,
+	PermPost,
+	PermAuditLog,
+	PermUserSession,
+}
+
+// 管理员登录时还需要完成的第二因素
+const (
+	TwoFactorNone   = 0
+	TwoFactorVerify = 1 // 已启用 TOTP，需要验证动态码或恢复码
+	TwoFactorEnroll = 2 // 策略要求 2FA 但尚未绑定，需要先完成绑定
+)
+
+// 数据导出状态，压缩包在下载链接过期后删除
+const (
+	DataExportPending    = 0
+	DataExportReady      = 1
+	DataExportDownloaded = 2
+	DataExportExpired    = 3
+)
+
+// 已注销用户在保留记录中的用户ID和在 OpenIM 中的昵称
+const (
+	DeletedUserID       = "deleted_user"
+	DeletedUserNickname = "Deleted User"
+)
+
+// 通知类型
+const (
+	NotificationLike      = 1
+	NotificationComment   = 2
+	NotificationForward   = 3
+	NotificationReference = 4
+	NotificationMention   = 5
+)
+
+const (
+	NotificationUnread = 0
+	NotificationRead   = 1
+)
+
+// 通过 OpenIM 业务通知推送给在线客户端的帖子互动事件
+const PostNotificationKey = "post_notification"
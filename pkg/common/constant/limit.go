@@ -18,4 +18,6 @@ const (
 	ShowNumber             = 1000
 	StatisticsTimeInterval = 60
 	MaxNotificationNum     = 500
+	MaxNotificationPage    = 100
+	MaxPostAllowUserNum    = 500
 )
@@ -0,0 +1,33 @@
+package convert
+
+import (
+	"github.com/openimsdk/tools/utils/datautil"
+
+	"github.com/openimsdk/chat/pkg/common/constant"
+	"github.com/openimsdk/chat/pkg/common/db/table/chat"
+	chatpb "github.com/openimsdk/chat/pkg/protocol/chat"
+)
+
+func ErasedRecordDB2Pb(record *chat.ErasedRecord) *chatpb.ErasedRecord {
+	return &chatpb.ErasedRecord{
+		Collection: record.Collection,
+		Deleted:    record.Deleted,
+		Anonymized: record.Anonymized,
+	}
+}
+
+func AccountDeletionDB2Pb(deletion *chat.AccountDeletion) *chatpb.AccountDeletion {
+	deletionPB := &chatpb.AccountDeletion{
+		UserID:         deletion.UserID,
+		Reason:         deletion.Reason,
+		OperatorUserID: deletion.OperatorUserID,
+		Status:         deletion.Status,
+		RequestTime:    deletion.RequestTime.UnixMilli(),
+		ExecuteAt:      deletion.ExecuteAt.UnixMilli(),
+		Records:        datautil.Slice(deletion.Records, ErasedRecordDB2Pb),
+	}
+	if deletion.Status == constant.AccountDeletionDone {
+		deletionPB.FinishTime = deletion.FinishTime.UnixMilli()
+	}
+	return deletionPB
+}
@@ -0,0 +1,21 @@
+package convert
+
+import (
+	"github.com/openimsdk/chat/pkg/common/db/table/chat"
+	chatpb "github.com/openimsdk/chat/pkg/protocol/chat"
+)
+
+func DataExportDB2Pb(export *chat.DataExport, downloadURL string) *chatpb.DataExport {
+	exportPB := &chatpb.DataExport{
+		ExportID:    export.ExportID,
+		Status:      export.Status,
+		CreateTime:  export.CreateTime.UnixMilli(),
+		Size:        export.Size,
+		DownloadURL: downloadURL,
+	}
+	if !export.FinishTime.IsZero() {
+		exportPB.FinishTime = export.FinishTime.UnixMilli()
+		exportPB.ExpireAt = export.ExpireAt.UnixMilli()
+	}
+	return exportPB
+}
@@ -0,0 +1,28 @@
+package convert
+
+import (
+	"github.com/openimsdk/chat/pkg/common/db/table/chat"
+	chatpb "github.com/openimsdk/chat/pkg/protocol/chat"
+)
+
+func NotificationDB2Pb(notificationDB *chat.Notification, attributes map[string]*chat.Attribute, opUsers int) *chatpb.Notification {
+	notificationPB := &chatpb.Notification{
+		NotificationID: notificationDB.NotificationID,
+		Type:           notificationDB.Type,
+		PostID:         notificationDB.PostID,
+		SourcePostID:   notificationDB.SourcePostID,
+		OpCount:        notificationDB.OpCount,
+		IsRead:         notificationDB.IsRead,
+		CreateTime:     notificationDB.CreateTime.UnixMilli(),
+		UpdateTime:     notificationDB.UpdateTime.UnixMilli(),
+	}
+	for _, userID := range notificationDB.OpUserIDs {
+		if len(notificationPB.OpUsers) == opUsers {
+			break
+		}
+		if attribute, ok := attributes[userID]; ok {
+			notificationPB.OpUsers = append(notificationPB.OpUsers, DbToPbAttribute(attribute))
+		}
+	}
+	return notificationPB
+}
@@ -31,6 +31,9 @@ func PostDB2Pb(postDB *chat.Post) *chatpb.Post {
 	postPB.UserInfo = DbToPbAttribute(postDB.UserInfo)
 	postPB.AtUserInfoList = DbToPbAttributes(postDB.AtUserInfoList)
 	postPB.MediaMsgs = PostMediasDB2Pb(postDB.MediaMsgs)
+	if postDB.RevisionCount > 0 {
+		postPB.IsEdited = constant.Edited
+	}
 	return postPB
 }
 
@@ -38,6 +41,21 @@ func PostsDB2Pb(postsDB []*chat.Post) []*chatpb.Post {
 	return datautil.Slice(postsDB, PostDB2Pb)
 }
 
+func PostRevisionDB2Pb(revisionDB *chat.PostRevision) *chatpb.PostRevision {
+	return &chatpb.PostRevision{
+		PostID:     revisionDB.PostID,
+		Revision:   revisionDB.Revision,
+		Content:    revisionDB.Content,
+		AtUserIds:  revisionDB.AtUserIds,
+		MediaMsgs:  PostMediasDB2Pb(revisionDB.MediaMsgs),
+		CreateTime: revisionDB.CreateTime.UnixMilli(),
+	}
+}
+
+func PostRevisionsDB2Pb(revisionsDB []*chat.PostRevision) []*chatpb.PostRevision {
+	return datautil.Slice(revisionsDB, PostRevisionDB2Pb)
+}
+
 func PostPb2DB(postPB *chatpb.Post) *chat.Post {
 	postDB := &chat.Post{}
 	if err := datautil.CopyStructFields(postDB, postPB); err != nil {
@@ -85,3 +103,26 @@ func PostMediaPb2DB(mediaPB *common.PostMedia) *chat.PostMedia {
 
 	return media
 }
+
+func PostDraftDB2Pb(draftDB *chat.PostDraft) *chatpb.PostDraft {
+	draftPB := &chatpb.PostDraft{
+		DraftID:      draftDB.DraftID,
+		Content:      draftDB.Content,
+		AllowComment: draftDB.AllowComment,
+		AllowForward: draftDB.AllowForward,
+		AtUserIds:    draftDB.AtUserIds,
+		MediaMsgs:    PostMediasDB2Pb(draftDB.MediaMsgs),
+		Visibility:   draftDB.Visibility,
+		AllowUserIDs: draftDB.AllowUserIDs,
+		CreateTime:   draftDB.CreateTime.UnixMilli(),
+		UpdateTime:   draftDB.UpdateTime.UnixMilli(),
+	}
+	if draftDB.Status == constant.DraftScheduled {
+		draftPB.PublishAt = draftDB.PublishAt.UnixMilli()
+	}
+	return draftPB
+}
+
+func PostDraftsDB2Pb(draftsDB []*chat.PostDraft) []*chatpb.PostDraft {
+	return datautil.Slice(draftsDB, PostDraftDB2Pb)
+}
@@ -0,0 +1,55 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"context"
+
+	"github.com/openimsdk/tools/errs"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	ipRuleVersion = "CHAT_IP_RULE_VERSION"
+)
+
+// IPRuleInterface tells the instances holding IP rules in memory that the rules changed.
+type IPRuleInterface interface {
+	GetVersion(ctx context.Context) (int64, error)
+	IncrVersion(ctx context.Context) error
+}
+
+type IPRuleCacheRedis struct {
+	rdb redis.UniversalClient
+}
+
+func NewIPRuleInterface(rdb redis.UniversalClient) *IPRuleCacheRedis {
+	return &IPRuleCacheRedis{rdb: rdb}
+}
+
+func (c *IPRuleCacheRedis) GetVersion(ctx context.Context) (int64, error) {
+	version, err := c.rdb.Get(ctx, ipRuleVersion).Int64()
+	if err == redis.Nil {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, errs.Wrap(err)
+	}
+	return version, nil
+}
+
+func (c *IPRuleCacheRedis) IncrVersion(ctx context.Context) error {
+	return errs.Wrap(c.rdb.Incr(ctx, ipRuleVersion).Err())
+}
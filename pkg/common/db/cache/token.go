@@ -16,6 +16,11 @@ package cache
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"strconv"
+	"time"
 
 	"github.com/openimsdk/tools/utils/stringutil"
 
@@ -25,12 +30,107 @@ import (
 
 const (
 	chatToken = "CHAT_UID_TOKEN_STATUS:"
+	// chatSession holds the Session of each token in chatToken by session ID
+	chatSession = "CHAT_UID_SESSION:"
+	// chatSessionSeen holds the last seen time of each session, written at most once per sessionSeenInterval
+	chatSessionSeen = "CHAT_UID_SESSION_SEEN:"
+	// chatRefresh holds the hash of the current refresh token of each session by session ID
+	chatRefresh = "CHAT_UID_REFRESH:"
+	// chatRefreshToken holds the RefreshToken of every refresh token issued, rotated ones are kept to detect their reuse
+	chatRefreshToken = "CHAT_REFRESH_TOKEN:"
+
+	// TokenRevokeChannel is the pub/sub channel a TokenRevoke is published to whenever tokens are deleted,
+	// the APIs drop the revoked tokens from their local caches.
+	TokenRevokeChannel = "CHAT_TOKEN_REVOKE"
+
+	// sessionSeenInterval is how old the last seen time of a session must be to be written again
+	sessionSeenInterval = time.Minute
 )
 
+// TokenRevoke lists the revoked tokens of a user, all tokens of the user are revoked if Tokens is empty.
+type TokenRevoke struct {
+	UserID string   `json:"userID"`
+	Tokens []string `json:"tokens,omitempty"`
+}
+
+func publishRevoke(ctx context.Context, pipe redis.Pipeliner, userID string, tokens []string) error {
+	data, err := json.Marshal(&TokenRevoke{UserID: userID, Tokens: tokens})
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	pipe.Publish(ctx, TokenRevokeChannel, data)
+	return nil
+}
+
+// swapRefreshScript sets the current refresh token of a session if it still is ARGV[2] and returns the one it was.
+var swapRefreshScript = redis.NewScript(`
+local current = redis.call('HGET', KEYS[1], ARGV[1])
+if not current then
+	current = ''
+end
+if current == ARGV[2] then
+	redis.call('HSET', KEYS[1], ARGV[1], ARGV[3])
+end
+return current
+`)
+
+// Session is the login a token was issued for.
+type Session struct {
+	SessionID  string `json:"sessionID"`
+	Token      string `json:"token"`
+	UserType   int32  `json:"userType"`
+	DeviceID   string `json:"deviceID"`
+	Platform   int32  `json:"platform"`
+	IP         string `json:"ip"`
+	UserAgent  string `json:"userAgent"`
+	CreateTime int64  `json:"createTime"`
+	ExpireTime int64  `json:"expireTime"`
+	// LastSeen is read from chatSessionSeen
+	LastSeen int64 `json:"-"`
+}
+
+// SessionID derives the session ID of a legacy token from the token, newer tokens carry their session ID.
+func SessionID(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:16])
+}
+
+// RefreshToken is the session a refresh token was issued for.
+type RefreshToken struct {
+	UserID    string `json:"userID"`
+	UserType  int32  `json:"userType"`
+	SessionID string `json:"sessionID"`
+}
+
+// RefreshTokenHash is what a refresh token is stored as, the token itself is only known to the client.
+func RefreshTokenHash(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
 type TokenInterface interface {
 	AddTokenFlag(ctx context.Context, userID string, token string, flag int) error
 	GetTokensWithoutError(ctx context.Context, userID string) (map[string]int32, error)
+	// DeleteTokenByUid deletes all tokens and sessions of the user, like the other deletions it publishes a TokenRevoke.
 	DeleteTokenByUid(ctx context.Context, userID string) error
+	// AddSession adds the token of the session with flag, the keys of the user expire after keyExpire
+	// unless another session is added.
+	AddSession(ctx context.Context, userID string, session *Session, flag int, keyExpire time.Duration) error
+	GetSessions(ctx context.Context, userID string) ([]*Session, error)
+	// SetSessionSeen updates the last seen time if the stored one is older than sessionSeenInterval,
+	// the time expires with the tokens of the user and is not written once they expired.
+	SetSessionSeen(ctx context.Context, userID string, sessionID string, seen time.Time) error
+	// DeleteSessions deletes tokens and sessions, a revoked token is no longer accepted.
+	DeleteSessions(ctx context.Context, userID string, tokens []string, sessionIDs []string) error
+	// SetSessionToken replaces oldToken of the session with session.Token.
+	SetSessionToken(ctx context.Context, userID string, session *Session, oldToken string, flag int) error
+	// AddRefreshToken records a refresh token for expire, it is accepted once SwapRefreshToken made it current.
+	AddRefreshToken(ctx context.Context, tokenHash string, refresh *RefreshToken, expire time.Duration) error
+	// GetRefreshToken returns nil if the refresh token was never issued or its session expired.
+	GetRefreshToken(ctx context.Context, tokenHash string) (*RefreshToken, error)
+	// SwapRefreshToken makes newHash the current refresh token of the session if oldHash is,
+	// and returns the hash that was current, empty if the session has none.
+	SwapRefreshToken(ctx context.Context, userID string, sessionID string, oldHash string, newHash string) (string, error)
 }
 
 type TokenCacheRedis struct {
@@ -60,6 +160,135 @@ func (t *TokenCacheRedis) GetTokensWithoutError(ctx context.Context, userID stri
 }
 
 func (t *TokenCacheRedis) DeleteTokenByUid(ctx context.Context, userID string) error {
-	key := chatToken + userID
-	return errs.Wrap(t.rdb.Del(ctx, key).Err())
+	pipe := t.rdb.TxPipeline()
+	pipe.Del(ctx, chatToken+userID, chatSession+userID, chatSessionSeen+userID, chatRefresh+userID)
+	if err := publishRevoke(ctx, pipe, userID, nil); err != nil {
+		return err
+	}
+	_, err := pipe.Exec(ctx)
+	return errs.Wrap(err)
+}
+
+func (t *TokenCacheRedis) AddSession(ctx context.Context, userID string, session *Session, flag int, keyExpire time.Duration) error {
+	data, err := json.Marshal(session)
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	pipe := t.rdb.TxPipeline()
+	pipe.HSet(ctx, chatToken+userID, session.Token, flag)
+	pipe.HSet(ctx, chatSession+userID, session.SessionID, data)
+	pipe.HSet(ctx, chatSessionSeen+userID, session.SessionID, session.CreateTime)
+	for _, key := range []string{chatToken, chatSession, chatSessionSeen, chatRefresh} {
+		pipe.Expire(ctx, key+userID, keyExpire)
+	}
+	_, err = pipe.Exec(ctx)
+	return errs.Wrap(err)
+}
+
+func (t *TokenCacheRedis) GetSessions(ctx context.Context, userID string) ([]*Session, error) {
+	m, err := t.rdb.HGetAll(ctx, chatSession+userID).Result()
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	seen, err := t.rdb.HGetAll(ctx, chatSessionSeen+userID).Result()
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	sessions := make([]*Session, 0, len(m))
+	for sessionID, v := range m {
+		var session Session
+		if err := json.Unmarshal([]byte(v), &session); err != nil {
+			return nil, errs.WrapMsg(err, "invalid session", "userID", userID, "sessionID", sessionID)
+		}
+		session.LastSeen, _ = strconv.ParseInt(seen[sessionID], 10, 64)
+		sessions = append(sessions, &session)
+	}
+	return sessions, nil
+}
+
+func (t *TokenCacheRedis) SetSessionSeen(ctx context.Context, userID string, sessionID string, seen time.Time) error {
+	pipe := t.rdb.Pipeline()
+	last := pipe.HGet(ctx, chatSessionSeen+userID, sessionID)
+	ttl := pipe.PTTL(ctx, chatToken+userID)
+	if _, err := pipe.Exec(ctx); err != nil && err != redis.Nil {
+		return errs.Wrap(err)
+	}
+	// the tokens expired or were deleted, writing would recreate the key without a TTL
+	if ttl.Val() == -2 {
+		return nil
+	}
+	if lastSeen, err := last.Int64(); err == nil && seen.Sub(time.UnixMilli(lastSeen)) < sessionSeenInterval {
+		return nil
+	}
+	pipe = t.rdb.TxPipeline()
+	pipe.HSet(ctx, chatSessionSeen+userID, sessionID, seen.UnixMilli())
+	if ttl.Val() > 0 {
+		pipe.PExpire(ctx, chatSessionSeen+userID, ttl.Val())
+	}
+	_, err := pipe.Exec(ctx)
+	return errs.Wrap(err)
+}
+
+func (t *TokenCacheRedis) DeleteSessions(ctx context.Context, userID string, tokens []string, sessionIDs []string) error {
+	pipe := t.rdb.TxPipeline()
+	if len(tokens) > 0 {
+		pipe.HDel(ctx, chatToken+userID, tokens...)
+		if err := publishRevoke(ctx, pipe, userID, tokens); err != nil {
+			return err
+		}
+	}
+	if len(sessionIDs) > 0 {
+		pipe.HDel(ctx, chatSession+userID, sessionIDs...)
+		pipe.HDel(ctx, chatSessionSeen+userID, sessionIDs...)
+		pipe.HDel(ctx, chatRefresh+userID, sessionIDs...)
+	}
+	_, err := pipe.Exec(ctx)
+	return errs.Wrap(err)
+}
+
+func (t *TokenCacheRedis) SetSessionToken(ctx context.Context, userID string, session *Session, oldToken string, flag int) error {
+	data, err := json.Marshal(session)
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	pipe := t.rdb.TxPipeline()
+	pipe.HDel(ctx, chatToken+userID, oldToken)
+	pipe.HSet(ctx, chatToken+userID, session.Token, flag)
+	pipe.HSet(ctx, chatSession+userID, session.SessionID, data)
+	if err := publishRevoke(ctx, pipe, userID, []string{oldToken}); err != nil {
+		return err
+	}
+	_, err = pipe.Exec(ctx)
+	return errs.Wrap(err)
+}
+
+func (t *TokenCacheRedis) AddRefreshToken(ctx context.Context, tokenHash string, refresh *RefreshToken, expire time.Duration) error {
+	data, err := json.Marshal(refresh)
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	return errs.Wrap(t.rdb.Set(ctx, chatRefreshToken+tokenHash, data, expire).Err())
+}
+
+func (t *TokenCacheRedis) GetRefreshToken(ctx context.Context, tokenHash string) (*RefreshToken, error) {
+	data, err := t.rdb.Get(ctx, chatRefreshToken+tokenHash).Bytes()
+	if err == redis.Nil {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	var refresh RefreshToken
+	if err := json.Unmarshal(data, &refresh); err != nil {
+		return nil, errs.WrapMsg(err, "invalid refresh token record")
+	}
+	return &refresh, nil
+}
+
+func (t *TokenCacheRedis) SwapRefreshToken(ctx context.Context, userID string, sessionID string, oldHash string, newHash string) (string, error) {
+	current, err := swapRefreshScript.Run(ctx, t.rdb, []string{chatRefresh + userID}, sessionID, oldHash, newHash).Text()
+	if err != nil {
+		return "", errs.Wrap(err)
+	}
+	return current, nil
 }
@@ -16,6 +16,7 @@ package database
 
 import (
 	"context"
+	"time"
 
 	"github.com/openimsdk/chat/pkg/common/db/cache"
 	"github.com/openimsdk/chat/pkg/protocol/constant"
@@ -33,7 +34,23 @@ type AdminDatabaseInterface interface {
 	GetAdminUserID(ctx context.Context, userID string) (*admindb.Admin, error)
 	UpdateAdmin(ctx context.Context, userID string, update map[string]any) error
 	ChangePassword(ctx context.Context, userID string, newPassword string) error
+	RehashPassword(ctx context.Context, account string, oldPassword string, newPassword string) error
+	UpdateAdminByAccount(ctx context.Context, account string, update map[string]any) error
+	UseTOTPStep(ctx context.Context, account string, step int64) (bool, error)
+	UseRecoveryCode(ctx context.Context, account string, codeHash string) (bool, error)
+	AddTOTPFailure(ctx context.Context, account string, limit int32, lockUntil time.Time) (bool, error)
 	AddAdminAccount(ctx context.Context, admin []*admindb.Admin) error
+	CreateAdminRole(ctx context.Context, roles []*admindb.AdminRole) error
+	UpdateAdminRole(ctx context.Context, roleID string, update map[string]any) error
+	// DelAdminRole deletes the roles and removes them from the admins holding them
+	DelAdminRole(ctx context.Context, roleIDs []string) error
+	// SetLegacyAdminRoles gives the roles to admins that have no roles field yet, it returns how many were changed
+	SetLegacyAdminRoles(ctx context.Context, roleIDs []string) (int64, error)
+	GetAdminRole(ctx context.Context, roleID string) (*admindb.AdminRole, error)
+	FindAdminRole(ctx context.Context, roleIDs []string) ([]*admindb.AdminRole, error)
+	SearchAdminRole(ctx context.Context, keyword string, pagination pagination.Pagination) (int64, []*admindb.AdminRole, error)
+	CreateAuditLog(ctx context.Context, logs []*admindb.AuditLog) error
+	SearchAuditLog(ctx context.Context, operatorUserID string, method string, start time.Time, end time.Time, pagination pagination.Pagination) (int64, []*admindb.AuditLog, error)
 	DelAdminAccount(ctx context.Context, userIDs []string) error
 	SearchAdminAccount(ctx context.Context, pagination pagination.Pagination) (int64, []*admindb.Admin, error)
 	CreateApplet(ctx context.Context, applets []*admindb.Applet) error
@@ -50,11 +67,18 @@ type AdminDatabaseInterface interface {
 	DelInvitationRegister(ctx context.Context, codes []string) error
 	UpdateInvitationRegister(ctx context.Context, code string, fields map[string]any) error
 	CreatInvitationRegister(ctx context.Context, invitationRegisters []*admindb.InvitationRegister) error
-	SearchInvitationRegister(ctx context.Context, keyword string, state int32, userIDs []string, codes []string, pagination pagination.Pagination) (int64, []*admindb.InvitationRegister, error)
+	SearchInvitationRegister(ctx context.Context, keyword string, state int32, userIDs []string, codes []string, inviterUserID string, campaign string, pagination pagination.Pagination) (int64, []*admindb.InvitationRegister, error)
+	// UseInvitationRegister counts the registration of userID on the code and records it, false if the code is used up or expired
+	UseInvitationRegister(ctx context.Context, register *admindb.InvitationRegister, userID string) (bool, error)
+	// ReleaseInvitationRegister takes back the registration of userID on the code, false if it was not counted
+	ReleaseInvitationRegister(ctx context.Context, code string, userID string) (bool, error)
+	SearchInvitationUse(ctx context.Context, code string, userID string, inviterUserID string, campaign string, pagination pagination.Pagination) (int64, []*admindb.InvitationUse, error)
+	InvitationReport(ctx context.Context, groupBy int32, keys []string, start time.Time, end time.Time, pagination pagination.Pagination) (int64, []*admindb.InvitationReport, error)
 	SearchIPForbidden(ctx context.Context, keyword string, state int32, pagination pagination.Pagination) (int64, []*admindb.IPForbidden, error)
 	AddIPForbidden(ctx context.Context, ms []*admindb.IPForbidden) error
 	FindIPForbidden(ctx context.Context, ms []string) ([]*admindb.IPForbidden, error)
 	DelIPForbidden(ctx context.Context, ips []string) error
+	FindAllIPForbidden(ctx context.Context) ([]*admindb.IPForbidden, error)
 	FindDefaultFriend(ctx context.Context, userIDs []string) ([]string, error)
 	AddDefaultFriend(ctx context.Context, ms []*admindb.RegisterAddFriend) error
 	DelDefaultFriend(ctx context.Context, userIDs []string) error
@@ -69,13 +93,29 @@ type AdminDatabaseInterface interface {
 	DelBlockUser(ctx context.Context, userID []string) error
 	SearchBlockUser(ctx context.Context, keyword string, pagination pagination.Pagination) (int64, []*admindb.ForbiddenAccount, error)
 	FindBlockUser(ctx context.Context, userIDs []string) ([]*admindb.ForbiddenAccount, error)
+	FindExpiredBlockUser(ctx context.Context, now time.Time, limit int64) ([]*admindb.ForbiddenAccount, error)
+	// EndBlockUser removes the block and records it in the history, false if it was already removed
+	EndBlockUser(ctx context.Context, block *admindb.ForbiddenAccount, endReason string, endOperatorUserID string) (bool, error)
+	SearchBlockHistory(ctx context.Context, userID string, pagination pagination.Pagination) (int64, []*admindb.ForbiddenAccountHistory, error)
 	SearchUserLimitLogin(ctx context.Context, keyword string, pagination pagination.Pagination) (int64, []*admindb.LimitUserLoginIP, error)
 	AddUserLimitLogin(ctx context.Context, ms []*admindb.LimitUserLoginIP) error
 	DelUserLimitLogin(ctx context.Context, ms []*admindb.LimitUserLoginIP) error
 	CountLimitUserLoginIP(ctx context.Context, userID string) (uint32, error)
 	GetLimitUserLoginIP(ctx context.Context, userID string, ip string) (*admindb.LimitUserLoginIP, error)
-	CacheToken(ctx context.Context, userID string, token string) error
+	FindAllUserLimitLogin(ctx context.Context) ([]*admindb.LimitUserLoginIP, error)
+	// GetIPRuleVersion changes whenever the IP forbidden list or a user login IP limit changes
+	GetIPRuleVersion(ctx context.Context) (int64, error)
+	// CacheSession stores the token with its session, the sessions of a user idle for keyExpire are dropped.
+	CacheSession(ctx context.Context, userID string, session *cache.Session, keyExpire time.Duration) error
 	GetTokens(ctx context.Context, userID string) (map[string]int32, error)
+	GetSessions(ctx context.Context, userID string) ([]*cache.Session, error)
+	SetSessionSeen(ctx context.Context, userID string, sessionID string, seen time.Time) error
+	DeleteSessions(ctx context.Context, userID string, tokens []string, sessionIDs []string) error
+	// SetSessionToken replaces oldToken of the session with the rotated session.Token.
+	SetSessionToken(ctx context.Context, userID string, session *cache.Session, oldToken string) error
+	AddRefreshToken(ctx context.Context, tokenHash string, refresh *cache.RefreshToken, expire time.Duration) error
+	GetRefreshToken(ctx context.Context, tokenHash string) (*cache.RefreshToken, error)
+	SwapRefreshToken(ctx context.Context, userID string, sessionID string, oldHash string, newHash string) (string, error)
 	DeleteToken(ctx context.Context, userID string) error
 }
 
@@ -92,6 +132,10 @@ func NewAdminDatabase(cli *mongoutil.Client, rdb redis.UniversalClient) (AdminDa
 	if err != nil {
 		return nil, err
 	}
+	forbiddenAccountHistory, err := admin.NewForbiddenAccountHistory(cli.GetDB())
+	if err != nil {
+		return nil, err
+	}
 	limitUserLoginIP, err := admin.NewLimitUserLoginIP(cli.GetDB())
 	if err != nil {
 		return nil, err
@@ -100,6 +144,10 @@ func NewAdminDatabase(cli *mongoutil.Client, rdb redis.UniversalClient) (AdminDa
 	if err != nil {
 		return nil, err
 	}
+	invitationUse, err := admin.NewInvitationUse(cli.GetDB())
+	if err != nil {
+		return nil, err
+	}
 	registerAddFriend, err := admin.NewRegisterAddFriend(cli.GetDB())
 	if err != nil {
 		return nil, err
@@ -116,18 +164,31 @@ func NewAdminDatabase(cli *mongoutil.Client, rdb redis.UniversalClient) (AdminDa
 	if err != nil {
 		return nil, err
 	}
+	adminRole, err := admin.NewAdminRole(cli.GetDB())
+	if err != nil {
+		return nil, err
+	}
+	auditLog, err := admin.NewAuditLog(cli.GetDB())
+	if err != nil {
+		return nil, err
+	}
 	return &AdminDatabase{
 		tx:                 cli.GetTx(),
 		admin:              a,
 		ipForbidden:        forbidden,
 		forbiddenAccount:   forbiddenAccount,
+		blockHistory:       forbiddenAccountHistory,
 		limitUserLoginIP:   limitUserLoginIP,
 		invitationRegister: invitationRegister,
+		invitationUse:      invitationUse,
 		registerAddFriend:  registerAddFriend,
 		registerAddGroup:   registerAddGroup,
 		applet:             applet,
 		clientConfig:       clientConfig,
+		adminRole:          adminRole,
+		auditLog:           auditLog,
 		cache:              cache.NewTokenInterface(rdb),
+		ipRule:             cache.NewIPRuleInterface(rdb),
 	}, nil
 }
 
@@ -136,13 +197,18 @@ type AdminDatabase struct {
 	admin              admindb.AdminInterface
 	ipForbidden        admindb.IPForbiddenInterface
 	forbiddenAccount   admindb.ForbiddenAccountInterface
+	blockHistory       admindb.ForbiddenAccountHistoryInterface
 	limitUserLoginIP   admindb.LimitUserLoginIPInterface
 	invitationRegister admindb.InvitationRegisterInterface
+	invitationUse      admindb.InvitationUseInterface
 	registerAddFriend  admindb.RegisterAddFriendInterface
 	registerAddGroup   admindb.RegisterAddGroupInterface
 	applet             admindb.AppletInterface
 	clientConfig       admindb.ClientConfigInterface
+	adminRole          admindb.AdminRoleInterface
+	auditLog           admindb.AuditLogInterface
 	cache              cache.TokenInterface
+	ipRule             cache.IPRuleInterface
 }
 
 func (o *AdminDatabase) GetAdmin(ctx context.Context, account string) (*admindb.Admin, error) {
@@ -161,10 +227,71 @@ func (o *AdminDatabase) ChangePassword(ctx context.Context, userID string, newPa
 	return o.admin.ChangePassword(ctx, userID, newPassword)
 }
 
+func (o *AdminDatabase) RehashPassword(ctx context.Context, account string, oldPassword string, newPassword string) error {
+	return o.admin.RehashPassword(ctx, account, oldPassword, newPassword)
+}
+
+func (o *AdminDatabase) UpdateAdminByAccount(ctx context.Context, account string, update map[string]any) error {
+	return o.admin.UpdateByAccount(ctx, account, update)
+}
+
+func (o *AdminDatabase) UseTOTPStep(ctx context.Context, account string, step int64) (bool, error) {
+	return o.admin.UseTOTPStep(ctx, account, step)
+}
+
+func (o *AdminDatabase) UseRecoveryCode(ctx context.Context, account string, codeHash string) (bool, error) {
+	return o.admin.UseRecoveryCode(ctx, account, codeHash)
+}
+
+func (o *AdminDatabase) AddTOTPFailure(ctx context.Context, account string, limit int32, lockUntil time.Time) (bool, error) {
+	return o.admin.AddTOTPFailure(ctx, account, limit, lockUntil)
+}
+
 func (o *AdminDatabase) AddAdminAccount(ctx context.Context, admins []*admindb.Admin) error {
 	return o.admin.Create(ctx, admins)
 }
 
+func (o *AdminDatabase) CreateAdminRole(ctx context.Context, roles []*admindb.AdminRole) error {
+	return o.adminRole.Create(ctx, roles)
+}
+
+func (o *AdminDatabase) UpdateAdminRole(ctx context.Context, roleID string, update map[string]any) error {
+	return o.adminRole.Update(ctx, roleID, update)
+}
+
+func (o *AdminDatabase) SetLegacyAdminRoles(ctx context.Context, roleIDs []string) (int64, error) {
+	return o.admin.SetMissingRoles(ctx, roleIDs)
+}
+
+func (o *AdminDatabase) DelAdminRole(ctx context.Context, roleIDs []string) error {
+	return o.tx.Transaction(ctx, func(ctx context.Context) error {
+		if err := o.admin.PullRoles(ctx, roleIDs); err != nil {
+			return err
+		}
+		return o.adminRole.Delete(ctx, roleIDs)
+	})
+}
+
+func (o *AdminDatabase) GetAdminRole(ctx context.Context, roleID string) (*admindb.AdminRole, error) {
+	return o.adminRole.Take(ctx, roleID)
+}
+
+func (o *AdminDatabase) FindAdminRole(ctx context.Context, roleIDs []string) ([]*admindb.AdminRole, error) {
+	return o.adminRole.Find(ctx, roleIDs)
+}
+
+func (o *AdminDatabase) SearchAdminRole(ctx context.Context, keyword string, pagination pagination.Pagination) (int64, []*admindb.AdminRole, error) {
+	return o.adminRole.Search(ctx, keyword, pagination)
+}
+
+func (o *AdminDatabase) CreateAuditLog(ctx context.Context, logs []*admindb.AuditLog) error {
+	return o.auditLog.Create(ctx, logs)
+}
+
+func (o *AdminDatabase) SearchAuditLog(ctx context.Context, operatorUserID string, method string, start time.Time, end time.Time, pagination pagination.Pagination) (int64, []*admindb.AuditLog, error) {
+	return o.auditLog.Search(ctx, operatorUserID, method, start, end, pagination)
+}
+
 func (o *AdminDatabase) DelAdminAccount(ctx context.Context, userIDs []string) error {
 	return o.admin.Delete(ctx, userIDs)
 }
@@ -229,8 +356,56 @@ func (o *AdminDatabase) CreatInvitationRegister(ctx context.Context, invitationR
 	return o.invitationRegister.Create(ctx, invitationRegisters)
 }
 
-func (o *AdminDatabase) SearchInvitationRegister(ctx context.Context, keyword string, state int32, userIDs []string, codes []string, pagination pagination.Pagination) (int64, []*admindb.InvitationRegister, error) {
-	return o.invitationRegister.Search(ctx, keyword, state, userIDs, codes, pagination)
+func (o *AdminDatabase) SearchInvitationRegister(ctx context.Context, keyword string, state int32, userIDs []string, codes []string, inviterUserID string, campaign string, pagination pagination.Pagination) (int64, []*admindb.InvitationRegister, error) {
+	return o.invitationRegister.Search(ctx, keyword, state, userIDs, codes, inviterUserID, campaign, pagination)
+}
+
+func (o *AdminDatabase) UseInvitationRegister(ctx context.Context, register *admindb.InvitationRegister, userID string) (bool, error) {
+	var used bool
+	err := o.tx.Transaction(ctx, func(ctx context.Context) error {
+		now := time.Now()
+		ok, err := o.invitationRegister.Use(ctx, register.InvitationCode, userID, now)
+		if err != nil || !ok {
+			return err
+		}
+		used = true
+		return o.invitationUse.Create(ctx, []*admindb.InvitationUse{{
+			InvitationCode: register.InvitationCode,
+			UserID:         userID,
+			InviterUserID:  register.InviterUserID,
+			Campaign:       register.Campaign,
+			CreateTime:     now,
+		}})
+	})
+	if err != nil {
+		return false, err
+	}
+	return used, nil
+}
+
+func (o *AdminDatabase) ReleaseInvitationRegister(ctx context.Context, code string, userID string) (bool, error) {
+	var released bool
+	err := o.tx.Transaction(ctx, func(ctx context.Context) error {
+		// the use row tells whether the registration was counted, so a repeated release changes nothing
+		deleted, err := o.invitationUse.Delete(ctx, code, userID)
+		if err != nil || deleted == 0 {
+			return err
+		}
+		released = true
+		return o.invitationRegister.Release(ctx, code, userID)
+	})
+	if err != nil {
+		return false, err
+	}
+	return released, nil
+}
+
+func (o *AdminDatabase) SearchInvitationUse(ctx context.Context, code string, userID string, inviterUserID string, campaign string, pagination pagination.Pagination) (int64, []*admindb.InvitationUse, error) {
+	return o.invitationUse.Search(ctx, code, userID, inviterUserID, campaign, pagination)
+}
+
+func (o *AdminDatabase) InvitationReport(ctx context.Context, groupBy int32, keys []string, start time.Time, end time.Time, pagination pagination.Pagination) (int64, []*admindb.InvitationReport, error) {
+	return o.invitationUse.Report(ctx, groupBy, keys, start, end, pagination)
 }
 
 func (o *AdminDatabase) SearchIPForbidden(ctx context.Context, keyword string, state int32, pagination pagination.Pagination) (int64, []*admindb.IPForbidden, error) {
@@ -238,7 +413,10 @@ func (o *AdminDatabase) SearchIPForbidden(ctx context.Context, keyword string, s
 }
 
 func (o *AdminDatabase) AddIPForbidden(ctx context.Context, ms []*admindb.IPForbidden) error {
-	return o.ipForbidden.Create(ctx, ms)
+	if err := o.ipForbidden.Create(ctx, ms); err != nil {
+		return err
+	}
+	return o.ipRule.IncrVersion(ctx)
 }
 
 func (o *AdminDatabase) FindIPForbidden(ctx context.Context, ms []string) ([]*admindb.IPForbidden, error) {
@@ -246,7 +424,14 @@ func (o *AdminDatabase) FindIPForbidden(ctx context.Context, ms []string) ([]*ad
 }
 
 func (o *AdminDatabase) DelIPForbidden(ctx context.Context, ips []string) error {
-	return o.ipForbidden.Delete(ctx, ips)
+	if err := o.ipForbidden.Delete(ctx, ips); err != nil {
+		return err
+	}
+	return o.ipRule.IncrVersion(ctx)
+}
+
+func (o *AdminDatabase) FindAllIPForbidden(ctx context.Context) ([]*admindb.IPForbidden, error) {
+	return o.ipForbidden.FindAll(ctx)
 }
 
 func (o *AdminDatabase) FindDefaultFriend(ctx context.Context, userIDs []string) ([]string, error) {
@@ -305,16 +490,55 @@ func (o *AdminDatabase) FindBlockUser(ctx context.Context, userIDs []string) ([]
 	return o.forbiddenAccount.Find(ctx, userIDs)
 }
 
+func (o *AdminDatabase) FindExpiredBlockUser(ctx context.Context, now time.Time, limit int64) ([]*admindb.ForbiddenAccount, error) {
+	return o.forbiddenAccount.FindExpired(ctx, now, limit)
+}
+
+func (o *AdminDatabase) EndBlockUser(ctx context.Context, block *admindb.ForbiddenAccount, endReason string, endOperatorUserID string) (bool, error) {
+	var ended bool
+	err := o.tx.Transaction(ctx, func(ctx context.Context) error {
+		deleted, err := o.forbiddenAccount.DeleteBlock(ctx, block.UserID, block.CreateTime)
+		if err != nil || !deleted {
+			return err
+		}
+		ended = true
+		return o.blockHistory.Create(ctx, []*admindb.ForbiddenAccountHistory{{
+			UserID:            block.UserID,
+			Reason:            block.Reason,
+			OperatorUserID:    block.OperatorUserID,
+			CreateTime:        block.CreateTime,
+			ExpireTime:        block.ExpireTime,
+			EndTime:           time.Now(),
+			EndReason:         endReason,
+			EndOperatorUserID: endOperatorUserID,
+		}})
+	})
+	if err != nil {
+		return false, err
+	}
+	return ended, nil
+}
+
+func (o *AdminDatabase) SearchBlockHistory(ctx context.Context, userID string, pagination pagination.Pagination) (int64, []*admindb.ForbiddenAccountHistory, error) {
+	return o.blockHistory.Search(ctx, userID, pagination)
+}
+
 func (o *AdminDatabase) SearchUserLimitLogin(ctx context.Context, keyword string, pagination pagination.Pagination) (int64, []*admindb.LimitUserLoginIP, error) {
 	return o.limitUserLoginIP.Search(ctx, keyword, pagination)
 }
 
 func (o *AdminDatabase) AddUserLimitLogin(ctx context.Context, ms []*admindb.LimitUserLoginIP) error {
-	return o.limitUserLoginIP.Create(ctx, ms)
+	if err := o.limitUserLoginIP.Create(ctx, ms); err != nil {
+		return err
+	}
+	return o.ipRule.IncrVersion(ctx)
 }
 
 func (o *AdminDatabase) DelUserLimitLogin(ctx context.Context, ms []*admindb.LimitUserLoginIP) error {
-	return o.limitUserLoginIP.Delete(ctx, ms)
+	if err := o.limitUserLoginIP.Delete(ctx, ms); err != nil {
+		return err
+	}
+	return o.ipRule.IncrVersion(ctx)
 }
 
 func (o *AdminDatabase) CountLimitUserLoginIP(ctx context.Context, userID string) (uint32, error) {
@@ -325,14 +549,50 @@ func (o *AdminDatabase) GetLimitUserLoginIP(ctx context.Context, userID string,
 	return o.limitUserLoginIP.Take(ctx, userID, ip)
 }
 
-func (o *AdminDatabase) CacheToken(ctx context.Context, userID string, token string) error {
-	return o.cache.AddTokenFlag(ctx, userID, token, constant.NormalToken)
+func (o *AdminDatabase) FindAllUserLimitLogin(ctx context.Context) ([]*admindb.LimitUserLoginIP, error) {
+	return o.limitUserLoginIP.FindAll(ctx)
+}
+
+func (o *AdminDatabase) GetIPRuleVersion(ctx context.Context) (int64, error) {
+	return o.ipRule.GetVersion(ctx)
+}
+
+func (o *AdminDatabase) CacheSession(ctx context.Context, userID string, session *cache.Session, keyExpire time.Duration) error {
+	return o.cache.AddSession(ctx, userID, session, constant.NormalToken, keyExpire)
 }
 
 func (o *AdminDatabase) GetTokens(ctx context.Context, userID string) (map[string]int32, error) {
 	return o.cache.GetTokensWithoutError(ctx, userID)
 }
 
+func (o *AdminDatabase) GetSessions(ctx context.Context, userID string) ([]*cache.Session, error) {
+	return o.cache.GetSessions(ctx, userID)
+}
+
+func (o *AdminDatabase) SetSessionSeen(ctx context.Context, userID string, sessionID string, seen time.Time) error {
+	return o.cache.SetSessionSeen(ctx, userID, sessionID, seen)
+}
+
+func (o *AdminDatabase) DeleteSessions(ctx context.Context, userID string, tokens []string, sessionIDs []string) error {
+	return o.cache.DeleteSessions(ctx, userID, tokens, sessionIDs)
+}
+
+func (o *AdminDatabase) SetSessionToken(ctx context.Context, userID string, session *cache.Session, oldToken string) error {
+	return o.cache.SetSessionToken(ctx, userID, session, oldToken, constant.NormalToken)
+}
+
+func (o *AdminDatabase) AddRefreshToken(ctx context.Context, tokenHash string, refresh *cache.RefreshToken, expire time.Duration) error {
+	return o.cache.AddRefreshToken(ctx, tokenHash, refresh, expire)
+}
+
+func (o *AdminDatabase) GetRefreshToken(ctx context.Context, tokenHash string) (*cache.RefreshToken, error) {
+	return o.cache.GetRefreshToken(ctx, tokenHash)
+}
+
+func (o *AdminDatabase) SwapRefreshToken(ctx context.Context, userID string, sessionID string, oldHash string, newHash string) (string, error) {
+	return o.cache.SwapRefreshToken(ctx, userID, sessionID, oldHash, newHash)
+}
+
 func (o *AdminDatabase) DeleteToken(ctx context.Context, userID string) error {
 	return o.cache.DeleteTokenByUid(ctx, userID)
 }
@@ -21,8 +21,12 @@ import (
 	"github.com/openimsdk/tools/db/mongoutil"
 	"github.com/openimsdk/tools/db/pagination"
 	"github.com/openimsdk/tools/db/tx"
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/utils/datautil"
+	"go.mongodb.org/mongo-driver/mongo"
 
 	"github.com/openimsdk/chat/pkg/common/constant"
+	"github.com/openimsdk/chat/pkg/common/db/dbutil"
 	admindb "github.com/openimsdk/chat/pkg/common/db/model/admin"
 	"github.com/openimsdk/chat/pkg/common/db/model/chat"
 	"github.com/openimsdk/chat/pkg/common/db/table/admin"
@@ -46,6 +50,14 @@ type ChatDatabaseInterface interface {
 	UpdateVerifyCodeIncrCount(ctx context.Context, id string) error
 	TakeLastVerifyCode(ctx context.Context, account string) (*chatdb.VerifyCode, error)
 	DelVerifyCode(ctx context.Context, id string) error
+	AddLoginNonce(ctx context.Context, nonce *chatdb.LoginNonce) error
+	TakeLoginNonce(ctx context.Context, nonce string) (*chatdb.LoginNonce, error)
+	UseLoginNonce(ctx context.Context, nonce string) (bool, error)
+	AddLinkedWallet(ctx context.Context, wallet *chatdb.LinkedWallet) error
+	GetLinkedWallet(ctx context.Context, address string) (*chatdb.LinkedWallet, error)
+	FindLinkedWallets(ctx context.Context, userID string) ([]*chatdb.LinkedWallet, error)
+	RemoveLinkedWallet(ctx context.Context, userID string, address string) error
+	PromoteLinkedWallet(ctx context.Context, wallet *chatdb.LinkedWallet) error
 	RegisterUser(ctx context.Context, register *chatdb.Register, account *chatdb.Account, attribute *chatdb.Attribute) error
 	GetAllUserID(ctx context.Context, pagination pagination.Pagination) (int64, []string, error)
 	GetAccount(ctx context.Context, userID string) (*chatdb.Account, error)
@@ -68,7 +80,10 @@ type ChatDatabaseInterface interface {
 	CreatePost(ctx context.Context, post []*chatdb.PostDB) error
 	UpdatePost(ctx context.Context, postID string, data map[string]any) error
 	DeletePost(ctx context.Context, postIDs []string) error
+	EditPost(ctx context.Context, revision *chatdb.PostRevision, data map[string]any) error
+	GetPostRevisions(ctx context.Context, postID string) ([]*chatdb.PostRevision, error)
 	GetPostByID(ctx context.Context, postID string) (*chatdb.Post, error)
+	GetVisiblePostByID(ctx context.Context, postID string) (*chatdb.Post, error)
 	GetPostByForwardPostID(ctx context.Context, userID, forwardPostID string) (*chatdb.Post, error)
 
 	GetPostsByCursorAndUserIDs(ctx context.Context, cursor int64, userIDs []string, count int64) ([]*chatdb.Post, string, error)
@@ -83,11 +98,51 @@ type ChatDatabaseInterface interface {
 	CreateUserPostRelation(ctx context.Context, relations []*chatdb.UserPostRelation) error
 	UpdateUserPostRelation(ctx context.Context, userID, postID string, data map[string]any) error
 	DeleteUserPostRelation(ctx context.Context, userID, postID string) error
+	ChangeUserPostRelation(ctx context.Context, userID, postID string, field string, value int32) (bool, error)
+	IncrPostCount(ctx context.Context, postID string, field string, delta int64) error
+	ReconcilePostCounts(ctx context.Context, postIDs []string) (int64, error)
+	BackfillPostCounts(ctx context.Context) (int64, error)
 
 	GetPostIDsByLike(ctx context.Context, userID string) ([]string, error)
 	GetPostIDsByCollect(ctx context.Context, userID string) ([]string, error)
 	GetPinnedPostByUserID(ctx context.Context, userID string) (*chatdb.Post, error)
 
+	AddNotifications(ctx context.Context, notifications []*chatdb.Notification) error
+	AggregateNotification(ctx context.Context, notification *chatdb.Notification, opUserID string) error
+	GetNotificationsByCursor(ctx context.Context, userID string, cursor int64, count int64) ([]*chatdb.Notification, string, error)
+	CountUnreadNotifications(ctx context.Context, userID string) (int64, error)
+	MarkNotificationsRead(ctx context.Context, userID string, notificationIDs []string) error
+
+	CreatePostDraft(ctx context.Context, draft *chatdb.PostDraft) error
+	GetPostDraft(ctx context.Context, draftID string) (*chatdb.PostDraft, error)
+	UpdatePostDraft(ctx context.Context, draftID string, data map[string]any) (bool, error)
+	DeletePostDrafts(ctx context.Context, userID string, draftIDs []string) error
+	FindPostDrafts(ctx context.Context, userID string, pagination pagination.Pagination) (int64, []*chatdb.PostDraft, error)
+	ClaimDuePostDraft(ctx context.Context, owner string, lease time.Duration) (*chatdb.PostDraft, error)
+	SetPostDraftPostID(ctx context.Context, draftID string, owner string, postID string) (bool, error)
+	PublishPostDraft(ctx context.Context, draftID string, owner string, post *chatdb.PostDB) (bool, error)
+
+	CreateAccountDeletion(ctx context.Context, deletion *chatdb.AccountDeletion) error
+	GetAccountDeletion(ctx context.Context, userID string) (*chatdb.AccountDeletion, error)
+	UpdateAccountDeletion(ctx context.Context, userID string, data map[string]any) (bool, error)
+	CancelAccountDeletion(ctx context.Context, userID string) (bool, error)
+	ClaimDueAccountDeletion(ctx context.Context, owner string, lease time.Duration) (*chatdb.AccountDeletion, error)
+	EraseUser(ctx context.Context, userID string, owner string, deleteFile func(ctx context.Context, key string) error) ([]*chatdb.ErasedRecord, error)
+
+	CreateDataExport(ctx context.Context, export *chatdb.DataExport) error
+	GetDataExport(ctx context.Context, exportID string) (*chatdb.DataExport, error)
+	GetLatestDataExport(ctx context.Context, userID string) (*chatdb.DataExport, error)
+	ClaimPendingDataExport(ctx context.Context, owner string, lease time.Duration) (*chatdb.DataExport, error)
+	FinishDataExport(ctx context.Context, exportID string, owner string, key string, size int64, expireAt time.Time) (bool, error)
+	ClaimDataExportDownload(ctx context.Context, exportID string, owner string, lease time.Duration) (*chatdb.DataExport, error)
+	ConsumeDataExport(ctx context.Context, exportID string, owner string) (*chatdb.DataExport, error)
+	ReleaseDataExportDownload(ctx context.Context, exportID string, owner string) error
+	FindExpiredDataExports(ctx context.Context, limit int64) ([]*chatdb.DataExport, error)
+	ExpireDataExport(ctx context.Context, exportID string) error
+	FindUserPosts(ctx context.Context, userID string) ([]*chatdb.PostDB, error)
+	FindUserPostRelations(ctx context.Context, userID string) ([]*chatdb.UserPostRelation, error)
+	FindUserLoginRecords(ctx context.Context, userID string) ([]*chatdb.UserLoginRecord, error)
+
 	GetVersionConfig(ctx context.Context) (*chatdb.AppVersionConfig, error)
 	GetFakeUserConfig(ctx context.Context) (*chatdb.AppFakeUserConfig, error)
 }
@@ -113,6 +168,14 @@ func NewChatDatabase(cli *mongoutil.Client) (ChatDatabaseInterface, error) {
 	if err != nil {
 		return nil, err
 	}
+	loginNonce, err := chat.NewLoginNonce(cli.GetDB())
+	if err != nil {
+		return nil, err
+	}
+	linkedWallet, err := chat.NewLinkedWallet(cli.GetDB())
+	if err != nil {
+		return nil, err
+	}
 	contact, err := chat.NewContact(cli.GetDB())
 	if err != nil {
 		return nil, err
@@ -121,12 +184,57 @@ func NewChatDatabase(cli *mongoutil.Client) (ChatDatabaseInterface, error) {
 	if err != nil {
 		return nil, err
 	}
+	forbiddenAccountHistory, err := admindb.NewForbiddenAccountHistory(cli.GetDB())
+	if err != nil {
+		return nil, err
+	}
+	limitUserLoginIP, err := admindb.NewLimitUserLoginIP(cli.GetDB())
+	if err != nil {
+		return nil, err
+	}
+	registerAddFriend, err := admindb.NewRegisterAddFriend(cli.GetDB())
+	if err != nil {
+		return nil, err
+	}
+	invitationRegister, err := admindb.NewInvitationRegister(cli.GetDB())
+	if err != nil {
+		return nil, err
+	}
+	invitationUse, err := admindb.NewInvitationUse(cli.GetDB())
+	if err != nil {
+		return nil, err
+	}
 
 	post, err := chat.NewPost(cli.GetDB())
 	if err != nil {
 		return nil, err
 	}
 
+	postRevision, err := chat.NewPostRevision(cli.GetDB())
+	if err != nil {
+		return nil, err
+	}
+
+	notification, err := chat.NewNotification(cli.GetDB())
+	if err != nil {
+		return nil, err
+	}
+
+	postDraft, err := chat.NewPostDraft(cli.GetDB())
+	if err != nil {
+		return nil, err
+	}
+
+	accountDeletion, err := chat.NewAccountDeletion(cli.GetDB())
+	if err != nil {
+		return nil, err
+	}
+
+	dataExport, err := chat.NewDataExport(cli.GetDB())
+	if err != nil {
+		return nil, err
+	}
+
 	userPostRelation, err := chat.NewUserPostRelation(cli.GetDB())
 
 	appConfig, err := chat.NewAppConfig(cli.GetDB())
@@ -141,32 +249,56 @@ func NewChatDatabase(cli *mongoutil.Client) (ChatDatabaseInterface, error) {
 	return &ChatDatabase{
 		tx: cli.GetTx(),
 		//rdb:              rdb,
-		register:         register,
-		account:          account,
-		contact:          contact,
-		attribute:        attribute,
-		userLoginRecord:  userLoginRecord,
-		verifyCode:       verifyCode,
-		forbiddenAccount: forbiddenAccount,
-		post:             post,
-		userPostRelation: userPostRelation,
-		appConfig:        appConfig,
+		register:           register,
+		account:            account,
+		contact:            contact,
+		attribute:          attribute,
+		userLoginRecord:    userLoginRecord,
+		verifyCode:         verifyCode,
+		loginNonce:         loginNonce,
+		linkedWallet:       linkedWallet,
+		forbiddenAccount:   forbiddenAccount,
+		blockHistory:       forbiddenAccountHistory,
+		limitUserLoginIP:   limitUserLoginIP,
+		registerAddFriend:  registerAddFriend,
+		invitationRegister: invitationRegister,
+		invitationUse:      invitationUse,
+		post:               post,
+		postRevision:       postRevision,
+		notification:       notification,
+		postDraft:          postDraft,
+		accountDeletion:    accountDeletion,
+		dataExport:         dataExport,
+		userPostRelation:   userPostRelation,
+		appConfig:          appConfig,
 	}, nil
 }
 
 type ChatDatabase struct {
 	tx tx.Tx
 	//rdb              redis.UniversalClient
-	register         chatdb.RegisterInterface
-	contact          chatdb.ContactInterface
-	account          chatdb.AccountInterface
-	attribute        chatdb.AttributeInterface
-	userLoginRecord  chatdb.UserLoginRecordInterface
-	verifyCode       chatdb.VerifyCodeInterface
-	forbiddenAccount admin.ForbiddenAccountInterface
-	post             chatdb.PostInterface
-	userPostRelation chatdb.UserPostRelationInterface
-	appConfig        chatdb.AppConfigInterface
+	register           chatdb.RegisterInterface
+	contact            chatdb.ContactInterface
+	account            chatdb.AccountInterface
+	attribute          chatdb.AttributeInterface
+	userLoginRecord    chatdb.UserLoginRecordInterface
+	verifyCode         chatdb.VerifyCodeInterface
+	loginNonce         chatdb.LoginNonceInterface
+	linkedWallet       chatdb.LinkedWalletInterface
+	forbiddenAccount   admin.ForbiddenAccountInterface
+	blockHistory       admin.ForbiddenAccountHistoryInterface
+	limitUserLoginIP   admin.LimitUserLoginIPInterface
+	registerAddFriend  admin.RegisterAddFriendInterface
+	invitationRegister admin.InvitationRegisterInterface
+	invitationUse      admin.InvitationUseInterface
+	post               chatdb.PostInterface
+	postRevision       chatdb.PostRevisionInterface
+	notification       chatdb.NotificationInterface
+	postDraft          chatdb.PostDraftInterface
+	accountDeletion    chatdb.AccountDeletionInterface
+	dataExport         chatdb.DataExportInterface
+	userPostRelation   chatdb.UserPostRelationInterface
+	appConfig          chatdb.AppConfigInterface
 }
 
 // DeleteGroupFromContact implements ChatDatabaseInterface.
@@ -267,9 +399,47 @@ func (o *ChatDatabase) DelVerifyCode(ctx context.Context, id string) error {
 	return o.verifyCode.Delete(ctx, id)
 }
 
-//func (o *ChatDatabase) ChallengeNonce(ctx context.Context, publicKey string, nonce string) error {
-//	return o.rdb.HSet(ctx, publicKey, nonce, 5*time.Minute).Err()
-//}
+func (o *ChatDatabase) AddLoginNonce(ctx context.Context, nonce *chatdb.LoginNonce) error {
+	return o.loginNonce.Create(ctx, nonce)
+}
+
+func (o *ChatDatabase) TakeLoginNonce(ctx context.Context, nonce string) (*chatdb.LoginNonce, error) {
+	return o.loginNonce.Take(ctx, nonce)
+}
+
+func (o *ChatDatabase) UseLoginNonce(ctx context.Context, nonce string) (bool, error) {
+	return o.loginNonce.MarkUsed(ctx, nonce)
+}
+
+func (o *ChatDatabase) AddLinkedWallet(ctx context.Context, wallet *chatdb.LinkedWallet) error {
+	return o.linkedWallet.Create(ctx, wallet)
+}
+
+func (o *ChatDatabase) GetLinkedWallet(ctx context.Context, address string) (*chatdb.LinkedWallet, error) {
+	return o.linkedWallet.Take(ctx, address)
+}
+
+func (o *ChatDatabase) FindLinkedWallets(ctx context.Context, userID string) ([]*chatdb.LinkedWallet, error) {
+	return o.linkedWallet.Find(ctx, userID)
+}
+
+func (o *ChatDatabase) RemoveLinkedWallet(ctx context.Context, userID string, address string) error {
+	return o.linkedWallet.Delete(ctx, userID, address)
+}
+
+// PromoteLinkedWallet makes a linked wallet the primary wallet of its account, replacing the current one.
+func (o *ChatDatabase) PromoteLinkedWallet(ctx context.Context, wallet *chatdb.LinkedWallet) error {
+	return o.tx.Transaction(ctx, func(ctx context.Context) error {
+		if err := o.linkedWallet.Delete(ctx, wallet.UserID, wallet.Address); err != nil {
+			return err
+		}
+		return o.attribute.Update(ctx, wallet.UserID, map[string]any{
+			"address":     wallet.Address,
+			"public_key":  wallet.PublicKey,
+			"change_time": time.Now(),
+		})
+	})
+}
 
 func (o *ChatDatabase) RegisterUser(ctx context.Context, register *chatdb.Register, account *chatdb.Account, attribute *chatdb.Attribute) error {
 	return o.tx.Transaction(ctx, func(ctx context.Context) error {
@@ -362,12 +532,37 @@ func (o *ChatDatabase) DelUserAccount(ctx context.Context, userIDs []string) err
 		if err := o.attribute.Delete(ctx, userIDs); err != nil {
 			return err
 		}
+		if err := o.linkedWallet.DeleteByUserIDs(ctx, userIDs); err != nil {
+			return err
+		}
 		return nil
 	})
 }
 
 func (o *ChatDatabase) DeletePost(ctx context.Context, postIDs []string) error {
-	return o.post.Delete(ctx, postIDs)
+	if err := o.post.Delete(ctx, postIDs); err != nil {
+		return err
+	}
+	if err := o.postRevision.DeleteByPostIDs(ctx, postIDs); err != nil {
+		return err
+	}
+	return o.notification.DeleteByPostIDs(ctx, postIDs)
+}
+
+// EditPost archives the current content as revision and applies data to the post.
+// Two concurrent edits produce the same revision number, so the second fails on the unique index.
+func (o *ChatDatabase) EditPost(ctx context.Context, revision *chatdb.PostRevision, data map[string]any) error {
+	return o.tx.Transaction(ctx, func(ctx context.Context) error {
+		if err := o.postRevision.Create(ctx, []*chatdb.PostRevision{revision}); err != nil {
+			return err
+		}
+		data["revision_count"] = revision.Revision
+		return o.post.UpdateByMap(ctx, revision.PostID, data)
+	})
+}
+
+func (o *ChatDatabase) GetPostRevisions(ctx context.Context, postID string) ([]*chatdb.PostRevision, error) {
+	return o.postRevision.Find(ctx, postID)
 }
 
 func (o *ChatDatabase) GetFollowedUserIDs(ctx context.Context, userID string) ([]string, error) {
@@ -398,6 +593,10 @@ func (o *ChatDatabase) GetPostByID(ctx context.Context, postID string) (*chatdb.
 	return o.post.Take(ctx, postID)
 }
 
+func (o *ChatDatabase) GetVisiblePostByID(ctx context.Context, postID string) (*chatdb.Post, error) {
+	return o.post.TakeVisible(ctx, postID)
+}
+
 func (o *ChatDatabase) CreatePost(ctx context.Context, posts []*chatdb.PostDB) error {
 	return o.post.Create(ctx, posts)
 }
@@ -426,6 +625,44 @@ func (o *ChatDatabase) DeleteUserPostRelation(ctx context.Context, userID, postI
 	return o.userPostRelation.Delete(ctx, userID, postID)
 }
 
+// postCountFields maps a relation flag to the counter it drives on the post.
+var postCountFields = map[string]string{
+	"is_liked":     "like_count",
+	"is_collected": "collect_count",
+	"is_forwarded": "forward_count",
+}
+
+// ChangeUserPostRelation sets a relation flag and moves the matching post counter when the flag flips.
+// Callers run it inside a transaction so the two writes land together.
+func (o *ChatDatabase) ChangeUserPostRelation(ctx context.Context, userID, postID string, field string, value int32) (bool, error) {
+	changed, err := o.userPostRelation.SetFlag(ctx, userID, postID, field, value)
+	if err != nil || !changed {
+		return changed, err
+	}
+	if countField, ok := postCountFields[field]; ok {
+		delta := int64(1)
+		if value == 0 {
+			delta = -1
+		}
+		if err := o.post.IncrCount(ctx, postID, countField, delta); err != nil {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+func (o *ChatDatabase) IncrPostCount(ctx context.Context, postID string, field string, delta int64) error {
+	return o.post.IncrCount(ctx, postID, field, delta)
+}
+
+func (o *ChatDatabase) ReconcilePostCounts(ctx context.Context, postIDs []string) (int64, error) {
+	return o.post.ReconcileCounts(ctx, postIDs)
+}
+
+func (o *ChatDatabase) BackfillPostCounts(ctx context.Context) (int64, error) {
+	return o.post.BackfillCounts(ctx)
+}
+
 func (o *ChatDatabase) GetPostIDsByLike(ctx context.Context, userID string) ([]string, error) {
 	return o.userPostRelation.GetLikedPostIDs(ctx, userID)
 }
@@ -449,3 +686,310 @@ func (o *ChatDatabase) GetVersionConfig(ctx context.Context) (*chatdb.AppVersion
 func (o *ChatDatabase) GetFakeUserConfig(ctx context.Context) (*chatdb.AppFakeUserConfig, error) {
 	return o.appConfig.GetFakeUserConfig(ctx)
 }
+
+func (o *ChatDatabase) AddNotifications(ctx context.Context, notifications []*chatdb.Notification) error {
+	return o.notification.Create(ctx, notifications)
+}
+
+func (o *ChatDatabase) AggregateNotification(ctx context.Context, notification *chatdb.Notification, opUserID string) error {
+	return o.notification.Aggregate(ctx, notification, opUserID)
+}
+
+func (o *ChatDatabase) GetNotificationsByCursor(ctx context.Context, userID string, cursor int64, count int64) ([]*chatdb.Notification, string, error) {
+	return o.notification.FindByCursor(ctx, userID, cursor, count)
+}
+
+func (o *ChatDatabase) CountUnreadNotifications(ctx context.Context, userID string) (int64, error) {
+	return o.notification.CountUnread(ctx, userID)
+}
+
+func (o *ChatDatabase) MarkNotificationsRead(ctx context.Context, userID string, notificationIDs []string) error {
+	return o.notification.MarkRead(ctx, userID, notificationIDs)
+}
+
+func (o *ChatDatabase) CreatePostDraft(ctx context.Context, draft *chatdb.PostDraft) error {
+	return o.postDraft.Create(ctx, []*chatdb.PostDraft{draft})
+}
+
+func (o *ChatDatabase) GetPostDraft(ctx context.Context, draftID string) (*chatdb.PostDraft, error) {
+	return o.postDraft.Take(ctx, draftID)
+}
+
+func (o *ChatDatabase) UpdatePostDraft(ctx context.Context, draftID string, data map[string]any) (bool, error) {
+	return o.postDraft.UpdateByMap(ctx, draftID, data)
+}
+
+func (o *ChatDatabase) DeletePostDrafts(ctx context.Context, userID string, draftIDs []string) error {
+	return o.postDraft.Delete(ctx, userID, draftIDs)
+}
+
+func (o *ChatDatabase) FindPostDrafts(ctx context.Context, userID string, pagination pagination.Pagination) (int64, []*chatdb.PostDraft, error) {
+	return o.postDraft.FindByUser(ctx, userID, pagination)
+}
+
+func (o *ChatDatabase) ClaimDuePostDraft(ctx context.Context, owner string, lease time.Duration) (*chatdb.PostDraft, error) {
+	return o.postDraft.ClaimDue(ctx, owner, lease)
+}
+
+func (o *ChatDatabase) SetPostDraftPostID(ctx context.Context, draftID string, owner string, postID string) (bool, error) {
+	return o.postDraft.SetPostID(ctx, draftID, owner, postID)
+}
+
+// PublishPostDraft creates the post of a claimed draft and removes the draft, it reports whether the post was created by this call.
+// The post ID is saved on the draft before publishing, so a retry after a crash hits the unique post_id index instead of posting twice.
+func (o *ChatDatabase) PublishPostDraft(ctx context.Context, draftID string, owner string, post *chatdb.PostDB) (bool, error) {
+	created := true
+	if err := o.post.Create(ctx, []*chatdb.PostDB{post}); err != nil {
+		if !mongo.IsDuplicateKeyError(err) {
+			return false, err
+		}
+		created = false
+	}
+	return created, o.postDraft.DeleteClaimed(ctx, draftID, owner)
+}
+
+func (o *ChatDatabase) CreateAccountDeletion(ctx context.Context, deletion *chatdb.AccountDeletion) error {
+	return o.accountDeletion.Create(ctx, []*chatdb.AccountDeletion{deletion})
+}
+
+func (o *ChatDatabase) GetAccountDeletion(ctx context.Context, userID string) (*chatdb.AccountDeletion, error) {
+	return o.accountDeletion.Take(ctx, userID)
+}
+
+func (o *ChatDatabase) UpdateAccountDeletion(ctx context.Context, userID string, data map[string]any) (bool, error) {
+	return o.accountDeletion.UpdatePending(ctx, userID, data)
+}
+
+func (o *ChatDatabase) CancelAccountDeletion(ctx context.Context, userID string) (bool, error) {
+	return o.accountDeletion.DeletePending(ctx, userID)
+}
+
+func (o *ChatDatabase) ClaimDueAccountDeletion(ctx context.Context, owner string, lease time.Duration) (*chatdb.AccountDeletion, error) {
+	return o.accountDeletion.ClaimDue(ctx, owner, lease)
+}
+
+// EraseUser deletes or anonymizes everything the chat and admin collections hold about a user and writes the receipt on the claimed deletion.
+// It runs in one transaction, if the lease was lost meanwhile nothing is written and the new owner erases the user again.
+// deleteFile removes the stored data export archives once the transaction committed, the account stays erased if it fails.
+func (o *ChatDatabase) EraseUser(ctx context.Context, userID string, owner string, deleteFile func(ctx context.Context, key string) error) ([]*chatdb.ErasedRecord, error) {
+	var (
+		records []*chatdb.ErasedRecord
+		keys    []string
+	)
+	err := o.tx.Transaction(ctx, func(ctx context.Context) error {
+		records, keys = nil, nil
+		record := func(collection string, deleted, anonymized int64) {
+			records = append(records, &chatdb.ErasedRecord{Collection: collection, Deleted: deleted, Anonymized: anonymized})
+		}
+
+		posts, err := o.post.FindByUserID(ctx, userID)
+		if err != nil {
+			return err
+		}
+		postIDs := make([]string, 0, len(posts))
+		related := make(map[string]struct{})
+		for _, post := range posts {
+			postIDs = append(postIDs, post.PostID)
+			for _, id := range []string{post.CommentPostID, post.ForwardPostID, post.RefPostID} {
+				if id != "" {
+					related[id] = struct{}{}
+				}
+			}
+		}
+		if err := o.DeletePost(ctx, postIDs); err != nil {
+			return err
+		}
+		if err := o.userPostRelation.DeleteByPostIDs(ctx, postIDs); err != nil {
+			return err
+		}
+		interacted, err := o.userPostRelation.DeleteByUserID(ctx, userID)
+		if err != nil {
+			return err
+		}
+		for _, postID := range interacted {
+			related[postID] = struct{}{}
+		}
+		for _, postID := range postIDs {
+			delete(related, postID)
+		}
+		// posts the user commented on, forwarded, referenced or interacted with get their counters recomputed
+		if _, err := o.post.ReconcileCounts(ctx, datautil.Keys(related)); err != nil {
+			return err
+		}
+		pulled, err := o.post.PullUser(ctx, userID)
+		if err != nil {
+			return err
+		}
+		record("post", int64(len(postIDs)), pulled)
+		record("user_post_relation", int64(len(interacted)), 0)
+
+		// nonces and verify codes are keyed by what the user signs in with, read it before the attribute and wallets go
+		contact, err := o.attribute.TakeContact(ctx, userID)
+		if err != nil && !dbutil.IsDBNotFound(err) {
+			return err
+		}
+		wallets, err := o.linkedWallet.Find(ctx, userID)
+		if err != nil {
+			return err
+		}
+		var addresses, accounts []string
+		if contact != nil {
+			if contact.Address != "" {
+				addresses = append(addresses, contact.Address)
+			}
+			if contact.Email != "" {
+				accounts = append(accounts, contact.Email)
+			}
+			if contact.PhoneNumber != "" {
+				// same account as chatSvr.verifyCodeJoin
+				accounts = append(accounts, contact.AreaCode+" "+contact.PhoneNumber)
+			}
+		}
+		for _, wallet := range wallets {
+			addresses = append(addresses, wallet.Address)
+		}
+		nonces, err := o.loginNonce.DeleteByAddress(ctx, addresses)
+		if err != nil {
+			return err
+		}
+		record("login_nonce", nonces, 0)
+		codes, err := o.verifyCode.DeleteByAccount(ctx, accounts)
+		if err != nil {
+			return err
+		}
+		record("verify_code", codes, 0)
+
+		exports, err := o.dataExport.FindByUserID(ctx, userID)
+		if err != nil {
+			return err
+		}
+		for _, export := range exports {
+			if export.Key != "" {
+				keys = append(keys, export.Key)
+			}
+		}
+		exported, err := o.dataExport.DeleteByUserID(ctx, userID)
+		if err != nil {
+			return err
+		}
+		record("data_export", exported, 0)
+
+		notified, err := o.notification.DeleteByUserID(ctx, userID)
+		if err != nil {
+			return err
+		}
+		if pulled, err = o.notification.PullOpUser(ctx, userID); err != nil {
+			return err
+		}
+		record("notification", notified, pulled)
+
+		for _, c := range []struct {
+			collection string
+			delete     func(ctx context.Context, userID string) (int64, error)
+		}{
+			{"post_drafts", o.postDraft.DeleteByUserID},
+			{"contact", o.contact.DeleteByUserID},
+			{"user_login_record", o.userLoginRecord.DeleteByUserID},
+			{"linked_wallet", o.linkedWallet.DeleteByUserID},
+			{"register", o.register.DeleteByUserID},
+			{"account", o.account.DeleteByUserID},
+			{"attribute", o.attribute.DeleteByUserID},
+			{"forbidden_account", o.forbiddenAccount.DeleteByUserID},
+			{"forbidden_account_history", o.blockHistory.DeleteByUserID},
+			{"limit_user_login_ip", o.limitUserLoginIP.DeleteByUserID},
+			{"register_add_friend", o.registerAddFriend.DeleteByUserID},
+		} {
+			n, err := c.delete(ctx, userID)
+			if err != nil {
+				return err
+			}
+			record(c.collection, n, 0)
+		}
+
+		// invitation codes and their uses stay counted, only the user is removed from them
+		replaced, err := o.invitationRegister.ReplaceUsedBy(ctx, userID, constant.DeletedUserID)
+		if err != nil {
+			return err
+		}
+		invited, err := o.invitationRegister.ReplaceInviter(ctx, userID, constant.DeletedUserID)
+		if err != nil {
+			return err
+		}
+		record("invitation_register", 0, replaced+invited)
+		if replaced, err = o.invitationUse.ReplaceUser(ctx, userID, constant.DeletedUserID); err != nil {
+			return err
+		}
+		record("invitation_use", 0, replaced)
+
+		finished, err := o.accountDeletion.Finish(ctx, userID, owner, records)
+		if err != nil {
+			return err
+		}
+		if !finished {
+			return errs.ErrNoPermission.WrapMsg("account deletion lease lost", "userID", userID)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	var deleteErr error
+	for _, key := range keys {
+		if err := deleteFile(ctx, key); err != nil && deleteErr == nil {
+			deleteErr = errs.WrapMsg(err, "account erased, data export archive not deleted", "userID", userID, "key", key)
+		}
+	}
+	return records, deleteErr
+}
+
+func (o *ChatDatabase) CreateDataExport(ctx context.Context, export *chatdb.DataExport) error {
+	return o.dataExport.Create(ctx, []*chatdb.DataExport{export})
+}
+
+func (o *ChatDatabase) GetDataExport(ctx context.Context, exportID string) (*chatdb.DataExport, error) {
+	return o.dataExport.Take(ctx, exportID)
+}
+
+func (o *ChatDatabase) GetLatestDataExport(ctx context.Context, userID string) (*chatdb.DataExport, error) {
+	return o.dataExport.TakeLatest(ctx, userID)
+}
+
+func (o *ChatDatabase) ClaimPendingDataExport(ctx context.Context, owner string, lease time.Duration) (*chatdb.DataExport, error) {
+	return o.dataExport.ClaimPending(ctx, owner, lease)
+}
+
+func (o *ChatDatabase) FinishDataExport(ctx context.Context, exportID string, owner string, key string, size int64, expireAt time.Time) (bool, error) {
+	return o.dataExport.Finish(ctx, exportID, owner, key, size, expireAt)
+}
+
+func (o *ChatDatabase) ClaimDataExportDownload(ctx context.Context, exportID string, owner string, lease time.Duration) (*chatdb.DataExport, error) {
+	return o.dataExport.ClaimDownload(ctx, exportID, owner, lease)
+}
+
+func (o *ChatDatabase) ConsumeDataExport(ctx context.Context, exportID string, owner string) (*chatdb.DataExport, error) {
+	return o.dataExport.Consume(ctx, exportID, owner)
+}
+
+func (o *ChatDatabase) ReleaseDataExportDownload(ctx context.Context, exportID string, owner string) error {
+	return o.dataExport.ReleaseDownload(ctx, exportID, owner)
+}
+
+func (o *ChatDatabase) FindExpiredDataExports(ctx context.Context, limit int64) ([]*chatdb.DataExport, error) {
+	return o.dataExport.FindExpired(ctx, limit)
+}
+
+func (o *ChatDatabase) ExpireDataExport(ctx context.Context, exportID string) error {
+	return o.dataExport.Expire(ctx, exportID)
+}
+
+func (o *ChatDatabase) FindUserPosts(ctx context.Context, userID string) ([]*chatdb.PostDB, error) {
+	return o.post.FindAllByUserID(ctx, userID)
+}
+
+func (o *ChatDatabase) FindUserPostRelations(ctx context.Context, userID string) ([]*chatdb.UserPostRelation, error) {
+	return o.userPostRelation.FindByUserID(ctx, userID)
+}
+
+func (o *ChatDatabase) FindUserLoginRecords(ctx context.Context, userID string) ([]*chatdb.UserLoginRecord, error) {
+	return o.userLoginRecord.FindByUserID(ctx, userID)
+}
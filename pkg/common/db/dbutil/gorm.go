@@ -19,8 +19,10 @@ import (
 	"fmt"
 	"reflect"
 	"strconv"
+	"strings"
 	"time"
 
+	"github.com/openimsdk/tools/db/mongoutil"
 	"github.com/openimsdk/tools/errs"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo"
@@ -30,6 +32,15 @@ func IsDBNotFound(err error) bool {
 	return errs.Unwrap(err) == mongo.ErrNoDocuments
 }
 
+// DeleteMany deletes the matched documents and returns how many were deleted.
+func DeleteMany(ctx context.Context, coll *mongo.Collection, filter any) (int64, error) {
+	res, err := mongoutil.DeleteManyResult(ctx, coll, filter)
+	if err != nil {
+		return 0, err
+	}
+	return res.DeletedCount, nil
+}
+
 func FindPageWithCursor[T any](ctx context.Context, coll *mongo.Collection, cursor int64, cursorField string, sortDirection int32, limit int64, filter bson.M, sort bson.D, pipeline mongo.Pipeline) ([]T, string, error) {
 
 	var _pipeline []bson.D
@@ -48,7 +59,7 @@ func FindPageWithCursor[T any](ctx context.Context, coll *mongo.Collection, curs
 			comparisonOperator = "$lt"
 		}
 		cursorTime := time.Unix(0, cursor*int64(time.Millisecond))
-		_pipeline = append(_pipeline, bson.D{{Key: "$match", Value: bson.M{cursorField: bson.M{comparisonOperator: cursorTime}}}})
+		_pipeline = append(_pipeline, bson.D{{Key: "$match", Value: bson.M{bsonFieldName[T](cursorField): bson.M{comparisonOperator: cursorTime}}}})
 	}
 
 	_pipeline = append(_pipeline, pipeline...)
@@ -82,6 +93,26 @@ func FindPageWithCursor[T any](ctx context.Context, coll *mongo.Collection, curs
 
 	return results, nextCursor, nil
 }
+
+// bsonFieldName 返回结构体字段在 bson 中的名字，cursorField 是 Go 字段名
+func bsonFieldName[T any](field string) string {
+	t := reflect.TypeOf((*T)(nil)).Elem()
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct {
+		return field
+	}
+	f, ok := t.FieldByName(field)
+	if !ok {
+		return field
+	}
+	if name, _, _ := strings.Cut(f.Tag.Get("bson"), ","); name != "" && name != "-" {
+		return name
+	}
+	return strings.ToLower(field)
+}
+
 func getFieldValue(v interface{}, field string) (string, error) {
 	r := reflect.ValueOf(v)
 	if r.Kind() == reflect.Ptr {
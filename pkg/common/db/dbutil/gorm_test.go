@@ -0,0 +1,24 @@
+package dbutil
+
+import (
+	"testing"
+	"time"
+)
+
+func TestBsonFieldName(t *testing.T) {
+	type doc struct {
+		CreateTime time.Time `bson:"create_time"`
+		UpdateTime time.Time `bson:"update_time,omitempty"`
+		Seq        int64
+	}
+	for field, want := range map[string]string{
+		"CreateTime": "create_time",
+		"UpdateTime": "update_time",
+		"Seq":        "seq",
+		"Missing":    "Missing",
+	} {
+		if got := bsonFieldName[*doc](field); got != want {
+			t.Errorf("%s: want %s, got %s", field, want, got)
+		}
+	}
+}
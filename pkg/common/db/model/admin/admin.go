@@ -16,6 +16,7 @@ package admin
 
 import (
 	"context"
+	"time"
 
 	"github.com/openimsdk/chat/pkg/common/constant"
 	admindb "github.com/openimsdk/chat/pkg/common/db/table/admin"
@@ -70,6 +71,68 @@ func (o *Admin) ChangePassword(ctx context.Context, userID string, newPassword s
 	return mongoutil.UpdateOne(ctx, o.coll, bson.M{"user_id": userID}, bson.M{"$set": bson.M{"password": newPassword}}, false)
 }
 
+func (o *Admin) RehashPassword(ctx context.Context, account string, oldPassword string, newPassword string) error {
+	return mongoutil.UpdateOne(ctx, o.coll, bson.M{"account": account, "password": oldPassword}, bson.M{"$set": bson.M{"password": newPassword}}, false)
+}
+
+func (o *Admin) UpdateByAccount(ctx context.Context, account string, update map[string]any) error {
+	if len(update) == 0 {
+		return nil
+	}
+	return mongoutil.UpdateOne(ctx, o.coll, bson.M{"account": account}, bson.M{"$set": update}, false)
+}
+
+func (o *Admin) UseTOTPStep(ctx context.Context, account string, step int64) (bool, error) {
+	filter := bson.M{"account": account, "totp_last_step": bson.M{"$lt": step}}
+	res, err := mongoutil.UpdateOneResult(ctx, o.coll, filter, bson.M{"$set": bson.M{"totp_last_step": step}})
+	if err != nil {
+		return false, err
+	}
+	return res.MatchedCount > 0, nil
+}
+
+func (o *Admin) UseRecoveryCode(ctx context.Context, account string, codeHash string) (bool, error) {
+	filter := bson.M{"account": account, "recovery_codes": codeHash}
+	res, err := mongoutil.UpdateOneResult(ctx, o.coll, filter, bson.M{"$pull": bson.M{"recovery_codes": codeHash}})
+	if err != nil {
+		return false, err
+	}
+	return res.MatchedCount > 0, nil
+}
+
+func (o *Admin) AddTOTPFailure(ctx context.Context, account string, limit int32, lockUntil time.Time) (bool, error) {
+	opt := options.FindOneAndUpdate().SetReturnDocument(options.After)
+	a, err := mongoutil.FindOneAndUpdate[*admindb.Admin](ctx, o.coll, bson.M{"account": account}, bson.M{"$inc": bson.M{"totp_failed_count": 1}}, opt)
+	if err != nil {
+		return false, err
+	}
+	if a.TOTPFailedCount < limit {
+		return false, nil
+	}
+	update := bson.M{"$set": bson.M{"totp_failed_count": 0, "totp_locked_until": lockUntil}}
+	if err := mongoutil.UpdateOne(ctx, o.coll, bson.M{"account": account}, update, false); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (o *Admin) PullRoles(ctx context.Context, roleIDs []string) error {
+	if len(roleIDs) == 0 {
+		return nil
+	}
+	_, err := o.coll.UpdateMany(ctx, bson.M{"roles": bson.M{"$in": roleIDs}}, bson.M{"$pull": bson.M{"roles": bson.M{"$in": roleIDs}}})
+	return errs.Wrap(err)
+}
+
+func (o *Admin) SetMissingRoles(ctx context.Context, roleIDs []string) (int64, error) {
+	filter := bson.M{"roles": bson.M{"$exists": false}, "level": bson.M{"$ne": constant.AdvancedUserLevel}}
+	res, err := mongoutil.UpdateMany(ctx, o.coll, filter, bson.M{"$set": bson.M{"roles": roleIDs}})
+	if err != nil {
+		return 0, err
+	}
+	return res.ModifiedCount, nil
+}
+
 func (o *Admin) Delete(ctx context.Context, userIDs []string) error {
 	if len(userIDs) == 0 {
 		return nil
@@ -0,0 +1,91 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admin
+
+import (
+	"context"
+
+	"github.com/openimsdk/tools/db/mongoutil"
+	"github.com/openimsdk/tools/db/pagination"
+	"github.com/openimsdk/tools/errs"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/openimsdk/chat/pkg/common/db/table/admin"
+)
+
+func NewAdminRole(db *mongo.Database) (admin.AdminRoleInterface, error) {
+	coll := db.Collection("admin_role")
+	_, err := coll.Indexes().CreateOne(context.Background(), mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "role_id", Value: 1},
+		},
+		Options: options.Index().SetUnique(true),
+	})
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return &AdminRole{
+		coll: coll,
+	}, nil
+}
+
+type AdminRole struct {
+	coll *mongo.Collection
+}
+
+func (o *AdminRole) Create(ctx context.Context, roles []*admin.AdminRole) error {
+	return mongoutil.InsertMany(ctx, o.coll, roles)
+}
+
+func (o *AdminRole) Update(ctx context.Context, roleID string, update map[string]any) error {
+	if len(update) == 0 {
+		return nil
+	}
+	return mongoutil.UpdateOne(ctx, o.coll, bson.M{"role_id": roleID}, bson.M{"$set": update}, true)
+}
+
+func (o *AdminRole) Delete(ctx context.Context, roleIDs []string) error {
+	if len(roleIDs) == 0 {
+		return nil
+	}
+	return mongoutil.DeleteMany(ctx, o.coll, bson.M{"role_id": bson.M{"$in": roleIDs}})
+}
+
+func (o *AdminRole) Take(ctx context.Context, roleID string) (*admin.AdminRole, error) {
+	return mongoutil.FindOne[*admin.AdminRole](ctx, o.coll, bson.M{"role_id": roleID})
+}
+
+func (o *AdminRole) Find(ctx context.Context, roleIDs []string) ([]*admin.AdminRole, error) {
+	if len(roleIDs) == 0 {
+		return nil, nil
+	}
+	return mongoutil.Find[*admin.AdminRole](ctx, o.coll, bson.M{"role_id": bson.M{"$in": roleIDs}})
+}
+
+func (o *AdminRole) Search(ctx context.Context, keyword string, pagination pagination.Pagination) (int64, []*admin.AdminRole, error) {
+	filter := bson.M{}
+	if keyword != "" {
+		filter = bson.M{
+			"$or": []bson.M{
+				{"role_id": bson.M{"$regex": keyword, "$options": "i"}},
+				{"name": bson.M{"$regex": keyword, "$options": "i"}},
+			},
+		}
+	}
+	opt := options.Find().SetSort(bson.D{{Key: "create_time", Value: 1}})
+	return mongoutil.FindPage[*admin.AdminRole](ctx, o.coll, filter, pagination, opt)
+}
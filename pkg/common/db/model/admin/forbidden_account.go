@@ -16,6 +16,7 @@ package admin
 
 import (
 	"context"
+	"time"
 
 	"github.com/openimsdk/tools/db/mongoutil"
 	"github.com/openimsdk/tools/db/pagination"
@@ -23,17 +24,25 @@ import (
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
 
+	"github.com/openimsdk/chat/pkg/common/db/dbutil"
 	"github.com/openimsdk/chat/pkg/common/db/table/admin"
 	"github.com/openimsdk/tools/errs"
 )
 
 func NewForbiddenAccount(db *mongo.Database) (admin.ForbiddenAccountInterface, error) {
 	coll := db.Collection("forbidden_account")
-	_, err := coll.Indexes().CreateOne(context.Background(), mongo.IndexModel{
-		Keys: bson.D{
-			{Key: "user_id", Value: 1},
+	_, err := coll.Indexes().CreateMany(context.Background(), []mongo.IndexModel{
+		{
+			Keys: bson.D{
+				{Key: "user_id", Value: 1},
+			},
+			Options: options.Index().SetUnique(true),
+		},
+		{
+			Keys: bson.D{
+				{Key: "expire_time", Value: 1},
+			},
 		},
-		Options: options.Index().SetUnique(true),
 	})
 	if err != nil {
 		return nil, errs.Wrap(err)
@@ -84,3 +93,21 @@ func (o *ForbiddenAccount) Search(ctx context.Context, keyword string, paginatio
 func (o *ForbiddenAccount) FindAllIDs(ctx context.Context) ([]string, error) {
 	return mongoutil.Find[string](ctx, o.coll, bson.M{}, options.Find().SetProjection(bson.M{"_id": 0, "user_id": 1}))
 }
+
+func (o *ForbiddenAccount) DeleteByUserID(ctx context.Context, userID string) (int64, error) {
+	return dbutil.DeleteMany(ctx, o.coll, bson.M{"user_id": userID})
+}
+
+func (o *ForbiddenAccount) FindExpired(ctx context.Context, now time.Time, limit int64) ([]*admin.ForbiddenAccount, error) {
+	filter := bson.M{"expire_time": bson.M{"$gt": time.Time{}, "$lte": now}}
+	opt := options.Find().SetSort(bson.D{{Key: "expire_time", Value: 1}}).SetLimit(limit)
+	return mongoutil.Find[*admin.ForbiddenAccount](ctx, o.coll, filter, opt)
+}
+
+func (o *ForbiddenAccount) DeleteBlock(ctx context.Context, userID string, createTime time.Time) (bool, error) {
+	n, err := dbutil.DeleteMany(ctx, o.coll, bson.M{"user_id": userID, "create_time": createTime})
+	if err != nil {
+		return false, err
+	}
+	return n > 0, nil
+}
@@ -0,0 +1,66 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admin
+
+import (
+	"context"
+
+	"github.com/openimsdk/tools/db/mongoutil"
+	"github.com/openimsdk/tools/db/pagination"
+	"github.com/openimsdk/tools/errs"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/openimsdk/chat/pkg/common/db/dbutil"
+	"github.com/openimsdk/chat/pkg/common/db/table/admin"
+)
+
+func NewForbiddenAccountHistory(db *mongo.Database) (admin.ForbiddenAccountHistoryInterface, error) {
+	coll := db.Collection("forbidden_account_history")
+	_, err := coll.Indexes().CreateOne(context.Background(), mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "user_id", Value: 1},
+			{Key: "end_time", Value: -1},
+		},
+	})
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return &ForbiddenAccountHistory{
+		coll: coll,
+	}, nil
+}
+
+type ForbiddenAccountHistory struct {
+	coll *mongo.Collection
+}
+
+func (o *ForbiddenAccountHistory) Create(ctx context.Context, histories []*admin.ForbiddenAccountHistory) error {
+	return mongoutil.InsertMany(ctx, o.coll, histories)
+}
+
+func (o *ForbiddenAccountHistory) Search(ctx context.Context, userID string, pagination pagination.Pagination) (int64, []*admin.ForbiddenAccountHistory, error) {
+	filter := bson.M{}
+	if userID != "" {
+		filter["user_id"] = userID
+	}
+	opt := options.Find().SetSort(bson.D{{Key: "end_time", Value: -1}})
+	return mongoutil.FindPage[*admin.ForbiddenAccountHistory](ctx, o.coll, filter, pagination, opt)
+}
+
+func (o *ForbiddenAccountHistory) DeleteByUserID(ctx context.Context, userID string) (int64, error) {
+	return dbutil.DeleteMany(ctx, o.coll, bson.M{"user_id": userID})
+}
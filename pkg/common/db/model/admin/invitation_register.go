@@ -16,6 +16,7 @@ package admin
 
 import (
 	"context"
+	"time"
 
 	"github.com/openimsdk/tools/db/mongoutil"
 	"github.com/openimsdk/tools/db/pagination"
@@ -30,11 +31,23 @@ import (
 
 func NewInvitationRegister(db *mongo.Database) (admindb.InvitationRegisterInterface, error) {
 	coll := db.Collection("invitation_register")
-	_, err := coll.Indexes().CreateOne(context.Background(), mongo.IndexModel{
-		Keys: bson.D{
-			{Key: "invitation_code", Value: 1},
+	_, err := coll.Indexes().CreateMany(context.Background(), []mongo.IndexModel{
+		{
+			Keys: bson.D{
+				{Key: "invitation_code", Value: 1},
+			},
+			Options: options.Index().SetUnique(true),
+		},
+		{
+			Keys: bson.D{
+				{Key: "inviter_user_id", Value: 1},
+			},
+		},
+		{
+			Keys: bson.D{
+				{Key: "campaign", Value: 1},
+			},
 		},
-		Options: options.Index().SetUnique(true),
 	})
 	if err != nil {
 		return nil, errs.Wrap(err)
@@ -48,6 +61,30 @@ type InvitationRegister struct {
 	coll *mongo.Collection
 }
 
+// invitationUses is InvitationRegister.Uses as an aggregation expression.
+var invitationUses = bson.M{"$cond": bson.A{
+	bson.M{"$and": bson.A{
+		bson.M{"$eq": bson.A{bson.M{"$ifNull": bson.A{"$used_count", 0}}, 0}},
+		bson.M{"$ne": bson.A{bson.M{"$ifNull": bson.A{"$used_by_user_id", ""}}, ""}},
+	}},
+	1,
+	bson.M{"$ifNull": bson.A{"$used_count", 0}},
+}}
+
+// invitationLimit is InvitationRegister.Limit as an aggregation expression.
+var invitationLimit = bson.M{"$max": bson.A{bson.M{"$ifNull": bson.A{"$max_uses", 0}}, 1}}
+
+func usableFilter(now time.Time) []bson.M {
+	return []bson.M{
+		{"$or": []bson.M{
+			{"expire_time": bson.M{"$exists": false}},
+			{"expire_time": time.Time{}},
+			{"expire_time": bson.M{"$gt": now}},
+		}},
+		{"$expr": bson.M{"$lt": bson.A{invitationUses, invitationLimit}}},
+	}
+}
+
 func (o *InvitationRegister) Find(ctx context.Context, codes []string) ([]*admindb.InvitationRegister, error) {
 	return mongoutil.Find[*admindb.InvitationRegister](ctx, o.coll, bson.M{"invitation_code": bson.M{"$in": codes}})
 }
@@ -64,7 +101,7 @@ func (o *InvitationRegister) Create(ctx context.Context, v []*admindb.Invitation
 }
 
 func (o *InvitationRegister) Take(ctx context.Context, code string) (*admindb.InvitationRegister, error) {
-	return mongoutil.FindOne[*admindb.InvitationRegister](ctx, o.coll, bson.M{"code": code})
+	return mongoutil.FindOne[*admindb.InvitationRegister](ctx, o.coll, bson.M{"invitation_code": code})
 }
 
 func (o *InvitationRegister) Update(ctx context.Context, code string, data map[string]any) error {
@@ -74,26 +111,90 @@ func (o *InvitationRegister) Update(ctx context.Context, code string, data map[s
 	return mongoutil.UpdateOne(ctx, o.coll, bson.M{"invitation_code": code}, bson.M{"$set": data}, false)
 }
 
-func (o *InvitationRegister) Search(ctx context.Context, keyword string, state int32, userIDs []string, codes []string, pagination pagination.Pagination) (int64, []*admindb.InvitationRegister, error) {
+func (o *InvitationRegister) Use(ctx context.Context, code string, userID string, now time.Time) (bool, error) {
+	filter := bson.M{"invitation_code": code, "$and": usableFilter(now)}
+	update := mongo.Pipeline{{{Key: "$set", Value: bson.M{
+		"used_count": bson.M{"$add": bson.A{invitationUses, 1}},
+		"used_by_user_id": bson.M{"$cond": bson.A{
+			bson.M{"$eq": bson.A{bson.M{"$ifNull": bson.A{"$used_by_user_id", ""}}, ""}},
+			bson.M{"$literal": userID},
+			"$used_by_user_id",
+		}},
+	}}}}
+	res, err := mongoutil.UpdateOneResult(ctx, o.coll, filter, update)
+	if err != nil {
+		return false, err
+	}
+	return res.MatchedCount > 0, nil
+}
+
+func (o *InvitationRegister) Release(ctx context.Context, code string, userID string) error {
+	filter := bson.M{"invitation_code": code, "used_count": bson.M{"$gt": 0}}
+	update := mongo.Pipeline{{{Key: "$set", Value: bson.M{
+		"used_count": bson.M{"$subtract": bson.A{"$used_count", 1}},
+		"used_by_user_id": bson.M{"$cond": bson.A{
+			bson.M{"$eq": bson.A{"$used_by_user_id", bson.M{"$literal": userID}}},
+			"",
+			"$used_by_user_id",
+		}},
+	}}}}
+	return mongoutil.UpdateOne(ctx, o.coll, filter, update, false)
+}
+
+func (o *InvitationRegister) Search(ctx context.Context, keyword string, state int32, userIDs []string, codes []string, inviterUserID string, campaign string, pagination pagination.Pagination) (int64, []*admindb.InvitationRegister, error) {
 	filter := bson.M{}
+	var and []bson.M
 	switch state {
 	case constant.InvitationCodeUsed:
-		filter = bson.M{"user_id": bson.M{"$ne": ""}}
+		and = append(and, bson.M{"$or": []bson.M{
+			{"used_count": bson.M{"$gt": 0}},
+			{"used_by_user_id": bson.M{"$nin": bson.A{"", nil}}},
+		}})
 	case constant.InvitationCodeUnused:
-		filter = bson.M{"user_id": ""}
+		filter["used_count"] = bson.M{"$in": bson.A{0, nil}}
+		filter["used_by_user_id"] = bson.M{"$in": bson.A{"", nil}}
+	case constant.InvitationCodeUsable:
+		and = append(and, usableFilter(time.Now())...)
 	}
 
 	if len(userIDs) > 0 {
-		filter["user_id"] = bson.M{"$in": userIDs}
+		filter["used_by_user_id"] = bson.M{"$in": userIDs}
 	}
 	if len(codes) > 0 {
 		filter["invitation_code"] = bson.M{"$in": codes}
 	}
+	if inviterUserID != "" {
+		filter["inviter_user_id"] = inviterUserID
+	}
+	if campaign != "" {
+		filter["campaign"] = campaign
+	}
 	if keyword != "" {
-		filter["$or"] = []bson.M{
+		and = append(and, bson.M{"$or": []bson.M{
 			{"invitation_code": bson.M{"$regex": keyword, "$options": "i"}},
-			{"user_id": bson.M{"$regex": keyword, "$options": "i"}},
-		}
+			{"used_by_user_id": bson.M{"$regex": keyword, "$options": "i"}},
+			{"inviter_user_id": bson.M{"$regex": keyword, "$options": "i"}},
+			{"campaign": bson.M{"$regex": keyword, "$options": "i"}},
+		}})
+	}
+	if len(and) > 0 {
+		filter["$and"] = and
 	}
 	return mongoutil.FindPage[*admindb.InvitationRegister](ctx, o.coll, filter, pagination)
 }
+
+func (o *InvitationRegister) ReplaceUsedBy(ctx context.Context, userID string, replacement string) (int64, error) {
+	res, err := mongoutil.UpdateMany(ctx, o.coll, bson.M{"used_by_user_id": userID}, bson.M{"$set": bson.M{"used_by_user_id": replacement}})
+	if err != nil {
+		return 0, err
+	}
+	return res.ModifiedCount, nil
+}
+
+func (o *InvitationRegister) ReplaceInviter(ctx context.Context, userID string, replacement string) (int64, error) {
+	res, err := mongoutil.UpdateMany(ctx, o.coll, bson.M{"inviter_user_id": userID}, bson.M{"$set": bson.M{"inviter_user_id": replacement}})
+	if err != nil {
+		return 0, err
+	}
+	return res.ModifiedCount, nil
+}
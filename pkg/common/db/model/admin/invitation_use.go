@@ -0,0 +1,170 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admin
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/tools/db/mongoutil"
+	"github.com/openimsdk/tools/db/pagination"
+	"github.com/openimsdk/tools/errs"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/openimsdk/chat/pkg/common/constant"
+	"github.com/openimsdk/chat/pkg/common/db/dbutil"
+	"github.com/openimsdk/chat/pkg/common/db/table/admin"
+)
+
+func NewInvitationUse(db *mongo.Database) (admin.InvitationUseInterface, error) {
+	coll := db.Collection("invitation_use")
+	_, err := coll.Indexes().CreateMany(context.Background(), []mongo.IndexModel{
+		{
+			Keys: bson.D{
+				{Key: "user_id", Value: 1},
+			},
+		},
+		{
+			Keys: bson.D{
+				{Key: "invitation_code", Value: 1},
+				{Key: "create_time", Value: -1},
+			},
+		},
+		{
+			Keys: bson.D{
+				{Key: "inviter_user_id", Value: 1},
+				{Key: "create_time", Value: -1},
+			},
+		},
+		{
+			Keys: bson.D{
+				{Key: "campaign", Value: 1},
+				{Key: "create_time", Value: -1},
+			},
+		},
+	})
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return &InvitationUse{
+		coll: coll,
+	}, nil
+}
+
+type InvitationUse struct {
+	coll *mongo.Collection
+}
+
+func (o *InvitationUse) Create(ctx context.Context, uses []*admin.InvitationUse) error {
+	return mongoutil.InsertMany(ctx, o.coll, uses)
+}
+
+func (o *InvitationUse) Search(ctx context.Context, code string, userID string, inviterUserID string, campaign string, pagination pagination.Pagination) (int64, []*admin.InvitationUse, error) {
+	filter := bson.M{}
+	if code != "" {
+		filter["invitation_code"] = code
+	}
+	if userID != "" {
+		filter["user_id"] = userID
+	}
+	if inviterUserID != "" {
+		filter["inviter_user_id"] = inviterUserID
+	}
+	if campaign != "" {
+		filter["campaign"] = campaign
+	}
+	opt := options.Find().SetSort(bson.D{{Key: "create_time", Value: -1}})
+	return mongoutil.FindPage[*admin.InvitationUse](ctx, o.coll, filter, pagination, opt)
+}
+
+func (o *InvitationUse) Report(ctx context.Context, groupBy int32, keys []string, start time.Time, end time.Time, pagination pagination.Pagination) (int64, []*admin.InvitationReport, error) {
+	var field string
+	switch groupBy {
+	case constant.InvitationReportByInviter:
+		field = "inviter_user_id"
+	case constant.InvitationReportByCampaign:
+		field = "campaign"
+	default:
+		return 0, nil, errs.ErrArgs.WrapMsg("invalid report group", "groupBy", groupBy)
+	}
+	// uses without an inviter or campaign are not part of the report
+	filter := bson.M{field: bson.M{"$ne": ""}}
+	if len(keys) > 0 {
+		filter[field] = bson.M{"$in": keys}
+	}
+	createTime := bson.M{}
+	if !start.IsZero() {
+		createTime["$gte"] = start
+	}
+	if !end.IsZero() {
+		createTime["$lte"] = end
+	}
+	if len(createTime) > 0 {
+		filter["create_time"] = createTime
+	}
+	list := bson.A{bson.M{"$sort": bson.D{{Key: "registered", Value: -1}, {Key: "_id", Value: 1}}}}
+	if pagination != nil {
+		list = append(list,
+			bson.M{"$skip": int64(pagination.GetPageNumber()-1) * int64(pagination.GetShowNumber())},
+			bson.M{"$limit": int64(pagination.GetShowNumber())},
+		)
+	}
+	type page struct {
+		Total []struct {
+			Count int64 `bson:"count"`
+		} `bson:"total"`
+		List []*admin.InvitationReport `bson:"list"`
+	}
+	res, err := mongoutil.Aggregate[*page](ctx, o.coll, mongo.Pipeline{
+		{{Key: "$match", Value: filter}},
+		{{Key: "$group", Value: bson.D{
+			{Key: "_id", Value: "$" + field},
+			{Key: "registered", Value: bson.M{"$sum": 1}},
+			{Key: "codes", Value: bson.M{"$addToSet": "$invitation_code"}},
+			{Key: "first_time", Value: bson.M{"$min": "$create_time"}},
+			{Key: "last_time", Value: bson.M{"$max": "$create_time"}},
+		}}},
+		{{Key: "$set", Value: bson.M{"codes": bson.M{"$size": "$codes"}}}},
+		{{Key: "$facet", Value: bson.M{
+			"total": bson.A{bson.M{"$count": "count"}},
+			"list":  list,
+		}}},
+	})
+	if err != nil {
+		return 0, nil, err
+	}
+	if len(res) == 0 || len(res[0].Total) == 0 {
+		return 0, []*admin.InvitationReport{}, nil
+	}
+	return res[0].Total[0].Count, res[0].List, nil
+}
+
+func (o *InvitationUse) Delete(ctx context.Context, code string, userID string) (int64, error) {
+	return dbutil.DeleteMany(ctx, o.coll, bson.M{"invitation_code": code, "user_id": userID})
+}
+
+func (o *InvitationUse) ReplaceUser(ctx context.Context, userID string, replacement string) (int64, error) {
+	used, err := mongoutil.UpdateMany(ctx, o.coll, bson.M{"user_id": userID}, bson.M{"$set": bson.M{"user_id": replacement}})
+	if err != nil {
+		return 0, err
+	}
+	invited, err := mongoutil.UpdateMany(ctx, o.coll, bson.M{"inviter_user_id": userID}, bson.M{"$set": bson.M{"inviter_user_id": replacement}})
+	if err != nil {
+		return 0, err
+	}
+	return used.ModifiedCount + invited.ModifiedCount, nil
+}
@@ -56,6 +56,10 @@ func (o *IPForbidden) Find(ctx context.Context, ips []string) ([]*admindb.IPForb
 	return mongoutil.Find[*admindb.IPForbidden](ctx, o.coll, bson.M{"ip": bson.M{"$in": ips}})
 }
 
+func (o *IPForbidden) FindAll(ctx context.Context) ([]*admindb.IPForbidden, error) {
+	return mongoutil.Find[*admindb.IPForbidden](ctx, o.coll, bson.M{})
+}
+
 func (o *IPForbidden) Search(ctx context.Context, keyword string, state int32, pagination pagination.Pagination) (int64, []*admindb.IPForbidden, error) {
 	filter := bson.M{}
 
@@ -23,6 +23,7 @@ import (
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
 
+	"github.com/openimsdk/chat/pkg/common/db/dbutil"
 	"github.com/openimsdk/chat/pkg/common/db/table/admin"
 	"github.com/openimsdk/tools/errs"
 )
@@ -68,6 +69,10 @@ func (o *LimitUserLoginIP) Take(ctx context.Context, userID string, ip string) (
 	return mongoutil.FindOne[*admin.LimitUserLoginIP](ctx, o.coll, bson.M{"user_id": userID, "ip": ip})
 }
 
+func (o *LimitUserLoginIP) FindAll(ctx context.Context) ([]*admin.LimitUserLoginIP, error) {
+	return mongoutil.Find[*admin.LimitUserLoginIP](ctx, o.coll, bson.M{})
+}
+
 func (o *LimitUserLoginIP) Search(ctx context.Context, keyword string, pagination pagination.Pagination) (int64, []*admin.LimitUserLoginIP, error) {
 	filter := bson.M{
 		"$or": []bson.M{
@@ -91,3 +96,7 @@ func (o *LimitUserLoginIP) limitUserLoginIPFilter(ips []*admin.LimitUserLoginIP)
 	}
 	return bson.M{"$or": or}
 }
+
+func (o *LimitUserLoginIP) DeleteByUserID(ctx context.Context, userID string) (int64, error) {
+	return dbutil.DeleteMany(ctx, o.coll, bson.M{"user_id": userID})
+}
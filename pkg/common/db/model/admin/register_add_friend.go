@@ -23,6 +23,7 @@ import (
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
 
+	"github.com/openimsdk/chat/pkg/common/db/dbutil"
 	"github.com/openimsdk/chat/pkg/common/db/table/admin"
 	"github.com/openimsdk/tools/errs"
 )
@@ -70,3 +71,7 @@ func (o *RegisterAddFriend) Search(ctx context.Context, keyword string, paginati
 	filter := bson.M{"user_id": bson.M{"$regex": keyword, "$options": "i"}}
 	return mongoutil.FindPage[*admin.RegisterAddFriend](ctx, o.coll, filter, pagination)
 }
+
+func (o *RegisterAddFriend) DeleteByUserID(ctx context.Context, userID string) (int64, error) {
+	return dbutil.DeleteMany(ctx, o.coll, bson.M{"user_id": userID})
+}
@@ -25,6 +25,7 @@ import (
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
 
+	"github.com/openimsdk/chat/pkg/common/db/dbutil"
 	"github.com/openimsdk/chat/pkg/common/db/table/chat"
 )
 
@@ -75,3 +76,7 @@ func (o *Account) Delete(ctx context.Context, userIDs []string) error {
 func (o *Account) GetAllUserID(ctx context.Context, pagination pagination.Pagination) (int64, []string, error) {
 	return mongoutil.FindPage[string](ctx, o.coll, bson.M{}, pagination, options.Find().SetProjection(bson.M{"_id": 0, "user_id": 1}))
 }
+
+func (o *Account) DeleteByUserID(ctx context.Context, userID string) (int64, error) {
+	return dbutil.DeleteMany(ctx, o.coll, bson.M{"user_id": userID})
+}
@@ -0,0 +1,105 @@
+package chat
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/tools/db/mongoutil"
+	"github.com/openimsdk/tools/errs"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/openimsdk/chat/pkg/common/constant"
+	"github.com/openimsdk/chat/pkg/common/db/table/chat"
+)
+
+func NewAccountDeletion(db *mongo.Database) (chat.AccountDeletionInterface, error) {
+	coll := db.Collection("account_deletion")
+	_, err := coll.Indexes().CreateMany(context.Background(), []mongo.IndexModel{
+		{
+			Keys: bson.D{
+				{Key: "user_id", Value: 1},
+			},
+			Options: options.Index().SetUnique(true),
+		},
+		{
+			Keys: bson.D{
+				{Key: "status", Value: 1},
+				{Key: "execute_at", Value: 1},
+			},
+		},
+	})
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return &AccountDeletion{coll: coll}, nil
+}
+
+type AccountDeletion struct {
+	coll *mongo.Collection
+}
+
+// 待执行且没有被领取的申请
+func pendingDeletion(userID string, now time.Time) bson.M {
+	filter := notClaimed(now)
+	filter["user_id"] = userID
+	filter["status"] = constant.AccountDeletionPending
+	return filter
+}
+
+func (o *AccountDeletion) Create(ctx context.Context, deletions []*chat.AccountDeletion) error {
+	for i, deletion := range deletions {
+		if deletion.RequestTime.IsZero() {
+			deletions[i].RequestTime = time.Now()
+		}
+	}
+	return mongoutil.InsertMany(ctx, o.coll, deletions)
+}
+
+func (o *AccountDeletion) Take(ctx context.Context, userID string) (*chat.AccountDeletion, error) {
+	return mongoutil.FindOne[*chat.AccountDeletion](ctx, o.coll, bson.M{"user_id": userID})
+}
+
+func (o *AccountDeletion) UpdatePending(ctx context.Context, userID string, data map[string]any) (bool, error) {
+	res, err := mongoutil.UpdateOneResult(ctx, o.coll, pendingDeletion(userID, time.Now()), bson.M{"$set": data})
+	if err != nil {
+		return false, err
+	}
+	return res.MatchedCount > 0, nil
+}
+
+func (o *AccountDeletion) DeletePending(ctx context.Context, userID string) (bool, error) {
+	res, err := mongoutil.DeleteOneResult(ctx, o.coll, pendingDeletion(userID, time.Now()))
+	if err != nil {
+		return false, err
+	}
+	return res.DeletedCount > 0, nil
+}
+
+func (o *AccountDeletion) ClaimDue(ctx context.Context, owner string, lease time.Duration) (*chat.AccountDeletion, error) {
+	now := time.Now()
+	filter := notClaimed(now)
+	filter["status"] = constant.AccountDeletionPending
+	filter["execute_at"] = bson.M{"$lte": now}
+	update := bson.M{"$set": bson.M{"lease_owner": owner, "lease_until": now.Add(lease)}}
+	opts := options.FindOneAndUpdate().
+		SetSort(bson.D{{Key: "execute_at", Value: 1}}).
+		SetReturnDocument(options.After)
+	return mongoutil.FindOneAndUpdate[*chat.AccountDeletion](ctx, o.coll, filter, update, opts)
+}
+
+func (o *AccountDeletion) Finish(ctx context.Context, userID string, owner string, records []*chat.ErasedRecord) (bool, error) {
+	filter := bson.M{"user_id": userID, "lease_owner": owner, "status": constant.AccountDeletionPending}
+	update := bson.M{"$set": bson.M{
+		"status":      constant.AccountDeletionDone,
+		"finish_time": time.Now(),
+		"records":     records,
+		"lease_until": time.Time{},
+	}}
+	res, err := mongoutil.UpdateOneResult(ctx, o.coll, filter, update)
+	if err != nil {
+		return false, err
+	}
+	return res.MatchedCount > 0, nil
+}
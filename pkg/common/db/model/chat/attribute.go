@@ -24,6 +24,7 @@ import (
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
 
+	"github.com/openimsdk/chat/pkg/common/db/dbutil"
 	"github.com/openimsdk/chat/pkg/common/db/table/chat"
 )
 
@@ -124,6 +125,11 @@ func (o *Attribute) Take(ctx context.Context, userID string) (*chat.Attribute, e
 	return mongoutil.FindOne[*chat.Attribute](ctx, o.coll, bson.M{"user_id": userID})
 }
 
+func (o *Attribute) TakeContact(ctx context.Context, userID string) (*chat.AttributeContact, error) {
+	opts := options.FindOne().SetProjection(bson.M{"address": 1, "email": 1, "area_code": 1, "phone_number": 1, "_id": 0})
+	return mongoutil.FindOne[*chat.AttributeContact](ctx, o.coll, bson.M{"user_id": userID}, opts)
+}
+
 func (o *Attribute) SearchNormalUser(ctx context.Context, keyword string, forbiddenIDs []string, gender int32, pagination pagination.Pagination) (int64, []*chat.Attribute, error) {
 	filter := bson.M{}
 	if gender == 0 {
@@ -178,3 +184,7 @@ func (o *Attribute) Delete(ctx context.Context, userIDs []string) error {
 	}
 	return mongoutil.DeleteMany(ctx, o.coll, bson.M{"user_id": bson.M{"$in": userIDs}})
 }
+
+func (o *Attribute) DeleteByUserID(ctx context.Context, userID string) (int64, error) {
+	return dbutil.DeleteMany(ctx, o.coll, bson.M{"user_id": userID})
+}
@@ -11,6 +11,7 @@ import (
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
 
+	"github.com/openimsdk/chat/pkg/common/db/dbutil"
 	"github.com/openimsdk/chat/pkg/common/db/table/chat"
 )
 
@@ -70,3 +71,7 @@ func (c *Contact) DeleteGroup(ctx context.Context, userID string, groupIDs []str
 func (c *Contact) TakeGroups(ctx context.Context, userId string) (*chat.Contact, error) {
 	return mongoutil.FindOne[*chat.Contact](ctx, c.coll, bson.M{"user_id": userId})
 }
+
+func (c *Contact) DeleteByUserID(ctx context.Context, userID string) (int64, error) {
+	return dbutil.DeleteMany(ctx, c.coll, bson.M{"user_id": userID})
+}
@@ -0,0 +1,136 @@
+package chat
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/tools/db/mongoutil"
+	"github.com/openimsdk/tools/errs"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/openimsdk/chat/pkg/common/constant"
+	"github.com/openimsdk/chat/pkg/common/db/dbutil"
+	"github.com/openimsdk/chat/pkg/common/db/table/chat"
+)
+
+func NewDataExport(db *mongo.Database) (chat.DataExportInterface, error) {
+	coll := db.Collection("data_export")
+	_, err := coll.Indexes().CreateMany(context.Background(), []mongo.IndexModel{
+		{
+			Keys: bson.D{
+				{Key: "export_id", Value: 1},
+			},
+			Options: options.Index().SetUnique(true),
+		},
+		{
+			Keys: bson.D{
+				{Key: "user_id", Value: 1},
+				{Key: "create_time", Value: -1},
+			},
+		},
+		{
+			Keys: bson.D{
+				{Key: "status", Value: 1},
+				{Key: "expire_at", Value: 1},
+			},
+		},
+	})
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return &DataExport{coll: coll}, nil
+}
+
+type DataExport struct {
+	coll *mongo.Collection
+}
+
+func (o *DataExport) Create(ctx context.Context, exports []*chat.DataExport) error {
+	for i, export := range exports {
+		if export.CreateTime.IsZero() {
+			exports[i].CreateTime = time.Now()
+		}
+	}
+	return mongoutil.InsertMany(ctx, o.coll, exports)
+}
+
+func (o *DataExport) Take(ctx context.Context, exportID string) (*chat.DataExport, error) {
+	return mongoutil.FindOne[*chat.DataExport](ctx, o.coll, bson.M{"export_id": exportID})
+}
+
+func (o *DataExport) TakeLatest(ctx context.Context, userID string) (*chat.DataExport, error) {
+	opts := options.FindOne().SetSort(bson.D{{Key: "create_time", Value: -1}})
+	return mongoutil.FindOne[*chat.DataExport](ctx, o.coll, bson.M{"user_id": userID}, opts)
+}
+
+func (o *DataExport) ClaimPending(ctx context.Context, owner string, lease time.Duration) (*chat.DataExport, error) {
+	now := time.Now()
+	filter := notClaimed(now)
+	filter["status"] = constant.DataExportPending
+	update := bson.M{"$set": bson.M{"lease_owner": owner, "lease_until": now.Add(lease)}}
+	opts := options.FindOneAndUpdate().
+		SetSort(bson.D{{Key: "create_time", Value: 1}}).
+		SetReturnDocument(options.After)
+	return mongoutil.FindOneAndUpdate[*chat.DataExport](ctx, o.coll, filter, update, opts)
+}
+
+func (o *DataExport) Finish(ctx context.Context, exportID string, owner string, key string, size int64, expireAt time.Time) (bool, error) {
+	filter := bson.M{"export_id": exportID, "lease_owner": owner, "status": constant.DataExportPending}
+	update := bson.M{"$set": bson.M{
+		"status":      constant.DataExportReady,
+		"key":         key,
+		"size":        size,
+		"finish_time": time.Now(),
+		"expire_at":   expireAt,
+		"lease_until": time.Time{},
+	}}
+	res, err := mongoutil.UpdateOneResult(ctx, o.coll, filter, update)
+	if err != nil {
+		return false, err
+	}
+	return res.MatchedCount > 0, nil
+}
+
+func (o *DataExport) ClaimDownload(ctx context.Context, exportID string, owner string, lease time.Duration) (*chat.DataExport, error) {
+	now := time.Now()
+	filter := notClaimed(now)
+	filter["export_id"] = exportID
+	filter["status"] = constant.DataExportReady
+	filter["expire_at"] = bson.M{"$gt": now}
+	update := bson.M{"$set": bson.M{"lease_owner": owner, "lease_until": now.Add(lease)}}
+	return mongoutil.FindOneAndUpdate[*chat.DataExport](ctx, o.coll, filter, update, options.FindOneAndUpdate().SetReturnDocument(options.After))
+}
+
+func (o *DataExport) Consume(ctx context.Context, exportID string, owner string) (*chat.DataExport, error) {
+	now := time.Now()
+	filter := bson.M{"export_id": exportID, "lease_owner": owner, "status": constant.DataExportReady, "expire_at": bson.M{"$gt": now}}
+	update := bson.M{"$set": bson.M{"status": constant.DataExportDownloaded, "download_time": now, "lease_until": time.Time{}}}
+	return mongoutil.FindOneAndUpdate[*chat.DataExport](ctx, o.coll, filter, update, options.FindOneAndUpdate().SetReturnDocument(options.After))
+}
+
+func (o *DataExport) ReleaseDownload(ctx context.Context, exportID string, owner string) error {
+	filter := bson.M{"export_id": exportID, "lease_owner": owner, "status": constant.DataExportReady}
+	return mongoutil.UpdateOne(ctx, o.coll, filter, bson.M{"$set": bson.M{"lease_until": time.Time{}}}, false)
+}
+
+func (o *DataExport) FindExpired(ctx context.Context, limit int64) ([]*chat.DataExport, error) {
+	filter := bson.M{
+		"status":    bson.M{"$in": []int32{constant.DataExportReady, constant.DataExportDownloaded}},
+		"expire_at": bson.M{"$lte": time.Now()},
+	}
+	return mongoutil.Find[*chat.DataExport](ctx, o.coll, filter, options.Find().SetLimit(limit))
+}
+
+func (o *DataExport) FindByUserID(ctx context.Context, userID string) ([]*chat.DataExport, error) {
+	return mongoutil.Find[*chat.DataExport](ctx, o.coll, bson.M{"user_id": userID})
+}
+
+func (o *DataExport) DeleteByUserID(ctx context.Context, userID string) (int64, error) {
+	return dbutil.DeleteMany(ctx, o.coll, bson.M{"user_id": userID})
+}
+
+func (o *DataExport) Expire(ctx context.Context, exportID string) error {
+	return mongoutil.UpdateOne(ctx, o.coll, bson.M{"export_id": exportID}, bson.M{"$set": bson.M{"status": constant.DataExportExpired, "key": ""}}, false)
+}
@@ -0,0 +1,80 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chat
+
+import (
+	"context"
+
+	"github.com/openimsdk/tools/db/mongoutil"
+	"github.com/openimsdk/tools/errs"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/openimsdk/chat/pkg/common/db/dbutil"
+	"github.com/openimsdk/chat/pkg/common/db/table/chat"
+)
+
+func NewLinkedWallet(db *mongo.Database) (chat.LinkedWalletInterface, error) {
+	coll := db.Collection("linked_wallet")
+	_, err := coll.Indexes().CreateMany(context.Background(), []mongo.IndexModel{
+		{
+			Keys: bson.D{
+				{Key: "address", Value: 1},
+			},
+			Options: options.Index().SetUnique(true),
+		},
+		{
+			Keys: bson.D{
+				{Key: "user_id", Value: 1},
+			},
+		},
+	})
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return &LinkedWallet{coll: coll}, nil
+}
+
+type LinkedWallet struct {
+	coll *mongo.Collection
+}
+
+func (o *LinkedWallet) Create(ctx context.Context, wallets ...*chat.LinkedWallet) error {
+	return mongoutil.InsertMany(ctx, o.coll, wallets)
+}
+
+func (o *LinkedWallet) Take(ctx context.Context, address string) (*chat.LinkedWallet, error) {
+	return mongoutil.FindOne[*chat.LinkedWallet](ctx, o.coll, bson.M{"address": address})
+}
+
+func (o *LinkedWallet) Find(ctx context.Context, userID string) ([]*chat.LinkedWallet, error) {
+	return mongoutil.Find[*chat.LinkedWallet](ctx, o.coll, bson.M{"user_id": userID}, options.Find().SetSort(bson.M{"create_time": 1}))
+}
+
+func (o *LinkedWallet) Delete(ctx context.Context, userID string, address string) error {
+	return mongoutil.DeleteOne(ctx, o.coll, bson.M{"user_id": userID, "address": address})
+}
+
+func (o *LinkedWallet) DeleteByUserIDs(ctx context.Context, userIDs []string) error {
+	if len(userIDs) == 0 {
+		return nil
+	}
+	return mongoutil.DeleteMany(ctx, o.coll, bson.M{"user_id": bson.M{"$in": userIDs}})
+}
+
+func (o *LinkedWallet) DeleteByUserID(ctx context.Context, userID string) (int64, error) {
+	return dbutil.DeleteMany(ctx, o.coll, bson.M{"user_id": userID})
+}
@@ -0,0 +1,84 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chat
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/openimsdk/tools/db/mongoutil"
+	"github.com/openimsdk/tools/errs"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/openimsdk/chat/pkg/common/db/dbutil"
+	"github.com/openimsdk/chat/pkg/common/db/table/chat"
+)
+
+func NewLoginNonce(db *mongo.Database) (chat.LoginNonceInterface, error) {
+	coll := db.Collection("login_nonce")
+	_, err := coll.Indexes().CreateMany(context.Background(), []mongo.IndexModel{
+		{
+			Keys: bson.D{
+				{Key: "nonce", Value: 1},
+			},
+			Options: options.Index().SetUnique(true),
+		},
+		{
+			Keys: bson.D{
+				{Key: "expire_time", Value: 1},
+			},
+			Options: options.Index().SetExpireAfterSeconds(0),
+		},
+	})
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return &LoginNonce{coll: coll}, nil
+}
+
+type LoginNonce struct {
+	coll *mongo.Collection
+}
+
+func (o *LoginNonce) Create(ctx context.Context, nonces ...*chat.LoginNonce) error {
+	return mongoutil.InsertMany(ctx, o.coll, nonces)
+}
+
+func (o *LoginNonce) Take(ctx context.Context, nonce string) (*chat.LoginNonce, error) {
+	return mongoutil.FindOne[*chat.LoginNonce](ctx, o.coll, bson.M{"nonce": nonce})
+}
+
+func (o *LoginNonce) MarkUsed(ctx context.Context, nonce string) (bool, error) {
+	res, err := mongoutil.UpdateOneResult(ctx, o.coll, bson.M{"nonce": nonce, "used": false}, bson.M{"$set": bson.M{"used": true}})
+	if err != nil {
+		return false, err
+	}
+	return res.ModifiedCount > 0, nil
+}
+
+func (o *LoginNonce) DeleteByAddress(ctx context.Context, addresses []string) (int64, error) {
+	if len(addresses) == 0 {
+		return 0, nil
+	}
+	// nonces keep the address as the client sent it
+	patterns := make(bson.A, 0, len(addresses))
+	for _, address := range addresses {
+		patterns = append(patterns, primitive.Regex{Pattern: "^" + regexp.QuoteMeta(address) + "$", Options: "i"})
+	}
+	return dbutil.DeleteMany(ctx, o.coll, bson.M{"address": bson.M{"$in": patterns}})
+}
@@ -0,0 +1,142 @@
+package chat
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/tools/db/mongoutil"
+	"github.com/openimsdk/tools/errs"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/openimsdk/chat/pkg/common/constant"
+	"github.com/openimsdk/chat/pkg/common/db/dbutil"
+	"github.com/openimsdk/chat/pkg/common/db/table/chat"
+)
+
+func NewNotification(db *mongo.Database) (chat.NotificationInterface, error) {
+	coll := db.Collection("notification")
+	_, err := coll.Indexes().CreateMany(context.Background(), []mongo.IndexModel{
+		{
+			Keys: bson.D{
+				{Key: "notification_id", Value: 1},
+			},
+			Options: options.Index().SetUnique(true),
+		},
+		{
+			Keys: bson.D{
+				{Key: "user_id", Value: 1},
+				{Key: "update_time", Value: -1},
+			},
+		},
+		{
+			Keys: bson.D{
+				{Key: "user_id", Value: 1},
+				{Key: "is_read", Value: 1},
+			},
+		},
+		{
+			// 每个用户每组只有一条未读的聚合通知
+			Keys: bson.D{
+				{Key: "user_id", Value: 1},
+				{Key: "group_key", Value: 1},
+			},
+			Options: options.Index().SetUnique(true).SetPartialFilterExpression(bson.M{
+				"is_read":   constant.NotificationUnread,
+				"group_key": bson.M{"$type": "string"},
+			}),
+		},
+	})
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return &Notification{coll: coll}, nil
+}
+
+type Notification struct {
+	coll *mongo.Collection
+}
+
+func (o *Notification) Create(ctx context.Context, notifications []*chat.Notification) error {
+	for i, notification := range notifications {
+		if notification.CreateTime.IsZero() {
+			notifications[i].CreateTime = time.Now()
+		}
+		if notification.UpdateTime.IsZero() {
+			notifications[i].UpdateTime = notifications[i].CreateTime
+		}
+	}
+	return mongoutil.InsertMany(ctx, o.coll, notifications)
+}
+
+func (o *Notification) Aggregate(ctx context.Context, notification *chat.Notification, opUserID string) error {
+	now := time.Now()
+	filter := bson.M{
+		"user_id":   notification.UserID,
+		"group_key": notification.GroupKey,
+		"is_read":   constant.NotificationUnread,
+	}
+	// 操作人移到最前面，重复操作不重复计数
+	opUserIDs := bson.M{"$concatArrays": bson.A{
+		bson.A{bson.M{"$literal": opUserID}},
+		bson.M{"$filter": bson.M{
+			"input": bson.M{"$ifNull": bson.A{"$op_user_ids", bson.A{}}},
+			"cond":  bson.M{"$ne": bson.A{"$$this", bson.M{"$literal": opUserID}}},
+		}},
+	}}
+	update := mongo.Pipeline{
+		{{Key: "$set", Value: bson.M{
+			"notification_id": bson.M{"$ifNull": bson.A{"$notification_id", notification.NotificationID}},
+			"type":            notification.Type,
+			"post_id":         notification.PostID,
+			"source_post_id":  notification.SourcePostID,
+			"op_user_ids":     opUserIDs,
+			"create_time":     bson.M{"$ifNull": bson.A{"$create_time", now}},
+			"update_time":     now,
+		}}},
+		{{Key: "$set", Value: bson.M{"op_count": bson.M{"$size": "$op_user_ids"}}}},
+	}
+	return mongoutil.UpdateOne(ctx, o.coll, filter, update, false, options.Update().SetUpsert(true))
+}
+
+func (o *Notification) FindByCursor(ctx context.Context, userID string, cursor int64, count int64) ([]*chat.Notification, string, error) {
+	filter := bson.M{"user_id": userID}
+	sort := bson.D{{Key: "update_time", Value: -1}}
+	return dbutil.FindPageWithCursor[*chat.Notification](ctx, o.coll, cursor, "UpdateTime", -1, count, filter, sort, nil)
+}
+
+func (o *Notification) CountUnread(ctx context.Context, userID string) (int64, error) {
+	return mongoutil.Count(ctx, o.coll, bson.M{"user_id": userID, "is_read": constant.NotificationUnread})
+}
+
+func (o *Notification) MarkRead(ctx context.Context, userID string, notificationIDs []string) error {
+	filter := bson.M{"user_id": userID, "is_read": constant.NotificationUnread}
+	if len(notificationIDs) > 0 {
+		filter["notification_id"] = bson.M{"$in": notificationIDs}
+	}
+	_, err := mongoutil.UpdateMany(ctx, o.coll, filter, bson.M{"$set": bson.M{"is_read": constant.NotificationRead}})
+	return err
+}
+
+func (o *Notification) DeleteByPostIDs(ctx context.Context, postIDs []string) error {
+	if len(postIDs) == 0 {
+		return nil
+	}
+	return mongoutil.DeleteMany(ctx, o.coll, bson.M{"$or": bson.A{
+		bson.M{"post_id": bson.M{"$in": postIDs}},
+		bson.M{"source_post_id": bson.M{"$in": postIDs}},
+	}})
+}
+
+func (o *Notification) DeleteByUserID(ctx context.Context, userID string) (int64, error) {
+	return dbutil.DeleteMany(ctx, o.coll, bson.M{"user_id": userID})
+}
+
+func (o *Notification) PullOpUser(ctx context.Context, opUserID string) (int64, error) {
+	res, err := mongoutil.UpdateMany(ctx, o.coll, bson.M{"op_user_ids": opUserID}, bson.M{"$pull": bson.M{"op_user_ids": opUserID}})
+	if err != nil {
+		return 0, err
+	}
+	return res.ModifiedCount, nil
+}
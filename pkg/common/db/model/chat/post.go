@@ -64,8 +64,19 @@ func (o *Post) Delete(ctx context.Context, postIDs []string) error {
 	return mongoutil.DeleteMany(ctx, o.coll, bson.M{"post_id": bson.M{"$in": postIDs}})
 }
 func (o *Post) Take(ctx context.Context, postID string) (*chat.Post, error) {
-	filter := bson.M{"post_id": postID}
-	results, err := mongoutil.Aggregate[*chat.Post](ctx, o.coll, GetAggregationPipeline(ctx, filter))
+	return o.take(ctx, bson.M{"post_id": postID}, nil)
+}
+
+func (o *Post) TakeVisible(ctx context.Context, postID string) (*chat.Post, error) {
+	visible, err := o.visibleFilter(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return o.take(ctx, bson.M{"$and": bson.A{bson.M{"post_id": postID}, visible}}, visible)
+}
+
+func (o *Post) take(ctx context.Context, filter bson.M, visible bson.M) (*chat.Post, error) {
+	results, err := mongoutil.Aggregate[*chat.Post](ctx, o.coll, GetAggregationPipeline(ctx, visible, filter))
 	if err != nil {
 		return nil, err
 	}
@@ -77,6 +88,73 @@ func (o *Post) Take(ctx context.Context, postID string) (*chat.Post, error) {
 	return results[0], nil
 }
 
+// visibleFilter 当前用户能看到的帖子，关注和好友关系与 GetFollowedUserIDs 一样来自 friend_relation
+func (o *Post) visibleFilter(ctx context.Context) (bson.M, error) {
+	opUserID, _ := mctx.CheckUser(ctx)
+	if opUserID == "" {
+		return bson.M{"visibility": bson.M{"$in": bson.A{constant.VisibilityPublic, nil}}}, nil
+	}
+	following, err := o.GetFollowedUserIDs(ctx, opUserID)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	friends, err := o.getFriendUserIDs(ctx, opUserID, following)
+	if err != nil {
+		return nil, err
+	}
+	return visibleFilter(opUserID, following, friends), nil
+}
+
+func visibleFilter(opUserID string, following []string, friends []string) bson.M {
+	return bson.M{"$or": bson.A{
+		bson.M{"user_id": opUserID},
+		bson.M{"visibility": bson.M{"$in": bson.A{constant.VisibilityPublic, nil}}},
+		bson.M{"visibility": constant.VisibilityFollowers, "user_id": bson.M{"$in": following}},
+		bson.M{"visibility": constant.VisibilityFriends, "user_id": bson.M{"$in": friends}},
+		bson.M{"visibility": constant.VisibilityCustom, "allow_user_ids": opUserID},
+	}}
+}
+
+// getFriendUserIDs 在 userID 关注的用户中找出同样关注 userID 的用户
+func (o *Post) getFriendUserIDs(ctx context.Context, userID string, following []string) ([]string, error) {
+	friends := make([]string, 0)
+	if len(following) == 0 {
+		return friends, nil
+	}
+	filter := bson.M{
+		"owner_user_id":   bson.M{"$in": following},
+		"related_user_id": userID,
+		"is_following":    1,
+		"is_blocked":      0,
+	}
+	opts := options.Find().SetProjection(bson.M{"owner_user_id": 1, "_id": 0})
+	results, err := mongoutil.Find[*struct {
+		OwnerUserID string `bson:"owner_user_id"`
+	}](ctx, o.coll.Database().Collection("friend_relation"), filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	for _, result := range results {
+		friends = append(friends, result.OwnerUserID)
+	}
+	return friends, nil
+}
+
+// findVisiblePage 只在当前用户可见的帖子中分页
+func (o *Post) findVisiblePage(ctx context.Context, cursor int64, count int64, filter bson.M, sort bson.D) ([]*chat.Post, string, error) {
+	visible, err := o.visibleFilter(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+	filter = bson.M{"$and": bson.A{filter, visible}}
+	// 先排序分页，关联查询只对当前页执行，关联阶段不会过滤或改变排序字段
+	pipeline := append(mongo.Pipeline{
+		{{Key: "$sort", Value: sort}},
+		{{Key: "$limit", Value: count}},
+	}, GetAggregationPipeline(ctx, visible)...)
+	return dbutil.FindPageWithCursor[*chat.Post](ctx, o.coll, cursor, "CreateTime", -1, count, filter, sort, pipeline)
+}
+
 func (o *Post) UpdateByMap(ctx context.Context, postID string, data map[string]any) error {
 	if len(data) == 0 {
 		return nil
@@ -86,6 +164,148 @@ func (o *Post) UpdateByMap(ctx context.Context, postID string, data map[string]a
 	return mongoutil.UpdateOne(ctx, o.coll, filter, bson.M{"$set": data}, false)
 }
 
+func (o *Post) IncrCount(ctx context.Context, postID string, field string, delta int64) error {
+	filter := bson.M{"post_id": postID}
+	return mongoutil.UpdateOne(ctx, o.coll, filter, bson.M{"$inc": bson.M{field: delta}}, false)
+}
+
+const reconcileBatchSize = 500
+
+type postCounts struct {
+	PostID       string `bson:"post_id"`
+	LikeCount    int64  `bson:"like_count"`
+	CollectCount int64  `bson:"collect_count"`
+	ForwardCount int64  `bson:"forward_count"`
+	CommentCount int64  `bson:"comment_count"`
+}
+
+func (o *Post) ReconcileCounts(ctx context.Context, postIDs []string) (int64, error) {
+	filter := bson.M{}
+	if len(postIDs) > 0 {
+		filter["post_id"] = bson.M{"$in": postIDs}
+	}
+	return o.reconcile(ctx, filter, false)
+}
+
+// BackfillCounts 计算计数字段加入之前创建的帖子，字段缺失时即使计数为 0 也写入，之后不会再被选中
+func (o *Post) BackfillCounts(ctx context.Context) (int64, error) {
+	filter := bson.M{"$or": []bson.M{
+		{"like_count": bson.M{"$exists": false}},
+		{"collect_count": bson.M{"$exists": false}},
+		{"forward_count": bson.M{"$exists": false}},
+		{"comment_count": bson.M{"$exists": false}},
+	}}
+	return o.reconcile(ctx, filter, true)
+}
+
+// reconcile 重新计算 filter 选中的帖子，force 时不比较直接写入
+func (o *Post) reconcile(ctx context.Context, filter bson.M, force bool) (int64, error) {
+	opts := options.Find().
+		SetProjection(bson.M{"_id": 0, "post_id": 1, "like_count": 1, "collect_count": 1, "forward_count": 1, "comment_count": 1}).
+		SetBatchSize(reconcileBatchSize)
+	cur, err := o.coll.Find(ctx, filter, opts)
+	if err != nil {
+		return 0, errs.Wrap(err)
+	}
+	defer cur.Close(ctx)
+
+	var (
+		updated int64
+		batch   = make([]*postCounts, 0, reconcileBatchSize)
+	)
+	for cur.Next(ctx) {
+		var stored postCounts
+		if err := cur.Decode(&stored); err != nil {
+			return updated, errs.Wrap(err)
+		}
+		batch = append(batch, &stored)
+		if len(batch) < reconcileBatchSize {
+			continue
+		}
+		n, err := o.reconcileBatch(ctx, batch, force)
+		updated += n
+		if err != nil {
+			return updated, err
+		}
+		batch = batch[:0]
+	}
+	if err := cur.Err(); err != nil {
+		return updated, errs.Wrap(err)
+	}
+	if len(batch) > 0 {
+		n, err := o.reconcileBatch(ctx, batch, force)
+		updated += n
+		if err != nil {
+			return updated, err
+		}
+	}
+	return updated, nil
+}
+
+// 用 user_post_relation 和评论帖子重新计算一批帖子的计数，只更新不一致的帖子
+func (o *Post) reconcileBatch(ctx context.Context, batch []*postCounts, force bool) (int64, error) {
+	postIDs := make([]string, 0, len(batch))
+	for _, stored := range batch {
+		postIDs = append(postIDs, stored.PostID)
+	}
+	relations, err := mongoutil.Aggregate[*postCounts](ctx, o.coll.Database().Collection("user_post_relation"), mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{"post_id": bson.M{"$in": postIDs}}}},
+		{{Key: "$group", Value: bson.D{
+			{Key: "_id", Value: "$post_id"},
+			{Key: "like_count", Value: bson.M{"$sum": "$is_liked"}},
+			{Key: "collect_count", Value: bson.M{"$sum": "$is_collected"}},
+			{Key: "forward_count", Value: bson.M{"$sum": "$is_forwarded"}},
+		}}},
+		{{Key: "$addFields", Value: bson.M{"post_id": "$_id"}}},
+	})
+	if err != nil {
+		return 0, err
+	}
+	comments, err := mongoutil.Aggregate[*postCounts](ctx, o.coll, mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{"comment_post_id": bson.M{"$in": postIDs}}}},
+		{{Key: "$group", Value: bson.D{
+			{Key: "_id", Value: "$comment_post_id"},
+			{Key: "comment_count", Value: bson.M{"$sum": 1}},
+		}}},
+		{{Key: "$addFields", Value: bson.M{"post_id": "$_id"}}},
+	})
+	if err != nil {
+		return 0, err
+	}
+	actual := make(map[string]*postCounts, len(batch))
+	for _, stored := range batch {
+		actual[stored.PostID] = &postCounts{PostID: stored.PostID}
+	}
+	for _, r := range relations {
+		if c, ok := actual[r.PostID]; ok {
+			c.LikeCount, c.CollectCount, c.ForwardCount = r.LikeCount, r.CollectCount, r.ForwardCount
+		}
+	}
+	for _, r := range comments {
+		if c, ok := actual[r.PostID]; ok {
+			c.CommentCount = r.CommentCount
+		}
+	}
+	var updated int64
+	for _, stored := range batch {
+		c := actual[stored.PostID]
+		if !force && *c == *stored {
+			continue
+		}
+		update := bson.M{"$set": bson.M{
+			"like_count":    c.LikeCount,
+			"collect_count": c.CollectCount,
+			"forward_count": c.ForwardCount,
+			"comment_count": c.CommentCount,
+		}}
+		if err := mongoutil.UpdateOne(ctx, o.coll, bson.M{"post_id": c.PostID}, update, false); err != nil {
+			return updated, err
+		}
+		updated++
+	}
+	return updated, nil
+}
+
 func (o *Post) GetPostsByCursorAndUserIDs(ctx context.Context, cursor int64, userIDs []string, count int64) ([]*chat.Post, string, error) {
 	filter := bson.M{
 		"user_id": bson.M{"$in": userIDs},
@@ -95,26 +315,22 @@ func (o *Post) GetPostsByCursorAndUserIDs(ctx context.Context, cursor int64, use
 			{"comment_post_id": bson.M{"$exists": false}},
 		},
 	}
-	sort := o.sortByCreateTime()
-	return dbutil.FindPageWithCursor[*chat.Post](ctx, o.coll, cursor, "CreateTime", -1, count, filter, sort, GetAggregationPipeline(ctx))
+	return o.findVisiblePage(ctx, cursor, count, filter, o.sortByCreateTime())
 }
 
 func (o *Post) GetPostsByCursorAndUser(ctx context.Context, cursor int64, userID string, count int64) ([]*chat.Post, string, error) {
 	filter := bson.M{"user_id": userID}
-	sort := o.sortByPinedAndCreateTime()
-	return dbutil.FindPageWithCursor[*chat.Post](ctx, o.coll, cursor, "CreateTime", -1, count, filter, sort, GetAggregationPipeline(ctx))
+	return o.findVisiblePage(ctx, cursor, count, filter, o.sortByPinedAndCreateTime())
 }
 
 func (o *Post) GetPostsByCursorAndPostIDs(ctx context.Context, cursor int64, postIDs []string, count int64) ([]*chat.Post, string, error) {
 	filter := bson.M{"post_id": bson.M{"$in": postIDs}}
-	sort := o.sortByCreateTime()
-	return dbutil.FindPageWithCursor[*chat.Post](ctx, o.coll, cursor, "CreateTime", -1, count, filter, sort, GetAggregationPipeline(ctx))
+	return o.findVisiblePage(ctx, cursor, count, filter, o.sortByCreateTime())
 }
 
 func (o *Post) GetCommentPostsByPostID(ctx context.Context, cursor int64, postID string, count int64) ([]*chat.Post, string, error) {
 	filter := bson.M{"comment_post_id": postID}
-	sort := o.sortByCreateTime()
-	return dbutil.FindPageWithCursor[*chat.Post](ctx, o.coll, cursor, "CreateTime", -1, count, filter, sort, GetAggregationPipeline(ctx))
+	return o.findVisiblePage(ctx, cursor, count, filter, o.sortByCreateTime())
 }
 
 func (o *Post) GetCommentPostIDsByUser(ctx context.Context, userID string) ([]string, error) {
@@ -190,7 +406,9 @@ func (o *Post) GetSubscriberUserIDs(ctx context.Context, userID string) ([]strin
 
 }
 
-func GetAggregationPipeline(ctx context.Context, filter ...bson.M) mongo.Pipeline {
+// GetAggregationPipeline 关联帖子的作者、当前用户关系和转发、评论、引用的帖子
+// visible 不为空时，关联的帖子对当前用户不可见则为空
+func GetAggregationPipeline(ctx context.Context, visible bson.M, filter ...bson.M) mongo.Pipeline {
 	opUserID, _ := mctx.CheckUser(ctx)
 	var _pipeline []bson.D
 	if len(filter) > 0 {
@@ -199,15 +417,14 @@ func GetAggregationPipeline(ctx context.Context, filter ...bson.M) mongo.Pipelin
 	_pipeline = append(_pipeline,
 		lookupUserInfo(),
 		unwindUserInfo(),
-		lookupRelations(),
-		lookupPost(ForwardPost, opUserID, 2),
+		lookupRelations(opUserID),
+		lookupPost(ForwardPost, opUserID, visible, 2),
 		unwindPost(ForwardPost),
-		lookupPost(CommentPost, opUserID, 2),
+		lookupPost(CommentPost, opUserID, visible, 2),
 		unwindPost(CommentPost),
-		lookupPost(RefPost, opUserID, 2),
+		lookupPost(RefPost, opUserID, visible, 2),
 		unwindPost(RefPost),
 		lookupAtUserInfo(),
-		lookupCommentPostsByUserID(opUserID),
 		addFields(opUserID),
 	)
 
@@ -222,7 +439,7 @@ const (
 	RefPost     PostLookupType = "ref"
 )
 
-func lookupPost(lookupType PostLookupType, opUserID string, maxDepth int) bson.D {
+func lookupPost(lookupType PostLookupType, opUserID string, visible bson.M, maxDepth int) bson.D {
 
 	var matchField string
 	var asField string
@@ -249,19 +466,23 @@ func lookupPost(lookupType PostLookupType, opUserID string, maxDepth int) bson.D
 				{Key: "$expr", Value: bson.D{{Key: "$eq", Value: bson.A{"$post_id", "$$postId"}}}},
 			}},
 		},
+	}
+	if visible != nil {
+		pipeline = append(pipeline, bson.D{{Key: "$match", Value: visible}})
+	}
+	pipeline = append(pipeline,
 		lookupUserInfo(),
 		unwindUserInfo(),
-		lookupRelations(),
-		lookupPost(ForwardPost, opUserID, maxDepth-1),
+		lookupRelations(opUserID),
+		lookupPost(ForwardPost, opUserID, visible, maxDepth-1),
 		unwindPost(ForwardPost),
-		lookupPost(CommentPost, opUserID, maxDepth-1),
+		lookupPost(CommentPost, opUserID, visible, maxDepth-1),
 		unwindPost(CommentPost),
-		lookupPost(RefPost, opUserID, maxDepth-1),
+		lookupPost(RefPost, opUserID, visible, maxDepth-1),
 		unwindPost(RefPost),
 		lookupAtUserInfo(),
-		lookupCommentPostsByUserID(opUserID),
 		addFields(opUserID),
-	}
+	)
 
 	return bson.D{
 		{Key: "$lookup", Value: bson.D{
@@ -294,29 +515,6 @@ func unwindPost(lookupType PostLookupType) bson.D {
 	}
 }
 
-func lookupCommentPostsByUserID(opUserID string) bson.D {
-	return bson.D{
-		{Key: "$lookup", Value: bson.D{
-			{Key: "from", Value: "post"},
-			{Key: "let", Value: bson.D{{Key: "postId", Value: "$post_id"}}},
-			{Key: "pipeline", Value: bson.A{
-				bson.D{
-					{Key: "$match", Value: bson.D{
-						{Key: "$expr", Value: bson.D{
-							{Key: "$and", Value: bson.A{
-								bson.D{{Key: "$eq", Value: bson.A{"$comment_post_id", "$$postId"}}},
-								bson.D{{Key: "$eq", Value: bson.A{"$user_id", opUserID}}},
-							}},
-						}},
-					}},
-				},
-				bson.D{{Key: "$count", Value: "count"}},
-			}},
-			{Key: "as", Value: "comment_counts"},
-		}},
-	}
-}
-
 func lookupUserInfo() bson.D {
 	return bson.D{
 		{Key: "$lookup", Value: bson.D{
@@ -337,12 +535,24 @@ func unwindUserInfo() bson.D {
 	}
 }
 
-func lookupRelations() bson.D {
+// 只关联当前用户的关系，计数已经保存在帖子上
+func lookupRelations(opUserID string) bson.D {
 	return bson.D{
 		{Key: "$lookup", Value: bson.D{
 			{Key: "from", Value: "user_post_relation"},
-			{Key: "localField", Value: "post_id"},
-			{Key: "foreignField", Value: "post_id"},
+			{Key: "let", Value: bson.D{{Key: "postId", Value: "$post_id"}}},
+			{Key: "pipeline", Value: bson.A{
+				bson.D{
+					{Key: "$match", Value: bson.D{
+						{Key: "$expr", Value: bson.D{
+							{Key: "$and", Value: bson.A{
+								bson.D{{Key: "$eq", Value: bson.A{"$post_id", "$$postId"}}},
+								bson.D{{Key: "$eq", Value: bson.A{"$user_id", opUserID}}},
+							}},
+						}},
+					}},
+				},
+			}},
 			{Key: "as", Value: "relations"},
 		}},
 	}
@@ -383,36 +593,16 @@ func lookupAtUserInfo() bson.D {
 func addFields(opUserID string) bson.D {
 	return bson.D{
 		{Key: "$addFields", Value: bson.D{
-			{Key: "comment_count", Value: getCommentCountField()},
-			{Key: "like_count", Value: getCountField("is_liked")},
-			{Key: "forward_count", Value: getCountField("is_forwarded")},
 			{Key: "is_liked", Value: getIsField("is_liked", opUserID)},
 			{Key: "is_collected", Value: getIsField("is_collected", opUserID)},
 			{Key: "is_commented", Value: getIsField("is_commented", opUserID)},
 			{Key: "is_forwarded", Value: getIsField("is_forwarded", opUserID)},
-		}},
-	}
-}
-
-func getCommentCountField() bson.D {
-	return bson.D{
-		{Key: "$ifNull", Value: bson.A{
-			bson.D{{Key: "$first", Value: "$comment_counts.count"}},
-			0,
-		}},
-	}
-}
-
-func getCountField(fieldName string) bson.D {
-	return bson.D{
-		{Key: "$size", Value: bson.D{
-			{Key: "$filter", Value: bson.D{
-				{Key: "input", Value: "$relations"},
-				{Key: "as", Value: "relation"},
-				{Key: "cond", Value: bson.D{
-					{Key: "$eq", Value: bson.A{fmt.Sprintf("$$relation.%s", fieldName), 1}},
-				}},
-			}},
+			// 可见名单只返回给作者
+			{Key: "allow_user_ids", Value: bson.D{{Key: "$cond", Value: bson.A{
+				bson.D{{Key: "$eq", Value: bson.A{"$user_id", opUserID}}},
+				"$allow_user_ids",
+				"$$REMOVE",
+			}}}},
 		}},
 	}
 }
@@ -443,3 +633,26 @@ func getIsField(fieldName string, opUserID string) bson.D {
 		}},
 	}
 }
+
+func (o *Post) FindByUserID(ctx context.Context, userID string) ([]*chat.PostDB, error) {
+	opts := options.Find().SetProjection(bson.M{"_id": 0, "post_id": 1, "comment_post_id": 1, "forward_post_id": 1, "ref_post_id": 1})
+	return mongoutil.Find[*chat.PostDB](ctx, o.coll, bson.M{"user_id": userID}, opts)
+}
+
+func (o *Post) FindAllByUserID(ctx context.Context, userID string) ([]*chat.PostDB, error) {
+	opts := options.Find().SetSort(bson.D{{Key: "create_time", Value: 1}})
+	return mongoutil.Find[*chat.PostDB](ctx, o.coll, bson.M{"user_id": userID}, opts)
+}
+
+func (o *Post) PullUser(ctx context.Context, userID string) (int64, error) {
+	filter := bson.M{"$or": bson.A{
+		bson.M{"at_user_ids": userID},
+		bson.M{"allow_user_ids": userID},
+	}}
+	update := bson.M{"$pull": bson.M{"at_user_ids": userID, "allow_user_ids": userID}}
+	res, err := mongoutil.UpdateMany(ctx, o.coll, filter, update)
+	if err != nil {
+		return 0, err
+	}
+	return res.ModifiedCount, nil
+}
@@ -0,0 +1,153 @@
+package chat
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"os"
+	"strconv"
+	"testing"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/openimsdk/chat/pkg/common/db/table/chat"
+)
+
+// Feed benchmarks need a MongoDB, e.g.
+//
+//	CHAT_BENCH_MONGO=mongodb://127.0.0.1:27017 go test -run '^$' -bench Feed ./pkg/common/db/model/chat/
+const (
+	benchUsers     = 50
+	benchPosts     = 5000
+	benchRelations = 40
+	benchPage      = 20
+)
+
+func benchDB(b *testing.B) (*mongo.Database, []string) {
+	uri := os.Getenv("CHAT_BENCH_MONGO")
+	if uri == "" {
+		b.Skip("CHAT_BENCH_MONGO not set")
+	}
+	ctx := context.Background()
+	cli, err := mongo.Connect(ctx, options.Client().ApplyURI(uri))
+	if err != nil {
+		b.Fatal(err)
+	}
+	db := cli.Database("chat_bench_" + strconv.FormatInt(time.Now().UnixNano(), 36))
+	b.Cleanup(func() {
+		_ = db.Drop(context.Background())
+		_ = cli.Disconnect(context.Background())
+	})
+	if _, err := NewPost(db); err != nil {
+		b.Fatal(err)
+	}
+	if _, err := NewUserPostRelation(db); err != nil {
+		b.Fatal(err)
+	}
+
+	userIDs := make([]string, benchUsers)
+	for i := range userIDs {
+		userIDs[i] = fmt.Sprintf("u%d", i)
+	}
+	r := rand.New(rand.NewSource(1))
+	now := time.Now()
+	posts := make([]any, 0, benchPosts)
+	var relations []any
+	for i := 0; i < benchPosts; i++ {
+		post := &chat.PostDB{
+			PostID:     strconv.Itoa(i),
+			UserID:     userIDs[i%benchUsers],
+			Content:    "bench",
+			CreateTime: now.Add(-time.Duration(i) * time.Second),
+			UpdateTime: now,
+		}
+		for j := 0; j < r.Intn(benchRelations); j++ {
+			relations = append(relations, &chat.UserPostRelation{
+				UserID:  fmt.Sprintf("r%d", j),
+				PostID:  post.PostID,
+				IsLiked: 1,
+			})
+			post.LikeCount++
+		}
+		posts = append(posts, post)
+	}
+	if _, err := db.Collection("post").InsertMany(ctx, posts); err != nil {
+		b.Fatal(err)
+	}
+	if _, err := db.Collection("user_post_relation").InsertMany(ctx, relations); err != nil {
+		b.Fatal(err)
+	}
+	return db, userIDs
+}
+
+// legacyFeedPipeline is the feed query before counters were stored on the post:
+// every matched post joined all of its relations and was counted before the page was cut.
+func legacyFeedPipeline(userIDs []string, opUserID string) mongo.Pipeline {
+	countField := func(field string) bson.D {
+		return bson.D{{Key: "$size", Value: bson.D{{Key: "$filter", Value: bson.D{
+			{Key: "input", Value: "$relations"},
+			{Key: "as", Value: "relation"},
+			{Key: "cond", Value: bson.D{{Key: "$eq", Value: bson.A{"$$relation." + field, 1}}}},
+		}}}}}
+	}
+	return mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{"user_id": bson.M{"$in": userIDs}}}},
+		lookupUserInfo(),
+		unwindUserInfo(),
+		{{Key: "$lookup", Value: bson.D{
+			{Key: "from", Value: "user_post_relation"},
+			{Key: "localField", Value: "post_id"},
+			{Key: "foreignField", Value: "post_id"},
+			{Key: "as", Value: "relations"},
+		}}},
+		{{Key: "$lookup", Value: bson.D{
+			{Key: "from", Value: "post"},
+			{Key: "let", Value: bson.D{{Key: "postId", Value: "$post_id"}}},
+			{Key: "pipeline", Value: bson.A{
+				bson.D{{Key: "$match", Value: bson.D{{Key: "$expr", Value: bson.D{{Key: "$eq", Value: bson.A{"$comment_post_id", "$$postId"}}}}}}},
+				bson.D{{Key: "$count", Value: "count"}},
+			}},
+			{Key: "as", Value: "comment_counts"},
+		}}},
+		{{Key: "$addFields", Value: bson.D{
+			{Key: "comment_count", Value: bson.D{{Key: "$ifNull", Value: bson.A{bson.D{{Key: "$first", Value: "$comment_counts.count"}}, 0}}}},
+			{Key: "like_count", Value: countField("is_liked")},
+			{Key: "forward_count", Value: countField("is_forwarded")},
+			{Key: "is_liked", Value: getIsField("is_liked", opUserID)},
+		}}},
+		{{Key: "$sort", Value: bson.D{{Key: "create_time", Value: -1}}}},
+		{{Key: "$limit", Value: benchPage}},
+	}
+}
+
+func BenchmarkFeedLegacy(b *testing.B) {
+	db, userIDs := benchDB(b)
+	ctx := context.Background()
+	coll := db.Collection("post")
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		cur, err := coll.Aggregate(ctx, legacyFeedPipeline(userIDs, "r1"))
+		if err != nil {
+			b.Fatal(err)
+		}
+		var posts []*chat.Post
+		if err := cur.All(ctx, &posts); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkFeed(b *testing.B) {
+	db, userIDs := benchDB(b)
+	ctx := context.Background()
+	post := &Post{coll: db.Collection("post")}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, _, err := post.GetPostsByCursorAndUserIDs(ctx, 0, userIDs, benchPage); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
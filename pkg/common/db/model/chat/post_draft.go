@@ -0,0 +1,131 @@
+package chat
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/tools/db/mongoutil"
+	"github.com/openimsdk/tools/db/pagination"
+	"github.com/openimsdk/tools/errs"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/openimsdk/chat/pkg/common/constant"
+	"github.com/openimsdk/chat/pkg/common/db/dbutil"
+	"github.com/openimsdk/chat/pkg/common/db/table/chat"
+)
+
+func NewPostDraft(db *mongo.Database) (chat.PostDraftInterface, error) {
+	coll := db.Collection("post_drafts")
+	_, err := coll.Indexes().CreateMany(context.Background(), []mongo.IndexModel{
+		{
+			Keys: bson.D{
+				{Key: "draft_id", Value: 1},
+			},
+			Options: options.Index().SetUnique(true),
+		},
+		{
+			Keys: bson.D{
+				{Key: "user_id", Value: 1},
+				{Key: "update_time", Value: -1},
+			},
+		},
+		{
+			Keys: bson.D{
+				{Key: "status", Value: 1},
+				{Key: "publish_at", Value: 1},
+			},
+		},
+	})
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return &PostDraft{coll: coll}, nil
+}
+
+type PostDraft struct {
+	coll *mongo.Collection
+}
+
+// 没有租约或租约已过期
+func notClaimed(now time.Time) bson.M {
+	return bson.M{"lease_until": bson.M{"$lte": now}}
+}
+
+// 到了发布时间且没有被领取的定时草稿
+func dueFilter(now time.Time) bson.M {
+	filter := notClaimed(now)
+	filter["status"] = constant.DraftScheduled
+	filter["publish_at"] = bson.M{"$lte": now}
+	return filter
+}
+
+func (o *PostDraft) Create(ctx context.Context, drafts []*chat.PostDraft) error {
+	for i, draft := range drafts {
+		if draft.CreateTime.IsZero() {
+			drafts[i].CreateTime = time.Now()
+		}
+		if draft.UpdateTime.IsZero() {
+			drafts[i].UpdateTime = drafts[i].CreateTime
+		}
+	}
+	return mongoutil.InsertMany(ctx, o.coll, drafts)
+}
+
+func (o *PostDraft) Take(ctx context.Context, draftID string) (*chat.PostDraft, error) {
+	return mongoutil.FindOne[*chat.PostDraft](ctx, o.coll, bson.M{"draft_id": draftID})
+}
+
+func (o *PostDraft) UpdateByMap(ctx context.Context, draftID string, data map[string]any) (bool, error) {
+	now := time.Now()
+	filter := notClaimed(now)
+	filter["draft_id"] = draftID
+	data["update_time"] = now
+	res, err := mongoutil.UpdateOneResult(ctx, o.coll, filter, bson.M{"$set": data})
+	if err != nil {
+		return false, err
+	}
+	return res.MatchedCount > 0, nil
+}
+
+func (o *PostDraft) Delete(ctx context.Context, userID string, draftIDs []string) error {
+	if len(draftIDs) == 0 {
+		return nil
+	}
+	filter := notClaimed(time.Now())
+	filter["user_id"] = userID
+	filter["draft_id"] = bson.M{"$in": draftIDs}
+	return mongoutil.DeleteMany(ctx, o.coll, filter)
+}
+
+func (o *PostDraft) FindByUser(ctx context.Context, userID string, pagination pagination.Pagination) (int64, []*chat.PostDraft, error) {
+	opts := options.Find().SetSort(bson.D{{Key: "update_time", Value: -1}})
+	return mongoutil.FindPage[*chat.PostDraft](ctx, o.coll, bson.M{"user_id": userID}, pagination, opts)
+}
+
+func (o *PostDraft) ClaimDue(ctx context.Context, owner string, lease time.Duration) (*chat.PostDraft, error) {
+	now := time.Now()
+	update := bson.M{"$set": bson.M{"lease_owner": owner, "lease_until": now.Add(lease)}}
+	opts := options.FindOneAndUpdate().
+		SetSort(bson.D{{Key: "publish_at", Value: 1}}).
+		SetReturnDocument(options.After)
+	return mongoutil.FindOneAndUpdate[*chat.PostDraft](ctx, o.coll, dueFilter(now), update, opts)
+}
+
+func (o *PostDraft) SetPostID(ctx context.Context, draftID string, owner string, postID string) (bool, error) {
+	filter := bson.M{"draft_id": draftID, "lease_owner": owner, "post_id": ""}
+	res, err := mongoutil.UpdateOneResult(ctx, o.coll, filter, bson.M{"$set": bson.M{"post_id": postID}})
+	if err != nil {
+		return false, err
+	}
+	return res.MatchedCount > 0, nil
+}
+
+func (o *PostDraft) DeleteClaimed(ctx context.Context, draftID string, owner string) error {
+	return mongoutil.DeleteOne(ctx, o.coll, bson.M{"draft_id": draftID, "lease_owner": owner})
+}
+
+func (o *PostDraft) DeleteByUserID(ctx context.Context, userID string) (int64, error) {
+	return dbutil.DeleteMany(ctx, o.coll, bson.M{"user_id": userID})
+}
@@ -0,0 +1,48 @@
+package chat
+
+import (
+	"testing"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+
+	"github.com/openimsdk/chat/pkg/common/constant"
+)
+
+func TestDueFilter(t *testing.T) {
+	now := time.Now()
+	past, future := now.Add(-time.Minute), now.Add(time.Minute)
+	drafts := map[string]bson.M{
+		"due":            {"status": int32(constant.DraftScheduled), "publish_at": past, "lease_until": time.Time{}},
+		"due now":        {"status": int32(constant.DraftScheduled), "publish_at": now, "lease_until": time.Time{}},
+		"lease expired":  {"status": int32(constant.DraftScheduled), "publish_at": past, "lease_until": past},
+		"leased":         {"status": int32(constant.DraftScheduled), "publish_at": past, "lease_until": future},
+		"not due":        {"status": int32(constant.DraftScheduled), "publish_at": future, "lease_until": time.Time{}},
+		"not scheduled":  {"status": int32(constant.DraftSaved), "publish_at": past, "lease_until": time.Time{}},
+		"without a date": {"status": int32(constant.DraftScheduled), "lease_until": time.Time{}},
+	}
+	want := map[string]bool{"due": true, "due now": true, "lease expired": true}
+	filter := dueFilter(now)
+	for name, draft := range drafts {
+		if got := matchFilter(t, draft, filter); got != want[name] {
+			t.Errorf("%s draft: claimed %v, want %v", name, got, want[name])
+		}
+	}
+}
+
+func TestNotClaimed(t *testing.T) {
+	now := time.Now()
+	for _, c := range []struct {
+		name       string
+		leaseUntil time.Time
+		want       bool
+	}{
+		{name: "never claimed", leaseUntil: time.Time{}, want: true},
+		{name: "lease expired", leaseUntil: now.Add(-time.Second), want: true},
+		{name: "lease held", leaseUntil: now.Add(time.Second), want: false},
+	} {
+		if got := matchFilter(t, bson.M{"lease_until": c.leaseUntil}, notClaimed(now)); got != c.want {
+			t.Errorf("%s: not claimed %v, want %v", c.name, got, c.want)
+		}
+	}
+}
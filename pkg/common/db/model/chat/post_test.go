@@ -0,0 +1,138 @@
+package chat
+
+import (
+	"testing"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+
+	"github.com/openimsdk/chat/pkg/common/constant"
+)
+
+// matchFilter evaluates the subset of the query language the model filters use:
+// $or, $in, $lte against a date, equality and equality against an array field.
+func matchFilter(t *testing.T, doc bson.M, filter bson.M) bool {
+	t.Helper()
+	for key, cond := range filter {
+		if key == "$or" {
+			matched := false
+			for _, sub := range cond.(bson.A) {
+				if matchFilter(t, doc, sub.(bson.M)) {
+					matched = true
+					break
+				}
+			}
+			if !matched {
+				return false
+			}
+			continue
+		}
+		value := doc[key]
+		if op, ok := cond.(bson.M); ok {
+			if !matchOp(t, value, op) {
+				return false
+			}
+			continue
+		}
+		if !matchValue(value, cond) {
+			return false
+		}
+	}
+	return true
+}
+
+func matchOp(t *testing.T, value any, op bson.M) bool {
+	t.Helper()
+	if len(op) != 1 {
+		t.Fatalf("unsupported condition %v", op)
+	}
+	if in, ok := op["$in"]; ok {
+		return matchIn(value, in)
+	}
+	if lte, ok := op["$lte"].(time.Time); ok {
+		// a missing field is null, which never compares to a date
+		v, ok := value.(time.Time)
+		return ok && !v.After(lte)
+	}
+	t.Fatalf("unsupported condition %v", op)
+	return false
+}
+
+func matchIn(value any, in any) bool {
+	switch list := in.(type) {
+	case bson.A:
+		for _, v := range list {
+			if matchValue(value, v) {
+				return true
+			}
+		}
+	case []string:
+		for _, v := range list {
+			if matchValue(value, v) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func matchValue(value any, want any) bool {
+	if values, ok := value.([]string); ok {
+		for _, v := range values {
+			if v == want {
+				return true
+			}
+		}
+		return false
+	}
+	return normalize(value) == normalize(want)
+}
+
+func normalize(v any) any {
+	switch n := v.(type) {
+	case int:
+		return int64(n)
+	case int32:
+		return int64(n)
+	}
+	return v
+}
+
+func TestVisibleFilter(t *testing.T) {
+	posts := map[string]bson.M{
+		"legacy":    {"user_id": "author"},
+		"public":    {"user_id": "author", "visibility": int32(constant.VisibilityPublic)},
+		"followers": {"user_id": "author", "visibility": int32(constant.VisibilityFollowers)},
+		"friends":   {"user_id": "author", "visibility": int32(constant.VisibilityFriends)},
+		"private":   {"user_id": "author", "visibility": int32(constant.VisibilityPrivate)},
+		"custom":    {"user_id": "author", "visibility": int32(constant.VisibilityCustom), "allow_user_ids": []string{"allowed"}},
+	}
+	viewers := []struct {
+		name      string
+		opUserID  string
+		following []string
+		friends   []string
+		visible   []string
+	}{
+		{name: "author", opUserID: "author", visible: []string{"legacy", "public", "followers", "friends", "private", "custom"}},
+		{name: "stranger", opUserID: "stranger", visible: []string{"legacy", "public"}},
+		{name: "follower", opUserID: "follower", following: []string{"author"}, friends: []string{}, visible: []string{"legacy", "public", "followers"}},
+		{name: "friend", opUserID: "friend", following: []string{"author"}, friends: []string{"author"}, visible: []string{"legacy", "public", "followers", "friends"}},
+		{name: "follows someone else", opUserID: "other", following: []string{"someone"}, friends: []string{"someone"}, visible: []string{"legacy", "public"}},
+		{name: "custom allowed", opUserID: "allowed", visible: []string{"legacy", "public", "custom"}},
+	}
+	for _, viewer := range viewers {
+		t.Run(viewer.name, func(t *testing.T) {
+			filter := visibleFilter(viewer.opUserID, viewer.following, viewer.friends)
+			want := make(map[string]bool)
+			for _, name := range viewer.visible {
+				want[name] = true
+			}
+			for name, post := range posts {
+				if got := matchFilter(t, post, filter); got != want[name] {
+					t.Errorf("%s post: visible %v, want %v", name, got, want[name])
+				}
+			}
+		})
+	}
+}
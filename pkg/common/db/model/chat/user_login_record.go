@@ -21,6 +21,7 @@ import (
 	"go.mongodb.org/mongo-driver/mongo"
 	"time"
 
+	"github.com/openimsdk/chat/pkg/common/db/dbutil"
 	"github.com/openimsdk/chat/pkg/common/db/table/chat"
 )
 
@@ -118,3 +119,11 @@ func (o *UserLoginRecord) CountRangeEverydayTotal(ctx context.Context, start *ti
 	}
 	return countMap, loginCount, nil
 }
+
+func (o *UserLoginRecord) FindByUserID(ctx context.Context, userID string) ([]*chat.UserLoginRecord, error) {
+	return mongoutil.Find[*chat.UserLoginRecord](ctx, o.coll, bson.M{"user_id": userID})
+}
+
+func (o *UserLoginRecord) DeleteByUserID(ctx context.Context, userID string) (int64, error) {
+	return dbutil.DeleteMany(ctx, o.coll, bson.M{"user_id": userID})
+}
@@ -56,6 +56,32 @@ func (o *UserPostRelation) UpdateByMap(ctx context.Context, userID, postID strin
 	return mongoutil.UpdateOne(ctx, o.coll, filter, bson.M{"$set": args}, false)
 }
 
+func (o *UserPostRelation) SetFlag(ctx context.Context, userID, postID, field string, value int32) (bool, error) {
+	// 没有关系或没有该字段时标记视为 0
+	changed := bson.M{"$ne": value}
+	if value == 0 {
+		changed = bson.M{"$nin": bson.A{value, nil}}
+	}
+	now := time.Now()
+	filter := bson.M{"post_id": postID, "user_id": userID, field: changed}
+	res, err := mongoutil.UpdateOneResult(ctx, o.coll, filter, bson.M{"$set": bson.M{field: value, "update_time": now}})
+	if err != nil {
+		return false, err
+	}
+	if res.ModifiedCount > 0 {
+		return true, nil
+	}
+	if value == 0 {
+		return false, nil
+	}
+	update := bson.M{"$setOnInsert": bson.M{field: value, "create_time": now, "update_time": now}}
+	res, err = mongoutil.UpdateOneResult(ctx, o.coll, bson.M{"post_id": postID, "user_id": userID}, update, options.Update().SetUpsert(true))
+	if err != nil {
+		return false, err
+	}
+	return res.UpsertedCount > 0, nil
+}
+
 func (o *UserPostRelation) Delete(ctx context.Context, userID, postID string) error {
 	return mongoutil.DeleteOne(ctx, o.coll, bson.M{"post_id": postID})
 }
@@ -166,3 +192,26 @@ func (o *UserPostRelation) GetLikedPostIDs(ctx context.Context, userID string) (
 func (o *UserPostRelation) GetCollectedPostIDs(ctx context.Context, userID string) ([]string, error) {
 	return mongoutil.Find[string](ctx, o.coll, bson.M{"user_id": userID, "is_collected": 1}, options.Find().SetProjection(bson.M{"_id": 0, "post_id": 1}))
 }
+
+func (o *UserPostRelation) FindByUserID(ctx context.Context, userID string) ([]*chat.UserPostRelation, error) {
+	return mongoutil.Find[*chat.UserPostRelation](ctx, o.coll, bson.M{"user_id": userID})
+}
+
+func (o *UserPostRelation) DeleteByUserID(ctx context.Context, userID string) ([]string, error) {
+	filter := bson.M{"user_id": userID}
+	postIDs, err := mongoutil.Find[string](ctx, o.coll, filter, options.Find().SetProjection(bson.M{"_id": 0, "post_id": 1}))
+	if err != nil {
+		return nil, err
+	}
+	if err := mongoutil.DeleteMany(ctx, o.coll, filter); err != nil {
+		return nil, err
+	}
+	return postIDs, nil
+}
+
+func (o *UserPostRelation) DeleteByPostIDs(ctx context.Context, postIDs []string) error {
+	if len(postIDs) == 0 {
+		return nil
+	}
+	return mongoutil.DeleteMany(ctx, o.coll, bson.M{"post_id": bson.M{"$in": postIDs}})
+}
@@ -23,6 +23,7 @@ import (
 	"go.mongodb.org/mongo-driver/mongo/options"
 	"time"
 
+	"github.com/openimsdk/chat/pkg/common/db/dbutil"
 	"github.com/openimsdk/chat/pkg/common/db/table/chat"
 	"github.com/openimsdk/tools/errs"
 )
@@ -143,3 +144,10 @@ func (o *VerifyCode) Delete(ctx context.Context, id string) error {
 	}
 	return mongoutil.DeleteOne(ctx, o.coll, bson.M{"_id": objID})
 }
+
+func (o *VerifyCode) DeleteByAccount(ctx context.Context, accounts []string) (int64, error) {
+	if len(accounts) == 0 {
+		return 0, nil
+	}
+	return dbutil.DeleteMany(ctx, o.coll, bson.M{"account": bson.M{"$in": accounts}})
+}
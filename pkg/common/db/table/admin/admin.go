@@ -29,6 +29,17 @@ type Admin struct {
 	UserID     string    `bson:"user_id"`
 	Level      int32     `bson:"level"`
 	CreateTime time.Time `bson:"create_time"`
+	Roles      []string  `bson:"roles"` // role ids, the super admin level has every permission regardless
+
+	// TOTP two-factor authentication, TOTPPendingSecret holds an enrolment that is not confirmed yet
+	TOTPSecret        string    `bson:"totp_secret"`
+	TOTPPendingSecret string    `bson:"totp_pending_secret"`
+	TOTPEnabled       bool      `bson:"totp_enabled"`
+	TOTPRequired      bool      `bson:"totp_required"`
+	TOTPLastStep      int64     `bson:"totp_last_step"`
+	RecoveryCodes     []string  `bson:"recovery_codes"` // sha256 hex of the unused recovery codes
+	TOTPFailedCount   int32     `bson:"totp_failed_count"`
+	TOTPLockedUntil   time.Time `bson:"totp_locked_until"`
 }
 
 func (Admin) TableName() string {
@@ -41,6 +52,19 @@ type AdminInterface interface {
 	TakeUserID(ctx context.Context, userID string) (*Admin, error)
 	Update(ctx context.Context, account string, update map[string]any) error
 	ChangePassword(ctx context.Context, userID string, newPassword string) error
+	// RehashPassword replaces the stored hash only if it is still oldPassword
+	RehashPassword(ctx context.Context, account string, oldPassword string, newPassword string) error
+	UpdateByAccount(ctx context.Context, account string, update map[string]any) error
+	// UseTOTPStep records step as the last used one, false if a code of this or a later step was already used
+	UseTOTPStep(ctx context.Context, account string, step int64) (bool, error)
+	// UseRecoveryCode removes the recovery code hash, false if it is not an unused code of the account
+	UseRecoveryCode(ctx context.Context, account string, codeHash string) (bool, error)
+	// AddTOTPFailure counts a wrong code and locks the second factor until lockUntil once limit is reached
+	AddTOTPFailure(ctx context.Context, account string, limit int32, lockUntil time.Time) (bool, error)
+	// PullRoles removes the roles from every admin holding them
+	PullRoles(ctx context.Context, roleIDs []string) error
+	// SetMissingRoles gives the roles to the admins stored before roles existed, the super admin level is left alone
+	SetMissingRoles(ctx context.Context, roleIDs []string) (int64, error)
 	Delete(ctx context.Context, userIDs []string) error
 	Search(ctx context.Context, pagination pagination.Pagination) (int64, []*Admin, error)
 }
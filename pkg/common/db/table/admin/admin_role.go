@@ -0,0 +1,44 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admin
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/tools/db/pagination"
+)
+
+// AdminRole is a named permission set assigned to admin accounts.
+type AdminRole struct {
+	RoleID      string    `bson:"role_id"`
+	Name        string    `bson:"name"`
+	Permissions []string  `bson:"permissions"`
+	CreateTime  time.Time `bson:"create_time"`
+	UpdateTime  time.Time `bson:"update_time"`
+}
+
+func (AdminRole) TableName() string {
+	return "admin_role"
+}
+
+type AdminRoleInterface interface {
+	Create(ctx context.Context, roles []*AdminRole) error
+	Update(ctx context.Context, roleID string, update map[string]any) error
+	Delete(ctx context.Context, roleIDs []string) error
+	Take(ctx context.Context, roleID string) (*AdminRole, error)
+	Find(ctx context.Context, roleIDs []string) ([]*AdminRole, error)
+	Search(ctx context.Context, keyword string, pagination pagination.Pagination) (int64, []*AdminRole, error)
+}
@@ -0,0 +1,44 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admin
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/tools/db/pagination"
+)
+
+// AuditLog records an admin operation and its result.
+type AuditLog struct {
+	OperatorUserID string    `bson:"operator_user_id"`
+	Method         string    `bson:"method"`
+	Permission     string    `bson:"permission"`
+	Request        string    `bson:"request"`
+	ResultCode     int32     `bson:"result_code"`
+	ResultMsg      string    `bson:"result_msg"`
+	IP             string    `bson:"ip"`
+	CreateTime     time.Time `bson:"create_time"`
+}
+
+func (AuditLog) TableName() string {
+	return "admin_audit_log"
+}
+
+type AuditLogInterface interface {
+	Create(ctx context.Context, logs []*AuditLog) error
+	// Search returns the logs newest first, empty filters and zero times are not applied
+	Search(ctx context.Context, operatorUserID string, method string, start time.Time, end time.Time, pagination pagination.Pagination) (int64, []*AuditLog, error)
+}
@@ -26,6 +26,12 @@ type ForbiddenAccount struct {
 	Reason         string    `bson:"reason"`
 	OperatorUserID string    `bson:"operator_user_id"`
 	CreateTime     time.Time `bson:"create_time"`
+	ExpireTime     time.Time `bson:"expire_time"` // zero for a permanent block
+}
+
+// Expired reports whether a temporary block has ended.
+func (f *ForbiddenAccount) Expired(now time.Time) bool {
+	return !f.ExpireTime.IsZero() && !now.Before(f.ExpireTime)
 }
 
 func (ForbiddenAccount) TableName() string {
@@ -39,4 +45,9 @@ type ForbiddenAccountInterface interface {
 	Find(ctx context.Context, userIDs []string) ([]*ForbiddenAccount, error)
 	Search(ctx context.Context, keyword string, pagination pagination.Pagination) (int64, []*ForbiddenAccount, error)
 	FindAllIDs(ctx context.Context) ([]string, error)
+	DeleteByUserID(ctx context.Context, userID string) (int64, error)
+	// FindExpired returns temporary blocks that ended at or before now
+	FindExpired(ctx context.Context, now time.Time, limit int64) ([]*ForbiddenAccount, error)
+	// DeleteBlock deletes the block of the user created at createTime, false if it was already replaced or lifted
+	DeleteBlock(ctx context.Context, userID string, createTime time.Time) (bool, error)
 }
@@ -0,0 +1,45 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admin
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/tools/db/pagination"
+)
+
+// ForbiddenAccountHistory is a block of an account that ended, by expiring or by an admin lifting it.
+type ForbiddenAccountHistory struct {
+	UserID            string    `bson:"user_id"`
+	Reason            string    `bson:"reason"`
+	OperatorUserID    string    `bson:"operator_user_id"`
+	CreateTime        time.Time `bson:"create_time"`
+	ExpireTime        time.Time `bson:"expire_time"`
+	EndTime           time.Time `bson:"end_time"`
+	EndReason         string    `bson:"end_reason"`
+	EndOperatorUserID string    `bson:"end_operator_user_id"` // empty if the block expired
+}
+
+func (ForbiddenAccountHistory) TableName() string {
+	return "forbidden_account_history"
+}
+
+type ForbiddenAccountHistoryInterface interface {
+	Create(ctx context.Context, histories []*ForbiddenAccountHistory) error
+	// Search returns the histories newest first, all users if userID is empty
+	Search(ctx context.Context, userID string, pagination pagination.Pagination) (int64, []*ForbiddenAccountHistory, error)
+	DeleteByUserID(ctx context.Context, userID string) (int64, error)
+}
@@ -16,25 +16,59 @@ package admin
 
 import (
 	"context"
-	"github.com/openimsdk/tools/db/pagination"
 	"time"
+
+	"github.com/openimsdk/tools/db/pagination"
 )
 
 type InvitationRegister struct {
-	InvitationCode string    `bson:"invitation_code"`
-	UsedByUserID   string    `bson:"used_by_user_id"`
-	CreateTime     time.Time `bson:"create_time"`
+	InvitationCode string `bson:"invitation_code"`
+	// UsedByUserID is the first user registered with the code
+	UsedByUserID string `bson:"used_by_user_id"`
+	// MaxUses is how many users may register with the code, codes created before limits existed have none and allow one
+	MaxUses       int32     `bson:"max_uses"`
+	UsedCount     int32     `bson:"used_count"`
+	ExpireTime    time.Time `bson:"expire_time"` // zero if the code never expires
+	InviterUserID string    `bson:"inviter_user_id"`
+	Campaign      string    `bson:"campaign"`
+	CreateTime    time.Time `bson:"create_time"`
 }
 
 func (InvitationRegister) TableName() string {
 	return "invitation_registers"
 }
 
+// Limit is the number of registrations the code allows.
+func (o *InvitationRegister) Limit() int32 {
+	if o.MaxUses < 1 {
+		return 1
+	}
+	return o.MaxUses
+}
+
+// Uses is the number of registrations with the code, counting codes used before the counter existed.
+func (o *InvitationRegister) Uses() int32 {
+	if o.UsedCount == 0 && o.UsedByUserID != "" {
+		return 1
+	}
+	return o.UsedCount
+}
+
+func (o *InvitationRegister) Expired(now time.Time) bool {
+	return !o.ExpireTime.IsZero() && !now.Before(o.ExpireTime)
+}
+
 type InvitationRegisterInterface interface {
 	Find(ctx context.Context, codes []string) ([]*InvitationRegister, error)
 	Del(ctx context.Context, codes []string) error
 	Create(ctx context.Context, v []*InvitationRegister) error
 	Take(ctx context.Context, code string) (*InvitationRegister, error)
 	Update(ctx context.Context, code string, data map[string]any) error
-	Search(ctx context.Context, keyword string, state int32, userIDs []string, codes []string, pagination pagination.Pagination) (int64, []*InvitationRegister, error)
+	// Use counts a registration by userID, false if the code is used up or expired at now
+	Use(ctx context.Context, code string, userID string, now time.Time) (bool, error)
+	// Release takes back a registration Use counted for userID
+	Release(ctx context.Context, code string, userID string) error
+	Search(ctx context.Context, keyword string, state int32, userIDs []string, codes []string, inviterUserID string, campaign string, pagination pagination.Pagination) (int64, []*InvitationRegister, error)
+	ReplaceUsedBy(ctx context.Context, userID string, replacement string) (int64, error)
+	ReplaceInviter(ctx context.Context, userID string, replacement string) (int64, error)
 }
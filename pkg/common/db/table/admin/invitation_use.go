@@ -0,0 +1,55 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package admin
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/tools/db/pagination"
+)
+
+// InvitationUse records a user registered with an invitation code, with the code's inviter and campaign at that time.
+type InvitationUse struct {
+	InvitationCode string    `bson:"invitation_code"`
+	UserID         string    `bson:"user_id"`
+	InviterUserID  string    `bson:"inviter_user_id"`
+	Campaign       string    `bson:"campaign"`
+	CreateTime     time.Time `bson:"create_time"`
+}
+
+func (InvitationUse) TableName() string {
+	return "invitation_use"
+}
+
+// InvitationReport is the registrations of one inviter or campaign.
+type InvitationReport struct {
+	Key        string    `bson:"_id"`
+	Registered int64     `bson:"registered"`
+	Codes      int64     `bson:"codes"`
+	FirstTime  time.Time `bson:"first_time"`
+	LastTime   time.Time `bson:"last_time"`
+}
+
+type InvitationUseInterface interface {
+	Create(ctx context.Context, uses []*InvitationUse) error
+	// Search returns the uses newest first, empty filters are not applied
+	Search(ctx context.Context, code string, userID string, inviterUserID string, campaign string, pagination pagination.Pagination) (int64, []*InvitationUse, error)
+	// Report groups the uses between start and end by inviter or campaign, most registrations first, zero times and empty keys are not applied
+	Report(ctx context.Context, groupBy int32, keys []string, start time.Time, end time.Time, pagination pagination.Pagination) (int64, []*InvitationReport, error)
+	ReplaceUser(ctx context.Context, userID string, replacement string) (int64, error)
+	// Delete removes the use of the code by userID and returns the number of deleted uses
+	Delete(ctx context.Context, code string, userID string) (int64, error)
+}
@@ -21,7 +21,7 @@ import (
 )
 
 type IPForbidden struct {
-	IP            string    `bson:"ip"`
+	IP            string    `bson:"ip"` // address, CIDR prefix or address range
 	LimitRegister bool      `bson:"limit_register"`
 	LimitLogin    bool      `bson:"limit_login"`
 	CreateTime    time.Time `bson:"create_time"`
@@ -34,6 +34,7 @@ func (IPForbidden) IPForbidden() string {
 type IPForbiddenInterface interface {
 	Take(ctx context.Context, ip string) (*IPForbidden, error)
 	Find(ctx context.Context, ips []string) ([]*IPForbidden, error)
+	FindAll(ctx context.Context) ([]*IPForbidden, error)
 	Search(ctx context.Context, keyword string, state int32, pagination pagination.Pagination) (int64, []*IPForbidden, error)
 	Create(ctx context.Context, ms []*IPForbidden) error
 	Delete(ctx context.Context, ips []string) error
@@ -22,7 +22,7 @@ import (
 
 type LimitUserLoginIP struct {
 	UserID     string    `bson:"user_id"`
-	IP         string    `bson:"ip"`
+	IP         string    `bson:"ip"` // address, CIDR prefix or address range
 	CreateTime time.Time `bson:"create_time"`
 }
 
@@ -35,5 +35,7 @@ type LimitUserLoginIPInterface interface {
 	Delete(ctx context.Context, ms []*LimitUserLoginIP) error
 	Count(ctx context.Context, userID string) (uint32, error)
 	Take(ctx context.Context, userID string, ip string) (*LimitUserLoginIP, error)
+	FindAll(ctx context.Context) ([]*LimitUserLoginIP, error)
 	Search(ctx context.Context, keyword string, pagination pagination.Pagination) (int64, []*LimitUserLoginIP, error)
+	DeleteByUserID(ctx context.Context, userID string) (int64, error)
 }
@@ -34,4 +34,5 @@ type RegisterAddFriendInterface interface {
 	Del(ctx context.Context, userIDs []string) error
 	FindUserID(ctx context.Context, userIDs []string) ([]string, error)
 	Search(ctx context.Context, keyword string, pagination pagination.Pagination) (int64, []*RegisterAddFriend, error)
+	DeleteByUserID(ctx context.Context, userID string) (int64, error)
 }
@@ -40,4 +40,5 @@ type AccountInterface interface {
 	UpdatePassword(ctx context.Context, userId string, password string) error
 	Delete(ctx context.Context, userIDs []string) error
 	GetAllUserID(ctx context.Context, pagination pagination.Pagination) (int64, []string, error)
+	DeleteByUserID(ctx context.Context, userID string) (int64, error)
 }
@@ -0,0 +1,48 @@
+package chat
+
+import (
+	"context"
+	"time"
+)
+
+// AccountDeletion 账号注销申请，冷静期结束后由定时任务清除用户数据
+// 清除完成后记录保留，作为注销回执
+type AccountDeletion struct {
+	UserID string `bson:"user_id"`
+	Reason string `bson:"reason"`
+	// OperatorUserID 管理员发起时为管理员ID，用户自己申请时为空
+	OperatorUserID string          `bson:"operator_user_id"`
+	Status         int32           `bson:"status"`
+	RequestTime    time.Time       `bson:"request_time"`
+	ExecuteAt      time.Time       `bson:"execute_at"`
+	LeaseOwner     string          `bson:"lease_owner"`
+	LeaseUntil     time.Time       `bson:"lease_until"`
+	FinishTime     time.Time       `bson:"finish_time"`
+	Records        []*ErasedRecord `bson:"records"`
+}
+
+// ErasedRecord 回执中每个集合删除和匿名化的记录数
+type ErasedRecord struct {
+	Collection string `bson:"collection"`
+	Deleted    int64  `bson:"deleted"`
+	Anonymized int64  `bson:"anonymized"`
+}
+
+func (AccountDeletion) TableName() string {
+	return "account_deletions"
+}
+
+type AccountDeletionInterface interface {
+	// 创建注销申请
+	Create(ctx context.Context, deletions []*AccountDeletion) error
+	// 通过用户ID获取注销申请或回执
+	Take(ctx context.Context, userID string) (*AccountDeletion, error)
+	// 更新待执行的申请，正在执行的申请不会被更新，返回是否更新
+	UpdatePending(ctx context.Context, userID string, data map[string]any) (bool, error)
+	// 撤销待执行的申请，正在执行的申请不会被撤销，返回是否撤销
+	DeletePending(ctx context.Context, userID string) (bool, error)
+	// 领取一条冷静期已结束的申请，lease 内其他实例领取不到
+	ClaimDue(ctx context.Context, owner string, lease time.Duration) (*AccountDeletion, error)
+	// 持有租约时标记完成并写入回执，返回是否写入
+	Finish(ctx context.Context, userID string, owner string, records []*ErasedRecord) (bool, error)
+}
@@ -50,6 +50,14 @@ func (Attribute) TableName() string {
 	return "attributes"
 }
 
+// AttributeContact is what a user signs in or receives verify codes with, email and phone are only on legacy documents.
+type AttributeContact struct {
+	Address     string `bson:"address"`
+	Email       string `bson:"email"`
+	AreaCode    string `bson:"area_code"`
+	PhoneNumber string `bson:"phone_number"`
+}
+
 type AttributeInterface interface {
 	// NewTx(tx any) AttributeInterface
 	Create(ctx context.Context, attribute ...*Attribute) error
@@ -62,7 +70,9 @@ type AttributeInterface interface {
 	TakeAccount(ctx context.Context, account string) (*Attribute, error)
 	TakeAddress(ctx context.Context, address string) (*Attribute, error)
 	Take(ctx context.Context, userID string) (*Attribute, error)
+	TakeContact(ctx context.Context, userID string) (*AttributeContact, error)
 	SearchNormalUser(ctx context.Context, keyword string, forbiddenID []string, gender int32, pagination pagination.Pagination) (int64, []*Attribute, error)
 	SearchUser(ctx context.Context, keyword string, userIDs []string, genders []int32, pagination pagination.Pagination) (int64, []*Attribute, error)
 	Delete(ctx context.Context, userIDs []string) error
+	DeleteByUserID(ctx context.Context, userID string) (int64, error)
 }
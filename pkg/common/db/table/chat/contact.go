@@ -20,4 +20,5 @@ type ContactInterface interface {
 	AddGroup(ctx context.Context, userId string, groupIDs []string) error
 	DeleteGroup(ctx context.Context, userID string, groupIDs []string) error
 	TakeGroups(ctx context.Context, userId string) (*Contact, error)
+	DeleteByUserID(ctx context.Context, userID string) (int64, error)
 }
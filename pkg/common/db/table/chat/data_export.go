@@ -0,0 +1,54 @@
+package chat
+
+import (
+	"context"
+	"time"
+)
+
+// DataExport 用户数据导出任务，由定时任务打包成 zip 存储，签名链接只能下载一次
+type DataExport struct {
+	ExportID   string    `bson:"export_id"`
+	UserID     string    `bson:"user_id"`
+	Status     int32     `bson:"status"`
+	CreateTime time.Time `bson:"create_time"`
+	LeaseOwner string    `bson:"lease_owner"`
+	LeaseUntil time.Time `bson:"lease_until"`
+	// Key 压缩包在存储中的路径
+	Key        string    `bson:"key"`
+	Size       int64     `bson:"size"`
+	FinishTime time.Time `bson:"finish_time"`
+	// ExpireAt 下载链接过期时间，过期后删除压缩包
+	ExpireAt     time.Time `bson:"expire_at"`
+	DownloadTime time.Time `bson:"download_time"`
+}
+
+func (DataExport) TableName() string {
+	return "data_exports"
+}
+
+type DataExportInterface interface {
+	// 创建导出任务
+	Create(ctx context.Context, exports []*DataExport) error
+	// 获取导出任务
+	Take(ctx context.Context, exportID string) (*DataExport, error)
+	// 获取用户最近一次的导出任务
+	TakeLatest(ctx context.Context, userID string) (*DataExport, error)
+	// 领取一条待打包的任务，lease 内其他实例领取不到
+	ClaimPending(ctx context.Context, owner string, lease time.Duration) (*DataExport, error)
+	// 持有租约时标记打包完成，返回是否写入
+	Finish(ctx context.Context, exportID string, owner string, key string, size int64, expireAt time.Time) (bool, error)
+	// 领取一条可以下载的任务，lease 内其他下载领取不到，没有可领取的任务时返回 not found
+	ClaimDownload(ctx context.Context, exportID string, owner string, lease time.Duration) (*DataExport, error)
+	// 持有下载租约时把未过期且未下载的任务标记为已下载，没有可下载的任务时返回 not found
+	Consume(ctx context.Context, exportID string, owner string) (*DataExport, error)
+	// 下载失败后释放下载租约
+	ReleaseDownload(ctx context.Context, exportID string, owner string) error
+	// 查找下载链接已过期但压缩包还没删除的任务
+	FindExpired(ctx context.Context, limit int64) ([]*DataExport, error)
+	// 压缩包删除后标记为已过期
+	Expire(ctx context.Context, exportID string) error
+	// 获取用户的全部导出任务
+	FindByUserID(ctx context.Context, userID string) ([]*DataExport, error)
+	// 删除用户的全部导出任务，返回删除数量
+	DeleteByUserID(ctx context.Context, userID string) (int64, error)
+}
@@ -0,0 +1,42 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chat
+
+import (
+	"context"
+	"time"
+)
+
+// LinkedWallet is an additional wallet address that can login to the account of UserID.
+// The primary wallet is kept in Attribute.
+type LinkedWallet struct {
+	UserID     string    `bson:"user_id"`
+	Address    string    `bson:"address"`
+	PublicKey  string    `bson:"public_key"`
+	CreateTime time.Time `bson:"create_time"`
+}
+
+func (LinkedWallet) TableName() string {
+	return "linked_wallets"
+}
+
+type LinkedWalletInterface interface {
+	Create(ctx context.Context, wallets ...*LinkedWallet) error
+	Take(ctx context.Context, address string) (*LinkedWallet, error)
+	Find(ctx context.Context, userID string) ([]*LinkedWallet, error)
+	Delete(ctx context.Context, userID string, address string) error
+	DeleteByUserIDs(ctx context.Context, userIDs []string) error
+	DeleteByUserID(ctx context.Context, userID string) (int64, error)
+}
@@ -0,0 +1,44 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chat
+
+import (
+	"context"
+	"time"
+)
+
+// LoginNonce is a challenge issued by ChallengeNonce, bound to the wallet
+// address (and optionally the device) that requested it.
+type LoginNonce struct {
+	Nonce      string    `bson:"nonce"`
+	Address    string    `bson:"address"`
+	DeviceID   string    `bson:"device_id"`
+	Used       bool      `bson:"used"`
+	CreateTime time.Time `bson:"create_time"`
+	ExpireTime time.Time `bson:"expire_time"`
+}
+
+func (LoginNonce) TableName() string {
+	return "login_nonces"
+}
+
+type LoginNonceInterface interface {
+	Create(ctx context.Context, nonces ...*LoginNonce) error
+	Take(ctx context.Context, nonce string) (*LoginNonce, error)
+	// MarkUsed flags an unused nonce as used and reports whether this call consumed it.
+	MarkUsed(ctx context.Context, nonce string) (bool, error)
+	// DeleteByAddress removes the nonces of the addresses, matched case-insensitively.
+	DeleteByAddress(ctx context.Context, addresses []string) (int64, error)
+}
@@ -0,0 +1,45 @@
+package chat
+
+import (
+	"context"
+	"time"
+)
+
+// Notification 帖子互动通知
+// 未读的点赞通知按帖子聚合，OpUserIDs 最近的在前
+type Notification struct {
+	NotificationID string    `bson:"notification_id"`
+	UserID         string    `bson:"user_id"`
+	Type           int32     `bson:"type"`
+	PostID         string    `bson:"post_id"`
+	SourcePostID   string    `bson:"source_post_id"`
+	GroupKey       string    `bson:"group_key,omitempty"`
+	OpUserIDs      []string  `bson:"op_user_ids"`
+	OpCount        int64     `bson:"op_count"`
+	IsRead         int32     `bson:"is_read"`
+	CreateTime     time.Time `bson:"create_time"`
+	UpdateTime     time.Time `bson:"update_time"`
+}
+
+func (Notification) TableName() string {
+	return "notifications"
+}
+
+type NotificationInterface interface {
+	// 创建通知
+	Create(ctx context.Context, notifications []*Notification) error
+	// 合并到未读的同组通知，没有则新建
+	Aggregate(ctx context.Context, notification *Notification, opUserID string) error
+	// 通过游标获取通知，按更新时间倒序
+	FindByCursor(ctx context.Context, userID string, cursor int64, count int64) ([]*Notification, string, error)
+	// 未读数量
+	CountUnread(ctx context.Context, userID string) (int64, error)
+	// 标记已读，notificationIDs 为空时全部标记
+	MarkRead(ctx context.Context, userID string, notificationIDs []string) error
+	// 删除帖子相关的通知
+	DeleteByPostIDs(ctx context.Context, postIDs []string) error
+	// 删除用户收到的通知
+	DeleteByUserID(ctx context.Context, userID string) (int64, error)
+	// 从其他用户的通知里移除操作者，返回修改的通知数
+	PullOpUser(ctx context.Context, opUserID string) (int64, error)
+}
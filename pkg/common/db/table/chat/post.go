@@ -16,6 +16,13 @@ type PostDB struct {
 	AllowForward  int32        `bson:"allow_forward"`
 	AtUserIds     []string     `bson:"at_user_ids"`
 	MediaMsgs     []*PostMedia `bson:"media_msgs"`
+	RevisionCount int64        `bson:"revision_count"`
+	LikeCount     int64        `bson:"like_count"`
+	CollectCount  int64        `bson:"collect_count"`
+	ForwardCount  int64        `bson:"forward_count"`
+	CommentCount  int64        `bson:"comment_count"`
+	Visibility    int32        `bson:"visibility"`
+	AllowUserIDs  []string     `bson:"allow_user_ids"`
 	CreateTime    time.Time    `bson:"create_time"`
 	UpdateTime    time.Time    `bson:"update_time"`
 }
@@ -43,9 +50,13 @@ type Post struct {
 	CommentCount   int64        `bson:"comment_count"`
 	LikeCount      int64        `bson:"like_count"`
 	ForwardCount   int64        `bson:"forward_count"`
+	CollectCount   int64        `bson:"collect_count"`
 	UserInfo       *Attribute   `bson:"user_info"`
 	AtUserInfoList []*Attribute `bson:"at_user_info_list"`
 	IsPinned       int32        `bson:"is_pinned"`
+	RevisionCount  int64        `bson:"revision_count"`
+	Visibility     int32        `bson:"visibility"`
+	AllowUserIDs   []string     `bson:"allow_user_ids"`
 }
 
 type PostMedia struct {
@@ -93,14 +104,17 @@ func (Post) TableName() string {
 type PostInterface interface {
 	// 创建帖子
 	Create(ctx context.Context, posts []*PostDB) error
-	// 通过帖子ID获取帖子
+	// 通过帖子ID获取帖子，不做可见性过滤，只用于内部校验
 	Take(ctx context.Context, postID string) (*Post, error)
+	// 通过帖子ID获取当前用户可见的帖子
+	TakeVisible(ctx context.Context, postID string) (*Post, error)
 	// 更新帖子
 	UpdateByMap(ctx context.Context, postID string, data map[string]any) error
 	// 删除帖子
 	Delete(ctx context.Context, postIDs []string) error
 	// 通过转发的帖子ID获取帖子
 	GetPostByForwardPostID(ctx context.Context, userID, forwardPostID string) (*Post, error)
+	// 以下列表只返回当前用户可见的帖子
 	// 通过游标和用户IDs获取此ID后Count数的帖子
 	GetPostsByCursorAndUserIDs(ctx context.Context, cursor int64, userIDs []string, count int64) ([]*Post, string, error)
 	// 通过游标和用户ID获取此ID后Count数的帖子
@@ -117,4 +131,16 @@ type PostInterface interface {
 	GetSubscriberUserIDs(ctx context.Context, userID string) ([]string, error)
 	// 获取置顶帖子
 	GetPinnedPostByUserID(ctx context.Context, userID string) (*Post, error)
+	// 增减帖子计数
+	IncrCount(ctx context.Context, postID string, field string, delta int64) error
+	// 根据用户帖子关系和评论重新计算计数，postIDs 为空时计算全部帖子，返回被修正的帖子数
+	ReconcileCounts(ctx context.Context, postIDs []string) (int64, error)
+	// 计算计数字段加入之前创建的帖子的计数，返回写入的帖子数
+	BackfillCounts(ctx context.Context) (int64, error)
+	// 获取用户发布的所有帖子，只包含帖子ID和关联的帖子ID
+	FindByUserID(ctx context.Context, userID string) ([]*PostDB, error)
+	// 获取用户发布的所有帖子和评论，用于导出数据
+	FindAllByUserID(ctx context.Context, userID string) ([]*PostDB, error)
+	// 从其他帖子的 @ 列表和可见用户列表中移除用户，返回修改的帖子数
+	PullUser(ctx context.Context, userID string) (int64, error)
 }
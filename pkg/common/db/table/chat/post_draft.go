@@ -0,0 +1,54 @@
+package chat
+
+import (
+	"context"
+	"time"
+
+	"github.com/openimsdk/tools/db/pagination"
+)
+
+// PostDraft 帖子草稿，定时的草稿到期后由定时任务发布，发布后删除
+// PostID 在第一次发布时生成并保存，重试时使用同一个帖子ID，帖子只会创建一次
+type PostDraft struct {
+	DraftID      string       `bson:"draft_id"`
+	UserID       string       `bson:"user_id"`
+	Content      string       `bson:"content"`
+	AllowComment int32        `bson:"allow_comment"`
+	AllowForward int32        `bson:"allow_forward"`
+	AtUserIds    []string     `bson:"at_user_ids"`
+	MediaMsgs    []*PostMedia `bson:"media_msgs"`
+	Visibility   int32        `bson:"visibility"`
+	AllowUserIDs []string     `bson:"allow_user_ids"`
+	Status       int32        `bson:"status"`
+	PublishAt    time.Time    `bson:"publish_at"`
+	PostID       string       `bson:"post_id"`
+	LeaseOwner   string       `bson:"lease_owner"`
+	LeaseUntil   time.Time    `bson:"lease_until"`
+	CreateTime   time.Time    `bson:"create_time"`
+	UpdateTime   time.Time    `bson:"update_time"`
+}
+
+func (PostDraft) TableName() string {
+	return "post_drafts"
+}
+
+type PostDraftInterface interface {
+	// 创建草稿
+	Create(ctx context.Context, drafts []*PostDraft) error
+	// 通过草稿ID获取草稿
+	Take(ctx context.Context, draftID string) (*PostDraft, error)
+	// 更新草稿，正在发布的草稿不会被更新，返回是否更新
+	UpdateByMap(ctx context.Context, draftID string, data map[string]any) (bool, error)
+	// 删除用户的草稿，正在发布的草稿不会被删除
+	Delete(ctx context.Context, userID string, draftIDs []string) error
+	// 分页获取用户的草稿，按更新时间倒序
+	FindByUser(ctx context.Context, userID string, pagination pagination.Pagination) (int64, []*PostDraft, error)
+	// 领取一条到期的定时草稿，lease 内其他实例领取不到
+	ClaimDue(ctx context.Context, owner string, lease time.Duration) (*PostDraft, error)
+	// 持有租约时保存发布用的帖子ID，已经保存过则不修改，返回是否保存
+	SetPostID(ctx context.Context, draftID string, owner string, postID string) (bool, error)
+	// 持有租约时删除已发布的草稿
+	DeleteClaimed(ctx context.Context, draftID string, owner string) error
+	// 删除用户的所有草稿，包括正在发布的草稿
+	DeleteByUserID(ctx context.Context, userID string) (int64, error)
+}
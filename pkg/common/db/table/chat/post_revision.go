@@ -0,0 +1,30 @@
+package chat
+
+import (
+	"context"
+	"time"
+)
+
+// PostRevision 帖子被编辑前的内容
+type PostRevision struct {
+	PostID     string       `bson:"post_id"`
+	Revision   int64        `bson:"revision"`
+	UserID     string       `bson:"user_id"`
+	Content    string       `bson:"content"`
+	AtUserIds  []string     `bson:"at_user_ids"`
+	MediaMsgs  []*PostMedia `bson:"media_msgs"`
+	CreateTime time.Time    `bson:"create_time"`
+}
+
+func (PostRevision) TableName() string {
+	return "post_revisions"
+}
+
+type PostRevisionInterface interface {
+	// 保存帖子的历史版本
+	Create(ctx context.Context, revisions []*PostRevision) error
+	// 获取帖子的历史版本，最新的在前
+	Find(ctx context.Context, postID string) ([]*PostRevision, error)
+	// 删除帖子的历史版本
+	DeleteByPostIDs(ctx context.Context, postIDs []string) error
+}
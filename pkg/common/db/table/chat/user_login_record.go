@@ -35,4 +35,6 @@ type UserLoginRecordInterface interface {
 	Create(ctx context.Context, records ...*UserLoginRecord) error
 	CountTotal(ctx context.Context, before *time.Time) (int64, error)
 	CountRangeEverydayTotal(ctx context.Context, start *time.Time, end *time.Time) (map[string]int64, int64, error)
+	FindByUserID(ctx context.Context, userID string) ([]*UserLoginRecord, error)
+	DeleteByUserID(ctx context.Context, userID string) (int64, error)
 }
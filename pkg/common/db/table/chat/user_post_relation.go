@@ -24,6 +24,8 @@ type UserPostRelationInterface interface {
 	Create(ctx context.Context, posts []*UserPostRelation) error
 	Take(ctx context.Context, userID, postID string) (*UserPostRelation, error)
 	UpdateByMap(ctx context.Context, userID, postID string, args map[string]any) error
+	// 设置 is_liked 等标记，返回标记是否发生变化
+	SetFlag(ctx context.Context, userID, postID, field string, value int32) (bool, error)
 	Delete(ctx context.Context, userID, postID string) error
 	GetLikeCount(ctx context.Context, postID string) (int64, error)
 	GetCollectCount(ctx context.Context, postID string) (int64, error)
@@ -38,4 +40,10 @@ type UserPostRelationInterface interface {
 	GetIsCommented(ctx context.Context, userID, postID string) (int32, error)
 	GetLikedPostIDs(ctx context.Context, userID string) ([]string, error)
 	GetCollectedPostIDs(ctx context.Context, userID string) ([]string, error)
+	// 获取用户的所有互动
+	FindByUserID(ctx context.Context, userID string) ([]*UserPostRelation, error)
+	// 删除用户的所有互动，返回互动过的帖子ID
+	DeleteByUserID(ctx context.Context, userID string) ([]string, error)
+	// 删除帖子上的所有互动
+	DeleteByPostIDs(ctx context.Context, postIDs []string) error
 }
@@ -40,4 +40,5 @@ type VerifyCodeInterface interface {
 	TakeLast(ctx context.Context, account string) (*VerifyCode, error)
 	Incr(ctx context.Context, id string) error
 	Delete(ctx context.Context, id string) error
+	DeleteByAccount(ctx context.Context, accounts []string) (int64, error)
 }
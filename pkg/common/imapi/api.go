@@ -15,6 +15,7 @@
 package imapi
 
 import (
+	"github.com/openimsdk/chat/pkg/common/apistruct"
 	"github.com/openimsdk/chat/pkg/protocol/auth"
 	"github.com/openimsdk/chat/pkg/protocol/chat"
 	"github.com/openimsdk/chat/pkg/protocol/friend"
@@ -38,4 +39,6 @@ var (
 	accountCheck        = NewApiCaller[user.AccountCheckReq, user.AccountCheckResp]("/user/account_check")
 	allUserOnlineStatus = NewApiCaller[msggateway.GetUsersOnlineStatusReq, []msggateway.GetUsersOnlineStatusResp_SuccessResult]("/user/get_users_online_status")
 	usersOnlineTime     = NewApiCaller[chat.GetUsersTimeReq, chat.GetUsersTimeResp]("/user/get_users_time")
+
+	sendBusinessNotification = NewApiCaller[apistruct.SendBusinessNotificationReq, apistruct.SendBusinessNotificationResp]("/msg/send_business_notification")
 )
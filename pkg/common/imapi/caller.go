@@ -19,6 +19,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/openimsdk/chat/pkg/common/apistruct"
 	"github.com/openimsdk/chat/pkg/eerrs"
 	"github.com/openimsdk/chat/pkg/protocol/auth"
 	"github.com/openimsdk/chat/pkg/protocol/friend"
@@ -39,6 +40,7 @@ type CallerInterface interface {
 	InviteToGroup(ctx context.Context, userID string, groupIDs []string) error
 	UpdateUserInfo(ctx context.Context, userID string, nickName string, faceURL string, coverURL string, about string, account string) error
 	ForceOffLine(ctx context.Context, userID string) error
+	ForceOffLinePlatform(ctx context.Context, userID string, platformID int32) error
 	RegisterUser(ctx context.Context, users []*sdkwss.UserInfo) error
 	FindGroupInfo(ctx context.Context, groupIDs []string) ([]*sdkwss.GroupInfo, error)
 	UserRegisterCount(ctx context.Context, start int64, end int64) (map[string]int64, int64, error)
@@ -46,6 +48,7 @@ type CallerInterface interface {
 	AccountCheckSingle(ctx context.Context, userID string) (bool, error)
 	UserOlineStatus(ctx context.Context, userIDs []string) ([]msggateway.GetUsersOnlineStatusResp_SuccessResult, error)
 	UserOlineTimes(ctx context.Context, userIDs []string) (*chatpb.GetUsersTimeResp, error)
+	SendBusinessNotification(ctx context.Context, sendUserID string, recvUserID string, key string, data string) error
 }
 
 type Caller struct {
@@ -148,14 +151,19 @@ func (c *Caller) RegisterUser(ctx context.Context, users []*sdkwss.UserInfo) err
 
 func (c *Caller) ForceOffLine(ctx context.Context, userID string) error {
 	for id := range constantpb.PlatformID2Name {
-		_, _ = forceOffLine.Call(ctx, c.imApi, &auth.ForceLogoutReq{
-			PlatformID: int32(id),
-			UserID:     userID,
-		})
+		_ = c.ForceOffLinePlatform(ctx, userID, int32(id))
 	}
 	return nil
 }
 
+func (c *Caller) ForceOffLinePlatform(ctx context.Context, userID string, platformID int32) error {
+	_, err := forceOffLine.Call(ctx, c.imApi, &auth.ForceLogoutReq{
+		PlatformID: platformID,
+		UserID:     userID,
+	})
+	return err
+}
+
 func (c *Caller) FindGroupInfo(ctx context.Context, groupIDs []string) ([]*sdkwss.GroupInfo, error) {
 	resp, err := getGroupsInfo.Call(ctx, c.imApi, &group.GetGroupsInfoReq{
 		GroupIDs: groupIDs,
@@ -212,3 +220,14 @@ func (c *Caller) UserOlineTimes(ctx context.Context, userIDs []string) (*chatpb.
 	}
 	return resp, nil
 }
+
+// SendBusinessNotification pushes data to the online clients of recvUserID, ctx must carry an admin token.
+func (c *Caller) SendBusinessNotification(ctx context.Context, sendUserID string, recvUserID string, key string, data string) error {
+	_, err := sendBusinessNotification.Call(ctx, c.imApi, &apistruct.SendBusinessNotificationReq{
+		Key:        key,
+		Data:       data,
+		SendUserID: sendUserID,
+		RecvUserID: recvUserID,
+	})
+	return err
+}
@@ -107,6 +107,15 @@ func GetOpUserID(ctx context.Context) string {
 	return userID
 }
 
+// GetOpClientIP returns the IP of the client that called the api, empty if not forwarded.
+func GetOpClientIP(ctx context.Context) string {
+	ips, _ := ctx.Value(constant.RpcOpClientIP).([]string)
+	if len(ips) == 0 {
+		return ""
+	}
+	return ips[0]
+}
+
 func GetUserType(ctx context.Context) (int, error) {
 	userTypeArr, _ := ctx.Value(constant.RpcOpUserType).([]string)
 	userType, err := strconv.Atoi(userTypeArr[0])
@@ -38,6 +38,33 @@ import (
 	"google.golang.org/grpc/credentials/insecure"
 )
 
+type stopHooksKey struct{}
+
+type stopHooks struct {
+	lock sync.Mutex
+	fns  []func()
+}
+
+// OnStop registers fn to be called on SIGTERM after the rpc server has stopped serving.
+// Hooks run in reverse registration order. ctx must be the one passed to rpcFn.
+func OnStop(ctx context.Context, fn func()) {
+	hooks, ok := ctx.Value(stopHooksKey{}).(*stopHooks)
+	if !ok {
+		return
+	}
+	hooks.lock.Lock()
+	defer hooks.lock.Unlock()
+	hooks.fns = append(hooks.fns, fn)
+}
+
+func (s *stopHooks) run() {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	for i := len(s.fns) - 1; i >= 0; i-- {
+		s.fns[i]()
+	}
+}
+
 // Start rpc server.
 func Start[T any](ctx context.Context, discovery *config.Discovery, listenIP,
 	registerIP string, rpcPorts []int, index int, rpcRegisterName string, share *config.Share, config T, rpcFn func(ctx context.Context,
@@ -69,14 +96,16 @@ func Start[T any](ctx context.Context, discovery *config.Discovery, listenIP,
 		return err
 	}
 
-	options = append(options, mw.GrpcServer())
+	// the interceptors in options run after mw, with the operator of the call in ctx
+	options = append([]grpc.ServerOption{mw.GrpcServer()}, options...)
 	srv := grpc.NewServer(options...)
 	once := sync.Once{}
 	defer func() {
 		once.Do(srv.GracefulStop)
 	}()
 
-	err = rpcFn(ctx, config, client, srv)
+	hooks := &stopHooks{}
+	err = rpcFn(context.WithValue(ctx, stopHooksKey{}, hooks), config, client, srv)
 	if err != nil {
 		return err
 	}
@@ -110,7 +139,7 @@ func Start[T any](ctx context.Context, discovery *config.Discovery, listenIP,
 		}
 		ctx, cancel = context.WithTimeout(context.Background(), 15*time.Second)
 		defer cancel()
-		return nil
+		return gracefulStopWithCtx(ctx, hooks.run)
 	case <-netDone:
 		close(netDone)
 		return netErr
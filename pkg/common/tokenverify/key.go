@@ -0,0 +1,192 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tokenverify
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/openimsdk/tools/errs"
+)
+
+// Key is an asymmetric key tokens are verified with, and signed with if its private key is known.
+// An ECDSA P-256 key signs ES256 and an Ed25519 key EdDSA.
+type Key struct {
+	ID      string
+	method  jwt.SigningMethod
+	private crypto.Signer
+	public  crypto.PublicKey
+}
+
+// JWK is the public key of a Key as published in a JWKS.
+type JWK struct {
+	Kty string `json:"kty"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y,omitempty"`
+	Kid string `json:"kid"`
+	Alg string `json:"alg"`
+	Use string `json:"use"`
+}
+
+// LoadKey reads the PEM files of a key, the public key is derived from the private key if its file is empty.
+// A key without private key only verifies tokens, it is published before it signs to rotate keys without downtime.
+func LoadKey(kid string, privateKeyFile string, publicKeyFile string) (*Key, error) {
+	var privatePEM, publicPEM []byte
+	if privateKeyFile != "" {
+		data, err := os.ReadFile(privateKeyFile)
+		if err != nil {
+			return nil, errs.WrapMsg(err, "read private key", "kid", kid)
+		}
+		privatePEM = data
+	}
+	if publicKeyFile != "" {
+		data, err := os.ReadFile(publicKeyFile)
+		if err != nil {
+			return nil, errs.WrapMsg(err, "read public key", "kid", kid)
+		}
+		publicPEM = data
+	}
+	return ParseKey(kid, privatePEM, publicPEM)
+}
+
+// ParseKey parses a PKCS #8 or SEC 1 private key or a PKIX public key in PEM, at least one of them is required.
+func ParseKey(kid string, privatePEM []byte, publicPEM []byte) (*Key, error) {
+	if kid == "" {
+		return nil, errs.New("key without kid")
+	}
+	key := &Key{ID: kid}
+	if len(privatePEM) > 0 {
+		block, _ := pem.Decode(privatePEM)
+		if block == nil {
+			return nil, errs.New("private key is not PEM", "kid", kid)
+		}
+		private, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+		if err != nil {
+			if private, err = x509.ParseECPrivateKey(block.Bytes); err != nil {
+				return nil, errs.WrapMsg(err, "parse private key", "kid", kid)
+			}
+		}
+		signer, ok := private.(crypto.Signer)
+		if !ok {
+			return nil, errs.New("private key type not supported", "kid", kid)
+		}
+		key.private = signer
+		key.public = signer.Public()
+	}
+	if len(publicPEM) > 0 {
+		block, _ := pem.Decode(publicPEM)
+		if block == nil {
+			return nil, errs.New("public key is not PEM", "kid", kid)
+		}
+		public, err := x509.ParsePKIXPublicKey(block.Bytes)
+		if err != nil {
+			return nil, errs.WrapMsg(err, "parse public key", "kid", kid)
+		}
+		if eq, ok := public.(interface{ Equal(crypto.PublicKey) bool }); key.public != nil && (!ok || !eq.Equal(key.public)) {
+			return nil, errs.New("public key does not match the private key", "kid", kid)
+		}
+		key.public = public
+	}
+	switch public := key.public.(type) {
+	case *ecdsa.PublicKey:
+		if public.Curve != elliptic.P256() {
+			return nil, errs.New("only the P-256 curve is supported", "kid", kid)
+		}
+		key.method = jwt.SigningMethodES256
+	case ed25519.PublicKey:
+		key.method = jwt.SigningMethodEdDSA
+	case nil:
+		return nil, errs.New("key has neither private nor public key", "kid", kid)
+	default:
+		return nil, errs.New("key type not supported, use ECDSA P-256 or Ed25519", "kid", kid)
+	}
+	return key, nil
+}
+
+// CanSign reports whether the private key is known.
+func (k *Key) CanSign() bool {
+	return k.private != nil
+}
+
+func (k *Key) JWK() JWK {
+	jwk := JWK{Kid: k.ID, Alg: k.method.Alg(), Use: "sig"}
+	switch public := k.public.(type) {
+	case *ecdsa.PublicKey:
+		size := (public.Curve.Params().BitSize + 7) / 8
+		jwk.Kty = "EC"
+		jwk.Crv = "P-256"
+		jwk.X = base64.RawURLEncoding.EncodeToString(public.X.FillBytes(make([]byte, size)))
+		jwk.Y = base64.RawURLEncoding.EncodeToString(public.Y.FillBytes(make([]byte, size)))
+	case ed25519.PublicKey:
+		jwk.Kty = "OKP"
+		jwk.Crv = "Ed25519"
+		jwk.X = base64.RawURLEncoding.EncodeToString(public)
+	}
+	return jwk
+}
+
+// KeyFromJWK returns the verification key of a JWK published by JWKS.
+func KeyFromJWK(jwk JWK) (*Key, error) {
+	x, err := base64.RawURLEncoding.DecodeString(jwk.X)
+	if err != nil {
+		return nil, errs.WrapMsg(err, "invalid jwk x", "kid", jwk.Kid)
+	}
+	key := &Key{ID: jwk.Kid}
+	switch {
+	case jwk.Kty == "EC" && jwk.Crv == "P-256" && jwk.Alg == jwt.SigningMethodES256.Alg():
+		y, err := base64.RawURLEncoding.DecodeString(jwk.Y)
+		if err != nil {
+			return nil, errs.WrapMsg(err, "invalid jwk y", "kid", jwk.Kid)
+		}
+		public := &ecdsa.PublicKey{Curve: elliptic.P256(), X: new(big.Int).SetBytes(x), Y: new(big.Int).SetBytes(y)}
+		if !public.Curve.IsOnCurve(public.X, public.Y) {
+			return nil, errs.New("jwk point not on curve", "kid", jwk.Kid)
+		}
+		key.method, key.public = jwt.SigningMethodES256, public
+	case jwk.Kty == "OKP" && jwk.Crv == "Ed25519" && jwk.Alg == jwt.SigningMethodEdDSA.Alg():
+		if len(x) != ed25519.PublicKeySize {
+			return nil, errs.New("invalid jwk ed25519 key size", "kid", jwk.Kid)
+		}
+		key.method, key.public = jwt.SigningMethodEdDSA, ed25519.PublicKey(x)
+	default:
+		return nil, errs.New("jwk type not supported", "kid", jwk.Kid, "kty", jwk.Kty, "alg", jwk.Alg)
+	}
+	return key, nil
+}
+
+// Peek returns the kid and the expiry of a token without verifying it, they are only trusted once it was verified.
+func Peek(token string) (string, time.Time, error) {
+	var c claims
+	t, _, err := jwt.NewParser().ParseUnverified(token, &c)
+	if err != nil {
+		return "", time.Time{}, errs.ErrTokenMalformed.Wrap()
+	}
+	kid, _ := t.Header["kid"].(string)
+	var expire time.Time
+	if c.ExpiresAt != nil {
+		expire = c.ExpiresAt.Time
+	}
+	return kid, expire, nil
+}
@@ -0,0 +1,172 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tokenverify
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+)
+
+func writePEM(t *testing.T, name string, typ string, der []byte) string {
+	path := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: typ, Bytes: der}), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func writePrivate(t *testing.T, name string, key any) string {
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return writePEM(t, name, "PRIVATE KEY", der)
+}
+
+func writePublic(t *testing.T, name string, key any) string {
+	der, err := x509.MarshalPKIXPublicKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return writePEM(t, name, "PUBLIC KEY", der)
+}
+
+func decode(t *testing.T, s string) []byte {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return b
+}
+
+func TestSigningKeys(t *testing.T) {
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	edPublic, edPrivate, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	es, err := LoadKey("es", writePrivate(t, "es.pem", ecKey), "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	ed, err := LoadKey("ed", writePrivate(t, "ed.pem", edPrivate), writePublic(t, "ed.pub.pem", edPublic))
+	if err != nil {
+		t.Fatal(err)
+	}
+	tk := &Token{Expires: time.Hour, Secret: "secret", SigningKey: es, Keys: map[string]*Key{"es": es, "ed": ed}}
+
+	esToken, err := tk.CreateToken("u1", TokenUser, "s1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if userID, _, sessionID, err := tk.GetTokenSession(esToken); err != nil || userID != "u1" || sessionID != "s1" {
+		t.Fatalf("es token = %s %s %v", userID, sessionID, err)
+	}
+
+	// other backends verify the token with the published key alone
+	jwks := tk.JWKS()
+	if len(jwks) != 2 || jwks[0].Kid != "ed" || jwks[1].Kid != "es" {
+		t.Fatalf("jwks = %+v", jwks)
+	}
+	ecJWK := jwks[1]
+	if ecJWK.Kty != "EC" || ecJWK.Crv != "P-256" || ecJWK.Alg != "ES256" {
+		t.Fatalf("ec jwk = %+v", ecJWK)
+	}
+	published := &ecdsa.PublicKey{Curve: elliptic.P256(), X: new(big.Int).SetBytes(decode(t, ecJWK.X)), Y: new(big.Int).SetBytes(decode(t, ecJWK.Y))}
+	parsed, err := jwt.Parse(esToken, func(token *jwt.Token) (any, error) { return published, nil }, jwt.WithValidMethods([]string{"ES256"}))
+	if err != nil || parsed.Header["kid"] != "es" {
+		t.Fatalf("verify with jwk: %v", err)
+	}
+
+	// the APIs rebuild the keys from the JWKS to verify tokens locally
+	jwkKeys := map[string]*Key{}
+	for _, jwk := range jwks {
+		key, err := KeyFromJWK(jwk)
+		if err != nil {
+			t.Fatal(err)
+		}
+		jwkKeys[key.ID] = key
+	}
+	if _, _, err := (&Token{Keys: jwkKeys}).GetToken(esToken); err != nil {
+		t.Fatalf("verify with keys from jwks: %v", err)
+	}
+
+	// rotating to the Ed25519 key keeps the tokens of the old key valid while it is configured
+	tk.SigningKey = ed
+	edToken, err := tk.CreateToken("u1", TokenUser, "s2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	parsed, err = jwt.Parse(edToken, func(token *jwt.Token) (any, error) { return ed25519.PublicKey(decode(t, jwks[0].X)), nil }, jwt.WithValidMethods([]string{"EdDSA"}))
+	if err != nil || parsed.Header["kid"] != "ed" {
+		t.Fatalf("verify ed token with jwk: %v", err)
+	}
+	if _, _, err := tk.GetToken(esToken); err != nil {
+		t.Fatalf("old key token rejected during rotation: %v", err)
+	}
+	delete(tk.Keys, "es")
+	if _, _, err := tk.GetToken(esToken); err == nil {
+		t.Fatal("token of a removed key accepted")
+	}
+
+	// HS256 tokens are accepted while the secret is set, never with a kid
+	hsToken, err := (&Token{Expires: time.Hour, Secret: "secret"}).CreateToken("u1", TokenUser, "s3")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := tk.GetToken(hsToken); err != nil {
+		t.Fatalf("hs256 token rejected: %v", err)
+	}
+	forged := jwt.NewWithClaims(jwt.SigningMethodHS256, tk.buildClaimsExpire("u1", TokenAdmin, time.Hour))
+	forged.Header["kid"] = "ed"
+	forgedToken, err := forged.SignedString([]byte(ed.JWK().X))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := tk.GetToken(forgedToken); err == nil {
+		t.Fatal("hs256 token with kid accepted")
+	}
+	tk.Secret = ""
+	if _, _, err := tk.GetToken(hsToken); err == nil {
+		t.Fatal("hs256 token accepted without secret")
+	}
+
+	// a published key not yet signing only verifies
+	verifyOnly, err := LoadKey("next", "", writePublic(t, "next.pub.pem", &ecKey.PublicKey))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if verifyOnly.CanSign() {
+		t.Fatal("public key can sign")
+	}
+	if _, err := LoadKey("bad", writePrivate(t, "bad.pem", ecKey), writePublic(t, "bad.pub.pem", edPublic)); err == nil {
+		t.Fatal("mismatched public key accepted")
+	}
+}
@@ -15,6 +15,9 @@
 package tokenverify
 
 import (
+	"crypto/rand"
+	"encoding/hex"
+	"sort"
 	"time"
 
 	"github.com/golang-jwt/jwt/v4"
@@ -25,88 +28,197 @@ import (
 const (
 	TokenUser  = constant.NormalUser
 	TokenAdmin = constant.AdminUser
+	// TokenAdminChallenge is issued after the password of an admin with two-factor authentication was verified,
+	// it is only accepted to complete the second factor and never as an admin token.
+	TokenAdminChallenge = 3
 )
 
 type claims struct {
 	UserID     string
 	UserType   int32
 	PlatformID int32
+	// SessionID is empty in the tokens issued before it was added
+	SessionID string
 	jwt.RegisteredClaims
 }
 
 type Token struct {
+	// Expires is the lifetime of a login, that is of its refresh token or of its token when refresh tokens are disabled.
 	Expires time.Duration
-	Secret  string
+	// AccessExpires is the lifetime of the tokens issued with a refresh token, 0 disables refresh tokens.
+	AccessExpires time.Duration
+	// LegacyUntil closes the migration window, tokens without a session are rejected after it unless it is zero.
+	LegacyUntil time.Time
+	// Secret signs HS256 tokens when SigningKey is nil, the tokens without kid are verified with it while it is set.
+	Secret string
+	// SigningKey signs new tokens with its kid in the header.
+	SigningKey *Key
+	// Keys verify the tokens by the kid in their header, they are published as JWKS.
+	Keys map[string]*Key
 }
 
-func (t *Token) secret() jwt.Keyfunc {
-	return func(token *jwt.Token) (any, error) {
+func (t *Token) keyFunc(token *jwt.Token) (any, error) {
+	kid, _ := token.Header["kid"].(string)
+	if kid == "" {
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok || t.Secret == "" {
+			return nil, errs.New("token without kid not accepted", "alg", token.Method.Alg())
+		}
 		return []byte(t.Secret), nil
 	}
+	key, ok := t.Keys[kid]
+	if !ok {
+		return nil, errs.New("unknown kid", "kid", kid)
+	}
+	// the algorithm is the one of the key, never the one the token claims
+	if token.Method.Alg() != key.method.Alg() {
+		return nil, errs.New("token algorithm does not match the key", "kid", kid, "alg", token.Method.Alg())
+	}
+	return key.public, nil
 }
 
-func (t *Token) buildClaims(userID string, userType int32) claims {
+func (t *Token) sign(c claims) (string, error) {
+	var (
+		str string
+		err error
+	)
+	if t.SigningKey == nil {
+		str, err = jwt.NewWithClaims(jwt.SigningMethodHS256, c).SignedString([]byte(t.Secret))
+	} else {
+		token := jwt.NewWithClaims(t.SigningKey.method, c)
+		token.Header["kid"] = t.SigningKey.ID
+		str, err = token.SignedString(t.SigningKey.private)
+	}
+	if err != nil {
+		return "", errs.Wrap(err)
+	}
+	return str, nil
+}
+
+// JWKS returns the public keys tokens are verified with, sorted by kid.
+func (t *Token) JWKS() []JWK {
+	kids := make([]string, 0, len(t.Keys))
+	for kid := range t.Keys {
+		kids = append(kids, kid)
+	}
+	sort.Strings(kids)
+	jwks := make([]JWK, 0, len(kids))
+	for _, kid := range kids {
+		jwks = append(jwks, t.Keys[kid].JWK())
+	}
+	return jwks
+}
+
+func (t *Token) buildClaimsExpire(userID string, userType int32, expire time.Duration) claims {
 	now := time.Now()
+	// a random ID keeps tokens issued to the same user within one second apart, each login is its own session
+	id := make([]byte, 16)
+	_, _ = rand.Read(id)
 	return claims{
 		UserID:   userID,
 		UserType: userType,
 		RegisteredClaims: jwt.RegisteredClaims{
-			ExpiresAt: jwt.NewNumericDate(now.Add(t.Expires)),    // Expiration time
+			ID:        hex.EncodeToString(id),
+			ExpiresAt: jwt.NewNumericDate(now.Add(expire)),       // Expiration time
 			IssuedAt:  jwt.NewNumericDate(now),                   // Issuing time
 			NotBefore: jwt.NewNumericDate(now.Add(-time.Minute)), // Begin Effective time
 		},
 	}
 }
 
-func (t *Token) getToken(str string) (string, int32, error) {
-	token, err := jwt.ParseWithClaims(str, &claims{}, t.secret())
+func (t *Token) getToken(str string) (*claims, error) {
+	token, err := jwt.ParseWithClaims(str, &claims{}, t.keyFunc)
 	if err != nil {
 		if ve, ok := err.(*jwt.ValidationError); ok {
 			if ve.Errors&jwt.ValidationErrorMalformed != 0 {
-				return "", 0, errs.ErrTokenMalformed.Wrap()
+				return nil, errs.ErrTokenMalformed.Wrap()
 			} else if ve.Errors&jwt.ValidationErrorExpired != 0 {
-				return "", 0, errs.ErrTokenExpired.Wrap()
+				return nil, errs.ErrTokenExpired.Wrap()
 			} else if ve.Errors&jwt.ValidationErrorNotValidYet != 0 {
-				return "", 0, errs.ErrTokenNotValidYet.Wrap()
+				return nil, errs.ErrTokenNotValidYet.Wrap()
 			} else {
-				return "", 0, errs.ErrTokenUnknown.Wrap()
+				return nil, errs.ErrTokenUnknown.Wrap()
 			}
 		} else {
-			return "", 0, errs.ErrTokenNotValidYet.Wrap()
+			return nil, errs.ErrTokenNotValidYet.Wrap()
 		}
 	} else {
 		claims, ok := token.Claims.(*claims)
 		if claims.PlatformID != 0 {
-			return "", 0, errs.ErrTokenExpired.Wrap()
+			return nil, errs.ErrTokenExpired.Wrap()
 		}
 		if ok && token.Valid {
-			return claims.UserID, claims.UserType, nil
+			return claims, nil
 		}
-		return "", 0, errs.ErrTokenNotValidYet.Wrap()
+		return nil, errs.ErrTokenNotValidYet.Wrap()
 	}
 }
 
-func (t *Token) CreateToken(UserID string, userType int32) (string, error) {
+// CreateToken issues the token of a session, it lives AccessExpires when refresh tokens are enabled and Expires otherwise.
+func (t *Token) CreateToken(UserID string, userType int32, sessionID string) (string, error) {
 	if !(userType == TokenUser || userType == TokenAdmin) {
 		return "", errs.ErrTokenUnknown.WrapMsg("token type unknown")
 	}
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, t.buildClaims(UserID, userType))
-	str, err := token.SignedString([]byte(t.Secret))
-	if err != nil {
-		return "", errs.Wrap(err)
+	expire := t.Expires
+	if t.AccessExpires > 0 {
+		expire = t.AccessExpires
 	}
-	return str, nil
+	c := t.buildClaimsExpire(UserID, userType, expire)
+	c.SessionID = sessionID
+	return t.sign(c)
 }
 
 func (t *Token) GetToken(token string) (string, int32, error) {
-	userID, userType, err := t.getToken(token)
+	userID, userType, _, err := t.GetTokenSession(token)
+	return userID, userType, err
+}
+
+// GetTokenSession is GetToken returning the session of the token too, it is empty for a legacy token.
+func (t *Token) GetTokenSession(token string) (string, int32, string, error) {
+	c, err := t.getLoginToken(token)
 	if err != nil {
-		return "", 0, err
+		return "", 0, "", err
 	}
-	if !(userType == TokenUser || userType == TokenAdmin) {
-		return "", 0, errs.ErrTokenUnknown.WrapMsg("token type unknown")
+	return c.UserID, c.UserType, c.SessionID, nil
+}
+
+// GetTokenExpire returns when a token GetToken accepts expires.
+func (t *Token) GetTokenExpire(token string) (time.Time, error) {
+	c, err := t.getLoginToken(token)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return c.ExpiresAt.Time, nil
+}
+
+func (t *Token) getLoginToken(token string) (*claims, error) {
+	c, err := t.getToken(token)
+	if err != nil {
+		return nil, err
+	}
+	if !(c.UserType == TokenUser || c.UserType == TokenAdmin) {
+		return nil, errs.ErrTokenUnknown.WrapMsg("token type unknown")
+	}
+	if c.SessionID == "" && !t.LegacyUntil.IsZero() && time.Now().After(t.LegacyUntil) {
+		return nil, errs.ErrTokenExpired.WrapMsg("legacy token no longer accepted")
+	}
+	return c, nil
+}
+
+// CreateChallengeToken returns a challenge token of the admin account valid for expire.
+func (t *Token) CreateChallengeToken(account string, expire time.Duration) (string, error) {
+	return t.sign(t.buildClaimsExpire(account, TokenAdminChallenge, expire))
+}
+
+// GetChallengeToken returns the admin account of a challenge token.
+func (t *Token) GetChallengeToken(token string) (string, error) {
+	c, err := t.getToken(token)
+	if err != nil {
+		return "", err
+	}
+	if c.UserType != TokenAdminChallenge {
+		return "", errs.ErrTokenUnknown.WrapMsg("token type error")
 	}
-	return userID, userType, nil
+	return c.UserID, nil
 }
 
 //func (t *Token) GetAdminToken(token string) (string, error) {
@@ -0,0 +1,16 @@
+package model
+
+type AuditLog struct {
+	CreateTime     string `column:"create_time"`
+	OperatorUserID string `column:"operator_user_id"`
+	Method         string `column:"method"`
+	Permission     string `column:"permission"`
+	Request        string `column:"request"`
+	ResultCode     int32  `column:"result_code"`
+	ResultMsg      string `column:"result_msg"`
+	IP             string `column:"ip"`
+}
+
+func (AuditLog) SheetName() string {
+	return "audit_log"
+}
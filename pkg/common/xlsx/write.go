@@ -0,0 +1,134 @@
+package xlsx
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"github.com/xuri/excelize/v2"
+	"io"
+	"reflect"
+)
+
+const defaultSheetName = "Sheet1"
+
+func sheetRows(v interface{}) ([]string, [][]string, error) {
+	val := reflect.ValueOf(v)
+	if val.Kind() == reflect.Ptr {
+		val = val.Elem()
+	}
+	if val.Kind() != reflect.Slice {
+		return nil, nil, errors.New("not slice")
+	}
+	itemType := val.Type().Elem()
+	if itemType.Kind() != reflect.Struct {
+		return nil, nil, errors.New("not struct")
+	}
+	var (
+		header []string
+		fields []int
+	)
+	for i := 0; i < itemType.NumField(); i++ {
+		field := itemType.Field(i)
+		alias := field.Tag.Get("column")
+		switch alias {
+		case "":
+			header = append(header, field.Name)
+		case "-":
+			continue
+		default:
+			header = append(header, alias)
+		}
+		fields = append(fields, i)
+	}
+	if len(fields) == 0 {
+		return nil, nil, errors.New("empty column struct")
+	}
+	rows := make([][]string, 0, val.Len())
+	for i := 0; i < val.Len(); i++ {
+		item := val.Index(i)
+		row := make([]string, 0, len(fields))
+		for _, index := range fields {
+			s, err := Value2String(item.Field(index))
+			if err != nil {
+				return nil, nil, err
+			}
+			row = append(row, s)
+		}
+		rows = append(rows, row)
+	}
+	return header, rows, nil
+}
+
+func Value2String(rv reflect.Value) (string, error) {
+	switch rv.Kind() {
+	case reflect.Bool, reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64, reflect.String:
+		return fmt.Sprint(rv.Interface()), nil
+	default:
+		return "", errors.New("not Supported " + rv.Kind().String())
+	}
+}
+
+func WriteSheet(file *excelize.File, v interface{}) error {
+	header, rows, err := sheetRows(v)
+	if err != nil {
+		return err
+	}
+	sheetName := GetSheetName(v)
+	if sheetIndex, err := file.GetSheetIndex(sheetName); err != nil {
+		return err
+	} else if sheetIndex < 0 {
+		if _, err := file.NewSheet(sheetName); err != nil {
+			return err
+		}
+	}
+	if err := file.SetSheetRow(sheetName, GetAxis(1, 1), &header); err != nil {
+		return err
+	}
+	for i, row := range rows {
+		if err := file.SetSheetRow(sheetName, GetAxis(1, i+2), &row); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func WriteAll(w io.Writer, models ...interface{}) error {
+	if len(models) == 0 {
+		return errors.New("empty models")
+	}
+	file := excelize.NewFile()
+	defer file.Close()
+	var keepDefault bool
+	for i := 0; i < len(models); i++ {
+		if GetSheetName(models[i]) == defaultSheetName {
+			keepDefault = true
+		}
+		if err := WriteSheet(file, models[i]); err != nil {
+			return err
+		}
+	}
+	if !keepDefault {
+		if err := file.DeleteSheet(defaultSheetName); err != nil {
+			return err
+		}
+	}
+	file.SetActiveSheet(0)
+	return file.Write(w)
+}
+
+func WriteCSV(w io.Writer, v interface{}) error {
+	header, rows, err := sheetRows(v)
+	if err != nil {
+		return err
+	}
+	writer := csv.NewWriter(w)
+	if err := writer.Write(header); err != nil {
+		return err
+	}
+	if err := writer.WriteAll(rows); err != nil {
+		return err
+	}
+	return writer.Error()
+}
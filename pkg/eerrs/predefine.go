@@ -33,7 +33,29 @@ var (
 	ErrRefuseFriend             = errs.NewCodeError(20013, "RefuseFriend")
 	ErrEmailAlreadyRegister     = errs.NewCodeError(20014, "EmailAlreadyRegister")
 
-	ErrTokenNotExist = errs.NewCodeError(20101, "ErrTokenNotExist")
+	ErrTokenNotExist      = errs.NewCodeError(20101, "ErrTokenNotExist")
+	ErrRefreshTokenReused = errs.NewCodeError(20102, "RefreshTokenReused")
 
 	ErrAccountLockChange = errs.NewCodeError(20015, "No more than 3 days since last modification")
+
+	ErrNonceNotFound = errs.NewCodeError(20016, "NonceNotFound")
+	ErrNonceExpired  = errs.NewCodeError(20017, "NonceExpired")
+	ErrNonceUsed     = errs.NewCodeError(20018, "NonceUsed")
+	ErrNonceNotMatch = errs.NewCodeError(20019, "NonceNotMatch")
+
+	ErrWalletAlreadyLinked = errs.NewCodeError(20020, "WalletAlreadyLinked")
+
+	ErrPostEditExpired = errs.NewCodeError(20021, "PostEditExpired")
+
+	ErrPostDraftPublishing = errs.NewCodeError(20022, "PostDraftPublishing")
+
+	ErrAccountDeletionExecuting = errs.NewCodeError(20023, "AccountDeletionExecuting")
+
+	ErrDataExportLinkInvalid = errs.NewCodeError(20024, "DataExportLinkInvalid")
+	ErrDataExportLinkUsed    = errs.NewCodeError(20025, "DataExportLinkUsed")
+
+	ErrTwoFactorCodeInvalid = errs.NewCodeError(20026, "TwoFactorCodeInvalid")
+	ErrTwoFactorLocked      = errs.NewCodeError(20027, "TwoFactorLocked")
+
+	ErrInvitationCodeExpired = errs.NewCodeError(20028, "InvitationCodeExpired")
 )
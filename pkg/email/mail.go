@@ -16,40 +16,302 @@ package email
 
 import (
 	"context"
-	"fmt"
+	"crypto/tls"
+	"net"
+	"net/smtp"
+	"strconv"
+	"sync"
+	"time"
+
 	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
+	"github.com/openimsdk/tools/mq/memamq"
 	"gopkg.in/gomail.v2"
 )
 
 type Mail interface {
 	Name() string
-	SendMail(ctx context.Context, mail string, verifyCode string) error
+	// SendMail sends the verification code of usedFor in the template of language, the default language if empty.
+	SendMail(ctx context.Context, mail string, verifyCode string, usedFor int32, language string) error
+	// Stop sends the queued mails, the waiting retries get a last attempt, and refuses new mails.
+	Stop()
+}
+
+const (
+	// SecurityAuto uses implicit TLS on port 465 and STARTTLS when the server offers it on other ports
+	SecurityAuto = ""
+	// SecurityStartTLS refuses servers that do not offer STARTTLS
+	SecurityStartTLS = "starttls"
+	// SecurityTLS connects with implicit TLS
+	SecurityTLS = "tls"
+	// SecurityNone never encrypts, the password is only sent to localhost then
+	SecurityNone = "none"
+)
+
+type Config struct {
+	SMTPAddr string
+	SMTPPort int
+	// Username is the SMTP login, SenderMail if empty
+	Username   string
+	Password   string
+	SenderMail string
+	// Title replaces the subject of the templates if not empty
+	Title    string
+	Security string
+	// TLSConfig defaults to verifying the certificate of SMTPAddr.
+	TLSConfig *tls.Config
+	// TemplateDir replaces the embedded templates, see loadTemplates.
+	TemplateDir     string
+	DefaultLanguage string
+	// ValidTime is how long a code is valid, shown in the templates.
+	ValidTime time.Duration
+
+	// Queue sends in the background, SendMail then only reports template and queue errors.
+	Queue      bool
+	Workers    int
+	BufferSize int
+	// MaxRetry is the number of retries after the first failed attempt of a queued mail.
+	MaxRetry int
+	// RetryInterval is the wait before the first retry, the n-th retry waits n times as long.
+	// A failed mail waits outside the queue and is pushed again when its retry is due.
+	RetryInterval time.Duration
+	// Timeout bounds a single attempt from dialing to QUIT.
+	Timeout time.Duration
+}
+
+func (c *Config) setDefaults() {
+	if c.Username == "" {
+		c.Username = c.SenderMail
+	}
+	if c.DefaultLanguage == "" {
+		c.DefaultLanguage = "en"
+	}
+	if c.ValidTime <= 0 {
+		c.ValidTime = 5 * time.Minute
+	}
+	if c.Workers <= 0 {
+		c.Workers = 2
+	}
+	if c.BufferSize <= 0 {
+		c.BufferSize = 1000
+	}
+	if c.MaxRetry < 0 {
+		c.MaxRetry = 0
+	}
+	if c.RetryInterval <= 0 {
+		c.RetryInterval = time.Second
+	}
+	if c.Timeout <= 0 {
+		c.Timeout = 10 * time.Second
+	}
 }
 
-func NewMail(smtpAddr string, smtpPort int, senderMail, senderAuthorizationCode, title string) Mail {
-	dail := gomail.NewDialer(smtpAddr, smtpPort, senderMail, senderAuthorizationCode)
-	return &mail{
-		title:      title,
-		senderMail: senderMail,
-		dail:       dail,
+func NewMail(conf Config) (Mail, error) {
+	conf.setDefaults()
+	switch conf.Security {
+	case SecurityAuto, SecurityStartTLS, SecurityTLS, SecurityNone:
+	default:
+		return nil, errs.New("unknown mail security " + conf.Security).Wrap()
+	}
+	tmpl, err := loadTemplates(conf.TemplateDir, conf.DefaultLanguage)
+	if err != nil {
+		return nil, err
+	}
+	if conf.TLSConfig == nil {
+		conf.TLSConfig = &tls.Config{}
+	} else {
+		conf.TLSConfig = conf.TLSConfig.Clone()
+	}
+	if conf.TLSConfig.ServerName == "" {
+		conf.TLSConfig.ServerName = conf.SMTPAddr
+	}
+	m := &mail{
+		conf:      conf,
+		templates: tmpl,
+	}
+	if conf.Queue {
+		m.queue = memamq.NewMemoryQueue(conf.Workers, conf.BufferSize)
+		m.retries = make(map[*retry]struct{})
 	}
+	return m, nil
 }
 
 type mail struct {
-	senderMail string
-	title      string
-	dail       *gomail.Dialer
+	conf      Config
+	templates *templates
+	queue     *memamq.MemoryQueue
+
+	lock    sync.Mutex
+	stopped bool
+	retries map[*retry]struct{}
+}
+
+// retry is a failed queued mail waiting for its next attempt.
+type retry struct {
+	timer *time.Timer
+	task  func()
 }
 
 func (m *mail) Name() string {
 	return "mail"
 }
 
-func (m *mail) SendMail(ctx context.Context, mail string, verifyCode string) error {
+func (m *mail) SendMail(ctx context.Context, mail string, verifyCode string, usedFor int32, language string) error {
+	c, err := m.templates.render(language, &VerifyCode{
+		Email:        mail,
+		Code:         verifyCode,
+		UsedFor:      usedFor,
+		ValidMinutes: int(m.conf.ValidTime / time.Minute),
+	})
+	if err != nil {
+		return err
+	}
+	msg := m.message(mail, c)
+	if m.queue == nil {
+		return m.sendOnce(ctx, mail, msg)
+	}
+	ctx = context.WithoutCancel(ctx)
+	if err := m.queue.NotWaitPush(func() { m.send(ctx, mail, msg, 0) }); err != nil {
+		return errs.WrapMsg(err, "mail queue is full")
+	}
+	return nil
+}
+
+func (m *mail) Stop() {
+	if m.queue == nil {
+		return
+	}
+	m.lock.Lock()
+	m.stopped = true
+	retries := m.retries
+	m.retries = nil
+	m.lock.Unlock()
+	// a timer that fired meanwhile finds its retry gone and leaves it to this push
+	for r := range retries {
+		r.timer.Stop()
+		if err := m.queue.NotWaitPush(r.task); err != nil {
+			log.ZError(context.Background(), "push mail retry failed on stop", err)
+		}
+	}
+	m.queue.Stop()
+}
+
+func (m *mail) message(to string, c *content) *gomail.Message {
+	subject := c.subject
+	if m.conf.Title != "" {
+		subject = m.conf.Title
+	}
 	msg := gomail.NewMessage()
-	msg.SetHeader(`From`, m.senderMail)
-	msg.SetHeader(`To`, []string{mail}...)
-	msg.SetHeader(`Subject`, m.title)
-	msg.SetBody(`text/html`, fmt.Sprintf("Your verification code is: %s. This code is valid for 5 minutes and should not be shared with others", verifyCode))
-	return errs.Wrap(m.dail.DialAndSend(msg))
+	msg.SetHeader("From", m.conf.SenderMail)
+	msg.SetHeader("To", to)
+	msg.SetHeader("Subject", subject)
+	msg.SetDateHeader("Date", time.Now())
+	msg.SetBody("text/plain", c.text)
+	msg.AddAlternative("text/html", c.html)
+	return msg
+}
+
+// send runs in a queue worker, a failed attempt is pushed again after the retry interval instead of blocking the worker.
+func (m *mail) send(ctx context.Context, to string, msg *gomail.Message, attempt int) {
+	err := m.sendOnce(ctx, to, msg)
+	if err == nil {
+		return
+	}
+	log.ZWarn(ctx, "send mail failed", err, "attempt", attempt)
+	if attempt >= m.conf.MaxRetry {
+		log.ZError(ctx, "send mail failed, giving up", err, "retry", m.conf.MaxRetry)
+		return
+	}
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if m.stopped {
+		log.ZError(ctx, "send mail failed, giving up on stop", err, "attempt", attempt)
+		return
+	}
+	r := &retry{task: func() { m.send(ctx, to, msg, attempt+1) }}
+	r.timer = time.AfterFunc(m.conf.RetryInterval*time.Duration(attempt+1), func() {
+		// pushed under the lock, so it is in the queue before Stop drains it
+		m.lock.Lock()
+		defer m.lock.Unlock()
+		if _, ok := m.retries[r]; !ok {
+			return
+		}
+		delete(m.retries, r)
+		if err := m.queue.NotWaitPush(r.task); err != nil {
+			log.ZError(ctx, "push mail retry failed", err, "attempt", attempt+1)
+		}
+	})
+	m.retries[r] = struct{}{}
+}
+
+func (m *mail) sendOnce(ctx context.Context, to string, msg *gomail.Message) error {
+	ctx, cancel := context.WithTimeout(ctx, m.conf.Timeout)
+	defer cancel()
+	client, err := m.dial(ctx)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+	if err := client.Mail(m.conf.SenderMail); err != nil {
+		return errs.Wrap(err)
+	}
+	if err := client.Rcpt(to); err != nil {
+		return errs.Wrap(err)
+	}
+	w, err := client.Data()
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	if _, err := msg.WriteTo(w); err != nil {
+		return errs.Wrap(err)
+	}
+	if err := w.Close(); err != nil {
+		return errs.Wrap(err)
+	}
+	return errs.Wrap(client.Quit())
+}
+
+// dial connects, encrypts as configured by Security and logs in.
+func (m *mail) dial(ctx context.Context) (*smtp.Client, error) {
+	addr := net.JoinHostPort(m.conf.SMTPAddr, strconv.Itoa(m.conf.SMTPPort))
+	security := m.conf.Security
+	if security == SecurityAuto && m.conf.SMTPPort == 465 {
+		security = SecurityTLS
+	}
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "tcp", addr)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = conn.SetDeadline(deadline)
+	}
+	if security == SecurityTLS {
+		conn = tls.Client(conn, m.conf.TLSConfig)
+	}
+	client, err := smtp.NewClient(conn, m.conf.SMTPAddr)
+	if err != nil {
+		conn.Close()
+		return nil, errs.Wrap(err)
+	}
+	if security == SecurityAuto || security == SecurityStartTLS {
+		if ok, _ := client.Extension("STARTTLS"); ok {
+			if err := client.StartTLS(m.conf.TLSConfig); err != nil {
+				client.Close()
+				return nil, errs.Wrap(err)
+			}
+		} else if security == SecurityStartTLS {
+			client.Close()
+			return nil, errs.New("smtp server does not support STARTTLS", "addr", addr).Wrap()
+		}
+	}
+	if m.conf.Password != "" {
+		if ok, _ := client.Extension("AUTH"); ok {
+			if err := client.Auth(smtp.PlainAuth("", m.conf.Username, m.conf.Password, m.conf.SMTPAddr)); err != nil {
+				client.Close()
+				return nil, errs.Wrap(err)
+			}
+		}
+	}
+	return client, nil
 }
@@ -14,64 +14,382 @@
 
 package email
 
-// import (
-// 	"context"
-// 	"errors"
-// 	"fmt"
-// 	"io/ioutil"
-// 	"os"
-// 	"testing"
-
-// 	"github.com/openimsdk/chat/pkg/common/config"
-// 	"gopkg.in/yaml.v3"
-// )
-
-// func TestEmail(T *testing.T) {
-// 	if err := InitConfig(); err != nil {
-// 		fmt.Fprintf(os.Stderr, "\n\nexit -1: \n%+v\n\n", err)
-// 		os.Exit(-1)
-// 	}
-// 	tests := []struct {
-// 		name string
-// 		ctx  context.Context
-// 		mail string
-// 		code string
-// 		want error
-// 	}{
-// 		{
-// 			name: "success send email",
-// 			ctx:  context.Background(),
-// 			mail: "test@gmail.com",
-// 			code: "5555",
-// 			want: errors.New("nil"),
-// 		},
-// 		{
-// 			name: "fail send email",
-// 			ctx:  context.Background(),
-// 			mail: "",
-// 			code: "5555",
-// 			want: errors.New("dial tcp :0: connectex: The requested address is not valid in its context."),
-// 		},
-// 	}
-// 	// mail := NewMail()
-
-// 	for _, tt := range tests {
-// 		T.Run(tt.name, func(t *testing.T) {
-// 			if got := mail.SendMail(tt.ctx, tt.mail, tt.code); errors.Is(got, tt.want) {
-// 				t.Errorf("%v have a err,%v", tt.name, tt.want)
-// 			}
-// 		})
-// 	}
-// }
-
-// func InitConfig() error {
-// 	yam, err := ioutil.ReadFile("../../config/config.yaml")
-// 	if err != nil {
-// 		return err
-// 	}
-// 	err = yaml.Unmarshal(yam, &config.Config)
-// 	if err != nil {
-// 		return err
-// 	}
-// 	return nil
-// }
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"io"
+	"math/big"
+	"mime"
+	"mime/multipart"
+	"net"
+	netmail "net/mail"
+	"net/textproto"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/openimsdk/chat/pkg/common/constant"
+)
+
+type received struct {
+	from string
+	to   string
+	auth string
+	tls  bool
+	data string
+}
+
+// smtpServer is a minimal in-process SMTP server.
+type smtpServer struct {
+	ln       net.Listener
+	tls      *tls.Config
+	startTLS bool
+	implicit bool
+
+	mu sync.Mutex
+	// fail rejects the next DATA commands with a temporary error
+	fail  int
+	mails chan received
+}
+
+func newSMTPServer(t *testing.T, startTLS bool, implicit bool) (*smtpServer, *tls.Config) {
+	serverTLS, clientTLS := testCert(t)
+	s := &smtpServer{tls: serverTLS, startTLS: startTLS, implicit: implicit, mails: make(chan received, 10)}
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if implicit {
+		ln = tls.NewListener(ln, serverTLS)
+	}
+	s.ln = ln
+	t.Cleanup(func() { ln.Close() })
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			go s.serve(conn)
+		}
+	}()
+	return s, clientTLS
+}
+
+func (s *smtpServer) port() int {
+	return s.ln.Addr().(*net.TCPAddr).Port
+}
+
+func (s *smtpServer) serve(conn net.Conn) {
+	defer conn.Close()
+	text := textproto.NewConn(conn)
+	encrypted := s.implicit
+	var mail received
+	reply := func(line string) { _ = text.PrintfLine("%s", line) }
+	reply("220 127.0.0.1 ESMTP test")
+	for {
+		line, err := text.ReadLine()
+		if err != nil {
+			return
+		}
+		cmd, arg, _ := strings.Cut(line, " ")
+		switch strings.ToUpper(cmd) {
+		case "EHLO", "HELO":
+			lines := []string{"127.0.0.1"}
+			if s.startTLS && !encrypted {
+				lines = append(lines, "STARTTLS")
+			}
+			lines = append(lines, "AUTH PLAIN")
+			for i, l := range lines {
+				if i == len(lines)-1 {
+					reply("250 " + l)
+				} else {
+					reply("250-" + l)
+				}
+			}
+		case "STARTTLS":
+			reply("220 ready")
+			tlsConn := tls.Server(conn, s.tls)
+			if err := tlsConn.Handshake(); err != nil {
+				return
+			}
+			conn = tlsConn
+			text = textproto.NewConn(conn)
+			encrypted = true
+		case "AUTH":
+			_, initial, _ := strings.Cut(arg, " ")
+			data, _ := base64.StdEncoding.DecodeString(initial)
+			mail.auth = string(data)
+			reply("235 ok")
+		case "MAIL":
+			mail.from = strings.Trim(strings.TrimPrefix(arg, "FROM:"), "<>")
+			reply("250 ok")
+		case "RCPT":
+			mail.to = strings.Trim(strings.TrimPrefix(arg, "TO:"), "<>")
+			reply("250 ok")
+		case "DATA":
+			s.mu.Lock()
+			fail := s.fail > 0
+			if fail {
+				s.fail--
+			}
+			s.mu.Unlock()
+			if fail {
+				reply("451 try again later")
+				continue
+			}
+			reply("354 go ahead")
+			data, err := io.ReadAll(text.DotReader())
+			if err != nil {
+				return
+			}
+			mail.data = string(data)
+			mail.tls = encrypted
+			reply("250 queued")
+			s.mails <- mail
+		case "QUIT":
+			reply("221 bye")
+			return
+		default:
+			reply("250 ok")
+		}
+	}
+}
+
+func (s *smtpServer) wait(t *testing.T) received {
+	select {
+	case mail := <-s.mails:
+		return mail
+	case <-time.After(5 * time.Second):
+		t.Fatal("no mail received")
+		return received{}
+	}
+}
+
+// testCert returns a server config with a self-signed certificate for 127.0.0.1 and a client config trusting it.
+func testCert(t *testing.T) (*tls.Config, *tls.Config) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "127.0.0.1"},
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pool := x509.NewCertPool()
+	pool.AddCert(cert)
+	return &tls.Config{Certificates: []tls.Certificate{{Certificate: [][]byte{der}, PrivateKey: key}}},
+		&tls.Config{RootCAs: pool}
+}
+
+type parsedMail struct {
+	subject string
+	text    string
+	html    string
+}
+
+func parseMail(t *testing.T, data string) *parsedMail {
+	msg, err := netmail.ReadMessage(strings.NewReader(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	subject, err := new(mime.WordDecoder).DecodeHeader(msg.Header.Get("Subject"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	mediaType, params, err := mime.ParseMediaType(msg.Header.Get("Content-Type"))
+	if err != nil || mediaType != "multipart/alternative" {
+		t.Fatalf("content type %s, %v", mediaType, err)
+	}
+	res := &parsedMail{subject: subject}
+	parts := multipart.NewReader(msg.Body, params["boundary"])
+	for {
+		part, err := parts.NextPart()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		body, err := io.ReadAll(part)
+		if err != nil {
+			t.Fatal(err)
+		}
+		switch contentType, _, _ := mime.ParseMediaType(part.Header.Get("Content-Type")); contentType {
+		case "text/plain":
+			res.text = string(body)
+		case "text/html":
+			res.html = string(body)
+		}
+	}
+	return res
+}
+
+func newTestMail(t *testing.T, s *smtpServer, clientTLS *tls.Config, conf Config) Mail {
+	conf.SMTPAddr = "127.0.0.1"
+	conf.SMTPPort = s.port()
+	conf.SenderMail = "noreply@example.com"
+	conf.TLSConfig = clientTLS
+	conf.Timeout = 2 * time.Second
+	m, err := NewMail(conf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return m
+}
+
+func TestSendMailStartTLS(t *testing.T) {
+	s, clientTLS := newSMTPServer(t, true, false)
+	m := newTestMail(t, s, clientTLS, Config{Security: SecurityStartTLS, Password: "secret"})
+	if err := m.SendMail(context.Background(), "user@example.com", "123456", constant.VerificationCodeForRegister, "zh-CN"); err != nil {
+		t.Fatal(err)
+	}
+	got := s.wait(t)
+	if !got.tls || got.from != "noreply@example.com" || got.to != "user@example.com" || got.auth != "\x00noreply@example.com\x00secret" {
+		t.Fatalf("received %+v", got)
+	}
+	msg := parseMail(t, got.data)
+	if msg.subject != "注册验证码" || !strings.Contains(msg.text, "123456") || !strings.Contains(msg.html, "123456") || !strings.Contains(msg.html, "<html>") {
+		t.Fatalf("mail %+v", msg)
+	}
+}
+
+func TestSendMailImplicitTLS(t *testing.T) {
+	s, clientTLS := newSMTPServer(t, false, true)
+	m := newTestMail(t, s, clientTLS, Config{Security: SecurityTLS})
+	// no template for fr, the default language is used
+	if err := m.SendMail(context.Background(), "user@example.com", "654321", constant.VerificationCodeForResetPassword, "fr-FR"); err != nil {
+		t.Fatal(err)
+	}
+	got := s.wait(t)
+	msg := parseMail(t, got.data)
+	if !got.tls || msg.subject != "Reset your password" || !strings.Contains(msg.text, "654321") || !strings.Contains(msg.text, "valid for 5 minutes") {
+		t.Fatalf("received %+v, mail %+v", got, msg)
+	}
+}
+
+func TestSendMailSecurity(t *testing.T) {
+	s, clientTLS := newSMTPServer(t, false, false)
+	m := newTestMail(t, s, clientTLS, Config{Security: SecurityStartTLS})
+	if err := m.SendMail(context.Background(), "user@example.com", "1", constant.VerificationCodeForLogin, ""); err == nil || !strings.Contains(err.Error(), "STARTTLS") {
+		t.Fatalf("want a STARTTLS error, got %v", err)
+	}
+	m = newTestMail(t, s, clientTLS, Config{Security: SecurityNone, Title: "Code"})
+	if err := m.SendMail(context.Background(), "user@example.com", "1", constant.VerificationCodeForLogin, ""); err != nil {
+		t.Fatal(err)
+	}
+	if got := s.wait(t); got.tls || parseMail(t, got.data).subject != "Code" {
+		t.Fatalf("received %+v", got)
+	}
+	if _, err := NewMail(Config{Security: "ssl"}); err == nil {
+		t.Fatal("want an error for an unknown security")
+	}
+}
+
+func TestSendMailQueue(t *testing.T) {
+	s, clientTLS := newSMTPServer(t, true, false)
+	s.fail = 2
+	m := newTestMail(t, s, clientTLS, Config{Queue: true, MaxRetry: 3, RetryInterval: 10 * time.Millisecond})
+	if err := m.SendMail(context.Background(), "user@example.com", "777777", constant.VerificationCodeForLogin, "en"); err != nil {
+		t.Fatal(err)
+	}
+	got := s.wait(t)
+	if msg := parseMail(t, got.data); msg.subject != "Your login code" || !strings.Contains(msg.text, "777777") {
+		t.Fatalf("mail %+v", msg)
+	}
+	// the template is still checked before queueing
+	if err := m.SendMail(context.Background(), "user@example.com", "1", 99, "en"); err == nil {
+		t.Fatal("want an error for an unknown usedFor")
+	}
+}
+
+func TestSendMailQueueRetryStop(t *testing.T) {
+	s, clientTLS := newSMTPServer(t, true, false)
+	s.fail = 1
+	m := newTestMail(t, s, clientTLS, Config{Queue: true, Workers: 1, MaxRetry: 1, RetryInterval: time.Hour})
+	if err := m.SendMail(context.Background(), "first@example.com", "1", constant.VerificationCodeForLogin, "en"); err != nil {
+		t.Fatal(err)
+	}
+	// the failed mail waits for its retry without holding the only worker
+	if err := m.SendMail(context.Background(), "second@example.com", "2", constant.VerificationCodeForLogin, "en"); err != nil {
+		t.Fatal(err)
+	}
+	if got := s.wait(t); got.to != "second@example.com" {
+		t.Fatalf("received %+v", got)
+	}
+	// stopping sends the waiting retry at once
+	m.Stop()
+	select {
+	case got := <-s.mails:
+		if got.to != "first@example.com" {
+			t.Fatalf("received %+v", got)
+		}
+	default:
+		t.Fatal("retry not sent on stop")
+	}
+	if err := m.SendMail(context.Background(), "third@example.com", "3", constant.VerificationCodeForLogin, "en"); err == nil {
+		t.Fatal("want an error after stop")
+	}
+}
+
+func TestTemplates(t *testing.T) {
+	dir := t.TempDir()
+	write := func(name string, data string) {
+		path := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(data), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	tmpl := `{{define "subject"}}{{.Code}}{{end}}{{define "text"}}{{.Code}}{{end}}{{define "html"}}<b>{{.Code}}</b>{{end}}`
+	write("de/register.tmpl", tmpl)
+	write("de/login.tmpl", tmpl)
+	if _, err := loadTemplates(dir, "de"); err == nil {
+		t.Fatal("want an error for a missing purpose of the default language")
+	}
+	write("de/reset_password.tmpl", tmpl)
+	write("pt-br/login.tmpl", strings.NewReplacer("<b>", "<i>", "</b>", "</i>").Replace(tmpl))
+	templates, err := loadTemplates(dir, "de")
+	if err != nil {
+		t.Fatal(err)
+	}
+	c, err := templates.render("pt_BR", &VerifyCode{Code: "<x>", UsedFor: constant.VerificationCodeForLogin})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// values are escaped in the html part only
+	if c.subject != "<x>" || c.text != "<x>" || c.html != "<i>&lt;x&gt;</i>" {
+		t.Fatalf("content %+v", c)
+	}
+	if c, _ := templates.render("pt", &VerifyCode{Code: "1", UsedFor: constant.VerificationCodeForRegister}); c.html != "<b>1</b>" {
+		t.Fatalf("fallback content %+v", c)
+	}
+	write("de/broken.tmpl", `{{define "subject"}}{{end}}`)
+	if _, err := loadTemplates(dir, "de"); err == nil {
+		t.Fatal("want an error for a template without text and html")
+	}
+}
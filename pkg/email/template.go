@@ -0,0 +1,176 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package email
+
+import (
+	"bytes"
+	"embed"
+	htmltemplate "html/template"
+	"io/fs"
+	"os"
+	"path"
+	"strings"
+	"text/template"
+
+	"github.com/openimsdk/tools/errs"
+
+	"github.com/openimsdk/chat/pkg/common/constant"
+)
+
+// templates/<language>/<purpose>.tmpl define the blocks subject, text and html
+//
+//go:embed templates
+var defaultTemplates embed.FS
+
+// purposes maps SendVerifyCodeReq.UsedFor to the template name.
+var purposes = map[int32]string{
+	constant.VerificationCodeForRegister:      "register",
+	constant.VerificationCodeForLogin:         "login",
+	constant.VerificationCodeForResetPassword: "reset_password",
+}
+
+// VerifyCode is the data of the templates.
+type VerifyCode struct {
+	Email        string
+	Code         string
+	UsedFor      int32
+	ValidMinutes int
+}
+
+type content struct {
+	subject string
+	text    string
+	html    string
+}
+
+type mailTemplate struct {
+	text *template.Template
+	html *htmltemplate.Template
+}
+
+// templates holds the templates by language and purpose.
+type templates struct {
+	defaultLanguage string
+	languages       map[string]map[string]*mailTemplate
+}
+
+// loadTemplates reads dir, or the embedded templates if dir is empty.
+// The default language must have a template for every purpose, other languages fall back to it.
+func loadTemplates(dir string, defaultLanguage string) (*templates, error) {
+	var fsys fs.FS
+	if dir == "" {
+		sub, err := fs.Sub(defaultTemplates, "templates")
+		if err != nil {
+			return nil, errs.Wrap(err)
+		}
+		fsys = sub
+	} else {
+		fsys = os.DirFS(dir)
+	}
+	files, err := fs.Glob(fsys, "*/*.tmpl")
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	t := &templates{
+		defaultLanguage: normalizeLanguage(defaultLanguage),
+		languages:       make(map[string]map[string]*mailTemplate),
+	}
+	for _, file := range files {
+		data, err := fs.ReadFile(fsys, file)
+		if err != nil {
+			return nil, errs.Wrap(err)
+		}
+		tmpl, err := parseMailTemplate(file, string(data))
+		if err != nil {
+			return nil, err
+		}
+		language := normalizeLanguage(path.Dir(file))
+		if t.languages[language] == nil {
+			t.languages[language] = make(map[string]*mailTemplate)
+		}
+		t.languages[language][strings.TrimSuffix(path.Base(file), ".tmpl")] = tmpl
+	}
+	for _, purpose := range purposes {
+		if _, ok := t.languages[t.defaultLanguage][purpose]; !ok {
+			return nil, errs.New("missing mail template", "language", t.defaultLanguage, "purpose", purpose).Wrap()
+		}
+	}
+	return t, nil
+}
+
+func parseMailTemplate(name string, data string) (*mailTemplate, error) {
+	text, err := template.New(name).Option("missingkey=error").Parse(data)
+	if err != nil {
+		return nil, errs.WrapMsg(err, "invalid mail template", "file", name)
+	}
+	// the html block is parsed again with html/template so the values are escaped
+	html, err := htmltemplate.New(name).Option("missingkey=error").Parse(data)
+	if err != nil {
+		return nil, errs.WrapMsg(err, "invalid mail template", "file", name)
+	}
+	for _, block := range []string{"subject", "text"} {
+		if text.Lookup(block) == nil {
+			return nil, errs.New("mail template without "+block, "file", name).Wrap()
+		}
+	}
+	if html.Lookup("html") == nil {
+		return nil, errs.New("mail template without html", "file", name).Wrap()
+	}
+	return &mailTemplate{text: text, html: html}, nil
+}
+
+// normalizeLanguage lowers a BCP 47 tag, zh_CN and zh-CN both become zh-cn.
+func normalizeLanguage(language string) string {
+	return strings.ToLower(strings.ReplaceAll(strings.TrimSpace(language), "_", "-"))
+}
+
+// lookup tries the language, its base language and the default language.
+func (t *templates) lookup(language string, purpose string) *mailTemplate {
+	language = normalizeLanguage(language)
+	candidates := []string{language}
+	if i := strings.IndexByte(language, '-'); i > 0 {
+		candidates = append(candidates, language[:i])
+	}
+	candidates = append(candidates, t.defaultLanguage)
+	for _, candidate := range candidates {
+		if tmpl, ok := t.languages[candidate][purpose]; ok {
+			return tmpl
+		}
+	}
+	return nil
+}
+
+func (t *templates) render(language string, data *VerifyCode) (*content, error) {
+	purpose, ok := purposes[data.UsedFor]
+	if !ok {
+		return nil, errs.ErrArgs.WrapMsg("unknown verify code usedFor")
+	}
+	tmpl := t.lookup(language, purpose)
+	var subject, text, html bytes.Buffer
+	if err := tmpl.text.ExecuteTemplate(&subject, "subject", data); err != nil {
+		return nil, errs.Wrap(err)
+	}
+	if err := tmpl.text.ExecuteTemplate(&text, "text", data); err != nil {
+		return nil, errs.Wrap(err)
+	}
+	if err := tmpl.html.ExecuteTemplate(&html, "html", data); err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return &content{
+		subject: strings.TrimSpace(subject.String()),
+		text:    text.String(),
+		html:    html.String(),
+	}, nil
+}
@@ -0,0 +1,61 @@
+package imnotify
+
+import (
+	"sync"
+	"time"
+)
+
+// breaker stops calls to OpenIM after threshold consecutive failures.
+// Once cooldown has passed a single probe is let through, its result closes or reopens the breaker.
+type breaker struct {
+	mu        sync.Mutex
+	threshold int
+	cooldown  time.Duration
+	failures  int
+	openUntil time.Time
+	probing   bool
+	now       func() time.Time
+}
+
+func newBreaker(threshold int, cooldown time.Duration) *breaker {
+	return &breaker{threshold: threshold, cooldown: cooldown, now: time.Now}
+}
+
+func (b *breaker) open() bool {
+	return b.failures >= b.threshold
+}
+
+// ready reports whether new events are worth queueing.
+func (b *breaker) ready() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return !b.open() || !b.now().Before(b.openUntil)
+}
+
+// allow reports whether a call may be made now, every allowed call must be followed by done.
+func (b *breaker) allow() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if !b.open() {
+		return true
+	}
+	if b.probing || b.now().Before(b.openUntil) {
+		return false
+	}
+	b.probing = true
+	return true
+}
+
+func (b *breaker) done(ok bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.probing = false
+	if ok {
+		b.failures = 0
+		return
+	}
+	b.failures++
+	if b.open() {
+		b.openUntil = b.now().Add(b.cooldown)
+	}
+}
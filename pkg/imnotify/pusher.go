@@ -0,0 +1,129 @@
+// Package imnotify pushes business events to online clients through OpenIM business notifications.
+package imnotify
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
+	"github.com/openimsdk/tools/mq/memamq"
+
+	"github.com/openimsdk/chat/pkg/common/imapi"
+	"github.com/openimsdk/chat/pkg/common/mctx"
+)
+
+var ErrBreakerOpen = errors.New("imnotify: circuit breaker is open")
+
+type Config struct {
+	Workers    int
+	BufferSize int
+	// MaxRetry is the number of retries after the first failed attempt.
+	MaxRetry int
+	// RetryInterval is the wait before the first retry, the n-th retry waits n times as long.
+	RetryInterval time.Duration
+	// Timeout bounds a single attempt including fetching the admin token.
+	Timeout time.Duration
+	// BreakerThreshold consecutive failures open the breaker for BreakerCooldown.
+	BreakerThreshold int
+	BreakerCooldown  time.Duration
+}
+
+func (c *Config) setDefaults() {
+	if c.Workers <= 0 {
+		c.Workers = 4
+	}
+	if c.BufferSize <= 0 {
+		c.BufferSize = 1000
+	}
+	if c.MaxRetry < 0 {
+		c.MaxRetry = 0
+	}
+	if c.RetryInterval <= 0 {
+		c.RetryInterval = 500 * time.Millisecond
+	}
+	if c.Timeout <= 0 {
+		c.Timeout = 3 * time.Second
+	}
+	if c.BreakerThreshold <= 0 {
+		c.BreakerThreshold = 5
+	}
+	if c.BreakerCooldown <= 0 {
+		c.BreakerCooldown = 30 * time.Second
+	}
+}
+
+type Pusher struct {
+	im      imapi.CallerInterface
+	conf    Config
+	queue   *memamq.MemoryQueue
+	breaker *breaker
+}
+
+func New(im imapi.CallerInterface, conf Config) *Pusher {
+	conf.setDefaults()
+	return &Pusher{
+		im:      im,
+		conf:    conf,
+		queue:   memamq.NewMemoryQueue(conf.Workers, conf.BufferSize),
+		breaker: newBreaker(conf.BreakerThreshold, conf.BreakerCooldown),
+	}
+}
+
+// Push queues data for recvUserID and returns without waiting for OpenIM.
+// The event is dropped with an error when the queue is full or the breaker is open.
+func (p *Pusher) Push(ctx context.Context, sendUserID, recvUserID, key string, data any) error {
+	if !p.breaker.ready() {
+		return ErrBreakerOpen
+	}
+	body, err := json.Marshal(data)
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	ctx = context.WithoutCancel(ctx)
+	return p.queue.NotWaitPush(func() {
+		p.send(ctx, sendUserID, recvUserID, key, string(body))
+	})
+}
+
+// Stop waits for queued events to be sent.
+func (p *Pusher) Stop() {
+	p.queue.Stop()
+}
+
+func (p *Pusher) send(ctx context.Context, sendUserID, recvUserID, key, data string) {
+	var err error
+	for attempt := 0; attempt <= p.conf.MaxRetry; attempt++ {
+		if attempt > 0 {
+			time.Sleep(p.conf.RetryInterval * time.Duration(attempt))
+		}
+		if !p.breaker.allow() {
+			err = ErrBreakerOpen
+			break
+		}
+		err = p.sendOnce(ctx, sendUserID, recvUserID, key, data)
+		var codeErr errs.CodeError
+		if errors.As(errs.Unwrap(err), &codeErr) {
+			// OpenIM answered and refused the request, retrying will not help and the server is healthy
+			p.breaker.done(true)
+			break
+		}
+		p.breaker.done(err == nil)
+		if err == nil {
+			return
+		}
+	}
+	log.ZWarn(ctx, "push business notification failed", err, "key", key, "recvUserID", recvUserID)
+}
+
+func (p *Pusher) sendOnce(ctx context.Context, sendUserID, recvUserID, key, data string) error {
+	ctx, cancel := context.WithTimeout(ctx, p.conf.Timeout)
+	defer cancel()
+	token, err := p.im.ImAdminTokenWithDefaultAdmin(ctx)
+	if err != nil {
+		return err
+	}
+	return p.im.SendBusinessNotification(mctx.WithApiToken(ctx, token), sendUserID, recvUserID, key, data)
+}
@@ -0,0 +1,171 @@
+package imnotify
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/openimsdk/chat/pkg/common/apistruct"
+	"github.com/openimsdk/chat/pkg/common/imapi"
+)
+
+// fakeOpenIM serves the token and business notification APIs, the first failures notification calls break.
+type fakeOpenIM struct {
+	mu       sync.Mutex
+	failures int
+	errCode  int
+	calls    int
+	received []apistruct.SendBusinessNotificationReq
+	tokens   []string
+}
+
+func (f *fakeOpenIM) start(t *testing.T) imapi.CallerInterface {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/auth/user_token", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"errCode":0,"data":{"token":"admin-token"}}`))
+	})
+	mux.HandleFunc("/msg/send_business_notification", func(w http.ResponseWriter, r *http.Request) {
+		f.mu.Lock()
+		defer f.mu.Unlock()
+		f.calls++
+		if f.calls <= f.failures {
+			w.WriteHeader(http.StatusBadGateway)
+			_, _ = w.Write([]byte("bad gateway"))
+			return
+		}
+		if f.errCode != 0 {
+			_ = json.NewEncoder(w).Encode(map[string]any{"errCode": f.errCode, "errMsg": "refused"})
+			return
+		}
+		var req apistruct.SendBusinessNotificationReq
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		f.received = append(f.received, req)
+		f.tokens = append(f.tokens, r.Header.Get("token"))
+		_, _ = w.Write([]byte(`{"errCode":0,"data":{"serverMsgID":"s1"}}`))
+	})
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+	return imapi.New(srv.URL, "secret", "imAdmin")
+}
+
+func testConfig() Config {
+	return Config{
+		Workers:          1,
+		BufferSize:       16,
+		MaxRetry:         2,
+		RetryInterval:    time.Millisecond,
+		Timeout:          time.Second,
+		BreakerThreshold: 3,
+		BreakerCooldown:  time.Hour,
+	}
+}
+
+func TestPushDelivers(t *testing.T) {
+	im := &fakeOpenIM{}
+	p := New(im.start(t), testConfig())
+	if err := p.Push(context.Background(), "u1", "u2", "post_notification", map[string]string{"postID": "p1"}); err != nil {
+		t.Fatal(err)
+	}
+	p.Stop()
+	if len(im.received) != 1 {
+		t.Fatalf("want 1 notification, have %d", len(im.received))
+	}
+	got := im.received[0]
+	if got.SendUserID != "u1" || got.RecvUserID != "u2" || got.Key != "post_notification" || got.Data != `{"postID":"p1"}` {
+		t.Fatalf("unexpected notification %+v", got)
+	}
+	if im.tokens[0] != "admin-token" {
+		t.Fatalf("notification sent without the admin token: %q", im.tokens[0])
+	}
+}
+
+func TestPushRetries(t *testing.T) {
+	im := &fakeOpenIM{failures: 2}
+	p := New(im.start(t), testConfig())
+	if err := p.Push(context.Background(), "u1", "u2", "k", "v"); err != nil {
+		t.Fatal(err)
+	}
+	p.Stop()
+	if im.calls != 3 || len(im.received) != 1 {
+		t.Fatalf("want delivery on the 3rd attempt, have %d calls and %d deliveries", im.calls, len(im.received))
+	}
+}
+
+func TestPushDoesNotRetryRejected(t *testing.T) {
+	im := &fakeOpenIM{errCode: 1004}
+	p := New(im.start(t), testConfig())
+	for i := 0; i < 5; i++ {
+		if err := p.Push(context.Background(), "u1", "u2", "k", "v"); err != nil {
+			t.Fatal(err)
+		}
+	}
+	p.Stop()
+	if im.calls != 5 {
+		t.Fatalf("rejected notifications must not be retried, have %d calls", im.calls)
+	}
+	if !p.breaker.ready() {
+		t.Fatal("rejected notifications must not open the breaker")
+	}
+}
+
+func TestPushBreakerOpens(t *testing.T) {
+	im := &fakeOpenIM{failures: 1 << 30}
+	p := New(im.start(t), testConfig())
+	if err := p.Push(context.Background(), "u1", "u2", "k", "v"); err != nil {
+		t.Fatal(err)
+	}
+	p.Stop()
+	if im.calls != 3 {
+		t.Fatalf("want 3 attempts, have %d", im.calls)
+	}
+	if err := p.Push(context.Background(), "u1", "u2", "k", "v"); !errors.Is(err, ErrBreakerOpen) {
+		t.Fatalf("want ErrBreakerOpen, got %v", err)
+	}
+	if im.calls != 3 {
+		t.Fatalf("breaker let a call through: %d calls", im.calls)
+	}
+}
+
+func TestBreakerHalfOpen(t *testing.T) {
+	now := time.Now()
+	b := newBreaker(2, time.Minute)
+	b.now = func() time.Time { return now }
+	for i := 0; i < 2; i++ {
+		if !b.allow() {
+			t.Fatal("closed breaker rejected a call")
+		}
+		b.done(false)
+	}
+	if b.allow() || b.ready() {
+		t.Fatal("open breaker let a call through")
+	}
+
+	now = now.Add(time.Minute)
+	if !b.ready() || !b.allow() {
+		t.Fatal("breaker did not half-open after the cooldown")
+	}
+	if b.allow() {
+		t.Fatal("half-open breaker let a second call through")
+	}
+	b.done(false)
+	if b.allow() {
+		t.Fatal("failed probe did not reopen the breaker")
+	}
+
+	now = now.Add(time.Minute)
+	if !b.allow() {
+		t.Fatal("breaker did not half-open after the cooldown")
+	}
+	b.done(true)
+	if !b.allow() || !b.allow() {
+		t.Fatal("successful probe did not close the breaker")
+	}
+}
@@ -0,0 +1,208 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package iptree matches IP addresses against rules given as single addresses,
+// CIDR prefixes or address ranges, for IPv4 and IPv6.
+package iptree
+
+import (
+	"errors"
+	"net/netip"
+	"strings"
+)
+
+var ErrInvalidRule = errors.New("iptree: invalid rule")
+
+// Tree is a binary prefix tree, one bit per level, with separate roots for IPv4 and IPv6.
+// It is not safe for concurrent writes, build it once and replace it on change.
+type Tree[V any] struct {
+	v4  *node[V]
+	v6  *node[V]
+	len int
+}
+
+type node[V any] struct {
+	child [2]*node[V]
+	set   bool
+	value V
+}
+
+func New[V any]() *Tree[V] {
+	return &Tree[V]{v4: &node[V]{}, v6: &node[V]{}}
+}
+
+// Len returns the number of prefixes in the tree.
+func (t *Tree[V]) Len() int {
+	return t.len
+}
+
+// Insert adds the prefix with its value, the value of a prefix inserted twice is replaced.
+func (t *Tree[V]) Insert(prefix netip.Prefix, value V) {
+	prefix = prefix.Masked()
+	n := t.root(prefix.Addr())
+	bytes := prefix.Addr().AsSlice()
+	for i := 0; i < prefix.Bits(); i++ {
+		b := bit(bytes, i)
+		if n.child[b] == nil {
+			n.child[b] = &node[V]{}
+		}
+		n = n.child[b]
+	}
+	if !n.set {
+		t.len++
+	}
+	n.set = true
+	n.value = value
+}
+
+// Get returns the value of exactly the prefix.
+func (t *Tree[V]) Get(prefix netip.Prefix) (V, bool) {
+	prefix = prefix.Masked()
+	n := t.root(prefix.Addr())
+	bytes := prefix.Addr().AsSlice()
+	for i := 0; i < prefix.Bits() && n != nil; i++ {
+		n = n.child[bit(bytes, i)]
+	}
+	if n == nil || !n.set {
+		var zero V
+		return zero, false
+	}
+	return n.value, true
+}
+
+// Match returns the value of the longest prefix containing addr.
+func (t *Tree[V]) Match(addr netip.Addr) (V, bool) {
+	values := t.MatchAll(addr)
+	if len(values) == 0 {
+		var zero V
+		return zero, false
+	}
+	return values[len(values)-1], true
+}
+
+// MatchAll returns the values of all prefixes containing addr, the shortest prefix first.
+func (t *Tree[V]) MatchAll(addr netip.Addr) []V {
+	addr = addr.Unmap()
+	if !addr.IsValid() {
+		return nil
+	}
+	n := t.root(addr)
+	bytes := addr.AsSlice()
+	var values []V
+	for i := 0; n != nil; i++ {
+		if n.set {
+			values = append(values, n.value)
+		}
+		if i == addr.BitLen() {
+			break
+		}
+		n = n.child[bit(bytes, i)]
+	}
+	return values
+}
+
+func (t *Tree[V]) root(addr netip.Addr) *node[V] {
+	if addr.Is4() {
+		return t.v4
+	}
+	return t.v6
+}
+
+func bit(bytes []byte, i int) int {
+	return int(bytes[i/8]>>(7-i%8)) & 1
+}
+
+// ParseAddr parses an IPv4 or IPv6 address, an IPv4-mapped IPv6 address is returned as IPv4.
+func ParseAddr(s string) (netip.Addr, error) {
+	addr, err := netip.ParseAddr(strings.TrimSpace(s))
+	if err != nil {
+		return netip.Addr{}, err
+	}
+	return addr.Unmap().WithZone(""), nil
+}
+
+// ParseRule parses a single address "192.0.2.1", a CIDR prefix "192.0.2.0/24" or an
+// inclusive range "192.0.2.10-192.0.2.20". It returns the prefixes covering the rule
+// and its canonical form: the address, the masked prefix or the range with both ends normalized.
+func ParseRule(rule string) ([]netip.Prefix, string, error) {
+	rule = strings.TrimSpace(rule)
+	if start, end, ok := strings.Cut(rule, "-"); ok {
+		from, err := ParseAddr(start)
+		if err != nil {
+			return nil, "", ErrInvalidRule
+		}
+		to, err := ParseAddr(end)
+		if err != nil || from.Is4() != to.Is4() || to.Less(from) {
+			return nil, "", ErrInvalidRule
+		}
+		if from == to {
+			return []netip.Prefix{netip.PrefixFrom(from, from.BitLen())}, from.String(), nil
+		}
+		return rangePrefixes(from, to), from.String() + "-" + to.String(), nil
+	}
+	if strings.Contains(rule, "/") {
+		prefix, err := netip.ParsePrefix(rule)
+		if err != nil {
+			return nil, "", ErrInvalidRule
+		}
+		addr := prefix.Addr()
+		bits := prefix.Bits()
+		if addr.Is4In6() && bits >= 96 {
+			addr, bits = addr.Unmap(), bits-96
+		}
+		prefix = netip.PrefixFrom(addr, bits).Masked()
+		if bits == addr.BitLen() {
+			return []netip.Prefix{prefix}, addr.String(), nil
+		}
+		return []netip.Prefix{prefix}, prefix.String(), nil
+	}
+	addr, err := ParseAddr(rule)
+	if err != nil {
+		return nil, "", ErrInvalidRule
+	}
+	return []netip.Prefix{netip.PrefixFrom(addr, addr.BitLen())}, addr.String(), nil
+}
+
+// rangePrefixes returns the fewest prefixes covering the addresses from through to.
+func rangePrefixes(from netip.Addr, to netip.Addr) []netip.Prefix {
+	var prefixes []netip.Prefix
+	for {
+		// the largest prefix starting at from that does not go past to
+		bits := from.BitLen()
+		for bits > 0 {
+			p := netip.PrefixFrom(from, bits-1)
+			if p.Masked().Addr() != from || to.Less(lastAddr(p)) {
+				break
+			}
+			bits--
+		}
+		p := netip.PrefixFrom(from, bits)
+		prefixes = append(prefixes, p)
+		last := lastAddr(p)
+		if last == to {
+			return prefixes
+		}
+		from = last.Next()
+	}
+}
+
+// lastAddr returns the last address of the prefix.
+func lastAddr(p netip.Prefix) netip.Addr {
+	bytes := p.Masked().Addr().AsSlice()
+	for i := p.Bits(); i < len(bytes)*8; i++ {
+		bytes[i/8] |= 1 << (7 - i%8)
+	}
+	addr, _ := netip.AddrFromSlice(bytes)
+	return addr
+}
@@ -0,0 +1,106 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package iptree
+
+import (
+	"net/netip"
+	"reflect"
+	"testing"
+)
+
+func TestParseRule(t *testing.T) {
+	for _, c := range []struct {
+		rule      string
+		canonical string
+		prefixes  []string
+	}{
+		{"192.0.2.1", "192.0.2.1", []string{"192.0.2.1/32"}},
+		{" 192.0.2.77/24 ", "192.0.2.0/24", []string{"192.0.2.0/24"}},
+		{"192.0.2.1/32", "192.0.2.1", []string{"192.0.2.1/32"}},
+		{"::ffff:192.0.2.1", "192.0.2.1", []string{"192.0.2.1/32"}},
+		{"::ffff:192.0.2.0/120", "192.0.2.0/24", []string{"192.0.2.0/24"}},
+		{"2001:db8:0:0:1::/64", "2001:db8::/64", []string{"2001:db8::/64"}},
+		{"192.0.2.0-192.0.2.255", "192.0.2.0-192.0.2.255", []string{"192.0.2.0/24"}},
+		{"192.0.2.5-192.0.2.5", "192.0.2.5", []string{"192.0.2.5/32"}},
+		{"192.0.2.10-192.0.2.20", "192.0.2.10-192.0.2.20", []string{"192.0.2.10/31", "192.0.2.12/30", "192.0.2.16/30", "192.0.2.20/32"}},
+		{"2001:db8::-2001:db8::3", "2001:db8::-2001:db8::3", []string{"2001:db8::/126"}},
+	} {
+		prefixes, canonical, err := ParseRule(c.rule)
+		if err != nil {
+			t.Fatalf("%q: %v", c.rule, err)
+		}
+		var got []string
+		for _, p := range prefixes {
+			got = append(got, p.String())
+		}
+		if canonical != c.canonical || !reflect.DeepEqual(got, c.prefixes) {
+			t.Errorf("%q: got %q %v, want %q %v", c.rule, canonical, got, c.canonical, c.prefixes)
+		}
+	}
+	for _, rule := range []string{"", "a.b.c.d", "192.0.2.0/33", "192.0.2.9-192.0.2.1", "192.0.2.1-2001:db8::1", "192.0.2.1-"} {
+		if _, _, err := ParseRule(rule); err == nil {
+			t.Errorf("%q accepted", rule)
+		}
+	}
+}
+
+func TestTree(t *testing.T) {
+	tree := New[string]()
+	for _, rule := range []string{"10.0.0.0/8", "10.1.0.0/16", "10.1.2.3", "2001:db8::/32", "192.0.2.10-192.0.2.20"} {
+		prefixes, canonical, err := ParseRule(rule)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, p := range prefixes {
+			tree.Insert(p, canonical)
+		}
+	}
+	for _, c := range []struct {
+		ip   string
+		want []string
+	}{
+		{"10.1.2.3", []string{"10.0.0.0/8", "10.1.0.0/16", "10.1.2.3"}},
+		{"10.1.9.9", []string{"10.0.0.0/8", "10.1.0.0/16"}},
+		{"::ffff:10.200.0.1", []string{"10.0.0.0/8"}},
+		{"11.0.0.1", nil},
+		{"2001:db8:ffff::1", []string{"2001:db8::/32"}},
+		{"2001:db9::1", nil},
+		{"192.0.2.15", []string{"192.0.2.10-192.0.2.20"}},
+		{"192.0.2.21", nil},
+	} {
+		addr, err := ParseAddr(c.ip)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := tree.MatchAll(addr); !reflect.DeepEqual(got, c.want) {
+			t.Errorf("%s: got %v, want %v", c.ip, got, c.want)
+		}
+	}
+	if v, ok := tree.Match(netip.MustParseAddr("10.1.2.3")); !ok || v != "10.1.2.3" {
+		t.Fatalf("longest match %q %v", v, ok)
+	}
+	if v, ok := tree.Get(netip.MustParsePrefix("10.1.77.0/16")); !ok || v != "10.1.0.0/16" {
+		t.Fatalf("get %q %v", v, ok)
+	}
+	if _, ok := tree.Get(netip.MustParsePrefix("10.1.2.0/24")); ok {
+		t.Fatal("get of a prefix not inserted")
+	}
+	// an IPv4 prefix never matches the IPv6 address with the same leading bits
+	tree = New[string]()
+	tree.Insert(netip.MustParsePrefix("0.0.0.0/0"), "v4")
+	if _, ok := tree.Match(netip.MustParseAddr("2001:db8::1")); ok {
+		t.Fatal("IPv6 address matched IPv4 rule")
+	}
+}
@@ -0,0 +1,159 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package passwd hashes stored passwords with argon2id or bcrypt.
+//
+// Hashes carry their algorithm: argon2id uses the PHC string format
+// ($argon2id$v=19$m=...,t=...,p=...$salt$hash) and bcrypt its own $2a$/$2b$ prefix.
+// Values without a prefix are legacy rows that stored the password as sent by the client.
+package passwd
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/bcrypt"
+)
+
+const (
+	Argon2id = "argon2id"
+	Bcrypt   = "bcrypt"
+	// Legacy is reported for rows stored before hashing was introduced.
+	Legacy = "legacy"
+)
+
+var ErrMalformedHash = errors.New("passwd: malformed hash")
+
+// Argon2Params are the argon2id cost parameters, memory is in KiB.
+type Argon2Params struct {
+	Memory  uint32
+	Time    uint32
+	Threads uint8
+	SaltLen uint32
+	KeyLen  uint32
+}
+
+// DefaultArgon2 follows the OWASP recommendation for argon2id.
+var DefaultArgon2 = Argon2Params{Memory: 64 * 1024, Time: 3, Threads: 2, SaltLen: 16, KeyLen: 32}
+
+// Hasher hashes new passwords with one algorithm and verifies hashes of any supported algorithm.
+type Hasher struct {
+	Algorithm  string
+	Argon2     Argon2Params
+	BcryptCost int
+}
+
+// Default is used by the package level Hash and Verify.
+var Default = &Hasher{Algorithm: Argon2id, Argon2: DefaultArgon2, BcryptCost: bcrypt.DefaultCost}
+
+func Hash(password string) (string, error) {
+	return Default.Hash(password)
+}
+
+func Verify(encoded string, password string) (ok bool, rehash bool) {
+	return Default.Verify(encoded, password)
+}
+
+// Hash returns the encoded hash of password with the configured algorithm.
+func (h *Hasher) Hash(password string) (string, error) {
+	switch h.Algorithm {
+	case Argon2id:
+		salt := make([]byte, h.Argon2.SaltLen)
+		if _, err := rand.Read(salt); err != nil {
+			return "", err
+		}
+		key := argon2.IDKey([]byte(password), salt, h.Argon2.Time, h.Argon2.Memory, h.Argon2.Threads, h.Argon2.KeyLen)
+		return fmt.Sprintf("$argon2id$v=%d$m=%d,t=%d,p=%d$%s$%s", argon2.Version, h.Argon2.Memory, h.Argon2.Time, h.Argon2.Threads,
+			base64.RawStdEncoding.EncodeToString(salt), base64.RawStdEncoding.EncodeToString(key)), nil
+	case Bcrypt:
+		hash, err := bcrypt.GenerateFromPassword([]byte(password), h.BcryptCost)
+		if err != nil {
+			return "", err
+		}
+		return string(hash), nil
+	default:
+		return "", fmt.Errorf("passwd: unsupported algorithm %q", h.Algorithm)
+	}
+}
+
+// Verify reports whether password matches encoded, and whether encoded should be replaced with a fresh Hash
+// because it is a legacy row, uses another algorithm or weaker parameters than configured.
+// An empty encoded value never matches.
+func (h *Hasher) Verify(encoded string, password string) (ok bool, rehash bool) {
+	if encoded == "" {
+		return false, false
+	}
+	switch Algorithm(encoded) {
+	case Argon2id:
+		params, salt, key, err := decodeArgon2(encoded)
+		if err != nil {
+			return false, false
+		}
+		got := argon2.IDKey([]byte(password), salt, params.Time, params.Memory, params.Threads, uint32(len(key)))
+		if subtle.ConstantTimeCompare(got, key) != 1 {
+			return false, false
+		}
+		return true, h.Algorithm != Argon2id || params.Memory < h.Argon2.Memory || params.Time < h.Argon2.Time || params.Threads < h.Argon2.Threads
+	case Bcrypt:
+		if bcrypt.CompareHashAndPassword([]byte(encoded), []byte(password)) != nil {
+			return false, false
+		}
+		cost, err := bcrypt.Cost([]byte(encoded))
+		return true, h.Algorithm != Bcrypt || err != nil || cost < h.BcryptCost
+	default:
+		ok := subtle.ConstantTimeCompare([]byte(encoded), []byte(password)) == 1
+		return ok, ok
+	}
+}
+
+// Algorithm returns the algorithm encoded was hashed with, Legacy if it carries no known tag.
+func Algorithm(encoded string) string {
+	switch {
+	case strings.HasPrefix(encoded, "$argon2id$"):
+		return Argon2id
+	case strings.HasPrefix(encoded, "$2a$"), strings.HasPrefix(encoded, "$2b$"), strings.HasPrefix(encoded, "$2y$"):
+		return Bcrypt
+	default:
+		return Legacy
+	}
+}
+
+func decodeArgon2(encoded string) (Argon2Params, []byte, []byte, error) {
+	var params Argon2Params
+	parts := strings.Split(encoded, "$")
+	if len(parts) != 6 {
+		return params, nil, nil, ErrMalformedHash
+	}
+	var version int
+	if _, err := fmt.Sscanf(parts[2], "v=%d", &version); err != nil || version != argon2.Version {
+		return params, nil, nil, ErrMalformedHash
+	}
+	if _, err := fmt.Sscanf(parts[3], "m=%d,t=%d,p=%d", &params.Memory, &params.Time, &params.Threads); err != nil {
+		return params, nil, nil, ErrMalformedHash
+	}
+	salt, err := base64.RawStdEncoding.DecodeString(parts[4])
+	if err != nil {
+		return params, nil, nil, ErrMalformedHash
+	}
+	key, err := base64.RawStdEncoding.DecodeString(parts[5])
+	if err != nil || len(key) == 0 {
+		return params, nil, nil, ErrMalformedHash
+	}
+	return params, salt, key, nil
+}
@@ -0,0 +1,105 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package passwd
+
+import (
+	"strings"
+	"testing"
+)
+
+// cheap parameters keep the tests fast
+var testArgon2 = Argon2Params{Memory: 1024, Time: 1, Threads: 1, SaltLen: 16, KeyLen: 32}
+
+func TestHashVerify(t *testing.T) {
+	for _, h := range []*Hasher{
+		{Algorithm: Argon2id, Argon2: testArgon2},
+		{Algorithm: Bcrypt, BcryptCost: 4},
+	} {
+		encoded, err := h.Hash("secret")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if Algorithm(encoded) != h.Algorithm {
+			t.Fatalf("%s: hash %q tagged as %s", h.Algorithm, encoded, Algorithm(encoded))
+		}
+		if ok, rehash := h.Verify(encoded, "secret"); !ok || rehash {
+			t.Fatalf("%s: verify = %v, %v", h.Algorithm, ok, rehash)
+		}
+		if ok, _ := h.Verify(encoded, "wrong"); ok {
+			t.Fatalf("%s: wrong password accepted", h.Algorithm)
+		}
+		again, err := h.Hash("secret")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if again == encoded {
+			t.Fatalf("%s: hash is not salted", h.Algorithm)
+		}
+	}
+}
+
+func TestVerifyMixedRows(t *testing.T) {
+	h := &Hasher{Algorithm: Argon2id, Argon2: testArgon2, BcryptCost: 4}
+	current, err := h.Hash("pw")
+	if err != nil {
+		t.Fatal(err)
+	}
+	weak, err := (&Hasher{Algorithm: Argon2id, Argon2: Argon2Params{Memory: 512, Time: 1, Threads: 1, SaltLen: 16, KeyLen: 32}}).Hash("pw")
+	if err != nil {
+		t.Fatal(err)
+	}
+	bcryptRow, err := (&Hasher{Algorithm: Bcrypt, BcryptCost: 4}).Hash("pw")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, c := range []struct {
+		name     string
+		encoded  string
+		password string
+		ok       bool
+		rehash   bool
+	}{
+		{"current", current, "pw", true, false},
+		{"weaker argon2id", weak, "pw", true, true},
+		{"bcrypt row", bcryptRow, "pw", true, true},
+		{"bcrypt wrong", bcryptRow, "px", false, false},
+		{"legacy", "pw", "pw", true, true},
+		{"legacy wrong", "pw", "px", false, false},
+		{"legacy hash sent by client", "5f4dcc3b5aa765d61d8327deb882cf99", "5f4dcc3b5aa765d61d8327deb882cf99", true, true},
+		{"empty row", "", "", false, false},
+		{"malformed", "$argon2id$v=19$m=1$x$y", "pw", false, false},
+		// a legacy row must not be matched by sending the stored hash of another row
+		{"hash as password", current, current, false, false},
+	} {
+		ok, rehash := h.Verify(c.encoded, c.password)
+		if ok != c.ok || rehash != c.rehash {
+			t.Errorf("%s: verify = %v, %v, want %v, %v", c.name, ok, rehash, c.ok, c.rehash)
+		}
+	}
+}
+
+func TestDefault(t *testing.T) {
+	encoded, err := Hash("pw")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(encoded, "$argon2id$v=19$m=65536,t=3,p=2$") {
+		t.Fatalf("unexpected default hash %q", encoded)
+	}
+	if ok, rehash := Verify(encoded, "pw"); !ok || rehash {
+		t.Fatalf("verify = %v, %v", ok, rehash)
+	}
+}
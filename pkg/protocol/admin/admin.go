@@ -37,6 +37,130 @@ func (x *ChangePasswordReq) Check() error {
 	return nil
 }
 
+func checkPermissions(permissions []string) error {
+	if datautil.Duplicate(permissions) {
+		return errs.ErrArgs.WrapMsg("permissions has duplicate")
+	}
+	for _, permission := range permissions {
+		if datautil.IndexOf(permission, constant.AdminPermissions...) < 0 {
+			return errs.ErrArgs.WrapMsg("unknown permission " + permission)
+		}
+	}
+	return nil
+}
+
+func (x *AddAdminRoleReq) Check() error {
+	if x.RoleID == "" {
+		return errs.ErrArgs.WrapMsg("roleID is empty")
+	}
+	if x.Name == "" {
+		return errs.ErrArgs.WrapMsg("name is empty")
+	}
+	return checkPermissions(x.Permissions)
+}
+
+func (x *UpdateAdminRoleReq) Check() error {
+	if x.RoleID == "" {
+		return errs.ErrArgs.WrapMsg("roleID is empty")
+	}
+	if x.Name == "" {
+		return errs.ErrArgs.WrapMsg("name is empty")
+	}
+	return checkPermissions(x.Permissions)
+}
+
+func (x *DelAdminRoleReq) Check() error {
+	if len(x.RoleIDs) == 0 {
+		return errs.ErrArgs.WrapMsg("roleIDs is empty")
+	}
+	if datautil.Duplicate(x.RoleIDs) {
+		return errs.ErrArgs.WrapMsg("roleIDs has duplicate")
+	}
+	return nil
+}
+
+func (x *SearchAdminRoleReq) Check() error {
+	if x.Pagination == nil {
+		return errs.ErrArgs.WrapMsg("pagination is empty")
+	}
+	return nil
+}
+
+func (x *SearchAuditLogReq) Check() error {
+	if x.Pagination == nil {
+		return errs.ErrArgs.WrapMsg("pagination is empty")
+	}
+	if x.Start < 0 || x.End < 0 {
+		return errs.ErrArgs.WrapMsg("start or end is invalid")
+	}
+	if x.End > 0 && x.Start > x.End {
+		return errs.ErrArgs.WrapMsg("start is after end")
+	}
+	return nil
+}
+
+func (x *AddAuditLogReq) Check() error {
+	if x.Log == nil || x.Log.Method == "" || x.Log.OperatorUserID == "" {
+		return errs.ErrArgs.WrapMsg("log method or operator is empty")
+	}
+	return nil
+}
+
+func (x *SetAdminRolesReq) Check() error {
+	if x.Account == "" {
+		return errs.ErrArgs.WrapMsg("account is empty")
+	}
+	if datautil.Duplicate(x.RoleIDs) {
+		return errs.ErrArgs.WrapMsg("roleIDs has duplicate")
+	}
+	return nil
+}
+
+func (x *CheckAdminPermissionReq) Check() error {
+	if datautil.IndexOf(x.Permission, constant.AdminPermissions...) < 0 {
+		return errs.ErrArgs.WrapMsg("unknown permission " + x.Permission)
+	}
+	return nil
+}
+
+func (x *VerifyLoginTOTPReq) Check() error {
+	if x.ChallengeToken == "" {
+		return errs.ErrArgs.WrapMsg("challengeToken is empty")
+	}
+	if x.Code == "" && x.RecoveryCode == "" {
+		return errs.ErrArgs.WrapMsg("code and recoveryCode are empty")
+	}
+	return nil
+}
+
+func (x *ConfirmTOTPReq) Check() error {
+	if x.Code == "" {
+		return errs.ErrArgs.WrapMsg("code is empty")
+	}
+	return nil
+}
+
+func (x *DisableTOTPReq) Check() error {
+	if x.Code == "" {
+		return errs.ErrArgs.WrapMsg("code is empty")
+	}
+	return nil
+}
+
+func (x *RegenerateRecoveryCodesReq) Check() error {
+	if x.Code == "" {
+		return errs.ErrArgs.WrapMsg("code is empty")
+	}
+	return nil
+}
+
+func (x *SetTOTPRequiredReq) Check() error {
+	if x.Account == "" {
+		return errs.ErrArgs.WrapMsg("account is empty")
+	}
+	return nil
+}
+
 func (x *AddDefaultFriendReq) Check() error {
 	if x.UserIDs == nil {
 		return errs.ErrArgs.WrapMsg("userIDs is empty")
@@ -101,6 +225,12 @@ func (x *AddInvitationCodeReq) Check() error {
 	if x.Codes == nil {
 		return errs.ErrArgs.WrapMsg("codes is invalid")
 	}
+	if x.MaxUses < 0 {
+		return errs.ErrArgs.WrapMsg("maxUses is invalid")
+	}
+	if x.ExpireTime < 0 {
+		return errs.ErrArgs.WrapMsg("expireTime is invalid")
+	}
 	return nil
 }
 
@@ -114,6 +244,12 @@ func (x *GenInvitationCodeReq) Check() error {
 	if x.Chars == "" {
 		return errs.ErrArgs.WrapMsg("chars is in invalid")
 	}
+	if x.MaxUses < 0 {
+		return errs.ErrArgs.WrapMsg("maxUses is invalid")
+	}
+	if x.ExpireTime < 0 {
+		return errs.ErrArgs.WrapMsg("expireTime is invalid")
+	}
 	return nil
 }
 
@@ -134,6 +270,16 @@ func (x *UseInvitationCodeReq) Check() error {
 	return nil
 }
 
+func (x *ReleaseInvitationCodeReq) Check() error {
+	if x.Code == "" {
+		return errs.ErrArgs.WrapMsg("code is empty")
+	}
+	if x.UserID == "" {
+		return errs.ErrArgs.WrapMsg("userID is empty")
+	}
+	return nil
+}
+
 func (x *DelInvitationCodeReq) Check() error {
 	if x.Codes == nil {
 		return errs.ErrArgs.WrapMsg("codes is empty")
@@ -142,7 +288,7 @@ func (x *DelInvitationCodeReq) Check() error {
 }
 
 func (x *SearchInvitationCodeReq) Check() error {
-	if !datautil.Contain(x.Status, constant.InvitationCodeUnused, constant.InvitationCodeUsed, constant.InvitationCodeAll) {
+	if !datautil.Contain(x.Status, constant.InvitationCodeUnused, constant.InvitationCodeUsed, constant.InvitationCodeUsable, constant.InvitationCodeAll) {
 		return errs.ErrArgs.WrapMsg("state invalid")
 	}
 	if x.Pagination == nil {
@@ -157,6 +303,38 @@ func (x *SearchInvitationCodeReq) Check() error {
 	return nil
 }
 
+func (x *SearchInvitationUseReq) Check() error {
+	if x.Pagination == nil {
+		return errs.ErrArgs.WrapMsg("pagination is empty")
+	}
+	if x.Pagination.PageNumber < 1 {
+		return errs.ErrArgs.WrapMsg("pageNumber is invalid")
+	}
+	if x.Pagination.ShowNumber < 1 {
+		return errs.ErrArgs.WrapMsg("showNumber is invalid")
+	}
+	return nil
+}
+
+func (x *GetInvitationReportReq) Check() error {
+	if !datautil.Contain(x.GroupBy, constant.InvitationReportByInviter, constant.InvitationReportByCampaign) {
+		return errs.ErrArgs.WrapMsg("groupBy is invalid")
+	}
+	if x.StartTime < 0 || x.EndTime < 0 || (x.EndTime > 0 && x.StartTime > x.EndTime) {
+		return errs.ErrArgs.WrapMsg("time range is invalid")
+	}
+	if x.Pagination == nil {
+		return errs.ErrArgs.WrapMsg("pagination is empty")
+	}
+	if x.Pagination.PageNumber < 1 {
+		return errs.ErrArgs.WrapMsg("pageNumber is invalid")
+	}
+	if x.Pagination.ShowNumber < 1 {
+		return errs.ErrArgs.WrapMsg("showNumber is invalid")
+	}
+	return nil
+}
+
 func (x *SearchUserIPLimitLoginReq) Check() error {
 	if x.Pagination == nil {
 		return errs.ErrArgs.WrapMsg("pagination is empty")
@@ -236,6 +414,9 @@ func (x *BlockUserReq) Check() error {
 	if x.UserID == "" {
 		return errs.ErrArgs.WrapMsg("userID is empty")
 	}
+	if x.ExpireTime < 0 {
+		return errs.ErrArgs.WrapMsg("expireTime is invalid")
+	}
 	return nil
 }
 
@@ -246,6 +427,13 @@ func (x *UnblockUserReq) Check() error {
 	return nil
 }
 
+func (x *SearchBlockHistoryReq) Check() error {
+	if x.Pagination == nil {
+		return errs.ErrArgs.WrapMsg("pagination is empty")
+	}
+	return nil
+}
+
 func (x *SearchBlockUserReq) Check() error {
 	if x.Pagination == nil {
 		return errs.ErrArgs.WrapMsg("pagination is empty")
@@ -283,6 +471,16 @@ func (x *ParseTokenReq) Check() error {
 	return nil
 }
 
+func (x *RefreshTokenReq) Check() error {
+	if x.RefreshToken == "" {
+		return errs.ErrArgs.WrapMsg("refreshToken is empty")
+	}
+	if x.UserType > constant.AdminUser || x.UserType < constant.NormalUser {
+		return errs.ErrArgs.WrapMsg("userType is invalid")
+	}
+	return nil
+}
+
 func (x *AddAppletReq) Check() error {
 	if x.Name == "" {
 		return errs.ErrArgs.WrapMsg("name is empty")
@@ -387,3 +585,30 @@ func (x *SearchAdminAccountReq) Check() error {
 	}
 	return nil
 }
+
+func (x *ListSessionsReq) Check() error {
+	if x.UserID == "" {
+		return errs.ErrArgs.WrapMsg("userID is empty")
+	}
+	return nil
+}
+
+func (x *RevokeSessionReq) Check() error {
+	if x.UserID == "" {
+		return errs.ErrArgs.WrapMsg("userID is empty")
+	}
+	if x.SessionID == "" {
+		return errs.ErrArgs.WrapMsg("sessionID is empty")
+	}
+	return nil
+}
+
+func (x *RevokeOtherSessionsReq) Check() error {
+	if x.UserID == "" {
+		return errs.ErrArgs.WrapMsg("userID is empty")
+	}
+	if x.CurrentSessionID == "" && x.CurrentToken == "" {
+		return errs.ErrArgs.WrapMsg("currentSessionID and currentToken are empty")
+	}
+	return nil
+}
@@ -108,6 +108,12 @@ type LoginResp struct {
 	FaceURL      string `protobuf:"bytes,4,opt,name=faceURL,proto3" json:"faceURL"`
 	Level        int32  `protobuf:"varint,5,opt,name=level,proto3" json:"level"`
 	AdminUserID  string `protobuf:"bytes,6,opt,name=adminUserID,proto3" json:"adminUserID"`
+	// set instead of adminToken when the second factor is still required
+	ChallengeToken string `protobuf:"bytes,7,opt,name=challengeToken,proto3" json:"challengeToken"`
+	// 0 none, 1 verify the TOTP or a recovery code, 2 enrol TOTP first
+	TwoFactor int32 `protobuf:"varint,8,opt,name=twoFactor,proto3" json:"twoFactor"`
+	// exchanged for a new adminToken at /account/refresh, empty when refresh tokens are disabled
+	RefreshToken string `protobuf:"bytes,9,opt,name=refreshToken,proto3" json:"refreshToken"`
 }
 
 func (x *LoginResp) Reset() {
@@ -184,19 +190,39 @@ func (x *LoginResp) GetAdminUserID() string {
 	return ""
 }
 
-type AddAdminAccountReq struct {
+func (x *LoginResp) GetChallengeToken() string {
+	if x != nil {
+		return x.ChallengeToken
+	}
+	return ""
+}
+
+func (x *LoginResp) GetTwoFactor() int32 {
+	if x != nil {
+		return x.TwoFactor
+	}
+	return 0
+}
+
+func (x *LoginResp) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+type VerifyLoginTOTPReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Account  string `protobuf:"bytes,1,opt,name=account,proto3" json:"account"`
-	Password string `protobuf:"bytes,2,opt,name=password,proto3" json:"password"`
-	FaceURL  string `protobuf:"bytes,3,opt,name=faceURL,proto3" json:"faceURL"`
-	Nickname string `protobuf:"bytes,4,opt,name=nickname,proto3" json:"nickname"`
+	ChallengeToken string `protobuf:"bytes,1,opt,name=challengeToken,proto3" json:"challengeToken"`
+	Code           string `protobuf:"bytes,2,opt,name=code,proto3" json:"code"`
+	RecoveryCode   string `protobuf:"bytes,3,opt,name=recoveryCode,proto3" json:"recoveryCode"`
 }
 
-func (x *AddAdminAccountReq) Reset() {
-	*x = AddAdminAccountReq{}
+func (x *VerifyLoginTOTPReq) Reset() {
+	*x = VerifyLoginTOTPReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_admin_admin_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -204,13 +230,13 @@ func (x *AddAdminAccountReq) Reset() {
 	}
 }
 
-func (x *AddAdminAccountReq) String() string {
+func (x *VerifyLoginTOTPReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddAdminAccountReq) ProtoMessage() {}
+func (*VerifyLoginTOTPReq) ProtoMessage() {}
 
-func (x *AddAdminAccountReq) ProtoReflect() protoreflect.Message {
+func (x *VerifyLoginTOTPReq) ProtoReflect() protoreflect.Message {
 	mi := &file_admin_admin_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -222,47 +248,43 @@ func (x *AddAdminAccountReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddAdminAccountReq.ProtoReflect.Descriptor instead.
-func (*AddAdminAccountReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use VerifyLoginTOTPReq.ProtoReflect.Descriptor instead.
+func (*VerifyLoginTOTPReq) Descriptor() ([]byte, []int) {
 	return file_admin_admin_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *AddAdminAccountReq) GetAccount() string {
-	if x != nil {
-		return x.Account
-	}
-	return ""
-}
-
-func (x *AddAdminAccountReq) GetPassword() string {
+func (x *VerifyLoginTOTPReq) GetChallengeToken() string {
 	if x != nil {
-		return x.Password
+		return x.ChallengeToken
 	}
 	return ""
 }
 
-func (x *AddAdminAccountReq) GetFaceURL() string {
+func (x *VerifyLoginTOTPReq) GetCode() string {
 	if x != nil {
-		return x.FaceURL
+		return x.Code
 	}
 	return ""
 }
 
-func (x *AddAdminAccountReq) GetNickname() string {
+func (x *VerifyLoginTOTPReq) GetRecoveryCode() string {
 	if x != nil {
-		return x.Nickname
+		return x.RecoveryCode
 	}
 	return ""
 }
 
-type AddAdminAccountResp struct {
+type EnrollTOTPReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// empty when called with an admin token
+	ChallengeToken string `protobuf:"bytes,1,opt,name=challengeToken,proto3" json:"challengeToken"`
 }
 
-func (x *AddAdminAccountResp) Reset() {
-	*x = AddAdminAccountResp{}
+func (x *EnrollTOTPReq) Reset() {
+	*x = EnrollTOTPReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_admin_admin_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -270,13 +292,13 @@ func (x *AddAdminAccountResp) Reset() {
 	}
 }
 
-func (x *AddAdminAccountResp) String() string {
+func (x *EnrollTOTPReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddAdminAccountResp) ProtoMessage() {}
+func (*EnrollTOTPReq) ProtoMessage() {}
 
-func (x *AddAdminAccountResp) ProtoReflect() protoreflect.Message {
+func (x *EnrollTOTPReq) ProtoReflect() protoreflect.Message {
 	mi := &file_admin_admin_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -288,25 +310,29 @@ func (x *AddAdminAccountResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddAdminAccountResp.ProtoReflect.Descriptor instead.
-func (*AddAdminAccountResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use EnrollTOTPReq.ProtoReflect.Descriptor instead.
+func (*EnrollTOTPReq) Descriptor() ([]byte, []int) {
 	return file_admin_admin_proto_rawDescGZIP(), []int{3}
 }
 
-type AdminUpdateInfoReq struct {
+func (x *EnrollTOTPReq) GetChallengeToken() string {
+	if x != nil {
+		return x.ChallengeToken
+	}
+	return ""
+}
+
+type EnrollTOTPResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Account  *wrapperspb.StringValue `protobuf:"bytes,1,opt,name=account,proto3" json:"account"`
-	Password *wrapperspb.StringValue `protobuf:"bytes,2,opt,name=password,proto3" json:"password"`
-	FaceURL  *wrapperspb.StringValue `protobuf:"bytes,3,opt,name=faceURL,proto3" json:"faceURL"`
-	Nickname *wrapperspb.StringValue `protobuf:"bytes,4,opt,name=nickname,proto3" json:"nickname"`
-	Level    *wrapperspb.Int32Value  `protobuf:"bytes,6,opt,name=level,proto3" json:"level"`
+	Secret          string `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret"`
+	ProvisioningURI string `protobuf:"bytes,2,opt,name=provisioningURI,proto3" json:"provisioningURI"`
 }
 
-func (x *AdminUpdateInfoReq) Reset() {
-	*x = AdminUpdateInfoReq{}
+func (x *EnrollTOTPResp) Reset() {
+	*x = EnrollTOTPResp{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_admin_admin_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -314,13 +340,13 @@ func (x *AdminUpdateInfoReq) Reset() {
 	}
 }
 
-func (x *AdminUpdateInfoReq) String() string {
+func (x *EnrollTOTPResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminUpdateInfoReq) ProtoMessage() {}
+func (*EnrollTOTPResp) ProtoMessage() {}
 
-func (x *AdminUpdateInfoReq) ProtoReflect() protoreflect.Message {
+func (x *EnrollTOTPResp) ProtoReflect() protoreflect.Message {
 	mi := &file_admin_admin_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -332,58 +358,36 @@ func (x *AdminUpdateInfoReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminUpdateInfoReq.ProtoReflect.Descriptor instead.
-func (*AdminUpdateInfoReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use EnrollTOTPResp.ProtoReflect.Descriptor instead.
+func (*EnrollTOTPResp) Descriptor() ([]byte, []int) {
 	return file_admin_admin_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *AdminUpdateInfoReq) GetAccount() *wrapperspb.StringValue {
-	if x != nil {
-		return x.Account
-	}
-	return nil
-}
-
-func (x *AdminUpdateInfoReq) GetPassword() *wrapperspb.StringValue {
-	if x != nil {
-		return x.Password
-	}
-	return nil
-}
-
-func (x *AdminUpdateInfoReq) GetFaceURL() *wrapperspb.StringValue {
-	if x != nil {
-		return x.FaceURL
-	}
-	return nil
-}
-
-func (x *AdminUpdateInfoReq) GetNickname() *wrapperspb.StringValue {
+func (x *EnrollTOTPResp) GetSecret() string {
 	if x != nil {
-		return x.Nickname
+		return x.Secret
 	}
-	return nil
+	return ""
 }
 
-func (x *AdminUpdateInfoReq) GetLevel() *wrapperspb.Int32Value {
+func (x *EnrollTOTPResp) GetProvisioningURI() string {
 	if x != nil {
-		return x.Level
+		return x.ProvisioningURI
 	}
-	return nil
+	return ""
 }
 
-type AdminUpdateInfoResp struct {
+type ConfirmTOTPReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserID   string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
-	Nickname string `protobuf:"bytes,2,opt,name=nickname,proto3" json:"nickname"`
-	FaceURL  string `protobuf:"bytes,3,opt,name=faceURL,proto3" json:"faceURL"`
+	ChallengeToken string `protobuf:"bytes,1,opt,name=challengeToken,proto3" json:"challengeToken"`
+	Code           string `protobuf:"bytes,2,opt,name=code,proto3" json:"code"`
 }
 
-func (x *AdminUpdateInfoResp) Reset() {
-	*x = AdminUpdateInfoResp{}
+func (x *ConfirmTOTPReq) Reset() {
+	*x = ConfirmTOTPReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_admin_admin_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -391,13 +395,13 @@ func (x *AdminUpdateInfoResp) Reset() {
 	}
 }
 
-func (x *AdminUpdateInfoResp) String() string {
+func (x *ConfirmTOTPReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AdminUpdateInfoResp) ProtoMessage() {}
+func (*ConfirmTOTPReq) ProtoMessage() {}
 
-func (x *AdminUpdateInfoResp) ProtoReflect() protoreflect.Message {
+func (x *ConfirmTOTPReq) ProtoReflect() protoreflect.Message {
 	mi := &file_admin_admin_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -409,42 +413,37 @@ func (x *AdminUpdateInfoResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AdminUpdateInfoResp.ProtoReflect.Descriptor instead.
-func (*AdminUpdateInfoResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use ConfirmTOTPReq.ProtoReflect.Descriptor instead.
+func (*ConfirmTOTPReq) Descriptor() ([]byte, []int) {
 	return file_admin_admin_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *AdminUpdateInfoResp) GetUserID() string {
-	if x != nil {
-		return x.UserID
-	}
-	return ""
-}
-
-func (x *AdminUpdateInfoResp) GetNickname() string {
+func (x *ConfirmTOTPReq) GetChallengeToken() string {
 	if x != nil {
-		return x.Nickname
+		return x.ChallengeToken
 	}
 	return ""
 }
 
-func (x *AdminUpdateInfoResp) GetFaceURL() string {
+func (x *ConfirmTOTPReq) GetCode() string {
 	if x != nil {
-		return x.FaceURL
+		return x.Code
 	}
 	return ""
 }
 
-type ChangePasswordReq struct {
+type ConfirmTOTPResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Password string `protobuf:"bytes,1,opt,name=password,proto3" json:"password"`
+	RecoveryCodes []string `protobuf:"bytes,1,rep,name=recoveryCodes,proto3" json:"recoveryCodes"`
+	// set when the enrolment completed a login
+	Login *LoginResp `protobuf:"bytes,2,opt,name=login,proto3" json:"login"`
 }
 
-func (x *ChangePasswordReq) Reset() {
-	*x = ChangePasswordReq{}
+func (x *ConfirmTOTPResp) Reset() {
+	*x = ConfirmTOTPResp{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_admin_admin_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -452,13 +451,13 @@ func (x *ChangePasswordReq) Reset() {
 	}
 }
 
-func (x *ChangePasswordReq) String() string {
+func (x *ConfirmTOTPResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChangePasswordReq) ProtoMessage() {}
+func (*ConfirmTOTPResp) ProtoMessage() {}
 
-func (x *ChangePasswordReq) ProtoReflect() protoreflect.Message {
+func (x *ConfirmTOTPResp) ProtoReflect() protoreflect.Message {
 	mi := &file_admin_admin_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -470,26 +469,35 @@ func (x *ChangePasswordReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChangePasswordReq.ProtoReflect.Descriptor instead.
-func (*ChangePasswordReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use ConfirmTOTPResp.ProtoReflect.Descriptor instead.
+func (*ConfirmTOTPResp) Descriptor() ([]byte, []int) {
 	return file_admin_admin_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *ChangePasswordReq) GetPassword() string {
+func (x *ConfirmTOTPResp) GetRecoveryCodes() []string {
 	if x != nil {
-		return x.Password
+		return x.RecoveryCodes
 	}
-	return ""
+	return nil
 }
 
-type ChangePasswordResp struct {
+func (x *ConfirmTOTPResp) GetLogin() *LoginResp {
+	if x != nil {
+		return x.Login
+	}
+	return nil
+}
+
+type DisableTOTPReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Code string `protobuf:"bytes,1,opt,name=code,proto3" json:"code"`
 }
 
-func (x *ChangePasswordResp) Reset() {
-	*x = ChangePasswordResp{}
+func (x *DisableTOTPReq) Reset() {
+	*x = DisableTOTPReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_admin_admin_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -497,13 +505,13 @@ func (x *ChangePasswordResp) Reset() {
 	}
 }
 
-func (x *ChangePasswordResp) String() string {
+func (x *DisableTOTPReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChangePasswordResp) ProtoMessage() {}
+func (*DisableTOTPReq) ProtoMessage() {}
 
-func (x *ChangePasswordResp) ProtoReflect() protoreflect.Message {
+func (x *DisableTOTPReq) ProtoReflect() protoreflect.Message {
 	mi := &file_admin_admin_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -515,19 +523,26 @@ func (x *ChangePasswordResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChangePasswordResp.ProtoReflect.Descriptor instead.
-func (*ChangePasswordResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use DisableTOTPReq.ProtoReflect.Descriptor instead.
+func (*DisableTOTPReq) Descriptor() ([]byte, []int) {
 	return file_admin_admin_proto_rawDescGZIP(), []int{7}
 }
 
-type GetAdminInfoReq struct {
+func (x *DisableTOTPReq) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type DisableTOTPResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *GetAdminInfoReq) Reset() {
-	*x = GetAdminInfoReq{}
+func (x *DisableTOTPResp) Reset() {
+	*x = DisableTOTPResp{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_admin_admin_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -535,13 +550,13 @@ func (x *GetAdminInfoReq) Reset() {
 	}
 }
 
-func (x *GetAdminInfoReq) String() string {
+func (x *DisableTOTPResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAdminInfoReq) ProtoMessage() {}
+func (*DisableTOTPResp) ProtoMessage() {}
 
-func (x *GetAdminInfoReq) ProtoReflect() protoreflect.Message {
+func (x *DisableTOTPResp) ProtoReflect() protoreflect.Message {
 	mi := &file_admin_admin_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -553,23 +568,21 @@ func (x *GetAdminInfoReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAdminInfoReq.ProtoReflect.Descriptor instead.
-func (*GetAdminInfoReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use DisableTOTPResp.ProtoReflect.Descriptor instead.
+func (*DisableTOTPResp) Descriptor() ([]byte, []int) {
 	return file_admin_admin_proto_rawDescGZIP(), []int{8}
 }
 
-type ChangeAdminPasswordReq struct {
+type RegenerateRecoveryCodesReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserID          string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
-	CurrentPassword string `protobuf:"bytes,2,opt,name=currentPassword,proto3" json:"currentPassword"`
-	NewPassword     string `protobuf:"bytes,3,opt,name=newPassword,proto3" json:"newPassword"`
+	Code string `protobuf:"bytes,1,opt,name=code,proto3" json:"code"`
 }
 
-func (x *ChangeAdminPasswordReq) Reset() {
-	*x = ChangeAdminPasswordReq{}
+func (x *RegenerateRecoveryCodesReq) Reset() {
+	*x = RegenerateRecoveryCodesReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_admin_admin_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -577,13 +590,13 @@ func (x *ChangeAdminPasswordReq) Reset() {
 	}
 }
 
-func (x *ChangeAdminPasswordReq) String() string {
+func (x *RegenerateRecoveryCodesReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChangeAdminPasswordReq) ProtoMessage() {}
+func (*RegenerateRecoveryCodesReq) ProtoMessage() {}
 
-func (x *ChangeAdminPasswordReq) ProtoReflect() protoreflect.Message {
+func (x *RegenerateRecoveryCodesReq) ProtoReflect() protoreflect.Message {
 	mi := &file_admin_admin_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -595,40 +608,28 @@ func (x *ChangeAdminPasswordReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChangeAdminPasswordReq.ProtoReflect.Descriptor instead.
-func (*ChangeAdminPasswordReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use RegenerateRecoveryCodesReq.ProtoReflect.Descriptor instead.
+func (*RegenerateRecoveryCodesReq) Descriptor() ([]byte, []int) {
 	return file_admin_admin_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *ChangeAdminPasswordReq) GetUserID() string {
-	if x != nil {
-		return x.UserID
-	}
-	return ""
-}
-
-func (x *ChangeAdminPasswordReq) GetCurrentPassword() string {
-	if x != nil {
-		return x.CurrentPassword
-	}
-	return ""
-}
-
-func (x *ChangeAdminPasswordReq) GetNewPassword() string {
+func (x *RegenerateRecoveryCodesReq) GetCode() string {
 	if x != nil {
-		return x.NewPassword
+		return x.Code
 	}
 	return ""
 }
 
-type ChangeAdminPasswordResp struct {
+type RegenerateRecoveryCodesResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	RecoveryCodes []string `protobuf:"bytes,1,rep,name=recoveryCodes,proto3" json:"recoveryCodes"`
 }
 
-func (x *ChangeAdminPasswordResp) Reset() {
-	*x = ChangeAdminPasswordResp{}
+func (x *RegenerateRecoveryCodesResp) Reset() {
+	*x = RegenerateRecoveryCodesResp{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_admin_admin_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -636,13 +637,13 @@ func (x *ChangeAdminPasswordResp) Reset() {
 	}
 }
 
-func (x *ChangeAdminPasswordResp) String() string {
+func (x *RegenerateRecoveryCodesResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChangeAdminPasswordResp) ProtoMessage() {}
+func (*RegenerateRecoveryCodesResp) ProtoMessage() {}
 
-func (x *ChangeAdminPasswordResp) ProtoReflect() protoreflect.Message {
+func (x *RegenerateRecoveryCodesResp) ProtoReflect() protoreflect.Message {
 	mi := &file_admin_admin_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -654,21 +655,29 @@ func (x *ChangeAdminPasswordResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChangeAdminPasswordResp.ProtoReflect.Descriptor instead.
-func (*ChangeAdminPasswordResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use RegenerateRecoveryCodesResp.ProtoReflect.Descriptor instead.
+func (*RegenerateRecoveryCodesResp) Descriptor() ([]byte, []int) {
 	return file_admin_admin_proto_rawDescGZIP(), []int{10}
 }
 
-type DelAdminAccountReq struct {
+func (x *RegenerateRecoveryCodesResp) GetRecoveryCodes() []string {
+	if x != nil {
+		return x.RecoveryCodes
+	}
+	return nil
+}
+
+type SetTOTPRequiredReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserIDs []string `protobuf:"bytes,1,rep,name=userIDs,proto3" json:"userIDs"`
+	Account  string `protobuf:"bytes,1,opt,name=account,proto3" json:"account"`
+	Required bool   `protobuf:"varint,2,opt,name=required,proto3" json:"required"`
 }
 
-func (x *DelAdminAccountReq) Reset() {
-	*x = DelAdminAccountReq{}
+func (x *SetTOTPRequiredReq) Reset() {
+	*x = SetTOTPRequiredReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_admin_admin_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -676,13 +685,13 @@ func (x *DelAdminAccountReq) Reset() {
 	}
 }
 
-func (x *DelAdminAccountReq) String() string {
+func (x *SetTOTPRequiredReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DelAdminAccountReq) ProtoMessage() {}
+func (*SetTOTPRequiredReq) ProtoMessage() {}
 
-func (x *DelAdminAccountReq) ProtoReflect() protoreflect.Message {
+func (x *SetTOTPRequiredReq) ProtoReflect() protoreflect.Message {
 	mi := &file_admin_admin_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -694,26 +703,33 @@ func (x *DelAdminAccountReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DelAdminAccountReq.ProtoReflect.Descriptor instead.
-func (*DelAdminAccountReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use SetTOTPRequiredReq.ProtoReflect.Descriptor instead.
+func (*SetTOTPRequiredReq) Descriptor() ([]byte, []int) {
 	return file_admin_admin_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *DelAdminAccountReq) GetUserIDs() []string {
+func (x *SetTOTPRequiredReq) GetAccount() string {
 	if x != nil {
-		return x.UserIDs
+		return x.Account
 	}
-	return nil
+	return ""
 }
 
-type DelAdminAccountResp struct {
+func (x *SetTOTPRequiredReq) GetRequired() bool {
+	if x != nil {
+		return x.Required
+	}
+	return false
+}
+
+type SetTOTPRequiredResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *DelAdminAccountResp) Reset() {
-	*x = DelAdminAccountResp{}
+func (x *SetTOTPRequiredResp) Reset() {
+	*x = SetTOTPRequiredResp{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_admin_admin_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -721,13 +737,13 @@ func (x *DelAdminAccountResp) Reset() {
 	}
 }
 
-func (x *DelAdminAccountResp) String() string {
+func (x *SetTOTPRequiredResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DelAdminAccountResp) ProtoMessage() {}
+func (*SetTOTPRequiredResp) ProtoMessage() {}
 
-func (x *DelAdminAccountResp) ProtoReflect() protoreflect.Message {
+func (x *SetTOTPRequiredResp) ProtoReflect() protoreflect.Message {
 	mi := &file_admin_admin_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -739,21 +755,25 @@ func (x *DelAdminAccountResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DelAdminAccountResp.ProtoReflect.Descriptor instead.
-func (*DelAdminAccountResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use SetTOTPRequiredResp.ProtoReflect.Descriptor instead.
+func (*SetTOTPRequiredResp) Descriptor() ([]byte, []int) {
 	return file_admin_admin_proto_rawDescGZIP(), []int{12}
 }
 
-type SearchAdminAccountReq struct {
+type AddAdminAccountReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Pagination *sdkwss.RequestPagination `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination"`
+	Account  string   `protobuf:"bytes,1,opt,name=account,proto3" json:"account"`
+	Password string   `protobuf:"bytes,2,opt,name=password,proto3" json:"password"`
+	FaceURL  string   `protobuf:"bytes,3,opt,name=faceURL,proto3" json:"faceURL"`
+	Nickname string   `protobuf:"bytes,4,opt,name=nickname,proto3" json:"nickname"`
+	Roles    []string `protobuf:"bytes,5,rep,name=roles,proto3" json:"roles"`
 }
 
-func (x *SearchAdminAccountReq) Reset() {
-	*x = SearchAdminAccountReq{}
+func (x *AddAdminAccountReq) Reset() {
+	*x = AddAdminAccountReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_admin_admin_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -761,13 +781,13 @@ func (x *SearchAdminAccountReq) Reset() {
 	}
 }
 
-func (x *SearchAdminAccountReq) String() string {
+func (x *AddAdminAccountReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SearchAdminAccountReq) ProtoMessage() {}
+func (*AddAdminAccountReq) ProtoMessage() {}
 
-func (x *SearchAdminAccountReq) ProtoReflect() protoreflect.Message {
+func (x *AddAdminAccountReq) ProtoReflect() protoreflect.Message {
 	mi := &file_admin_admin_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -779,29 +799,54 @@ func (x *SearchAdminAccountReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SearchAdminAccountReq.ProtoReflect.Descriptor instead.
-func (*SearchAdminAccountReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use AddAdminAccountReq.ProtoReflect.Descriptor instead.
+func (*AddAdminAccountReq) Descriptor() ([]byte, []int) {
 	return file_admin_admin_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *SearchAdminAccountReq) GetPagination() *sdkwss.RequestPagination {
+func (x *AddAdminAccountReq) GetAccount() string {
 	if x != nil {
-		return x.Pagination
+		return x.Account
 	}
-	return nil
+	return ""
 }
 
-type SearchAdminAccountResp struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *AddAdminAccountReq) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
 
-	Total         uint32              `protobuf:"varint,1,opt,name=total,proto3" json:"total"`
-	AdminAccounts []*GetAdminInfoResp `protobuf:"bytes,2,rep,name=adminAccounts,proto3" json:"adminAccounts"`
+func (x *AddAdminAccountReq) GetFaceURL() string {
+	if x != nil {
+		return x.FaceURL
+	}
+	return ""
 }
 
-func (x *SearchAdminAccountResp) Reset() {
-	*x = SearchAdminAccountResp{}
+func (x *AddAdminAccountReq) GetNickname() string {
+	if x != nil {
+		return x.Nickname
+	}
+	return ""
+}
+
+func (x *AddAdminAccountReq) GetRoles() []string {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+type AddAdminAccountResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *AddAdminAccountResp) Reset() {
+	*x = AddAdminAccountResp{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_admin_admin_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -809,13 +854,13 @@ func (x *SearchAdminAccountResp) Reset() {
 	}
 }
 
-func (x *SearchAdminAccountResp) String() string {
+func (x *AddAdminAccountResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SearchAdminAccountResp) ProtoMessage() {}
+func (*AddAdminAccountResp) ProtoMessage() {}
 
-func (x *SearchAdminAccountResp) ProtoReflect() protoreflect.Message {
+func (x *AddAdminAccountResp) ProtoReflect() protoreflect.Message {
 	mi := &file_admin_admin_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -827,41 +872,25 @@ func (x *SearchAdminAccountResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SearchAdminAccountResp.ProtoReflect.Descriptor instead.
-func (*SearchAdminAccountResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use AddAdminAccountResp.ProtoReflect.Descriptor instead.
+func (*AddAdminAccountResp) Descriptor() ([]byte, []int) {
 	return file_admin_admin_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *SearchAdminAccountResp) GetTotal() uint32 {
-	if x != nil {
-		return x.Total
-	}
-	return 0
-}
-
-func (x *SearchAdminAccountResp) GetAdminAccounts() []*GetAdminInfoResp {
-	if x != nil {
-		return x.AdminAccounts
-	}
-	return nil
-}
-
-type GetAdminInfoResp struct {
+type AdminRole struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Account    string `protobuf:"bytes,2,opt,name=account,proto3" json:"account"`
-	Password   string `protobuf:"bytes,3,opt,name=password,proto3" json:"password"`
-	FaceURL    string `protobuf:"bytes,4,opt,name=faceURL,proto3" json:"faceURL"`
-	Nickname   string `protobuf:"bytes,5,opt,name=nickname,proto3" json:"nickname"`
-	UserID     string `protobuf:"bytes,6,opt,name=userID,proto3" json:"userID"`
-	Level      int32  `protobuf:"varint,7,opt,name=level,proto3" json:"level"`
-	CreateTime int64  `protobuf:"varint,8,opt,name=createTime,proto3" json:"createTime"`
+	RoleID      string   `protobuf:"bytes,1,opt,name=roleID,proto3" json:"roleID"`
+	Name        string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name"`
+	Permissions []string `protobuf:"bytes,3,rep,name=permissions,proto3" json:"permissions"`
+	CreateTime  int64    `protobuf:"varint,4,opt,name=createTime,proto3" json:"createTime"`
+	UpdateTime  int64    `protobuf:"varint,5,opt,name=updateTime,proto3" json:"updateTime"`
 }
 
-func (x *GetAdminInfoResp) Reset() {
-	*x = GetAdminInfoResp{}
+func (x *AdminRole) Reset() {
+	*x = AdminRole{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_admin_admin_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -869,13 +898,13 @@ func (x *GetAdminInfoResp) Reset() {
 	}
 }
 
-func (x *GetAdminInfoResp) String() string {
+func (x *AdminRole) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAdminInfoResp) ProtoMessage() {}
+func (*AdminRole) ProtoMessage() {}
 
-func (x *GetAdminInfoResp) ProtoReflect() protoreflect.Message {
+func (x *AdminRole) ProtoReflect() protoreflect.Message {
 	mi := &file_admin_admin_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -887,70 +916,58 @@ func (x *GetAdminInfoResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAdminInfoResp.ProtoReflect.Descriptor instead.
-func (*GetAdminInfoResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use AdminRole.ProtoReflect.Descriptor instead.
+func (*AdminRole) Descriptor() ([]byte, []int) {
 	return file_admin_admin_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *GetAdminInfoResp) GetAccount() string {
-	if x != nil {
-		return x.Account
-	}
-	return ""
-}
-
-func (x *GetAdminInfoResp) GetPassword() string {
-	if x != nil {
-		return x.Password
-	}
-	return ""
-}
-
-func (x *GetAdminInfoResp) GetFaceURL() string {
+func (x *AdminRole) GetRoleID() string {
 	if x != nil {
-		return x.FaceURL
+		return x.RoleID
 	}
 	return ""
 }
 
-func (x *GetAdminInfoResp) GetNickname() string {
+func (x *AdminRole) GetName() string {
 	if x != nil {
-		return x.Nickname
+		return x.Name
 	}
 	return ""
 }
 
-func (x *GetAdminInfoResp) GetUserID() string {
+func (x *AdminRole) GetPermissions() []string {
 	if x != nil {
-		return x.UserID
+		return x.Permissions
 	}
-	return ""
+	return nil
 }
 
-func (x *GetAdminInfoResp) GetLevel() int32 {
+func (x *AdminRole) GetCreateTime() int64 {
 	if x != nil {
-		return x.Level
+		return x.CreateTime
 	}
 	return 0
 }
 
-func (x *GetAdminInfoResp) GetCreateTime() int64 {
+func (x *AdminRole) GetUpdateTime() int64 {
 	if x != nil {
-		return x.CreateTime
+		return x.UpdateTime
 	}
 	return 0
 }
 
-type AddDefaultFriendReq struct {
+type AddAdminRoleReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserIDs []string `protobuf:"bytes,1,rep,name=userIDs,proto3" json:"userIDs"`
+	RoleID      string   `protobuf:"bytes,1,opt,name=roleID,proto3" json:"roleID"`
+	Name        string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name"`
+	Permissions []string `protobuf:"bytes,3,rep,name=permissions,proto3" json:"permissions"`
 }
 
-func (x *AddDefaultFriendReq) Reset() {
-	*x = AddDefaultFriendReq{}
+func (x *AddAdminRoleReq) Reset() {
+	*x = AddAdminRoleReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_admin_admin_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -958,13 +975,13 @@ func (x *AddDefaultFriendReq) Reset() {
 	}
 }
 
-func (x *AddDefaultFriendReq) String() string {
+func (x *AddAdminRoleReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddDefaultFriendReq) ProtoMessage() {}
+func (*AddAdminRoleReq) ProtoMessage() {}
 
-func (x *AddDefaultFriendReq) ProtoReflect() protoreflect.Message {
+func (x *AddAdminRoleReq) ProtoReflect() protoreflect.Message {
 	mi := &file_admin_admin_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -976,26 +993,40 @@ func (x *AddDefaultFriendReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddDefaultFriendReq.ProtoReflect.Descriptor instead.
-func (*AddDefaultFriendReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use AddAdminRoleReq.ProtoReflect.Descriptor instead.
+func (*AddAdminRoleReq) Descriptor() ([]byte, []int) {
 	return file_admin_admin_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *AddDefaultFriendReq) GetUserIDs() []string {
+func (x *AddAdminRoleReq) GetRoleID() string {
 	if x != nil {
-		return x.UserIDs
+		return x.RoleID
+	}
+	return ""
+}
+
+func (x *AddAdminRoleReq) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AddAdminRoleReq) GetPermissions() []string {
+	if x != nil {
+		return x.Permissions
 	}
 	return nil
 }
 
-type AddDefaultFriendResp struct {
+type AddAdminRoleResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *AddDefaultFriendResp) Reset() {
-	*x = AddDefaultFriendResp{}
+func (x *AddAdminRoleResp) Reset() {
+	*x = AddAdminRoleResp{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_admin_admin_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1003,13 +1034,13 @@ func (x *AddDefaultFriendResp) Reset() {
 	}
 }
 
-func (x *AddDefaultFriendResp) String() string {
+func (x *AddAdminRoleResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddDefaultFriendResp) ProtoMessage() {}
+func (*AddAdminRoleResp) ProtoMessage() {}
 
-func (x *AddDefaultFriendResp) ProtoReflect() protoreflect.Message {
+func (x *AddAdminRoleResp) ProtoReflect() protoreflect.Message {
 	mi := &file_admin_admin_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1021,21 +1052,24 @@ func (x *AddDefaultFriendResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddDefaultFriendResp.ProtoReflect.Descriptor instead.
-func (*AddDefaultFriendResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use AddAdminRoleResp.ProtoReflect.Descriptor instead.
+func (*AddAdminRoleResp) Descriptor() ([]byte, []int) {
 	return file_admin_admin_proto_rawDescGZIP(), []int{17}
 }
 
-type DelDefaultFriendReq struct {
+// replaces the name and the permissions of the role
+type UpdateAdminRoleReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserIDs []string `protobuf:"bytes,1,rep,name=userIDs,proto3" json:"userIDs"`
+	RoleID      string   `protobuf:"bytes,1,opt,name=roleID,proto3" json:"roleID"`
+	Name        string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name"`
+	Permissions []string `protobuf:"bytes,3,rep,name=permissions,proto3" json:"permissions"`
 }
 
-func (x *DelDefaultFriendReq) Reset() {
-	*x = DelDefaultFriendReq{}
+func (x *UpdateAdminRoleReq) Reset() {
+	*x = UpdateAdminRoleReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_admin_admin_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1043,13 +1077,13 @@ func (x *DelDefaultFriendReq) Reset() {
 	}
 }
 
-func (x *DelDefaultFriendReq) String() string {
+func (x *UpdateAdminRoleReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DelDefaultFriendReq) ProtoMessage() {}
+func (*UpdateAdminRoleReq) ProtoMessage() {}
 
-func (x *DelDefaultFriendReq) ProtoReflect() protoreflect.Message {
+func (x *UpdateAdminRoleReq) ProtoReflect() protoreflect.Message {
 	mi := &file_admin_admin_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1061,26 +1095,40 @@ func (x *DelDefaultFriendReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DelDefaultFriendReq.ProtoReflect.Descriptor instead.
-func (*DelDefaultFriendReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdateAdminRoleReq.ProtoReflect.Descriptor instead.
+func (*UpdateAdminRoleReq) Descriptor() ([]byte, []int) {
 	return file_admin_admin_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *DelDefaultFriendReq) GetUserIDs() []string {
+func (x *UpdateAdminRoleReq) GetRoleID() string {
 	if x != nil {
-		return x.UserIDs
+		return x.RoleID
+	}
+	return ""
+}
+
+func (x *UpdateAdminRoleReq) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateAdminRoleReq) GetPermissions() []string {
+	if x != nil {
+		return x.Permissions
 	}
 	return nil
 }
 
-type DelDefaultFriendResp struct {
+type UpdateAdminRoleResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *DelDefaultFriendResp) Reset() {
-	*x = DelDefaultFriendResp{}
+func (x *UpdateAdminRoleResp) Reset() {
+	*x = UpdateAdminRoleResp{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_admin_admin_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1088,13 +1136,13 @@ func (x *DelDefaultFriendResp) Reset() {
 	}
 }
 
-func (x *DelDefaultFriendResp) String() string {
+func (x *UpdateAdminRoleResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DelDefaultFriendResp) ProtoMessage() {}
+func (*UpdateAdminRoleResp) ProtoMessage() {}
 
-func (x *DelDefaultFriendResp) ProtoReflect() protoreflect.Message {
+func (x *UpdateAdminRoleResp) ProtoReflect() protoreflect.Message {
 	mi := &file_admin_admin_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1106,19 +1154,21 @@ func (x *DelDefaultFriendResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DelDefaultFriendResp.ProtoReflect.Descriptor instead.
-func (*DelDefaultFriendResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdateAdminRoleResp.ProtoReflect.Descriptor instead.
+func (*UpdateAdminRoleResp) Descriptor() ([]byte, []int) {
 	return file_admin_admin_proto_rawDescGZIP(), []int{19}
 }
 
-type FindDefaultFriendReq struct {
+type DelAdminRoleReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	RoleIDs []string `protobuf:"bytes,1,rep,name=roleIDs,proto3" json:"roleIDs"`
 }
 
-func (x *FindDefaultFriendReq) Reset() {
-	*x = FindDefaultFriendReq{}
+func (x *DelAdminRoleReq) Reset() {
+	*x = DelAdminRoleReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_admin_admin_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1126,13 +1176,13 @@ func (x *FindDefaultFriendReq) Reset() {
 	}
 }
 
-func (x *FindDefaultFriendReq) String() string {
+func (x *DelAdminRoleReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FindDefaultFriendReq) ProtoMessage() {}
+func (*DelAdminRoleReq) ProtoMessage() {}
 
-func (x *FindDefaultFriendReq) ProtoReflect() protoreflect.Message {
+func (x *DelAdminRoleReq) ProtoReflect() protoreflect.Message {
 	mi := &file_admin_admin_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1144,21 +1194,26 @@ func (x *FindDefaultFriendReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FindDefaultFriendReq.ProtoReflect.Descriptor instead.
-func (*FindDefaultFriendReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use DelAdminRoleReq.ProtoReflect.Descriptor instead.
+func (*DelAdminRoleReq) Descriptor() ([]byte, []int) {
 	return file_admin_admin_proto_rawDescGZIP(), []int{20}
 }
 
-type FindDefaultFriendResp struct {
+func (x *DelAdminRoleReq) GetRoleIDs() []string {
+	if x != nil {
+		return x.RoleIDs
+	}
+	return nil
+}
+
+type DelAdminRoleResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	UserIDs []string `protobuf:"bytes,1,rep,name=userIDs,proto3" json:"userIDs"`
 }
 
-func (x *FindDefaultFriendResp) Reset() {
-	*x = FindDefaultFriendResp{}
+func (x *DelAdminRoleResp) Reset() {
+	*x = DelAdminRoleResp{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_admin_admin_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1166,13 +1221,13 @@ func (x *FindDefaultFriendResp) Reset() {
 	}
 }
 
-func (x *FindDefaultFriendResp) String() string {
+func (x *DelAdminRoleResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FindDefaultFriendResp) ProtoMessage() {}
+func (*DelAdminRoleResp) ProtoMessage() {}
 
-func (x *FindDefaultFriendResp) ProtoReflect() protoreflect.Message {
+func (x *DelAdminRoleResp) ProtoReflect() protoreflect.Message {
 	mi := &file_admin_admin_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1184,19 +1239,12 @@ func (x *FindDefaultFriendResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FindDefaultFriendResp.ProtoReflect.Descriptor instead.
-func (*FindDefaultFriendResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use DelAdminRoleResp.ProtoReflect.Descriptor instead.
+func (*DelAdminRoleResp) Descriptor() ([]byte, []int) {
 	return file_admin_admin_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *FindDefaultFriendResp) GetUserIDs() []string {
-	if x != nil {
-		return x.UserIDs
-	}
-	return nil
-}
-
-type SearchDefaultFriendReq struct {
+type SearchAdminRoleReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -1205,8 +1253,8 @@ type SearchDefaultFriendReq struct {
 	Pagination *sdkwss.RequestPagination `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination"`
 }
 
-func (x *SearchDefaultFriendReq) Reset() {
-	*x = SearchDefaultFriendReq{}
+func (x *SearchAdminRoleReq) Reset() {
+	*x = SearchAdminRoleReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_admin_admin_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1214,13 +1262,13 @@ func (x *SearchDefaultFriendReq) Reset() {
 	}
 }
 
-func (x *SearchDefaultFriendReq) String() string {
+func (x *SearchAdminRoleReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SearchDefaultFriendReq) ProtoMessage() {}
+func (*SearchAdminRoleReq) ProtoMessage() {}
 
-func (x *SearchDefaultFriendReq) ProtoReflect() protoreflect.Message {
+func (x *SearchAdminRoleReq) ProtoReflect() protoreflect.Message {
 	mi := &file_admin_admin_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1232,37 +1280,36 @@ func (x *SearchDefaultFriendReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SearchDefaultFriendReq.ProtoReflect.Descriptor instead.
-func (*SearchDefaultFriendReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use SearchAdminRoleReq.ProtoReflect.Descriptor instead.
+func (*SearchAdminRoleReq) Descriptor() ([]byte, []int) {
 	return file_admin_admin_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *SearchDefaultFriendReq) GetKeyword() string {
+func (x *SearchAdminRoleReq) GetKeyword() string {
 	if x != nil {
 		return x.Keyword
 	}
 	return ""
 }
 
-func (x *SearchDefaultFriendReq) GetPagination() *sdkwss.RequestPagination {
+func (x *SearchAdminRoleReq) GetPagination() *sdkwss.RequestPagination {
 	if x != nil {
 		return x.Pagination
 	}
 	return nil
 }
 
-type DefaultFriendAttribute struct {
+type SearchAdminRoleResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserID     string                 `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
-	CreateTime int64                  `protobuf:"varint,2,opt,name=createTime,proto3" json:"createTime"`
-	User       *common.UserPublicInfo `protobuf:"bytes,3,opt,name=user,proto3" json:"user"`
+	Total uint32       `protobuf:"varint,1,opt,name=total,proto3" json:"total"`
+	Roles []*AdminRole `protobuf:"bytes,2,rep,name=roles,proto3" json:"roles"`
 }
 
-func (x *DefaultFriendAttribute) Reset() {
-	*x = DefaultFriendAttribute{}
+func (x *SearchAdminRoleResp) Reset() {
+	*x = SearchAdminRoleResp{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_admin_admin_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1270,13 +1317,13 @@ func (x *DefaultFriendAttribute) Reset() {
 	}
 }
 
-func (x *DefaultFriendAttribute) String() string {
+func (x *SearchAdminRoleResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DefaultFriendAttribute) ProtoMessage() {}
+func (*SearchAdminRoleResp) ProtoMessage() {}
 
-func (x *DefaultFriendAttribute) ProtoReflect() protoreflect.Message {
+func (x *SearchAdminRoleResp) ProtoReflect() protoreflect.Message {
 	mi := &file_admin_admin_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1288,43 +1335,36 @@ func (x *DefaultFriendAttribute) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DefaultFriendAttribute.ProtoReflect.Descriptor instead.
-func (*DefaultFriendAttribute) Descriptor() ([]byte, []int) {
+// Deprecated: Use SearchAdminRoleResp.ProtoReflect.Descriptor instead.
+func (*SearchAdminRoleResp) Descriptor() ([]byte, []int) {
 	return file_admin_admin_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *DefaultFriendAttribute) GetUserID() string {
-	if x != nil {
-		return x.UserID
-	}
-	return ""
-}
-
-func (x *DefaultFriendAttribute) GetCreateTime() int64 {
+func (x *SearchAdminRoleResp) GetTotal() uint32 {
 	if x != nil {
-		return x.CreateTime
+		return x.Total
 	}
 	return 0
 }
 
-func (x *DefaultFriendAttribute) GetUser() *common.UserPublicInfo {
+func (x *SearchAdminRoleResp) GetRoles() []*AdminRole {
 	if x != nil {
-		return x.User
+		return x.Roles
 	}
 	return nil
 }
 
-type SearchDefaultFriendResp struct {
+type SetAdminRolesReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Total uint32                    `protobuf:"varint,1,opt,name=total,proto3" json:"total"`
-	Users []*DefaultFriendAttribute `protobuf:"bytes,2,rep,name=users,proto3" json:"users"`
+	Account string   `protobuf:"bytes,1,opt,name=account,proto3" json:"account"`
+	RoleIDs []string `protobuf:"bytes,2,rep,name=roleIDs,proto3" json:"roleIDs"`
 }
 
-func (x *SearchDefaultFriendResp) Reset() {
-	*x = SearchDefaultFriendResp{}
+func (x *SetAdminRolesReq) Reset() {
+	*x = SetAdminRolesReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_admin_admin_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1332,13 +1372,13 @@ func (x *SearchDefaultFriendResp) Reset() {
 	}
 }
 
-func (x *SearchDefaultFriendResp) String() string {
+func (x *SetAdminRolesReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SearchDefaultFriendResp) ProtoMessage() {}
+func (*SetAdminRolesReq) ProtoMessage() {}
 
-func (x *SearchDefaultFriendResp) ProtoReflect() protoreflect.Message {
+func (x *SetAdminRolesReq) ProtoReflect() protoreflect.Message {
 	mi := &file_admin_admin_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1350,35 +1390,33 @@ func (x *SearchDefaultFriendResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SearchDefaultFriendResp.ProtoReflect.Descriptor instead.
-func (*SearchDefaultFriendResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use SetAdminRolesReq.ProtoReflect.Descriptor instead.
+func (*SetAdminRolesReq) Descriptor() ([]byte, []int) {
 	return file_admin_admin_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *SearchDefaultFriendResp) GetTotal() uint32 {
+func (x *SetAdminRolesReq) GetAccount() string {
 	if x != nil {
-		return x.Total
+		return x.Account
 	}
-	return 0
+	return ""
 }
 
-func (x *SearchDefaultFriendResp) GetUsers() []*DefaultFriendAttribute {
+func (x *SetAdminRolesReq) GetRoleIDs() []string {
 	if x != nil {
-		return x.Users
+		return x.RoleIDs
 	}
 	return nil
 }
 
-type AddDefaultGroupReq struct {
+type SetAdminRolesResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	GroupIDs []string `protobuf:"bytes,1,rep,name=groupIDs,proto3" json:"groupIDs"`
 }
 
-func (x *AddDefaultGroupReq) Reset() {
-	*x = AddDefaultGroupReq{}
+func (x *SetAdminRolesResp) Reset() {
+	*x = SetAdminRolesResp{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_admin_admin_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1386,13 +1424,13 @@ func (x *AddDefaultGroupReq) Reset() {
 	}
 }
 
-func (x *AddDefaultGroupReq) String() string {
+func (x *SetAdminRolesResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddDefaultGroupReq) ProtoMessage() {}
+func (*SetAdminRolesResp) ProtoMessage() {}
 
-func (x *AddDefaultGroupReq) ProtoReflect() protoreflect.Message {
+func (x *SetAdminRolesResp) ProtoReflect() protoreflect.Message {
 	mi := &file_admin_admin_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1404,26 +1442,23 @@ func (x *AddDefaultGroupReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddDefaultGroupReq.ProtoReflect.Descriptor instead.
-func (*AddDefaultGroupReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use SetAdminRolesResp.ProtoReflect.Descriptor instead.
+func (*SetAdminRolesResp) Descriptor() ([]byte, []int) {
 	return file_admin_admin_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *AddDefaultGroupReq) GetGroupIDs() []string {
-	if x != nil {
-		return x.GroupIDs
-	}
-	return nil
-}
-
-type AddDefaultGroupResp struct {
+type CheckAdminPermissionReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Permission string `protobuf:"bytes,1,opt,name=permission,proto3" json:"permission"`
+	// api route recorded in the audit log if denied
+	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path"`
 }
 
-func (x *AddDefaultGroupResp) Reset() {
-	*x = AddDefaultGroupResp{}
+func (x *CheckAdminPermissionReq) Reset() {
+	*x = CheckAdminPermissionReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_admin_admin_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1431,13 +1466,13 @@ func (x *AddDefaultGroupResp) Reset() {
 	}
 }
 
-func (x *AddDefaultGroupResp) String() string {
+func (x *CheckAdminPermissionReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddDefaultGroupResp) ProtoMessage() {}
+func (*CheckAdminPermissionReq) ProtoMessage() {}
 
-func (x *AddDefaultGroupResp) ProtoReflect() protoreflect.Message {
+func (x *CheckAdminPermissionReq) ProtoReflect() protoreflect.Message {
 	mi := &file_admin_admin_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1449,21 +1484,33 @@ func (x *AddDefaultGroupResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddDefaultGroupResp.ProtoReflect.Descriptor instead.
-func (*AddDefaultGroupResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use CheckAdminPermissionReq.ProtoReflect.Descriptor instead.
+func (*CheckAdminPermissionReq) Descriptor() ([]byte, []int) {
 	return file_admin_admin_proto_rawDescGZIP(), []int{26}
 }
 
-type DelDefaultGroupReq struct {
+func (x *CheckAdminPermissionReq) GetPermission() string {
+	if x != nil {
+		return x.Permission
+	}
+	return ""
+}
+
+func (x *CheckAdminPermissionReq) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+type CheckAdminPermissionResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	GroupIDs []string `protobuf:"bytes,1,rep,name=groupIDs,proto3" json:"groupIDs"`
 }
 
-func (x *DelDefaultGroupReq) Reset() {
-	*x = DelDefaultGroupReq{}
+func (x *CheckAdminPermissionResp) Reset() {
+	*x = CheckAdminPermissionResp{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_admin_admin_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1471,13 +1518,13 @@ func (x *DelDefaultGroupReq) Reset() {
 	}
 }
 
-func (x *DelDefaultGroupReq) String() string {
+func (x *CheckAdminPermissionResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DelDefaultGroupReq) ProtoMessage() {}
+func (*CheckAdminPermissionResp) ProtoMessage() {}
 
-func (x *DelDefaultGroupReq) ProtoReflect() protoreflect.Message {
+func (x *CheckAdminPermissionResp) ProtoReflect() protoreflect.Message {
 	mi := &file_admin_admin_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1489,26 +1536,29 @@ func (x *DelDefaultGroupReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DelDefaultGroupReq.ProtoReflect.Descriptor instead.
-func (*DelDefaultGroupReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use CheckAdminPermissionResp.ProtoReflect.Descriptor instead.
+func (*CheckAdminPermissionResp) Descriptor() ([]byte, []int) {
 	return file_admin_admin_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *DelDefaultGroupReq) GetGroupIDs() []string {
-	if x != nil {
-		return x.GroupIDs
-	}
-	return nil
-}
-
-type DelDefaultGroupResp struct {
+type AuditLog struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	OperatorUserID string `protobuf:"bytes,1,opt,name=operatorUserID,proto3" json:"operatorUserID"`
+	Method         string `protobuf:"bytes,2,opt,name=method,proto3" json:"method"`
+	Permission     string `protobuf:"bytes,3,opt,name=permission,proto3" json:"permission"`
+	// request with secrets redacted
+	Request    string `protobuf:"bytes,4,opt,name=request,proto3" json:"request"`
+	ResultCode int32  `protobuf:"varint,5,opt,name=resultCode,proto3" json:"resultCode"`
+	ResultMsg  string `protobuf:"bytes,6,opt,name=resultMsg,proto3" json:"resultMsg"`
+	Ip         string `protobuf:"bytes,7,opt,name=ip,proto3" json:"ip"`
+	CreateTime int64  `protobuf:"varint,8,opt,name=createTime,proto3" json:"createTime"`
 }
 
-func (x *DelDefaultGroupResp) Reset() {
-	*x = DelDefaultGroupResp{}
+func (x *AuditLog) Reset() {
+	*x = AuditLog{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_admin_admin_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1516,13 +1566,13 @@ func (x *DelDefaultGroupResp) Reset() {
 	}
 }
 
-func (x *DelDefaultGroupResp) String() string {
+func (x *AuditLog) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DelDefaultGroupResp) ProtoMessage() {}
+func (*AuditLog) ProtoMessage() {}
 
-func (x *DelDefaultGroupResp) ProtoReflect() protoreflect.Message {
+func (x *AuditLog) ProtoReflect() protoreflect.Message {
 	mi := &file_admin_admin_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1534,19 +1584,82 @@ func (x *DelDefaultGroupResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DelDefaultGroupResp.ProtoReflect.Descriptor instead.
-func (*DelDefaultGroupResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use AuditLog.ProtoReflect.Descriptor instead.
+func (*AuditLog) Descriptor() ([]byte, []int) {
 	return file_admin_admin_proto_rawDescGZIP(), []int{28}
 }
 
-type FindDefaultGroupReq struct {
+func (x *AuditLog) GetOperatorUserID() string {
+	if x != nil {
+		return x.OperatorUserID
+	}
+	return ""
+}
+
+func (x *AuditLog) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *AuditLog) GetPermission() string {
+	if x != nil {
+		return x.Permission
+	}
+	return ""
+}
+
+func (x *AuditLog) GetRequest() string {
+	if x != nil {
+		return x.Request
+	}
+	return ""
+}
+
+func (x *AuditLog) GetResultCode() int32 {
+	if x != nil {
+		return x.ResultCode
+	}
+	return 0
+}
+
+func (x *AuditLog) GetResultMsg() string {
+	if x != nil {
+		return x.ResultMsg
+	}
+	return ""
+}
+
+func (x *AuditLog) GetIp() string {
+	if x != nil {
+		return x.Ip
+	}
+	return ""
+}
+
+func (x *AuditLog) GetCreateTime() int64 {
+	if x != nil {
+		return x.CreateTime
+	}
+	return 0
+}
+
+type SearchAuditLogReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	OperatorUserID string `protobuf:"bytes,1,opt,name=operatorUserID,proto3" json:"operatorUserID"`
+	Method         string `protobuf:"bytes,2,opt,name=method,proto3" json:"method"`
+	// unix milliseconds, 0 means unbounded
+	Start      int64                     `protobuf:"varint,3,opt,name=start,proto3" json:"start"`
+	End        int64                     `protobuf:"varint,4,opt,name=end,proto3" json:"end"`
+	Pagination *sdkwss.RequestPagination `protobuf:"bytes,5,opt,name=pagination,proto3" json:"pagination"`
 }
 
-func (x *FindDefaultGroupReq) Reset() {
-	*x = FindDefaultGroupReq{}
+func (x *SearchAuditLogReq) Reset() {
+	*x = SearchAuditLogReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_admin_admin_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1554,13 +1667,13 @@ func (x *FindDefaultGroupReq) Reset() {
 	}
 }
 
-func (x *FindDefaultGroupReq) String() string {
+func (x *SearchAuditLogReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FindDefaultGroupReq) ProtoMessage() {}
+func (*SearchAuditLogReq) ProtoMessage() {}
 
-func (x *FindDefaultGroupReq) ProtoReflect() protoreflect.Message {
+func (x *SearchAuditLogReq) ProtoReflect() protoreflect.Message {
 	mi := &file_admin_admin_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1572,21 +1685,57 @@ func (x *FindDefaultGroupReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FindDefaultGroupReq.ProtoReflect.Descriptor instead.
-func (*FindDefaultGroupReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use SearchAuditLogReq.ProtoReflect.Descriptor instead.
+func (*SearchAuditLogReq) Descriptor() ([]byte, []int) {
 	return file_admin_admin_proto_rawDescGZIP(), []int{29}
 }
 
-type FindDefaultGroupResp struct {
+func (x *SearchAuditLogReq) GetOperatorUserID() string {
+	if x != nil {
+		return x.OperatorUserID
+	}
+	return ""
+}
+
+func (x *SearchAuditLogReq) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *SearchAuditLogReq) GetStart() int64 {
+	if x != nil {
+		return x.Start
+	}
+	return 0
+}
+
+func (x *SearchAuditLogReq) GetEnd() int64 {
+	if x != nil {
+		return x.End
+	}
+	return 0
+}
+
+func (x *SearchAuditLogReq) GetPagination() *sdkwss.RequestPagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type SearchAuditLogResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	GroupIDs []string `protobuf:"bytes,1,rep,name=groupIDs,proto3" json:"groupIDs"`
+	Total uint32      `protobuf:"varint,1,opt,name=total,proto3" json:"total"`
+	Logs  []*AuditLog `protobuf:"bytes,2,rep,name=logs,proto3" json:"logs"`
 }
 
-func (x *FindDefaultGroupResp) Reset() {
-	*x = FindDefaultGroupResp{}
+func (x *SearchAuditLogResp) Reset() {
+	*x = SearchAuditLogResp{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_admin_admin_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1594,13 +1743,13 @@ func (x *FindDefaultGroupResp) Reset() {
 	}
 }
 
-func (x *FindDefaultGroupResp) String() string {
+func (x *SearchAuditLogResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FindDefaultGroupResp) ProtoMessage() {}
+func (*SearchAuditLogResp) ProtoMessage() {}
 
-func (x *FindDefaultGroupResp) ProtoReflect() protoreflect.Message {
+func (x *SearchAuditLogResp) ProtoReflect() protoreflect.Message {
 	mi := &file_admin_admin_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1612,29 +1761,35 @@ func (x *FindDefaultGroupResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FindDefaultGroupResp.ProtoReflect.Descriptor instead.
-func (*FindDefaultGroupResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use SearchAuditLogResp.ProtoReflect.Descriptor instead.
+func (*SearchAuditLogResp) Descriptor() ([]byte, []int) {
 	return file_admin_admin_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *FindDefaultGroupResp) GetGroupIDs() []string {
+func (x *SearchAuditLogResp) GetTotal() uint32 {
 	if x != nil {
-		return x.GroupIDs
+		return x.Total
+	}
+	return 0
+}
+
+func (x *SearchAuditLogResp) GetLogs() []*AuditLog {
+	if x != nil {
+		return x.Logs
 	}
 	return nil
 }
 
-type SearchDefaultGroupReq struct {
+type AddAuditLogReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Keyword    string                    `protobuf:"bytes,1,opt,name=keyword,proto3" json:"keyword"`
-	Pagination *sdkwss.RequestPagination `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination"`
+	Log *AuditLog `protobuf:"bytes,1,opt,name=log,proto3" json:"log"`
 }
 
-func (x *SearchDefaultGroupReq) Reset() {
-	*x = SearchDefaultGroupReq{}
+func (x *AddAuditLogReq) Reset() {
+	*x = AddAuditLogReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_admin_admin_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1642,13 +1797,13 @@ func (x *SearchDefaultGroupReq) Reset() {
 	}
 }
 
-func (x *SearchDefaultGroupReq) String() string {
+func (x *AddAuditLogReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SearchDefaultGroupReq) ProtoMessage() {}
+func (*AddAuditLogReq) ProtoMessage() {}
 
-func (x *SearchDefaultGroupReq) ProtoReflect() protoreflect.Message {
+func (x *AddAuditLogReq) ProtoReflect() protoreflect.Message {
 	mi := &file_admin_admin_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1660,37 +1815,26 @@ func (x *SearchDefaultGroupReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SearchDefaultGroupReq.ProtoReflect.Descriptor instead.
-func (*SearchDefaultGroupReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use AddAuditLogReq.ProtoReflect.Descriptor instead.
+func (*AddAuditLogReq) Descriptor() ([]byte, []int) {
 	return file_admin_admin_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *SearchDefaultGroupReq) GetKeyword() string {
-	if x != nil {
-		return x.Keyword
-	}
-	return ""
-}
-
-func (x *SearchDefaultGroupReq) GetPagination() *sdkwss.RequestPagination {
+func (x *AddAuditLogReq) GetLog() *AuditLog {
 	if x != nil {
-		return x.Pagination
+		return x.Log
 	}
 	return nil
 }
 
-type GroupAttribute struct {
+type AddAuditLogResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	GroupID    string            `protobuf:"bytes,1,opt,name=groupID,proto3" json:"groupID"`
-	CreateTime int64             `protobuf:"varint,2,opt,name=createTime,proto3" json:"createTime"`
-	Group      *sdkwss.GroupInfo `protobuf:"bytes,3,opt,name=group,proto3" json:"group"`
 }
 
-func (x *GroupAttribute) Reset() {
-	*x = GroupAttribute{}
+func (x *AddAuditLogResp) Reset() {
+	*x = AddAuditLogResp{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_admin_admin_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1698,13 +1842,13 @@ func (x *GroupAttribute) Reset() {
 	}
 }
 
-func (x *GroupAttribute) String() string {
+func (x *AddAuditLogResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GroupAttribute) ProtoMessage() {}
+func (*AddAuditLogResp) ProtoMessage() {}
 
-func (x *GroupAttribute) ProtoReflect() protoreflect.Message {
+func (x *AddAuditLogResp) ProtoReflect() protoreflect.Message {
 	mi := &file_admin_admin_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1716,43 +1860,25 @@ func (x *GroupAttribute) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GroupAttribute.ProtoReflect.Descriptor instead.
-func (*GroupAttribute) Descriptor() ([]byte, []int) {
+// Deprecated: Use AddAuditLogResp.ProtoReflect.Descriptor instead.
+func (*AddAuditLogResp) Descriptor() ([]byte, []int) {
 	return file_admin_admin_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *GroupAttribute) GetGroupID() string {
-	if x != nil {
-		return x.GroupID
-	}
-	return ""
-}
-
-func (x *GroupAttribute) GetCreateTime() int64 {
-	if x != nil {
-		return x.CreateTime
-	}
-	return 0
-}
-
-func (x *GroupAttribute) GetGroup() *sdkwss.GroupInfo {
-	if x != nil {
-		return x.Group
-	}
-	return nil
-}
-
-type SearchDefaultGroupResp struct {
+type AdminUpdateInfoReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Total    uint32   `protobuf:"varint,1,opt,name=total,proto3" json:"total"`
-	GroupIDs []string `protobuf:"bytes,2,rep,name=groupIDs,proto3" json:"groupIDs"`
+	Account  *wrapperspb.StringValue `protobuf:"bytes,1,opt,name=account,proto3" json:"account"`
+	Password *wrapperspb.StringValue `protobuf:"bytes,2,opt,name=password,proto3" json:"password"`
+	FaceURL  *wrapperspb.StringValue `protobuf:"bytes,3,opt,name=faceURL,proto3" json:"faceURL"`
+	Nickname *wrapperspb.StringValue `protobuf:"bytes,4,opt,name=nickname,proto3" json:"nickname"`
+	Level    *wrapperspb.Int32Value  `protobuf:"bytes,6,opt,name=level,proto3" json:"level"`
 }
 
-func (x *SearchDefaultGroupResp) Reset() {
-	*x = SearchDefaultGroupResp{}
+func (x *AdminUpdateInfoReq) Reset() {
+	*x = AdminUpdateInfoReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_admin_admin_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1760,13 +1886,13 @@ func (x *SearchDefaultGroupResp) Reset() {
 	}
 }
 
-func (x *SearchDefaultGroupResp) String() string {
+func (x *AdminUpdateInfoReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SearchDefaultGroupResp) ProtoMessage() {}
+func (*AdminUpdateInfoReq) ProtoMessage() {}
 
-func (x *SearchDefaultGroupResp) ProtoReflect() protoreflect.Message {
+func (x *AdminUpdateInfoReq) ProtoReflect() protoreflect.Message {
 	mi := &file_admin_admin_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1778,35 +1904,58 @@ func (x *SearchDefaultGroupResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SearchDefaultGroupResp.ProtoReflect.Descriptor instead.
-func (*SearchDefaultGroupResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use AdminUpdateInfoReq.ProtoReflect.Descriptor instead.
+func (*AdminUpdateInfoReq) Descriptor() ([]byte, []int) {
 	return file_admin_admin_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *SearchDefaultGroupResp) GetTotal() uint32 {
+func (x *AdminUpdateInfoReq) GetAccount() *wrapperspb.StringValue {
 	if x != nil {
-		return x.Total
+		return x.Account
 	}
-	return 0
+	return nil
 }
 
-func (x *SearchDefaultGroupResp) GetGroupIDs() []string {
+func (x *AdminUpdateInfoReq) GetPassword() *wrapperspb.StringValue {
 	if x != nil {
-		return x.GroupIDs
+		return x.Password
 	}
 	return nil
 }
 
-type AddInvitationCodeReq struct {
+func (x *AdminUpdateInfoReq) GetFaceURL() *wrapperspb.StringValue {
+	if x != nil {
+		return x.FaceURL
+	}
+	return nil
+}
+
+func (x *AdminUpdateInfoReq) GetNickname() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Nickname
+	}
+	return nil
+}
+
+func (x *AdminUpdateInfoReq) GetLevel() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.Level
+	}
+	return nil
+}
+
+type AdminUpdateInfoResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Codes []string `protobuf:"bytes,1,rep,name=codes,proto3" json:"codes"`
+	UserID   string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
+	Nickname string `protobuf:"bytes,2,opt,name=nickname,proto3" json:"nickname"`
+	FaceURL  string `protobuf:"bytes,3,opt,name=faceURL,proto3" json:"faceURL"`
 }
 
-func (x *AddInvitationCodeReq) Reset() {
-	*x = AddInvitationCodeReq{}
+func (x *AdminUpdateInfoResp) Reset() {
+	*x = AdminUpdateInfoResp{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_admin_admin_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1814,13 +1963,13 @@ func (x *AddInvitationCodeReq) Reset() {
 	}
 }
 
-func (x *AddInvitationCodeReq) String() string {
+func (x *AdminUpdateInfoResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddInvitationCodeReq) ProtoMessage() {}
+func (*AdminUpdateInfoResp) ProtoMessage() {}
 
-func (x *AddInvitationCodeReq) ProtoReflect() protoreflect.Message {
+func (x *AdminUpdateInfoResp) ProtoReflect() protoreflect.Message {
 	mi := &file_admin_admin_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1832,26 +1981,42 @@ func (x *AddInvitationCodeReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddInvitationCodeReq.ProtoReflect.Descriptor instead.
-func (*AddInvitationCodeReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use AdminUpdateInfoResp.ProtoReflect.Descriptor instead.
+func (*AdminUpdateInfoResp) Descriptor() ([]byte, []int) {
 	return file_admin_admin_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *AddInvitationCodeReq) GetCodes() []string {
+func (x *AdminUpdateInfoResp) GetUserID() string {
 	if x != nil {
-		return x.Codes
+		return x.UserID
 	}
-	return nil
+	return ""
 }
 
-type AddInvitationCodeResp struct {
+func (x *AdminUpdateInfoResp) GetNickname() string {
+	if x != nil {
+		return x.Nickname
+	}
+	return ""
+}
+
+func (x *AdminUpdateInfoResp) GetFaceURL() string {
+	if x != nil {
+		return x.FaceURL
+	}
+	return ""
+}
+
+type ChangePasswordReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Password string `protobuf:"bytes,1,opt,name=password,proto3" json:"password"`
 }
 
-func (x *AddInvitationCodeResp) Reset() {
-	*x = AddInvitationCodeResp{}
+func (x *ChangePasswordReq) Reset() {
+	*x = ChangePasswordReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_admin_admin_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1859,13 +2024,13 @@ func (x *AddInvitationCodeResp) Reset() {
 	}
 }
 
-func (x *AddInvitationCodeResp) String() string {
+func (x *ChangePasswordReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddInvitationCodeResp) ProtoMessage() {}
+func (*ChangePasswordReq) ProtoMessage() {}
 
-func (x *AddInvitationCodeResp) ProtoReflect() protoreflect.Message {
+func (x *ChangePasswordReq) ProtoReflect() protoreflect.Message {
 	mi := &file_admin_admin_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1877,23 +2042,26 @@ func (x *AddInvitationCodeResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddInvitationCodeResp.ProtoReflect.Descriptor instead.
-func (*AddInvitationCodeResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use ChangePasswordReq.ProtoReflect.Descriptor instead.
+func (*ChangePasswordReq) Descriptor() ([]byte, []int) {
 	return file_admin_admin_proto_rawDescGZIP(), []int{35}
 }
 
-type GenInvitationCodeReq struct {
+func (x *ChangePasswordReq) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+type ChangePasswordResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Len   int32  `protobuf:"varint,1,opt,name=len,proto3" json:"len"`
-	Num   int32  `protobuf:"varint,2,opt,name=num,proto3" json:"num"`
-	Chars string `protobuf:"bytes,3,opt,name=chars,proto3" json:"chars"`
 }
 
-func (x *GenInvitationCodeReq) Reset() {
-	*x = GenInvitationCodeReq{}
+func (x *ChangePasswordResp) Reset() {
+	*x = ChangePasswordResp{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_admin_admin_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1901,13 +2069,13 @@ func (x *GenInvitationCodeReq) Reset() {
 	}
 }
 
-func (x *GenInvitationCodeReq) String() string {
+func (x *ChangePasswordResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GenInvitationCodeReq) ProtoMessage() {}
+func (*ChangePasswordResp) ProtoMessage() {}
 
-func (x *GenInvitationCodeReq) ProtoReflect() protoreflect.Message {
+func (x *ChangePasswordResp) ProtoReflect() protoreflect.Message {
 	mi := &file_admin_admin_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1919,40 +2087,19 @@ func (x *GenInvitationCodeReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GenInvitationCodeReq.ProtoReflect.Descriptor instead.
-func (*GenInvitationCodeReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use ChangePasswordResp.ProtoReflect.Descriptor instead.
+func (*ChangePasswordResp) Descriptor() ([]byte, []int) {
 	return file_admin_admin_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *GenInvitationCodeReq) GetLen() int32 {
-	if x != nil {
-		return x.Len
-	}
-	return 0
-}
-
-func (x *GenInvitationCodeReq) GetNum() int32 {
-	if x != nil {
-		return x.Num
-	}
-	return 0
-}
-
-func (x *GenInvitationCodeReq) GetChars() string {
-	if x != nil {
-		return x.Chars
-	}
-	return ""
-}
-
-type GenInvitationCodeResp struct {
+type GetAdminInfoReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *GenInvitationCodeResp) Reset() {
-	*x = GenInvitationCodeResp{}
+func (x *GetAdminInfoReq) Reset() {
+	*x = GetAdminInfoReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_admin_admin_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1960,13 +2107,13 @@ func (x *GenInvitationCodeResp) Reset() {
 	}
 }
 
-func (x *GenInvitationCodeResp) String() string {
+func (x *GetAdminInfoReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GenInvitationCodeResp) ProtoMessage() {}
+func (*GetAdminInfoReq) ProtoMessage() {}
 
-func (x *GenInvitationCodeResp) ProtoReflect() protoreflect.Message {
+func (x *GetAdminInfoReq) ProtoReflect() protoreflect.Message {
 	mi := &file_admin_admin_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1978,21 +2125,23 @@ func (x *GenInvitationCodeResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GenInvitationCodeResp.ProtoReflect.Descriptor instead.
-func (*GenInvitationCodeResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetAdminInfoReq.ProtoReflect.Descriptor instead.
+func (*GetAdminInfoReq) Descriptor() ([]byte, []int) {
 	return file_admin_admin_proto_rawDescGZIP(), []int{37}
 }
 
-type FindInvitationCodeReq struct {
+type ChangeAdminPasswordReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Codes []string `protobuf:"bytes,1,rep,name=codes,proto3" json:"codes"`
+	UserID          string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
+	CurrentPassword string `protobuf:"bytes,2,opt,name=currentPassword,proto3" json:"currentPassword"`
+	NewPassword     string `protobuf:"bytes,3,opt,name=newPassword,proto3" json:"newPassword"`
 }
 
-func (x *FindInvitationCodeReq) Reset() {
-	*x = FindInvitationCodeReq{}
+func (x *ChangeAdminPasswordReq) Reset() {
+	*x = ChangeAdminPasswordReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_admin_admin_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2000,13 +2149,13 @@ func (x *FindInvitationCodeReq) Reset() {
 	}
 }
 
-func (x *FindInvitationCodeReq) String() string {
+func (x *ChangeAdminPasswordReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FindInvitationCodeReq) ProtoMessage() {}
+func (*ChangeAdminPasswordReq) ProtoMessage() {}
 
-func (x *FindInvitationCodeReq) ProtoReflect() protoreflect.Message {
+func (x *ChangeAdminPasswordReq) ProtoReflect() protoreflect.Message {
 	mi := &file_admin_admin_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2018,28 +2167,40 @@ func (x *FindInvitationCodeReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FindInvitationCodeReq.ProtoReflect.Descriptor instead.
-func (*FindInvitationCodeReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use ChangeAdminPasswordReq.ProtoReflect.Descriptor instead.
+func (*ChangeAdminPasswordReq) Descriptor() ([]byte, []int) {
 	return file_admin_admin_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *FindInvitationCodeReq) GetCodes() []string {
+func (x *ChangeAdminPasswordReq) GetUserID() string {
 	if x != nil {
-		return x.Codes
+		return x.UserID
 	}
-	return nil
+	return ""
 }
 
-type FindInvitationCodeResp struct {
+func (x *ChangeAdminPasswordReq) GetCurrentPassword() string {
+	if x != nil {
+		return x.CurrentPassword
+	}
+	return ""
+}
+
+func (x *ChangeAdminPasswordReq) GetNewPassword() string {
+	if x != nil {
+		return x.NewPassword
+	}
+	return ""
+}
+
+type ChangeAdminPasswordResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Codes []*InvitationRegister `protobuf:"bytes,1,rep,name=codes,proto3" json:"codes"`
 }
 
-func (x *FindInvitationCodeResp) Reset() {
-	*x = FindInvitationCodeResp{}
+func (x *ChangeAdminPasswordResp) Reset() {
+	*x = ChangeAdminPasswordResp{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_admin_admin_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2047,13 +2208,13 @@ func (x *FindInvitationCodeResp) Reset() {
 	}
 }
 
-func (x *FindInvitationCodeResp) String() string {
+func (x *ChangeAdminPasswordResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FindInvitationCodeResp) ProtoMessage() {}
+func (*ChangeAdminPasswordResp) ProtoMessage() {}
 
-func (x *FindInvitationCodeResp) ProtoReflect() protoreflect.Message {
+func (x *ChangeAdminPasswordResp) ProtoReflect() protoreflect.Message {
 	mi := &file_admin_admin_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2065,29 +2226,21 @@ func (x *FindInvitationCodeResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FindInvitationCodeResp.ProtoReflect.Descriptor instead.
-func (*FindInvitationCodeResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use ChangeAdminPasswordResp.ProtoReflect.Descriptor instead.
+func (*ChangeAdminPasswordResp) Descriptor() ([]byte, []int) {
 	return file_admin_admin_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *FindInvitationCodeResp) GetCodes() []*InvitationRegister {
-	if x != nil {
-		return x.Codes
-	}
-	return nil
-}
-
-type UseInvitationCodeReq struct {
+type DelAdminAccountReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Code   string `protobuf:"bytes,1,opt,name=code,proto3" json:"code"`
-	UserID string `protobuf:"bytes,2,opt,name=userID,proto3" json:"userID"`
+	UserIDs []string `protobuf:"bytes,1,rep,name=userIDs,proto3" json:"userIDs"`
 }
 
-func (x *UseInvitationCodeReq) Reset() {
-	*x = UseInvitationCodeReq{}
+func (x *DelAdminAccountReq) Reset() {
+	*x = DelAdminAccountReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_admin_admin_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2095,13 +2248,13 @@ func (x *UseInvitationCodeReq) Reset() {
 	}
 }
 
-func (x *UseInvitationCodeReq) String() string {
+func (x *DelAdminAccountReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UseInvitationCodeReq) ProtoMessage() {}
+func (*DelAdminAccountReq) ProtoMessage() {}
 
-func (x *UseInvitationCodeReq) ProtoReflect() protoreflect.Message {
+func (x *DelAdminAccountReq) ProtoReflect() protoreflect.Message {
 	mi := &file_admin_admin_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2113,33 +2266,26 @@ func (x *UseInvitationCodeReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UseInvitationCodeReq.ProtoReflect.Descriptor instead.
-func (*UseInvitationCodeReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use DelAdminAccountReq.ProtoReflect.Descriptor instead.
+func (*DelAdminAccountReq) Descriptor() ([]byte, []int) {
 	return file_admin_admin_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *UseInvitationCodeReq) GetCode() string {
-	if x != nil {
-		return x.Code
-	}
-	return ""
-}
-
-func (x *UseInvitationCodeReq) GetUserID() string {
+func (x *DelAdminAccountReq) GetUserIDs() []string {
 	if x != nil {
-		return x.UserID
+		return x.UserIDs
 	}
-	return ""
+	return nil
 }
 
-type UseInvitationCodeResp struct {
+type DelAdminAccountResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *UseInvitationCodeResp) Reset() {
-	*x = UseInvitationCodeResp{}
+func (x *DelAdminAccountResp) Reset() {
+	*x = DelAdminAccountResp{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_admin_admin_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2147,13 +2293,13 @@ func (x *UseInvitationCodeResp) Reset() {
 	}
 }
 
-func (x *UseInvitationCodeResp) String() string {
+func (x *DelAdminAccountResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UseInvitationCodeResp) ProtoMessage() {}
+func (*DelAdminAccountResp) ProtoMessage() {}
 
-func (x *UseInvitationCodeResp) ProtoReflect() protoreflect.Message {
+func (x *DelAdminAccountResp) ProtoReflect() protoreflect.Message {
 	mi := &file_admin_admin_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2165,21 +2311,21 @@ func (x *UseInvitationCodeResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UseInvitationCodeResp.ProtoReflect.Descriptor instead.
-func (*UseInvitationCodeResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use DelAdminAccountResp.ProtoReflect.Descriptor instead.
+func (*DelAdminAccountResp) Descriptor() ([]byte, []int) {
 	return file_admin_admin_proto_rawDescGZIP(), []int{41}
 }
 
-type DelInvitationCodeReq struct {
+type SearchAdminAccountReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Codes []string `protobuf:"bytes,1,rep,name=codes,proto3" json:"codes"`
+	Pagination *sdkwss.RequestPagination `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination"`
 }
 
-func (x *DelInvitationCodeReq) Reset() {
-	*x = DelInvitationCodeReq{}
+func (x *SearchAdminAccountReq) Reset() {
+	*x = SearchAdminAccountReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_admin_admin_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2187,13 +2333,13 @@ func (x *DelInvitationCodeReq) Reset() {
 	}
 }
 
-func (x *DelInvitationCodeReq) String() string {
+func (x *SearchAdminAccountReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DelInvitationCodeReq) ProtoMessage() {}
+func (*SearchAdminAccountReq) ProtoMessage() {}
 
-func (x *DelInvitationCodeReq) ProtoReflect() protoreflect.Message {
+func (x *SearchAdminAccountReq) ProtoReflect() protoreflect.Message {
 	mi := &file_admin_admin_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2205,26 +2351,29 @@ func (x *DelInvitationCodeReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DelInvitationCodeReq.ProtoReflect.Descriptor instead.
-func (*DelInvitationCodeReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use SearchAdminAccountReq.ProtoReflect.Descriptor instead.
+func (*SearchAdminAccountReq) Descriptor() ([]byte, []int) {
 	return file_admin_admin_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *DelInvitationCodeReq) GetCodes() []string {
+func (x *SearchAdminAccountReq) GetPagination() *sdkwss.RequestPagination {
 	if x != nil {
-		return x.Codes
+		return x.Pagination
 	}
 	return nil
 }
 
-type DelInvitationCodeResp struct {
+type SearchAdminAccountResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Total         uint32              `protobuf:"varint,1,opt,name=total,proto3" json:"total"`
+	AdminAccounts []*GetAdminInfoResp `protobuf:"bytes,2,rep,name=adminAccounts,proto3" json:"adminAccounts"`
 }
 
-func (x *DelInvitationCodeResp) Reset() {
-	*x = DelInvitationCodeResp{}
+func (x *SearchAdminAccountResp) Reset() {
+	*x = SearchAdminAccountResp{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_admin_admin_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2232,13 +2381,13 @@ func (x *DelInvitationCodeResp) Reset() {
 	}
 }
 
-func (x *DelInvitationCodeResp) String() string {
+func (x *SearchAdminAccountResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DelInvitationCodeResp) ProtoMessage() {}
+func (*SearchAdminAccountResp) ProtoMessage() {}
 
-func (x *DelInvitationCodeResp) ProtoReflect() protoreflect.Message {
+func (x *SearchAdminAccountResp) ProtoReflect() protoreflect.Message {
 	mi := &file_admin_admin_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2250,24 +2399,45 @@ func (x *DelInvitationCodeResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DelInvitationCodeResp.ProtoReflect.Descriptor instead.
-func (*DelInvitationCodeResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use SearchAdminAccountResp.ProtoReflect.Descriptor instead.
+func (*SearchAdminAccountResp) Descriptor() ([]byte, []int) {
 	return file_admin_admin_proto_rawDescGZIP(), []int{43}
 }
 
-type InvitationRegister struct {
+func (x *SearchAdminAccountResp) GetTotal() uint32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *SearchAdminAccountResp) GetAdminAccounts() []*GetAdminInfoResp {
+	if x != nil {
+		return x.AdminAccounts
+	}
+	return nil
+}
+
+type GetAdminInfoResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	InvitationCode string                 `protobuf:"bytes,1,opt,name=invitationCode,proto3" json:"invitationCode"`
-	CreateTime     int64                  `protobuf:"varint,2,opt,name=createTime,proto3" json:"createTime"`
-	UsedUserID     string                 `protobuf:"bytes,3,opt,name=usedUserID,proto3" json:"usedUserID"`
-	UsedUser       *common.UserPublicInfo `protobuf:"bytes,4,opt,name=usedUser,proto3" json:"usedUser"`
+	Account      string   `protobuf:"bytes,2,opt,name=account,proto3" json:"account"`
+	Password     string   `protobuf:"bytes,3,opt,name=password,proto3" json:"password"`
+	FaceURL      string   `protobuf:"bytes,4,opt,name=faceURL,proto3" json:"faceURL"`
+	Nickname     string   `protobuf:"bytes,5,opt,name=nickname,proto3" json:"nickname"`
+	UserID       string   `protobuf:"bytes,6,opt,name=userID,proto3" json:"userID"`
+	Level        int32    `protobuf:"varint,7,opt,name=level,proto3" json:"level"`
+	CreateTime   int64    `protobuf:"varint,8,opt,name=createTime,proto3" json:"createTime"`
+	TotpEnabled  bool     `protobuf:"varint,9,opt,name=totpEnabled,proto3" json:"totpEnabled"`
+	TotpRequired bool     `protobuf:"varint,10,opt,name=totpRequired,proto3" json:"totpRequired"`
+	Roles        []string `protobuf:"bytes,11,rep,name=roles,proto3" json:"roles"`
+	Permissions  []string `protobuf:"bytes,12,rep,name=permissions,proto3" json:"permissions"`
 }
 
-func (x *InvitationRegister) Reset() {
-	*x = InvitationRegister{}
+func (x *GetAdminInfoResp) Reset() {
+	*x = GetAdminInfoResp{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_admin_admin_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2275,13 +2445,13 @@ func (x *InvitationRegister) Reset() {
 	}
 }
 
-func (x *InvitationRegister) String() string {
+func (x *GetAdminInfoResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InvitationRegister) ProtoMessage() {}
+func (*GetAdminInfoResp) ProtoMessage() {}
 
-func (x *InvitationRegister) ProtoReflect() protoreflect.Message {
+func (x *GetAdminInfoResp) ProtoReflect() protoreflect.Message {
 	mi := &file_admin_admin_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2293,53 +2463,98 @@ func (x *InvitationRegister) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InvitationRegister.ProtoReflect.Descriptor instead.
-func (*InvitationRegister) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetAdminInfoResp.ProtoReflect.Descriptor instead.
+func (*GetAdminInfoResp) Descriptor() ([]byte, []int) {
 	return file_admin_admin_proto_rawDescGZIP(), []int{44}
 }
 
-func (x *InvitationRegister) GetInvitationCode() string {
+func (x *GetAdminInfoResp) GetAccount() string {
 	if x != nil {
-		return x.InvitationCode
+		return x.Account
 	}
 	return ""
 }
 
-func (x *InvitationRegister) GetCreateTime() int64 {
+func (x *GetAdminInfoResp) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *GetAdminInfoResp) GetFaceURL() string {
+	if x != nil {
+		return x.FaceURL
+	}
+	return ""
+}
+
+func (x *GetAdminInfoResp) GetNickname() string {
+	if x != nil {
+		return x.Nickname
+	}
+	return ""
+}
+
+func (x *GetAdminInfoResp) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *GetAdminInfoResp) GetLevel() int32 {
+	if x != nil {
+		return x.Level
+	}
+	return 0
+}
+
+func (x *GetAdminInfoResp) GetCreateTime() int64 {
 	if x != nil {
 		return x.CreateTime
 	}
 	return 0
 }
 
-func (x *InvitationRegister) GetUsedUserID() string {
+func (x *GetAdminInfoResp) GetTotpEnabled() bool {
 	if x != nil {
-		return x.UsedUserID
+		return x.TotpEnabled
 	}
-	return ""
+	return false
 }
 
-func (x *InvitationRegister) GetUsedUser() *common.UserPublicInfo {
+func (x *GetAdminInfoResp) GetTotpRequired() bool {
 	if x != nil {
-		return x.UsedUser
+		return x.TotpRequired
+	}
+	return false
+}
+
+func (x *GetAdminInfoResp) GetRoles() []string {
+	if x != nil {
+		return x.Roles
 	}
 	return nil
 }
 
-type SearchInvitationCodeReq struct {
+func (x *GetAdminInfoResp) GetPermissions() []string {
+	if x != nil {
+		return x.Permissions
+	}
+	return nil
+}
+
+type AddDefaultFriendReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Status     int32                     `protobuf:"varint,1,opt,name=status,proto3" json:"status"`
-	UserIDs    []string                  `protobuf:"bytes,2,rep,name=userIDs,proto3" json:"userIDs"`
-	Codes      []string                  `protobuf:"bytes,3,rep,name=codes,proto3" json:"codes"`
-	Keyword    string                    `protobuf:"bytes,4,opt,name=keyword,proto3" json:"keyword"`
-	Pagination *sdkwss.RequestPagination `protobuf:"bytes,5,opt,name=pagination,proto3" json:"pagination"`
+	UserIDs []string `protobuf:"bytes,1,rep,name=userIDs,proto3" json:"userIDs"`
 }
 
-func (x *SearchInvitationCodeReq) Reset() {
-	*x = SearchInvitationCodeReq{}
+func (x *AddDefaultFriendReq) Reset() {
+	*x = AddDefaultFriendReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_admin_admin_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2347,13 +2562,13 @@ func (x *SearchInvitationCodeReq) Reset() {
 	}
 }
 
-func (x *SearchInvitationCodeReq) String() string {
+func (x *AddDefaultFriendReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SearchInvitationCodeReq) ProtoMessage() {}
+func (*AddDefaultFriendReq) ProtoMessage() {}
 
-func (x *SearchInvitationCodeReq) ProtoReflect() protoreflect.Message {
+func (x *AddDefaultFriendReq) ProtoReflect() protoreflect.Message {
 	mi := &file_admin_admin_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2365,57 +2580,26 @@ func (x *SearchInvitationCodeReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SearchInvitationCodeReq.ProtoReflect.Descriptor instead.
-func (*SearchInvitationCodeReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use AddDefaultFriendReq.ProtoReflect.Descriptor instead.
+func (*AddDefaultFriendReq) Descriptor() ([]byte, []int) {
 	return file_admin_admin_proto_rawDescGZIP(), []int{45}
 }
 
-func (x *SearchInvitationCodeReq) GetStatus() int32 {
-	if x != nil {
-		return x.Status
-	}
-	return 0
-}
-
-func (x *SearchInvitationCodeReq) GetUserIDs() []string {
+func (x *AddDefaultFriendReq) GetUserIDs() []string {
 	if x != nil {
 		return x.UserIDs
 	}
 	return nil
 }
 
-func (x *SearchInvitationCodeReq) GetCodes() []string {
-	if x != nil {
-		return x.Codes
-	}
-	return nil
-}
-
-func (x *SearchInvitationCodeReq) GetKeyword() string {
-	if x != nil {
-		return x.Keyword
-	}
-	return ""
-}
-
-func (x *SearchInvitationCodeReq) GetPagination() *sdkwss.RequestPagination {
-	if x != nil {
-		return x.Pagination
-	}
-	return nil
-}
-
-type SearchInvitationCodeResp struct {
+type AddDefaultFriendResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Total uint32                `protobuf:"varint,1,opt,name=total,proto3" json:"total"`
-	List  []*InvitationRegister `protobuf:"bytes,2,rep,name=list,proto3" json:"list"`
 }
 
-func (x *SearchInvitationCodeResp) Reset() {
-	*x = SearchInvitationCodeResp{}
+func (x *AddDefaultFriendResp) Reset() {
+	*x = AddDefaultFriendResp{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_admin_admin_proto_msgTypes[46]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2423,13 +2607,13 @@ func (x *SearchInvitationCodeResp) Reset() {
 	}
 }
 
-func (x *SearchInvitationCodeResp) String() string {
+func (x *AddDefaultFriendResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SearchInvitationCodeResp) ProtoMessage() {}
+func (*AddDefaultFriendResp) ProtoMessage() {}
 
-func (x *SearchInvitationCodeResp) ProtoReflect() protoreflect.Message {
+func (x *AddDefaultFriendResp) ProtoReflect() protoreflect.Message {
 	mi := &file_admin_admin_proto_msgTypes[46]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2441,36 +2625,21 @@ func (x *SearchInvitationCodeResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SearchInvitationCodeResp.ProtoReflect.Descriptor instead.
-func (*SearchInvitationCodeResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use AddDefaultFriendResp.ProtoReflect.Descriptor instead.
+func (*AddDefaultFriendResp) Descriptor() ([]byte, []int) {
 	return file_admin_admin_proto_rawDescGZIP(), []int{46}
 }
 
-func (x *SearchInvitationCodeResp) GetTotal() uint32 {
-	if x != nil {
-		return x.Total
-	}
-	return 0
-}
-
-func (x *SearchInvitationCodeResp) GetList() []*InvitationRegister {
-	if x != nil {
-		return x.List
-	}
-	return nil
-}
-
-type SearchUserIPLimitLoginReq struct {
+type DelDefaultFriendReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Keyword    string                    `protobuf:"bytes,1,opt,name=keyword,proto3" json:"keyword"`
-	Pagination *sdkwss.RequestPagination `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination"`
+	UserIDs []string `protobuf:"bytes,1,rep,name=userIDs,proto3" json:"userIDs"`
 }
 
-func (x *SearchUserIPLimitLoginReq) Reset() {
-	*x = SearchUserIPLimitLoginReq{}
+func (x *DelDefaultFriendReq) Reset() {
+	*x = DelDefaultFriendReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_admin_admin_proto_msgTypes[47]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2478,13 +2647,13 @@ func (x *SearchUserIPLimitLoginReq) Reset() {
 	}
 }
 
-func (x *SearchUserIPLimitLoginReq) String() string {
+func (x *DelDefaultFriendReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SearchUserIPLimitLoginReq) ProtoMessage() {}
+func (*DelDefaultFriendReq) ProtoMessage() {}
 
-func (x *SearchUserIPLimitLoginReq) ProtoReflect() protoreflect.Message {
+func (x *DelDefaultFriendReq) ProtoReflect() protoreflect.Message {
 	mi := &file_admin_admin_proto_msgTypes[47]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2496,38 +2665,26 @@ func (x *SearchUserIPLimitLoginReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SearchUserIPLimitLoginReq.ProtoReflect.Descriptor instead.
-func (*SearchUserIPLimitLoginReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use DelDefaultFriendReq.ProtoReflect.Descriptor instead.
+func (*DelDefaultFriendReq) Descriptor() ([]byte, []int) {
 	return file_admin_admin_proto_rawDescGZIP(), []int{47}
 }
 
-func (x *SearchUserIPLimitLoginReq) GetKeyword() string {
-	if x != nil {
-		return x.Keyword
-	}
-	return ""
-}
-
-func (x *SearchUserIPLimitLoginReq) GetPagination() *sdkwss.RequestPagination {
+func (x *DelDefaultFriendReq) GetUserIDs() []string {
 	if x != nil {
-		return x.Pagination
+		return x.UserIDs
 	}
 	return nil
 }
 
-type LimitUserLoginIP struct {
+type DelDefaultFriendResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	UserID     string                 `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
-	Ip         string                 `protobuf:"bytes,2,opt,name=ip,proto3" json:"ip"`
-	CreateTime int64                  `protobuf:"varint,3,opt,name=createTime,proto3" json:"createTime"`
-	User       *common.UserPublicInfo `protobuf:"bytes,4,opt,name=user,proto3" json:"user"`
 }
 
-func (x *LimitUserLoginIP) Reset() {
-	*x = LimitUserLoginIP{}
+func (x *DelDefaultFriendResp) Reset() {
+	*x = DelDefaultFriendResp{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_admin_admin_proto_msgTypes[48]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2535,13 +2692,13 @@ func (x *LimitUserLoginIP) Reset() {
 	}
 }
 
-func (x *LimitUserLoginIP) String() string {
+func (x *DelDefaultFriendResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LimitUserLoginIP) ProtoMessage() {}
+func (*DelDefaultFriendResp) ProtoMessage() {}
 
-func (x *LimitUserLoginIP) ProtoReflect() protoreflect.Message {
+func (x *DelDefaultFriendResp) ProtoReflect() protoreflect.Message {
 	mi := &file_admin_admin_proto_msgTypes[48]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2553,65 +2710,74 @@ func (x *LimitUserLoginIP) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LimitUserLoginIP.ProtoReflect.Descriptor instead.
-func (*LimitUserLoginIP) Descriptor() ([]byte, []int) {
+// Deprecated: Use DelDefaultFriendResp.ProtoReflect.Descriptor instead.
+func (*DelDefaultFriendResp) Descriptor() ([]byte, []int) {
 	return file_admin_admin_proto_rawDescGZIP(), []int{48}
 }
 
-func (x *LimitUserLoginIP) GetUserID() string {
-	if x != nil {
-		return x.UserID
-	}
-	return ""
+type FindDefaultFriendReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 }
 
-func (x *LimitUserLoginIP) GetIp() string {
-	if x != nil {
-		return x.Ip
+func (x *FindDefaultFriendReq) Reset() {
+	*x = FindDefaultFriendReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_admin_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *LimitUserLoginIP) GetCreateTime() int64 {
-	if x != nil {
-		return x.CreateTime
-	}
-	return 0
+func (x *FindDefaultFriendReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *LimitUserLoginIP) GetUser() *common.UserPublicInfo {
-	if x != nil {
-		return x.User
+func (*FindDefaultFriendReq) ProtoMessage() {}
+
+func (x *FindDefaultFriendReq) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_admin_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-type SearchUserIPLimitLoginResp struct {
+// Deprecated: Use FindDefaultFriendReq.ProtoReflect.Descriptor instead.
+func (*FindDefaultFriendReq) Descriptor() ([]byte, []int) {
+	return file_admin_admin_proto_rawDescGZIP(), []int{49}
+}
+
+type FindDefaultFriendResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Total  uint32              `protobuf:"varint,1,opt,name=total,proto3" json:"total"`
-	Limits []*LimitUserLoginIP `protobuf:"bytes,2,rep,name=limits,proto3" json:"limits"`
+	UserIDs []string `protobuf:"bytes,1,rep,name=userIDs,proto3" json:"userIDs"`
 }
 
-func (x *SearchUserIPLimitLoginResp) Reset() {
-	*x = SearchUserIPLimitLoginResp{}
+func (x *FindDefaultFriendResp) Reset() {
+	*x = FindDefaultFriendResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_admin_admin_proto_msgTypes[49]
+		mi := &file_admin_admin_proto_msgTypes[50]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SearchUserIPLimitLoginResp) String() string {
+func (x *FindDefaultFriendResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SearchUserIPLimitLoginResp) ProtoMessage() {}
+func (*FindDefaultFriendResp) ProtoMessage() {}
 
-func (x *SearchUserIPLimitLoginResp) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_admin_proto_msgTypes[49]
+func (x *FindDefaultFriendResp) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_admin_proto_msgTypes[50]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2622,51 +2788,44 @@ func (x *SearchUserIPLimitLoginResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SearchUserIPLimitLoginResp.ProtoReflect.Descriptor instead.
-func (*SearchUserIPLimitLoginResp) Descriptor() ([]byte, []int) {
-	return file_admin_admin_proto_rawDescGZIP(), []int{49}
-}
-
-func (x *SearchUserIPLimitLoginResp) GetTotal() uint32 {
-	if x != nil {
-		return x.Total
-	}
-	return 0
+// Deprecated: Use FindDefaultFriendResp.ProtoReflect.Descriptor instead.
+func (*FindDefaultFriendResp) Descriptor() ([]byte, []int) {
+	return file_admin_admin_proto_rawDescGZIP(), []int{50}
 }
 
-func (x *SearchUserIPLimitLoginResp) GetLimits() []*LimitUserLoginIP {
+func (x *FindDefaultFriendResp) GetUserIDs() []string {
 	if x != nil {
-		return x.Limits
+		return x.UserIDs
 	}
 	return nil
 }
 
-type UserIPLimitLogin struct {
+type SearchDefaultFriendReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserID string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
-	Ip     string `protobuf:"bytes,2,opt,name=ip,proto3" json:"ip"`
+	Keyword    string                    `protobuf:"bytes,1,opt,name=keyword,proto3" json:"keyword"`
+	Pagination *sdkwss.RequestPagination `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination"`
 }
 
-func (x *UserIPLimitLogin) Reset() {
-	*x = UserIPLimitLogin{}
+func (x *SearchDefaultFriendReq) Reset() {
+	*x = SearchDefaultFriendReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_admin_admin_proto_msgTypes[50]
+		mi := &file_admin_admin_proto_msgTypes[51]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UserIPLimitLogin) String() string {
+func (x *SearchDefaultFriendReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserIPLimitLogin) ProtoMessage() {}
+func (*SearchDefaultFriendReq) ProtoMessage() {}
 
-func (x *UserIPLimitLogin) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_admin_proto_msgTypes[50]
+func (x *SearchDefaultFriendReq) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_admin_proto_msgTypes[51]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2677,50 +2836,52 @@ func (x *UserIPLimitLogin) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserIPLimitLogin.ProtoReflect.Descriptor instead.
-func (*UserIPLimitLogin) Descriptor() ([]byte, []int) {
-	return file_admin_admin_proto_rawDescGZIP(), []int{50}
+// Deprecated: Use SearchDefaultFriendReq.ProtoReflect.Descriptor instead.
+func (*SearchDefaultFriendReq) Descriptor() ([]byte, []int) {
+	return file_admin_admin_proto_rawDescGZIP(), []int{51}
 }
 
-func (x *UserIPLimitLogin) GetUserID() string {
+func (x *SearchDefaultFriendReq) GetKeyword() string {
 	if x != nil {
-		return x.UserID
+		return x.Keyword
 	}
 	return ""
 }
 
-func (x *UserIPLimitLogin) GetIp() string {
+func (x *SearchDefaultFriendReq) GetPagination() *sdkwss.RequestPagination {
 	if x != nil {
-		return x.Ip
+		return x.Pagination
 	}
-	return ""
+	return nil
 }
 
-type AddUserIPLimitLoginReq struct {
+type DefaultFriendAttribute struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Limits []*UserIPLimitLogin `protobuf:"bytes,1,rep,name=limits,proto3" json:"limits"`
+	UserID     string                 `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
+	CreateTime int64                  `protobuf:"varint,2,opt,name=createTime,proto3" json:"createTime"`
+	User       *common.UserPublicInfo `protobuf:"bytes,3,opt,name=user,proto3" json:"user"`
 }
 
-func (x *AddUserIPLimitLoginReq) Reset() {
-	*x = AddUserIPLimitLoginReq{}
+func (x *DefaultFriendAttribute) Reset() {
+	*x = DefaultFriendAttribute{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_admin_admin_proto_msgTypes[51]
+		mi := &file_admin_admin_proto_msgTypes[52]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AddUserIPLimitLoginReq) String() string {
+func (x *DefaultFriendAttribute) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddUserIPLimitLoginReq) ProtoMessage() {}
+func (*DefaultFriendAttribute) ProtoMessage() {}
 
-func (x *AddUserIPLimitLoginReq) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_admin_proto_msgTypes[51]
+func (x *DefaultFriendAttribute) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_admin_proto_msgTypes[52]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2731,41 +2892,58 @@ func (x *AddUserIPLimitLoginReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddUserIPLimitLoginReq.ProtoReflect.Descriptor instead.
-func (*AddUserIPLimitLoginReq) Descriptor() ([]byte, []int) {
-	return file_admin_admin_proto_rawDescGZIP(), []int{51}
+// Deprecated: Use DefaultFriendAttribute.ProtoReflect.Descriptor instead.
+func (*DefaultFriendAttribute) Descriptor() ([]byte, []int) {
+	return file_admin_admin_proto_rawDescGZIP(), []int{52}
 }
 
-func (x *AddUserIPLimitLoginReq) GetLimits() []*UserIPLimitLogin {
+func (x *DefaultFriendAttribute) GetUserID() string {
 	if x != nil {
-		return x.Limits
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *DefaultFriendAttribute) GetCreateTime() int64 {
+	if x != nil {
+		return x.CreateTime
+	}
+	return 0
+}
+
+func (x *DefaultFriendAttribute) GetUser() *common.UserPublicInfo {
+	if x != nil {
+		return x.User
 	}
 	return nil
 }
 
-type AddUserIPLimitLoginResp struct {
+type SearchDefaultFriendResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Total uint32                    `protobuf:"varint,1,opt,name=total,proto3" json:"total"`
+	Users []*DefaultFriendAttribute `protobuf:"bytes,2,rep,name=users,proto3" json:"users"`
 }
 
-func (x *AddUserIPLimitLoginResp) Reset() {
-	*x = AddUserIPLimitLoginResp{}
+func (x *SearchDefaultFriendResp) Reset() {
+	*x = SearchDefaultFriendResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_admin_admin_proto_msgTypes[52]
+		mi := &file_admin_admin_proto_msgTypes[53]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AddUserIPLimitLoginResp) String() string {
+func (x *SearchDefaultFriendResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddUserIPLimitLoginResp) ProtoMessage() {}
+func (*SearchDefaultFriendResp) ProtoMessage() {}
 
-func (x *AddUserIPLimitLoginResp) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_admin_proto_msgTypes[52]
+func (x *SearchDefaultFriendResp) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_admin_proto_msgTypes[53]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2776,36 +2954,50 @@ func (x *AddUserIPLimitLoginResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddUserIPLimitLoginResp.ProtoReflect.Descriptor instead.
-func (*AddUserIPLimitLoginResp) Descriptor() ([]byte, []int) {
-	return file_admin_admin_proto_rawDescGZIP(), []int{52}
+// Deprecated: Use SearchDefaultFriendResp.ProtoReflect.Descriptor instead.
+func (*SearchDefaultFriendResp) Descriptor() ([]byte, []int) {
+	return file_admin_admin_proto_rawDescGZIP(), []int{53}
 }
 
-type DelUserIPLimitLoginReq struct {
+func (x *SearchDefaultFriendResp) GetTotal() uint32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *SearchDefaultFriendResp) GetUsers() []*DefaultFriendAttribute {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+type AddDefaultGroupReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Limits []*UserIPLimitLogin `protobuf:"bytes,1,rep,name=limits,proto3" json:"limits"`
+	GroupIDs []string `protobuf:"bytes,1,rep,name=groupIDs,proto3" json:"groupIDs"`
 }
 
-func (x *DelUserIPLimitLoginReq) Reset() {
-	*x = DelUserIPLimitLoginReq{}
+func (x *AddDefaultGroupReq) Reset() {
+	*x = AddDefaultGroupReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_admin_admin_proto_msgTypes[53]
+		mi := &file_admin_admin_proto_msgTypes[54]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DelUserIPLimitLoginReq) String() string {
+func (x *AddDefaultGroupReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DelUserIPLimitLoginReq) ProtoMessage() {}
+func (*AddDefaultGroupReq) ProtoMessage() {}
 
-func (x *DelUserIPLimitLoginReq) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_admin_proto_msgTypes[53]
+func (x *AddDefaultGroupReq) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_admin_proto_msgTypes[54]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2816,41 +3008,41 @@ func (x *DelUserIPLimitLoginReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DelUserIPLimitLoginReq.ProtoReflect.Descriptor instead.
-func (*DelUserIPLimitLoginReq) Descriptor() ([]byte, []int) {
-	return file_admin_admin_proto_rawDescGZIP(), []int{53}
+// Deprecated: Use AddDefaultGroupReq.ProtoReflect.Descriptor instead.
+func (*AddDefaultGroupReq) Descriptor() ([]byte, []int) {
+	return file_admin_admin_proto_rawDescGZIP(), []int{54}
 }
 
-func (x *DelUserIPLimitLoginReq) GetLimits() []*UserIPLimitLogin {
+func (x *AddDefaultGroupReq) GetGroupIDs() []string {
 	if x != nil {
-		return x.Limits
+		return x.GroupIDs
 	}
 	return nil
 }
 
-type DelUserIPLimitLoginResp struct {
+type AddDefaultGroupResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *DelUserIPLimitLoginResp) Reset() {
-	*x = DelUserIPLimitLoginResp{}
+func (x *AddDefaultGroupResp) Reset() {
+	*x = AddDefaultGroupResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_admin_admin_proto_msgTypes[54]
+		mi := &file_admin_admin_proto_msgTypes[55]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DelUserIPLimitLoginResp) String() string {
+func (x *AddDefaultGroupResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DelUserIPLimitLoginResp) ProtoMessage() {}
+func (*AddDefaultGroupResp) ProtoMessage() {}
 
-func (x *DelUserIPLimitLoginResp) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_admin_proto_msgTypes[54]
+func (x *AddDefaultGroupResp) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_admin_proto_msgTypes[55]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2861,39 +3053,36 @@ func (x *DelUserIPLimitLoginResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DelUserIPLimitLoginResp.ProtoReflect.Descriptor instead.
-func (*DelUserIPLimitLoginResp) Descriptor() ([]byte, []int) {
-	return file_admin_admin_proto_rawDescGZIP(), []int{54}
+// Deprecated: Use AddDefaultGroupResp.ProtoReflect.Descriptor instead.
+func (*AddDefaultGroupResp) Descriptor() ([]byte, []int) {
+	return file_admin_admin_proto_rawDescGZIP(), []int{55}
 }
 
-type IPForbidden struct {
+type DelDefaultGroupReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Ip            string `protobuf:"bytes,1,opt,name=ip,proto3" json:"ip"`
-	LimitRegister bool   `protobuf:"varint,2,opt,name=limitRegister,proto3" json:"limitRegister"`
-	LimitLogin    bool   `protobuf:"varint,3,opt,name=limitLogin,proto3" json:"limitLogin"`
-	CreateTime    int64  `protobuf:"varint,4,opt,name=createTime,proto3" json:"createTime"`
+	GroupIDs []string `protobuf:"bytes,1,rep,name=groupIDs,proto3" json:"groupIDs"`
 }
 
-func (x *IPForbidden) Reset() {
-	*x = IPForbidden{}
+func (x *DelDefaultGroupReq) Reset() {
+	*x = DelDefaultGroupReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_admin_admin_proto_msgTypes[55]
+		mi := &file_admin_admin_proto_msgTypes[56]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *IPForbidden) String() string {
+func (x *DelDefaultGroupReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*IPForbidden) ProtoMessage() {}
+func (*DelDefaultGroupReq) ProtoMessage() {}
 
-func (x *IPForbidden) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_admin_proto_msgTypes[55]
+func (x *DelDefaultGroupReq) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_admin_proto_msgTypes[56]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2904,66 +3093,79 @@ func (x *IPForbidden) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use IPForbidden.ProtoReflect.Descriptor instead.
-func (*IPForbidden) Descriptor() ([]byte, []int) {
-	return file_admin_admin_proto_rawDescGZIP(), []int{55}
+// Deprecated: Use DelDefaultGroupReq.ProtoReflect.Descriptor instead.
+func (*DelDefaultGroupReq) Descriptor() ([]byte, []int) {
+	return file_admin_admin_proto_rawDescGZIP(), []int{56}
 }
 
-func (x *IPForbidden) GetIp() string {
+func (x *DelDefaultGroupReq) GetGroupIDs() []string {
 	if x != nil {
-		return x.Ip
+		return x.GroupIDs
 	}
-	return ""
+	return nil
 }
 
-func (x *IPForbidden) GetLimitRegister() bool {
-	if x != nil {
-		return x.LimitRegister
-	}
-	return false
+type DelDefaultGroupResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 }
 
-func (x *IPForbidden) GetLimitLogin() bool {
-	if x != nil {
-		return x.LimitLogin
+func (x *DelDefaultGroupResp) Reset() {
+	*x = DelDefaultGroupResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_admin_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return false
 }
 
-func (x *IPForbidden) GetCreateTime() int64 {
-	if x != nil {
-		return x.CreateTime
+func (x *DelDefaultGroupResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DelDefaultGroupResp) ProtoMessage() {}
+
+func (x *DelDefaultGroupResp) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_admin_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-type IPForbiddenAdd struct {
+// Deprecated: Use DelDefaultGroupResp.ProtoReflect.Descriptor instead.
+func (*DelDefaultGroupResp) Descriptor() ([]byte, []int) {
+	return file_admin_admin_proto_rawDescGZIP(), []int{57}
+}
+
+type FindDefaultGroupReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Ip            string `protobuf:"bytes,1,opt,name=ip,proto3" json:"ip"`
-	LimitRegister bool   `protobuf:"varint,2,opt,name=limitRegister,proto3" json:"limitRegister"`
-	LimitLogin    bool   `protobuf:"varint,3,opt,name=limitLogin,proto3" json:"limitLogin"`
 }
 
-func (x *IPForbiddenAdd) Reset() {
-	*x = IPForbiddenAdd{}
+func (x *FindDefaultGroupReq) Reset() {
+	*x = FindDefaultGroupReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_admin_admin_proto_msgTypes[56]
+		mi := &file_admin_admin_proto_msgTypes[58]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *IPForbiddenAdd) String() string {
+func (x *FindDefaultGroupReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*IPForbiddenAdd) ProtoMessage() {}
+func (*FindDefaultGroupReq) ProtoMessage() {}
 
-func (x *IPForbiddenAdd) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_admin_proto_msgTypes[56]
+func (x *FindDefaultGroupReq) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_admin_proto_msgTypes[58]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2974,59 +3176,84 @@ func (x *IPForbiddenAdd) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use IPForbiddenAdd.ProtoReflect.Descriptor instead.
-func (*IPForbiddenAdd) Descriptor() ([]byte, []int) {
-	return file_admin_admin_proto_rawDescGZIP(), []int{56}
+// Deprecated: Use FindDefaultGroupReq.ProtoReflect.Descriptor instead.
+func (*FindDefaultGroupReq) Descriptor() ([]byte, []int) {
+	return file_admin_admin_proto_rawDescGZIP(), []int{58}
 }
 
-func (x *IPForbiddenAdd) GetIp() string {
-	if x != nil {
-		return x.Ip
+type FindDefaultGroupResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GroupIDs []string `protobuf:"bytes,1,rep,name=groupIDs,proto3" json:"groupIDs"`
+}
+
+func (x *FindDefaultGroupResp) Reset() {
+	*x = FindDefaultGroupResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_admin_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *IPForbiddenAdd) GetLimitRegister() bool {
-	if x != nil {
-		return x.LimitRegister
+func (x *FindDefaultGroupResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindDefaultGroupResp) ProtoMessage() {}
+
+func (x *FindDefaultGroupResp) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_admin_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return false
+	return mi.MessageOf(x)
 }
 
-func (x *IPForbiddenAdd) GetLimitLogin() bool {
+// Deprecated: Use FindDefaultGroupResp.ProtoReflect.Descriptor instead.
+func (*FindDefaultGroupResp) Descriptor() ([]byte, []int) {
+	return file_admin_admin_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *FindDefaultGroupResp) GetGroupIDs() []string {
 	if x != nil {
-		return x.LimitLogin
+		return x.GroupIDs
 	}
-	return false
+	return nil
 }
 
-type SearchIPForbiddenReq struct {
+type SearchDefaultGroupReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	Keyword    string                    `protobuf:"bytes,1,opt,name=keyword,proto3" json:"keyword"`
-	Status     int32                     `protobuf:"varint,2,opt,name=status,proto3" json:"status"`
-	Pagination *sdkwss.RequestPagination `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination"`
+	Pagination *sdkwss.RequestPagination `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination"`
 }
 
-func (x *SearchIPForbiddenReq) Reset() {
-	*x = SearchIPForbiddenReq{}
+func (x *SearchDefaultGroupReq) Reset() {
+	*x = SearchDefaultGroupReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_admin_admin_proto_msgTypes[57]
+		mi := &file_admin_admin_proto_msgTypes[60]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SearchIPForbiddenReq) String() string {
+func (x *SearchDefaultGroupReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SearchIPForbiddenReq) ProtoMessage() {}
+func (*SearchDefaultGroupReq) ProtoMessage() {}
 
-func (x *SearchIPForbiddenReq) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_admin_proto_msgTypes[57]
+func (x *SearchDefaultGroupReq) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_admin_proto_msgTypes[60]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3037,58 +3264,52 @@ func (x *SearchIPForbiddenReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SearchIPForbiddenReq.ProtoReflect.Descriptor instead.
-func (*SearchIPForbiddenReq) Descriptor() ([]byte, []int) {
-	return file_admin_admin_proto_rawDescGZIP(), []int{57}
+// Deprecated: Use SearchDefaultGroupReq.ProtoReflect.Descriptor instead.
+func (*SearchDefaultGroupReq) Descriptor() ([]byte, []int) {
+	return file_admin_admin_proto_rawDescGZIP(), []int{60}
 }
 
-func (x *SearchIPForbiddenReq) GetKeyword() string {
+func (x *SearchDefaultGroupReq) GetKeyword() string {
 	if x != nil {
 		return x.Keyword
 	}
 	return ""
 }
 
-func (x *SearchIPForbiddenReq) GetStatus() int32 {
-	if x != nil {
-		return x.Status
-	}
-	return 0
-}
-
-func (x *SearchIPForbiddenReq) GetPagination() *sdkwss.RequestPagination {
+func (x *SearchDefaultGroupReq) GetPagination() *sdkwss.RequestPagination {
 	if x != nil {
 		return x.Pagination
 	}
 	return nil
 }
 
-type SearchIPForbiddenResp struct {
+type GroupAttribute struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Total      uint32         `protobuf:"varint,1,opt,name=total,proto3" json:"total"`
-	Forbiddens []*IPForbidden `protobuf:"bytes,2,rep,name=forbiddens,proto3" json:"forbiddens"`
+	GroupID    string            `protobuf:"bytes,1,opt,name=groupID,proto3" json:"groupID"`
+	CreateTime int64             `protobuf:"varint,2,opt,name=createTime,proto3" json:"createTime"`
+	Group      *sdkwss.GroupInfo `protobuf:"bytes,3,opt,name=group,proto3" json:"group"`
 }
 
-func (x *SearchIPForbiddenResp) Reset() {
-	*x = SearchIPForbiddenResp{}
+func (x *GroupAttribute) Reset() {
+	*x = GroupAttribute{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_admin_admin_proto_msgTypes[58]
+		mi := &file_admin_admin_proto_msgTypes[61]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SearchIPForbiddenResp) String() string {
+func (x *GroupAttribute) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SearchIPForbiddenResp) ProtoMessage() {}
+func (*GroupAttribute) ProtoMessage() {}
 
-func (x *SearchIPForbiddenResp) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_admin_proto_msgTypes[58]
+func (x *GroupAttribute) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_admin_proto_msgTypes[61]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3099,50 +3320,58 @@ func (x *SearchIPForbiddenResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SearchIPForbiddenResp.ProtoReflect.Descriptor instead.
-func (*SearchIPForbiddenResp) Descriptor() ([]byte, []int) {
-	return file_admin_admin_proto_rawDescGZIP(), []int{58}
+// Deprecated: Use GroupAttribute.ProtoReflect.Descriptor instead.
+func (*GroupAttribute) Descriptor() ([]byte, []int) {
+	return file_admin_admin_proto_rawDescGZIP(), []int{61}
 }
 
-func (x *SearchIPForbiddenResp) GetTotal() uint32 {
+func (x *GroupAttribute) GetGroupID() string {
 	if x != nil {
-		return x.Total
+		return x.GroupID
+	}
+	return ""
+}
+
+func (x *GroupAttribute) GetCreateTime() int64 {
+	if x != nil {
+		return x.CreateTime
 	}
 	return 0
 }
 
-func (x *SearchIPForbiddenResp) GetForbiddens() []*IPForbidden {
+func (x *GroupAttribute) GetGroup() *sdkwss.GroupInfo {
 	if x != nil {
-		return x.Forbiddens
+		return x.Group
 	}
 	return nil
 }
 
-type AddIPForbiddenReq struct {
+type SearchDefaultGroupResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Forbiddens []*IPForbiddenAdd `protobuf:"bytes,1,rep,name=forbiddens,proto3" json:"forbiddens"`
+	Total    uint32   `protobuf:"varint,1,opt,name=total,proto3" json:"total"`
+	GroupIDs []string `protobuf:"bytes,2,rep,name=groupIDs,proto3" json:"groupIDs"`
 }
 
-func (x *AddIPForbiddenReq) Reset() {
-	*x = AddIPForbiddenReq{}
+func (x *SearchDefaultGroupResp) Reset() {
+	*x = SearchDefaultGroupResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_admin_admin_proto_msgTypes[59]
+		mi := &file_admin_admin_proto_msgTypes[62]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AddIPForbiddenReq) String() string {
+func (x *SearchDefaultGroupResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddIPForbiddenReq) ProtoMessage() {}
+func (*SearchDefaultGroupResp) ProtoMessage() {}
 
-func (x *AddIPForbiddenReq) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_admin_proto_msgTypes[59]
+func (x *SearchDefaultGroupResp) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_admin_proto_msgTypes[62]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3153,41 +3382,56 @@ func (x *AddIPForbiddenReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddIPForbiddenReq.ProtoReflect.Descriptor instead.
-func (*AddIPForbiddenReq) Descriptor() ([]byte, []int) {
-	return file_admin_admin_proto_rawDescGZIP(), []int{59}
+// Deprecated: Use SearchDefaultGroupResp.ProtoReflect.Descriptor instead.
+func (*SearchDefaultGroupResp) Descriptor() ([]byte, []int) {
+	return file_admin_admin_proto_rawDescGZIP(), []int{62}
 }
 
-func (x *AddIPForbiddenReq) GetForbiddens() []*IPForbiddenAdd {
+func (x *SearchDefaultGroupResp) GetTotal() uint32 {
 	if x != nil {
-		return x.Forbiddens
+		return x.Total
+	}
+	return 0
+}
+
+func (x *SearchDefaultGroupResp) GetGroupIDs() []string {
+	if x != nil {
+		return x.GroupIDs
 	}
 	return nil
 }
 
-type AddIPForbiddenResp struct {
+type AddInvitationCodeReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Codes []string `protobuf:"bytes,1,rep,name=codes,proto3" json:"codes"`
+	// how many users may register with each code, 1 if not set
+	MaxUses int32 `protobuf:"varint,2,opt,name=maxUses,proto3" json:"maxUses"`
+	// unix milliseconds, the codes never expire if not set
+	ExpireTime    int64  `protobuf:"varint,3,opt,name=expireTime,proto3" json:"expireTime"`
+	InviterUserID string `protobuf:"bytes,4,opt,name=inviterUserID,proto3" json:"inviterUserID"`
+	Campaign      string `protobuf:"bytes,5,opt,name=campaign,proto3" json:"campaign"`
 }
 
-func (x *AddIPForbiddenResp) Reset() {
-	*x = AddIPForbiddenResp{}
+func (x *AddInvitationCodeReq) Reset() {
+	*x = AddInvitationCodeReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_admin_admin_proto_msgTypes[60]
+		mi := &file_admin_admin_proto_msgTypes[63]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AddIPForbiddenResp) String() string {
+func (x *AddInvitationCodeReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddIPForbiddenResp) ProtoMessage() {}
+func (*AddInvitationCodeReq) ProtoMessage() {}
 
-func (x *AddIPForbiddenResp) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_admin_proto_msgTypes[60]
+func (x *AddInvitationCodeReq) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_admin_proto_msgTypes[63]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3198,81 +3442,69 @@ func (x *AddIPForbiddenResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddIPForbiddenResp.ProtoReflect.Descriptor instead.
-func (*AddIPForbiddenResp) Descriptor() ([]byte, []int) {
-	return file_admin_admin_proto_rawDescGZIP(), []int{60}
+// Deprecated: Use AddInvitationCodeReq.ProtoReflect.Descriptor instead.
+func (*AddInvitationCodeReq) Descriptor() ([]byte, []int) {
+	return file_admin_admin_proto_rawDescGZIP(), []int{63}
 }
 
-type DelIPForbiddenReq struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Ips []string `protobuf:"bytes,1,rep,name=ips,proto3" json:"ips"`
-}
-
-func (x *DelIPForbiddenReq) Reset() {
-	*x = DelIPForbiddenReq{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_admin_admin_proto_msgTypes[61]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *AddInvitationCodeReq) GetCodes() []string {
+	if x != nil {
+		return x.Codes
 	}
+	return nil
 }
 
-func (x *DelIPForbiddenReq) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *AddInvitationCodeReq) GetMaxUses() int32 {
+	if x != nil {
+		return x.MaxUses
+	}
+	return 0
 }
 
-func (*DelIPForbiddenReq) ProtoMessage() {}
-
-func (x *DelIPForbiddenReq) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_admin_proto_msgTypes[61]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *AddInvitationCodeReq) GetExpireTime() int64 {
+	if x != nil {
+		return x.ExpireTime
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use DelIPForbiddenReq.ProtoReflect.Descriptor instead.
-func (*DelIPForbiddenReq) Descriptor() ([]byte, []int) {
-	return file_admin_admin_proto_rawDescGZIP(), []int{61}
+func (x *AddInvitationCodeReq) GetInviterUserID() string {
+	if x != nil {
+		return x.InviterUserID
+	}
+	return ""
 }
 
-func (x *DelIPForbiddenReq) GetIps() []string {
+func (x *AddInvitationCodeReq) GetCampaign() string {
 	if x != nil {
-		return x.Ips
+		return x.Campaign
 	}
-	return nil
+	return ""
 }
 
-type DelIPForbiddenResp struct {
+type AddInvitationCodeResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *DelIPForbiddenResp) Reset() {
-	*x = DelIPForbiddenResp{}
+func (x *AddInvitationCodeResp) Reset() {
+	*x = AddInvitationCodeResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_admin_admin_proto_msgTypes[62]
+		mi := &file_admin_admin_proto_msgTypes[64]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DelIPForbiddenResp) String() string {
+func (x *AddInvitationCodeResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DelIPForbiddenResp) ProtoMessage() {}
+func (*AddInvitationCodeResp) ProtoMessage() {}
 
-func (x *DelIPForbiddenResp) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_admin_proto_msgTypes[62]
+func (x *AddInvitationCodeResp) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_admin_proto_msgTypes[64]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3283,37 +3515,42 @@ func (x *DelIPForbiddenResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DelIPForbiddenResp.ProtoReflect.Descriptor instead.
-func (*DelIPForbiddenResp) Descriptor() ([]byte, []int) {
-	return file_admin_admin_proto_rawDescGZIP(), []int{62}
+// Deprecated: Use AddInvitationCodeResp.ProtoReflect.Descriptor instead.
+func (*AddInvitationCodeResp) Descriptor() ([]byte, []int) {
+	return file_admin_admin_proto_rawDescGZIP(), []int{64}
 }
 
-// ################### User Limit ###################
-type CheckRegisterForbiddenReq struct {
+type GenInvitationCodeReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Ip string `protobuf:"bytes,1,opt,name=ip,proto3" json:"ip"`
+	Len           int32  `protobuf:"varint,1,opt,name=len,proto3" json:"len"`
+	Num           int32  `protobuf:"varint,2,opt,name=num,proto3" json:"num"`
+	Chars         string `protobuf:"bytes,3,opt,name=chars,proto3" json:"chars"`
+	MaxUses       int32  `protobuf:"varint,4,opt,name=maxUses,proto3" json:"maxUses"`
+	ExpireTime    int64  `protobuf:"varint,5,opt,name=expireTime,proto3" json:"expireTime"`
+	InviterUserID string `protobuf:"bytes,6,opt,name=inviterUserID,proto3" json:"inviterUserID"`
+	Campaign      string `protobuf:"bytes,7,opt,name=campaign,proto3" json:"campaign"`
 }
 
-func (x *CheckRegisterForbiddenReq) Reset() {
-	*x = CheckRegisterForbiddenReq{}
+func (x *GenInvitationCodeReq) Reset() {
+	*x = GenInvitationCodeReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_admin_admin_proto_msgTypes[63]
+		mi := &file_admin_admin_proto_msgTypes[65]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CheckRegisterForbiddenReq) String() string {
+func (x *GenInvitationCodeReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CheckRegisterForbiddenReq) ProtoMessage() {}
+func (*GenInvitationCodeReq) ProtoMessage() {}
 
-func (x *CheckRegisterForbiddenReq) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_admin_proto_msgTypes[63]
+func (x *GenInvitationCodeReq) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_admin_proto_msgTypes[65]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3324,119 +3561,70 @@ func (x *CheckRegisterForbiddenReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CheckRegisterForbiddenReq.ProtoReflect.Descriptor instead.
-func (*CheckRegisterForbiddenReq) Descriptor() ([]byte, []int) {
-	return file_admin_admin_proto_rawDescGZIP(), []int{63}
+// Deprecated: Use GenInvitationCodeReq.ProtoReflect.Descriptor instead.
+func (*GenInvitationCodeReq) Descriptor() ([]byte, []int) {
+	return file_admin_admin_proto_rawDescGZIP(), []int{65}
 }
 
-func (x *CheckRegisterForbiddenReq) GetIp() string {
+func (x *GenInvitationCodeReq) GetLen() int32 {
 	if x != nil {
-		return x.Ip
+		return x.Len
 	}
-	return ""
-}
-
-type CheckRegisterForbiddenResp struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+	return 0
 }
 
-func (x *CheckRegisterForbiddenResp) Reset() {
-	*x = CheckRegisterForbiddenResp{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_admin_admin_proto_msgTypes[64]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *GenInvitationCodeReq) GetNum() int32 {
+	if x != nil {
+		return x.Num
 	}
+	return 0
 }
 
-func (x *CheckRegisterForbiddenResp) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*CheckRegisterForbiddenResp) ProtoMessage() {}
-
-func (x *CheckRegisterForbiddenResp) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_admin_proto_msgTypes[64]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *GenInvitationCodeReq) GetChars() string {
+	if x != nil {
+		return x.Chars
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use CheckRegisterForbiddenResp.ProtoReflect.Descriptor instead.
-func (*CheckRegisterForbiddenResp) Descriptor() ([]byte, []int) {
-	return file_admin_admin_proto_rawDescGZIP(), []int{64}
-}
-
-type CheckLoginForbiddenReq struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Ip     string `protobuf:"bytes,1,opt,name=ip,proto3" json:"ip"`
-	UserID string `protobuf:"bytes,2,opt,name=userID,proto3" json:"userID"`
+	return ""
 }
 
-func (x *CheckLoginForbiddenReq) Reset() {
-	*x = CheckLoginForbiddenReq{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_admin_admin_proto_msgTypes[65]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *GenInvitationCodeReq) GetMaxUses() int32 {
+	if x != nil {
+		return x.MaxUses
 	}
+	return 0
 }
 
-func (x *CheckLoginForbiddenReq) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*CheckLoginForbiddenReq) ProtoMessage() {}
-
-func (x *CheckLoginForbiddenReq) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_admin_proto_msgTypes[65]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *GenInvitationCodeReq) GetExpireTime() int64 {
+	if x != nil {
+		return x.ExpireTime
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use CheckLoginForbiddenReq.ProtoReflect.Descriptor instead.
-func (*CheckLoginForbiddenReq) Descriptor() ([]byte, []int) {
-	return file_admin_admin_proto_rawDescGZIP(), []int{65}
+	return 0
 }
 
-func (x *CheckLoginForbiddenReq) GetIp() string {
+func (x *GenInvitationCodeReq) GetInviterUserID() string {
 	if x != nil {
-		return x.Ip
+		return x.InviterUserID
 	}
 	return ""
 }
 
-func (x *CheckLoginForbiddenReq) GetUserID() string {
+func (x *GenInvitationCodeReq) GetCampaign() string {
 	if x != nil {
-		return x.UserID
+		return x.Campaign
 	}
 	return ""
 }
 
-type CheckLoginForbiddenResp struct {
+type GenInvitationCodeResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Codes []string `protobuf:"bytes,1,rep,name=codes,proto3" json:"codes"`
 }
 
-func (x *CheckLoginForbiddenResp) Reset() {
-	*x = CheckLoginForbiddenResp{}
+func (x *GenInvitationCodeResp) Reset() {
+	*x = GenInvitationCodeResp{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_admin_admin_proto_msgTypes[66]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3444,13 +3632,13 @@ func (x *CheckLoginForbiddenResp) Reset() {
 	}
 }
 
-func (x *CheckLoginForbiddenResp) String() string {
+func (x *GenInvitationCodeResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CheckLoginForbiddenResp) ProtoMessage() {}
+func (*GenInvitationCodeResp) ProtoMessage() {}
 
-func (x *CheckLoginForbiddenResp) ProtoReflect() protoreflect.Message {
+func (x *GenInvitationCodeResp) ProtoReflect() protoreflect.Message {
 	mi := &file_admin_admin_proto_msgTypes[66]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3462,23 +3650,28 @@ func (x *CheckLoginForbiddenResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CheckLoginForbiddenResp.ProtoReflect.Descriptor instead.
-func (*CheckLoginForbiddenResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use GenInvitationCodeResp.ProtoReflect.Descriptor instead.
+func (*GenInvitationCodeResp) Descriptor() ([]byte, []int) {
 	return file_admin_admin_proto_rawDescGZIP(), []int{66}
 }
 
-// ################### login out ###################
-type CancellationUserReq struct {
+func (x *GenInvitationCodeResp) GetCodes() []string {
+	if x != nil {
+		return x.Codes
+	}
+	return nil
+}
+
+type FindInvitationCodeReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserID string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
-	Reason string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason"`
+	Codes []string `protobuf:"bytes,1,rep,name=codes,proto3" json:"codes"`
 }
 
-func (x *CancellationUserReq) Reset() {
-	*x = CancellationUserReq{}
+func (x *FindInvitationCodeReq) Reset() {
+	*x = FindInvitationCodeReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_admin_admin_proto_msgTypes[67]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3486,13 +3679,13 @@ func (x *CancellationUserReq) Reset() {
 	}
 }
 
-func (x *CancellationUserReq) String() string {
+func (x *FindInvitationCodeReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CancellationUserReq) ProtoMessage() {}
+func (*FindInvitationCodeReq) ProtoMessage() {}
 
-func (x *CancellationUserReq) ProtoReflect() protoreflect.Message {
+func (x *FindInvitationCodeReq) ProtoReflect() protoreflect.Message {
 	mi := &file_admin_admin_proto_msgTypes[67]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3504,33 +3697,28 @@ func (x *CancellationUserReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CancellationUserReq.ProtoReflect.Descriptor instead.
-func (*CancellationUserReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use FindInvitationCodeReq.ProtoReflect.Descriptor instead.
+func (*FindInvitationCodeReq) Descriptor() ([]byte, []int) {
 	return file_admin_admin_proto_rawDescGZIP(), []int{67}
 }
 
-func (x *CancellationUserReq) GetUserID() string {
-	if x != nil {
-		return x.UserID
-	}
-	return ""
-}
-
-func (x *CancellationUserReq) GetReason() string {
+func (x *FindInvitationCodeReq) GetCodes() []string {
 	if x != nil {
-		return x.Reason
+		return x.Codes
 	}
-	return ""
+	return nil
 }
 
-type CancellationUserResp struct {
+type FindInvitationCodeResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Codes []*InvitationRegister `protobuf:"bytes,1,rep,name=codes,proto3" json:"codes"`
 }
 
-func (x *CancellationUserResp) Reset() {
-	*x = CancellationUserResp{}
+func (x *FindInvitationCodeResp) Reset() {
+	*x = FindInvitationCodeResp{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_admin_admin_proto_msgTypes[68]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3538,13 +3726,13 @@ func (x *CancellationUserResp) Reset() {
 	}
 }
 
-func (x *CancellationUserResp) String() string {
+func (x *FindInvitationCodeResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CancellationUserResp) ProtoMessage() {}
+func (*FindInvitationCodeResp) ProtoMessage() {}
 
-func (x *CancellationUserResp) ProtoReflect() protoreflect.Message {
+func (x *FindInvitationCodeResp) ProtoReflect() protoreflect.Message {
 	mi := &file_admin_admin_proto_msgTypes[68]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3556,23 +3744,29 @@ func (x *CancellationUserResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CancellationUserResp.ProtoReflect.Descriptor instead.
-func (*CancellationUserResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use FindInvitationCodeResp.ProtoReflect.Descriptor instead.
+func (*FindInvitationCodeResp) Descriptor() ([]byte, []int) {
 	return file_admin_admin_proto_rawDescGZIP(), []int{68}
 }
 
-// ################### Block User, Unblock User ###################
-type BlockUserReq struct {
+func (x *FindInvitationCodeResp) GetCodes() []*InvitationRegister {
+	if x != nil {
+		return x.Codes
+	}
+	return nil
+}
+
+type UseInvitationCodeReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserID string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
-	Reason string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason"`
+	Code   string `protobuf:"bytes,1,opt,name=code,proto3" json:"code"`
+	UserID string `protobuf:"bytes,2,opt,name=userID,proto3" json:"userID"`
 }
 
-func (x *BlockUserReq) Reset() {
-	*x = BlockUserReq{}
+func (x *UseInvitationCodeReq) Reset() {
+	*x = UseInvitationCodeReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_admin_admin_proto_msgTypes[69]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3580,13 +3774,13 @@ func (x *BlockUserReq) Reset() {
 	}
 }
 
-func (x *BlockUserReq) String() string {
+func (x *UseInvitationCodeReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BlockUserReq) ProtoMessage() {}
+func (*UseInvitationCodeReq) ProtoMessage() {}
 
-func (x *BlockUserReq) ProtoReflect() protoreflect.Message {
+func (x *UseInvitationCodeReq) ProtoReflect() protoreflect.Message {
 	mi := &file_admin_admin_proto_msgTypes[69]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3598,33 +3792,33 @@ func (x *BlockUserReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BlockUserReq.ProtoReflect.Descriptor instead.
-func (*BlockUserReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use UseInvitationCodeReq.ProtoReflect.Descriptor instead.
+func (*UseInvitationCodeReq) Descriptor() ([]byte, []int) {
 	return file_admin_admin_proto_rawDescGZIP(), []int{69}
 }
 
-func (x *BlockUserReq) GetUserID() string {
+func (x *UseInvitationCodeReq) GetCode() string {
 	if x != nil {
-		return x.UserID
+		return x.Code
 	}
 	return ""
 }
 
-func (x *BlockUserReq) GetReason() string {
+func (x *UseInvitationCodeReq) GetUserID() string {
 	if x != nil {
-		return x.Reason
+		return x.UserID
 	}
 	return ""
 }
 
-type BlockUserResp struct {
+type UseInvitationCodeResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *BlockUserResp) Reset() {
-	*x = BlockUserResp{}
+func (x *UseInvitationCodeResp) Reset() {
+	*x = UseInvitationCodeResp{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_admin_admin_proto_msgTypes[70]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3632,13 +3826,13 @@ func (x *BlockUserResp) Reset() {
 	}
 }
 
-func (x *BlockUserResp) String() string {
+func (x *UseInvitationCodeResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BlockUserResp) ProtoMessage() {}
+func (*UseInvitationCodeResp) ProtoMessage() {}
 
-func (x *BlockUserResp) ProtoReflect() protoreflect.Message {
+func (x *UseInvitationCodeResp) ProtoReflect() protoreflect.Message {
 	mi := &file_admin_admin_proto_msgTypes[70]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3650,21 +3844,22 @@ func (x *BlockUserResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BlockUserResp.ProtoReflect.Descriptor instead.
-func (*BlockUserResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use UseInvitationCodeResp.ProtoReflect.Descriptor instead.
+func (*UseInvitationCodeResp) Descriptor() ([]byte, []int) {
 	return file_admin_admin_proto_rawDescGZIP(), []int{70}
 }
 
-type UnblockUserReq struct {
+type ReleaseInvitationCodeReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserIDs []string `protobuf:"bytes,1,rep,name=userIDs,proto3" json:"userIDs"`
+	Code   string `protobuf:"bytes,1,opt,name=code,proto3" json:"code"`
+	UserID string `protobuf:"bytes,2,opt,name=userID,proto3" json:"userID"`
 }
 
-func (x *UnblockUserReq) Reset() {
-	*x = UnblockUserReq{}
+func (x *ReleaseInvitationCodeReq) Reset() {
+	*x = ReleaseInvitationCodeReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_admin_admin_proto_msgTypes[71]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3672,13 +3867,13 @@ func (x *UnblockUserReq) Reset() {
 	}
 }
 
-func (x *UnblockUserReq) String() string {
+func (x *ReleaseInvitationCodeReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UnblockUserReq) ProtoMessage() {}
+func (*ReleaseInvitationCodeReq) ProtoMessage() {}
 
-func (x *UnblockUserReq) ProtoReflect() protoreflect.Message {
+func (x *ReleaseInvitationCodeReq) ProtoReflect() protoreflect.Message {
 	mi := &file_admin_admin_proto_msgTypes[71]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3690,26 +3885,33 @@ func (x *UnblockUserReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UnblockUserReq.ProtoReflect.Descriptor instead.
-func (*UnblockUserReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use ReleaseInvitationCodeReq.ProtoReflect.Descriptor instead.
+func (*ReleaseInvitationCodeReq) Descriptor() ([]byte, []int) {
 	return file_admin_admin_proto_rawDescGZIP(), []int{71}
 }
 
-func (x *UnblockUserReq) GetUserIDs() []string {
+func (x *ReleaseInvitationCodeReq) GetCode() string {
 	if x != nil {
-		return x.UserIDs
+		return x.Code
 	}
-	return nil
+	return ""
 }
 
-type UnblockUserResp struct {
+func (x *ReleaseInvitationCodeReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+type ReleaseInvitationCodeResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *UnblockUserResp) Reset() {
-	*x = UnblockUserResp{}
+func (x *ReleaseInvitationCodeResp) Reset() {
+	*x = ReleaseInvitationCodeResp{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_admin_admin_proto_msgTypes[72]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3717,13 +3919,13 @@ func (x *UnblockUserResp) Reset() {
 	}
 }
 
-func (x *UnblockUserResp) String() string {
+func (x *ReleaseInvitationCodeResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UnblockUserResp) ProtoMessage() {}
+func (*ReleaseInvitationCodeResp) ProtoMessage() {}
 
-func (x *UnblockUserResp) ProtoReflect() protoreflect.Message {
+func (x *ReleaseInvitationCodeResp) ProtoReflect() protoreflect.Message {
 	mi := &file_admin_admin_proto_msgTypes[72]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3735,22 +3937,21 @@ func (x *UnblockUserResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UnblockUserResp.ProtoReflect.Descriptor instead.
-func (*UnblockUserResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use ReleaseInvitationCodeResp.ProtoReflect.Descriptor instead.
+func (*ReleaseInvitationCodeResp) Descriptor() ([]byte, []int) {
 	return file_admin_admin_proto_rawDescGZIP(), []int{72}
 }
 
-type SearchBlockUserReq struct {
+type DelInvitationCodeReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Keyword    string                    `protobuf:"bytes,1,opt,name=keyword,proto3" json:"keyword"`
-	Pagination *sdkwss.RequestPagination `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination"`
+	Codes []string `protobuf:"bytes,1,rep,name=codes,proto3" json:"codes"`
 }
 
-func (x *SearchBlockUserReq) Reset() {
-	*x = SearchBlockUserReq{}
+func (x *DelInvitationCodeReq) Reset() {
+	*x = DelInvitationCodeReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_admin_admin_proto_msgTypes[73]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3758,13 +3959,13 @@ func (x *SearchBlockUserReq) Reset() {
 	}
 }
 
-func (x *SearchBlockUserReq) String() string {
+func (x *DelInvitationCodeReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SearchBlockUserReq) ProtoMessage() {}
+func (*DelInvitationCodeReq) ProtoMessage() {}
 
-func (x *SearchBlockUserReq) ProtoReflect() protoreflect.Message {
+func (x *DelInvitationCodeReq) ProtoReflect() protoreflect.Message {
 	mi := &file_admin_admin_proto_msgTypes[73]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3776,45 +3977,26 @@ func (x *SearchBlockUserReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SearchBlockUserReq.ProtoReflect.Descriptor instead.
-func (*SearchBlockUserReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use DelInvitationCodeReq.ProtoReflect.Descriptor instead.
+func (*DelInvitationCodeReq) Descriptor() ([]byte, []int) {
 	return file_admin_admin_proto_rawDescGZIP(), []int{73}
 }
 
-func (x *SearchBlockUserReq) GetKeyword() string {
-	if x != nil {
-		return x.Keyword
-	}
-	return ""
-}
-
-func (x *SearchBlockUserReq) GetPagination() *sdkwss.RequestPagination {
+func (x *DelInvitationCodeReq) GetCodes() []string {
 	if x != nil {
-		return x.Pagination
+		return x.Codes
 	}
 	return nil
 }
 
-type BlockUserInfo struct {
+type DelInvitationCodeResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	UserID      string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
-	Account     string `protobuf:"bytes,2,opt,name=account,proto3" json:"account"`
-	PhoneNumber string `protobuf:"bytes,3,opt,name=phoneNumber,proto3" json:"phoneNumber"`
-	AreaCode    string `protobuf:"bytes,4,opt,name=areaCode,proto3" json:"areaCode"`
-	Email       string `protobuf:"bytes,5,opt,name=email,proto3" json:"email"`
-	Nickname    string `protobuf:"bytes,6,opt,name=nickname,proto3" json:"nickname"`
-	FaceURL     string `protobuf:"bytes,7,opt,name=faceURL,proto3" json:"faceURL"`
-	Gender      int32  `protobuf:"varint,8,opt,name=gender,proto3" json:"gender"`
-	Reason      string `protobuf:"bytes,9,opt,name=reason,proto3" json:"reason"`
-	OpUserID    string `protobuf:"bytes,10,opt,name=opUserID,proto3" json:"opUserID"`
-	CreateTime  int64  `protobuf:"varint,11,opt,name=createTime,proto3" json:"createTime"`
 }
 
-func (x *BlockUserInfo) Reset() {
-	*x = BlockUserInfo{}
+func (x *DelInvitationCodeResp) Reset() {
+	*x = DelInvitationCodeResp{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_admin_admin_proto_msgTypes[74]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3822,13 +4004,13 @@ func (x *BlockUserInfo) Reset() {
 	}
 }
 
-func (x *BlockUserInfo) String() string {
+func (x *DelInvitationCodeResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BlockUserInfo) ProtoMessage() {}
+func (*DelInvitationCodeResp) ProtoMessage() {}
 
-func (x *BlockUserInfo) ProtoReflect() protoreflect.Message {
+func (x *DelInvitationCodeResp) ProtoReflect() protoreflect.Message {
 	mi := &file_admin_admin_proto_msgTypes[74]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3840,114 +4022,163 @@ func (x *BlockUserInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BlockUserInfo.ProtoReflect.Descriptor instead.
-func (*BlockUserInfo) Descriptor() ([]byte, []int) {
+// Deprecated: Use DelInvitationCodeResp.ProtoReflect.Descriptor instead.
+func (*DelInvitationCodeResp) Descriptor() ([]byte, []int) {
 	return file_admin_admin_proto_rawDescGZIP(), []int{74}
 }
 
-func (x *BlockUserInfo) GetUserID() string {
-	if x != nil {
-		return x.UserID
+type InvitationRegister struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	InvitationCode string `protobuf:"bytes,1,opt,name=invitationCode,proto3" json:"invitationCode"`
+	CreateTime     int64  `protobuf:"varint,2,opt,name=createTime,proto3" json:"createTime"`
+	// the first user registered with the code
+	UsedUserID string                 `protobuf:"bytes,3,opt,name=usedUserID,proto3" json:"usedUserID"`
+	UsedUser   *common.UserPublicInfo `protobuf:"bytes,4,opt,name=usedUser,proto3" json:"usedUser"`
+	MaxUses    int32                  `protobuf:"varint,5,opt,name=maxUses,proto3" json:"maxUses"`
+	UsedCount  int32                  `protobuf:"varint,6,opt,name=usedCount,proto3" json:"usedCount"`
+	// unix milliseconds, 0 if the code never expires
+	ExpireTime    int64                  `protobuf:"varint,7,opt,name=expireTime,proto3" json:"expireTime"`
+	InviterUserID string                 `protobuf:"bytes,8,opt,name=inviterUserID,proto3" json:"inviterUserID"`
+	Inviter       *common.UserPublicInfo `protobuf:"bytes,9,opt,name=inviter,proto3" json:"inviter"`
+	Campaign      string                 `protobuf:"bytes,10,opt,name=campaign,proto3" json:"campaign"`
+}
+
+func (x *InvitationRegister) Reset() {
+	*x = InvitationRegister{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_admin_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *BlockUserInfo) GetAccount() string {
+func (x *InvitationRegister) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvitationRegister) ProtoMessage() {}
+
+func (x *InvitationRegister) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_admin_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InvitationRegister.ProtoReflect.Descriptor instead.
+func (*InvitationRegister) Descriptor() ([]byte, []int) {
+	return file_admin_admin_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *InvitationRegister) GetInvitationCode() string {
 	if x != nil {
-		return x.Account
+		return x.InvitationCode
 	}
 	return ""
 }
 
-func (x *BlockUserInfo) GetPhoneNumber() string {
+func (x *InvitationRegister) GetCreateTime() int64 {
 	if x != nil {
-		return x.PhoneNumber
+		return x.CreateTime
 	}
-	return ""
+	return 0
 }
 
-func (x *BlockUserInfo) GetAreaCode() string {
+func (x *InvitationRegister) GetUsedUserID() string {
 	if x != nil {
-		return x.AreaCode
+		return x.UsedUserID
 	}
 	return ""
 }
 
-func (x *BlockUserInfo) GetEmail() string {
+func (x *InvitationRegister) GetUsedUser() *common.UserPublicInfo {
 	if x != nil {
-		return x.Email
+		return x.UsedUser
 	}
-	return ""
+	return nil
 }
 
-func (x *BlockUserInfo) GetNickname() string {
+func (x *InvitationRegister) GetMaxUses() int32 {
 	if x != nil {
-		return x.Nickname
+		return x.MaxUses
 	}
-	return ""
+	return 0
 }
 
-func (x *BlockUserInfo) GetFaceURL() string {
+func (x *InvitationRegister) GetUsedCount() int32 {
 	if x != nil {
-		return x.FaceURL
+		return x.UsedCount
 	}
-	return ""
+	return 0
 }
 
-func (x *BlockUserInfo) GetGender() int32 {
+func (x *InvitationRegister) GetExpireTime() int64 {
 	if x != nil {
-		return x.Gender
+		return x.ExpireTime
 	}
 	return 0
 }
 
-func (x *BlockUserInfo) GetReason() string {
+func (x *InvitationRegister) GetInviterUserID() string {
 	if x != nil {
-		return x.Reason
+		return x.InviterUserID
 	}
 	return ""
 }
 
-func (x *BlockUserInfo) GetOpUserID() string {
+func (x *InvitationRegister) GetInviter() *common.UserPublicInfo {
 	if x != nil {
-		return x.OpUserID
+		return x.Inviter
 	}
-	return ""
+	return nil
 }
 
-func (x *BlockUserInfo) GetCreateTime() int64 {
+func (x *InvitationRegister) GetCampaign() string {
 	if x != nil {
-		return x.CreateTime
+		return x.Campaign
 	}
-	return 0
+	return ""
 }
 
-type SearchBlockUserResp struct {
+type SearchInvitationCodeReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Total uint32           `protobuf:"varint,1,opt,name=total,proto3" json:"total"`
-	Users []*BlockUserInfo `protobuf:"bytes,2,rep,name=users,proto3" json:"users"`
+	Status        int32                     `protobuf:"varint,1,opt,name=status,proto3" json:"status"`
+	UserIDs       []string                  `protobuf:"bytes,2,rep,name=userIDs,proto3" json:"userIDs"`
+	Codes         []string                  `protobuf:"bytes,3,rep,name=codes,proto3" json:"codes"`
+	Keyword       string                    `protobuf:"bytes,4,opt,name=keyword,proto3" json:"keyword"`
+	Pagination    *sdkwss.RequestPagination `protobuf:"bytes,5,opt,name=pagination,proto3" json:"pagination"`
+	InviterUserID string                    `protobuf:"bytes,6,opt,name=inviterUserID,proto3" json:"inviterUserID"`
+	Campaign      string                    `protobuf:"bytes,7,opt,name=campaign,proto3" json:"campaign"`
 }
 
-func (x *SearchBlockUserResp) Reset() {
-	*x = SearchBlockUserResp{}
+func (x *SearchInvitationCodeReq) Reset() {
+	*x = SearchInvitationCodeReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_admin_admin_proto_msgTypes[75]
+		mi := &file_admin_admin_proto_msgTypes[76]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SearchBlockUserResp) String() string {
+func (x *SearchInvitationCodeReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SearchBlockUserResp) ProtoMessage() {}
+func (*SearchInvitationCodeReq) ProtoMessage() {}
 
-func (x *SearchBlockUserResp) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_admin_proto_msgTypes[75]
+func (x *SearchInvitationCodeReq) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_admin_proto_msgTypes[76]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3958,85 +4189,71 @@ func (x *SearchBlockUserResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SearchBlockUserResp.ProtoReflect.Descriptor instead.
-func (*SearchBlockUserResp) Descriptor() ([]byte, []int) {
-	return file_admin_admin_proto_rawDescGZIP(), []int{75}
+// Deprecated: Use SearchInvitationCodeReq.ProtoReflect.Descriptor instead.
+func (*SearchInvitationCodeReq) Descriptor() ([]byte, []int) {
+	return file_admin_admin_proto_rawDescGZIP(), []int{76}
 }
 
-func (x *SearchBlockUserResp) GetTotal() uint32 {
+func (x *SearchInvitationCodeReq) GetStatus() int32 {
 	if x != nil {
-		return x.Total
+		return x.Status
 	}
 	return 0
 }
 
-func (x *SearchBlockUserResp) GetUsers() []*BlockUserInfo {
+func (x *SearchInvitationCodeReq) GetUserIDs() []string {
 	if x != nil {
-		return x.Users
+		return x.UserIDs
 	}
 	return nil
 }
 
-type FindUserBlockInfoReq struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	UserIDs []string `protobuf:"bytes,1,rep,name=userIDs,proto3" json:"userIDs"`
-}
-
-func (x *FindUserBlockInfoReq) Reset() {
-	*x = FindUserBlockInfoReq{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_admin_admin_proto_msgTypes[76]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *SearchInvitationCodeReq) GetCodes() []string {
+	if x != nil {
+		return x.Codes
 	}
+	return nil
 }
 
-func (x *FindUserBlockInfoReq) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *SearchInvitationCodeReq) GetKeyword() string {
+	if x != nil {
+		return x.Keyword
+	}
+	return ""
 }
 
-func (*FindUserBlockInfoReq) ProtoMessage() {}
-
-func (x *FindUserBlockInfoReq) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_admin_proto_msgTypes[76]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *SearchInvitationCodeReq) GetPagination() *sdkwss.RequestPagination {
+	if x != nil {
+		return x.Pagination
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use FindUserBlockInfoReq.ProtoReflect.Descriptor instead.
-func (*FindUserBlockInfoReq) Descriptor() ([]byte, []int) {
-	return file_admin_admin_proto_rawDescGZIP(), []int{76}
+func (x *SearchInvitationCodeReq) GetInviterUserID() string {
+	if x != nil {
+		return x.InviterUserID
+	}
+	return ""
 }
 
-func (x *FindUserBlockInfoReq) GetUserIDs() []string {
+func (x *SearchInvitationCodeReq) GetCampaign() string {
 	if x != nil {
-		return x.UserIDs
+		return x.Campaign
 	}
-	return nil
+	return ""
 }
 
-type BlockInfo struct {
+type SearchInvitationCodeResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserID     string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
-	Reason     string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason"`
-	OpUserID   string `protobuf:"bytes,3,opt,name=opUserID,proto3" json:"opUserID"`
-	CreateTime int64  `protobuf:"varint,4,opt,name=createTime,proto3" json:"createTime"`
+	Total uint32                `protobuf:"varint,1,opt,name=total,proto3" json:"total"`
+	List  []*InvitationRegister `protobuf:"bytes,2,rep,name=list,proto3" json:"list"`
 }
 
-func (x *BlockInfo) Reset() {
-	*x = BlockInfo{}
+func (x *SearchInvitationCodeResp) Reset() {
+	*x = SearchInvitationCodeResp{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_admin_admin_proto_msgTypes[77]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4044,13 +4261,13 @@ func (x *BlockInfo) Reset() {
 	}
 }
 
-func (x *BlockInfo) String() string {
+func (x *SearchInvitationCodeResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BlockInfo) ProtoMessage() {}
+func (*SearchInvitationCodeResp) ProtoMessage() {}
 
-func (x *BlockInfo) ProtoReflect() protoreflect.Message {
+func (x *SearchInvitationCodeResp) ProtoReflect() protoreflect.Message {
 	mi := &file_admin_admin_proto_msgTypes[77]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4062,49 +4279,40 @@ func (x *BlockInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BlockInfo.ProtoReflect.Descriptor instead.
-func (*BlockInfo) Descriptor() ([]byte, []int) {
+// Deprecated: Use SearchInvitationCodeResp.ProtoReflect.Descriptor instead.
+func (*SearchInvitationCodeResp) Descriptor() ([]byte, []int) {
 	return file_admin_admin_proto_rawDescGZIP(), []int{77}
 }
 
-func (x *BlockInfo) GetUserID() string {
-	if x != nil {
-		return x.UserID
-	}
-	return ""
-}
-
-func (x *BlockInfo) GetReason() string {
-	if x != nil {
-		return x.Reason
-	}
-	return ""
-}
-
-func (x *BlockInfo) GetOpUserID() string {
+func (x *SearchInvitationCodeResp) GetTotal() uint32 {
 	if x != nil {
-		return x.OpUserID
+		return x.Total
 	}
-	return ""
+	return 0
 }
 
-func (x *BlockInfo) GetCreateTime() int64 {
+func (x *SearchInvitationCodeResp) GetList() []*InvitationRegister {
 	if x != nil {
-		return x.CreateTime
+		return x.List
 	}
-	return 0
+	return nil
 }
 
-type FindUserBlockInfoResp struct {
+type InvitationUse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Blocks []*BlockInfo `protobuf:"bytes,2,rep,name=blocks,proto3" json:"blocks"`
+	InvitationCode string                 `protobuf:"bytes,1,opt,name=invitationCode,proto3" json:"invitationCode"`
+	UserID         string                 `protobuf:"bytes,2,opt,name=userID,proto3" json:"userID"`
+	User           *common.UserPublicInfo `protobuf:"bytes,3,opt,name=user,proto3" json:"user"`
+	InviterUserID  string                 `protobuf:"bytes,4,opt,name=inviterUserID,proto3" json:"inviterUserID"`
+	Campaign       string                 `protobuf:"bytes,5,opt,name=campaign,proto3" json:"campaign"`
+	CreateTime     int64                  `protobuf:"varint,6,opt,name=createTime,proto3" json:"createTime"`
 }
 
-func (x *FindUserBlockInfoResp) Reset() {
-	*x = FindUserBlockInfoResp{}
+func (x *InvitationUse) Reset() {
+	*x = InvitationUse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_admin_admin_proto_msgTypes[78]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4112,13 +4320,13 @@ func (x *FindUserBlockInfoResp) Reset() {
 	}
 }
 
-func (x *FindUserBlockInfoResp) String() string {
+func (x *InvitationUse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FindUserBlockInfoResp) ProtoMessage() {}
+func (*InvitationUse) ProtoMessage() {}
 
-func (x *FindUserBlockInfoResp) ProtoReflect() protoreflect.Message {
+func (x *InvitationUse) ProtoReflect() protoreflect.Message {
 	mi := &file_admin_admin_proto_msgTypes[78]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4130,29 +4338,67 @@ func (x *FindUserBlockInfoResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FindUserBlockInfoResp.ProtoReflect.Descriptor instead.
-func (*FindUserBlockInfoResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use InvitationUse.ProtoReflect.Descriptor instead.
+func (*InvitationUse) Descriptor() ([]byte, []int) {
 	return file_admin_admin_proto_rawDescGZIP(), []int{78}
 }
 
-func (x *FindUserBlockInfoResp) GetBlocks() []*BlockInfo {
+func (x *InvitationUse) GetInvitationCode() string {
 	if x != nil {
-		return x.Blocks
+		return x.InvitationCode
+	}
+	return ""
+}
+
+func (x *InvitationUse) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *InvitationUse) GetUser() *common.UserPublicInfo {
+	if x != nil {
+		return x.User
 	}
 	return nil
 }
 
-type CreateTokenReq struct {
+func (x *InvitationUse) GetInviterUserID() string {
+	if x != nil {
+		return x.InviterUserID
+	}
+	return ""
+}
+
+func (x *InvitationUse) GetCampaign() string {
+	if x != nil {
+		return x.Campaign
+	}
+	return ""
+}
+
+func (x *InvitationUse) GetCreateTime() int64 {
+	if x != nil {
+		return x.CreateTime
+	}
+	return 0
+}
+
+type SearchInvitationUseReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserID   string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
-	UserType int32  `protobuf:"varint,32,opt,name=userType,proto3" json:"userType"`
+	Code          string                    `protobuf:"bytes,1,opt,name=code,proto3" json:"code"`
+	UserID        string                    `protobuf:"bytes,2,opt,name=userID,proto3" json:"userID"`
+	InviterUserID string                    `protobuf:"bytes,3,opt,name=inviterUserID,proto3" json:"inviterUserID"`
+	Campaign      string                    `protobuf:"bytes,4,opt,name=campaign,proto3" json:"campaign"`
+	Pagination    *sdkwss.RequestPagination `protobuf:"bytes,5,opt,name=pagination,proto3" json:"pagination"`
 }
 
-func (x *CreateTokenReq) Reset() {
-	*x = CreateTokenReq{}
+func (x *SearchInvitationUseReq) Reset() {
+	*x = SearchInvitationUseReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_admin_admin_proto_msgTypes[79]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4160,13 +4406,13 @@ func (x *CreateTokenReq) Reset() {
 	}
 }
 
-func (x *CreateTokenReq) String() string {
+func (x *SearchInvitationUseReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateTokenReq) ProtoMessage() {}
+func (*SearchInvitationUseReq) ProtoMessage() {}
 
-func (x *CreateTokenReq) ProtoReflect() protoreflect.Message {
+func (x *SearchInvitationUseReq) ProtoReflect() protoreflect.Message {
 	mi := &file_admin_admin_proto_msgTypes[79]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4178,35 +4424,57 @@ func (x *CreateTokenReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateTokenReq.ProtoReflect.Descriptor instead.
-func (*CreateTokenReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use SearchInvitationUseReq.ProtoReflect.Descriptor instead.
+func (*SearchInvitationUseReq) Descriptor() ([]byte, []int) {
 	return file_admin_admin_proto_rawDescGZIP(), []int{79}
 }
 
-func (x *CreateTokenReq) GetUserID() string {
+func (x *SearchInvitationUseReq) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *SearchInvitationUseReq) GetUserID() string {
 	if x != nil {
 		return x.UserID
 	}
 	return ""
 }
 
-func (x *CreateTokenReq) GetUserType() int32 {
+func (x *SearchInvitationUseReq) GetInviterUserID() string {
 	if x != nil {
-		return x.UserType
+		return x.InviterUserID
 	}
-	return 0
+	return ""
 }
 
-type CreateTokenResp struct {
+func (x *SearchInvitationUseReq) GetCampaign() string {
+	if x != nil {
+		return x.Campaign
+	}
+	return ""
+}
+
+func (x *SearchInvitationUseReq) GetPagination() *sdkwss.RequestPagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type SearchInvitationUseResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token"`
+	Total uint32           `protobuf:"varint,1,opt,name=total,proto3" json:"total"`
+	List  []*InvitationUse `protobuf:"bytes,2,rep,name=list,proto3" json:"list"`
 }
 
-func (x *CreateTokenResp) Reset() {
-	*x = CreateTokenResp{}
+func (x *SearchInvitationUseResp) Reset() {
+	*x = SearchInvitationUseResp{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_admin_admin_proto_msgTypes[80]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4214,13 +4482,13 @@ func (x *CreateTokenResp) Reset() {
 	}
 }
 
-func (x *CreateTokenResp) String() string {
+func (x *SearchInvitationUseResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateTokenResp) ProtoMessage() {}
+func (*SearchInvitationUseResp) ProtoMessage() {}
 
-func (x *CreateTokenResp) ProtoReflect() protoreflect.Message {
+func (x *SearchInvitationUseResp) ProtoReflect() protoreflect.Message {
 	mi := &file_admin_admin_proto_msgTypes[80]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4232,28 +4500,42 @@ func (x *CreateTokenResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateTokenResp.ProtoReflect.Descriptor instead.
-func (*CreateTokenResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use SearchInvitationUseResp.ProtoReflect.Descriptor instead.
+func (*SearchInvitationUseResp) Descriptor() ([]byte, []int) {
 	return file_admin_admin_proto_rawDescGZIP(), []int{80}
 }
 
-func (x *CreateTokenResp) GetToken() string {
+func (x *SearchInvitationUseResp) GetTotal() uint32 {
 	if x != nil {
-		return x.Token
+		return x.Total
 	}
-	return ""
+	return 0
 }
 
-type ParseTokenReq struct {
+func (x *SearchInvitationUseResp) GetList() []*InvitationUse {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+type InvitationReport struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token"`
+	// the inviter user ID or the campaign
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key"`
+	// set when grouped by inviter
+	Inviter    *common.UserPublicInfo `protobuf:"bytes,2,opt,name=inviter,proto3" json:"inviter"`
+	Registered int64                  `protobuf:"varint,3,opt,name=registered,proto3" json:"registered"`
+	Codes      int64                  `protobuf:"varint,4,opt,name=codes,proto3" json:"codes"`
+	FirstTime  int64                  `protobuf:"varint,5,opt,name=firstTime,proto3" json:"firstTime"`
+	LastTime   int64                  `protobuf:"varint,6,opt,name=lastTime,proto3" json:"lastTime"`
 }
 
-func (x *ParseTokenReq) Reset() {
-	*x = ParseTokenReq{}
+func (x *InvitationReport) Reset() {
+	*x = InvitationReport{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_admin_admin_proto_msgTypes[81]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4261,13 +4543,13 @@ func (x *ParseTokenReq) Reset() {
 	}
 }
 
-func (x *ParseTokenReq) String() string {
+func (x *InvitationReport) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ParseTokenReq) ProtoMessage() {}
+func (*InvitationReport) ProtoMessage() {}
 
-func (x *ParseTokenReq) ProtoReflect() protoreflect.Message {
+func (x *InvitationReport) ProtoReflect() protoreflect.Message {
 	mi := &file_admin_admin_proto_msgTypes[81]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4279,30 +4561,70 @@ func (x *ParseTokenReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ParseTokenReq.ProtoReflect.Descriptor instead.
-func (*ParseTokenReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use InvitationReport.ProtoReflect.Descriptor instead.
+func (*InvitationReport) Descriptor() ([]byte, []int) {
 	return file_admin_admin_proto_rawDescGZIP(), []int{81}
 }
 
-func (x *ParseTokenReq) GetToken() string {
+func (x *InvitationReport) GetKey() string {
 	if x != nil {
-		return x.Token
+		return x.Key
 	}
 	return ""
 }
 
-type ParseTokenResp struct {
+func (x *InvitationReport) GetInviter() *common.UserPublicInfo {
+	if x != nil {
+		return x.Inviter
+	}
+	return nil
+}
+
+func (x *InvitationReport) GetRegistered() int64 {
+	if x != nil {
+		return x.Registered
+	}
+	return 0
+}
+
+func (x *InvitationReport) GetCodes() int64 {
+	if x != nil {
+		return x.Codes
+	}
+	return 0
+}
+
+func (x *InvitationReport) GetFirstTime() int64 {
+	if x != nil {
+		return x.FirstTime
+	}
+	return 0
+}
+
+func (x *InvitationReport) GetLastTime() int64 {
+	if x != nil {
+		return x.LastTime
+	}
+	return 0
+}
+
+type GetInvitationReportReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserID            string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
-	UserType          int32  `protobuf:"varint,2,opt,name=userType,proto3" json:"userType"`
-	ExpireTimeSeconds int64  `protobuf:"varint,3,opt,name=expireTimeSeconds,proto3" json:"expireTimeSeconds"`
+	// 1 by inviter, 2 by campaign
+	GroupBy int32 `protobuf:"varint,1,opt,name=groupBy,proto3" json:"groupBy"`
+	// only these inviters or campaigns if set
+	Keys []string `protobuf:"bytes,2,rep,name=keys,proto3" json:"keys"`
+	// unix milliseconds of the registrations, not applied if 0
+	StartTime  int64                     `protobuf:"varint,3,opt,name=startTime,proto3" json:"startTime"`
+	EndTime    int64                     `protobuf:"varint,4,opt,name=endTime,proto3" json:"endTime"`
+	Pagination *sdkwss.RequestPagination `protobuf:"bytes,5,opt,name=pagination,proto3" json:"pagination"`
 }
 
-func (x *ParseTokenResp) Reset() {
-	*x = ParseTokenResp{}
+func (x *GetInvitationReportReq) Reset() {
+	*x = GetInvitationReportReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_admin_admin_proto_msgTypes[82]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4310,13 +4632,13 @@ func (x *ParseTokenResp) Reset() {
 	}
 }
 
-func (x *ParseTokenResp) String() string {
+func (x *GetInvitationReportReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ParseTokenResp) ProtoMessage() {}
+func (*GetInvitationReportReq) ProtoMessage() {}
 
-func (x *ParseTokenResp) ProtoReflect() protoreflect.Message {
+func (x *GetInvitationReportReq) ProtoReflect() protoreflect.Message {
 	mi := &file_admin_admin_proto_msgTypes[82]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4328,42 +4650,57 @@ func (x *ParseTokenResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ParseTokenResp.ProtoReflect.Descriptor instead.
-func (*ParseTokenResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetInvitationReportReq.ProtoReflect.Descriptor instead.
+func (*GetInvitationReportReq) Descriptor() ([]byte, []int) {
 	return file_admin_admin_proto_rawDescGZIP(), []int{82}
 }
 
-func (x *ParseTokenResp) GetUserID() string {
+func (x *GetInvitationReportReq) GetGroupBy() int32 {
 	if x != nil {
-		return x.UserID
+		return x.GroupBy
 	}
-	return ""
+	return 0
 }
 
-func (x *ParseTokenResp) GetUserType() int32 {
+func (x *GetInvitationReportReq) GetKeys() []string {
 	if x != nil {
-		return x.UserType
+		return x.Keys
+	}
+	return nil
+}
+
+func (x *GetInvitationReportReq) GetStartTime() int64 {
+	if x != nil {
+		return x.StartTime
 	}
 	return 0
 }
 
-func (x *ParseTokenResp) GetExpireTimeSeconds() int64 {
+func (x *GetInvitationReportReq) GetEndTime() int64 {
 	if x != nil {
-		return x.ExpireTimeSeconds
+		return x.EndTime
 	}
 	return 0
 }
 
-type InvalidateTokenReq struct {
+func (x *GetInvitationReportReq) GetPagination() *sdkwss.RequestPagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type GetInvitationReportResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserID string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
+	Total uint32              `protobuf:"varint,1,opt,name=total,proto3" json:"total"`
+	List  []*InvitationReport `protobuf:"bytes,2,rep,name=list,proto3" json:"list"`
 }
 
-func (x *InvalidateTokenReq) Reset() {
-	*x = InvalidateTokenReq{}
+func (x *GetInvitationReportResp) Reset() {
+	*x = GetInvitationReportResp{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_admin_admin_proto_msgTypes[83]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4371,13 +4708,13 @@ func (x *InvalidateTokenReq) Reset() {
 	}
 }
 
-func (x *InvalidateTokenReq) String() string {
+func (x *GetInvitationReportResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InvalidateTokenReq) ProtoMessage() {}
+func (*GetInvitationReportResp) ProtoMessage() {}
 
-func (x *InvalidateTokenReq) ProtoReflect() protoreflect.Message {
+func (x *GetInvitationReportResp) ProtoReflect() protoreflect.Message {
 	mi := &file_admin_admin_proto_msgTypes[83]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4389,26 +4726,38 @@ func (x *InvalidateTokenReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InvalidateTokenReq.ProtoReflect.Descriptor instead.
-func (*InvalidateTokenReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetInvitationReportResp.ProtoReflect.Descriptor instead.
+func (*GetInvitationReportResp) Descriptor() ([]byte, []int) {
 	return file_admin_admin_proto_rawDescGZIP(), []int{83}
 }
 
-func (x *InvalidateTokenReq) GetUserID() string {
+func (x *GetInvitationReportResp) GetTotal() uint32 {
 	if x != nil {
-		return x.UserID
+		return x.Total
 	}
-	return ""
+	return 0
 }
 
-type InvalidateTokenResp struct {
+func (x *GetInvitationReportResp) GetList() []*InvitationReport {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+type SearchUserIPLimitLoginReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Keyword    string                    `protobuf:"bytes,1,opt,name=keyword,proto3" json:"keyword"`
+	Pagination *sdkwss.RequestPagination `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination"`
+	// if set, only the limits whose rule contains this ip, the keyword then matches the userID
+	Ip string `protobuf:"bytes,3,opt,name=ip,proto3" json:"ip"`
 }
 
-func (x *InvalidateTokenResp) Reset() {
-	*x = InvalidateTokenResp{}
+func (x *SearchUserIPLimitLoginReq) Reset() {
+	*x = SearchUserIPLimitLoginReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_admin_admin_proto_msgTypes[84]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4416,13 +4765,13 @@ func (x *InvalidateTokenResp) Reset() {
 	}
 }
 
-func (x *InvalidateTokenResp) String() string {
+func (x *SearchUserIPLimitLoginReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InvalidateTokenResp) ProtoMessage() {}
+func (*SearchUserIPLimitLoginReq) ProtoMessage() {}
 
-func (x *InvalidateTokenResp) ProtoReflect() protoreflect.Message {
+func (x *SearchUserIPLimitLoginReq) ProtoReflect() protoreflect.Message {
 	mi := &file_admin_admin_proto_msgTypes[84]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4434,31 +4783,45 @@ func (x *InvalidateTokenResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InvalidateTokenResp.ProtoReflect.Descriptor instead.
-func (*InvalidateTokenResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use SearchUserIPLimitLoginReq.ProtoReflect.Descriptor instead.
+func (*SearchUserIPLimitLoginReq) Descriptor() ([]byte, []int) {
 	return file_admin_admin_proto_rawDescGZIP(), []int{84}
 }
 
-type AddAppletReq struct {
+func (x *SearchUserIPLimitLoginReq) GetKeyword() string {
+	if x != nil {
+		return x.Keyword
+	}
+	return ""
+}
+
+func (x *SearchUserIPLimitLoginReq) GetPagination() *sdkwss.RequestPagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+func (x *SearchUserIPLimitLoginReq) GetIp() string {
+	if x != nil {
+		return x.Ip
+	}
+	return ""
+}
+
+type LimitUserLoginIP struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id         string `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
-	Name       string `protobuf:"bytes,2,opt,name=name,proto3" json:"name"`
-	AppID      string `protobuf:"bytes,3,opt,name=appID,proto3" json:"appID"`
-	Icon       string `protobuf:"bytes,4,opt,name=icon,proto3" json:"icon"`
-	Url        string `protobuf:"bytes,5,opt,name=url,proto3" json:"url"`
-	Md5        string `protobuf:"bytes,6,opt,name=md5,proto3" json:"md5"`
-	Size       int64  `protobuf:"varint,7,opt,name=size,proto3" json:"size"`
-	Version    string `protobuf:"bytes,8,opt,name=version,proto3" json:"version"`
-	Priority   uint32 `protobuf:"varint,9,opt,name=priority,proto3" json:"priority"`
-	Status     uint32 `protobuf:"varint,10,opt,name=status,proto3" json:"status"`
-	CreateTime int64  `protobuf:"varint,11,opt,name=createTime,proto3" json:"createTime"`
+	UserID     string                 `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
+	Ip         string                 `protobuf:"bytes,2,opt,name=ip,proto3" json:"ip"`
+	CreateTime int64                  `protobuf:"varint,3,opt,name=createTime,proto3" json:"createTime"`
+	User       *common.UserPublicInfo `protobuf:"bytes,4,opt,name=user,proto3" json:"user"`
 }
 
-func (x *AddAppletReq) Reset() {
-	*x = AddAppletReq{}
+func (x *LimitUserLoginIP) Reset() {
+	*x = LimitUserLoginIP{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_admin_admin_proto_msgTypes[85]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4466,13 +4829,13 @@ func (x *AddAppletReq) Reset() {
 	}
 }
 
-func (x *AddAppletReq) String() string {
+func (x *LimitUserLoginIP) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddAppletReq) ProtoMessage() {}
+func (*LimitUserLoginIP) ProtoMessage() {}
 
-func (x *AddAppletReq) ProtoReflect() protoreflect.Message {
+func (x *LimitUserLoginIP) ProtoReflect() protoreflect.Message {
 	mi := &file_admin_admin_proto_msgTypes[85]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4484,111 +4847,120 @@ func (x *AddAppletReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddAppletReq.ProtoReflect.Descriptor instead.
-func (*AddAppletReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use LimitUserLoginIP.ProtoReflect.Descriptor instead.
+func (*LimitUserLoginIP) Descriptor() ([]byte, []int) {
 	return file_admin_admin_proto_rawDescGZIP(), []int{85}
 }
 
-func (x *AddAppletReq) GetId() string {
+func (x *LimitUserLoginIP) GetUserID() string {
 	if x != nil {
-		return x.Id
+		return x.UserID
 	}
 	return ""
 }
 
-func (x *AddAppletReq) GetName() string {
+func (x *LimitUserLoginIP) GetIp() string {
 	if x != nil {
-		return x.Name
+		return x.Ip
 	}
 	return ""
 }
 
-func (x *AddAppletReq) GetAppID() string {
+func (x *LimitUserLoginIP) GetCreateTime() int64 {
 	if x != nil {
-		return x.AppID
+		return x.CreateTime
 	}
-	return ""
+	return 0
 }
 
-func (x *AddAppletReq) GetIcon() string {
+func (x *LimitUserLoginIP) GetUser() *common.UserPublicInfo {
 	if x != nil {
-		return x.Icon
+		return x.User
 	}
-	return ""
+	return nil
 }
 
-func (x *AddAppletReq) GetUrl() string {
-	if x != nil {
-		return x.Url
-	}
-	return ""
+type SearchUserIPLimitLoginResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Total  uint32              `protobuf:"varint,1,opt,name=total,proto3" json:"total"`
+	Limits []*LimitUserLoginIP `protobuf:"bytes,2,rep,name=limits,proto3" json:"limits"`
 }
 
-func (x *AddAppletReq) GetMd5() string {
-	if x != nil {
-		return x.Md5
+func (x *SearchUserIPLimitLoginResp) Reset() {
+	*x = SearchUserIPLimitLoginResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_admin_proto_msgTypes[86]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *AddAppletReq) GetSize() int64 {
-	if x != nil {
-		return x.Size
-	}
-	return 0
+func (x *SearchUserIPLimitLoginResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *AddAppletReq) GetVersion() string {
-	if x != nil {
-		return x.Version
+func (*SearchUserIPLimitLoginResp) ProtoMessage() {}
+
+func (x *SearchUserIPLimitLoginResp) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_admin_proto_msgTypes[86]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *AddAppletReq) GetPriority() uint32 {
-	if x != nil {
-		return x.Priority
-	}
-	return 0
+// Deprecated: Use SearchUserIPLimitLoginResp.ProtoReflect.Descriptor instead.
+func (*SearchUserIPLimitLoginResp) Descriptor() ([]byte, []int) {
+	return file_admin_admin_proto_rawDescGZIP(), []int{86}
 }
 
-func (x *AddAppletReq) GetStatus() uint32 {
+func (x *SearchUserIPLimitLoginResp) GetTotal() uint32 {
 	if x != nil {
-		return x.Status
+		return x.Total
 	}
 	return 0
 }
 
-func (x *AddAppletReq) GetCreateTime() int64 {
+func (x *SearchUserIPLimitLoginResp) GetLimits() []*LimitUserLoginIP {
 	if x != nil {
-		return x.CreateTime
+		return x.Limits
 	}
-	return 0
+	return nil
 }
 
-type AddAppletResp struct {
+type UserIPLimitLogin struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	UserID string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
+	Ip     string `protobuf:"bytes,2,opt,name=ip,proto3" json:"ip"`
 }
 
-func (x *AddAppletResp) Reset() {
-	*x = AddAppletResp{}
+func (x *UserIPLimitLogin) Reset() {
+	*x = UserIPLimitLogin{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_admin_admin_proto_msgTypes[86]
+		mi := &file_admin_admin_proto_msgTypes[87]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AddAppletResp) String() string {
+func (x *UserIPLimitLogin) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddAppletResp) ProtoMessage() {}
+func (*UserIPLimitLogin) ProtoMessage() {}
 
-func (x *AddAppletResp) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_admin_proto_msgTypes[86]
+func (x *UserIPLimitLogin) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_admin_proto_msgTypes[87]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4599,36 +4971,50 @@ func (x *AddAppletResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddAppletResp.ProtoReflect.Descriptor instead.
-func (*AddAppletResp) Descriptor() ([]byte, []int) {
-	return file_admin_admin_proto_rawDescGZIP(), []int{86}
+// Deprecated: Use UserIPLimitLogin.ProtoReflect.Descriptor instead.
+func (*UserIPLimitLogin) Descriptor() ([]byte, []int) {
+	return file_admin_admin_proto_rawDescGZIP(), []int{87}
 }
 
-type DelAppletReq struct {
+func (x *UserIPLimitLogin) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *UserIPLimitLogin) GetIp() string {
+	if x != nil {
+		return x.Ip
+	}
+	return ""
+}
+
+type AddUserIPLimitLoginReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	AppletIds []string `protobuf:"bytes,1,rep,name=appletIds,proto3" json:"appletIds"`
+	Limits []*UserIPLimitLogin `protobuf:"bytes,1,rep,name=limits,proto3" json:"limits"`
 }
 
-func (x *DelAppletReq) Reset() {
-	*x = DelAppletReq{}
+func (x *AddUserIPLimitLoginReq) Reset() {
+	*x = AddUserIPLimitLoginReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_admin_admin_proto_msgTypes[87]
+		mi := &file_admin_admin_proto_msgTypes[88]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DelAppletReq) String() string {
+func (x *AddUserIPLimitLoginReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DelAppletReq) ProtoMessage() {}
+func (*AddUserIPLimitLoginReq) ProtoMessage() {}
 
-func (x *DelAppletReq) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_admin_proto_msgTypes[87]
+func (x *AddUserIPLimitLoginReq) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_admin_proto_msgTypes[88]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4639,41 +5025,41 @@ func (x *DelAppletReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DelAppletReq.ProtoReflect.Descriptor instead.
-func (*DelAppletReq) Descriptor() ([]byte, []int) {
-	return file_admin_admin_proto_rawDescGZIP(), []int{87}
+// Deprecated: Use AddUserIPLimitLoginReq.ProtoReflect.Descriptor instead.
+func (*AddUserIPLimitLoginReq) Descriptor() ([]byte, []int) {
+	return file_admin_admin_proto_rawDescGZIP(), []int{88}
 }
 
-func (x *DelAppletReq) GetAppletIds() []string {
+func (x *AddUserIPLimitLoginReq) GetLimits() []*UserIPLimitLogin {
 	if x != nil {
-		return x.AppletIds
+		return x.Limits
 	}
 	return nil
 }
 
-type DelAppletResp struct {
+type AddUserIPLimitLoginResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *DelAppletResp) Reset() {
-	*x = DelAppletResp{}
+func (x *AddUserIPLimitLoginResp) Reset() {
+	*x = AddUserIPLimitLoginResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_admin_admin_proto_msgTypes[88]
+		mi := &file_admin_admin_proto_msgTypes[89]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DelAppletResp) String() string {
+func (x *AddUserIPLimitLoginResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DelAppletResp) ProtoMessage() {}
+func (*AddUserIPLimitLoginResp) ProtoMessage() {}
 
-func (x *DelAppletResp) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_admin_proto_msgTypes[88]
+func (x *AddUserIPLimitLoginResp) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_admin_proto_msgTypes[89]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4684,46 +5070,36 @@ func (x *DelAppletResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DelAppletResp.ProtoReflect.Descriptor instead.
-func (*DelAppletResp) Descriptor() ([]byte, []int) {
-	return file_admin_admin_proto_rawDescGZIP(), []int{88}
+// Deprecated: Use AddUserIPLimitLoginResp.ProtoReflect.Descriptor instead.
+func (*AddUserIPLimitLoginResp) Descriptor() ([]byte, []int) {
+	return file_admin_admin_proto_rawDescGZIP(), []int{89}
 }
 
-type UpdateAppletReq struct {
+type DelUserIPLimitLoginReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id         string                  `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
-	Name       *wrapperspb.StringValue `protobuf:"bytes,2,opt,name=name,proto3" json:"name"`
-	AppID      *wrapperspb.StringValue `protobuf:"bytes,3,opt,name=appID,proto3" json:"appID"`
-	Icon       *wrapperspb.StringValue `protobuf:"bytes,4,opt,name=icon,proto3" json:"icon"`
-	Url        *wrapperspb.StringValue `protobuf:"bytes,5,opt,name=url,proto3" json:"url"`
-	Md5        *wrapperspb.StringValue `protobuf:"bytes,6,opt,name=md5,proto3" json:"md5"`
-	Size       *wrapperspb.Int64Value  `protobuf:"bytes,7,opt,name=size,proto3" json:"size"`
-	Version    *wrapperspb.StringValue `protobuf:"bytes,8,opt,name=version,proto3" json:"version"`
-	Priority   *wrapperspb.UInt32Value `protobuf:"bytes,9,opt,name=priority,proto3" json:"priority"`
-	Status     *wrapperspb.UInt32Value `protobuf:"bytes,10,opt,name=status,proto3" json:"status"`
-	CreateTime *wrapperspb.Int64Value  `protobuf:"bytes,11,opt,name=createTime,proto3" json:"createTime"`
+	Limits []*UserIPLimitLogin `protobuf:"bytes,1,rep,name=limits,proto3" json:"limits"`
 }
 
-func (x *UpdateAppletReq) Reset() {
-	*x = UpdateAppletReq{}
+func (x *DelUserIPLimitLoginReq) Reset() {
+	*x = DelUserIPLimitLoginReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_admin_admin_proto_msgTypes[89]
+		mi := &file_admin_admin_proto_msgTypes[90]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UpdateAppletReq) String() string {
+func (x *DelUserIPLimitLoginReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateAppletReq) ProtoMessage() {}
+func (*DelUserIPLimitLoginReq) ProtoMessage() {}
 
-func (x *UpdateAppletReq) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_admin_proto_msgTypes[89]
+func (x *DelUserIPLimitLoginReq) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_admin_proto_msgTypes[90]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4734,111 +5110,41 @@ func (x *UpdateAppletReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateAppletReq.ProtoReflect.Descriptor instead.
-func (*UpdateAppletReq) Descriptor() ([]byte, []int) {
-	return file_admin_admin_proto_rawDescGZIP(), []int{89}
-}
-
-func (x *UpdateAppletReq) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
-}
-
-func (x *UpdateAppletReq) GetName() *wrapperspb.StringValue {
-	if x != nil {
-		return x.Name
-	}
-	return nil
-}
-
-func (x *UpdateAppletReq) GetAppID() *wrapperspb.StringValue {
-	if x != nil {
-		return x.AppID
-	}
-	return nil
-}
-
-func (x *UpdateAppletReq) GetIcon() *wrapperspb.StringValue {
-	if x != nil {
-		return x.Icon
-	}
-	return nil
-}
-
-func (x *UpdateAppletReq) GetUrl() *wrapperspb.StringValue {
-	if x != nil {
-		return x.Url
-	}
-	return nil
-}
-
-func (x *UpdateAppletReq) GetMd5() *wrapperspb.StringValue {
-	if x != nil {
-		return x.Md5
-	}
-	return nil
-}
-
-func (x *UpdateAppletReq) GetSize() *wrapperspb.Int64Value {
-	if x != nil {
-		return x.Size
-	}
-	return nil
-}
-
-func (x *UpdateAppletReq) GetVersion() *wrapperspb.StringValue {
-	if x != nil {
-		return x.Version
-	}
-	return nil
-}
-
-func (x *UpdateAppletReq) GetPriority() *wrapperspb.UInt32Value {
-	if x != nil {
-		return x.Priority
-	}
-	return nil
-}
-
-func (x *UpdateAppletReq) GetStatus() *wrapperspb.UInt32Value {
-	if x != nil {
-		return x.Status
-	}
-	return nil
+// Deprecated: Use DelUserIPLimitLoginReq.ProtoReflect.Descriptor instead.
+func (*DelUserIPLimitLoginReq) Descriptor() ([]byte, []int) {
+	return file_admin_admin_proto_rawDescGZIP(), []int{90}
 }
 
-func (x *UpdateAppletReq) GetCreateTime() *wrapperspb.Int64Value {
+func (x *DelUserIPLimitLoginReq) GetLimits() []*UserIPLimitLogin {
 	if x != nil {
-		return x.CreateTime
+		return x.Limits
 	}
 	return nil
 }
 
-type UpdateAppletResp struct {
+type DelUserIPLimitLoginResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *UpdateAppletResp) Reset() {
-	*x = UpdateAppletResp{}
+func (x *DelUserIPLimitLoginResp) Reset() {
+	*x = DelUserIPLimitLoginResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_admin_admin_proto_msgTypes[90]
+		mi := &file_admin_admin_proto_msgTypes[91]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UpdateAppletResp) String() string {
+func (x *DelUserIPLimitLoginResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateAppletResp) ProtoMessage() {}
+func (*DelUserIPLimitLoginResp) ProtoMessage() {}
 
-func (x *UpdateAppletResp) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_admin_proto_msgTypes[90]
+func (x *DelUserIPLimitLoginResp) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_admin_proto_msgTypes[91]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4849,34 +5155,40 @@ func (x *UpdateAppletResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateAppletResp.ProtoReflect.Descriptor instead.
-func (*UpdateAppletResp) Descriptor() ([]byte, []int) {
-	return file_admin_admin_proto_rawDescGZIP(), []int{90}
+// Deprecated: Use DelUserIPLimitLoginResp.ProtoReflect.Descriptor instead.
+func (*DelUserIPLimitLoginResp) Descriptor() ([]byte, []int) {
+	return file_admin_admin_proto_rawDescGZIP(), []int{91}
 }
 
-type FindAppletReq struct {
+type IPForbidden struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// address, CIDR prefix or address range
+	Ip            string `protobuf:"bytes,1,opt,name=ip,proto3" json:"ip"`
+	LimitRegister bool   `protobuf:"varint,2,opt,name=limitRegister,proto3" json:"limitRegister"`
+	LimitLogin    bool   `protobuf:"varint,3,opt,name=limitLogin,proto3" json:"limitLogin"`
+	CreateTime    int64  `protobuf:"varint,4,opt,name=createTime,proto3" json:"createTime"`
 }
 
-func (x *FindAppletReq) Reset() {
-	*x = FindAppletReq{}
+func (x *IPForbidden) Reset() {
+	*x = IPForbidden{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_admin_admin_proto_msgTypes[91]
+		mi := &file_admin_admin_proto_msgTypes[92]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *FindAppletReq) String() string {
+func (x *IPForbidden) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FindAppletReq) ProtoMessage() {}
+func (*IPForbidden) ProtoMessage() {}
 
-func (x *FindAppletReq) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_admin_proto_msgTypes[91]
+func (x *IPForbidden) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_admin_proto_msgTypes[92]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4887,36 +5199,66 @@ func (x *FindAppletReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FindAppletReq.ProtoReflect.Descriptor instead.
-func (*FindAppletReq) Descriptor() ([]byte, []int) {
-	return file_admin_admin_proto_rawDescGZIP(), []int{91}
+// Deprecated: Use IPForbidden.ProtoReflect.Descriptor instead.
+func (*IPForbidden) Descriptor() ([]byte, []int) {
+	return file_admin_admin_proto_rawDescGZIP(), []int{92}
 }
 
-type FindAppletResp struct {
+func (x *IPForbidden) GetIp() string {
+	if x != nil {
+		return x.Ip
+	}
+	return ""
+}
+
+func (x *IPForbidden) GetLimitRegister() bool {
+	if x != nil {
+		return x.LimitRegister
+	}
+	return false
+}
+
+func (x *IPForbidden) GetLimitLogin() bool {
+	if x != nil {
+		return x.LimitLogin
+	}
+	return false
+}
+
+func (x *IPForbidden) GetCreateTime() int64 {
+	if x != nil {
+		return x.CreateTime
+	}
+	return 0
+}
+
+type IPForbiddenAdd struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Applets []*common.AppletInfo `protobuf:"bytes,1,rep,name=applets,proto3" json:"applets"`
+	Ip            string `protobuf:"bytes,1,opt,name=ip,proto3" json:"ip"`
+	LimitRegister bool   `protobuf:"varint,2,opt,name=limitRegister,proto3" json:"limitRegister"`
+	LimitLogin    bool   `protobuf:"varint,3,opt,name=limitLogin,proto3" json:"limitLogin"`
 }
 
-func (x *FindAppletResp) Reset() {
-	*x = FindAppletResp{}
+func (x *IPForbiddenAdd) Reset() {
+	*x = IPForbiddenAdd{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_admin_admin_proto_msgTypes[92]
+		mi := &file_admin_admin_proto_msgTypes[93]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *FindAppletResp) String() string {
+func (x *IPForbiddenAdd) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FindAppletResp) ProtoMessage() {}
+func (*IPForbiddenAdd) ProtoMessage() {}
 
-func (x *FindAppletResp) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_admin_proto_msgTypes[92]
+func (x *IPForbiddenAdd) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_admin_proto_msgTypes[93]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4927,44 +5269,61 @@ func (x *FindAppletResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FindAppletResp.ProtoReflect.Descriptor instead.
-func (*FindAppletResp) Descriptor() ([]byte, []int) {
-	return file_admin_admin_proto_rawDescGZIP(), []int{92}
+// Deprecated: Use IPForbiddenAdd.ProtoReflect.Descriptor instead.
+func (*IPForbiddenAdd) Descriptor() ([]byte, []int) {
+	return file_admin_admin_proto_rawDescGZIP(), []int{93}
 }
 
-func (x *FindAppletResp) GetApplets() []*common.AppletInfo {
+func (x *IPForbiddenAdd) GetIp() string {
 	if x != nil {
-		return x.Applets
+		return x.Ip
 	}
-	return nil
+	return ""
 }
 
-type SearchAppletReq struct {
+func (x *IPForbiddenAdd) GetLimitRegister() bool {
+	if x != nil {
+		return x.LimitRegister
+	}
+	return false
+}
+
+func (x *IPForbiddenAdd) GetLimitLogin() bool {
+	if x != nil {
+		return x.LimitLogin
+	}
+	return false
+}
+
+type SearchIPForbiddenReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	Keyword    string                    `protobuf:"bytes,1,opt,name=keyword,proto3" json:"keyword"`
-	Pagination *sdkwss.RequestPagination `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination"`
+	Status     int32                     `protobuf:"varint,2,opt,name=status,proto3" json:"status"`
+	Pagination *sdkwss.RequestPagination `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination"`
+	// if set, only the rules containing this ip, the most specific first
+	Ip string `protobuf:"bytes,4,opt,name=ip,proto3" json:"ip"`
 }
 
-func (x *SearchAppletReq) Reset() {
-	*x = SearchAppletReq{}
+func (x *SearchIPForbiddenReq) Reset() {
+	*x = SearchIPForbiddenReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_admin_admin_proto_msgTypes[93]
+		mi := &file_admin_admin_proto_msgTypes[94]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SearchAppletReq) String() string {
+func (x *SearchIPForbiddenReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SearchAppletReq) ProtoMessage() {}
+func (*SearchIPForbiddenReq) ProtoMessage() {}
 
-func (x *SearchAppletReq) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_admin_proto_msgTypes[93]
+func (x *SearchIPForbiddenReq) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_admin_proto_msgTypes[94]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4975,90 +5334,50 @@ func (x *SearchAppletReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SearchAppletReq.ProtoReflect.Descriptor instead.
-func (*SearchAppletReq) Descriptor() ([]byte, []int) {
-	return file_admin_admin_proto_rawDescGZIP(), []int{93}
+// Deprecated: Use SearchIPForbiddenReq.ProtoReflect.Descriptor instead.
+func (*SearchIPForbiddenReq) Descriptor() ([]byte, []int) {
+	return file_admin_admin_proto_rawDescGZIP(), []int{94}
 }
 
-func (x *SearchAppletReq) GetKeyword() string {
+func (x *SearchIPForbiddenReq) GetKeyword() string {
 	if x != nil {
 		return x.Keyword
 	}
 	return ""
 }
 
-func (x *SearchAppletReq) GetPagination() *sdkwss.RequestPagination {
+func (x *SearchIPForbiddenReq) GetStatus() int32 {
 	if x != nil {
-		return x.Pagination
-	}
-	return nil
-}
-
-type SearchAppletResp struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Total   uint32               `protobuf:"varint,1,opt,name=total,proto3" json:"total"`
-	Applets []*common.AppletInfo `protobuf:"bytes,2,rep,name=applets,proto3" json:"applets"`
-}
-
-func (x *SearchAppletResp) Reset() {
-	*x = SearchAppletResp{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_admin_admin_proto_msgTypes[94]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *SearchAppletResp) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*SearchAppletResp) ProtoMessage() {}
-
-func (x *SearchAppletResp) ProtoReflect() protoreflect.Message {
-	mi := &file_admin_admin_proto_msgTypes[94]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Status
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use SearchAppletResp.ProtoReflect.Descriptor instead.
-func (*SearchAppletResp) Descriptor() ([]byte, []int) {
-	return file_admin_admin_proto_rawDescGZIP(), []int{94}
+	return 0
 }
 
-func (x *SearchAppletResp) GetTotal() uint32 {
+func (x *SearchIPForbiddenReq) GetPagination() *sdkwss.RequestPagination {
 	if x != nil {
-		return x.Total
+		return x.Pagination
 	}
-	return 0
+	return nil
 }
 
-func (x *SearchAppletResp) GetApplets() []*common.AppletInfo {
+func (x *SearchIPForbiddenReq) GetIp() string {
 	if x != nil {
-		return x.Applets
+		return x.Ip
 	}
-	return nil
+	return ""
 }
 
-type SetClientConfigReq struct {
+type SearchIPForbiddenResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Config map[string]string `protobuf:"bytes,1,rep,name=config,proto3" json:"config,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Total      uint32         `protobuf:"varint,1,opt,name=total,proto3" json:"total"`
+	Forbiddens []*IPForbidden `protobuf:"bytes,2,rep,name=forbiddens,proto3" json:"forbiddens"`
 }
 
-func (x *SetClientConfigReq) Reset() {
-	*x = SetClientConfigReq{}
+func (x *SearchIPForbiddenResp) Reset() {
+	*x = SearchIPForbiddenResp{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_admin_admin_proto_msgTypes[95]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5066,13 +5385,13 @@ func (x *SetClientConfigReq) Reset() {
 	}
 }
 
-func (x *SetClientConfigReq) String() string {
+func (x *SearchIPForbiddenResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetClientConfigReq) ProtoMessage() {}
+func (*SearchIPForbiddenResp) ProtoMessage() {}
 
-func (x *SetClientConfigReq) ProtoReflect() protoreflect.Message {
+func (x *SearchIPForbiddenResp) ProtoReflect() protoreflect.Message {
 	mi := &file_admin_admin_proto_msgTypes[95]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5084,26 +5403,35 @@ func (x *SetClientConfigReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetClientConfigReq.ProtoReflect.Descriptor instead.
-func (*SetClientConfigReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use SearchIPForbiddenResp.ProtoReflect.Descriptor instead.
+func (*SearchIPForbiddenResp) Descriptor() ([]byte, []int) {
 	return file_admin_admin_proto_rawDescGZIP(), []int{95}
 }
 
-func (x *SetClientConfigReq) GetConfig() map[string]string {
+func (x *SearchIPForbiddenResp) GetTotal() uint32 {
 	if x != nil {
-		return x.Config
+		return x.Total
+	}
+	return 0
+}
+
+func (x *SearchIPForbiddenResp) GetForbiddens() []*IPForbidden {
+	if x != nil {
+		return x.Forbiddens
 	}
 	return nil
 }
 
-type SetClientConfigResp struct {
+type AddIPForbiddenReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Forbiddens []*IPForbiddenAdd `protobuf:"bytes,1,rep,name=forbiddens,proto3" json:"forbiddens"`
 }
 
-func (x *SetClientConfigResp) Reset() {
-	*x = SetClientConfigResp{}
+func (x *AddIPForbiddenReq) Reset() {
+	*x = AddIPForbiddenReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_admin_admin_proto_msgTypes[96]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5111,13 +5439,13 @@ func (x *SetClientConfigResp) Reset() {
 	}
 }
 
-func (x *SetClientConfigResp) String() string {
+func (x *AddIPForbiddenReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetClientConfigResp) ProtoMessage() {}
+func (*AddIPForbiddenReq) ProtoMessage() {}
 
-func (x *SetClientConfigResp) ProtoReflect() protoreflect.Message {
+func (x *AddIPForbiddenReq) ProtoReflect() protoreflect.Message {
 	mi := &file_admin_admin_proto_msgTypes[96]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5129,21 +5457,26 @@ func (x *SetClientConfigResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetClientConfigResp.ProtoReflect.Descriptor instead.
-func (*SetClientConfigResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use AddIPForbiddenReq.ProtoReflect.Descriptor instead.
+func (*AddIPForbiddenReq) Descriptor() ([]byte, []int) {
 	return file_admin_admin_proto_rawDescGZIP(), []int{96}
 }
 
-type DelClientConfigReq struct {
+func (x *AddIPForbiddenReq) GetForbiddens() []*IPForbiddenAdd {
+	if x != nil {
+		return x.Forbiddens
+	}
+	return nil
+}
+
+type AddIPForbiddenResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Keys []string `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys"`
 }
 
-func (x *DelClientConfigReq) Reset() {
-	*x = DelClientConfigReq{}
+func (x *AddIPForbiddenResp) Reset() {
+	*x = AddIPForbiddenResp{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_admin_admin_proto_msgTypes[97]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5151,13 +5484,13 @@ func (x *DelClientConfigReq) Reset() {
 	}
 }
 
-func (x *DelClientConfigReq) String() string {
+func (x *AddIPForbiddenResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DelClientConfigReq) ProtoMessage() {}
+func (*AddIPForbiddenResp) ProtoMessage() {}
 
-func (x *DelClientConfigReq) ProtoReflect() protoreflect.Message {
+func (x *AddIPForbiddenResp) ProtoReflect() protoreflect.Message {
 	mi := &file_admin_admin_proto_msgTypes[97]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5169,26 +5502,21 @@ func (x *DelClientConfigReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DelClientConfigReq.ProtoReflect.Descriptor instead.
-func (*DelClientConfigReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use AddIPForbiddenResp.ProtoReflect.Descriptor instead.
+func (*AddIPForbiddenResp) Descriptor() ([]byte, []int) {
 	return file_admin_admin_proto_rawDescGZIP(), []int{97}
 }
 
-func (x *DelClientConfigReq) GetKeys() []string {
-	if x != nil {
-		return x.Keys
-	}
-	return nil
-}
-
-type DelClientConfigResp struct {
+type DelIPForbiddenReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Ips []string `protobuf:"bytes,1,rep,name=ips,proto3" json:"ips"`
 }
 
-func (x *DelClientConfigResp) Reset() {
-	*x = DelClientConfigResp{}
+func (x *DelIPForbiddenReq) Reset() {
+	*x = DelIPForbiddenReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_admin_admin_proto_msgTypes[98]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5196,13 +5524,13 @@ func (x *DelClientConfigResp) Reset() {
 	}
 }
 
-func (x *DelClientConfigResp) String() string {
+func (x *DelIPForbiddenReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DelClientConfigResp) ProtoMessage() {}
+func (*DelIPForbiddenReq) ProtoMessage() {}
 
-func (x *DelClientConfigResp) ProtoReflect() protoreflect.Message {
+func (x *DelIPForbiddenReq) ProtoReflect() protoreflect.Message {
 	mi := &file_admin_admin_proto_msgTypes[98]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5214,19 +5542,26 @@ func (x *DelClientConfigResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DelClientConfigResp.ProtoReflect.Descriptor instead.
-func (*DelClientConfigResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use DelIPForbiddenReq.ProtoReflect.Descriptor instead.
+func (*DelIPForbiddenReq) Descriptor() ([]byte, []int) {
 	return file_admin_admin_proto_rawDescGZIP(), []int{98}
 }
 
-type GetClientConfigReq struct {
+func (x *DelIPForbiddenReq) GetIps() []string {
+	if x != nil {
+		return x.Ips
+	}
+	return nil
+}
+
+type DelIPForbiddenResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *GetClientConfigReq) Reset() {
-	*x = GetClientConfigReq{}
+func (x *DelIPForbiddenResp) Reset() {
+	*x = DelIPForbiddenResp{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_admin_admin_proto_msgTypes[99]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5234,13 +5569,13 @@ func (x *GetClientConfigReq) Reset() {
 	}
 }
 
-func (x *GetClientConfigReq) String() string {
+func (x *DelIPForbiddenResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetClientConfigReq) ProtoMessage() {}
+func (*DelIPForbiddenResp) ProtoMessage() {}
 
-func (x *GetClientConfigReq) ProtoReflect() protoreflect.Message {
+func (x *DelIPForbiddenResp) ProtoReflect() protoreflect.Message {
 	mi := &file_admin_admin_proto_msgTypes[99]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5252,21 +5587,22 @@ func (x *GetClientConfigReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetClientConfigReq.ProtoReflect.Descriptor instead.
-func (*GetClientConfigReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use DelIPForbiddenResp.ProtoReflect.Descriptor instead.
+func (*DelIPForbiddenResp) Descriptor() ([]byte, []int) {
 	return file_admin_admin_proto_rawDescGZIP(), []int{99}
 }
 
-type GetClientConfigResp struct {
+// ################### User Limit ###################
+type CheckRegisterForbiddenReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Config map[string]string `protobuf:"bytes,1,rep,name=config,proto3" json:"config,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Ip string `protobuf:"bytes,1,opt,name=ip,proto3" json:"ip"`
 }
 
-func (x *GetClientConfigResp) Reset() {
-	*x = GetClientConfigResp{}
+func (x *CheckRegisterForbiddenReq) Reset() {
+	*x = CheckRegisterForbiddenReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_admin_admin_proto_msgTypes[100]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5274,13 +5610,13 @@ func (x *GetClientConfigResp) Reset() {
 	}
 }
 
-func (x *GetClientConfigResp) String() string {
+func (x *CheckRegisterForbiddenReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetClientConfigResp) ProtoMessage() {}
+func (*CheckRegisterForbiddenReq) ProtoMessage() {}
 
-func (x *GetClientConfigResp) ProtoReflect() protoreflect.Message {
+func (x *CheckRegisterForbiddenReq) ProtoReflect() protoreflect.Message {
 	mi := &file_admin_admin_proto_msgTypes[100]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5292,28 +5628,26 @@ func (x *GetClientConfigResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetClientConfigResp.ProtoReflect.Descriptor instead.
-func (*GetClientConfigResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use CheckRegisterForbiddenReq.ProtoReflect.Descriptor instead.
+func (*CheckRegisterForbiddenReq) Descriptor() ([]byte, []int) {
 	return file_admin_admin_proto_rawDescGZIP(), []int{100}
 }
 
-func (x *GetClientConfigResp) GetConfig() map[string]string {
+func (x *CheckRegisterForbiddenReq) GetIp() string {
 	if x != nil {
-		return x.Config
+		return x.Ip
 	}
-	return nil
+	return ""
 }
 
-type GetUserTokenReq struct {
+type CheckRegisterForbiddenResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	UserID string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
 }
 
-func (x *GetUserTokenReq) Reset() {
-	*x = GetUserTokenReq{}
+func (x *CheckRegisterForbiddenResp) Reset() {
+	*x = CheckRegisterForbiddenResp{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_admin_admin_proto_msgTypes[101]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5321,13 +5655,13 @@ func (x *GetUserTokenReq) Reset() {
 	}
 }
 
-func (x *GetUserTokenReq) String() string {
+func (x *CheckRegisterForbiddenResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserTokenReq) ProtoMessage() {}
+func (*CheckRegisterForbiddenResp) ProtoMessage() {}
 
-func (x *GetUserTokenReq) ProtoReflect() protoreflect.Message {
+func (x *CheckRegisterForbiddenResp) ProtoReflect() protoreflect.Message {
 	mi := &file_admin_admin_proto_msgTypes[101]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5339,28 +5673,22 @@ func (x *GetUserTokenReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserTokenReq.ProtoReflect.Descriptor instead.
-func (*GetUserTokenReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use CheckRegisterForbiddenResp.ProtoReflect.Descriptor instead.
+func (*CheckRegisterForbiddenResp) Descriptor() ([]byte, []int) {
 	return file_admin_admin_proto_rawDescGZIP(), []int{101}
 }
 
-func (x *GetUserTokenReq) GetUserID() string {
-	if x != nil {
-		return x.UserID
-	}
-	return ""
-}
-
-type GetUserTokenResp struct {
+type CheckLoginForbiddenReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TokensMap map[string]int32 `protobuf:"bytes,1,rep,name=tokensMap,proto3" json:"tokensMap,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	Ip     string `protobuf:"bytes,1,opt,name=ip,proto3" json:"ip"`
+	UserID string `protobuf:"bytes,2,opt,name=userID,proto3" json:"userID"`
 }
 
-func (x *GetUserTokenResp) Reset() {
-	*x = GetUserTokenResp{}
+func (x *CheckLoginForbiddenReq) Reset() {
+	*x = CheckLoginForbiddenReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_admin_admin_proto_msgTypes[102]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5368,13 +5696,13 @@ func (x *GetUserTokenResp) Reset() {
 	}
 }
 
-func (x *GetUserTokenResp) String() string {
+func (x *CheckLoginForbiddenReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserTokenResp) ProtoMessage() {}
+func (*CheckLoginForbiddenReq) ProtoMessage() {}
 
-func (x *GetUserTokenResp) ProtoReflect() protoreflect.Message {
+func (x *CheckLoginForbiddenReq) ProtoReflect() protoreflect.Message {
 	mi := &file_admin_admin_proto_msgTypes[102]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5386,1092 +5714,5286 @@ func (x *GetUserTokenResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserTokenResp.ProtoReflect.Descriptor instead.
-func (*GetUserTokenResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use CheckLoginForbiddenReq.ProtoReflect.Descriptor instead.
+func (*CheckLoginForbiddenReq) Descriptor() ([]byte, []int) {
 	return file_admin_admin_proto_rawDescGZIP(), []int{102}
 }
 
-func (x *GetUserTokenResp) GetTokensMap() map[string]int32 {
+func (x *CheckLoginForbiddenReq) GetIp() string {
 	if x != nil {
-		return x.TokensMap
+		return x.Ip
 	}
-	return nil
+	return ""
 }
 
-var File_admin_admin_proto protoreflect.FileDescriptor
+func (x *CheckLoginForbiddenReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
 
-var file_admin_admin_proto_rawDesc = []byte{
-	0x0a, 0x11, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x12, 0x0c, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61, 0x64, 0x6d, 0x69,
-	0x6e, 0x1a, 0x13, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x13, 0x73, 0x64, 0x6b, 0x77, 0x73, 0x73, 0x2f, 0x73,
-	0x64, 0x6b, 0x77, 0x73, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x77, 0x72, 0x61,
-	0x70, 0x70, 0x65, 0x72, 0x73, 0x70, 0x62, 0x2f, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73,
-	0x70, 0x62, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x40, 0x0a, 0x08, 0x4c, 0x6f, 0x67, 0x69,
-	0x6e, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1a,
-	0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0xbd, 0x01, 0x0a, 0x09, 0x4c,
-	0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x22, 0x0a, 0x0c, 0x61, 0x64, 0x6d, 0x69,
-	0x6e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
-	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1e, 0x0a, 0x0a,
-	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0a, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1a, 0x0a, 0x08,
-	0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
-	0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x66, 0x61, 0x63, 0x65,
-	0x55, 0x52, 0x4c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x66, 0x61, 0x63, 0x65, 0x55,
-	0x52, 0x4c, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x64, 0x6d, 0x69,
-	0x6e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61,
-	0x64, 0x6d, 0x69, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x22, 0x80, 0x01, 0x0a, 0x12, 0x41,
-	0x64, 0x64, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65,
-	0x71, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70,
-	0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70,
-	0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x66, 0x61, 0x63, 0x65, 0x55,
-	0x52, 0x4c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x66, 0x61, 0x63, 0x65, 0x55, 0x52,
-	0x4c, 0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x15, 0x0a,
-	0x13, 0x41, 0x64, 0x64, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x52, 0x65, 0x73, 0x70, 0x22, 0xab, 0x02, 0x0a, 0x12, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x12, 0x36, 0x0a, 0x07, 0x61,
-	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6f,
-	0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53,
-	0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x12, 0x38, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61,
-	0x6c, 0x75, 0x65, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x36, 0x0a,
-	0x07, 0x66, 0x61, 0x63, 0x65, 0x55, 0x52, 0x4c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c,
-	0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x07, 0x66, 0x61,
-	0x63, 0x65, 0x55, 0x52, 0x4c, 0x12, 0x38, 0x0a, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
-	0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x12,
-	0x31, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b,
-	0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x6c, 0x65, 0x76,
-	0x65, 0x6c, 0x22, 0x63, 0x0a, 0x13, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65,
-	0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
-	0x44, 0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a,
-	0x07, 0x66, 0x61, 0x63, 0x65, 0x55, 0x52, 0x4c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
-	0x66, 0x61, 0x63, 0x65, 0x55, 0x52, 0x4c, 0x22, 0x2f, 0x0a, 0x11, 0x43, 0x68, 0x61, 0x6e, 0x67,
-	0x65, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x12, 0x1a, 0x0a, 0x08,
-	0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
-	0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x14, 0x0a, 0x12, 0x43, 0x68, 0x61, 0x6e,
-	0x67, 0x65, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x22, 0x11,
-	0x0a, 0x0f, 0x47, 0x65, 0x74, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65,
-	0x71, 0x22, 0x7c, 0x0a, 0x16, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x41, 0x64, 0x6d, 0x69, 0x6e,
-	0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x75,
-	0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65,
-	0x72, 0x49, 0x44, 0x12, 0x28, 0x0a, 0x0f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x50, 0x61,
-	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x75,
-	0x72, 0x72, 0x65, 0x6e, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x20, 0x0a,
-	0x0b, 0x6e, 0x65, 0x77, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0b, 0x6e, 0x65, 0x77, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22,
-	0x19, 0x0a, 0x17, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x50, 0x61,
-	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x22, 0x2e, 0x0a, 0x12, 0x44, 0x65,
-	0x6c, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71,
-	0x12, 0x18, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x09, 0x52, 0x07, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x22, 0x15, 0x0a, 0x13, 0x44, 0x65,
-	0x6c, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73,
-	0x70, 0x22, 0x59, 0x0a, 0x15, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x41, 0x64, 0x6d, 0x69, 0x6e,
-	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x12, 0x40, 0x0a, 0x0a, 0x70, 0x61,
-	0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20,
-	0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x73, 0x64, 0x6b, 0x77, 0x73, 0x73, 0x2e, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x74, 0x0a, 0x16,
-	0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x41, 0x63, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x44, 0x0a, 0x0d,
-	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61, 0x64, 0x6d,
-	0x69, 0x6e, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52,
-	0x65, 0x73, 0x70, 0x52, 0x0d, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x73, 0x22, 0xcc, 0x01, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x49,
-	0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x18, 0x0a,
-	0x07, 0x66, 0x61, 0x63, 0x65, 0x55, 0x52, 0x4c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
-	0x66, 0x61, 0x63, 0x65, 0x55, 0x52, 0x4c, 0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e,
-	0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x6c,
-	0x65, 0x76, 0x65, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65,
-	0x6c, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18,
-	0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d,
-	0x65, 0x22, 0x2f, 0x0a, 0x13, 0x41, 0x64, 0x64, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x46,
-	0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72,
-	0x49, 0x44, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x75, 0x73, 0x65, 0x72, 0x49,
-	0x44, 0x73, 0x22, 0x16, 0x0a, 0x14, 0x41, 0x64, 0x64, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74,
-	0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x22, 0x2f, 0x0a, 0x13, 0x44, 0x65,
-	0x6c, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65,
-	0x71, 0x12, 0x18, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x09, 0x52, 0x07, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x22, 0x16, 0x0a, 0x14, 0x44,
-	0x65, 0x6c, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52,
-	0x65, 0x73, 0x70, 0x22, 0x16, 0x0a, 0x14, 0x46, 0x69, 0x6e, 0x64, 0x44, 0x65, 0x66, 0x61, 0x75,
-	0x6c, 0x74, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x22, 0x31, 0x0a, 0x15, 0x46,
-	0x69, 0x6e, 0x64, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64,
-	0x52, 0x65, 0x73, 0x70, 0x12, 0x18, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x22, 0x74,
-	0x0a, 0x16, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x46,
-	0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x6b, 0x65, 0x79, 0x77,
-	0x6f, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6b, 0x65, 0x79, 0x77, 0x6f,
-	0x72, 0x64, 0x12, 0x40, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e,
-	0x73, 0x64, 0x6b, 0x77, 0x73, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x61,
-	0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x22, 0x83, 0x01, 0x0a, 0x16, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74,
-	0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x12,
-	0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x63, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x31, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63,
-	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63,
-	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x22, 0x6b, 0x0a, 0x17, 0x53, 0x65,
-	0x61, 0x72, 0x63, 0x68, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x46, 0x72, 0x69, 0x65, 0x6e,
-	0x64, 0x52, 0x65, 0x73, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x3a, 0x0a, 0x05, 0x75,
-	0x73, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6f, 0x70, 0x65,
-	0x6e, 0x69, 0x6d, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c,
-	0x74, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65,
-	0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x22, 0x30, 0x0a, 0x12, 0x41, 0x64, 0x64, 0x44, 0x65,
-	0x66, 0x61, 0x75, 0x6c, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x12, 0x1a, 0x0a,
-	0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52,
-	0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x73, 0x22, 0x15, 0x0a, 0x13, 0x41, 0x64, 0x64,
-	0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70,
-	0x22, 0x30, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x47, 0x72,
-	0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x12, 0x1a, 0x0a, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49,
-	0x44, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49,
-	0x44, 0x73, 0x22, 0x15, 0x0a, 0x13, 0x44, 0x65, 0x6c, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74,
-	0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x22, 0x15, 0x0a, 0x13, 0x46, 0x69, 0x6e,
-	0x64, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71,
-	0x22, 0x32, 0x0a, 0x14, 0x46, 0x69, 0x6e, 0x64, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x47,
-	0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x12, 0x1a, 0x0a, 0x08, 0x67, 0x72, 0x6f, 0x75,
-	0x70, 0x49, 0x44, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x67, 0x72, 0x6f, 0x75,
-	0x70, 0x49, 0x44, 0x73, 0x22, 0x73, 0x0a, 0x15, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x44, 0x65,
-	0x66, 0x61, 0x75, 0x6c, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a,
-	0x07, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
-	0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x40, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6f, 0x70,
-	0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x73, 0x64, 0x6b, 0x77, 0x73, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70,
-	0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x7a, 0x0a, 0x0e, 0x47, 0x72, 0x6f,
-	0x75, 0x70, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x67,
-	0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x72,
-	0x6f, 0x75, 0x70, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54,
-	0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x2e, 0x0a, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x73, 0x64,
-	0x6b, 0x77, 0x73, 0x73, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05,
-	0x67, 0x72, 0x6f, 0x75, 0x70, 0x22, 0x4a, 0x0a, 0x16, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x44,
-	0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x12,
-	0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05,
-	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44,
-	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44,
-	0x73, 0x22, 0x2c, 0x0a, 0x14, 0x41, 0x64, 0x64, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x64,
-	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x63, 0x6f, 0x64, 0x65, 0x73, 0x22,
-	0x17, 0x0a, 0x15, 0x41, 0x64, 0x64, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x22, 0x50, 0x0a, 0x14, 0x47, 0x65, 0x6e, 0x49,
-	0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71,
-	0x12, 0x10, 0x0a, 0x03, 0x6c, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x6c,
-	0x65, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x6e, 0x75, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x03, 0x6e, 0x75, 0x6d, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x68, 0x61, 0x72, 0x73, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x63, 0x68, 0x61, 0x72, 0x73, 0x22, 0x17, 0x0a, 0x15, 0x47, 0x65,
-	0x6e, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x52,
-	0x65, 0x73, 0x70, 0x22, 0x2d, 0x0a, 0x15, 0x46, 0x69, 0x6e, 0x64, 0x49, 0x6e, 0x76, 0x69, 0x74,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x12, 0x14, 0x0a, 0x05,
-	0x63, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x63, 0x6f, 0x64,
-	0x65, 0x73, 0x22, 0x50, 0x0a, 0x16, 0x46, 0x69, 0x6e, 0x64, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x36, 0x0a, 0x05,
-	0x63, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6f, 0x70,
-	0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x49, 0x6e, 0x76, 0x69, 0x74,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x05, 0x63,
-	0x6f, 0x64, 0x65, 0x73, 0x22, 0x42, 0x0a, 0x14, 0x55, 0x73, 0x65, 0x49, 0x6e, 0x76, 0x69, 0x74,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04,
-	0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65,
-	0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x22, 0x17, 0x0a, 0x15, 0x55, 0x73, 0x65, 0x49,
-	0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73,
-	0x70, 0x22, 0x2c, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x64,
-	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x63, 0x6f, 0x64, 0x65, 0x73, 0x22,
-	0x17, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x22, 0xb7, 0x01, 0x0a, 0x12, 0x49, 0x6e, 0x76,
-	0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x12,
-	0x26, 0x0a, 0x0e, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x63, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x75, 0x73, 0x65, 0x64, 0x55,
-	0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x75, 0x73, 0x65,
-	0x64, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x39, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x64, 0x55,
-	0x73, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6f, 0x70, 0x65, 0x6e,
-	0x69, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x50, 0x75,
-	0x62, 0x6c, 0x69, 0x63, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x08, 0x75, 0x73, 0x65, 0x64, 0x55, 0x73,
-	0x65, 0x72, 0x22, 0xbd, 0x01, 0x0a, 0x17, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x49, 0x6e, 0x76,
-	0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x12, 0x16,
-	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06,
-	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44,
-	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73,
-	0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52,
-	0x05, 0x63, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72,
-	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64,
-	0x12, 0x40, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x73, 0x64,
-	0x6b, 0x77, 0x73, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x61, 0x67, 0x69,
-	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x22, 0x66, 0x0a, 0x18, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x49, 0x6e, 0x76, 0x69,
-	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x14,
-	0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x74,
-	0x6f, 0x74, 0x61, 0x6c, 0x12, 0x34, 0x0a, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x02, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61, 0x64, 0x6d, 0x69,
-	0x6e, 0x2e, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x67, 0x69,
-	0x73, 0x74, 0x65, 0x72, 0x52, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x22, 0x77, 0x0a, 0x19, 0x53, 0x65,
-	0x61, 0x72, 0x63, 0x68, 0x55, 0x73, 0x65, 0x72, 0x49, 0x50, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x4c,
-	0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x6b, 0x65, 0x79, 0x77, 0x6f,
-	0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72,
-	0x64, 0x12, 0x40, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x73,
-	0x64, 0x6b, 0x77, 0x73, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x61, 0x67,
-	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x22, 0x8d, 0x01, 0x0a, 0x10, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x55, 0x73, 0x65,
-	0x72, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x49, 0x50, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72,
-	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44,
-	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x70,
-	0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65,
-	0x12, 0x31, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d,
-	0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x55,
-	0x73, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x75,
-	0x73, 0x65, 0x72, 0x22, 0x6a, 0x0a, 0x1a, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x55, 0x73, 0x65,
-	0x72, 0x49, 0x50, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x73,
-	0x70, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x36, 0x0a, 0x06, 0x6c, 0x69, 0x6d, 0x69, 0x74,
-	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d,
-	0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x55, 0x73, 0x65, 0x72,
-	0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x49, 0x50, 0x52, 0x06, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x22,
-	0x3a, 0x0a, 0x10, 0x55, 0x73, 0x65, 0x72, 0x49, 0x50, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x4c, 0x6f,
-	0x67, 0x69, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x0e, 0x0a, 0x02, 0x69,
-	0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x70, 0x22, 0x50, 0x0a, 0x16, 0x41,
-	0x64, 0x64, 0x55, 0x73, 0x65, 0x72, 0x49, 0x50, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x4c, 0x6f, 0x67,
-	0x69, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x36, 0x0a, 0x06, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61,
-	0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x50, 0x4c, 0x69, 0x6d, 0x69, 0x74,
-	0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x06, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x22, 0x19, 0x0a,
-	0x17, 0x41, 0x64, 0x64, 0x55, 0x73, 0x65, 0x72, 0x49, 0x50, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x4c,
-	0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x22, 0x50, 0x0a, 0x16, 0x44, 0x65, 0x6c, 0x55,
-	0x73, 0x65, 0x72, 0x49, 0x50, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52,
-	0x65, 0x71, 0x12, 0x36, 0x0a, 0x06, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61, 0x64, 0x6d, 0x69,
-	0x6e, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x50, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x4c, 0x6f, 0x67,
-	0x69, 0x6e, 0x52, 0x06, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x22, 0x19, 0x0a, 0x17, 0x44, 0x65,
-	0x6c, 0x55, 0x73, 0x65, 0x72, 0x49, 0x50, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x69,
-	0x6e, 0x52, 0x65, 0x73, 0x70, 0x22, 0x83, 0x01, 0x0a, 0x0b, 0x49, 0x50, 0x46, 0x6f, 0x72, 0x62,
-	0x69, 0x64, 0x64, 0x65, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x02, 0x69, 0x70, 0x12, 0x24, 0x0a, 0x0d, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x52, 0x65,
-	0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x6c, 0x69,
-	0x6d, 0x69, 0x74, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x6c,
-	0x69, 0x6d, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x0a, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x63,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x66, 0x0a, 0x0e, 0x49,
-	0x50, 0x46, 0x6f, 0x72, 0x62, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x41, 0x64, 0x64, 0x12, 0x0e, 0x0a,
-	0x02, 0x69, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x70, 0x12, 0x24, 0x0a,
-	0x0d, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x67, 0x69, 0x73,
-	0x74, 0x65, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x69,
-	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x4c, 0x6f,
-	0x67, 0x69, 0x6e, 0x22, 0x8a, 0x01, 0x0a, 0x14, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x49, 0x50,
-	0x46, 0x6f, 0x72, 0x62, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07,
-	0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6b,
-	0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x40,
-	0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x73, 0x64, 0x6b, 0x77,
-	0x73, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x22, 0x68, 0x0a, 0x15, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x49, 0x50, 0x46, 0x6f, 0x72, 0x62,
-	0x69, 0x64, 0x64, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74,
-	0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12,
-	0x39, 0x0a, 0x0a, 0x66, 0x6f, 0x72, 0x62, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x73, 0x18, 0x02, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61, 0x64, 0x6d,
-	0x69, 0x6e, 0x2e, 0x49, 0x50, 0x46, 0x6f, 0x72, 0x62, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x52, 0x0a,
-	0x66, 0x6f, 0x72, 0x62, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x73, 0x22, 0x51, 0x0a, 0x11, 0x41, 0x64,
-	0x64, 0x49, 0x50, 0x46, 0x6f, 0x72, 0x62, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x12,
-	0x3c, 0x0a, 0x0a, 0x66, 0x6f, 0x72, 0x62, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61, 0x64, 0x6d,
-	0x69, 0x6e, 0x2e, 0x49, 0x50, 0x46, 0x6f, 0x72, 0x62, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x41, 0x64,
-	0x64, 0x52, 0x0a, 0x66, 0x6f, 0x72, 0x62, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x73, 0x22, 0x14, 0x0a,
-	0x12, 0x41, 0x64, 0x64, 0x49, 0x50, 0x46, 0x6f, 0x72, 0x62, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x52,
-	0x65, 0x73, 0x70, 0x22, 0x25, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x49, 0x50, 0x46, 0x6f, 0x72, 0x62,
-	0x69, 0x64, 0x64, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x70, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x03, 0x69, 0x70, 0x73, 0x22, 0x14, 0x0a, 0x12, 0x44, 0x65,
-	0x6c, 0x49, 0x50, 0x46, 0x6f, 0x72, 0x62, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70,
-	0x22, 0x2b, 0x0a, 0x19, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65,
-	0x72, 0x46, 0x6f, 0x72, 0x62, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a,
-	0x02, 0x69, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x70, 0x22, 0x1c, 0x0a,
-	0x1a, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x46, 0x6f,
-	0x72, 0x62, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x22, 0x40, 0x0a, 0x16, 0x43,
-	0x68, 0x65, 0x63, 0x6b, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x46, 0x6f, 0x72, 0x62, 0x69, 0x64, 0x64,
-	0x65, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x02, 0x69, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x22, 0x19, 0x0a,
-	0x17, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x46, 0x6f, 0x72, 0x62, 0x69,
-	0x64, 0x64, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x22, 0x45, 0x0a, 0x13, 0x43, 0x61, 0x6e, 0x63,
-	0x65, 0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x12,
-	0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f,
-	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22,
-	0x16, 0x0a, 0x14, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x55,
-	0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x22, 0x3e, 0x0a, 0x0c, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
-	0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
-	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12,
-	0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x0f, 0x0a, 0x0d, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
-	0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x22, 0x2a, 0x0a, 0x0e, 0x55, 0x6e, 0x62, 0x6c,
-	0x6f, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x75, 0x73,
-	0x65, 0x72, 0x49, 0x44, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x75, 0x73, 0x65,
-	0x72, 0x49, 0x44, 0x73, 0x22, 0x11, 0x0a, 0x0f, 0x55, 0x6e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x55,
-	0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x22, 0x70, 0x0a, 0x12, 0x53, 0x65, 0x61, 0x72, 0x63,
-	0x68, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a,
-	0x07, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
-	0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x40, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6f, 0x70,
-	0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x73, 0x64, 0x6b, 0x77, 0x73, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70,
-	0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xb7, 0x02, 0x0a, 0x0d, 0x42, 0x6c,
-	0x6f, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x75,
-	0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65,
-	0x72, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x20, 0x0a,
-	0x0b, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0b, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12,
-	0x1a, 0x0a, 0x08, 0x61, 0x72, 0x65, 0x61, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x08, 0x61, 0x72, 0x65, 0x61, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65,
-	0x6d, 0x61, 0x69, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69,
-	0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a,
-	0x07, 0x66, 0x61, 0x63, 0x65, 0x55, 0x52, 0x4c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
-	0x66, 0x61, 0x63, 0x65, 0x55, 0x52, 0x4c, 0x12, 0x16, 0x0a, 0x06, 0x67, 0x65, 0x6e, 0x64, 0x65,
-	0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x67, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12,
-	0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x70, 0x55, 0x73, 0x65,
-	0x72, 0x49, 0x44, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x70, 0x55, 0x73, 0x65,
-	0x72, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d,
-	0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54,
-	0x69, 0x6d, 0x65, 0x22, 0x5e, 0x0a, 0x13, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x42, 0x6c, 0x6f,
-	0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f,
-	0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c,
-	0x12, 0x31, 0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x1b, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x42,
-	0x6c, 0x6f, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x75, 0x73,
-	0x65, 0x72, 0x73, 0x22, 0x30, 0x0a, 0x14, 0x46, 0x69, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x42,
-	0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x75,
-	0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x75, 0x73,
-	0x65, 0x72, 0x49, 0x44, 0x73, 0x22, 0x77, 0x0a, 0x09, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x6e,
-	0x66, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65,
-	0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73,
-	0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x70, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x70, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1e,
-	0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x48,
-	0x0a, 0x15, 0x46, 0x69, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x49,
-	0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x12, 0x2f, 0x0a, 0x06, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
-	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d,
-	0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x6e, 0x66, 0x6f,
-	0x52, 0x06, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x22, 0x44, 0x0a, 0x0e, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73,
-	0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
-	0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x18, 0x20,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x22, 0x27,
-	0x0a, 0x0f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73,
-	0x70, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x25, 0x0a, 0x0d, 0x50, 0x61, 0x72, 0x73, 0x65,
-	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65,
-	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x72,
-	0x0a, 0x0e, 0x50, 0x61, 0x72, 0x73, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70,
-	0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72,
-	0x54, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72,
-	0x54, 0x79, 0x70, 0x65, 0x12, 0x2c, 0x0a, 0x11, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x54, 0x69,
-	0x6d, 0x65, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x11, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x65, 0x63, 0x6f, 0x6e,
-	0x64, 0x73, 0x22, 0x2c, 0x0a, 0x12, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65,
-	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72,
-	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44,
-	0x22, 0x15, 0x0a, 0x13, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x54, 0x6f,
-	0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x22, 0x82, 0x02, 0x0a, 0x0c, 0x41, 0x64, 0x64, 0x41,
-	0x70, 0x70, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05,
-	0x61, 0x70, 0x70, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x70, 0x70,
-	0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x69, 0x63, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x69, 0x63, 0x6f, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x64, 0x35, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x64, 0x35, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69,
-	0x7a, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x18,
-	0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x69, 0x6f,
-	0x72, 0x69, 0x74, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x70, 0x72, 0x69, 0x6f,
-	0x72, 0x69, 0x74, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x0a,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1e, 0x0a, 0x0a,
-	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x0f, 0x0a, 0x0d,
-	0x41, 0x64, 0x64, 0x41, 0x70, 0x70, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x22, 0x2c, 0x0a,
-	0x0c, 0x44, 0x65, 0x6c, 0x41, 0x70, 0x70, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a,
-	0x09, 0x61, 0x70, 0x70, 0x6c, 0x65, 0x74, 0x49, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09,
-	0x52, 0x09, 0x61, 0x70, 0x70, 0x6c, 0x65, 0x74, 0x49, 0x64, 0x73, 0x22, 0x0f, 0x0a, 0x0d, 0x44,
-	0x65, 0x6c, 0x41, 0x70, 0x70, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x22, 0xaf, 0x04, 0x0a,
-	0x0f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x70, 0x70, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x71,
-	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
-	0x12, 0x30, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c,
-	0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x12, 0x32, 0x0a, 0x05, 0x61, 0x70, 0x70, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1c, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52,
-	0x05, 0x61, 0x70, 0x70, 0x49, 0x44, 0x12, 0x30, 0x0a, 0x04, 0x69, 0x63, 0x6f, 0x6e, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c,
-	0x75, 0x65, 0x52, 0x04, 0x69, 0x63, 0x6f, 0x6e, 0x12, 0x2e, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61,
-	0x6c, 0x75, 0x65, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x2e, 0x0a, 0x03, 0x6d, 0x64, 0x35, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61,
-	0x6c, 0x75, 0x65, 0x52, 0x03, 0x6d, 0x64, 0x35, 0x12, 0x2f, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65,
-	0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61,
-	0x6c, 0x75, 0x65, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x36, 0x0a, 0x07, 0x76, 0x65, 0x72,
-	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6f, 0x70, 0x65,
-	0x6e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72,
-	0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
-	0x6e, 0x12, 0x38, 0x0a, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x09, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75,
-	0x65, 0x52, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x34, 0x0a, 0x06, 0x73,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6f, 0x70,
-	0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49,
-	0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x12, 0x3b, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18,
-	0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c,
-	0x75, 0x65, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x12,
-	0x0a, 0x10, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x70, 0x70, 0x6c, 0x65, 0x74, 0x52, 0x65,
-	0x73, 0x70, 0x22, 0x0f, 0x0a, 0x0d, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x70, 0x70, 0x6c, 0x65, 0x74,
-	0x52, 0x65, 0x71, 0x22, 0x45, 0x0a, 0x0e, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x70, 0x70, 0x6c, 0x65,
-	0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x33, 0x0a, 0x07, 0x61, 0x70, 0x70, 0x6c, 0x65, 0x74, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e,
-	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x65, 0x74, 0x49, 0x6e, 0x66,
-	0x6f, 0x52, 0x07, 0x61, 0x70, 0x70, 0x6c, 0x65, 0x74, 0x73, 0x22, 0x6d, 0x0a, 0x0f, 0x53, 0x65,
-	0x61, 0x72, 0x63, 0x68, 0x41, 0x70, 0x70, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a,
-	0x07, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
-	0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x40, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6f, 0x70,
-	0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x73, 0x64, 0x6b, 0x77, 0x73, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70,
-	0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x5d, 0x0a, 0x10, 0x53, 0x65, 0x61,
-	0x72, 0x63, 0x68, 0x41, 0x70, 0x70, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x14, 0x0a,
-	0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x74, 0x6f,
-	0x74, 0x61, 0x6c, 0x12, 0x33, 0x0a, 0x07, 0x61, 0x70, 0x70, 0x6c, 0x65, 0x74, 0x73, 0x18, 0x02,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x6f,
-	0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52,
-	0x07, 0x61, 0x70, 0x70, 0x6c, 0x65, 0x74, 0x73, 0x22, 0x95, 0x01, 0x0a, 0x12, 0x53, 0x65, 0x74,
-	0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x12,
-	0x44, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x2c, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x53,
-	0x65, 0x74, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65,
-	0x71, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x63,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x1a, 0x39, 0x0a, 0x0b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x45,
-	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
-	0x22, 0x15, 0x0a, 0x13, 0x53, 0x65, 0x74, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x22, 0x28, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x43, 0x6c,
-	0x69, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a,
-	0x04, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x65, 0x79,
-	0x73, 0x22, 0x15, 0x0a, 0x13, 0x44, 0x65, 0x6c, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x22, 0x14, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x43,
-	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x22, 0x97,
-	0x01, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x12, 0x45, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e,
-	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x1a, 0x39, 0x0a,
-	0x0b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
-	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14,
-	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x29, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x55,
-	0x73, 0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x75,
-	0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65,
-	0x72, 0x49, 0x44, 0x22, 0x9d, 0x01, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x54,
-	0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x4b, 0x0a, 0x09, 0x74, 0x6f, 0x6b, 0x65,
-	0x6e, 0x73, 0x4d, 0x61, 0x70, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x6f, 0x70,
-	0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73,
-	0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x54, 0x6f, 0x6b, 0x65,
-	0x6e, 0x73, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x09, 0x74, 0x6f, 0x6b, 0x65,
-	0x6e, 0x73, 0x4d, 0x61, 0x70, 0x1a, 0x3c, 0x0a, 0x0e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x4d,
-	0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
-	0x02, 0x38, 0x01, 0x32, 0xbe, 0x20, 0x0a, 0x05, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x12, 0x38, 0x0a,
-	0x05, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x16, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e,
-	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x17,
-	0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x4c, 0x6f,
-	0x67, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x53, 0x0a, 0x0e, 0x43, 0x68, 0x61, 0x6e, 0x67,
-	0x65, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x1f, 0x2e, 0x6f, 0x70, 0x65, 0x6e,
-	0x69, 0x6d, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50,
-	0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x1a, 0x20, 0x2e, 0x6f, 0x70, 0x65,
-	0x6e, 0x69, 0x6d, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65,
-	0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x12, 0x56, 0x0a, 0x0f,
-	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12,
-	0x20, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x41,
-	0x64, 0x6d, 0x69, 0x6e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65,
-	0x71, 0x1a, 0x21, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e,
-	0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x66, 0x6f,
-	0x52, 0x65, 0x73, 0x70, 0x12, 0x4d, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x41, 0x64, 0x6d, 0x69, 0x6e,
-	0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1d, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61, 0x64,
-	0x6d, 0x69, 0x6e, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x49, 0x6e, 0x66, 0x6f,
-	0x52, 0x65, 0x71, 0x1a, 0x1e, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61, 0x64, 0x6d,
-	0x69, 0x6e, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52,
-	0x65, 0x73, 0x70, 0x12, 0x56, 0x0a, 0x0f, 0x41, 0x64, 0x64, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x41,
-	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x20, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e,
-	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x41, 0x64, 0x64, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x41, 0x63,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x21, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69,
-	0x6d, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x41, 0x64, 0x64, 0x41, 0x64, 0x6d, 0x69, 0x6e,
-	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x62, 0x0a, 0x13, 0x43,
-	0x68, 0x61, 0x6e, 0x67, 0x65, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f,
-	0x72, 0x64, 0x12, 0x24, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61, 0x64, 0x6d, 0x69,
-	0x6e, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x50, 0x61, 0x73,
-	0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x1a, 0x25, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69,
-	0x6d, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x41, 0x64,
-	0x6d, 0x69, 0x6e, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x12,
-	0x56, 0x0a, 0x0f, 0x44, 0x65, 0x6c, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x41, 0x63, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x12, 0x20, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61, 0x64, 0x6d, 0x69,
-	0x6e, 0x2e, 0x44, 0x65, 0x6c, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x52, 0x65, 0x71, 0x1a, 0x21, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61, 0x64,
-	0x6d, 0x69, 0x6e, 0x2e, 0x44, 0x65, 0x6c, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x41, 0x63, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x5f, 0x0a, 0x12, 0x53, 0x65, 0x61, 0x72, 0x63,
-	0x68, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x23, 0x2e,
-	0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x53, 0x65, 0x61,
-	0x72, 0x63, 0x68, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52,
-	0x65, 0x71, 0x1a, 0x24, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61, 0x64, 0x6d, 0x69,
-	0x6e, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x41, 0x63, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x59, 0x0a, 0x10, 0x41, 0x64, 0x64, 0x44,
-	0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x12, 0x21, 0x2e, 0x6f,
-	0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x41, 0x64, 0x64, 0x44,
-	0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x1a,
-	0x22, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x41,
-	0x64, 0x64, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52,
-	0x65, 0x73, 0x70, 0x12, 0x59, 0x0a, 0x10, 0x44, 0x65, 0x6c, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c,
-	0x74, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x12, 0x21, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d,
-	0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x44, 0x65, 0x6c, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c,
-	0x74, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x1a, 0x22, 0x2e, 0x6f, 0x70, 0x65,
-	0x6e, 0x69, 0x6d, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x44, 0x65, 0x6c, 0x44, 0x65, 0x66,
-	0x61, 0x75, 0x6c, 0x74, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x12, 0x5c,
-	0x0a, 0x11, 0x46, 0x69, 0x6e, 0x64, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x46, 0x72, 0x69,
-	0x65, 0x6e, 0x64, 0x12, 0x22, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61, 0x64, 0x6d,
-	0x69, 0x6e, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x46, 0x72,
-	0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x1a, 0x23, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d,
-	0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x44, 0x65, 0x66, 0x61, 0x75,
-	0x6c, 0x74, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x12, 0x62, 0x0a, 0x13,
-	0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x46, 0x72, 0x69,
-	0x65, 0x6e, 0x64, 0x12, 0x24, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61, 0x64, 0x6d,
-	0x69, 0x6e, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74,
-	0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x1a, 0x25, 0x2e, 0x6f, 0x70, 0x65, 0x6e,
-	0x69, 0x6d, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x44,
-	0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70,
-	0x12, 0x56, 0x0a, 0x0f, 0x41, 0x64, 0x64, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x47, 0x72,
-	0x6f, 0x75, 0x70, 0x12, 0x20, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61, 0x64, 0x6d,
-	0x69, 0x6e, 0x2e, 0x41, 0x64, 0x64, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x47, 0x72, 0x6f,
-	0x75, 0x70, 0x52, 0x65, 0x71, 0x1a, 0x21, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61,
-	0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x41, 0x64, 0x64, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x47,
-	0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x12, 0x56, 0x0a, 0x0f, 0x44, 0x65, 0x6c, 0x44,
-	0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x20, 0x2e, 0x6f, 0x70,
-	0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x44, 0x65, 0x6c, 0x44, 0x65,
-	0x66, 0x61, 0x75, 0x6c, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x1a, 0x21, 0x2e,
-	0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x44, 0x65, 0x6c,
-	0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70,
-	0x12, 0x59, 0x0a, 0x10, 0x46, 0x69, 0x6e, 0x64, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x47,
-	0x72, 0x6f, 0x75, 0x70, 0x12, 0x21, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61, 0x64,
-	0x6d, 0x69, 0x6e, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x47,
-	0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x1a, 0x22, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d,
-	0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x44, 0x65, 0x66, 0x61, 0x75,
-	0x6c, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x12, 0x5f, 0x0a, 0x12, 0x53,
-	0x65, 0x61, 0x72, 0x63, 0x68, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x47, 0x72, 0x6f, 0x75,
-	0x70, 0x12, 0x23, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e,
-	0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x47, 0x72,
-	0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x1a, 0x24, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e,
-	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x44, 0x65, 0x66, 0x61,
-	0x75, 0x6c, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x12, 0x5c, 0x0a, 0x11,
-	0x41, 0x64, 0x64, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64,
-	0x65, 0x12, 0x22, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e,
-	0x2e, 0x41, 0x64, 0x64, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f,
-	0x64, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x23, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61,
-	0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x41, 0x64, 0x64, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x5c, 0x0a, 0x11, 0x47, 0x65,
-	0x6e, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x12,
-	0x22, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x47,
-	0x65, 0x6e, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65,
-	0x52, 0x65, 0x71, 0x1a, 0x23, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61, 0x64, 0x6d,
-	0x69, 0x6e, 0x2e, 0x47, 0x65, 0x6e, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x5f, 0x0a, 0x12, 0x46, 0x69, 0x6e, 0x64,
-	0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x23,
-	0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x46, 0x69,
-	0x6e, 0x64, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65,
-	0x52, 0x65, 0x71, 0x1a, 0x24, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61, 0x64, 0x6d,
-	0x69, 0x6e, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x5c, 0x0a, 0x11, 0x55, 0x73, 0x65,
-	0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x22,
-	0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x55, 0x73,
-	0x65, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x52,
-	0x65, 0x71, 0x1a, 0x23, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61, 0x64, 0x6d, 0x69,
-	0x6e, 0x2e, 0x55, 0x73, 0x65, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43,
-	0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x5c, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x49, 0x6e,
-	0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x22, 0x2e, 0x6f,
-	0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x44, 0x65, 0x6c, 0x49,
-	0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71,
-	0x1a, 0x23, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e,
-	0x44, 0x65, 0x6c, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64,
-	0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x65, 0x0a, 0x14, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x49,
-	0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x25, 0x2e,
-	0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x53, 0x65, 0x61,
-	0x72, 0x63, 0x68, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64,
-	0x65, 0x52, 0x65, 0x71, 0x1a, 0x26, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61, 0x64,
-	0x6d, 0x69, 0x6e, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x6b, 0x0a, 0x16,
-	0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x55, 0x73, 0x65, 0x72, 0x49, 0x50, 0x4c, 0x69, 0x6d, 0x69,
-	0x74, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x27, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e,
-	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x55, 0x73, 0x65, 0x72,
-	0x49, 0x50, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x1a,
-	0x28, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x53,
-	0x65, 0x61, 0x72, 0x63, 0x68, 0x55, 0x73, 0x65, 0x72, 0x49, 0x50, 0x4c, 0x69, 0x6d, 0x69, 0x74,
-	0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x62, 0x0a, 0x13, 0x41, 0x64, 0x64,
-	0x55, 0x73, 0x65, 0x72, 0x49, 0x50, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x69, 0x6e,
-	0x12, 0x24, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e,
-	0x41, 0x64, 0x64, 0x55, 0x73, 0x65, 0x72, 0x49, 0x50, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x4c, 0x6f,
-	0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x25, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e,
-	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x41, 0x64, 0x64, 0x55, 0x73, 0x65, 0x72, 0x49, 0x50, 0x4c,
-	0x69, 0x6d, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x62, 0x0a,
-	0x13, 0x44, 0x65, 0x6c, 0x55, 0x73, 0x65, 0x72, 0x49, 0x50, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x4c,
-	0x6f, 0x67, 0x69, 0x6e, 0x12, 0x24, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61, 0x64,
-	0x6d, 0x69, 0x6e, 0x2e, 0x44, 0x65, 0x6c, 0x55, 0x73, 0x65, 0x72, 0x49, 0x50, 0x4c, 0x69, 0x6d,
-	0x69, 0x74, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x25, 0x2e, 0x6f, 0x70, 0x65,
-	0x6e, 0x69, 0x6d, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x44, 0x65, 0x6c, 0x55, 0x73, 0x65,
-	0x72, 0x49, 0x50, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x73,
-	0x70, 0x12, 0x5c, 0x0a, 0x11, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x49, 0x50, 0x46, 0x6f, 0x72,
-	0x62, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x12, 0x22, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e,
-	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x49, 0x50, 0x46, 0x6f,
-	0x72, 0x62, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x23, 0x2e, 0x6f, 0x70, 0x65,
-	0x6e, 0x69, 0x6d, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68,
-	0x49, 0x50, 0x46, 0x6f, 0x72, 0x62, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12,
-	0x53, 0x0a, 0x0e, 0x41, 0x64, 0x64, 0x49, 0x50, 0x46, 0x6f, 0x72, 0x62, 0x69, 0x64, 0x64, 0x65,
-	0x6e, 0x12, 0x1f, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e,
-	0x2e, 0x41, 0x64, 0x64, 0x49, 0x50, 0x46, 0x6f, 0x72, 0x62, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x52,
-	0x65, 0x71, 0x1a, 0x20, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61, 0x64, 0x6d, 0x69,
-	0x6e, 0x2e, 0x41, 0x64, 0x64, 0x49, 0x50, 0x46, 0x6f, 0x72, 0x62, 0x69, 0x64, 0x64, 0x65, 0x6e,
-	0x52, 0x65, 0x73, 0x70, 0x12, 0x53, 0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x49, 0x50, 0x46, 0x6f, 0x72,
-	0x62, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x12, 0x1f, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e,
-	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x44, 0x65, 0x6c, 0x49, 0x50, 0x46, 0x6f, 0x72, 0x62, 0x69,
-	0x64, 0x64, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x20, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d,
-	0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x44, 0x65, 0x6c, 0x49, 0x50, 0x46, 0x6f, 0x72, 0x62,
-	0x69, 0x64, 0x64, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x59, 0x0a, 0x10, 0x43, 0x61, 0x6e,
-	0x63, 0x65, 0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x12, 0x21, 0x2e,
-	0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x43, 0x61, 0x6e,
-	0x63, 0x65, 0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71,
-	0x1a, 0x22, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e,
-	0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x73, 0x65, 0x72,
-	0x52, 0x65, 0x73, 0x70, 0x12, 0x44, 0x0a, 0x09, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x55, 0x73, 0x65,
-	0x72, 0x12, 0x1a, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e,
-	0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x1b, 0x2e,
-	0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x42, 0x6c, 0x6f,
-	0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x12, 0x4a, 0x0a, 0x0b, 0x55, 0x6e,
-	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x12, 0x1c, 0x2e, 0x6f, 0x70, 0x65, 0x6e,
-	0x69, 0x6d, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x55, 0x6e, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
-	0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x1d, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d,
-	0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x55, 0x6e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x55, 0x73,
-	0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x12, 0x56, 0x0a, 0x0f, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68,
-	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x12, 0x20, 0x2e, 0x6f, 0x70, 0x65, 0x6e,
-	0x69, 0x6d, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x42,
-	0x6c, 0x6f, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x21, 0x2e, 0x6f, 0x70,
-	0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63,
-	0x68, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x12, 0x5c,
-	0x0a, 0x11, 0x46, 0x69, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x49,
-	0x6e, 0x66, 0x6f, 0x12, 0x22, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61, 0x64, 0x6d,
-	0x69, 0x6e, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
-	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x1a, 0x23, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d,
-	0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x42,
-	0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x12, 0x6b, 0x0a, 0x16,
-	0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x46, 0x6f, 0x72,
-	0x62, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x12, 0x27, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e,
-	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x67, 0x69, 0x73,
-	0x74, 0x65, 0x72, 0x46, 0x6f, 0x72, 0x62, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x1a,
-	0x28, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x43,
-	0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x46, 0x6f, 0x72, 0x62,
-	0x69, 0x64, 0x64, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x62, 0x0a, 0x13, 0x43, 0x68, 0x65,
-	0x63, 0x6b, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x46, 0x6f, 0x72, 0x62, 0x69, 0x64, 0x64, 0x65, 0x6e,
-	0x12, 0x24, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e,
-	0x43, 0x68, 0x65, 0x63, 0x6b, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x46, 0x6f, 0x72, 0x62, 0x69, 0x64,
-	0x64, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x25, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e,
-	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x4c, 0x6f, 0x67, 0x69, 0x6e,
-	0x46, 0x6f, 0x72, 0x62, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x4a, 0x0a,
-	0x0b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1c, 0x2e, 0x6f,
-	0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x1d, 0x2e, 0x6f, 0x70, 0x65,
-	0x6e, 0x69, 0x6d, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x47, 0x0a, 0x0a, 0x50, 0x61, 0x72,
-	0x73, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1b, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d,
-	0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x50, 0x61, 0x72, 0x73, 0x65, 0x54, 0x6f, 0x6b, 0x65,
-	0x6e, 0x52, 0x65, 0x71, 0x1a, 0x1c, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61, 0x64,
-	0x6d, 0x69, 0x6e, 0x2e, 0x50, 0x61, 0x72, 0x73, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65,
-	0x73, 0x70, 0x12, 0x44, 0x0a, 0x09, 0x41, 0x64, 0x64, 0x41, 0x70, 0x70, 0x6c, 0x65, 0x74, 0x12,
-	0x1a, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x41,
-	0x64, 0x64, 0x41, 0x70, 0x70, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x1b, 0x2e, 0x6f, 0x70,
-	0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x41, 0x64, 0x64, 0x41, 0x70,
-	0x70, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x44, 0x0a, 0x09, 0x44, 0x65, 0x6c, 0x41,
-	0x70, 0x70, 0x6c, 0x65, 0x74, 0x12, 0x1a, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61,
-	0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x44, 0x65, 0x6c, 0x41, 0x70, 0x70, 0x6c, 0x65, 0x74, 0x52, 0x65,
-	0x71, 0x1a, 0x1b, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e,
-	0x2e, 0x44, 0x65, 0x6c, 0x41, 0x70, 0x70, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x4d,
-	0x0a, 0x0c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x70, 0x70, 0x6c, 0x65, 0x74, 0x12, 0x1d,
-	0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x41, 0x70, 0x70, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x1e, 0x2e,
-	0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x41, 0x70, 0x70, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x47, 0x0a,
-	0x0a, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x70, 0x70, 0x6c, 0x65, 0x74, 0x12, 0x1b, 0x2e, 0x6f, 0x70,
-	0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x41,
-	0x70, 0x70, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x1c, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69,
-	0x6d, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x70, 0x70, 0x6c,
-	0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x4d, 0x0a, 0x0c, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68,
-	0x41, 0x70, 0x70, 0x6c, 0x65, 0x74, 0x12, 0x1d, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e,
-	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x41, 0x70, 0x70, 0x6c,
-	0x65, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x1e, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61,
-	0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x41, 0x70, 0x70, 0x6c, 0x65,
-	0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x56, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x43, 0x6c, 0x69, 0x65,
-	0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x20, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69,
-	0x6d, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6c, 0x69, 0x65, 0x6e,
-	0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x1a, 0x21, 0x2e, 0x6f, 0x70, 0x65,
-	0x6e, 0x69, 0x6d, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6c, 0x69,
-	0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x12, 0x56, 0x0a,
-	0x0f, 0x53, 0x65, 0x74, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x12, 0x20, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e,
-	0x53, 0x65, 0x74, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52,
-	0x65, 0x71, 0x1a, 0x21, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61, 0x64, 0x6d, 0x69,
-	0x6e, 0x2e, 0x53, 0x65, 0x74, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x52, 0x65, 0x73, 0x70, 0x12, 0x56, 0x0a, 0x0f, 0x44, 0x65, 0x6c, 0x43, 0x6c, 0x69, 0x65,
-	0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x20, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69,
-	0x6d, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x44, 0x65, 0x6c, 0x43, 0x6c, 0x69, 0x65, 0x6e,
-	0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x1a, 0x21, 0x2e, 0x6f, 0x70, 0x65,
-	0x6e, 0x69, 0x6d, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x44, 0x65, 0x6c, 0x43, 0x6c, 0x69,
-	0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x12, 0x4d, 0x0a,
-	0x0c, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1d, 0x2e,
-	0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x47, 0x65, 0x74,
-	0x55, 0x73, 0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x1e, 0x2e, 0x6f,
-	0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x47, 0x65, 0x74, 0x55,
-	0x73, 0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x56, 0x0a, 0x0f,
-	0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12,
-	0x20, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x49,
-	0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65,
-	0x71, 0x1a, 0x21, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e,
-	0x2e, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
-	0x52, 0x65, 0x73, 0x70, 0x42, 0x2e, 0x5a, 0x2c, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
-	0x6f, 0x6d, 0x2f, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x73, 0x64, 0x6b, 0x2f, 0x63, 0x68, 0x61,
-	0x74, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x61,
-	0x64, 0x6d, 0x69, 0x6e, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+type CheckLoginForbiddenResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 }
 
-var (
-	file_admin_admin_proto_rawDescOnce sync.Once
-	file_admin_admin_proto_rawDescData = file_admin_admin_proto_rawDesc
-)
+func (x *CheckLoginForbiddenResp) Reset() {
+	*x = CheckLoginForbiddenResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_admin_proto_msgTypes[103]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
 
-func file_admin_admin_proto_rawDescGZIP() []byte {
-	file_admin_admin_proto_rawDescOnce.Do(func() {
-		file_admin_admin_proto_rawDescData = protoimpl.X.CompressGZIP(file_admin_admin_proto_rawDescData)
-	})
-	return file_admin_admin_proto_rawDescData
+func (x *CheckLoginForbiddenResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-var file_admin_admin_proto_msgTypes = make([]protoimpl.MessageInfo, 106)
-var file_admin_admin_proto_goTypes = []any{
-	(*LoginReq)(nil),                   // 0: openim.admin.LoginReq
-	(*LoginResp)(nil),                  // 1: openim.admin.LoginResp
-	(*AddAdminAccountReq)(nil),         // 2: openim.admin.AddAdminAccountReq
-	(*AddAdminAccountResp)(nil),        // 3: openim.admin.AddAdminAccountResp
-	(*AdminUpdateInfoReq)(nil),         // 4: openim.admin.AdminUpdateInfoReq
-	(*AdminUpdateInfoResp)(nil),        // 5: openim.admin.AdminUpdateInfoResp
-	(*ChangePasswordReq)(nil),          // 6: openim.admin.ChangePasswordReq
-	(*ChangePasswordResp)(nil),         // 7: openim.admin.ChangePasswordResp
-	(*GetAdminInfoReq)(nil),            // 8: openim.admin.GetAdminInfoReq
-	(*ChangeAdminPasswordReq)(nil),     // 9: openim.admin.ChangeAdminPasswordReq
-	(*ChangeAdminPasswordResp)(nil),    // 10: openim.admin.ChangeAdminPasswordResp
-	(*DelAdminAccountReq)(nil),         // 11: openim.admin.DelAdminAccountReq
-	(*DelAdminAccountResp)(nil),        // 12: openim.admin.DelAdminAccountResp
-	(*SearchAdminAccountReq)(nil),      // 13: openim.admin.SearchAdminAccountReq
-	(*SearchAdminAccountResp)(nil),     // 14: openim.admin.SearchAdminAccountResp
-	(*GetAdminInfoResp)(nil),           // 15: openim.admin.GetAdminInfoResp
-	(*AddDefaultFriendReq)(nil),        // 16: openim.admin.AddDefaultFriendReq
-	(*AddDefaultFriendResp)(nil),       // 17: openim.admin.AddDefaultFriendResp
-	(*DelDefaultFriendReq)(nil),        // 18: openim.admin.DelDefaultFriendReq
-	(*DelDefaultFriendResp)(nil),       // 19: openim.admin.DelDefaultFriendResp
-	(*FindDefaultFriendReq)(nil),       // 20: openim.admin.FindDefaultFriendReq
-	(*FindDefaultFriendResp)(nil),      // 21: openim.admin.FindDefaultFriendResp
-	(*SearchDefaultFriendReq)(nil),     // 22: openim.admin.SearchDefaultFriendReq
-	(*DefaultFriendAttribute)(nil),     // 23: openim.admin.DefaultFriendAttribute
-	(*SearchDefaultFriendResp)(nil),    // 24: openim.admin.SearchDefaultFriendResp
-	(*AddDefaultGroupReq)(nil),         // 25: openim.admin.AddDefaultGroupReq
-	(*AddDefaultGroupResp)(nil),        // 26: openim.admin.AddDefaultGroupResp
-	(*DelDefaultGroupReq)(nil),         // 27: openim.admin.DelDefaultGroupReq
-	(*DelDefaultGroupResp)(nil),        // 28: openim.admin.DelDefaultGroupResp
-	(*FindDefaultGroupReq)(nil),        // 29: openim.admin.FindDefaultGroupReq
-	(*FindDefaultGroupResp)(nil),       // 30: openim.admin.FindDefaultGroupResp
-	(*SearchDefaultGroupReq)(nil),      // 31: openim.admin.SearchDefaultGroupReq
-	(*GroupAttribute)(nil),             // 32: openim.admin.GroupAttribute
-	(*SearchDefaultGroupResp)(nil),     // 33: openim.admin.SearchDefaultGroupResp
-	(*AddInvitationCodeReq)(nil),       // 34: openim.admin.AddInvitationCodeReq
-	(*AddInvitationCodeResp)(nil),      // 35: openim.admin.AddInvitationCodeResp
-	(*GenInvitationCodeReq)(nil),       // 36: openim.admin.GenInvitationCodeReq
-	(*GenInvitationCodeResp)(nil),      // 37: openim.admin.GenInvitationCodeResp
-	(*FindInvitationCodeReq)(nil),      // 38: openim.admin.FindInvitationCodeReq
-	(*FindInvitationCodeResp)(nil),     // 39: openim.admin.FindInvitationCodeResp
-	(*UseInvitationCodeReq)(nil),       // 40: openim.admin.UseInvitationCodeReq
-	(*UseInvitationCodeResp)(nil),      // 41: openim.admin.UseInvitationCodeResp
-	(*DelInvitationCodeReq)(nil),       // 42: openim.admin.DelInvitationCodeReq
-	(*DelInvitationCodeResp)(nil),      // 43: openim.admin.DelInvitationCodeResp
-	(*InvitationRegister)(nil),         // 44: openim.admin.InvitationRegister
-	(*SearchInvitationCodeReq)(nil),    // 45: openim.admin.SearchInvitationCodeReq
-	(*SearchInvitationCodeResp)(nil),   // 46: openim.admin.SearchInvitationCodeResp
-	(*SearchUserIPLimitLoginReq)(nil),  // 47: openim.admin.SearchUserIPLimitLoginReq
-	(*LimitUserLoginIP)(nil),           // 48: openim.admin.LimitUserLoginIP
-	(*SearchUserIPLimitLoginResp)(nil), // 49: openim.admin.SearchUserIPLimitLoginResp
-	(*UserIPLimitLogin)(nil),           // 50: openim.admin.UserIPLimitLogin
-	(*AddUserIPLimitLoginReq)(nil),     // 51: openim.admin.AddUserIPLimitLoginReq
-	(*AddUserIPLimitLoginResp)(nil),    // 52: openim.admin.AddUserIPLimitLoginResp
-	(*DelUserIPLimitLoginReq)(nil),     // 53: openim.admin.DelUserIPLimitLoginReq
-	(*DelUserIPLimitLoginResp)(nil),    // 54: openim.admin.DelUserIPLimitLoginResp
-	(*IPForbidden)(nil),                // 55: openim.admin.IPForbidden
-	(*IPForbiddenAdd)(nil),             // 56: openim.admin.IPForbiddenAdd
-	(*SearchIPForbiddenReq)(nil),       // 57: openim.admin.SearchIPForbiddenReq
-	(*SearchIPForbiddenResp)(nil),      // 58: openim.admin.SearchIPForbiddenResp
-	(*AddIPForbiddenReq)(nil),          // 59: openim.admin.AddIPForbiddenReq
-	(*AddIPForbiddenResp)(nil),         // 60: openim.admin.AddIPForbiddenResp
-	(*DelIPForbiddenReq)(nil),          // 61: openim.admin.DelIPForbiddenReq
-	(*DelIPForbiddenResp)(nil),         // 62: openim.admin.DelIPForbiddenResp
-	(*CheckRegisterForbiddenReq)(nil),  // 63: openim.admin.CheckRegisterForbiddenReq
-	(*CheckRegisterForbiddenResp)(nil), // 64: openim.admin.CheckRegisterForbiddenResp
-	(*CheckLoginForbiddenReq)(nil),     // 65: openim.admin.CheckLoginForbiddenReq
-	(*CheckLoginForbiddenResp)(nil),    // 66: openim.admin.CheckLoginForbiddenResp
-	(*CancellationUserReq)(nil),        // 67: openim.admin.CancellationUserReq
-	(*CancellationUserResp)(nil),       // 68: openim.admin.CancellationUserResp
-	(*BlockUserReq)(nil),               // 69: openim.admin.BlockUserReq
-	(*BlockUserResp)(nil),              // 70: openim.admin.BlockUserResp
-	(*UnblockUserReq)(nil),             // 71: openim.admin.UnblockUserReq
-	(*UnblockUserResp)(nil),            // 72: openim.admin.UnblockUserResp
-	(*SearchBlockUserReq)(nil),         // 73: openim.admin.SearchBlockUserReq
-	(*BlockUserInfo)(nil),              // 74: openim.admin.BlockUserInfo
-	(*SearchBlockUserResp)(nil),        // 75: openim.admin.SearchBlockUserResp
-	(*FindUserBlockInfoReq)(nil),       // 76: openim.admin.FindUserBlockInfoReq
-	(*BlockInfo)(nil),                  // 77: openim.admin.BlockInfo
-	(*FindUserBlockInfoResp)(nil),      // 78: openim.admin.FindUserBlockInfoResp
-	(*CreateTokenReq)(nil),             // 79: openim.admin.CreateTokenReq
-	(*CreateTokenResp)(nil),            // 80: openim.admin.CreateTokenResp
-	(*ParseTokenReq)(nil),              // 81: openim.admin.ParseTokenReq
-	(*ParseTokenResp)(nil),             // 82: openim.admin.ParseTokenResp
-	(*InvalidateTokenReq)(nil),         // 83: openim.admin.InvalidateTokenReq
-	(*InvalidateTokenResp)(nil),        // 84: openim.admin.InvalidateTokenResp
-	(*AddAppletReq)(nil),               // 85: openim.admin.AddAppletReq
-	(*AddAppletResp)(nil),              // 86: openim.admin.AddAppletResp
-	(*DelAppletReq)(nil),               // 87: openim.admin.DelAppletReq
-	(*DelAppletResp)(nil),              // 88: openim.admin.DelAppletResp
-	(*UpdateAppletReq)(nil),            // 89: openim.admin.UpdateAppletReq
-	(*UpdateAppletResp)(nil),           // 90: openim.admin.UpdateAppletResp
-	(*FindAppletReq)(nil),              // 91: openim.admin.FindAppletReq
-	(*FindAppletResp)(nil),             // 92: openim.admin.FindAppletResp
-	(*SearchAppletReq)(nil),            // 93: openim.admin.SearchAppletReq
-	(*SearchAppletResp)(nil),           // 94: openim.admin.SearchAppletResp
-	(*SetClientConfigReq)(nil),         // 95: openim.admin.SetClientConfigReq
-	(*SetClientConfigResp)(nil),        // 96: openim.admin.SetClientConfigResp
-	(*DelClientConfigReq)(nil),         // 97: openim.admin.DelClientConfigReq
-	(*DelClientConfigResp)(nil),        // 98: openim.admin.DelClientConfigResp
-	(*GetClientConfigReq)(nil),         // 99: openim.admin.GetClientConfigReq
-	(*GetClientConfigResp)(nil),        // 100: openim.admin.GetClientConfigResp
-	(*GetUserTokenReq)(nil),            // 101: openim.admin.GetUserTokenReq
-	(*GetUserTokenResp)(nil),           // 102: openim.admin.GetUserTokenResp
-	nil,                                // 103: openim.admin.SetClientConfigReq.ConfigEntry
-	nil,                                // 104: openim.admin.GetClientConfigResp.ConfigEntry
-	nil,                                // 105: openim.admin.GetUserTokenResp.TokensMapEntry
-	(*wrapperspb.StringValue)(nil),     // 106: openim.protobuf.StringValue
-	(*wrapperspb.Int32Value)(nil),      // 107: openim.protobuf.Int32Value
-	(*sdkwss.RequestPagination)(nil),   // 108: openim.sdkwss.RequestPagination
-	(*common.UserPublicInfo)(nil),      // 109: openim.common.UserPublicInfo
-	(*sdkwss.GroupInfo)(nil),           // 110: openim.sdkwss.GroupInfo
-	(*wrapperspb.Int64Value)(nil),      // 111: openim.protobuf.Int64Value
-	(*wrapperspb.UInt32Value)(nil),     // 112: openim.protobuf.UInt32Value
-	(*common.AppletInfo)(nil),          // 113: openim.common.AppletInfo
+func (*CheckLoginForbiddenResp) ProtoMessage() {}
+
+func (x *CheckLoginForbiddenResp) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_admin_proto_msgTypes[103]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
-var file_admin_admin_proto_depIdxs = []int32{
-	106, // 0: openim.admin.AdminUpdateInfoReq.account:type_name -> openim.protobuf.StringValue
-	106, // 1: openim.admin.AdminUpdateInfoReq.password:type_name -> openim.protobuf.StringValue
-	106, // 2: openim.admin.AdminUpdateInfoReq.faceURL:type_name -> openim.protobuf.StringValue
-	106, // 3: openim.admin.AdminUpdateInfoReq.nickname:type_name -> openim.protobuf.StringValue
-	107, // 4: openim.admin.AdminUpdateInfoReq.level:type_name -> openim.protobuf.Int32Value
-	108, // 5: openim.admin.SearchAdminAccountReq.pagination:type_name -> openim.sdkwss.RequestPagination
-	15,  // 6: openim.admin.SearchAdminAccountResp.adminAccounts:type_name -> openim.admin.GetAdminInfoResp
-	108, // 7: openim.admin.SearchDefaultFriendReq.pagination:type_name -> openim.sdkwss.RequestPagination
-	109, // 8: openim.admin.DefaultFriendAttribute.user:type_name -> openim.common.UserPublicInfo
-	23,  // 9: openim.admin.SearchDefaultFriendResp.users:type_name -> openim.admin.DefaultFriendAttribute
-	108, // 10: openim.admin.SearchDefaultGroupReq.pagination:type_name -> openim.sdkwss.RequestPagination
-	110, // 11: openim.admin.GroupAttribute.group:type_name -> openim.sdkwss.GroupInfo
-	44,  // 12: openim.admin.FindInvitationCodeResp.codes:type_name -> openim.admin.InvitationRegister
-	109, // 13: openim.admin.InvitationRegister.usedUser:type_name -> openim.common.UserPublicInfo
-	108, // 14: openim.admin.SearchInvitationCodeReq.pagination:type_name -> openim.sdkwss.RequestPagination
-	44,  // 15: openim.admin.SearchInvitationCodeResp.list:type_name -> openim.admin.InvitationRegister
-	108, // 16: openim.admin.SearchUserIPLimitLoginReq.pagination:type_name -> openim.sdkwss.RequestPagination
-	109, // 17: openim.admin.LimitUserLoginIP.user:type_name -> openim.common.UserPublicInfo
-	48,  // 18: openim.admin.SearchUserIPLimitLoginResp.limits:type_name -> openim.admin.LimitUserLoginIP
-	50,  // 19: openim.admin.AddUserIPLimitLoginReq.limits:type_name -> openim.admin.UserIPLimitLogin
-	50,  // 20: openim.admin.DelUserIPLimitLoginReq.limits:type_name -> openim.admin.UserIPLimitLogin
-	108, // 21: openim.admin.SearchIPForbiddenReq.pagination:type_name -> openim.sdkwss.RequestPagination
-	55,  // 22: openim.admin.SearchIPForbiddenResp.forbiddens:type_name -> openim.admin.IPForbidden
-	56,  // 23: openim.admin.AddIPForbiddenReq.forbiddens:type_name -> openim.admin.IPForbiddenAdd
-	108, // 24: openim.admin.SearchBlockUserReq.pagination:type_name -> openim.sdkwss.RequestPagination
-	74,  // 25: openim.admin.SearchBlockUserResp.users:type_name -> openim.admin.BlockUserInfo
-	77,  // 26: openim.admin.FindUserBlockInfoResp.blocks:type_name -> openim.admin.BlockInfo
-	106, // 27: openim.admin.UpdateAppletReq.name:type_name -> openim.protobuf.StringValue
-	106, // 28: openim.admin.UpdateAppletReq.appID:type_name -> openim.protobuf.StringValue
-	106, // 29: openim.admin.UpdateAppletReq.icon:type_name -> openim.protobuf.StringValue
-	106, // 30: openim.admin.UpdateAppletReq.url:type_name -> openim.protobuf.StringValue
-	106, // 31: openim.admin.UpdateAppletReq.md5:type_name -> openim.protobuf.StringValue
-	111, // 32: openim.admin.UpdateAppletReq.size:type_name -> openim.protobuf.Int64Value
-	106, // 33: openim.admin.UpdateAppletReq.version:type_name -> openim.protobuf.StringValue
-	112, // 34: openim.admin.UpdateAppletReq.priority:type_name -> openim.protobuf.UInt32Value
-	112, // 35: openim.admin.UpdateAppletReq.status:type_name -> openim.protobuf.UInt32Value
-	111, // 36: openim.admin.UpdateAppletReq.createTime:type_name -> openim.protobuf.Int64Value
-	113, // 37: openim.admin.FindAppletResp.applets:type_name -> openim.common.AppletInfo
-	108, // 38: openim.admin.SearchAppletReq.pagination:type_name -> openim.sdkwss.RequestPagination
-	113, // 39: openim.admin.SearchAppletResp.applets:type_name -> openim.common.AppletInfo
-	103, // 40: openim.admin.SetClientConfigReq.config:type_name -> openim.admin.SetClientConfigReq.ConfigEntry
-	104, // 41: openim.admin.GetClientConfigResp.config:type_name -> openim.admin.GetClientConfigResp.ConfigEntry
-	105, // 42: openim.admin.GetUserTokenResp.tokensMap:type_name -> openim.admin.GetUserTokenResp.TokensMapEntry
-	0,   // 43: openim.admin.admin.Login:input_type -> openim.admin.LoginReq
-	6,   // 44: openim.admin.admin.ChangePassword:input_type -> openim.admin.ChangePasswordReq
-	4,   // 45: openim.admin.admin.AdminUpdateInfo:input_type -> openim.admin.AdminUpdateInfoReq
-	8,   // 46: openim.admin.admin.GetAdminInfo:input_type -> openim.admin.GetAdminInfoReq
-	2,   // 47: openim.admin.admin.AddAdminAccount:input_type -> openim.admin.AddAdminAccountReq
-	9,   // 48: openim.admin.admin.ChangeAdminPassword:input_type -> openim.admin.ChangeAdminPasswordReq
-	11,  // 49: openim.admin.admin.DelAdminAccount:input_type -> openim.admin.DelAdminAccountReq
-	13,  // 50: openim.admin.admin.SearchAdminAccount:input_type -> openim.admin.SearchAdminAccountReq
-	16,  // 51: openim.admin.admin.AddDefaultFriend:input_type -> openim.admin.AddDefaultFriendReq
-	18,  // 52: openim.admin.admin.DelDefaultFriend:input_type -> openim.admin.DelDefaultFriendReq
-	20,  // 53: openim.admin.admin.FindDefaultFriend:input_type -> openim.admin.FindDefaultFriendReq
-	22,  // 54: openim.admin.admin.SearchDefaultFriend:input_type -> openim.admin.SearchDefaultFriendReq
-	25,  // 55: openim.admin.admin.AddDefaultGroup:input_type -> openim.admin.AddDefaultGroupReq
-	27,  // 56: openim.admin.admin.DelDefaultGroup:input_type -> openim.admin.DelDefaultGroupReq
-	29,  // 57: openim.admin.admin.FindDefaultGroup:input_type -> openim.admin.FindDefaultGroupReq
-	31,  // 58: openim.admin.admin.SearchDefaultGroup:input_type -> openim.admin.SearchDefaultGroupReq
-	34,  // 59: openim.admin.admin.AddInvitationCode:input_type -> openim.admin.AddInvitationCodeReq
-	36,  // 60: openim.admin.admin.GenInvitationCode:input_type -> openim.admin.GenInvitationCodeReq
-	38,  // 61: openim.admin.admin.FindInvitationCode:input_type -> openim.admin.FindInvitationCodeReq
-	40,  // 62: openim.admin.admin.UseInvitationCode:input_type -> openim.admin.UseInvitationCodeReq
-	42,  // 63: openim.admin.admin.DelInvitationCode:input_type -> openim.admin.DelInvitationCodeReq
-	45,  // 64: openim.admin.admin.SearchInvitationCode:input_type -> openim.admin.SearchInvitationCodeReq
-	47,  // 65: openim.admin.admin.SearchUserIPLimitLogin:input_type -> openim.admin.SearchUserIPLimitLoginReq
-	51,  // 66: openim.admin.admin.AddUserIPLimitLogin:input_type -> openim.admin.AddUserIPLimitLoginReq
-	53,  // 67: openim.admin.admin.DelUserIPLimitLogin:input_type -> openim.admin.DelUserIPLimitLoginReq
-	57,  // 68: openim.admin.admin.SearchIPForbidden:input_type -> openim.admin.SearchIPForbiddenReq
-	59,  // 69: openim.admin.admin.AddIPForbidden:input_type -> openim.admin.AddIPForbiddenReq
-	61,  // 70: openim.admin.admin.DelIPForbidden:input_type -> openim.admin.DelIPForbiddenReq
-	67,  // 71: openim.admin.admin.CancellationUser:input_type -> openim.admin.CancellationUserReq
-	69,  // 72: openim.admin.admin.BlockUser:input_type -> openim.admin.BlockUserReq
-	71,  // 73: openim.admin.admin.UnblockUser:input_type -> openim.admin.UnblockUserReq
-	73,  // 74: openim.admin.admin.SearchBlockUser:input_type -> openim.admin.SearchBlockUserReq
-	76,  // 75: openim.admin.admin.FindUserBlockInfo:input_type -> openim.admin.FindUserBlockInfoReq
-	63,  // 76: openim.admin.admin.CheckRegisterForbidden:input_type -> openim.admin.CheckRegisterForbiddenReq
-	65,  // 77: openim.admin.admin.CheckLoginForbidden:input_type -> openim.admin.CheckLoginForbiddenReq
-	79,  // 78: openim.admin.admin.CreateToken:input_type -> openim.admin.CreateTokenReq
-	81,  // 79: openim.admin.admin.ParseToken:input_type -> openim.admin.ParseTokenReq
-	85,  // 80: openim.admin.admin.AddApplet:input_type -> openim.admin.AddAppletReq
-	87,  // 81: openim.admin.admin.DelApplet:input_type -> openim.admin.DelAppletReq
-	89,  // 82: openim.admin.admin.UpdateApplet:input_type -> openim.admin.UpdateAppletReq
-	91,  // 83: openim.admin.admin.FindApplet:input_type -> openim.admin.FindAppletReq
-	93,  // 84: openim.admin.admin.SearchApplet:input_type -> openim.admin.SearchAppletReq
-	99,  // 85: openim.admin.admin.GetClientConfig:input_type -> openim.admin.GetClientConfigReq
-	95,  // 86: openim.admin.admin.SetClientConfig:input_type -> openim.admin.SetClientConfigReq
-	97,  // 87: openim.admin.admin.DelClientConfig:input_type -> openim.admin.DelClientConfigReq
-	101, // 88: openim.admin.admin.GetUserToken:input_type -> openim.admin.GetUserTokenReq
-	83,  // 89: openim.admin.admin.InvalidateToken:input_type -> openim.admin.InvalidateTokenReq
-	1,   // 90: openim.admin.admin.Login:output_type -> openim.admin.LoginResp
-	7,   // 91: openim.admin.admin.ChangePassword:output_type -> openim.admin.ChangePasswordResp
-	5,   // 92: openim.admin.admin.AdminUpdateInfo:output_type -> openim.admin.AdminUpdateInfoResp
-	15,  // 93: openim.admin.admin.GetAdminInfo:output_type -> openim.admin.GetAdminInfoResp
-	3,   // 94: openim.admin.admin.AddAdminAccount:output_type -> openim.admin.AddAdminAccountResp
-	10,  // 95: openim.admin.admin.ChangeAdminPassword:output_type -> openim.admin.ChangeAdminPasswordResp
-	12,  // 96: openim.admin.admin.DelAdminAccount:output_type -> openim.admin.DelAdminAccountResp
-	14,  // 97: openim.admin.admin.SearchAdminAccount:output_type -> openim.admin.SearchAdminAccountResp
-	17,  // 98: openim.admin.admin.AddDefaultFriend:output_type -> openim.admin.AddDefaultFriendResp
-	19,  // 99: openim.admin.admin.DelDefaultFriend:output_type -> openim.admin.DelDefaultFriendResp
-	21,  // 100: openim.admin.admin.FindDefaultFriend:output_type -> openim.admin.FindDefaultFriendResp
-	24,  // 101: openim.admin.admin.SearchDefaultFriend:output_type -> openim.admin.SearchDefaultFriendResp
-	26,  // 102: openim.admin.admin.AddDefaultGroup:output_type -> openim.admin.AddDefaultGroupResp
-	28,  // 103: openim.admin.admin.DelDefaultGroup:output_type -> openim.admin.DelDefaultGroupResp
-	30,  // 104: openim.admin.admin.FindDefaultGroup:output_type -> openim.admin.FindDefaultGroupResp
-	33,  // 105: openim.admin.admin.SearchDefaultGroup:output_type -> openim.admin.SearchDefaultGroupResp
-	35,  // 106: openim.admin.admin.AddInvitationCode:output_type -> openim.admin.AddInvitationCodeResp
-	37,  // 107: openim.admin.admin.GenInvitationCode:output_type -> openim.admin.GenInvitationCodeResp
-	39,  // 108: openim.admin.admin.FindInvitationCode:output_type -> openim.admin.FindInvitationCodeResp
-	41,  // 109: openim.admin.admin.UseInvitationCode:output_type -> openim.admin.UseInvitationCodeResp
-	43,  // 110: openim.admin.admin.DelInvitationCode:output_type -> openim.admin.DelInvitationCodeResp
-	46,  // 111: openim.admin.admin.SearchInvitationCode:output_type -> openim.admin.SearchInvitationCodeResp
-	49,  // 112: openim.admin.admin.SearchUserIPLimitLogin:output_type -> openim.admin.SearchUserIPLimitLoginResp
-	52,  // 113: openim.admin.admin.AddUserIPLimitLogin:output_type -> openim.admin.AddUserIPLimitLoginResp
-	54,  // 114: openim.admin.admin.DelUserIPLimitLogin:output_type -> openim.admin.DelUserIPLimitLoginResp
-	58,  // 115: openim.admin.admin.SearchIPForbidden:output_type -> openim.admin.SearchIPForbiddenResp
-	60,  // 116: openim.admin.admin.AddIPForbidden:output_type -> openim.admin.AddIPForbiddenResp
-	62,  // 117: openim.admin.admin.DelIPForbidden:output_type -> openim.admin.DelIPForbiddenResp
-	68,  // 118: openim.admin.admin.CancellationUser:output_type -> openim.admin.CancellationUserResp
-	70,  // 119: openim.admin.admin.BlockUser:output_type -> openim.admin.BlockUserResp
-	72,  // 120: openim.admin.admin.UnblockUser:output_type -> openim.admin.UnblockUserResp
-	75,  // 121: openim.admin.admin.SearchBlockUser:output_type -> openim.admin.SearchBlockUserResp
-	78,  // 122: openim.admin.admin.FindUserBlockInfo:output_type -> openim.admin.FindUserBlockInfoResp
-	64,  // 123: openim.admin.admin.CheckRegisterForbidden:output_type -> openim.admin.CheckRegisterForbiddenResp
-	66,  // 124: openim.admin.admin.CheckLoginForbidden:output_type -> openim.admin.CheckLoginForbiddenResp
-	80,  // 125: openim.admin.admin.CreateToken:output_type -> openim.admin.CreateTokenResp
-	82,  // 126: openim.admin.admin.ParseToken:output_type -> openim.admin.ParseTokenResp
-	86,  // 127: openim.admin.admin.AddApplet:output_type -> openim.admin.AddAppletResp
-	88,  // 128: openim.admin.admin.DelApplet:output_type -> openim.admin.DelAppletResp
-	90,  // 129: openim.admin.admin.UpdateApplet:output_type -> openim.admin.UpdateAppletResp
-	92,  // 130: openim.admin.admin.FindApplet:output_type -> openim.admin.FindAppletResp
-	94,  // 131: openim.admin.admin.SearchApplet:output_type -> openim.admin.SearchAppletResp
-	100, // 132: openim.admin.admin.GetClientConfig:output_type -> openim.admin.GetClientConfigResp
-	96,  // 133: openim.admin.admin.SetClientConfig:output_type -> openim.admin.SetClientConfigResp
-	98,  // 134: openim.admin.admin.DelClientConfig:output_type -> openim.admin.DelClientConfigResp
-	102, // 135: openim.admin.admin.GetUserToken:output_type -> openim.admin.GetUserTokenResp
-	84,  // 136: openim.admin.admin.InvalidateToken:output_type -> openim.admin.InvalidateTokenResp
-	90,  // [90:137] is the sub-list for method output_type
-	43,  // [43:90] is the sub-list for method input_type
-	43,  // [43:43] is the sub-list for extension type_name
-	43,  // [43:43] is the sub-list for extension extendee
-	0,   // [0:43] is the sub-list for field type_name
+
+// Deprecated: Use CheckLoginForbiddenResp.ProtoReflect.Descriptor instead.
+func (*CheckLoginForbiddenResp) Descriptor() ([]byte, []int) {
+	return file_admin_admin_proto_rawDescGZIP(), []int{103}
 }
 
-func init() { file_admin_admin_proto_init() }
-func file_admin_admin_proto_init() {
-	if File_admin_admin_proto != nil {
-		return
+// ################### login out ###################
+type CancellationUserReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
+	Reason string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason"`
+	// erase the account now instead of after the grace period
+	Immediate bool `protobuf:"varint,3,opt,name=immediate,proto3" json:"immediate"`
+}
+
+func (x *CancellationUserReq) Reset() {
+	*x = CancellationUserReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_admin_proto_msgTypes[104]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_admin_admin_proto_msgTypes[0].Exporter = func(v any, i int) any {
-			switch v := v.(*LoginReq); i {
+}
+
+func (x *CancellationUserReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancellationUserReq) ProtoMessage() {}
+
+func (x *CancellationUserReq) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_admin_proto_msgTypes[104]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancellationUserReq.ProtoReflect.Descriptor instead.
+func (*CancellationUserReq) Descriptor() ([]byte, []int) {
+	return file_admin_admin_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *CancellationUserReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *CancellationUserReq) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *CancellationUserReq) GetImmediate() bool {
+	if x != nil {
+		return x.Immediate
+	}
+	return false
+}
+
+type CancellationUserResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ExecuteAt int64 `protobuf:"varint,1,opt,name=executeAt,proto3" json:"executeAt"`
+}
+
+func (x *CancellationUserResp) Reset() {
+	*x = CancellationUserResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_admin_proto_msgTypes[105]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancellationUserResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancellationUserResp) ProtoMessage() {}
+
+func (x *CancellationUserResp) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_admin_proto_msgTypes[105]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancellationUserResp.ProtoReflect.Descriptor instead.
+func (*CancellationUserResp) Descriptor() ([]byte, []int) {
+	return file_admin_admin_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *CancellationUserResp) GetExecuteAt() int64 {
+	if x != nil {
+		return x.ExecuteAt
+	}
+	return 0
+}
+
+// ################### Block User, Unblock User ###################
+type BlockUserReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
+	Reason string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason"`
+	// unix milliseconds the block ends at, 0 blocks permanently
+	ExpireTime int64 `protobuf:"varint,3,opt,name=expireTime,proto3" json:"expireTime"`
+}
+
+func (x *BlockUserReq) Reset() {
+	*x = BlockUserReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_admin_proto_msgTypes[106]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BlockUserReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockUserReq) ProtoMessage() {}
+
+func (x *BlockUserReq) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_admin_proto_msgTypes[106]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockUserReq.ProtoReflect.Descriptor instead.
+func (*BlockUserReq) Descriptor() ([]byte, []int) {
+	return file_admin_admin_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *BlockUserReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *BlockUserReq) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *BlockUserReq) GetExpireTime() int64 {
+	if x != nil {
+		return x.ExpireTime
+	}
+	return 0
+}
+
+type BlockUserResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *BlockUserResp) Reset() {
+	*x = BlockUserResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_admin_proto_msgTypes[107]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BlockUserResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockUserResp) ProtoMessage() {}
+
+func (x *BlockUserResp) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_admin_proto_msgTypes[107]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockUserResp.ProtoReflect.Descriptor instead.
+func (*BlockUserResp) Descriptor() ([]byte, []int) {
+	return file_admin_admin_proto_rawDescGZIP(), []int{107}
+}
+
+type UnblockUserReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserIDs []string `protobuf:"bytes,1,rep,name=userIDs,proto3" json:"userIDs"`
+}
+
+func (x *UnblockUserReq) Reset() {
+	*x = UnblockUserReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_admin_proto_msgTypes[108]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnblockUserReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnblockUserReq) ProtoMessage() {}
+
+func (x *UnblockUserReq) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_admin_proto_msgTypes[108]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnblockUserReq.ProtoReflect.Descriptor instead.
+func (*UnblockUserReq) Descriptor() ([]byte, []int) {
+	return file_admin_admin_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *UnblockUserReq) GetUserIDs() []string {
+	if x != nil {
+		return x.UserIDs
+	}
+	return nil
+}
+
+type UnblockUserResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *UnblockUserResp) Reset() {
+	*x = UnblockUserResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_admin_proto_msgTypes[109]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnblockUserResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnblockUserResp) ProtoMessage() {}
+
+func (x *UnblockUserResp) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_admin_proto_msgTypes[109]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnblockUserResp.ProtoReflect.Descriptor instead.
+func (*UnblockUserResp) Descriptor() ([]byte, []int) {
+	return file_admin_admin_proto_rawDescGZIP(), []int{109}
+}
+
+type SearchBlockUserReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Keyword    string                    `protobuf:"bytes,1,opt,name=keyword,proto3" json:"keyword"`
+	Pagination *sdkwss.RequestPagination `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination"`
+}
+
+func (x *SearchBlockUserReq) Reset() {
+	*x = SearchBlockUserReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_admin_proto_msgTypes[110]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchBlockUserReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchBlockUserReq) ProtoMessage() {}
+
+func (x *SearchBlockUserReq) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_admin_proto_msgTypes[110]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchBlockUserReq.ProtoReflect.Descriptor instead.
+func (*SearchBlockUserReq) Descriptor() ([]byte, []int) {
+	return file_admin_admin_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *SearchBlockUserReq) GetKeyword() string {
+	if x != nil {
+		return x.Keyword
+	}
+	return ""
+}
+
+func (x *SearchBlockUserReq) GetPagination() *sdkwss.RequestPagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type BlockUserInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID      string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
+	Account     string `protobuf:"bytes,2,opt,name=account,proto3" json:"account"`
+	PhoneNumber string `protobuf:"bytes,3,opt,name=phoneNumber,proto3" json:"phoneNumber"`
+	AreaCode    string `protobuf:"bytes,4,opt,name=areaCode,proto3" json:"areaCode"`
+	Email       string `protobuf:"bytes,5,opt,name=email,proto3" json:"email"`
+	Nickname    string `protobuf:"bytes,6,opt,name=nickname,proto3" json:"nickname"`
+	FaceURL     string `protobuf:"bytes,7,opt,name=faceURL,proto3" json:"faceURL"`
+	Gender      int32  `protobuf:"varint,8,opt,name=gender,proto3" json:"gender"`
+	Reason      string `protobuf:"bytes,9,opt,name=reason,proto3" json:"reason"`
+	OpUserID    string `protobuf:"bytes,10,opt,name=opUserID,proto3" json:"opUserID"`
+	CreateTime  int64  `protobuf:"varint,11,opt,name=createTime,proto3" json:"createTime"`
+	ExpireTime  int64  `protobuf:"varint,12,opt,name=expireTime,proto3" json:"expireTime"`
+}
+
+func (x *BlockUserInfo) Reset() {
+	*x = BlockUserInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_admin_proto_msgTypes[111]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BlockUserInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockUserInfo) ProtoMessage() {}
+
+func (x *BlockUserInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_admin_proto_msgTypes[111]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockUserInfo.ProtoReflect.Descriptor instead.
+func (*BlockUserInfo) Descriptor() ([]byte, []int) {
+	return file_admin_admin_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *BlockUserInfo) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *BlockUserInfo) GetAccount() string {
+	if x != nil {
+		return x.Account
+	}
+	return ""
+}
+
+func (x *BlockUserInfo) GetPhoneNumber() string {
+	if x != nil {
+		return x.PhoneNumber
+	}
+	return ""
+}
+
+func (x *BlockUserInfo) GetAreaCode() string {
+	if x != nil {
+		return x.AreaCode
+	}
+	return ""
+}
+
+func (x *BlockUserInfo) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *BlockUserInfo) GetNickname() string {
+	if x != nil {
+		return x.Nickname
+	}
+	return ""
+}
+
+func (x *BlockUserInfo) GetFaceURL() string {
+	if x != nil {
+		return x.FaceURL
+	}
+	return ""
+}
+
+func (x *BlockUserInfo) GetGender() int32 {
+	if x != nil {
+		return x.Gender
+	}
+	return 0
+}
+
+func (x *BlockUserInfo) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *BlockUserInfo) GetOpUserID() string {
+	if x != nil {
+		return x.OpUserID
+	}
+	return ""
+}
+
+func (x *BlockUserInfo) GetCreateTime() int64 {
+	if x != nil {
+		return x.CreateTime
+	}
+	return 0
+}
+
+func (x *BlockUserInfo) GetExpireTime() int64 {
+	if x != nil {
+		return x.ExpireTime
+	}
+	return 0
+}
+
+type SearchBlockUserResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Total uint32           `protobuf:"varint,1,opt,name=total,proto3" json:"total"`
+	Users []*BlockUserInfo `protobuf:"bytes,2,rep,name=users,proto3" json:"users"`
+}
+
+func (x *SearchBlockUserResp) Reset() {
+	*x = SearchBlockUserResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_admin_proto_msgTypes[112]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchBlockUserResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchBlockUserResp) ProtoMessage() {}
+
+func (x *SearchBlockUserResp) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_admin_proto_msgTypes[112]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchBlockUserResp.ProtoReflect.Descriptor instead.
+func (*SearchBlockUserResp) Descriptor() ([]byte, []int) {
+	return file_admin_admin_proto_rawDescGZIP(), []int{112}
+}
+
+func (x *SearchBlockUserResp) GetTotal() uint32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *SearchBlockUserResp) GetUsers() []*BlockUserInfo {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+type FindUserBlockInfoReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserIDs []string `protobuf:"bytes,1,rep,name=userIDs,proto3" json:"userIDs"`
+}
+
+func (x *FindUserBlockInfoReq) Reset() {
+	*x = FindUserBlockInfoReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_admin_proto_msgTypes[113]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindUserBlockInfoReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindUserBlockInfoReq) ProtoMessage() {}
+
+func (x *FindUserBlockInfoReq) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_admin_proto_msgTypes[113]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindUserBlockInfoReq.ProtoReflect.Descriptor instead.
+func (*FindUserBlockInfoReq) Descriptor() ([]byte, []int) {
+	return file_admin_admin_proto_rawDescGZIP(), []int{113}
+}
+
+func (x *FindUserBlockInfoReq) GetUserIDs() []string {
+	if x != nil {
+		return x.UserIDs
+	}
+	return nil
+}
+
+type BlockInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID     string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
+	Reason     string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason"`
+	OpUserID   string `protobuf:"bytes,3,opt,name=opUserID,proto3" json:"opUserID"`
+	CreateTime int64  `protobuf:"varint,4,opt,name=createTime,proto3" json:"createTime"`
+	ExpireTime int64  `protobuf:"varint,5,opt,name=expireTime,proto3" json:"expireTime"`
+}
+
+func (x *BlockInfo) Reset() {
+	*x = BlockInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_admin_proto_msgTypes[114]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BlockInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockInfo) ProtoMessage() {}
+
+func (x *BlockInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_admin_proto_msgTypes[114]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockInfo.ProtoReflect.Descriptor instead.
+func (*BlockInfo) Descriptor() ([]byte, []int) {
+	return file_admin_admin_proto_rawDescGZIP(), []int{114}
+}
+
+func (x *BlockInfo) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *BlockInfo) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *BlockInfo) GetOpUserID() string {
+	if x != nil {
+		return x.OpUserID
+	}
+	return ""
+}
+
+func (x *BlockInfo) GetCreateTime() int64 {
+	if x != nil {
+		return x.CreateTime
+	}
+	return 0
+}
+
+func (x *BlockInfo) GetExpireTime() int64 {
+	if x != nil {
+		return x.ExpireTime
+	}
+	return 0
+}
+
+type FindUserBlockInfoResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Blocks []*BlockInfo `protobuf:"bytes,2,rep,name=blocks,proto3" json:"blocks"`
+}
+
+func (x *FindUserBlockInfoResp) Reset() {
+	*x = FindUserBlockInfoResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_admin_proto_msgTypes[115]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindUserBlockInfoResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindUserBlockInfoResp) ProtoMessage() {}
+
+func (x *FindUserBlockInfoResp) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_admin_proto_msgTypes[115]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindUserBlockInfoResp.ProtoReflect.Descriptor instead.
+func (*FindUserBlockInfoResp) Descriptor() ([]byte, []int) {
+	return file_admin_admin_proto_rawDescGZIP(), []int{115}
+}
+
+func (x *FindUserBlockInfoResp) GetBlocks() []*BlockInfo {
+	if x != nil {
+		return x.Blocks
+	}
+	return nil
+}
+
+type BlockHistory struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID     string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
+	Reason     string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason"`
+	OpUserID   string `protobuf:"bytes,3,opt,name=opUserID,proto3" json:"opUserID"`
+	CreateTime int64  `protobuf:"varint,4,opt,name=createTime,proto3" json:"createTime"`
+	ExpireTime int64  `protobuf:"varint,5,opt,name=expireTime,proto3" json:"expireTime"`
+	EndTime    int64  `protobuf:"varint,6,opt,name=endTime,proto3" json:"endTime"`
+	// expired or unblocked
+	EndReason string `protobuf:"bytes,7,opt,name=endReason,proto3" json:"endReason"`
+	// admin who lifted the block, empty if it expired
+	EndOpUserID string `protobuf:"bytes,8,opt,name=endOpUserID,proto3" json:"endOpUserID"`
+}
+
+func (x *BlockHistory) Reset() {
+	*x = BlockHistory{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_admin_proto_msgTypes[116]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BlockHistory) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockHistory) ProtoMessage() {}
+
+func (x *BlockHistory) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_admin_proto_msgTypes[116]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockHistory.ProtoReflect.Descriptor instead.
+func (*BlockHistory) Descriptor() ([]byte, []int) {
+	return file_admin_admin_proto_rawDescGZIP(), []int{116}
+}
+
+func (x *BlockHistory) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *BlockHistory) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *BlockHistory) GetOpUserID() string {
+	if x != nil {
+		return x.OpUserID
+	}
+	return ""
+}
+
+func (x *BlockHistory) GetCreateTime() int64 {
+	if x != nil {
+		return x.CreateTime
+	}
+	return 0
+}
+
+func (x *BlockHistory) GetExpireTime() int64 {
+	if x != nil {
+		return x.ExpireTime
+	}
+	return 0
+}
+
+func (x *BlockHistory) GetEndTime() int64 {
+	if x != nil {
+		return x.EndTime
+	}
+	return 0
+}
+
+func (x *BlockHistory) GetEndReason() string {
+	if x != nil {
+		return x.EndReason
+	}
+	return ""
+}
+
+func (x *BlockHistory) GetEndOpUserID() string {
+	if x != nil {
+		return x.EndOpUserID
+	}
+	return ""
+}
+
+type SearchBlockHistoryReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID     string                    `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
+	Pagination *sdkwss.RequestPagination `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination"`
+}
+
+func (x *SearchBlockHistoryReq) Reset() {
+	*x = SearchBlockHistoryReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_admin_proto_msgTypes[117]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchBlockHistoryReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchBlockHistoryReq) ProtoMessage() {}
+
+func (x *SearchBlockHistoryReq) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_admin_proto_msgTypes[117]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchBlockHistoryReq.ProtoReflect.Descriptor instead.
+func (*SearchBlockHistoryReq) Descriptor() ([]byte, []int) {
+	return file_admin_admin_proto_rawDescGZIP(), []int{117}
+}
+
+func (x *SearchBlockHistoryReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *SearchBlockHistoryReq) GetPagination() *sdkwss.RequestPagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type SearchBlockHistoryResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Total     uint32          `protobuf:"varint,1,opt,name=total,proto3" json:"total"`
+	Histories []*BlockHistory `protobuf:"bytes,2,rep,name=histories,proto3" json:"histories"`
+}
+
+func (x *SearchBlockHistoryResp) Reset() {
+	*x = SearchBlockHistoryResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_admin_proto_msgTypes[118]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchBlockHistoryResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchBlockHistoryResp) ProtoMessage() {}
+
+func (x *SearchBlockHistoryResp) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_admin_proto_msgTypes[118]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchBlockHistoryResp.ProtoReflect.Descriptor instead.
+func (*SearchBlockHistoryResp) Descriptor() ([]byte, []int) {
+	return file_admin_admin_proto_rawDescGZIP(), []int{118}
+}
+
+func (x *SearchBlockHistoryResp) GetTotal() uint32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *SearchBlockHistoryResp) GetHistories() []*BlockHistory {
+	if x != nil {
+		return x.Histories
+	}
+	return nil
+}
+
+type CreateTokenReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID   string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
+	UserType int32  `protobuf:"varint,32,opt,name=userType,proto3" json:"userType"`
+	// the login the token is issued for, shown in the session list
+	DeviceID  string `protobuf:"bytes,2,opt,name=deviceID,proto3" json:"deviceID"`
+	Platform  int32  `protobuf:"varint,3,opt,name=platform,proto3" json:"platform"`
+	Ip        string `protobuf:"bytes,4,opt,name=ip,proto3" json:"ip"`
+	UserAgent string `protobuf:"bytes,5,opt,name=userAgent,proto3" json:"userAgent"`
+}
+
+func (x *CreateTokenReq) Reset() {
+	*x = CreateTokenReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_admin_proto_msgTypes[119]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateTokenReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTokenReq) ProtoMessage() {}
+
+func (x *CreateTokenReq) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_admin_proto_msgTypes[119]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTokenReq.ProtoReflect.Descriptor instead.
+func (*CreateTokenReq) Descriptor() ([]byte, []int) {
+	return file_admin_admin_proto_rawDescGZIP(), []int{119}
+}
+
+func (x *CreateTokenReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *CreateTokenReq) GetUserType() int32 {
+	if x != nil {
+		return x.UserType
+	}
+	return 0
+}
+
+func (x *CreateTokenReq) GetDeviceID() string {
+	if x != nil {
+		return x.DeviceID
+	}
+	return ""
+}
+
+func (x *CreateTokenReq) GetPlatform() int32 {
+	if x != nil {
+		return x.Platform
+	}
+	return 0
+}
+
+func (x *CreateTokenReq) GetIp() string {
+	if x != nil {
+		return x.Ip
+	}
+	return ""
+}
+
+func (x *CreateTokenReq) GetUserAgent() string {
+	if x != nil {
+		return x.UserAgent
+	}
+	return ""
+}
+
+type CreateTokenResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Token     string `protobuf:"bytes,1,opt,name=token,proto3" json:"token"`
+	SessionID string `protobuf:"bytes,2,opt,name=sessionID,proto3" json:"sessionID"`
+	// empty when refresh tokens are disabled
+	RefreshToken string `protobuf:"bytes,3,opt,name=refreshToken,proto3" json:"refreshToken"`
+}
+
+func (x *CreateTokenResp) Reset() {
+	*x = CreateTokenResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_admin_proto_msgTypes[120]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateTokenResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTokenResp) ProtoMessage() {}
+
+func (x *CreateTokenResp) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_admin_proto_msgTypes[120]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTokenResp.ProtoReflect.Descriptor instead.
+func (*CreateTokenResp) Descriptor() ([]byte, []int) {
+	return file_admin_admin_proto_rawDescGZIP(), []int{120}
+}
+
+func (x *CreateTokenResp) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *CreateTokenResp) GetSessionID() string {
+	if x != nil {
+		return x.SessionID
+	}
+	return ""
+}
+
+func (x *CreateTokenResp) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+type ParseTokenReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token"`
+}
+
+func (x *ParseTokenReq) Reset() {
+	*x = ParseTokenReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_admin_proto_msgTypes[121]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ParseTokenReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ParseTokenReq) ProtoMessage() {}
+
+func (x *ParseTokenReq) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_admin_proto_msgTypes[121]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ParseTokenReq.ProtoReflect.Descriptor instead.
+func (*ParseTokenReq) Descriptor() ([]byte, []int) {
+	return file_admin_admin_proto_rawDescGZIP(), []int{121}
+}
+
+func (x *ParseTokenReq) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type ParseTokenResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID            string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
+	UserType          int32  `protobuf:"varint,2,opt,name=userType,proto3" json:"userType"`
+	ExpireTimeSeconds int64  `protobuf:"varint,3,opt,name=expireTimeSeconds,proto3" json:"expireTimeSeconds"`
+	SessionID         string `protobuf:"bytes,4,opt,name=sessionID,proto3" json:"sessionID"`
+	// the token was issued before refresh tokens, it is accepted until the migration window closes
+	Legacy bool `protobuf:"varint,5,opt,name=legacy,proto3" json:"legacy"`
+}
+
+func (x *ParseTokenResp) Reset() {
+	*x = ParseTokenResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_admin_proto_msgTypes[122]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ParseTokenResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ParseTokenResp) ProtoMessage() {}
+
+func (x *ParseTokenResp) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_admin_proto_msgTypes[122]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ParseTokenResp.ProtoReflect.Descriptor instead.
+func (*ParseTokenResp) Descriptor() ([]byte, []int) {
+	return file_admin_admin_proto_rawDescGZIP(), []int{122}
+}
+
+func (x *ParseTokenResp) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *ParseTokenResp) GetUserType() int32 {
+	if x != nil {
+		return x.UserType
+	}
+	return 0
+}
+
+func (x *ParseTokenResp) GetExpireTimeSeconds() int64 {
+	if x != nil {
+		return x.ExpireTimeSeconds
+	}
+	return 0
+}
+
+func (x *ParseTokenResp) GetSessionID() string {
+	if x != nil {
+		return x.SessionID
+	}
+	return ""
+}
+
+func (x *ParseTokenResp) GetLegacy() bool {
+	if x != nil {
+		return x.Legacy
+	}
+	return false
+}
+
+type RefreshTokenReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RefreshToken string `protobuf:"bytes,1,opt,name=refreshToken,proto3" json:"refreshToken"`
+	// the refresh token must have been issued for this user type
+	UserType  int32  `protobuf:"varint,2,opt,name=userType,proto3" json:"userType"`
+	Ip        string `protobuf:"bytes,3,opt,name=ip,proto3" json:"ip"`
+	UserAgent string `protobuf:"bytes,4,opt,name=userAgent,proto3" json:"userAgent"`
+}
+
+func (x *RefreshTokenReq) Reset() {
+	*x = RefreshTokenReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_admin_proto_msgTypes[123]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RefreshTokenReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefreshTokenReq) ProtoMessage() {}
+
+func (x *RefreshTokenReq) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_admin_proto_msgTypes[123]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefreshTokenReq.ProtoReflect.Descriptor instead.
+func (*RefreshTokenReq) Descriptor() ([]byte, []int) {
+	return file_admin_admin_proto_rawDescGZIP(), []int{123}
+}
+
+func (x *RefreshTokenReq) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+func (x *RefreshTokenReq) GetUserType() int32 {
+	if x != nil {
+		return x.UserType
+	}
+	return 0
+}
+
+func (x *RefreshTokenReq) GetIp() string {
+	if x != nil {
+		return x.Ip
+	}
+	return ""
+}
+
+func (x *RefreshTokenReq) GetUserAgent() string {
+	if x != nil {
+		return x.UserAgent
+	}
+	return ""
+}
+
+type RefreshTokenResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID       string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
+	Token        string `protobuf:"bytes,2,opt,name=token,proto3" json:"token"`
+	RefreshToken string `protobuf:"bytes,3,opt,name=refreshToken,proto3" json:"refreshToken"`
+	SessionID    string `protobuf:"bytes,4,opt,name=sessionID,proto3" json:"sessionID"`
+}
+
+func (x *RefreshTokenResp) Reset() {
+	*x = RefreshTokenResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_admin_proto_msgTypes[124]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RefreshTokenResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefreshTokenResp) ProtoMessage() {}
+
+func (x *RefreshTokenResp) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_admin_proto_msgTypes[124]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefreshTokenResp.ProtoReflect.Descriptor instead.
+func (*RefreshTokenResp) Descriptor() ([]byte, []int) {
+	return file_admin_admin_proto_rawDescGZIP(), []int{124}
+}
+
+func (x *RefreshTokenResp) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *RefreshTokenResp) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *RefreshTokenResp) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+func (x *RefreshTokenResp) GetSessionID() string {
+	if x != nil {
+		return x.SessionID
+	}
+	return ""
+}
+
+// JWK is a public key tokens are verified with, RFC 7517
+type JWK struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Kty string `protobuf:"bytes,1,opt,name=kty,proto3" json:"kty"`
+	Crv string `protobuf:"bytes,2,opt,name=crv,proto3" json:"crv"`
+	X   string `protobuf:"bytes,3,opt,name=x,proto3" json:"x"`
+	Y   string `protobuf:"bytes,4,opt,name=y,proto3" json:"y"`
+	Kid string `protobuf:"bytes,5,opt,name=kid,proto3" json:"kid"`
+	Alg string `protobuf:"bytes,6,opt,name=alg,proto3" json:"alg"`
+	Use string `protobuf:"bytes,7,opt,name=use,proto3" json:"use"`
+}
+
+func (x *JWK) Reset() {
+	*x = JWK{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_admin_proto_msgTypes[125]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JWK) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JWK) ProtoMessage() {}
+
+func (x *JWK) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_admin_proto_msgTypes[125]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JWK.ProtoReflect.Descriptor instead.
+func (*JWK) Descriptor() ([]byte, []int) {
+	return file_admin_admin_proto_rawDescGZIP(), []int{125}
+}
+
+func (x *JWK) GetKty() string {
+	if x != nil {
+		return x.Kty
+	}
+	return ""
+}
+
+func (x *JWK) GetCrv() string {
+	if x != nil {
+		return x.Crv
+	}
+	return ""
+}
+
+func (x *JWK) GetX() string {
+	if x != nil {
+		return x.X
+	}
+	return ""
+}
+
+func (x *JWK) GetY() string {
+	if x != nil {
+		return x.Y
+	}
+	return ""
+}
+
+func (x *JWK) GetKid() string {
+	if x != nil {
+		return x.Kid
+	}
+	return ""
+}
+
+func (x *JWK) GetAlg() string {
+	if x != nil {
+		return x.Alg
+	}
+	return ""
+}
+
+func (x *JWK) GetUse() string {
+	if x != nil {
+		return x.Use
+	}
+	return ""
+}
+
+type GetJWKSReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetJWKSReq) Reset() {
+	*x = GetJWKSReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_admin_proto_msgTypes[126]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetJWKSReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetJWKSReq) ProtoMessage() {}
+
+func (x *GetJWKSReq) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_admin_proto_msgTypes[126]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetJWKSReq.ProtoReflect.Descriptor instead.
+func (*GetJWKSReq) Descriptor() ([]byte, []int) {
+	return file_admin_admin_proto_rawDescGZIP(), []int{126}
+}
+
+type GetJWKSResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Keys []*JWK `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys"`
+}
+
+func (x *GetJWKSResp) Reset() {
+	*x = GetJWKSResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_admin_proto_msgTypes[127]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetJWKSResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetJWKSResp) ProtoMessage() {}
+
+func (x *GetJWKSResp) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_admin_proto_msgTypes[127]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetJWKSResp.ProtoReflect.Descriptor instead.
+func (*GetJWKSResp) Descriptor() ([]byte, []int) {
+	return file_admin_admin_proto_rawDescGZIP(), []int{127}
+}
+
+func (x *GetJWKSResp) GetKeys() []*JWK {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+type InvalidateTokenReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
+}
+
+func (x *InvalidateTokenReq) Reset() {
+	*x = InvalidateTokenReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_admin_proto_msgTypes[128]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InvalidateTokenReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvalidateTokenReq) ProtoMessage() {}
+
+func (x *InvalidateTokenReq) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_admin_proto_msgTypes[128]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InvalidateTokenReq.ProtoReflect.Descriptor instead.
+func (*InvalidateTokenReq) Descriptor() ([]byte, []int) {
+	return file_admin_admin_proto_rawDescGZIP(), []int{128}
+}
+
+func (x *InvalidateTokenReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+type InvalidateTokenResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *InvalidateTokenResp) Reset() {
+	*x = InvalidateTokenResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_admin_proto_msgTypes[129]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InvalidateTokenResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvalidateTokenResp) ProtoMessage() {}
+
+func (x *InvalidateTokenResp) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_admin_proto_msgTypes[129]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InvalidateTokenResp.ProtoReflect.Descriptor instead.
+func (*InvalidateTokenResp) Descriptor() ([]byte, []int) {
+	return file_admin_admin_proto_rawDescGZIP(), []int{129}
+}
+
+type Session struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SessionID  string `protobuf:"bytes,1,opt,name=sessionID,proto3" json:"sessionID"`
+	DeviceID   string `protobuf:"bytes,2,opt,name=deviceID,proto3" json:"deviceID"`
+	Platform   int32  `protobuf:"varint,3,opt,name=platform,proto3" json:"platform"`
+	Ip         string `protobuf:"bytes,4,opt,name=ip,proto3" json:"ip"`
+	UserAgent  string `protobuf:"bytes,5,opt,name=userAgent,proto3" json:"userAgent"`
+	CreateTime int64  `protobuf:"varint,6,opt,name=createTime,proto3" json:"createTime"`
+	LastSeen   int64  `protobuf:"varint,7,opt,name=lastSeen,proto3" json:"lastSeen"`
+	ExpireTime int64  `protobuf:"varint,8,opt,name=expireTime,proto3" json:"expireTime"`
+	// the session of the token of the request
+	Current bool `protobuf:"varint,9,opt,name=current,proto3" json:"current"`
+	// a token issued before sessions were recorded, it has no session, device or platform
+	Legacy bool `protobuf:"varint,10,opt,name=legacy,proto3" json:"legacy"`
+}
+
+func (x *Session) Reset() {
+	*x = Session{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_admin_proto_msgTypes[130]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Session) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Session) ProtoMessage() {}
+
+func (x *Session) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_admin_proto_msgTypes[130]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Session.ProtoReflect.Descriptor instead.
+func (*Session) Descriptor() ([]byte, []int) {
+	return file_admin_admin_proto_rawDescGZIP(), []int{130}
+}
+
+func (x *Session) GetSessionID() string {
+	if x != nil {
+		return x.SessionID
+	}
+	return ""
+}
+
+func (x *Session) GetDeviceID() string {
+	if x != nil {
+		return x.DeviceID
+	}
+	return ""
+}
+
+func (x *Session) GetPlatform() int32 {
+	if x != nil {
+		return x.Platform
+	}
+	return 0
+}
+
+func (x *Session) GetIp() string {
+	if x != nil {
+		return x.Ip
+	}
+	return ""
+}
+
+func (x *Session) GetUserAgent() string {
+	if x != nil {
+		return x.UserAgent
+	}
+	return ""
+}
+
+func (x *Session) GetCreateTime() int64 {
+	if x != nil {
+		return x.CreateTime
+	}
+	return 0
+}
+
+func (x *Session) GetLastSeen() int64 {
+	if x != nil {
+		return x.LastSeen
+	}
+	return 0
+}
+
+func (x *Session) GetExpireTime() int64 {
+	if x != nil {
+		return x.ExpireTime
+	}
+	return 0
+}
+
+func (x *Session) GetCurrent() bool {
+	if x != nil {
+		return x.Current
+	}
+	return false
+}
+
+func (x *Session) GetLegacy() bool {
+	if x != nil {
+		return x.Legacy
+	}
+	return false
+}
+
+type ListSessionsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID           string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
+	CurrentSessionID string `protobuf:"bytes,2,opt,name=currentSessionID,proto3" json:"currentSessionID"`
+	// the token of the request, marks the legacy entry of a legacy token as current
+	CurrentToken string `protobuf:"bytes,3,opt,name=currentToken,proto3" json:"currentToken"`
+}
+
+func (x *ListSessionsReq) Reset() {
+	*x = ListSessionsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_admin_proto_msgTypes[131]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListSessionsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSessionsReq) ProtoMessage() {}
+
+func (x *ListSessionsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_admin_proto_msgTypes[131]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSessionsReq.ProtoReflect.Descriptor instead.
+func (*ListSessionsReq) Descriptor() ([]byte, []int) {
+	return file_admin_admin_proto_rawDescGZIP(), []int{131}
+}
+
+func (x *ListSessionsReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *ListSessionsReq) GetCurrentSessionID() string {
+	if x != nil {
+		return x.CurrentSessionID
+	}
+	return ""
+}
+
+func (x *ListSessionsReq) GetCurrentToken() string {
+	if x != nil {
+		return x.CurrentToken
+	}
+	return ""
+}
+
+type ListSessionsResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sessions []*Session `protobuf:"bytes,1,rep,name=sessions,proto3" json:"sessions"`
+}
+
+func (x *ListSessionsResp) Reset() {
+	*x = ListSessionsResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_admin_proto_msgTypes[132]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListSessionsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSessionsResp) ProtoMessage() {}
+
+func (x *ListSessionsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_admin_proto_msgTypes[132]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSessionsResp.ProtoReflect.Descriptor instead.
+func (*ListSessionsResp) Descriptor() ([]byte, []int) {
+	return file_admin_admin_proto_rawDescGZIP(), []int{132}
+}
+
+func (x *ListSessionsResp) GetSessions() []*Session {
+	if x != nil {
+		return x.Sessions
+	}
+	return nil
+}
+
+type RevokeSessionReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID    string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
+	SessionID string `protobuf:"bytes,2,opt,name=sessionID,proto3" json:"sessionID"`
+}
+
+func (x *RevokeSessionReq) Reset() {
+	*x = RevokeSessionReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_admin_proto_msgTypes[133]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RevokeSessionReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeSessionReq) ProtoMessage() {}
+
+func (x *RevokeSessionReq) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_admin_proto_msgTypes[133]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeSessionReq.ProtoReflect.Descriptor instead.
+func (*RevokeSessionReq) Descriptor() ([]byte, []int) {
+	return file_admin_admin_proto_rawDescGZIP(), []int{133}
+}
+
+func (x *RevokeSessionReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *RevokeSessionReq) GetSessionID() string {
+	if x != nil {
+		return x.SessionID
+	}
+	return ""
+}
+
+type RevokeSessionResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *RevokeSessionResp) Reset() {
+	*x = RevokeSessionResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_admin_proto_msgTypes[134]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RevokeSessionResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeSessionResp) ProtoMessage() {}
+
+func (x *RevokeSessionResp) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_admin_proto_msgTypes[134]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeSessionResp.ProtoReflect.Descriptor instead.
+func (*RevokeSessionResp) Descriptor() ([]byte, []int) {
+	return file_admin_admin_proto_rawDescGZIP(), []int{134}
+}
+
+type RevokeOtherSessionsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID           string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
+	CurrentSessionID string `protobuf:"bytes,2,opt,name=currentSessionID,proto3" json:"currentSessionID"`
+	// the token of the request, kept when it is a legacy token
+	CurrentToken string `protobuf:"bytes,3,opt,name=currentToken,proto3" json:"currentToken"`
+}
+
+func (x *RevokeOtherSessionsReq) Reset() {
+	*x = RevokeOtherSessionsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_admin_proto_msgTypes[135]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RevokeOtherSessionsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeOtherSessionsReq) ProtoMessage() {}
+
+func (x *RevokeOtherSessionsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_admin_proto_msgTypes[135]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeOtherSessionsReq.ProtoReflect.Descriptor instead.
+func (*RevokeOtherSessionsReq) Descriptor() ([]byte, []int) {
+	return file_admin_admin_proto_rawDescGZIP(), []int{135}
+}
+
+func (x *RevokeOtherSessionsReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *RevokeOtherSessionsReq) GetCurrentSessionID() string {
+	if x != nil {
+		return x.CurrentSessionID
+	}
+	return ""
+}
+
+func (x *RevokeOtherSessionsReq) GetCurrentToken() string {
+	if x != nil {
+		return x.CurrentToken
+	}
+	return ""
+}
+
+type RevokeOtherSessionsResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Revoked int32 `protobuf:"varint,1,opt,name=revoked,proto3" json:"revoked"`
+}
+
+func (x *RevokeOtherSessionsResp) Reset() {
+	*x = RevokeOtherSessionsResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_admin_proto_msgTypes[136]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RevokeOtherSessionsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeOtherSessionsResp) ProtoMessage() {}
+
+func (x *RevokeOtherSessionsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_admin_proto_msgTypes[136]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeOtherSessionsResp.ProtoReflect.Descriptor instead.
+func (*RevokeOtherSessionsResp) Descriptor() ([]byte, []int) {
+	return file_admin_admin_proto_rawDescGZIP(), []int{136}
+}
+
+func (x *RevokeOtherSessionsResp) GetRevoked() int32 {
+	if x != nil {
+		return x.Revoked
+	}
+	return 0
+}
+
+type AddAppletReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         string `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	Name       string `protobuf:"bytes,2,opt,name=name,proto3" json:"name"`
+	AppID      string `protobuf:"bytes,3,opt,name=appID,proto3" json:"appID"`
+	Icon       string `protobuf:"bytes,4,opt,name=icon,proto3" json:"icon"`
+	Url        string `protobuf:"bytes,5,opt,name=url,proto3" json:"url"`
+	Md5        string `protobuf:"bytes,6,opt,name=md5,proto3" json:"md5"`
+	Size       int64  `protobuf:"varint,7,opt,name=size,proto3" json:"size"`
+	Version    string `protobuf:"bytes,8,opt,name=version,proto3" json:"version"`
+	Priority   uint32 `protobuf:"varint,9,opt,name=priority,proto3" json:"priority"`
+	Status     uint32 `protobuf:"varint,10,opt,name=status,proto3" json:"status"`
+	CreateTime int64  `protobuf:"varint,11,opt,name=createTime,proto3" json:"createTime"`
+}
+
+func (x *AddAppletReq) Reset() {
+	*x = AddAppletReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_admin_proto_msgTypes[137]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddAppletReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddAppletReq) ProtoMessage() {}
+
+func (x *AddAppletReq) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_admin_proto_msgTypes[137]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddAppletReq.ProtoReflect.Descriptor instead.
+func (*AddAppletReq) Descriptor() ([]byte, []int) {
+	return file_admin_admin_proto_rawDescGZIP(), []int{137}
+}
+
+func (x *AddAppletReq) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AddAppletReq) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AddAppletReq) GetAppID() string {
+	if x != nil {
+		return x.AppID
+	}
+	return ""
+}
+
+func (x *AddAppletReq) GetIcon() string {
+	if x != nil {
+		return x.Icon
+	}
+	return ""
+}
+
+func (x *AddAppletReq) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *AddAppletReq) GetMd5() string {
+	if x != nil {
+		return x.Md5
+	}
+	return ""
+}
+
+func (x *AddAppletReq) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *AddAppletReq) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *AddAppletReq) GetPriority() uint32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *AddAppletReq) GetStatus() uint32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *AddAppletReq) GetCreateTime() int64 {
+	if x != nil {
+		return x.CreateTime
+	}
+	return 0
+}
+
+type AddAppletResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *AddAppletResp) Reset() {
+	*x = AddAppletResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_admin_proto_msgTypes[138]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddAppletResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddAppletResp) ProtoMessage() {}
+
+func (x *AddAppletResp) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_admin_proto_msgTypes[138]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddAppletResp.ProtoReflect.Descriptor instead.
+func (*AddAppletResp) Descriptor() ([]byte, []int) {
+	return file_admin_admin_proto_rawDescGZIP(), []int{138}
+}
+
+type DelAppletReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AppletIds []string `protobuf:"bytes,1,rep,name=appletIds,proto3" json:"appletIds"`
+}
+
+func (x *DelAppletReq) Reset() {
+	*x = DelAppletReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_admin_proto_msgTypes[139]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DelAppletReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DelAppletReq) ProtoMessage() {}
+
+func (x *DelAppletReq) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_admin_proto_msgTypes[139]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DelAppletReq.ProtoReflect.Descriptor instead.
+func (*DelAppletReq) Descriptor() ([]byte, []int) {
+	return file_admin_admin_proto_rawDescGZIP(), []int{139}
+}
+
+func (x *DelAppletReq) GetAppletIds() []string {
+	if x != nil {
+		return x.AppletIds
+	}
+	return nil
+}
+
+type DelAppletResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DelAppletResp) Reset() {
+	*x = DelAppletResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_admin_proto_msgTypes[140]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DelAppletResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DelAppletResp) ProtoMessage() {}
+
+func (x *DelAppletResp) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_admin_proto_msgTypes[140]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DelAppletResp.ProtoReflect.Descriptor instead.
+func (*DelAppletResp) Descriptor() ([]byte, []int) {
+	return file_admin_admin_proto_rawDescGZIP(), []int{140}
+}
+
+type UpdateAppletReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         string                  `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	Name       *wrapperspb.StringValue `protobuf:"bytes,2,opt,name=name,proto3" json:"name"`
+	AppID      *wrapperspb.StringValue `protobuf:"bytes,3,opt,name=appID,proto3" json:"appID"`
+	Icon       *wrapperspb.StringValue `protobuf:"bytes,4,opt,name=icon,proto3" json:"icon"`
+	Url        *wrapperspb.StringValue `protobuf:"bytes,5,opt,name=url,proto3" json:"url"`
+	Md5        *wrapperspb.StringValue `protobuf:"bytes,6,opt,name=md5,proto3" json:"md5"`
+	Size       *wrapperspb.Int64Value  `protobuf:"bytes,7,opt,name=size,proto3" json:"size"`
+	Version    *wrapperspb.StringValue `protobuf:"bytes,8,opt,name=version,proto3" json:"version"`
+	Priority   *wrapperspb.UInt32Value `protobuf:"bytes,9,opt,name=priority,proto3" json:"priority"`
+	Status     *wrapperspb.UInt32Value `protobuf:"bytes,10,opt,name=status,proto3" json:"status"`
+	CreateTime *wrapperspb.Int64Value  `protobuf:"bytes,11,opt,name=createTime,proto3" json:"createTime"`
+}
+
+func (x *UpdateAppletReq) Reset() {
+	*x = UpdateAppletReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_admin_proto_msgTypes[141]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateAppletReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAppletReq) ProtoMessage() {}
+
+func (x *UpdateAppletReq) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_admin_proto_msgTypes[141]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateAppletReq.ProtoReflect.Descriptor instead.
+func (*UpdateAppletReq) Descriptor() ([]byte, []int) {
+	return file_admin_admin_proto_rawDescGZIP(), []int{141}
+}
+
+func (x *UpdateAppletReq) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateAppletReq) GetName() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Name
+	}
+	return nil
+}
+
+func (x *UpdateAppletReq) GetAppID() *wrapperspb.StringValue {
+	if x != nil {
+		return x.AppID
+	}
+	return nil
+}
+
+func (x *UpdateAppletReq) GetIcon() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Icon
+	}
+	return nil
+}
+
+func (x *UpdateAppletReq) GetUrl() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Url
+	}
+	return nil
+}
+
+func (x *UpdateAppletReq) GetMd5() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Md5
+	}
+	return nil
+}
+
+func (x *UpdateAppletReq) GetSize() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Size
+	}
+	return nil
+}
+
+func (x *UpdateAppletReq) GetVersion() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Version
+	}
+	return nil
+}
+
+func (x *UpdateAppletReq) GetPriority() *wrapperspb.UInt32Value {
+	if x != nil {
+		return x.Priority
+	}
+	return nil
+}
+
+func (x *UpdateAppletReq) GetStatus() *wrapperspb.UInt32Value {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *UpdateAppletReq) GetCreateTime() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+type UpdateAppletResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *UpdateAppletResp) Reset() {
+	*x = UpdateAppletResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_admin_proto_msgTypes[142]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateAppletResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAppletResp) ProtoMessage() {}
+
+func (x *UpdateAppletResp) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_admin_proto_msgTypes[142]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateAppletResp.ProtoReflect.Descriptor instead.
+func (*UpdateAppletResp) Descriptor() ([]byte, []int) {
+	return file_admin_admin_proto_rawDescGZIP(), []int{142}
+}
+
+type FindAppletReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *FindAppletReq) Reset() {
+	*x = FindAppletReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_admin_proto_msgTypes[143]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindAppletReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindAppletReq) ProtoMessage() {}
+
+func (x *FindAppletReq) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_admin_proto_msgTypes[143]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindAppletReq.ProtoReflect.Descriptor instead.
+func (*FindAppletReq) Descriptor() ([]byte, []int) {
+	return file_admin_admin_proto_rawDescGZIP(), []int{143}
+}
+
+type FindAppletResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Applets []*common.AppletInfo `protobuf:"bytes,1,rep,name=applets,proto3" json:"applets"`
+}
+
+func (x *FindAppletResp) Reset() {
+	*x = FindAppletResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_admin_proto_msgTypes[144]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindAppletResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindAppletResp) ProtoMessage() {}
+
+func (x *FindAppletResp) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_admin_proto_msgTypes[144]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindAppletResp.ProtoReflect.Descriptor instead.
+func (*FindAppletResp) Descriptor() ([]byte, []int) {
+	return file_admin_admin_proto_rawDescGZIP(), []int{144}
+}
+
+func (x *FindAppletResp) GetApplets() []*common.AppletInfo {
+	if x != nil {
+		return x.Applets
+	}
+	return nil
+}
+
+type SearchAppletReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Keyword    string                    `protobuf:"bytes,1,opt,name=keyword,proto3" json:"keyword"`
+	Pagination *sdkwss.RequestPagination `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination"`
+}
+
+func (x *SearchAppletReq) Reset() {
+	*x = SearchAppletReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_admin_proto_msgTypes[145]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchAppletReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchAppletReq) ProtoMessage() {}
+
+func (x *SearchAppletReq) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_admin_proto_msgTypes[145]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchAppletReq.ProtoReflect.Descriptor instead.
+func (*SearchAppletReq) Descriptor() ([]byte, []int) {
+	return file_admin_admin_proto_rawDescGZIP(), []int{145}
+}
+
+func (x *SearchAppletReq) GetKeyword() string {
+	if x != nil {
+		return x.Keyword
+	}
+	return ""
+}
+
+func (x *SearchAppletReq) GetPagination() *sdkwss.RequestPagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type SearchAppletResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Total   uint32               `protobuf:"varint,1,opt,name=total,proto3" json:"total"`
+	Applets []*common.AppletInfo `protobuf:"bytes,2,rep,name=applets,proto3" json:"applets"`
+}
+
+func (x *SearchAppletResp) Reset() {
+	*x = SearchAppletResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_admin_proto_msgTypes[146]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchAppletResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchAppletResp) ProtoMessage() {}
+
+func (x *SearchAppletResp) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_admin_proto_msgTypes[146]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchAppletResp.ProtoReflect.Descriptor instead.
+func (*SearchAppletResp) Descriptor() ([]byte, []int) {
+	return file_admin_admin_proto_rawDescGZIP(), []int{146}
+}
+
+func (x *SearchAppletResp) GetTotal() uint32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *SearchAppletResp) GetApplets() []*common.AppletInfo {
+	if x != nil {
+		return x.Applets
+	}
+	return nil
+}
+
+type SetClientConfigReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Config map[string]string `protobuf:"bytes,1,rep,name=config,proto3" json:"config,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *SetClientConfigReq) Reset() {
+	*x = SetClientConfigReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_admin_proto_msgTypes[147]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetClientConfigReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetClientConfigReq) ProtoMessage() {}
+
+func (x *SetClientConfigReq) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_admin_proto_msgTypes[147]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetClientConfigReq.ProtoReflect.Descriptor instead.
+func (*SetClientConfigReq) Descriptor() ([]byte, []int) {
+	return file_admin_admin_proto_rawDescGZIP(), []int{147}
+}
+
+func (x *SetClientConfigReq) GetConfig() map[string]string {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+type SetClientConfigResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SetClientConfigResp) Reset() {
+	*x = SetClientConfigResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_admin_proto_msgTypes[148]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetClientConfigResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetClientConfigResp) ProtoMessage() {}
+
+func (x *SetClientConfigResp) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_admin_proto_msgTypes[148]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetClientConfigResp.ProtoReflect.Descriptor instead.
+func (*SetClientConfigResp) Descriptor() ([]byte, []int) {
+	return file_admin_admin_proto_rawDescGZIP(), []int{148}
+}
+
+type DelClientConfigReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Keys []string `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys"`
+}
+
+func (x *DelClientConfigReq) Reset() {
+	*x = DelClientConfigReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_admin_proto_msgTypes[149]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DelClientConfigReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DelClientConfigReq) ProtoMessage() {}
+
+func (x *DelClientConfigReq) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_admin_proto_msgTypes[149]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DelClientConfigReq.ProtoReflect.Descriptor instead.
+func (*DelClientConfigReq) Descriptor() ([]byte, []int) {
+	return file_admin_admin_proto_rawDescGZIP(), []int{149}
+}
+
+func (x *DelClientConfigReq) GetKeys() []string {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+type DelClientConfigResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DelClientConfigResp) Reset() {
+	*x = DelClientConfigResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_admin_proto_msgTypes[150]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DelClientConfigResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DelClientConfigResp) ProtoMessage() {}
+
+func (x *DelClientConfigResp) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_admin_proto_msgTypes[150]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DelClientConfigResp.ProtoReflect.Descriptor instead.
+func (*DelClientConfigResp) Descriptor() ([]byte, []int) {
+	return file_admin_admin_proto_rawDescGZIP(), []int{150}
+}
+
+type GetClientConfigReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetClientConfigReq) Reset() {
+	*x = GetClientConfigReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_admin_proto_msgTypes[151]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetClientConfigReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetClientConfigReq) ProtoMessage() {}
+
+func (x *GetClientConfigReq) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_admin_proto_msgTypes[151]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetClientConfigReq.ProtoReflect.Descriptor instead.
+func (*GetClientConfigReq) Descriptor() ([]byte, []int) {
+	return file_admin_admin_proto_rawDescGZIP(), []int{151}
+}
+
+type GetClientConfigResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Config map[string]string `protobuf:"bytes,1,rep,name=config,proto3" json:"config,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *GetClientConfigResp) Reset() {
+	*x = GetClientConfigResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_admin_proto_msgTypes[152]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetClientConfigResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetClientConfigResp) ProtoMessage() {}
+
+func (x *GetClientConfigResp) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_admin_proto_msgTypes[152]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetClientConfigResp.ProtoReflect.Descriptor instead.
+func (*GetClientConfigResp) Descriptor() ([]byte, []int) {
+	return file_admin_admin_proto_rawDescGZIP(), []int{152}
+}
+
+func (x *GetClientConfigResp) GetConfig() map[string]string {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+type GetUserTokenReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
+}
+
+func (x *GetUserTokenReq) Reset() {
+	*x = GetUserTokenReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_admin_proto_msgTypes[153]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetUserTokenReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserTokenReq) ProtoMessage() {}
+
+func (x *GetUserTokenReq) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_admin_proto_msgTypes[153]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserTokenReq.ProtoReflect.Descriptor instead.
+func (*GetUserTokenReq) Descriptor() ([]byte, []int) {
+	return file_admin_admin_proto_rawDescGZIP(), []int{153}
+}
+
+func (x *GetUserTokenReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+type GetUserTokenResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TokensMap map[string]int32 `protobuf:"bytes,1,rep,name=tokensMap,proto3" json:"tokensMap,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+}
+
+func (x *GetUserTokenResp) Reset() {
+	*x = GetUserTokenResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_admin_admin_proto_msgTypes[154]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetUserTokenResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserTokenResp) ProtoMessage() {}
+
+func (x *GetUserTokenResp) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_admin_proto_msgTypes[154]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserTokenResp.ProtoReflect.Descriptor instead.
+func (*GetUserTokenResp) Descriptor() ([]byte, []int) {
+	return file_admin_admin_proto_rawDescGZIP(), []int{154}
+}
+
+func (x *GetUserTokenResp) GetTokensMap() map[string]int32 {
+	if x != nil {
+		return x.TokensMap
+	}
+	return nil
+}
+
+var File_admin_admin_proto protoreflect.FileDescriptor
+
+var file_admin_admin_proto_rawDesc = []byte{
+	0x0a, 0x11, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x12, 0x0c, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61, 0x64, 0x6d, 0x69,
+	0x6e, 0x1a, 0x13, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x13, 0x73, 0x64, 0x6b, 0x77, 0x73, 0x73, 0x2f, 0x73,
+	0x64, 0x6b, 0x77, 0x73, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x77, 0x72, 0x61,
+	0x70, 0x70, 0x65, 0x72, 0x73, 0x70, 0x62, 0x2f, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73,
+	0x70, 0x62, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x40, 0x0a, 0x08, 0x4c, 0x6f, 0x67, 0x69,
+	0x6e, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1a,
+	0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0xa7, 0x02, 0x0a, 0x09, 0x4c,
+	0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x22, 0x0a, 0x0c, 0x61, 0x64, 0x6d, 0x69,
+	0x6e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
+	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1e, 0x0a, 0x0a,
+	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1a, 0x0a, 0x08,
+	0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x66, 0x61, 0x63, 0x65,
+	0x55, 0x52, 0x4c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x66, 0x61, 0x63, 0x65, 0x55,
+	0x52, 0x4c, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x64, 0x6d, 0x69,
+	0x6e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61,
+	0x64, 0x6d, 0x69, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x26, 0x0a, 0x0e, 0x63, 0x68,
+	0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0e, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x77, 0x6f, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x74, 0x77, 0x6f, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72,
+	0x12, 0x22, 0x0a, 0x0c, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x74, 0x0a, 0x12, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x4c, 0x6f,
+	0x67, 0x69, 0x6e, 0x54, 0x4f, 0x54, 0x50, 0x52, 0x65, 0x71, 0x12, 0x26, 0x0a, 0x0e, 0x63, 0x68,
+	0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0e, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x72, 0x65, 0x63, 0x6f, 0x76, 0x65,
+	0x72, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65,
+	0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x22, 0x37, 0x0a, 0x0d, 0x45, 0x6e,
+	0x72, 0x6f, 0x6c, 0x6c, 0x54, 0x4f, 0x54, 0x50, 0x52, 0x65, 0x71, 0x12, 0x26, 0x0a, 0x0e, 0x63,
+	0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x22, 0x52, 0x0a, 0x0e, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x54, 0x4f, 0x54,
+	0x50, 0x52, 0x65, 0x73, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x28, 0x0a,
+	0x0f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x69, 0x6e, 0x67, 0x55, 0x52, 0x49,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x73, 0x69, 0x6f,
+	0x6e, 0x69, 0x6e, 0x67, 0x55, 0x52, 0x49, 0x22, 0x4c, 0x0a, 0x0e, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x72, 0x6d, 0x54, 0x4f, 0x54, 0x50, 0x52, 0x65, 0x71, 0x12, 0x26, 0x0a, 0x0e, 0x63, 0x68, 0x61,
+	0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0e, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x63, 0x6f, 0x64, 0x65, 0x22, 0x66, 0x0a, 0x0f, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d,
+	0x54, 0x4f, 0x54, 0x50, 0x52, 0x65, 0x73, 0x70, 0x12, 0x24, 0x0a, 0x0d, 0x72, 0x65, 0x63, 0x6f,
+	0x76, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x0d, 0x72, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x2d,
+	0x0a, 0x05, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e,
+	0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x4c, 0x6f, 0x67,
+	0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x52, 0x05, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x22, 0x24, 0x0a,
+	0x0e, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x4f, 0x54, 0x50, 0x52, 0x65, 0x71, 0x12,
+	0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63,
+	0x6f, 0x64, 0x65, 0x22, 0x11, 0x0a, 0x0f, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x4f,
+	0x54, 0x50, 0x52, 0x65, 0x73, 0x70, 0x22, 0x30, 0x0a, 0x1a, 0x52, 0x65, 0x67, 0x65, 0x6e, 0x65,
+	0x72, 0x61, 0x74, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x64, 0x65,
+	0x73, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x22, 0x43, 0x0a, 0x1b, 0x52, 0x65, 0x67, 0x65,
+	0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x43, 0x6f,
+	0x64, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x24, 0x0a, 0x0d, 0x72, 0x65, 0x63, 0x6f, 0x76,
+	0x65, 0x72, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d,
+	0x72, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x22, 0x4a, 0x0a,
+	0x12, 0x53, 0x65, 0x74, 0x54, 0x4f, 0x54, 0x50, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64,
+	0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1a, 0x0a,
+	0x08, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x08, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x22, 0x15, 0x0a, 0x13, 0x53, 0x65, 0x74,
+	0x54, 0x4f, 0x54, 0x50, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70,
+	0x22, 0x96, 0x01, 0x0a, 0x12, 0x41, 0x64, 0x64, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x18, 0x0a,
+	0x07, 0x66, 0x61, 0x63, 0x65, 0x55, 0x52, 0x4c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x66, 0x61, 0x63, 0x65, 0x55, 0x52, 0x4c, 0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x22, 0x15, 0x0a, 0x13, 0x41, 0x64, 0x64,
+	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x22, 0x99, 0x01, 0x0a, 0x09, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x72, 0x6f, 0x6c, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x72, 0x6f, 0x6c, 0x65, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x65,
+	0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x0b, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1e, 0x0a, 0x0a,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x5f, 0x0a, 0x0f,
+	0x41, 0x64, 0x64, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x12,
+	0x16, 0x0a, 0x06, 0x72, 0x6f, 0x6c, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x72, 0x6f, 0x6c, 0x65, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x70,
+	0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x0b, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x12, 0x0a,
+	0x10, 0x41, 0x64, 0x64, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x22, 0x62, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x64, 0x6d, 0x69, 0x6e,
+	0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x6f, 0x6c, 0x65, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x6f, 0x6c, 0x65, 0x49, 0x44, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x15, 0x0a, 0x13, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41,
+	0x64, 0x6d, 0x69, 0x6e, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x22, 0x2b, 0x0a, 0x0f,
+	0x44, 0x65, 0x6c, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x12,
+	0x18, 0x0a, 0x07, 0x72, 0x6f, 0x6c, 0x65, 0x49, 0x44, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x07, 0x72, 0x6f, 0x6c, 0x65, 0x49, 0x44, 0x73, 0x22, 0x12, 0x0a, 0x10, 0x44, 0x65, 0x6c,
+	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x22, 0x70, 0x0a,
+	0x12, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x6f, 0x6c, 0x65,
+	0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x40, 0x0a,
+	0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x20, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x73, 0x64, 0x6b, 0x77, 0x73,
+	0x73, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22,
+	0x5a, 0x0a, 0x13, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x6f,
+	0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x2d, 0x0a, 0x05,
+	0x72, 0x6f, 0x6c, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6f, 0x70,
+	0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e,
+	0x52, 0x6f, 0x6c, 0x65, 0x52, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x22, 0x46, 0x0a, 0x10, 0x53,
+	0x65, 0x74, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x12,
+	0x18, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x6f, 0x6c,
+	0x65, 0x49, 0x44, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x72, 0x6f, 0x6c, 0x65,
+	0x49, 0x44, 0x73, 0x22, 0x13, 0x0a, 0x11, 0x53, 0x65, 0x74, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52,
+	0x6f, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x22, 0x4d, 0x0a, 0x17, 0x43, 0x68, 0x65, 0x63,
+	0x6b, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x71, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x22, 0x1a, 0x0a, 0x18, 0x43, 0x68, 0x65, 0x63, 0x6b,
+	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x22, 0xf2, 0x01, 0x0a, 0x08, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67,
+	0x12, 0x26, 0x0a, 0x0e, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x55, 0x73, 0x65, 0x72,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x6f, 0x72, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68,
+	0x6f, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64,
+	0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a,
+	0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x4d, 0x73, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x4d, 0x73, 0x67, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x70, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x70, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x22, 0xbd, 0x01, 0x0a, 0x11, 0x53, 0x65, 0x61,
+	0x72, 0x63, 0x68, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x71, 0x12, 0x26,
+	0x0a, 0x0e, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72,
+	0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x14,
+	0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x03, 0x65, 0x6e, 0x64, 0x12, 0x40, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6f, 0x70, 0x65,
+	0x6e, 0x69, 0x6d, 0x2e, 0x73, 0x64, 0x6b, 0x77, 0x73, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x61,
+	0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x56, 0x0a, 0x12, 0x53, 0x65, 0x61, 0x72,
+	0x63, 0x68, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x73, 0x70, 0x12, 0x14,
+	0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x12, 0x2a, 0x0a, 0x04, 0x6c, 0x6f, 0x67, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61, 0x64, 0x6d, 0x69,
+	0x6e, 0x2e, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x52, 0x04, 0x6c, 0x6f, 0x67, 0x73,
+	0x22, 0x3a, 0x0a, 0x0e, 0x41, 0x64, 0x64, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x52,
+	0x65, 0x71, 0x12, 0x28, 0x0a, 0x03, 0x6c, 0x6f, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x16, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x41,
+	0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x52, 0x03, 0x6c, 0x6f, 0x67, 0x22, 0x11, 0x0a, 0x0f,
+	0x41, 0x64, 0x64, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x73, 0x70, 0x22,
+	0xab, 0x02, 0x0a, 0x12, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49,
+	0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x12, 0x36, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x38,
+	0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1c, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x08,
+	0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x36, 0x0a, 0x07, 0x66, 0x61, 0x63, 0x65,
+	0x55, 0x52, 0x4c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6f, 0x70, 0x65, 0x6e,
+	0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x07, 0x66, 0x61, 0x63, 0x65, 0x55, 0x52, 0x4c,
+	0x12, 0x38, 0x0a, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x52, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x31, 0x0a, 0x05, 0x6c, 0x65,
+	0x76, 0x65, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6f, 0x70, 0x65, 0x6e,
+	0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x33,
+	0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x22, 0x63, 0x0a,
+	0x13, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x66, 0x6f,
+	0x52, 0x65, 0x73, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08,
+	0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x66, 0x61, 0x63, 0x65,
+	0x55, 0x52, 0x4c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x66, 0x61, 0x63, 0x65, 0x55,
+	0x52, 0x4c, 0x22, 0x2f, 0x0a, 0x11, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x61, 0x73, 0x73,
+	0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77,
+	0x6f, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77,
+	0x6f, 0x72, 0x64, 0x22, 0x14, 0x0a, 0x12, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x61, 0x73,
+	0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x22, 0x11, 0x0a, 0x0f, 0x47, 0x65, 0x74,
+	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x22, 0x7c, 0x0a, 0x16,
+	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x50, 0x61, 0x73, 0x73, 0x77,
+	0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x28,
+	0x0a, 0x0f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74,
+	0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x6e, 0x65, 0x77, 0x50,
+	0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6e,
+	0x65, 0x77, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x19, 0x0a, 0x17, 0x43, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
+	0x64, 0x52, 0x65, 0x73, 0x70, 0x22, 0x2e, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x41, 0x64, 0x6d, 0x69,
+	0x6e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x44, 0x73, 0x22, 0x15, 0x0a, 0x13, 0x44, 0x65, 0x6c, 0x41, 0x64, 0x6d, 0x69,
+	0x6e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x22, 0x59, 0x0a, 0x15,
+	0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x52, 0x65, 0x71, 0x12, 0x40, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6f, 0x70, 0x65, 0x6e,
+	0x69, 0x6d, 0x2e, 0x73, 0x64, 0x6b, 0x77, 0x73, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x61, 0x67,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x74, 0x0a, 0x16, 0x53, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x44, 0x0a, 0x0d, 0x61, 0x64, 0x6d, 0x69, 0x6e,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e,
+	0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x47, 0x65,
+	0x74, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x52, 0x0d,
+	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x22, 0xca, 0x02,
+	0x0a, 0x10, 0x47, 0x65, 0x74, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65,
+	0x73, 0x70, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08,
+	0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x66, 0x61, 0x63, 0x65,
+	0x55, 0x52, 0x4c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x66, 0x61, 0x63, 0x65, 0x55,
+	0x52, 0x4c, 0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x1e, 0x0a, 0x0a,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b,
+	0x74, 0x6f, 0x74, 0x70, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0b, 0x74, 0x6f, 0x74, 0x70, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x22,
+	0x0a, 0x0c, 0x74, 0x6f, 0x74, 0x70, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x74, 0x6f, 0x74, 0x70, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72,
+	0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x65, 0x72, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x70,
+	0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x2f, 0x0a, 0x13, 0x41, 0x64,
+	0x64, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65,
+	0x71, 0x12, 0x18, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x07, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x22, 0x16, 0x0a, 0x14, 0x41,
+	0x64, 0x64, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52,
+	0x65, 0x73, 0x70, 0x22, 0x2f, 0x0a, 0x13, 0x44, 0x65, 0x6c, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c,
+	0x74, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x44, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x44, 0x73, 0x22, 0x16, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x44, 0x65, 0x66, 0x61, 0x75,
+	0x6c, 0x74, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x22, 0x16, 0x0a, 0x14,
+	0x46, 0x69, 0x6e, 0x64, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x46, 0x72, 0x69, 0x65, 0x6e,
+	0x64, 0x52, 0x65, 0x71, 0x22, 0x31, 0x0a, 0x15, 0x46, 0x69, 0x6e, 0x64, 0x44, 0x65, 0x66, 0x61,
+	0x75, 0x6c, 0x74, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x12, 0x18, 0x0a,
+	0x07, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x22, 0x74, 0x0a, 0x16, 0x53, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65,
+	0x71, 0x12, 0x18, 0x0a, 0x07, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x40, 0x0a, 0x0a, 0x70,
+	0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x20, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x73, 0x64, 0x6b, 0x77, 0x73, 0x73, 0x2e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x83, 0x01,
+	0x0a, 0x16, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x41,
+	0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44,
+	0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65,
+	0x12, 0x31, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d,
+	0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x55,
+	0x73, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x75,
+	0x73, 0x65, 0x72, 0x22, 0x6b, 0x0a, 0x17, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x44, 0x65, 0x66,
+	0x61, 0x75, 0x6c, 0x74, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x12, 0x14,
+	0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x12, 0x3a, 0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61, 0x64, 0x6d,
+	0x69, 0x6e, 0x2e, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64,
+	0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73,
+	0x22, 0x30, 0x0a, 0x12, 0x41, 0x64, 0x64, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x12, 0x1a, 0x0a, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49,
+	0x44, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49,
+	0x44, 0x73, 0x22, 0x15, 0x0a, 0x13, 0x41, 0x64, 0x64, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x22, 0x30, 0x0a, 0x12, 0x44, 0x65, 0x6c,
+	0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x12,
+	0x1a, 0x0a, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x73, 0x22, 0x15, 0x0a, 0x13, 0x44,
+	0x65, 0x6c, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65,
+	0x73, 0x70, 0x22, 0x15, 0x0a, 0x13, 0x46, 0x69, 0x6e, 0x64, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c,
+	0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x22, 0x32, 0x0a, 0x14, 0x46, 0x69, 0x6e,
+	0x64, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x73,
+	0x70, 0x12, 0x1a, 0x0a, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x73, 0x22, 0x73, 0x0a,
+	0x15, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64,
+	0x12, 0x40, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x73, 0x64,
+	0x6b, 0x77, 0x73, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x61, 0x67, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x22, 0x7a, 0x0a, 0x0e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x74, 0x74, 0x72, 0x69,
+	0x62, 0x75, 0x74, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x12, 0x1e,
+	0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x2e,
+	0x0a, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e,
+	0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x73, 0x64, 0x6b, 0x77, 0x73, 0x73, 0x2e, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x22, 0x4a,
+	0x0a, 0x16, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x1a,
+	0x0a, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x73, 0x22, 0xa8, 0x01, 0x0a, 0x14, 0x41,
+	0x64, 0x64, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65,
+	0x52, 0x65, 0x71, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x05, 0x63, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x61, 0x78,
+	0x55, 0x73, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x6d, 0x61, 0x78, 0x55,
+	0x73, 0x65, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x54, 0x69, 0x6d,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x54,
+	0x69, 0x6d, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x72, 0x55, 0x73,
+	0x65, 0x72, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x69, 0x6e, 0x76, 0x69,
+	0x74, 0x65, 0x72, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x6d,
+	0x70, 0x61, 0x69, 0x67, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x61, 0x6d,
+	0x70, 0x61, 0x69, 0x67, 0x6e, 0x22, 0x17, 0x0a, 0x15, 0x41, 0x64, 0x64, 0x49, 0x6e, 0x76, 0x69,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x22, 0xcc,
+	0x01, 0x0a, 0x14, 0x47, 0x65, 0x6e, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x6c, 0x65, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x6c, 0x65, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x6e, 0x75, 0x6d,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x6e, 0x75, 0x6d, 0x12, 0x14, 0x0a, 0x05, 0x63,
+	0x68, 0x61, 0x72, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x63, 0x68, 0x61, 0x72,
+	0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x61, 0x78, 0x55, 0x73, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x07, 0x6d, 0x61, 0x78, 0x55, 0x73, 0x65, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x65,
+	0x78, 0x70, 0x69, 0x72, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x69,
+	0x6e, 0x76, 0x69, 0x74, 0x65, 0x72, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0d, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x72, 0x55, 0x73, 0x65, 0x72, 0x49,
+	0x44, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x22, 0x2d, 0x0a,
+	0x15, 0x47, 0x65, 0x6e, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f,
+	0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x64, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x63, 0x6f, 0x64, 0x65, 0x73, 0x22, 0x2d, 0x0a, 0x15,
+	0x46, 0x69, 0x6e, 0x64, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f,
+	0x64, 0x65, 0x52, 0x65, 0x71, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x63, 0x6f, 0x64, 0x65, 0x73, 0x22, 0x50, 0x0a, 0x16, 0x46,
+	0x69, 0x6e, 0x64, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x36, 0x0a, 0x05, 0x63, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61, 0x64,
+	0x6d, 0x69, 0x6e, 0x2e, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x05, 0x63, 0x6f, 0x64, 0x65, 0x73, 0x22, 0x42, 0x0a,
+	0x14, 0x55, 0x73, 0x65, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f,
+	0x64, 0x65, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x44, 0x22, 0x17, 0x0a, 0x15, 0x55, 0x73, 0x65, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x22, 0x46, 0x0a, 0x18, 0x52, 0x65,
+	0x6c, 0x65, 0x61, 0x73, 0x65, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43,
+	0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x44, 0x22, 0x1b, 0x0a, 0x19, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x49, 0x6e, 0x76,
+	0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x22,
+	0x2c, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x64, 0x65, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x63, 0x6f, 0x64, 0x65, 0x73, 0x22, 0x17, 0x0a,
+	0x15, 0x44, 0x65, 0x6c, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f,
+	0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x22, 0x8a, 0x03, 0x0a, 0x12, 0x49, 0x6e, 0x76, 0x69, 0x74,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x12, 0x26, 0x0a,
+	0x0e, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54,
+	0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x75, 0x73, 0x65, 0x64, 0x55, 0x73, 0x65,
+	0x72, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x75, 0x73, 0x65, 0x64, 0x55,
+	0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x39, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x64, 0x55, 0x73, 0x65,
+	0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d,
+	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6c,
+	0x69, 0x63, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x08, 0x75, 0x73, 0x65, 0x64, 0x55, 0x73, 0x65, 0x72,
+	0x12, 0x18, 0x0a, 0x07, 0x6d, 0x61, 0x78, 0x55, 0x73, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x07, 0x6d, 0x61, 0x78, 0x55, 0x73, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x75, 0x73,
+	0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x75,
+	0x73, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69,
+	0x72, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x65, 0x78,
+	0x70, 0x69, 0x72, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x69, 0x6e, 0x76, 0x69,
+	0x74, 0x65, 0x72, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x72, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x37,
+	0x0a, 0x07, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1d, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e,
+	0x55, 0x73, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07,
+	0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x6d, 0x70, 0x61,
+	0x69, 0x67, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x61, 0x6d, 0x70, 0x61,
+	0x69, 0x67, 0x6e, 0x22, 0xff, 0x01, 0x0a, 0x17, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x49, 0x6e,
+	0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x12,
+	0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x44, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44,
+	0x73, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x05, 0x63, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6b, 0x65, 0x79, 0x77, 0x6f,
+	0x72, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72,
+	0x64, 0x12, 0x40, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x73,
+	0x64, 0x6b, 0x77, 0x73, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x61, 0x67,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x24, 0x0a, 0x0d, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x72, 0x55, 0x73,
+	0x65, 0x72, 0x49, 0x44, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x69, 0x6e, 0x76, 0x69,
+	0x74, 0x65, 0x72, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x6d,
+	0x70, 0x61, 0x69, 0x67, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x61, 0x6d,
+	0x70, 0x61, 0x69, 0x67, 0x6e, 0x22, 0x66, 0x0a, 0x18, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x49,
+	0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x34, 0x0a, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61,
+	0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x22, 0xe4, 0x01,
+	0x0a, 0x0d, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x73, 0x65, 0x12,
+	0x26, 0x0a, 0x0e, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12,
+	0x31, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e,
+	0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x55, 0x73,
+	0x65, 0x72, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x75, 0x73,
+	0x65, 0x72, 0x12, 0x24, 0x0a, 0x0d, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x72, 0x55, 0x73, 0x65,
+	0x72, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x69, 0x6e, 0x76, 0x69, 0x74,
+	0x65, 0x72, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x6d, 0x70,
+	0x61, 0x69, 0x67, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x61, 0x6d, 0x70,
+	0x61, 0x69, 0x67, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69,
+	0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x54, 0x69, 0x6d, 0x65, 0x22, 0xc8, 0x01, 0x0a, 0x16, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x49,
+	0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x73, 0x65, 0x52, 0x65, 0x71, 0x12,
+	0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63,
+	0x6f, 0x64, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x24, 0x0a, 0x0d, 0x69,
+	0x6e, 0x76, 0x69, 0x74, 0x65, 0x72, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0d, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x72, 0x55, 0x73, 0x65, 0x72, 0x49,
+	0x44, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x61, 0x6d, 0x70, 0x61, 0x69, 0x67, 0x6e, 0x12, 0x40, 0x0a,
+	0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x20, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x73, 0x64, 0x6b, 0x77, 0x73,
+	0x73, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22,
+	0x60, 0x0a, 0x17, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x55, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x12, 0x2f, 0x0a, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b,
+	0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x49, 0x6e,
+	0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x73, 0x65, 0x52, 0x04, 0x6c, 0x69, 0x73,
+	0x74, 0x22, 0xcd, 0x01, 0x0a, 0x10, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x37, 0x0a, 0x07, 0x69, 0x6e, 0x76, 0x69,
+	0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6f, 0x70, 0x65, 0x6e,
+	0x69, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x50, 0x75,
+	0x62, 0x6c, 0x69, 0x63, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65,
+	0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65,
+	0x64, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x05, 0x63, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x66, 0x69, 0x72, 0x73, 0x74,
+	0x54, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x66, 0x69, 0x72, 0x73,
+	0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x54, 0x69, 0x6d,
+	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x54, 0x69, 0x6d,
+	0x65, 0x22, 0xc0, 0x01, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x42, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x64, 0x54,
+	0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69,
+	0x6d, 0x65, 0x12, 0x40, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e,
+	0x73, 0x64, 0x6b, 0x77, 0x73, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x61,
+	0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x22, 0x63, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x76, 0x69, 0x74,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12,
+	0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x32, 0x0a, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61, 0x64, 0x6d,
+	0x69, 0x6e, 0x2e, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x52, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x22, 0x87, 0x01, 0x0a, 0x19, 0x53, 0x65,
+	0x61, 0x72, 0x63, 0x68, 0x55, 0x73, 0x65, 0x72, 0x49, 0x50, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x4c,
+	0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x6b, 0x65, 0x79, 0x77, 0x6f,
+	0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72,
+	0x64, 0x12, 0x40, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x73,
+	0x64, 0x6b, 0x77, 0x73, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x61, 0x67,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x70, 0x22, 0x8d, 0x01, 0x0a, 0x10, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x55, 0x73, 0x65,
+	0x72, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x49, 0x50, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x70,
+	0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65,
+	0x12, 0x31, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d,
+	0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x55,
+	0x73, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x75,
+	0x73, 0x65, 0x72, 0x22, 0x6a, 0x0a, 0x1a, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x55, 0x73, 0x65,
+	0x72, 0x49, 0x50, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x36, 0x0a, 0x06, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d,
+	0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x55, 0x73, 0x65, 0x72,
+	0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x49, 0x50, 0x52, 0x06, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x22,
+	0x3a, 0x0a, 0x10, 0x55, 0x73, 0x65, 0x72, 0x49, 0x50, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x4c, 0x6f,
+	0x67, 0x69, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x70, 0x22, 0x50, 0x0a, 0x16, 0x41,
+	0x64, 0x64, 0x55, 0x73, 0x65, 0x72, 0x49, 0x50, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x4c, 0x6f, 0x67,
+	0x69, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x36, 0x0a, 0x06, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61,
+	0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x50, 0x4c, 0x69, 0x6d, 0x69, 0x74,
+	0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x06, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x22, 0x19, 0x0a,
+	0x17, 0x41, 0x64, 0x64, 0x55, 0x73, 0x65, 0x72, 0x49, 0x50, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x4c,
+	0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x22, 0x50, 0x0a, 0x16, 0x44, 0x65, 0x6c, 0x55,
+	0x73, 0x65, 0x72, 0x49, 0x50, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52,
+	0x65, 0x71, 0x12, 0x36, 0x0a, 0x06, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61, 0x64, 0x6d, 0x69,
+	0x6e, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x50, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x4c, 0x6f, 0x67,
+	0x69, 0x6e, 0x52, 0x06, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x22, 0x19, 0x0a, 0x17, 0x44, 0x65,
+	0x6c, 0x55, 0x73, 0x65, 0x72, 0x49, 0x50, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x69,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x22, 0x83, 0x01, 0x0a, 0x0b, 0x49, 0x50, 0x46, 0x6f, 0x72, 0x62,
+	0x69, 0x64, 0x64, 0x65, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x70, 0x12, 0x24, 0x0a, 0x0d, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x52, 0x65,
+	0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x6c, 0x69,
+	0x6d, 0x69, 0x74, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x6c,
+	0x69, 0x6d, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0a, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x66, 0x0a, 0x0e, 0x49,
+	0x50, 0x46, 0x6f, 0x72, 0x62, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x41, 0x64, 0x64, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x70, 0x12, 0x24, 0x0a,
+	0x0d, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x67, 0x69, 0x73,
+	0x74, 0x65, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x69,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x4c, 0x6f,
+	0x67, 0x69, 0x6e, 0x22, 0x9a, 0x01, 0x0a, 0x14, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x49, 0x50,
+	0x46, 0x6f, 0x72, 0x62, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07,
+	0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6b,
+	0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x40,
+	0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x73, 0x64, 0x6b, 0x77,
+	0x73, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x70,
+	0x22, 0x68, 0x0a, 0x15, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x49, 0x50, 0x46, 0x6f, 0x72, 0x62,
+	0x69, 0x64, 0x64, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12,
+	0x39, 0x0a, 0x0a, 0x66, 0x6f, 0x72, 0x62, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61, 0x64, 0x6d,
+	0x69, 0x6e, 0x2e, 0x49, 0x50, 0x46, 0x6f, 0x72, 0x62, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x52, 0x0a,
+	0x66, 0x6f, 0x72, 0x62, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x73, 0x22, 0x51, 0x0a, 0x11, 0x41, 0x64,
+	0x64, 0x49, 0x50, 0x46, 0x6f, 0x72, 0x62, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x12,
+	0x3c, 0x0a, 0x0a, 0x66, 0x6f, 0x72, 0x62, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61, 0x64, 0x6d,
+	0x69, 0x6e, 0x2e, 0x49, 0x50, 0x46, 0x6f, 0x72, 0x62, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x41, 0x64,
+	0x64, 0x52, 0x0a, 0x66, 0x6f, 0x72, 0x62, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x73, 0x22, 0x14, 0x0a,
+	0x12, 0x41, 0x64, 0x64, 0x49, 0x50, 0x46, 0x6f, 0x72, 0x62, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x22, 0x25, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x49, 0x50, 0x46, 0x6f, 0x72, 0x62,
+	0x69, 0x64, 0x64, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x70, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x03, 0x69, 0x70, 0x73, 0x22, 0x14, 0x0a, 0x12, 0x44, 0x65,
+	0x6c, 0x49, 0x50, 0x46, 0x6f, 0x72, 0x62, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x22, 0x2b, 0x0a, 0x19, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65,
+	0x72, 0x46, 0x6f, 0x72, 0x62, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x70, 0x22, 0x1c, 0x0a,
+	0x1a, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x46, 0x6f,
+	0x72, 0x62, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x22, 0x40, 0x0a, 0x16, 0x43,
+	0x68, 0x65, 0x63, 0x6b, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x46, 0x6f, 0x72, 0x62, 0x69, 0x64, 0x64,
+	0x65, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x22, 0x19, 0x0a,
+	0x17, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x46, 0x6f, 0x72, 0x62, 0x69,
+	0x64, 0x64, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x22, 0x63, 0x0a, 0x13, 0x43, 0x61, 0x6e, 0x63,
+	0x65, 0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x12,
+	0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12,
+	0x1c, 0x0a, 0x09, 0x69, 0x6d, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x09, 0x69, 0x6d, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x74, 0x65, 0x22, 0x34, 0x0a,
+	0x14, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x73, 0x65,
+	0x72, 0x52, 0x65, 0x73, 0x70, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65,
+	0x41, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74,
+	0x65, 0x41, 0x74, 0x22, 0x5e, 0x0a, 0x0c, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72,
+	0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x72,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x54, 0x69, 0x6d,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x54,
+	0x69, 0x6d, 0x65, 0x22, 0x0f, 0x0a, 0x0d, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72,
+	0x52, 0x65, 0x73, 0x70, 0x22, 0x2a, 0x0a, 0x0e, 0x55, 0x6e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x55,
+	0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73,
+	0x22, 0x11, 0x0a, 0x0f, 0x55, 0x6e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x52,
+	0x65, 0x73, 0x70, 0x22, 0x70, 0x0a, 0x12, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x6b, 0x65, 0x79,
+	0x77, 0x6f, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6b, 0x65, 0x79, 0x77,
+	0x6f, 0x72, 0x64, 0x12, 0x40, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d,
+	0x2e, 0x73, 0x64, 0x6b, 0x77, 0x73, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50,
+	0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xd7, 0x02, 0x0a, 0x0d, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x55,
+	0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12,
+	0x18, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x68, 0x6f,
+	0x6e, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x70, 0x68, 0x6f, 0x6e, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x61,
+	0x72, 0x65, 0x61, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61,
+	0x72, 0x65, 0x61, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x1a, 0x0a,
+	0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x66, 0x61, 0x63,
+	0x65, 0x55, 0x52, 0x4c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x66, 0x61, 0x63, 0x65,
+	0x55, 0x52, 0x4c, 0x12, 0x16, 0x0a, 0x06, 0x67, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x06, 0x67, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x72,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x70, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x70, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12,
+	0x1e, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12,
+	0x1e, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x0c, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x22,
+	0x5e, 0x0a, 0x13, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x55, 0x73,
+	0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x31, 0x0a, 0x05,
+	0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6f, 0x70,
+	0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x22,
+	0x30, 0x0a, 0x14, 0x46, 0x69, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x44, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44,
+	0x73, 0x22, 0x97, 0x01, 0x0a, 0x09, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x12,
+	0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12,
+	0x1a, 0x0a, 0x08, 0x6f, 0x70, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x6f, 0x70, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x65,
+	0x78, 0x70, 0x69, 0x72, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x48, 0x0a, 0x15, 0x46,
+	0x69, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x6e, 0x66, 0x6f,
+	0x52, 0x65, 0x73, 0x70, 0x12, 0x2f, 0x0a, 0x06, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61, 0x64,
+	0x6d, 0x69, 0x6e, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x06, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x22, 0xf4, 0x01, 0x0a, 0x0c, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48,
+	0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x16,
+	0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x70, 0x55, 0x73, 0x65, 0x72,
+	0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x70, 0x55, 0x73, 0x65, 0x72,
+	0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69,
+	0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x54, 0x69, 0x6d, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x54, 0x69,
+	0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09,
+	0x65, 0x6e, 0x64, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x65, 0x6e,
+	0x64, 0x4f, 0x70, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x65, 0x6e, 0x64, 0x4f, 0x70, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x22, 0x71, 0x0a, 0x15,
+	0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x69, 0x73, 0x74, 0x6f,
+	0x72, 0x79, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x40, 0x0a,
+	0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x20, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x73, 0x64, 0x6b, 0x77, 0x73,
+	0x73, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22,
+	0x68, 0x0a, 0x16, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x69,
+	0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12,
+	0x38, 0x0a, 0x09, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61, 0x64, 0x6d, 0x69,
+	0x6e, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x09,
+	0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x22, 0xaa, 0x01, 0x0a, 0x0e, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65,
+	0x18, 0x20, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08,
+	0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08,
+	0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x70, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x70, 0x12, 0x1c, 0x0a, 0x09, 0x75, 0x73, 0x65, 0x72,
+	0x41, 0x67, 0x65, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x73, 0x65,
+	0x72, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x22, 0x69, 0x0a, 0x0f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12,
+	0x1c, 0x0a, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x22, 0x0a,
+	0x0c, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x22, 0x25, 0x0a, 0x0d, 0x50, 0x61, 0x72, 0x73, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52,
+	0x65, 0x71, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xa8, 0x01, 0x0a, 0x0e, 0x50, 0x61, 0x72,
+	0x73, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x2c, 0x0a, 0x11, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x65, 0x63,
+	0x6f, 0x6e, 0x64, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x65, 0x78, 0x70, 0x69,
+	0x72, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x1c, 0x0a,
+	0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x6c,
+	0x65, 0x67, 0x61, 0x63, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x6c, 0x65, 0x67,
+	0x61, 0x63, 0x79, 0x22, 0x7f, 0x0a, 0x0f, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x22, 0x0a, 0x0c, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73,
+	0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65,
+	0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73,
+	0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x75, 0x73,
+	0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x70, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x69, 0x70, 0x12, 0x1c, 0x0a, 0x09, 0x75, 0x73, 0x65, 0x72, 0x41, 0x67,
+	0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x73, 0x65, 0x72, 0x41,
+	0x67, 0x65, 0x6e, 0x74, 0x22, 0x82, 0x01, 0x0a, 0x10, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x44, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x22, 0x0a, 0x0c, 0x72, 0x65, 0x66, 0x72, 0x65,
+	0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72,
+	0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x73,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x22, 0x7b, 0x0a, 0x03, 0x4a, 0x57, 0x4b,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
+	0x74, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x72, 0x76, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x63, 0x72, 0x76, 0x12, 0x0c, 0x0a, 0x01, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x01, 0x78, 0x12, 0x0c, 0x0a, 0x01, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x01, 0x79,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
+	0x69, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x6c, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x61, 0x6c, 0x67, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x73, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x75, 0x73, 0x65, 0x22, 0x0c, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x4a, 0x57, 0x4b,
+	0x53, 0x52, 0x65, 0x71, 0x22, 0x34, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x4a, 0x57, 0x4b, 0x53, 0x52,
+	0x65, 0x73, 0x70, 0x12, 0x25, 0x0a, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x11, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e,
+	0x2e, 0x4a, 0x57, 0x4b, 0x52, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x22, 0x2c, 0x0a, 0x12, 0x49, 0x6e,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71,
+	0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x22, 0x15, 0x0a, 0x13, 0x49, 0x6e, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x22,
+	0x9b, 0x02, 0x0a, 0x07, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x73,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65, 0x76,
+	0x69, 0x63, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x65, 0x76,
+	0x69, 0x63, 0x65, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72,
+	0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72,
+	0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x70, 0x12, 0x1c, 0x0a, 0x09, 0x75, 0x73, 0x65, 0x72, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x73, 0x65, 0x72, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x12,
+	0x1e, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12,
+	0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x65, 0x65, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x65, 0x65, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x65,
+	0x78, 0x70, 0x69, 0x72, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x63, 0x75,
+	0x72, 0x72, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x6c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x22, 0x79, 0x0a,
+	0x0f, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71,
+	0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x2a, 0x0a, 0x10, 0x63, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x10, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x45, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74,
+	0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x31, 0x0a, 0x08,
+	0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15,
+	0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x53, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22,
+	0x48, 0x0a, 0x10, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x73,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x22, 0x13, 0x0a, 0x11, 0x52, 0x65, 0x76,
+	0x6f, 0x6b, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x22, 0x80,
+	0x01, 0x0a, 0x16, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x4f, 0x74, 0x68, 0x65, 0x72, 0x53, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x44, 0x12, 0x2a, 0x0a, 0x10, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x63, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x22, 0x0a,
+	0x0c, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x22, 0x33, 0x0a, 0x17, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x4f, 0x74, 0x68, 0x65, 0x72,
+	0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x18, 0x0a, 0x07,
+	0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x72,
+	0x65, 0x76, 0x6f, 0x6b, 0x65, 0x64, 0x22, 0x82, 0x02, 0x0a, 0x0c, 0x41, 0x64, 0x64, 0x41, 0x70,
+	0x70, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x61,
+	0x70, 0x70, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x70, 0x70, 0x49,
+	0x44, 0x12, 0x12, 0x0a, 0x04, 0x69, 0x63, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x69, 0x63, 0x6f, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x64, 0x35, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6d, 0x64, 0x35, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a,
+	0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x18, 0x0a,
+	0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72,
+	0x69, 0x74, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72,
+	0x69, 0x74, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x0f, 0x0a, 0x0d, 0x41,
+	0x64, 0x64, 0x41, 0x70, 0x70, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x22, 0x2c, 0x0a, 0x0c,
+	0x44, 0x65, 0x6c, 0x41, 0x70, 0x70, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09,
+	0x61, 0x70, 0x70, 0x6c, 0x65, 0x74, 0x49, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x09, 0x61, 0x70, 0x70, 0x6c, 0x65, 0x74, 0x49, 0x64, 0x73, 0x22, 0x0f, 0x0a, 0x0d, 0x44, 0x65,
+	0x6c, 0x41, 0x70, 0x70, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x22, 0xaf, 0x04, 0x0a, 0x0f,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x70, 0x70, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x71, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x30, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x32, 0x0a, 0x05, 0x61, 0x70, 0x70, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1c, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05,
+	0x61, 0x70, 0x70, 0x49, 0x44, 0x12, 0x30, 0x0a, 0x04, 0x69, 0x63, 0x6f, 0x6e, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x52, 0x04, 0x69, 0x63, 0x6f, 0x6e, 0x12, 0x2e, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x2e, 0x0a, 0x03, 0x6d, 0x64, 0x35, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x52, 0x03, 0x6d, 0x64, 0x35, 0x12, 0x2f, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x36, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6f, 0x70, 0x65, 0x6e,
+	0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x38, 0x0a, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x52, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x34, 0x0a, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6f, 0x70, 0x65,
+	0x6e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e,
+	0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x3b, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x12, 0x0a,
+	0x10, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x70, 0x70, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x22, 0x0f, 0x0a, 0x0d, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x70, 0x70, 0x6c, 0x65, 0x74, 0x52,
+	0x65, 0x71, 0x22, 0x45, 0x0a, 0x0e, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x70, 0x70, 0x6c, 0x65, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x12, 0x33, 0x0a, 0x07, 0x61, 0x70, 0x70, 0x6c, 0x65, 0x74, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63,
+	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f,
+	0x52, 0x07, 0x61, 0x70, 0x70, 0x6c, 0x65, 0x74, 0x73, 0x22, 0x6d, 0x0a, 0x0f, 0x53, 0x65, 0x61,
+	0x72, 0x63, 0x68, 0x41, 0x70, 0x70, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07,
+	0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6b,
+	0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x40, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6f, 0x70, 0x65,
+	0x6e, 0x69, 0x6d, 0x2e, 0x73, 0x64, 0x6b, 0x77, 0x73, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x61,
+	0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x5d, 0x0a, 0x10, 0x53, 0x65, 0x61, 0x72,
+	0x63, 0x68, 0x41, 0x70, 0x70, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x14, 0x0a, 0x05,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x12, 0x33, 0x0a, 0x07, 0x61, 0x70, 0x70, 0x6c, 0x65, 0x74, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x6f, 0x6d,
+	0x6d, 0x6f, 0x6e, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07,
+	0x61, 0x70, 0x70, 0x6c, 0x65, 0x74, 0x73, 0x22, 0x95, 0x01, 0x0a, 0x12, 0x53, 0x65, 0x74, 0x43,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x12, 0x44,
+	0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c,
+	0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x53, 0x65,
+	0x74, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71,
+	0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x1a, 0x39, 0x0a, 0x0b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22,
+	0x15, 0x0a, 0x13, 0x53, 0x65, 0x74, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x22, 0x28, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x43, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04,
+	0x6b, 0x65, 0x79, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x65, 0x79, 0x73,
+	0x22, 0x15, 0x0a, 0x13, 0x44, 0x65, 0x6c, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x22, 0x14, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x43, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x22, 0x97, 0x01,
+	0x0a, 0x13, 0x47, 0x65, 0x74, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x52, 0x65, 0x73, 0x70, 0x12, 0x45, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61,
+	0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x1a, 0x39, 0x0a, 0x0b,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x29, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x55, 0x73,
+	0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x44, 0x22, 0x9d, 0x01, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x4b, 0x0a, 0x09, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x73, 0x4d, 0x61, 0x70, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x6f, 0x70, 0x65,
+	0x6e, 0x69, 0x6d, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65,
+	0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x73, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x09, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x73, 0x4d, 0x61, 0x70, 0x1a, 0x3c, 0x0a, 0x0e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x4d, 0x61,
+	0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
+	0x38, 0x01, 0x32, 0x84, 0x30, 0x0a, 0x05, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x12, 0x38, 0x0a, 0x05,
+	0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x16, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61,
+	0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x17, 0x2e,
+	0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x4c, 0x6f, 0x67,
+	0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x53, 0x0a, 0x0e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x1f, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69,
+	0x6d, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x61,
+	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x1a, 0x20, 0x2e, 0x6f, 0x70, 0x65, 0x6e,
+	0x69, 0x6d, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50,
+	0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x12, 0x56, 0x0a, 0x0f, 0x41,
+	0x64, 0x6d, 0x69, 0x6e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x20,
+	0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x41, 0x64,
+	0x6d, 0x69, 0x6e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71,
+	0x1a, 0x21, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e,
+	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52,
+	0x65, 0x73, 0x70, 0x12, 0x4d, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x49,
+	0x6e, 0x66, 0x6f, 0x12, 0x1d, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61, 0x64, 0x6d,
+	0x69, 0x6e, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52,
+	0x65, 0x71, 0x1a, 0x1e, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61, 0x64, 0x6d, 0x69,
+	0x6e, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65,
+	0x73, 0x70, 0x12, 0x56, 0x0a, 0x0f, 0x41, 0x64, 0x64, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x20, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61,
+	0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x41, 0x64, 0x64, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x21, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d,
+	0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x41, 0x64, 0x64, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x62, 0x0a, 0x13, 0x43, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
+	0x64, 0x12, 0x24, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e,
+	0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x50, 0x61, 0x73, 0x73,
+	0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x1a, 0x25, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d,
+	0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x41, 0x64, 0x6d,
+	0x69, 0x6e, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x12, 0x56,
+	0x0a, 0x0f, 0x44, 0x65, 0x6c, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x20, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e,
+	0x2e, 0x44, 0x65, 0x6c, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x52, 0x65, 0x71, 0x1a, 0x21, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61, 0x64, 0x6d,
+	0x69, 0x6e, 0x2e, 0x44, 0x65, 0x6c, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x5f, 0x0a, 0x12, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x23, 0x2e, 0x6f,
+	0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x53, 0x65, 0x61, 0x72,
+	0x63, 0x68, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65,
+	0x71, 0x1a, 0x24, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e,
+	0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x4d, 0x0a, 0x0c, 0x41, 0x64, 0x64, 0x41, 0x64,
+	0x6d, 0x69, 0x6e, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x1d, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d,
+	0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x41, 0x64, 0x64, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52,
+	0x6f, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x1e, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e,
+	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x41, 0x64, 0x64, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x6f,
+	0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x56, 0x0a, 0x0f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x20, 0x2e, 0x6f, 0x70, 0x65, 0x6e,
+	0x69, 0x6d, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41,
+	0x64, 0x6d, 0x69, 0x6e, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x21, 0x2e, 0x6f, 0x70,
+	0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x4d,
+	0x0a, 0x0c, 0x44, 0x65, 0x6c, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x1d,
+	0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x44, 0x65,
+	0x6c, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x1e, 0x2e,
+	0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x44, 0x65, 0x6c,
+	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x56, 0x0a,
+	0x0f, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x6f, 0x6c, 0x65,
+	0x12, 0x20, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e,
+	0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x6f, 0x6c, 0x65, 0x52,
+	0x65, 0x71, 0x1a, 0x21, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61, 0x64, 0x6d, 0x69,
+	0x6e, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x6f, 0x6c,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x50, 0x0a, 0x0d, 0x53, 0x65, 0x74, 0x41, 0x64, 0x6d, 0x69,
+	0x6e, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x12, 0x1e, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e,
+	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x53, 0x65, 0x74, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x6f,
+	0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x1f, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e,
+	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x53, 0x65, 0x74, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x6f,
+	0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x65, 0x0a, 0x14, 0x43, 0x68, 0x65, 0x63, 0x6b,
+	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12,
+	0x25, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x43,
+	0x68, 0x65, 0x63, 0x6b, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x26, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e,
+	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x41, 0x64, 0x6d, 0x69, 0x6e,
+	0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x53,
+	0x0a, 0x0e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67,
+	0x12, 0x1f, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e,
+	0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x52, 0x65,
+	0x71, 0x1a, 0x20, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e,
+	0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x52,
+	0x65, 0x73, 0x70, 0x12, 0x4a, 0x0a, 0x0b, 0x41, 0x64, 0x64, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4c,
+	0x6f, 0x67, 0x12, 0x1c, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61, 0x64, 0x6d, 0x69,
+	0x6e, 0x2e, 0x41, 0x64, 0x64, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x71,
+	0x1a, 0x1d, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e,
+	0x41, 0x64, 0x64, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x73, 0x70, 0x12,
+	0x4c, 0x0a, 0x0f, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x54, 0x4f,
+	0x54, 0x50, 0x12, 0x20, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61, 0x64, 0x6d, 0x69,
+	0x6e, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x54, 0x4f, 0x54,
+	0x50, 0x52, 0x65, 0x71, 0x1a, 0x17, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61, 0x64,
+	0x6d, 0x69, 0x6e, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x47, 0x0a,
+	0x0a, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x54, 0x4f, 0x54, 0x50, 0x12, 0x1b, 0x2e, 0x6f, 0x70,
+	0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x45, 0x6e, 0x72, 0x6f, 0x6c,
+	0x6c, 0x54, 0x4f, 0x54, 0x50, 0x52, 0x65, 0x71, 0x1a, 0x1c, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69,
+	0x6d, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x45, 0x6e, 0x72, 0x6f, 0x6c, 0x6c, 0x54, 0x4f,
+	0x54, 0x50, 0x52, 0x65, 0x73, 0x70, 0x12, 0x4a, 0x0a, 0x0b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72,
+	0x6d, 0x54, 0x4f, 0x54, 0x50, 0x12, 0x1c, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61,
+	0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x54, 0x4f, 0x54, 0x50,
+	0x52, 0x65, 0x71, 0x1a, 0x1d, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61, 0x64, 0x6d,
+	0x69, 0x6e, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x54, 0x4f, 0x54, 0x50, 0x52, 0x65,
+	0x73, 0x70, 0x12, 0x4a, 0x0a, 0x0b, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x4f, 0x54,
+	0x50, 0x12, 0x1c, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e,
+	0x2e, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x4f, 0x54, 0x50, 0x52, 0x65, 0x71, 0x1a,
+	0x1d, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x44,
+	0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x4f, 0x54, 0x50, 0x52, 0x65, 0x73, 0x70, 0x12, 0x6e,
+	0x0a, 0x17, 0x52, 0x65, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x52, 0x65, 0x63, 0x6f,
+	0x76, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x28, 0x2e, 0x6f, 0x70, 0x65, 0x6e,
+	0x69, 0x6d, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x52, 0x65, 0x67, 0x65, 0x6e, 0x65, 0x72,
+	0x61, 0x74, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x73,
+	0x52, 0x65, 0x71, 0x1a, 0x29, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61, 0x64, 0x6d,
+	0x69, 0x6e, 0x2e, 0x52, 0x65, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x52, 0x65, 0x63,
+	0x6f, 0x76, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x56,
+	0x0a, 0x0f, 0x53, 0x65, 0x74, 0x54, 0x4f, 0x54, 0x50, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65,
+	0x64, 0x12, 0x20, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e,
+	0x2e, 0x53, 0x65, 0x74, 0x54, 0x4f, 0x54, 0x50, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64,
+	0x52, 0x65, 0x71, 0x1a, 0x21, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61, 0x64, 0x6d,
+	0x69, 0x6e, 0x2e, 0x53, 0x65, 0x74, 0x54, 0x4f, 0x54, 0x50, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72,
+	0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x12, 0x59, 0x0a, 0x10, 0x41, 0x64, 0x64, 0x44, 0x65, 0x66,
+	0x61, 0x75, 0x6c, 0x74, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x12, 0x21, 0x2e, 0x6f, 0x70, 0x65,
+	0x6e, 0x69, 0x6d, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x41, 0x64, 0x64, 0x44, 0x65, 0x66,
+	0x61, 0x75, 0x6c, 0x74, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x1a, 0x22, 0x2e,
+	0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x41, 0x64, 0x64,
+	0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x73,
+	0x70, 0x12, 0x59, 0x0a, 0x10, 0x44, 0x65, 0x6c, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x46,
+	0x72, 0x69, 0x65, 0x6e, 0x64, 0x12, 0x21, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61,
+	0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x44, 0x65, 0x6c, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x46,
+	0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x1a, 0x22, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69,
+	0x6d, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x44, 0x65, 0x6c, 0x44, 0x65, 0x66, 0x61, 0x75,
+	0x6c, 0x74, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x12, 0x5c, 0x0a, 0x11,
+	0x46, 0x69, 0x6e, 0x64, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x46, 0x72, 0x69, 0x65, 0x6e,
+	0x64, 0x12, 0x22, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e,
+	0x2e, 0x46, 0x69, 0x6e, 0x64, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x46, 0x72, 0x69, 0x65,
+	0x6e, 0x64, 0x52, 0x65, 0x71, 0x1a, 0x23, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61,
+	0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74,
+	0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x12, 0x62, 0x0a, 0x13, 0x53, 0x65,
+	0x61, 0x72, 0x63, 0x68, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x46, 0x72, 0x69, 0x65, 0x6e,
+	0x64, 0x12, 0x24, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e,
+	0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x46, 0x72,
+	0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x1a, 0x25, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d,
+	0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x44, 0x65, 0x66,
+	0x61, 0x75, 0x6c, 0x74, 0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x12, 0x56,
+	0x0a, 0x0f, 0x41, 0x64, 0x64, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x12, 0x20, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e,
+	0x2e, 0x41, 0x64, 0x64, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x52, 0x65, 0x71, 0x1a, 0x21, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61, 0x64, 0x6d,
+	0x69, 0x6e, 0x2e, 0x41, 0x64, 0x64, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x12, 0x56, 0x0a, 0x0f, 0x44, 0x65, 0x6c, 0x44, 0x65, 0x66,
+	0x61, 0x75, 0x6c, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x20, 0x2e, 0x6f, 0x70, 0x65, 0x6e,
+	0x69, 0x6d, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x44, 0x65, 0x6c, 0x44, 0x65, 0x66, 0x61,
+	0x75, 0x6c, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x1a, 0x21, 0x2e, 0x6f, 0x70,
+	0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x44, 0x65, 0x6c, 0x44, 0x65,
+	0x66, 0x61, 0x75, 0x6c, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x12, 0x59,
+	0x0a, 0x10, 0x46, 0x69, 0x6e, 0x64, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x12, 0x21, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61, 0x64, 0x6d, 0x69,
+	0x6e, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x52, 0x65, 0x71, 0x1a, 0x22, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61,
+	0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x12, 0x5f, 0x0a, 0x12, 0x53, 0x65, 0x61,
+	0x72, 0x63, 0x68, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12,
+	0x23, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x53,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x52, 0x65, 0x71, 0x1a, 0x24, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61, 0x64,
+	0x6d, 0x69, 0x6e, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c,
+	0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x12, 0x5c, 0x0a, 0x11, 0x41, 0x64,
+	0x64, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x12,
+	0x22, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x41,
+	0x64, 0x64, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65,
+	0x52, 0x65, 0x71, 0x1a, 0x23, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61, 0x64, 0x6d,
+	0x69, 0x6e, 0x2e, 0x41, 0x64, 0x64, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x5c, 0x0a, 0x11, 0x47, 0x65, 0x6e, 0x49,
+	0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x22, 0x2e,
+	0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x47, 0x65, 0x6e,
+	0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65,
+	0x71, 0x1a, 0x23, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e,
+	0x2e, 0x47, 0x65, 0x6e, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f,
+	0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x5f, 0x0a, 0x12, 0x46, 0x69, 0x6e, 0x64, 0x49, 0x6e,
+	0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x23, 0x2e, 0x6f,
+	0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x46, 0x69, 0x6e, 0x64,
+	0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65,
+	0x71, 0x1a, 0x24, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e,
+	0x2e, 0x46, 0x69, 0x6e, 0x64, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43,
+	0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x5c, 0x0a, 0x11, 0x55, 0x73, 0x65, 0x49, 0x6e,
+	0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x22, 0x2e, 0x6f,
+	0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x55, 0x73, 0x65, 0x49,
+	0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71,
+	0x1a, 0x23, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e,
+	0x55, 0x73, 0x65, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x68, 0x0a, 0x15, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65,
+	0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x26,
+	0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x52, 0x65,
+	0x6c, 0x65, 0x61, 0x73, 0x65, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43,
+	0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x27, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e,
+	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x49, 0x6e, 0x76,
+	0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12,
+	0x5c, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x43, 0x6f, 0x64, 0x65, 0x12, 0x22, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61, 0x64,
+	0x6d, 0x69, 0x6e, 0x2e, 0x44, 0x65, 0x6c, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x23, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69,
+	0x6d, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x44, 0x65, 0x6c, 0x49, 0x6e, 0x76, 0x69, 0x74,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x65, 0x0a,
+	0x14, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x25, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61,
+	0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x49, 0x6e, 0x76, 0x69, 0x74,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x26, 0x2e, 0x6f,
+	0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x53, 0x65, 0x61, 0x72,
+	0x63, 0x68, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x12, 0x62, 0x0a, 0x13, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x49, 0x6e,
+	0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x73, 0x65, 0x12, 0x24, 0x2e, 0x6f, 0x70,
+	0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x73, 0x65, 0x52, 0x65,
+	0x71, 0x1a, 0x25, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e,
+	0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x55, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x62, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x49,
+	0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12,
+	0x24, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x47,
+	0x65, 0x74, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6f,
+	0x72, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x25, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61,
+	0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x6b, 0x0a, 0x16,
+	0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x55, 0x73, 0x65, 0x72, 0x49, 0x50, 0x4c, 0x69, 0x6d, 0x69,
+	0x74, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x27, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e,
+	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x55, 0x73, 0x65, 0x72,
+	0x49, 0x50, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x1a,
+	0x28, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x53,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x55, 0x73, 0x65, 0x72, 0x49, 0x50, 0x4c, 0x69, 0x6d, 0x69, 0x74,
+	0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x62, 0x0a, 0x13, 0x41, 0x64, 0x64,
+	0x55, 0x73, 0x65, 0x72, 0x49, 0x50, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x69, 0x6e,
+	0x12, 0x24, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e,
+	0x41, 0x64, 0x64, 0x55, 0x73, 0x65, 0x72, 0x49, 0x50, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x4c, 0x6f,
+	0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x25, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e,
+	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x41, 0x64, 0x64, 0x55, 0x73, 0x65, 0x72, 0x49, 0x50, 0x4c,
+	0x69, 0x6d, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x62, 0x0a,
+	0x13, 0x44, 0x65, 0x6c, 0x55, 0x73, 0x65, 0x72, 0x49, 0x50, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x4c,
+	0x6f, 0x67, 0x69, 0x6e, 0x12, 0x24, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61, 0x64,
+	0x6d, 0x69, 0x6e, 0x2e, 0x44, 0x65, 0x6c, 0x55, 0x73, 0x65, 0x72, 0x49, 0x50, 0x4c, 0x69, 0x6d,
+	0x69, 0x74, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x25, 0x2e, 0x6f, 0x70, 0x65,
+	0x6e, 0x69, 0x6d, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x44, 0x65, 0x6c, 0x55, 0x73, 0x65,
+	0x72, 0x49, 0x50, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x12, 0x5c, 0x0a, 0x11, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x49, 0x50, 0x46, 0x6f, 0x72,
+	0x62, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x12, 0x22, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e,
+	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x49, 0x50, 0x46, 0x6f,
+	0x72, 0x62, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x23, 0x2e, 0x6f, 0x70, 0x65,
+	0x6e, 0x69, 0x6d, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x49, 0x50, 0x46, 0x6f, 0x72, 0x62, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12,
+	0x53, 0x0a, 0x0e, 0x41, 0x64, 0x64, 0x49, 0x50, 0x46, 0x6f, 0x72, 0x62, 0x69, 0x64, 0x64, 0x65,
+	0x6e, 0x12, 0x1f, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e,
+	0x2e, 0x41, 0x64, 0x64, 0x49, 0x50, 0x46, 0x6f, 0x72, 0x62, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x52,
+	0x65, 0x71, 0x1a, 0x20, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61, 0x64, 0x6d, 0x69,
+	0x6e, 0x2e, 0x41, 0x64, 0x64, 0x49, 0x50, 0x46, 0x6f, 0x72, 0x62, 0x69, 0x64, 0x64, 0x65, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x12, 0x53, 0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x49, 0x50, 0x46, 0x6f, 0x72,
+	0x62, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x12, 0x1f, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e,
+	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x44, 0x65, 0x6c, 0x49, 0x50, 0x46, 0x6f, 0x72, 0x62, 0x69,
+	0x64, 0x64, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x20, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d,
+	0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x44, 0x65, 0x6c, 0x49, 0x50, 0x46, 0x6f, 0x72, 0x62,
+	0x69, 0x64, 0x64, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x59, 0x0a, 0x10, 0x43, 0x61, 0x6e,
+	0x63, 0x65, 0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x12, 0x21, 0x2e,
+	0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x43, 0x61, 0x6e,
+	0x63, 0x65, 0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71,
+	0x1a, 0x22, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e,
+	0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x73, 0x65, 0x72,
+	0x52, 0x65, 0x73, 0x70, 0x12, 0x44, 0x0a, 0x09, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x55, 0x73, 0x65,
+	0x72, 0x12, 0x1a, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e,
+	0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x1b, 0x2e,
+	0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x12, 0x4a, 0x0a, 0x0b, 0x55, 0x6e,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x12, 0x1c, 0x2e, 0x6f, 0x70, 0x65, 0x6e,
+	0x69, 0x6d, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x55, 0x6e, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x1d, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d,
+	0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x55, 0x6e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x55, 0x73,
+	0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x12, 0x56, 0x0a, 0x0f, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x12, 0x20, 0x2e, 0x6f, 0x70, 0x65, 0x6e,
+	0x69, 0x6d, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x21, 0x2e, 0x6f, 0x70,
+	0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x12, 0x5c,
+	0x0a, 0x11, 0x46, 0x69, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x49,
+	0x6e, 0x66, 0x6f, 0x12, 0x22, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61, 0x64, 0x6d,
+	0x69, 0x6e, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x1a, 0x23, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d,
+	0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x12, 0x5f, 0x0a, 0x12,
+	0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x69, 0x73, 0x74, 0x6f,
+	0x72, 0x79, 0x12, 0x23, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61, 0x64, 0x6d, 0x69,
+	0x6e, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x69, 0x73,
+	0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x1a, 0x24, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d,
+	0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x12, 0x6b, 0x0a,
+	0x16, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x46, 0x6f,
+	0x72, 0x62, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x12, 0x27, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d,
+	0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x67, 0x69,
+	0x73, 0x74, 0x65, 0x72, 0x46, 0x6f, 0x72, 0x62, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x52, 0x65, 0x71,
+	0x1a, 0x28, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e,
+	0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x46, 0x6f, 0x72,
+	0x62, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x62, 0x0a, 0x13, 0x43, 0x68,
+	0x65, 0x63, 0x6b, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x46, 0x6f, 0x72, 0x62, 0x69, 0x64, 0x64, 0x65,
+	0x6e, 0x12, 0x24, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e,
+	0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x46, 0x6f, 0x72, 0x62, 0x69,
+	0x64, 0x64, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x25, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d,
+	0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x4c, 0x6f, 0x67, 0x69,
+	0x6e, 0x46, 0x6f, 0x72, 0x62, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x4a,
+	0x0a, 0x0b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1c, 0x2e,
+	0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x1d, 0x2e, 0x6f, 0x70,
+	0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x47, 0x0a, 0x0a, 0x50, 0x61,
+	0x72, 0x73, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1b, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69,
+	0x6d, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x50, 0x61, 0x72, 0x73, 0x65, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x1c, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61,
+	0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x50, 0x61, 0x72, 0x73, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x12, 0x4d, 0x0a, 0x0c, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x12, 0x1d, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61, 0x64, 0x6d,
+	0x69, 0x6e, 0x2e, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52,
+	0x65, 0x71, 0x1a, 0x1e, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61, 0x64, 0x6d, 0x69,
+	0x6e, 0x2e, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x12, 0x3e, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x4a, 0x57, 0x4b, 0x53, 0x12, 0x18, 0x2e,
+	0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x47, 0x65, 0x74,
+	0x4a, 0x57, 0x4b, 0x53, 0x52, 0x65, 0x71, 0x1a, 0x19, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d,
+	0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x47, 0x65, 0x74, 0x4a, 0x57, 0x4b, 0x53, 0x52, 0x65,
+	0x73, 0x70, 0x12, 0x44, 0x0a, 0x09, 0x41, 0x64, 0x64, 0x41, 0x70, 0x70, 0x6c, 0x65, 0x74, 0x12,
+	0x1a, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x41,
+	0x64, 0x64, 0x41, 0x70, 0x70, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x1b, 0x2e, 0x6f, 0x70,
+	0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x41, 0x64, 0x64, 0x41, 0x70,
+	0x70, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x44, 0x0a, 0x09, 0x44, 0x65, 0x6c, 0x41,
+	0x70, 0x70, 0x6c, 0x65, 0x74, 0x12, 0x1a, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61,
+	0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x44, 0x65, 0x6c, 0x41, 0x70, 0x70, 0x6c, 0x65, 0x74, 0x52, 0x65,
+	0x71, 0x1a, 0x1b, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e,
+	0x2e, 0x44, 0x65, 0x6c, 0x41, 0x70, 0x70, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x4d,
+	0x0a, 0x0c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x70, 0x70, 0x6c, 0x65, 0x74, 0x12, 0x1d,
+	0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x41, 0x70, 0x70, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x1e, 0x2e,
+	0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x41, 0x70, 0x70, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x47, 0x0a,
+	0x0a, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x70, 0x70, 0x6c, 0x65, 0x74, 0x12, 0x1b, 0x2e, 0x6f, 0x70,
+	0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x41,
+	0x70, 0x70, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x1c, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69,
+	0x6d, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x70, 0x70, 0x6c,
+	0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x4d, 0x0a, 0x0c, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x41, 0x70, 0x70, 0x6c, 0x65, 0x74, 0x12, 0x1d, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e,
+	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x41, 0x70, 0x70, 0x6c,
+	0x65, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x1e, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61,
+	0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x41, 0x70, 0x70, 0x6c, 0x65,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x56, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x43, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x20, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69,
+	0x6d, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x1a, 0x21, 0x2e, 0x6f, 0x70, 0x65,
+	0x6e, 0x69, 0x6d, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x12, 0x56, 0x0a,
+	0x0f, 0x53, 0x65, 0x74, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x12, 0x20, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e,
+	0x53, 0x65, 0x74, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52,
+	0x65, 0x71, 0x1a, 0x21, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61, 0x64, 0x6d, 0x69,
+	0x6e, 0x2e, 0x53, 0x65, 0x74, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x52, 0x65, 0x73, 0x70, 0x12, 0x56, 0x0a, 0x0f, 0x44, 0x65, 0x6c, 0x43, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x20, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69,
+	0x6d, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x44, 0x65, 0x6c, 0x43, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x1a, 0x21, 0x2e, 0x6f, 0x70, 0x65,
+	0x6e, 0x69, 0x6d, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x44, 0x65, 0x6c, 0x43, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x12, 0x4d, 0x0a,
+	0x0c, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1d, 0x2e,
+	0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x47, 0x65, 0x74,
+	0x55, 0x73, 0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x1e, 0x2e, 0x6f,
+	0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x47, 0x65, 0x74, 0x55,
+	0x73, 0x65, 0x72, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x56, 0x0a, 0x0f,
+	0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12,
+	0x20, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x49,
+	0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65,
+	0x71, 0x1a, 0x21, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e,
+	0x2e, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x12, 0x4d, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1d, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61, 0x64,
+	0x6d, 0x69, 0x6e, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x52, 0x65, 0x71, 0x1a, 0x1e, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61, 0x64, 0x6d,
+	0x69, 0x6e, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x12, 0x50, 0x0a, 0x0d, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x53, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61, 0x64,
+	0x6d, 0x69, 0x6e, 0x2e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x71, 0x1a, 0x1f, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61, 0x64,
+	0x6d, 0x69, 0x6e, 0x2e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x62, 0x0a, 0x13, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x4f,
+	0x74, 0x68, 0x65, 0x72, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x24, 0x2e, 0x6f,
+	0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x52, 0x65, 0x76, 0x6f,
+	0x6b, 0x65, 0x4f, 0x74, 0x68, 0x65, 0x72, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52,
+	0x65, 0x71, 0x1a, 0x25, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x61, 0x64, 0x6d, 0x69,
+	0x6e, 0x2e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x4f, 0x74, 0x68, 0x65, 0x72, 0x53, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x42, 0x2e, 0x5a, 0x2c, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x73, 0x64,
+	0x6b, 0x2f, 0x63, 0x68, 0x61, 0x74, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x63, 0x6f, 0x6c, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
+}
+
+var (
+	file_admin_admin_proto_rawDescOnce sync.Once
+	file_admin_admin_proto_rawDescData = file_admin_admin_proto_rawDesc
+)
+
+func file_admin_admin_proto_rawDescGZIP() []byte {
+	file_admin_admin_proto_rawDescOnce.Do(func() {
+		file_admin_admin_proto_rawDescData = protoimpl.X.CompressGZIP(file_admin_admin_proto_rawDescData)
+	})
+	return file_admin_admin_proto_rawDescData
+}
+
+var file_admin_admin_proto_msgTypes = make([]protoimpl.MessageInfo, 158)
+var file_admin_admin_proto_goTypes = []any{
+	(*LoginReq)(nil),                    // 0: openim.admin.LoginReq
+	(*LoginResp)(nil),                   // 1: openim.admin.LoginResp
+	(*VerifyLoginTOTPReq)(nil),          // 2: openim.admin.VerifyLoginTOTPReq
+	(*EnrollTOTPReq)(nil),               // 3: openim.admin.EnrollTOTPReq
+	(*EnrollTOTPResp)(nil),              // 4: openim.admin.EnrollTOTPResp
+	(*ConfirmTOTPReq)(nil),              // 5: openim.admin.ConfirmTOTPReq
+	(*ConfirmTOTPResp)(nil),             // 6: openim.admin.ConfirmTOTPResp
+	(*DisableTOTPReq)(nil),              // 7: openim.admin.DisableTOTPReq
+	(*DisableTOTPResp)(nil),             // 8: openim.admin.DisableTOTPResp
+	(*RegenerateRecoveryCodesReq)(nil),  // 9: openim.admin.RegenerateRecoveryCodesReq
+	(*RegenerateRecoveryCodesResp)(nil), // 10: openim.admin.RegenerateRecoveryCodesResp
+	(*SetTOTPRequiredReq)(nil),          // 11: openim.admin.SetTOTPRequiredReq
+	(*SetTOTPRequiredResp)(nil),         // 12: openim.admin.SetTOTPRequiredResp
+	(*AddAdminAccountReq)(nil),          // 13: openim.admin.AddAdminAccountReq
+	(*AddAdminAccountResp)(nil),         // 14: openim.admin.AddAdminAccountResp
+	(*AdminRole)(nil),                   // 15: openim.admin.AdminRole
+	(*AddAdminRoleReq)(nil),             // 16: openim.admin.AddAdminRoleReq
+	(*AddAdminRoleResp)(nil),            // 17: openim.admin.AddAdminRoleResp
+	(*UpdateAdminRoleReq)(nil),          // 18: openim.admin.UpdateAdminRoleReq
+	(*UpdateAdminRoleResp)(nil),         // 19: openim.admin.UpdateAdminRoleResp
+	(*DelAdminRoleReq)(nil),             // 20: openim.admin.DelAdminRoleReq
+	(*DelAdminRoleResp)(nil),            // 21: openim.admin.DelAdminRoleResp
+	(*SearchAdminRoleReq)(nil),          // 22: openim.admin.SearchAdminRoleReq
+	(*SearchAdminRoleResp)(nil),         // 23: openim.admin.SearchAdminRoleResp
+	(*SetAdminRolesReq)(nil),            // 24: openim.admin.SetAdminRolesReq
+	(*SetAdminRolesResp)(nil),           // 25: openim.admin.SetAdminRolesResp
+	(*CheckAdminPermissionReq)(nil),     // 26: openim.admin.CheckAdminPermissionReq
+	(*CheckAdminPermissionResp)(nil),    // 27: openim.admin.CheckAdminPermissionResp
+	(*AuditLog)(nil),                    // 28: openim.admin.AuditLog
+	(*SearchAuditLogReq)(nil),           // 29: openim.admin.SearchAuditLogReq
+	(*SearchAuditLogResp)(nil),          // 30: openim.admin.SearchAuditLogResp
+	(*AddAuditLogReq)(nil),              // 31: openim.admin.AddAuditLogReq
+	(*AddAuditLogResp)(nil),             // 32: openim.admin.AddAuditLogResp
+	(*AdminUpdateInfoReq)(nil),          // 33: openim.admin.AdminUpdateInfoReq
+	(*AdminUpdateInfoResp)(nil),         // 34: openim.admin.AdminUpdateInfoResp
+	(*ChangePasswordReq)(nil),           // 35: openim.admin.ChangePasswordReq
+	(*ChangePasswordResp)(nil),          // 36: openim.admin.ChangePasswordResp
+	(*GetAdminInfoReq)(nil),             // 37: openim.admin.GetAdminInfoReq
+	(*ChangeAdminPasswordReq)(nil),      // 38: openim.admin.ChangeAdminPasswordReq
+	(*ChangeAdminPasswordResp)(nil),     // 39: openim.admin.ChangeAdminPasswordResp
+	(*DelAdminAccountReq)(nil),          // 40: openim.admin.DelAdminAccountReq
+	(*DelAdminAccountResp)(nil),         // 41: openim.admin.DelAdminAccountResp
+	(*SearchAdminAccountReq)(nil),       // 42: openim.admin.SearchAdminAccountReq
+	(*SearchAdminAccountResp)(nil),      // 43: openim.admin.SearchAdminAccountResp
+	(*GetAdminInfoResp)(nil),            // 44: openim.admin.GetAdminInfoResp
+	(*AddDefaultFriendReq)(nil),         // 45: openim.admin.AddDefaultFriendReq
+	(*AddDefaultFriendResp)(nil),        // 46: openim.admin.AddDefaultFriendResp
+	(*DelDefaultFriendReq)(nil),         // 47: openim.admin.DelDefaultFriendReq
+	(*DelDefaultFriendResp)(nil),        // 48: openim.admin.DelDefaultFriendResp
+	(*FindDefaultFriendReq)(nil),        // 49: openim.admin.FindDefaultFriendReq
+	(*FindDefaultFriendResp)(nil),       // 50: openim.admin.FindDefaultFriendResp
+	(*SearchDefaultFriendReq)(nil),      // 51: openim.admin.SearchDefaultFriendReq
+	(*DefaultFriendAttribute)(nil),      // 52: openim.admin.DefaultFriendAttribute
+	(*SearchDefaultFriendResp)(nil),     // 53: openim.admin.SearchDefaultFriendResp
+	(*AddDefaultGroupReq)(nil),          // 54: openim.admin.AddDefaultGroupReq
+	(*AddDefaultGroupResp)(nil),         // 55: openim.admin.AddDefaultGroupResp
+	(*DelDefaultGroupReq)(nil),          // 56: openim.admin.DelDefaultGroupReq
+	(*DelDefaultGroupResp)(nil),         // 57: openim.admin.DelDefaultGroupResp
+	(*FindDefaultGroupReq)(nil),         // 58: openim.admin.FindDefaultGroupReq
+	(*FindDefaultGroupResp)(nil),        // 59: openim.admin.FindDefaultGroupResp
+	(*SearchDefaultGroupReq)(nil),       // 60: openim.admin.SearchDefaultGroupReq
+	(*GroupAttribute)(nil),              // 61: openim.admin.GroupAttribute
+	(*SearchDefaultGroupResp)(nil),      // 62: openim.admin.SearchDefaultGroupResp
+	(*AddInvitationCodeReq)(nil),        // 63: openim.admin.AddInvitationCodeReq
+	(*AddInvitationCodeResp)(nil),       // 64: openim.admin.AddInvitationCodeResp
+	(*GenInvitationCodeReq)(nil),        // 65: openim.admin.GenInvitationCodeReq
+	(*GenInvitationCodeResp)(nil),       // 66: openim.admin.GenInvitationCodeResp
+	(*FindInvitationCodeReq)(nil),       // 67: openim.admin.FindInvitationCodeReq
+	(*FindInvitationCodeResp)(nil),      // 68: openim.admin.FindInvitationCodeResp
+	(*UseInvitationCodeReq)(nil),        // 69: openim.admin.UseInvitationCodeReq
+	(*UseInvitationCodeResp)(nil),       // 70: openim.admin.UseInvitationCodeResp
+	(*ReleaseInvitationCodeReq)(nil),    // 71: openim.admin.ReleaseInvitationCodeReq
+	(*ReleaseInvitationCodeResp)(nil),   // 72: openim.admin.ReleaseInvitationCodeResp
+	(*DelInvitationCodeReq)(nil),        // 73: openim.admin.DelInvitationCodeReq
+	(*DelInvitationCodeResp)(nil),       // 74: openim.admin.DelInvitationCodeResp
+	(*InvitationRegister)(nil),          // 75: openim.admin.InvitationRegister
+	(*SearchInvitationCodeReq)(nil),     // 76: openim.admin.SearchInvitationCodeReq
+	(*SearchInvitationCodeResp)(nil),    // 77: openim.admin.SearchInvitationCodeResp
+	(*InvitationUse)(nil),               // 78: openim.admin.InvitationUse
+	(*SearchInvitationUseReq)(nil),      // 79: openim.admin.SearchInvitationUseReq
+	(*SearchInvitationUseResp)(nil),     // 80: openim.admin.SearchInvitationUseResp
+	(*InvitationReport)(nil),            // 81: openim.admin.InvitationReport
+	(*GetInvitationReportReq)(nil),      // 82: openim.admin.GetInvitationReportReq
+	(*GetInvitationReportResp)(nil),     // 83: openim.admin.GetInvitationReportResp
+	(*SearchUserIPLimitLoginReq)(nil),   // 84: openim.admin.SearchUserIPLimitLoginReq
+	(*LimitUserLoginIP)(nil),            // 85: openim.admin.LimitUserLoginIP
+	(*SearchUserIPLimitLoginResp)(nil),  // 86: openim.admin.SearchUserIPLimitLoginResp
+	(*UserIPLimitLogin)(nil),            // 87: openim.admin.UserIPLimitLogin
+	(*AddUserIPLimitLoginReq)(nil),      // 88: openim.admin.AddUserIPLimitLoginReq
+	(*AddUserIPLimitLoginResp)(nil),     // 89: openim.admin.AddUserIPLimitLoginResp
+	(*DelUserIPLimitLoginReq)(nil),      // 90: openim.admin.DelUserIPLimitLoginReq
+	(*DelUserIPLimitLoginResp)(nil),     // 91: openim.admin.DelUserIPLimitLoginResp
+	(*IPForbidden)(nil),                 // 92: openim.admin.IPForbidden
+	(*IPForbiddenAdd)(nil),              // 93: openim.admin.IPForbiddenAdd
+	(*SearchIPForbiddenReq)(nil),        // 94: openim.admin.SearchIPForbiddenReq
+	(*SearchIPForbiddenResp)(nil),       // 95: openim.admin.SearchIPForbiddenResp
+	(*AddIPForbiddenReq)(nil),           // 96: openim.admin.AddIPForbiddenReq
+	(*AddIPForbiddenResp)(nil),          // 97: openim.admin.AddIPForbiddenResp
+	(*DelIPForbiddenReq)(nil),           // 98: openim.admin.DelIPForbiddenReq
+	(*DelIPForbiddenResp)(nil),          // 99: openim.admin.DelIPForbiddenResp
+	(*CheckRegisterForbiddenReq)(nil),   // 100: openim.admin.CheckRegisterForbiddenReq
+	(*CheckRegisterForbiddenResp)(nil),  // 101: openim.admin.CheckRegisterForbiddenResp
+	(*CheckLoginForbiddenReq)(nil),      // 102: openim.admin.CheckLoginForbiddenReq
+	(*CheckLoginForbiddenResp)(nil),     // 103: openim.admin.CheckLoginForbiddenResp
+	(*CancellationUserReq)(nil),         // 104: openim.admin.CancellationUserReq
+	(*CancellationUserResp)(nil),        // 105: openim.admin.CancellationUserResp
+	(*BlockUserReq)(nil),                // 106: openim.admin.BlockUserReq
+	(*BlockUserResp)(nil),               // 107: openim.admin.BlockUserResp
+	(*UnblockUserReq)(nil),              // 108: openim.admin.UnblockUserReq
+	(*UnblockUserResp)(nil),             // 109: openim.admin.UnblockUserResp
+	(*SearchBlockUserReq)(nil),          // 110: openim.admin.SearchBlockUserReq
+	(*BlockUserInfo)(nil),               // 111: openim.admin.BlockUserInfo
+	(*SearchBlockUserResp)(nil),         // 112: openim.admin.SearchBlockUserResp
+	(*FindUserBlockInfoReq)(nil),        // 113: openim.admin.FindUserBlockInfoReq
+	(*BlockInfo)(nil),                   // 114: openim.admin.BlockInfo
+	(*FindUserBlockInfoResp)(nil),       // 115: openim.admin.FindUserBlockInfoResp
+	(*BlockHistory)(nil),                // 116: openim.admin.BlockHistory
+	(*SearchBlockHistoryReq)(nil),       // 117: openim.admin.SearchBlockHistoryReq
+	(*SearchBlockHistoryResp)(nil),      // 118: openim.admin.SearchBlockHistoryResp
+	(*CreateTokenReq)(nil),              // 119: openim.admin.CreateTokenReq
+	(*CreateTokenResp)(nil),             // 120: openim.admin.CreateTokenResp
+	(*ParseTokenReq)(nil),               // 121: openim.admin.ParseTokenReq
+	(*ParseTokenResp)(nil),              // 122: openim.admin.ParseTokenResp
+	(*RefreshTokenReq)(nil),             // 123: openim.admin.RefreshTokenReq
+	(*RefreshTokenResp)(nil),            // 124: openim.admin.RefreshTokenResp
+	(*JWK)(nil),                         // 125: openim.admin.JWK
+	(*GetJWKSReq)(nil),                  // 126: openim.admin.GetJWKSReq
+	(*GetJWKSResp)(nil),                 // 127: openim.admin.GetJWKSResp
+	(*InvalidateTokenReq)(nil),          // 128: openim.admin.InvalidateTokenReq
+	(*InvalidateTokenResp)(nil),         // 129: openim.admin.InvalidateTokenResp
+	(*Session)(nil),                     // 130: openim.admin.Session
+	(*ListSessionsReq)(nil),             // 131: openim.admin.ListSessionsReq
+	(*ListSessionsResp)(nil),            // 132: openim.admin.ListSessionsResp
+	(*RevokeSessionReq)(nil),            // 133: openim.admin.RevokeSessionReq
+	(*RevokeSessionResp)(nil),           // 134: openim.admin.RevokeSessionResp
+	(*RevokeOtherSessionsReq)(nil),      // 135: openim.admin.RevokeOtherSessionsReq
+	(*RevokeOtherSessionsResp)(nil),     // 136: openim.admin.RevokeOtherSessionsResp
+	(*AddAppletReq)(nil),                // 137: openim.admin.AddAppletReq
+	(*AddAppletResp)(nil),               // 138: openim.admin.AddAppletResp
+	(*DelAppletReq)(nil),                // 139: openim.admin.DelAppletReq
+	(*DelAppletResp)(nil),               // 140: openim.admin.DelAppletResp
+	(*UpdateAppletReq)(nil),             // 141: openim.admin.UpdateAppletReq
+	(*UpdateAppletResp)(nil),            // 142: openim.admin.UpdateAppletResp
+	(*FindAppletReq)(nil),               // 143: openim.admin.FindAppletReq
+	(*FindAppletResp)(nil),              // 144: openim.admin.FindAppletResp
+	(*SearchAppletReq)(nil),             // 145: openim.admin.SearchAppletReq
+	(*SearchAppletResp)(nil),            // 146: openim.admin.SearchAppletResp
+	(*SetClientConfigReq)(nil),          // 147: openim.admin.SetClientConfigReq
+	(*SetClientConfigResp)(nil),         // 148: openim.admin.SetClientConfigResp
+	(*DelClientConfigReq)(nil),          // 149: openim.admin.DelClientConfigReq
+	(*DelClientConfigResp)(nil),         // 150: openim.admin.DelClientConfigResp
+	(*GetClientConfigReq)(nil),          // 151: openim.admin.GetClientConfigReq
+	(*GetClientConfigResp)(nil),         // 152: openim.admin.GetClientConfigResp
+	(*GetUserTokenReq)(nil),             // 153: openim.admin.GetUserTokenReq
+	(*GetUserTokenResp)(nil),            // 154: openim.admin.GetUserTokenResp
+	nil,                                 // 155: openim.admin.SetClientConfigReq.ConfigEntry
+	nil,                                 // 156: openim.admin.GetClientConfigResp.ConfigEntry
+	nil,                                 // 157: openim.admin.GetUserTokenResp.TokensMapEntry
+	(*sdkwss.RequestPagination)(nil),    // 158: openim.sdkwss.RequestPagination
+	(*wrapperspb.StringValue)(nil),      // 159: openim.protobuf.StringValue
+	(*wrapperspb.Int32Value)(nil),       // 160: openim.protobuf.Int32Value
+	(*common.UserPublicInfo)(nil),       // 161: openim.common.UserPublicInfo
+	(*sdkwss.GroupInfo)(nil),            // 162: openim.sdkwss.GroupInfo
+	(*wrapperspb.Int64Value)(nil),       // 163: openim.protobuf.Int64Value
+	(*wrapperspb.UInt32Value)(nil),      // 164: openim.protobuf.UInt32Value
+	(*common.AppletInfo)(nil),           // 165: openim.common.AppletInfo
+}
+var file_admin_admin_proto_depIdxs = []int32{
+	1,   // 0: openim.admin.ConfirmTOTPResp.login:type_name -> openim.admin.LoginResp
+	158, // 1: openim.admin.SearchAdminRoleReq.pagination:type_name -> openim.sdkwss.RequestPagination
+	15,  // 2: openim.admin.SearchAdminRoleResp.roles:type_name -> openim.admin.AdminRole
+	158, // 3: openim.admin.SearchAuditLogReq.pagination:type_name -> openim.sdkwss.RequestPagination
+	28,  // 4: openim.admin.SearchAuditLogResp.logs:type_name -> openim.admin.AuditLog
+	28,  // 5: openim.admin.AddAuditLogReq.log:type_name -> openim.admin.AuditLog
+	159, // 6: openim.admin.AdminUpdateInfoReq.account:type_name -> openim.protobuf.StringValue
+	159, // 7: openim.admin.AdminUpdateInfoReq.password:type_name -> openim.protobuf.StringValue
+	159, // 8: openim.admin.AdminUpdateInfoReq.faceURL:type_name -> openim.protobuf.StringValue
+	159, // 9: openim.admin.AdminUpdateInfoReq.nickname:type_name -> openim.protobuf.StringValue
+	160, // 10: openim.admin.AdminUpdateInfoReq.level:type_name -> openim.protobuf.Int32Value
+	158, // 11: openim.admin.SearchAdminAccountReq.pagination:type_name -> openim.sdkwss.RequestPagination
+	44,  // 12: openim.admin.SearchAdminAccountResp.adminAccounts:type_name -> openim.admin.GetAdminInfoResp
+	158, // 13: openim.admin.SearchDefaultFriendReq.pagination:type_name -> openim.sdkwss.RequestPagination
+	161, // 14: openim.admin.DefaultFriendAttribute.user:type_name -> openim.common.UserPublicInfo
+	52,  // 15: openim.admin.SearchDefaultFriendResp.users:type_name -> openim.admin.DefaultFriendAttribute
+	158, // 16: openim.admin.SearchDefaultGroupReq.pagination:type_name -> openim.sdkwss.RequestPagination
+	162, // 17: openim.admin.GroupAttribute.group:type_name -> openim.sdkwss.GroupInfo
+	75,  // 18: openim.admin.FindInvitationCodeResp.codes:type_name -> openim.admin.InvitationRegister
+	161, // 19: openim.admin.InvitationRegister.usedUser:type_name -> openim.common.UserPublicInfo
+	161, // 20: openim.admin.InvitationRegister.inviter:type_name -> openim.common.UserPublicInfo
+	158, // 21: openim.admin.SearchInvitationCodeReq.pagination:type_name -> openim.sdkwss.RequestPagination
+	75,  // 22: openim.admin.SearchInvitationCodeResp.list:type_name -> openim.admin.InvitationRegister
+	161, // 23: openim.admin.InvitationUse.user:type_name -> openim.common.UserPublicInfo
+	158, // 24: openim.admin.SearchInvitationUseReq.pagination:type_name -> openim.sdkwss.RequestPagination
+	78,  // 25: openim.admin.SearchInvitationUseResp.list:type_name -> openim.admin.InvitationUse
+	161, // 26: openim.admin.InvitationReport.inviter:type_name -> openim.common.UserPublicInfo
+	158, // 27: openim.admin.GetInvitationReportReq.pagination:type_name -> openim.sdkwss.RequestPagination
+	81,  // 28: openim.admin.GetInvitationReportResp.list:type_name -> openim.admin.InvitationReport
+	158, // 29: openim.admin.SearchUserIPLimitLoginReq.pagination:type_name -> openim.sdkwss.RequestPagination
+	161, // 30: openim.admin.LimitUserLoginIP.user:type_name -> openim.common.UserPublicInfo
+	85,  // 31: openim.admin.SearchUserIPLimitLoginResp.limits:type_name -> openim.admin.LimitUserLoginIP
+	87,  // 32: openim.admin.AddUserIPLimitLoginReq.limits:type_name -> openim.admin.UserIPLimitLogin
+	87,  // 33: openim.admin.DelUserIPLimitLoginReq.limits:type_name -> openim.admin.UserIPLimitLogin
+	158, // 34: openim.admin.SearchIPForbiddenReq.pagination:type_name -> openim.sdkwss.RequestPagination
+	92,  // 35: openim.admin.SearchIPForbiddenResp.forbiddens:type_name -> openim.admin.IPForbidden
+	93,  // 36: openim.admin.AddIPForbiddenReq.forbiddens:type_name -> openim.admin.IPForbiddenAdd
+	158, // 37: openim.admin.SearchBlockUserReq.pagination:type_name -> openim.sdkwss.RequestPagination
+	111, // 38: openim.admin.SearchBlockUserResp.users:type_name -> openim.admin.BlockUserInfo
+	114, // 39: openim.admin.FindUserBlockInfoResp.blocks:type_name -> openim.admin.BlockInfo
+	158, // 40: openim.admin.SearchBlockHistoryReq.pagination:type_name -> openim.sdkwss.RequestPagination
+	116, // 41: openim.admin.SearchBlockHistoryResp.histories:type_name -> openim.admin.BlockHistory
+	125, // 42: openim.admin.GetJWKSResp.keys:type_name -> openim.admin.JWK
+	130, // 43: openim.admin.ListSessionsResp.sessions:type_name -> openim.admin.Session
+	159, // 44: openim.admin.UpdateAppletReq.name:type_name -> openim.protobuf.StringValue
+	159, // 45: openim.admin.UpdateAppletReq.appID:type_name -> openim.protobuf.StringValue
+	159, // 46: openim.admin.UpdateAppletReq.icon:type_name -> openim.protobuf.StringValue
+	159, // 47: openim.admin.UpdateAppletReq.url:type_name -> openim.protobuf.StringValue
+	159, // 48: openim.admin.UpdateAppletReq.md5:type_name -> openim.protobuf.StringValue
+	163, // 49: openim.admin.UpdateAppletReq.size:type_name -> openim.protobuf.Int64Value
+	159, // 50: openim.admin.UpdateAppletReq.version:type_name -> openim.protobuf.StringValue
+	164, // 51: openim.admin.UpdateAppletReq.priority:type_name -> openim.protobuf.UInt32Value
+	164, // 52: openim.admin.UpdateAppletReq.status:type_name -> openim.protobuf.UInt32Value
+	163, // 53: openim.admin.UpdateAppletReq.createTime:type_name -> openim.protobuf.Int64Value
+	165, // 54: openim.admin.FindAppletResp.applets:type_name -> openim.common.AppletInfo
+	158, // 55: openim.admin.SearchAppletReq.pagination:type_name -> openim.sdkwss.RequestPagination
+	165, // 56: openim.admin.SearchAppletResp.applets:type_name -> openim.common.AppletInfo
+	155, // 57: openim.admin.SetClientConfigReq.config:type_name -> openim.admin.SetClientConfigReq.ConfigEntry
+	156, // 58: openim.admin.GetClientConfigResp.config:type_name -> openim.admin.GetClientConfigResp.ConfigEntry
+	157, // 59: openim.admin.GetUserTokenResp.tokensMap:type_name -> openim.admin.GetUserTokenResp.TokensMapEntry
+	0,   // 60: openim.admin.admin.Login:input_type -> openim.admin.LoginReq
+	35,  // 61: openim.admin.admin.ChangePassword:input_type -> openim.admin.ChangePasswordReq
+	33,  // 62: openim.admin.admin.AdminUpdateInfo:input_type -> openim.admin.AdminUpdateInfoReq
+	37,  // 63: openim.admin.admin.GetAdminInfo:input_type -> openim.admin.GetAdminInfoReq
+	13,  // 64: openim.admin.admin.AddAdminAccount:input_type -> openim.admin.AddAdminAccountReq
+	38,  // 65: openim.admin.admin.ChangeAdminPassword:input_type -> openim.admin.ChangeAdminPasswordReq
+	40,  // 66: openim.admin.admin.DelAdminAccount:input_type -> openim.admin.DelAdminAccountReq
+	42,  // 67: openim.admin.admin.SearchAdminAccount:input_type -> openim.admin.SearchAdminAccountReq
+	16,  // 68: openim.admin.admin.AddAdminRole:input_type -> openim.admin.AddAdminRoleReq
+	18,  // 69: openim.admin.admin.UpdateAdminRole:input_type -> openim.admin.UpdateAdminRoleReq
+	20,  // 70: openim.admin.admin.DelAdminRole:input_type -> openim.admin.DelAdminRoleReq
+	22,  // 71: openim.admin.admin.SearchAdminRole:input_type -> openim.admin.SearchAdminRoleReq
+	24,  // 72: openim.admin.admin.SetAdminRoles:input_type -> openim.admin.SetAdminRolesReq
+	26,  // 73: openim.admin.admin.CheckAdminPermission:input_type -> openim.admin.CheckAdminPermissionReq
+	29,  // 74: openim.admin.admin.SearchAuditLog:input_type -> openim.admin.SearchAuditLogReq
+	31,  // 75: openim.admin.admin.AddAuditLog:input_type -> openim.admin.AddAuditLogReq
+	2,   // 76: openim.admin.admin.VerifyLoginTOTP:input_type -> openim.admin.VerifyLoginTOTPReq
+	3,   // 77: openim.admin.admin.EnrollTOTP:input_type -> openim.admin.EnrollTOTPReq
+	5,   // 78: openim.admin.admin.ConfirmTOTP:input_type -> openim.admin.ConfirmTOTPReq
+	7,   // 79: openim.admin.admin.DisableTOTP:input_type -> openim.admin.DisableTOTPReq
+	9,   // 80: openim.admin.admin.RegenerateRecoveryCodes:input_type -> openim.admin.RegenerateRecoveryCodesReq
+	11,  // 81: openim.admin.admin.SetTOTPRequired:input_type -> openim.admin.SetTOTPRequiredReq
+	45,  // 82: openim.admin.admin.AddDefaultFriend:input_type -> openim.admin.AddDefaultFriendReq
+	47,  // 83: openim.admin.admin.DelDefaultFriend:input_type -> openim.admin.DelDefaultFriendReq
+	49,  // 84: openim.admin.admin.FindDefaultFriend:input_type -> openim.admin.FindDefaultFriendReq
+	51,  // 85: openim.admin.admin.SearchDefaultFriend:input_type -> openim.admin.SearchDefaultFriendReq
+	54,  // 86: openim.admin.admin.AddDefaultGroup:input_type -> openim.admin.AddDefaultGroupReq
+	56,  // 87: openim.admin.admin.DelDefaultGroup:input_type -> openim.admin.DelDefaultGroupReq
+	58,  // 88: openim.admin.admin.FindDefaultGroup:input_type -> openim.admin.FindDefaultGroupReq
+	60,  // 89: openim.admin.admin.SearchDefaultGroup:input_type -> openim.admin.SearchDefaultGroupReq
+	63,  // 90: openim.admin.admin.AddInvitationCode:input_type -> openim.admin.AddInvitationCodeReq
+	65,  // 91: openim.admin.admin.GenInvitationCode:input_type -> openim.admin.GenInvitationCodeReq
+	67,  // 92: openim.admin.admin.FindInvitationCode:input_type -> openim.admin.FindInvitationCodeReq
+	69,  // 93: openim.admin.admin.UseInvitationCode:input_type -> openim.admin.UseInvitationCodeReq
+	71,  // 94: openim.admin.admin.ReleaseInvitationCode:input_type -> openim.admin.ReleaseInvitationCodeReq
+	73,  // 95: openim.admin.admin.DelInvitationCode:input_type -> openim.admin.DelInvitationCodeReq
+	76,  // 96: openim.admin.admin.SearchInvitationCode:input_type -> openim.admin.SearchInvitationCodeReq
+	79,  // 97: openim.admin.admin.SearchInvitationUse:input_type -> openim.admin.SearchInvitationUseReq
+	82,  // 98: openim.admin.admin.GetInvitationReport:input_type -> openim.admin.GetInvitationReportReq
+	84,  // 99: openim.admin.admin.SearchUserIPLimitLogin:input_type -> openim.admin.SearchUserIPLimitLoginReq
+	88,  // 100: openim.admin.admin.AddUserIPLimitLogin:input_type -> openim.admin.AddUserIPLimitLoginReq
+	90,  // 101: openim.admin.admin.DelUserIPLimitLogin:input_type -> openim.admin.DelUserIPLimitLoginReq
+	94,  // 102: openim.admin.admin.SearchIPForbidden:input_type -> openim.admin.SearchIPForbiddenReq
+	96,  // 103: openim.admin.admin.AddIPForbidden:input_type -> openim.admin.AddIPForbiddenReq
+	98,  // 104: openim.admin.admin.DelIPForbidden:input_type -> openim.admin.DelIPForbiddenReq
+	104, // 105: openim.admin.admin.CancellationUser:input_type -> openim.admin.CancellationUserReq
+	106, // 106: openim.admin.admin.BlockUser:input_type -> openim.admin.BlockUserReq
+	108, // 107: openim.admin.admin.UnblockUser:input_type -> openim.admin.UnblockUserReq
+	110, // 108: openim.admin.admin.SearchBlockUser:input_type -> openim.admin.SearchBlockUserReq
+	113, // 109: openim.admin.admin.FindUserBlockInfo:input_type -> openim.admin.FindUserBlockInfoReq
+	117, // 110: openim.admin.admin.SearchBlockHistory:input_type -> openim.admin.SearchBlockHistoryReq
+	100, // 111: openim.admin.admin.CheckRegisterForbidden:input_type -> openim.admin.CheckRegisterForbiddenReq
+	102, // 112: openim.admin.admin.CheckLoginForbidden:input_type -> openim.admin.CheckLoginForbiddenReq
+	119, // 113: openim.admin.admin.CreateToken:input_type -> openim.admin.CreateTokenReq
+	121, // 114: openim.admin.admin.ParseToken:input_type -> openim.admin.ParseTokenReq
+	123, // 115: openim.admin.admin.RefreshToken:input_type -> openim.admin.RefreshTokenReq
+	126, // 116: openim.admin.admin.GetJWKS:input_type -> openim.admin.GetJWKSReq
+	137, // 117: openim.admin.admin.AddApplet:input_type -> openim.admin.AddAppletReq
+	139, // 118: openim.admin.admin.DelApplet:input_type -> openim.admin.DelAppletReq
+	141, // 119: openim.admin.admin.UpdateApplet:input_type -> openim.admin.UpdateAppletReq
+	143, // 120: openim.admin.admin.FindApplet:input_type -> openim.admin.FindAppletReq
+	145, // 121: openim.admin.admin.SearchApplet:input_type -> openim.admin.SearchAppletReq
+	151, // 122: openim.admin.admin.GetClientConfig:input_type -> openim.admin.GetClientConfigReq
+	147, // 123: openim.admin.admin.SetClientConfig:input_type -> openim.admin.SetClientConfigReq
+	149, // 124: openim.admin.admin.DelClientConfig:input_type -> openim.admin.DelClientConfigReq
+	153, // 125: openim.admin.admin.GetUserToken:input_type -> openim.admin.GetUserTokenReq
+	128, // 126: openim.admin.admin.InvalidateToken:input_type -> openim.admin.InvalidateTokenReq
+	131, // 127: openim.admin.admin.ListSessions:input_type -> openim.admin.ListSessionsReq
+	133, // 128: openim.admin.admin.RevokeSession:input_type -> openim.admin.RevokeSessionReq
+	135, // 129: openim.admin.admin.RevokeOtherSessions:input_type -> openim.admin.RevokeOtherSessionsReq
+	1,   // 130: openim.admin.admin.Login:output_type -> openim.admin.LoginResp
+	36,  // 131: openim.admin.admin.ChangePassword:output_type -> openim.admin.ChangePasswordResp
+	34,  // 132: openim.admin.admin.AdminUpdateInfo:output_type -> openim.admin.AdminUpdateInfoResp
+	44,  // 133: openim.admin.admin.GetAdminInfo:output_type -> openim.admin.GetAdminInfoResp
+	14,  // 134: openim.admin.admin.AddAdminAccount:output_type -> openim.admin.AddAdminAccountResp
+	39,  // 135: openim.admin.admin.ChangeAdminPassword:output_type -> openim.admin.ChangeAdminPasswordResp
+	41,  // 136: openim.admin.admin.DelAdminAccount:output_type -> openim.admin.DelAdminAccountResp
+	43,  // 137: openim.admin.admin.SearchAdminAccount:output_type -> openim.admin.SearchAdminAccountResp
+	17,  // 138: openim.admin.admin.AddAdminRole:output_type -> openim.admin.AddAdminRoleResp
+	19,  // 139: openim.admin.admin.UpdateAdminRole:output_type -> openim.admin.UpdateAdminRoleResp
+	21,  // 140: openim.admin.admin.DelAdminRole:output_type -> openim.admin.DelAdminRoleResp
+	23,  // 141: openim.admin.admin.SearchAdminRole:output_type -> openim.admin.SearchAdminRoleResp
+	25,  // 142: openim.admin.admin.SetAdminRoles:output_type -> openim.admin.SetAdminRolesResp
+	27,  // 143: openim.admin.admin.CheckAdminPermission:output_type -> openim.admin.CheckAdminPermissionResp
+	30,  // 144: openim.admin.admin.SearchAuditLog:output_type -> openim.admin.SearchAuditLogResp
+	32,  // 145: openim.admin.admin.AddAuditLog:output_type -> openim.admin.AddAuditLogResp
+	1,   // 146: openim.admin.admin.VerifyLoginTOTP:output_type -> openim.admin.LoginResp
+	4,   // 147: openim.admin.admin.EnrollTOTP:output_type -> openim.admin.EnrollTOTPResp
+	6,   // 148: openim.admin.admin.ConfirmTOTP:output_type -> openim.admin.ConfirmTOTPResp
+	8,   // 149: openim.admin.admin.DisableTOTP:output_type -> openim.admin.DisableTOTPResp
+	10,  // 150: openim.admin.admin.RegenerateRecoveryCodes:output_type -> openim.admin.RegenerateRecoveryCodesResp
+	12,  // 151: openim.admin.admin.SetTOTPRequired:output_type -> openim.admin.SetTOTPRequiredResp
+	46,  // 152: openim.admin.admin.AddDefaultFriend:output_type -> openim.admin.AddDefaultFriendResp
+	48,  // 153: openim.admin.admin.DelDefaultFriend:output_type -> openim.admin.DelDefaultFriendResp
+	50,  // 154: openim.admin.admin.FindDefaultFriend:output_type -> openim.admin.FindDefaultFriendResp
+	53,  // 155: openim.admin.admin.SearchDefaultFriend:output_type -> openim.admin.SearchDefaultFriendResp
+	55,  // 156: openim.admin.admin.AddDefaultGroup:output_type -> openim.admin.AddDefaultGroupResp
+	57,  // 157: openim.admin.admin.DelDefaultGroup:output_type -> openim.admin.DelDefaultGroupResp
+	59,  // 158: openim.admin.admin.FindDefaultGroup:output_type -> openim.admin.FindDefaultGroupResp
+	62,  // 159: openim.admin.admin.SearchDefaultGroup:output_type -> openim.admin.SearchDefaultGroupResp
+	64,  // 160: openim.admin.admin.AddInvitationCode:output_type -> openim.admin.AddInvitationCodeResp
+	66,  // 161: openim.admin.admin.GenInvitationCode:output_type -> openim.admin.GenInvitationCodeResp
+	68,  // 162: openim.admin.admin.FindInvitationCode:output_type -> openim.admin.FindInvitationCodeResp
+	70,  // 163: openim.admin.admin.UseInvitationCode:output_type -> openim.admin.UseInvitationCodeResp
+	72,  // 164: openim.admin.admin.ReleaseInvitationCode:output_type -> openim.admin.ReleaseInvitationCodeResp
+	74,  // 165: openim.admin.admin.DelInvitationCode:output_type -> openim.admin.DelInvitationCodeResp
+	77,  // 166: openim.admin.admin.SearchInvitationCode:output_type -> openim.admin.SearchInvitationCodeResp
+	80,  // 167: openim.admin.admin.SearchInvitationUse:output_type -> openim.admin.SearchInvitationUseResp
+	83,  // 168: openim.admin.admin.GetInvitationReport:output_type -> openim.admin.GetInvitationReportResp
+	86,  // 169: openim.admin.admin.SearchUserIPLimitLogin:output_type -> openim.admin.SearchUserIPLimitLoginResp
+	89,  // 170: openim.admin.admin.AddUserIPLimitLogin:output_type -> openim.admin.AddUserIPLimitLoginResp
+	91,  // 171: openim.admin.admin.DelUserIPLimitLogin:output_type -> openim.admin.DelUserIPLimitLoginResp
+	95,  // 172: openim.admin.admin.SearchIPForbidden:output_type -> openim.admin.SearchIPForbiddenResp
+	97,  // 173: openim.admin.admin.AddIPForbidden:output_type -> openim.admin.AddIPForbiddenResp
+	99,  // 174: openim.admin.admin.DelIPForbidden:output_type -> openim.admin.DelIPForbiddenResp
+	105, // 175: openim.admin.admin.CancellationUser:output_type -> openim.admin.CancellationUserResp
+	107, // 176: openim.admin.admin.BlockUser:output_type -> openim.admin.BlockUserResp
+	109, // 177: openim.admin.admin.UnblockUser:output_type -> openim.admin.UnblockUserResp
+	112, // 178: openim.admin.admin.SearchBlockUser:output_type -> openim.admin.SearchBlockUserResp
+	115, // 179: openim.admin.admin.FindUserBlockInfo:output_type -> openim.admin.FindUserBlockInfoResp
+	118, // 180: openim.admin.admin.SearchBlockHistory:output_type -> openim.admin.SearchBlockHistoryResp
+	101, // 181: openim.admin.admin.CheckRegisterForbidden:output_type -> openim.admin.CheckRegisterForbiddenResp
+	103, // 182: openim.admin.admin.CheckLoginForbidden:output_type -> openim.admin.CheckLoginForbiddenResp
+	120, // 183: openim.admin.admin.CreateToken:output_type -> openim.admin.CreateTokenResp
+	122, // 184: openim.admin.admin.ParseToken:output_type -> openim.admin.ParseTokenResp
+	124, // 185: openim.admin.admin.RefreshToken:output_type -> openim.admin.RefreshTokenResp
+	127, // 186: openim.admin.admin.GetJWKS:output_type -> openim.admin.GetJWKSResp
+	138, // 187: openim.admin.admin.AddApplet:output_type -> openim.admin.AddAppletResp
+	140, // 188: openim.admin.admin.DelApplet:output_type -> openim.admin.DelAppletResp
+	142, // 189: openim.admin.admin.UpdateApplet:output_type -> openim.admin.UpdateAppletResp
+	144, // 190: openim.admin.admin.FindApplet:output_type -> openim.admin.FindAppletResp
+	146, // 191: openim.admin.admin.SearchApplet:output_type -> openim.admin.SearchAppletResp
+	152, // 192: openim.admin.admin.GetClientConfig:output_type -> openim.admin.GetClientConfigResp
+	148, // 193: openim.admin.admin.SetClientConfig:output_type -> openim.admin.SetClientConfigResp
+	150, // 194: openim.admin.admin.DelClientConfig:output_type -> openim.admin.DelClientConfigResp
+	154, // 195: openim.admin.admin.GetUserToken:output_type -> openim.admin.GetUserTokenResp
+	129, // 196: openim.admin.admin.InvalidateToken:output_type -> openim.admin.InvalidateTokenResp
+	132, // 197: openim.admin.admin.ListSessions:output_type -> openim.admin.ListSessionsResp
+	134, // 198: openim.admin.admin.RevokeSession:output_type -> openim.admin.RevokeSessionResp
+	136, // 199: openim.admin.admin.RevokeOtherSessions:output_type -> openim.admin.RevokeOtherSessionsResp
+	130, // [130:200] is the sub-list for method output_type
+	60,  // [60:130] is the sub-list for method input_type
+	60,  // [60:60] is the sub-list for extension type_name
+	60,  // [60:60] is the sub-list for extension extendee
+	0,   // [0:60] is the sub-list for field type_name
+}
+
+func init() { file_admin_admin_proto_init() }
+func file_admin_admin_proto_init() {
+	if File_admin_admin_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_admin_admin_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*LoginReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_admin_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*LoginResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_admin_proto_msgTypes[2].Exporter = func(v any, i int) any {
+			switch v := v.(*VerifyLoginTOTPReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_admin_proto_msgTypes[3].Exporter = func(v any, i int) any {
+			switch v := v.(*EnrollTOTPReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_admin_proto_msgTypes[4].Exporter = func(v any, i int) any {
+			switch v := v.(*EnrollTOTPResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_admin_proto_msgTypes[5].Exporter = func(v any, i int) any {
+			switch v := v.(*ConfirmTOTPReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_admin_proto_msgTypes[6].Exporter = func(v any, i int) any {
+			switch v := v.(*ConfirmTOTPResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_admin_proto_msgTypes[7].Exporter = func(v any, i int) any {
+			switch v := v.(*DisableTOTPReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_admin_proto_msgTypes[8].Exporter = func(v any, i int) any {
+			switch v := v.(*DisableTOTPResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_admin_proto_msgTypes[9].Exporter = func(v any, i int) any {
+			switch v := v.(*RegenerateRecoveryCodesReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_admin_proto_msgTypes[10].Exporter = func(v any, i int) any {
+			switch v := v.(*RegenerateRecoveryCodesResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_admin_proto_msgTypes[11].Exporter = func(v any, i int) any {
+			switch v := v.(*SetTOTPRequiredReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_admin_proto_msgTypes[12].Exporter = func(v any, i int) any {
+			switch v := v.(*SetTOTPRequiredResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_admin_proto_msgTypes[13].Exporter = func(v any, i int) any {
+			switch v := v.(*AddAdminAccountReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_admin_proto_msgTypes[14].Exporter = func(v any, i int) any {
+			switch v := v.(*AddAdminAccountResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_admin_proto_msgTypes[15].Exporter = func(v any, i int) any {
+			switch v := v.(*AdminRole); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_admin_proto_msgTypes[16].Exporter = func(v any, i int) any {
+			switch v := v.(*AddAdminRoleReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_admin_proto_msgTypes[17].Exporter = func(v any, i int) any {
+			switch v := v.(*AddAdminRoleResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_admin_proto_msgTypes[18].Exporter = func(v any, i int) any {
+			switch v := v.(*UpdateAdminRoleReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_admin_proto_msgTypes[19].Exporter = func(v any, i int) any {
+			switch v := v.(*UpdateAdminRoleResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_admin_proto_msgTypes[20].Exporter = func(v any, i int) any {
+			switch v := v.(*DelAdminRoleReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_admin_proto_msgTypes[21].Exporter = func(v any, i int) any {
+			switch v := v.(*DelAdminRoleResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_admin_proto_msgTypes[22].Exporter = func(v any, i int) any {
+			switch v := v.(*SearchAdminRoleReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_admin_proto_msgTypes[23].Exporter = func(v any, i int) any {
+			switch v := v.(*SearchAdminRoleResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_admin_proto_msgTypes[24].Exporter = func(v any, i int) any {
+			switch v := v.(*SetAdminRolesReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_admin_proto_msgTypes[25].Exporter = func(v any, i int) any {
+			switch v := v.(*SetAdminRolesResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_admin_proto_msgTypes[26].Exporter = func(v any, i int) any {
+			switch v := v.(*CheckAdminPermissionReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_admin_proto_msgTypes[27].Exporter = func(v any, i int) any {
+			switch v := v.(*CheckAdminPermissionResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_admin_proto_msgTypes[28].Exporter = func(v any, i int) any {
+			switch v := v.(*AuditLog); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_admin_proto_msgTypes[29].Exporter = func(v any, i int) any {
+			switch v := v.(*SearchAuditLogReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_admin_proto_msgTypes[30].Exporter = func(v any, i int) any {
+			switch v := v.(*SearchAuditLogResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_admin_proto_msgTypes[31].Exporter = func(v any, i int) any {
+			switch v := v.(*AddAuditLogReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_admin_proto_msgTypes[32].Exporter = func(v any, i int) any {
+			switch v := v.(*AddAuditLogResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_admin_proto_msgTypes[33].Exporter = func(v any, i int) any {
+			switch v := v.(*AdminUpdateInfoReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_admin_proto_msgTypes[34].Exporter = func(v any, i int) any {
+			switch v := v.(*AdminUpdateInfoResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_admin_proto_msgTypes[35].Exporter = func(v any, i int) any {
+			switch v := v.(*ChangePasswordReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_admin_proto_msgTypes[36].Exporter = func(v any, i int) any {
+			switch v := v.(*ChangePasswordResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_admin_proto_msgTypes[37].Exporter = func(v any, i int) any {
+			switch v := v.(*GetAdminInfoReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_admin_proto_msgTypes[38].Exporter = func(v any, i int) any {
+			switch v := v.(*ChangeAdminPasswordReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_admin_proto_msgTypes[39].Exporter = func(v any, i int) any {
+			switch v := v.(*ChangeAdminPasswordResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_admin_proto_msgTypes[40].Exporter = func(v any, i int) any {
+			switch v := v.(*DelAdminAccountReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_admin_proto_msgTypes[41].Exporter = func(v any, i int) any {
+			switch v := v.(*DelAdminAccountResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_admin_proto_msgTypes[42].Exporter = func(v any, i int) any {
+			switch v := v.(*SearchAdminAccountReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6482,8 +11004,8 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[1].Exporter = func(v any, i int) any {
-			switch v := v.(*LoginResp); i {
+		file_admin_admin_proto_msgTypes[43].Exporter = func(v any, i int) any {
+			switch v := v.(*SearchAdminAccountResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6494,8 +11016,8 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[2].Exporter = func(v any, i int) any {
-			switch v := v.(*AddAdminAccountReq); i {
+		file_admin_admin_proto_msgTypes[44].Exporter = func(v any, i int) any {
+			switch v := v.(*GetAdminInfoResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6506,8 +11028,8 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[3].Exporter = func(v any, i int) any {
-			switch v := v.(*AddAdminAccountResp); i {
+		file_admin_admin_proto_msgTypes[45].Exporter = func(v any, i int) any {
+			switch v := v.(*AddDefaultFriendReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6518,8 +11040,8 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[4].Exporter = func(v any, i int) any {
-			switch v := v.(*AdminUpdateInfoReq); i {
+		file_admin_admin_proto_msgTypes[46].Exporter = func(v any, i int) any {
+			switch v := v.(*AddDefaultFriendResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6530,8 +11052,8 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[5].Exporter = func(v any, i int) any {
-			switch v := v.(*AdminUpdateInfoResp); i {
+		file_admin_admin_proto_msgTypes[47].Exporter = func(v any, i int) any {
+			switch v := v.(*DelDefaultFriendReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6542,8 +11064,8 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[6].Exporter = func(v any, i int) any {
-			switch v := v.(*ChangePasswordReq); i {
+		file_admin_admin_proto_msgTypes[48].Exporter = func(v any, i int) any {
+			switch v := v.(*DelDefaultFriendResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6554,8 +11076,8 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[7].Exporter = func(v any, i int) any {
-			switch v := v.(*ChangePasswordResp); i {
+		file_admin_admin_proto_msgTypes[49].Exporter = func(v any, i int) any {
+			switch v := v.(*FindDefaultFriendReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6566,8 +11088,8 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[8].Exporter = func(v any, i int) any {
-			switch v := v.(*GetAdminInfoReq); i {
+		file_admin_admin_proto_msgTypes[50].Exporter = func(v any, i int) any {
+			switch v := v.(*FindDefaultFriendResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6578,8 +11100,8 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[9].Exporter = func(v any, i int) any {
-			switch v := v.(*ChangeAdminPasswordReq); i {
+		file_admin_admin_proto_msgTypes[51].Exporter = func(v any, i int) any {
+			switch v := v.(*SearchDefaultFriendReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6590,8 +11112,8 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[10].Exporter = func(v any, i int) any {
-			switch v := v.(*ChangeAdminPasswordResp); i {
+		file_admin_admin_proto_msgTypes[52].Exporter = func(v any, i int) any {
+			switch v := v.(*DefaultFriendAttribute); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6602,8 +11124,8 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[11].Exporter = func(v any, i int) any {
-			switch v := v.(*DelAdminAccountReq); i {
+		file_admin_admin_proto_msgTypes[53].Exporter = func(v any, i int) any {
+			switch v := v.(*SearchDefaultFriendResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6614,8 +11136,8 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[12].Exporter = func(v any, i int) any {
-			switch v := v.(*DelAdminAccountResp); i {
+		file_admin_admin_proto_msgTypes[54].Exporter = func(v any, i int) any {
+			switch v := v.(*AddDefaultGroupReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6626,8 +11148,8 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[13].Exporter = func(v any, i int) any {
-			switch v := v.(*SearchAdminAccountReq); i {
+		file_admin_admin_proto_msgTypes[55].Exporter = func(v any, i int) any {
+			switch v := v.(*AddDefaultGroupResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6638,8 +11160,8 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[14].Exporter = func(v any, i int) any {
-			switch v := v.(*SearchAdminAccountResp); i {
+		file_admin_admin_proto_msgTypes[56].Exporter = func(v any, i int) any {
+			switch v := v.(*DelDefaultGroupReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6650,8 +11172,8 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[15].Exporter = func(v any, i int) any {
-			switch v := v.(*GetAdminInfoResp); i {
+		file_admin_admin_proto_msgTypes[57].Exporter = func(v any, i int) any {
+			switch v := v.(*DelDefaultGroupResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6662,8 +11184,8 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[16].Exporter = func(v any, i int) any {
-			switch v := v.(*AddDefaultFriendReq); i {
+		file_admin_admin_proto_msgTypes[58].Exporter = func(v any, i int) any {
+			switch v := v.(*FindDefaultGroupReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6674,8 +11196,8 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[17].Exporter = func(v any, i int) any {
-			switch v := v.(*AddDefaultFriendResp); i {
+		file_admin_admin_proto_msgTypes[59].Exporter = func(v any, i int) any {
+			switch v := v.(*FindDefaultGroupResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6686,8 +11208,8 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[18].Exporter = func(v any, i int) any {
-			switch v := v.(*DelDefaultFriendReq); i {
+		file_admin_admin_proto_msgTypes[60].Exporter = func(v any, i int) any {
+			switch v := v.(*SearchDefaultGroupReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6698,8 +11220,8 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[19].Exporter = func(v any, i int) any {
-			switch v := v.(*DelDefaultFriendResp); i {
+		file_admin_admin_proto_msgTypes[61].Exporter = func(v any, i int) any {
+			switch v := v.(*GroupAttribute); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6710,8 +11232,8 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[20].Exporter = func(v any, i int) any {
-			switch v := v.(*FindDefaultFriendReq); i {
+		file_admin_admin_proto_msgTypes[62].Exporter = func(v any, i int) any {
+			switch v := v.(*SearchDefaultGroupResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6722,8 +11244,8 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[21].Exporter = func(v any, i int) any {
-			switch v := v.(*FindDefaultFriendResp); i {
+		file_admin_admin_proto_msgTypes[63].Exporter = func(v any, i int) any {
+			switch v := v.(*AddInvitationCodeReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6734,8 +11256,8 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[22].Exporter = func(v any, i int) any {
-			switch v := v.(*SearchDefaultFriendReq); i {
+		file_admin_admin_proto_msgTypes[64].Exporter = func(v any, i int) any {
+			switch v := v.(*AddInvitationCodeResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6746,8 +11268,8 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[23].Exporter = func(v any, i int) any {
-			switch v := v.(*DefaultFriendAttribute); i {
+		file_admin_admin_proto_msgTypes[65].Exporter = func(v any, i int) any {
+			switch v := v.(*GenInvitationCodeReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6758,8 +11280,8 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[24].Exporter = func(v any, i int) any {
-			switch v := v.(*SearchDefaultFriendResp); i {
+		file_admin_admin_proto_msgTypes[66].Exporter = func(v any, i int) any {
+			switch v := v.(*GenInvitationCodeResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6770,8 +11292,8 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[25].Exporter = func(v any, i int) any {
-			switch v := v.(*AddDefaultGroupReq); i {
+		file_admin_admin_proto_msgTypes[67].Exporter = func(v any, i int) any {
+			switch v := v.(*FindInvitationCodeReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6782,8 +11304,8 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[26].Exporter = func(v any, i int) any {
-			switch v := v.(*AddDefaultGroupResp); i {
+		file_admin_admin_proto_msgTypes[68].Exporter = func(v any, i int) any {
+			switch v := v.(*FindInvitationCodeResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6794,8 +11316,8 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[27].Exporter = func(v any, i int) any {
-			switch v := v.(*DelDefaultGroupReq); i {
+		file_admin_admin_proto_msgTypes[69].Exporter = func(v any, i int) any {
+			switch v := v.(*UseInvitationCodeReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6806,8 +11328,8 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[28].Exporter = func(v any, i int) any {
-			switch v := v.(*DelDefaultGroupResp); i {
+		file_admin_admin_proto_msgTypes[70].Exporter = func(v any, i int) any {
+			switch v := v.(*UseInvitationCodeResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6818,8 +11340,8 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[29].Exporter = func(v any, i int) any {
-			switch v := v.(*FindDefaultGroupReq); i {
+		file_admin_admin_proto_msgTypes[71].Exporter = func(v any, i int) any {
+			switch v := v.(*ReleaseInvitationCodeReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6830,8 +11352,8 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[30].Exporter = func(v any, i int) any {
-			switch v := v.(*FindDefaultGroupResp); i {
+		file_admin_admin_proto_msgTypes[72].Exporter = func(v any, i int) any {
+			switch v := v.(*ReleaseInvitationCodeResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6842,8 +11364,8 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[31].Exporter = func(v any, i int) any {
-			switch v := v.(*SearchDefaultGroupReq); i {
+		file_admin_admin_proto_msgTypes[73].Exporter = func(v any, i int) any {
+			switch v := v.(*DelInvitationCodeReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6854,8 +11376,8 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[32].Exporter = func(v any, i int) any {
-			switch v := v.(*GroupAttribute); i {
+		file_admin_admin_proto_msgTypes[74].Exporter = func(v any, i int) any {
+			switch v := v.(*DelInvitationCodeResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6866,8 +11388,8 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[33].Exporter = func(v any, i int) any {
-			switch v := v.(*SearchDefaultGroupResp); i {
+		file_admin_admin_proto_msgTypes[75].Exporter = func(v any, i int) any {
+			switch v := v.(*InvitationRegister); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6878,8 +11400,8 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[34].Exporter = func(v any, i int) any {
-			switch v := v.(*AddInvitationCodeReq); i {
+		file_admin_admin_proto_msgTypes[76].Exporter = func(v any, i int) any {
+			switch v := v.(*SearchInvitationCodeReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6890,8 +11412,8 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[35].Exporter = func(v any, i int) any {
-			switch v := v.(*AddInvitationCodeResp); i {
+		file_admin_admin_proto_msgTypes[77].Exporter = func(v any, i int) any {
+			switch v := v.(*SearchInvitationCodeResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6902,8 +11424,128 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[36].Exporter = func(v any, i int) any {
-			switch v := v.(*GenInvitationCodeReq); i {
+		file_admin_admin_proto_msgTypes[78].Exporter = func(v any, i int) any {
+			switch v := v.(*InvitationUse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_admin_proto_msgTypes[79].Exporter = func(v any, i int) any {
+			switch v := v.(*SearchInvitationUseReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_admin_proto_msgTypes[80].Exporter = func(v any, i int) any {
+			switch v := v.(*SearchInvitationUseResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_admin_proto_msgTypes[81].Exporter = func(v any, i int) any {
+			switch v := v.(*InvitationReport); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_admin_proto_msgTypes[82].Exporter = func(v any, i int) any {
+			switch v := v.(*GetInvitationReportReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_admin_proto_msgTypes[83].Exporter = func(v any, i int) any {
+			switch v := v.(*GetInvitationReportResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_admin_proto_msgTypes[84].Exporter = func(v any, i int) any {
+			switch v := v.(*SearchUserIPLimitLoginReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_admin_proto_msgTypes[85].Exporter = func(v any, i int) any {
+			switch v := v.(*LimitUserLoginIP); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_admin_proto_msgTypes[86].Exporter = func(v any, i int) any {
+			switch v := v.(*SearchUserIPLimitLoginResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_admin_proto_msgTypes[87].Exporter = func(v any, i int) any {
+			switch v := v.(*UserIPLimitLogin); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_admin_admin_proto_msgTypes[88].Exporter = func(v any, i int) any {
+			switch v := v.(*AddUserIPLimitLoginReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6914,8 +11556,8 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[37].Exporter = func(v any, i int) any {
-			switch v := v.(*GenInvitationCodeResp); i {
+		file_admin_admin_proto_msgTypes[89].Exporter = func(v any, i int) any {
+			switch v := v.(*AddUserIPLimitLoginResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6926,8 +11568,8 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[38].Exporter = func(v any, i int) any {
-			switch v := v.(*FindInvitationCodeReq); i {
+		file_admin_admin_proto_msgTypes[90].Exporter = func(v any, i int) any {
+			switch v := v.(*DelUserIPLimitLoginReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6938,8 +11580,8 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[39].Exporter = func(v any, i int) any {
-			switch v := v.(*FindInvitationCodeResp); i {
+		file_admin_admin_proto_msgTypes[91].Exporter = func(v any, i int) any {
+			switch v := v.(*DelUserIPLimitLoginResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6950,8 +11592,8 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[40].Exporter = func(v any, i int) any {
-			switch v := v.(*UseInvitationCodeReq); i {
+		file_admin_admin_proto_msgTypes[92].Exporter = func(v any, i int) any {
+			switch v := v.(*IPForbidden); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6962,8 +11604,8 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[41].Exporter = func(v any, i int) any {
-			switch v := v.(*UseInvitationCodeResp); i {
+		file_admin_admin_proto_msgTypes[93].Exporter = func(v any, i int) any {
+			switch v := v.(*IPForbiddenAdd); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6974,8 +11616,8 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[42].Exporter = func(v any, i int) any {
-			switch v := v.(*DelInvitationCodeReq); i {
+		file_admin_admin_proto_msgTypes[94].Exporter = func(v any, i int) any {
+			switch v := v.(*SearchIPForbiddenReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6986,8 +11628,8 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[43].Exporter = func(v any, i int) any {
-			switch v := v.(*DelInvitationCodeResp); i {
+		file_admin_admin_proto_msgTypes[95].Exporter = func(v any, i int) any {
+			switch v := v.(*SearchIPForbiddenResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6998,8 +11640,8 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[44].Exporter = func(v any, i int) any {
-			switch v := v.(*InvitationRegister); i {
+		file_admin_admin_proto_msgTypes[96].Exporter = func(v any, i int) any {
+			switch v := v.(*AddIPForbiddenReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7010,8 +11652,8 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[45].Exporter = func(v any, i int) any {
-			switch v := v.(*SearchInvitationCodeReq); i {
+		file_admin_admin_proto_msgTypes[97].Exporter = func(v any, i int) any {
+			switch v := v.(*AddIPForbiddenResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7022,8 +11664,8 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[46].Exporter = func(v any, i int) any {
-			switch v := v.(*SearchInvitationCodeResp); i {
+		file_admin_admin_proto_msgTypes[98].Exporter = func(v any, i int) any {
+			switch v := v.(*DelIPForbiddenReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7034,8 +11676,8 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[47].Exporter = func(v any, i int) any {
-			switch v := v.(*SearchUserIPLimitLoginReq); i {
+		file_admin_admin_proto_msgTypes[99].Exporter = func(v any, i int) any {
+			switch v := v.(*DelIPForbiddenResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7046,8 +11688,8 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[48].Exporter = func(v any, i int) any {
-			switch v := v.(*LimitUserLoginIP); i {
+		file_admin_admin_proto_msgTypes[100].Exporter = func(v any, i int) any {
+			switch v := v.(*CheckRegisterForbiddenReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7058,8 +11700,8 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[49].Exporter = func(v any, i int) any {
-			switch v := v.(*SearchUserIPLimitLoginResp); i {
+		file_admin_admin_proto_msgTypes[101].Exporter = func(v any, i int) any {
+			switch v := v.(*CheckRegisterForbiddenResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7070,8 +11712,8 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[50].Exporter = func(v any, i int) any {
-			switch v := v.(*UserIPLimitLogin); i {
+		file_admin_admin_proto_msgTypes[102].Exporter = func(v any, i int) any {
+			switch v := v.(*CheckLoginForbiddenReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7082,8 +11724,8 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[51].Exporter = func(v any, i int) any {
-			switch v := v.(*AddUserIPLimitLoginReq); i {
+		file_admin_admin_proto_msgTypes[103].Exporter = func(v any, i int) any {
+			switch v := v.(*CheckLoginForbiddenResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7094,8 +11736,8 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[52].Exporter = func(v any, i int) any {
-			switch v := v.(*AddUserIPLimitLoginResp); i {
+		file_admin_admin_proto_msgTypes[104].Exporter = func(v any, i int) any {
+			switch v := v.(*CancellationUserReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7106,8 +11748,8 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[53].Exporter = func(v any, i int) any {
-			switch v := v.(*DelUserIPLimitLoginReq); i {
+		file_admin_admin_proto_msgTypes[105].Exporter = func(v any, i int) any {
+			switch v := v.(*CancellationUserResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7118,8 +11760,8 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[54].Exporter = func(v any, i int) any {
-			switch v := v.(*DelUserIPLimitLoginResp); i {
+		file_admin_admin_proto_msgTypes[106].Exporter = func(v any, i int) any {
+			switch v := v.(*BlockUserReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7130,8 +11772,8 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[55].Exporter = func(v any, i int) any {
-			switch v := v.(*IPForbidden); i {
+		file_admin_admin_proto_msgTypes[107].Exporter = func(v any, i int) any {
+			switch v := v.(*BlockUserResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7142,8 +11784,8 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[56].Exporter = func(v any, i int) any {
-			switch v := v.(*IPForbiddenAdd); i {
+		file_admin_admin_proto_msgTypes[108].Exporter = func(v any, i int) any {
+			switch v := v.(*UnblockUserReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7154,8 +11796,8 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[57].Exporter = func(v any, i int) any {
-			switch v := v.(*SearchIPForbiddenReq); i {
+		file_admin_admin_proto_msgTypes[109].Exporter = func(v any, i int) any {
+			switch v := v.(*UnblockUserResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7166,8 +11808,8 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[58].Exporter = func(v any, i int) any {
-			switch v := v.(*SearchIPForbiddenResp); i {
+		file_admin_admin_proto_msgTypes[110].Exporter = func(v any, i int) any {
+			switch v := v.(*SearchBlockUserReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7178,8 +11820,8 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[59].Exporter = func(v any, i int) any {
-			switch v := v.(*AddIPForbiddenReq); i {
+		file_admin_admin_proto_msgTypes[111].Exporter = func(v any, i int) any {
+			switch v := v.(*BlockUserInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7190,8 +11832,8 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[60].Exporter = func(v any, i int) any {
-			switch v := v.(*AddIPForbiddenResp); i {
+		file_admin_admin_proto_msgTypes[112].Exporter = func(v any, i int) any {
+			switch v := v.(*SearchBlockUserResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7202,8 +11844,8 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[61].Exporter = func(v any, i int) any {
-			switch v := v.(*DelIPForbiddenReq); i {
+		file_admin_admin_proto_msgTypes[113].Exporter = func(v any, i int) any {
+			switch v := v.(*FindUserBlockInfoReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7214,8 +11856,8 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[62].Exporter = func(v any, i int) any {
-			switch v := v.(*DelIPForbiddenResp); i {
+		file_admin_admin_proto_msgTypes[114].Exporter = func(v any, i int) any {
+			switch v := v.(*BlockInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7226,8 +11868,8 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[63].Exporter = func(v any, i int) any {
-			switch v := v.(*CheckRegisterForbiddenReq); i {
+		file_admin_admin_proto_msgTypes[115].Exporter = func(v any, i int) any {
+			switch v := v.(*FindUserBlockInfoResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7238,8 +11880,8 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[64].Exporter = func(v any, i int) any {
-			switch v := v.(*CheckRegisterForbiddenResp); i {
+		file_admin_admin_proto_msgTypes[116].Exporter = func(v any, i int) any {
+			switch v := v.(*BlockHistory); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7250,8 +11892,8 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[65].Exporter = func(v any, i int) any {
-			switch v := v.(*CheckLoginForbiddenReq); i {
+		file_admin_admin_proto_msgTypes[117].Exporter = func(v any, i int) any {
+			switch v := v.(*SearchBlockHistoryReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7262,8 +11904,8 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[66].Exporter = func(v any, i int) any {
-			switch v := v.(*CheckLoginForbiddenResp); i {
+		file_admin_admin_proto_msgTypes[118].Exporter = func(v any, i int) any {
+			switch v := v.(*SearchBlockHistoryResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7274,8 +11916,8 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[67].Exporter = func(v any, i int) any {
-			switch v := v.(*CancellationUserReq); i {
+		file_admin_admin_proto_msgTypes[119].Exporter = func(v any, i int) any {
+			switch v := v.(*CreateTokenReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7286,8 +11928,8 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[68].Exporter = func(v any, i int) any {
-			switch v := v.(*CancellationUserResp); i {
+		file_admin_admin_proto_msgTypes[120].Exporter = func(v any, i int) any {
+			switch v := v.(*CreateTokenResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7298,8 +11940,8 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[69].Exporter = func(v any, i int) any {
-			switch v := v.(*BlockUserReq); i {
+		file_admin_admin_proto_msgTypes[121].Exporter = func(v any, i int) any {
+			switch v := v.(*ParseTokenReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7310,8 +11952,8 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[70].Exporter = func(v any, i int) any {
-			switch v := v.(*BlockUserResp); i {
+		file_admin_admin_proto_msgTypes[122].Exporter = func(v any, i int) any {
+			switch v := v.(*ParseTokenResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7322,8 +11964,8 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[71].Exporter = func(v any, i int) any {
-			switch v := v.(*UnblockUserReq); i {
+		file_admin_admin_proto_msgTypes[123].Exporter = func(v any, i int) any {
+			switch v := v.(*RefreshTokenReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7334,8 +11976,8 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[72].Exporter = func(v any, i int) any {
-			switch v := v.(*UnblockUserResp); i {
+		file_admin_admin_proto_msgTypes[124].Exporter = func(v any, i int) any {
+			switch v := v.(*RefreshTokenResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7346,8 +11988,8 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[73].Exporter = func(v any, i int) any {
-			switch v := v.(*SearchBlockUserReq); i {
+		file_admin_admin_proto_msgTypes[125].Exporter = func(v any, i int) any {
+			switch v := v.(*JWK); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7358,8 +12000,8 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[74].Exporter = func(v any, i int) any {
-			switch v := v.(*BlockUserInfo); i {
+		file_admin_admin_proto_msgTypes[126].Exporter = func(v any, i int) any {
+			switch v := v.(*GetJWKSReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7370,8 +12012,8 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[75].Exporter = func(v any, i int) any {
-			switch v := v.(*SearchBlockUserResp); i {
+		file_admin_admin_proto_msgTypes[127].Exporter = func(v any, i int) any {
+			switch v := v.(*GetJWKSResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7382,8 +12024,8 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[76].Exporter = func(v any, i int) any {
-			switch v := v.(*FindUserBlockInfoReq); i {
+		file_admin_admin_proto_msgTypes[128].Exporter = func(v any, i int) any {
+			switch v := v.(*InvalidateTokenReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7394,8 +12036,8 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[77].Exporter = func(v any, i int) any {
-			switch v := v.(*BlockInfo); i {
+		file_admin_admin_proto_msgTypes[129].Exporter = func(v any, i int) any {
+			switch v := v.(*InvalidateTokenResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7406,8 +12048,8 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[78].Exporter = func(v any, i int) any {
-			switch v := v.(*FindUserBlockInfoResp); i {
+		file_admin_admin_proto_msgTypes[130].Exporter = func(v any, i int) any {
+			switch v := v.(*Session); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7418,8 +12060,8 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[79].Exporter = func(v any, i int) any {
-			switch v := v.(*CreateTokenReq); i {
+		file_admin_admin_proto_msgTypes[131].Exporter = func(v any, i int) any {
+			switch v := v.(*ListSessionsReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7430,8 +12072,8 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[80].Exporter = func(v any, i int) any {
-			switch v := v.(*CreateTokenResp); i {
+		file_admin_admin_proto_msgTypes[132].Exporter = func(v any, i int) any {
+			switch v := v.(*ListSessionsResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7442,8 +12084,8 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[81].Exporter = func(v any, i int) any {
-			switch v := v.(*ParseTokenReq); i {
+		file_admin_admin_proto_msgTypes[133].Exporter = func(v any, i int) any {
+			switch v := v.(*RevokeSessionReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7454,8 +12096,8 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[82].Exporter = func(v any, i int) any {
-			switch v := v.(*ParseTokenResp); i {
+		file_admin_admin_proto_msgTypes[134].Exporter = func(v any, i int) any {
+			switch v := v.(*RevokeSessionResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7466,8 +12108,8 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[83].Exporter = func(v any, i int) any {
-			switch v := v.(*InvalidateTokenReq); i {
+		file_admin_admin_proto_msgTypes[135].Exporter = func(v any, i int) any {
+			switch v := v.(*RevokeOtherSessionsReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7478,8 +12120,8 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[84].Exporter = func(v any, i int) any {
-			switch v := v.(*InvalidateTokenResp); i {
+		file_admin_admin_proto_msgTypes[136].Exporter = func(v any, i int) any {
+			switch v := v.(*RevokeOtherSessionsResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7490,7 +12132,7 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[85].Exporter = func(v any, i int) any {
+		file_admin_admin_proto_msgTypes[137].Exporter = func(v any, i int) any {
 			switch v := v.(*AddAppletReq); i {
 			case 0:
 				return &v.state
@@ -7502,7 +12144,7 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[86].Exporter = func(v any, i int) any {
+		file_admin_admin_proto_msgTypes[138].Exporter = func(v any, i int) any {
 			switch v := v.(*AddAppletResp); i {
 			case 0:
 				return &v.state
@@ -7514,7 +12156,7 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[87].Exporter = func(v any, i int) any {
+		file_admin_admin_proto_msgTypes[139].Exporter = func(v any, i int) any {
 			switch v := v.(*DelAppletReq); i {
 			case 0:
 				return &v.state
@@ -7526,7 +12168,7 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[88].Exporter = func(v any, i int) any {
+		file_admin_admin_proto_msgTypes[140].Exporter = func(v any, i int) any {
 			switch v := v.(*DelAppletResp); i {
 			case 0:
 				return &v.state
@@ -7538,7 +12180,7 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[89].Exporter = func(v any, i int) any {
+		file_admin_admin_proto_msgTypes[141].Exporter = func(v any, i int) any {
 			switch v := v.(*UpdateAppletReq); i {
 			case 0:
 				return &v.state
@@ -7550,7 +12192,7 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[90].Exporter = func(v any, i int) any {
+		file_admin_admin_proto_msgTypes[142].Exporter = func(v any, i int) any {
 			switch v := v.(*UpdateAppletResp); i {
 			case 0:
 				return &v.state
@@ -7562,7 +12204,7 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[91].Exporter = func(v any, i int) any {
+		file_admin_admin_proto_msgTypes[143].Exporter = func(v any, i int) any {
 			switch v := v.(*FindAppletReq); i {
 			case 0:
 				return &v.state
@@ -7574,7 +12216,7 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[92].Exporter = func(v any, i int) any {
+		file_admin_admin_proto_msgTypes[144].Exporter = func(v any, i int) any {
 			switch v := v.(*FindAppletResp); i {
 			case 0:
 				return &v.state
@@ -7586,7 +12228,7 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[93].Exporter = func(v any, i int) any {
+		file_admin_admin_proto_msgTypes[145].Exporter = func(v any, i int) any {
 			switch v := v.(*SearchAppletReq); i {
 			case 0:
 				return &v.state
@@ -7598,7 +12240,7 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[94].Exporter = func(v any, i int) any {
+		file_admin_admin_proto_msgTypes[146].Exporter = func(v any, i int) any {
 			switch v := v.(*SearchAppletResp); i {
 			case 0:
 				return &v.state
@@ -7610,7 +12252,7 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[95].Exporter = func(v any, i int) any {
+		file_admin_admin_proto_msgTypes[147].Exporter = func(v any, i int) any {
 			switch v := v.(*SetClientConfigReq); i {
 			case 0:
 				return &v.state
@@ -7622,7 +12264,7 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[96].Exporter = func(v any, i int) any {
+		file_admin_admin_proto_msgTypes[148].Exporter = func(v any, i int) any {
 			switch v := v.(*SetClientConfigResp); i {
 			case 0:
 				return &v.state
@@ -7634,7 +12276,7 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[97].Exporter = func(v any, i int) any {
+		file_admin_admin_proto_msgTypes[149].Exporter = func(v any, i int) any {
 			switch v := v.(*DelClientConfigReq); i {
 			case 0:
 				return &v.state
@@ -7646,7 +12288,7 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[98].Exporter = func(v any, i int) any {
+		file_admin_admin_proto_msgTypes[150].Exporter = func(v any, i int) any {
 			switch v := v.(*DelClientConfigResp); i {
 			case 0:
 				return &v.state
@@ -7658,7 +12300,7 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[99].Exporter = func(v any, i int) any {
+		file_admin_admin_proto_msgTypes[151].Exporter = func(v any, i int) any {
 			switch v := v.(*GetClientConfigReq); i {
 			case 0:
 				return &v.state
@@ -7670,7 +12312,7 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[100].Exporter = func(v any, i int) any {
+		file_admin_admin_proto_msgTypes[152].Exporter = func(v any, i int) any {
 			switch v := v.(*GetClientConfigResp); i {
 			case 0:
 				return &v.state
@@ -7682,7 +12324,7 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[101].Exporter = func(v any, i int) any {
+		file_admin_admin_proto_msgTypes[153].Exporter = func(v any, i int) any {
 			switch v := v.(*GetUserTokenReq); i {
 			case 0:
 				return &v.state
@@ -7694,7 +12336,7 @@ func file_admin_admin_proto_init() {
 				return nil
 			}
 		}
-		file_admin_admin_proto_msgTypes[102].Exporter = func(v any, i int) any {
+		file_admin_admin_proto_msgTypes[154].Exporter = func(v any, i int) any {
 			switch v := v.(*GetUserTokenResp); i {
 			case 0:
 				return &v.state
@@ -7713,7 +12355,7 @@ func file_admin_admin_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_admin_admin_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   106,
+			NumMessages:   158,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -7749,6 +12391,24 @@ type AdminClient interface {
 	ChangeAdminPassword(ctx context.Context, in *ChangeAdminPasswordReq, opts ...grpc.CallOption) (*ChangeAdminPasswordResp, error)
 	DelAdminAccount(ctx context.Context, in *DelAdminAccountReq, opts ...grpc.CallOption) (*DelAdminAccountResp, error)
 	SearchAdminAccount(ctx context.Context, in *SearchAdminAccountReq, opts ...grpc.CallOption) (*SearchAdminAccountResp, error)
+	// admin role
+	AddAdminRole(ctx context.Context, in *AddAdminRoleReq, opts ...grpc.CallOption) (*AddAdminRoleResp, error)
+	UpdateAdminRole(ctx context.Context, in *UpdateAdminRoleReq, opts ...grpc.CallOption) (*UpdateAdminRoleResp, error)
+	DelAdminRole(ctx context.Context, in *DelAdminRoleReq, opts ...grpc.CallOption) (*DelAdminRoleResp, error)
+	SearchAdminRole(ctx context.Context, in *SearchAdminRoleReq, opts ...grpc.CallOption) (*SearchAdminRoleResp, error)
+	SetAdminRoles(ctx context.Context, in *SetAdminRolesReq, opts ...grpc.CallOption) (*SetAdminRolesResp, error)
+	CheckAdminPermission(ctx context.Context, in *CheckAdminPermissionReq, opts ...grpc.CallOption) (*CheckAdminPermissionResp, error)
+	// audit log
+	SearchAuditLog(ctx context.Context, in *SearchAuditLogReq, opts ...grpc.CallOption) (*SearchAuditLogResp, error)
+	// writes an admin operation another service audited, only the chat services may call it
+	AddAuditLog(ctx context.Context, in *AddAuditLogReq, opts ...grpc.CallOption) (*AddAuditLogResp, error)
+	// two-factor authentication
+	VerifyLoginTOTP(ctx context.Context, in *VerifyLoginTOTPReq, opts ...grpc.CallOption) (*LoginResp, error)
+	EnrollTOTP(ctx context.Context, in *EnrollTOTPReq, opts ...grpc.CallOption) (*EnrollTOTPResp, error)
+	ConfirmTOTP(ctx context.Context, in *ConfirmTOTPReq, opts ...grpc.CallOption) (*ConfirmTOTPResp, error)
+	DisableTOTP(ctx context.Context, in *DisableTOTPReq, opts ...grpc.CallOption) (*DisableTOTPResp, error)
+	RegenerateRecoveryCodes(ctx context.Context, in *RegenerateRecoveryCodesReq, opts ...grpc.CallOption) (*RegenerateRecoveryCodesResp, error)
+	SetTOTPRequired(ctx context.Context, in *SetTOTPRequiredReq, opts ...grpc.CallOption) (*SetTOTPRequiredResp, error)
 	// Add Remove Get default friend list on registration
 	AddDefaultFriend(ctx context.Context, in *AddDefaultFriendReq, opts ...grpc.CallOption) (*AddDefaultFriendResp, error)
 	DelDefaultFriend(ctx context.Context, in *DelDefaultFriendReq, opts ...grpc.CallOption) (*DelDefaultFriendResp, error)
@@ -7763,8 +12423,12 @@ type AdminClient interface {
 	GenInvitationCode(ctx context.Context, in *GenInvitationCodeReq, opts ...grpc.CallOption) (*GenInvitationCodeResp, error)
 	FindInvitationCode(ctx context.Context, in *FindInvitationCodeReq, opts ...grpc.CallOption) (*FindInvitationCodeResp, error)
 	UseInvitationCode(ctx context.Context, in *UseInvitationCodeReq, opts ...grpc.CallOption) (*UseInvitationCodeResp, error)
+	// ReleaseInvitationCode takes back a use when the registration that took it failed
+	ReleaseInvitationCode(ctx context.Context, in *ReleaseInvitationCodeReq, opts ...grpc.CallOption) (*ReleaseInvitationCodeResp, error)
 	DelInvitationCode(ctx context.Context, in *DelInvitationCodeReq, opts ...grpc.CallOption) (*DelInvitationCodeResp, error)
 	SearchInvitationCode(ctx context.Context, in *SearchInvitationCodeReq, opts ...grpc.CallOption) (*SearchInvitationCodeResp, error)
+	SearchInvitationUse(ctx context.Context, in *SearchInvitationUseReq, opts ...grpc.CallOption) (*SearchInvitationUseResp, error)
+	GetInvitationReport(ctx context.Context, in *GetInvitationReportReq, opts ...grpc.CallOption) (*GetInvitationReportResp, error)
 	// User login ip limit Query Add Remove
 	SearchUserIPLimitLogin(ctx context.Context, in *SearchUserIPLimitLoginReq, opts ...grpc.CallOption) (*SearchUserIPLimitLoginResp, error)
 	AddUserIPLimitLogin(ctx context.Context, in *AddUserIPLimitLoginReq, opts ...grpc.CallOption) (*AddUserIPLimitLoginResp, error)
@@ -7779,12 +12443,17 @@ type AdminClient interface {
 	UnblockUser(ctx context.Context, in *UnblockUserReq, opts ...grpc.CallOption) (*UnblockUserResp, error)
 	SearchBlockUser(ctx context.Context, in *SearchBlockUserReq, opts ...grpc.CallOption) (*SearchBlockUserResp, error)
 	FindUserBlockInfo(ctx context.Context, in *FindUserBlockInfoReq, opts ...grpc.CallOption) (*FindUserBlockInfoResp, error)
+	SearchBlockHistory(ctx context.Context, in *SearchBlockHistoryReq, opts ...grpc.CallOption) (*SearchBlockHistoryResp, error)
 	CheckRegisterForbidden(ctx context.Context, in *CheckRegisterForbiddenReq, opts ...grpc.CallOption) (*CheckRegisterForbiddenResp, error)
 	CheckLoginForbidden(ctx context.Context, in *CheckLoginForbiddenReq, opts ...grpc.CallOption) (*CheckLoginForbiddenResp, error)
 	// create token
 	CreateToken(ctx context.Context, in *CreateTokenReq, opts ...grpc.CallOption) (*CreateTokenResp, error)
 	// parse token
 	ParseToken(ctx context.Context, in *ParseTokenReq, opts ...grpc.CallOption) (*ParseTokenResp, error)
+	// rotates the refresh token, a reused refresh token revokes its session
+	RefreshToken(ctx context.Context, in *RefreshTokenReq, opts ...grpc.CallOption) (*RefreshTokenResp, error)
+	// the public keys of the signing keys, empty while tokens are signed with the shared secret
+	GetJWKS(ctx context.Context, in *GetJWKSReq, opts ...grpc.CallOption) (*GetJWKSResp, error)
 	// app
 	AddApplet(ctx context.Context, in *AddAppletReq, opts ...grpc.CallOption) (*AddAppletResp, error)
 	DelApplet(ctx context.Context, in *DelAppletReq, opts ...grpc.CallOption) (*DelAppletResp, error)
@@ -7798,6 +12467,10 @@ type AdminClient interface {
 	GetUserToken(ctx context.Context, in *GetUserTokenReq, opts ...grpc.CallOption) (*GetUserTokenResp, error)
 	// invalidate token
 	InvalidateToken(ctx context.Context, in *InvalidateTokenReq, opts ...grpc.CallOption) (*InvalidateTokenResp, error)
+	// sessions of a user, each token is one session
+	ListSessions(ctx context.Context, in *ListSessionsReq, opts ...grpc.CallOption) (*ListSessionsResp, error)
+	RevokeSession(ctx context.Context, in *RevokeSessionReq, opts ...grpc.CallOption) (*RevokeSessionResp, error)
+	RevokeOtherSessions(ctx context.Context, in *RevokeOtherSessionsReq, opts ...grpc.CallOption) (*RevokeOtherSessionsResp, error)
 }
 
 type adminClient struct {
@@ -7880,6 +12553,132 @@ func (c *adminClient) SearchAdminAccount(ctx context.Context, in *SearchAdminAcc
 	return out, nil
 }
 
+func (c *adminClient) AddAdminRole(ctx context.Context, in *AddAdminRoleReq, opts ...grpc.CallOption) (*AddAdminRoleResp, error) {
+	out := new(AddAdminRoleResp)
+	err := c.cc.Invoke(ctx, "/openim.admin.admin/AddAdminRole", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) UpdateAdminRole(ctx context.Context, in *UpdateAdminRoleReq, opts ...grpc.CallOption) (*UpdateAdminRoleResp, error) {
+	out := new(UpdateAdminRoleResp)
+	err := c.cc.Invoke(ctx, "/openim.admin.admin/UpdateAdminRole", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) DelAdminRole(ctx context.Context, in *DelAdminRoleReq, opts ...grpc.CallOption) (*DelAdminRoleResp, error) {
+	out := new(DelAdminRoleResp)
+	err := c.cc.Invoke(ctx, "/openim.admin.admin/DelAdminRole", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) SearchAdminRole(ctx context.Context, in *SearchAdminRoleReq, opts ...grpc.CallOption) (*SearchAdminRoleResp, error) {
+	out := new(SearchAdminRoleResp)
+	err := c.cc.Invoke(ctx, "/openim.admin.admin/SearchAdminRole", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) SetAdminRoles(ctx context.Context, in *SetAdminRolesReq, opts ...grpc.CallOption) (*SetAdminRolesResp, error) {
+	out := new(SetAdminRolesResp)
+	err := c.cc.Invoke(ctx, "/openim.admin.admin/SetAdminRoles", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) CheckAdminPermission(ctx context.Context, in *CheckAdminPermissionReq, opts ...grpc.CallOption) (*CheckAdminPermissionResp, error) {
+	out := new(CheckAdminPermissionResp)
+	err := c.cc.Invoke(ctx, "/openim.admin.admin/CheckAdminPermission", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) SearchAuditLog(ctx context.Context, in *SearchAuditLogReq, opts ...grpc.CallOption) (*SearchAuditLogResp, error) {
+	out := new(SearchAuditLogResp)
+	err := c.cc.Invoke(ctx, "/openim.admin.admin/SearchAuditLog", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) AddAuditLog(ctx context.Context, in *AddAuditLogReq, opts ...grpc.CallOption) (*AddAuditLogResp, error) {
+	out := new(AddAuditLogResp)
+	err := c.cc.Invoke(ctx, "/openim.admin.admin/AddAuditLog", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) VerifyLoginTOTP(ctx context.Context, in *VerifyLoginTOTPReq, opts ...grpc.CallOption) (*LoginResp, error) {
+	out := new(LoginResp)
+	err := c.cc.Invoke(ctx, "/openim.admin.admin/VerifyLoginTOTP", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) EnrollTOTP(ctx context.Context, in *EnrollTOTPReq, opts ...grpc.CallOption) (*EnrollTOTPResp, error) {
+	out := new(EnrollTOTPResp)
+	err := c.cc.Invoke(ctx, "/openim.admin.admin/EnrollTOTP", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) ConfirmTOTP(ctx context.Context, in *ConfirmTOTPReq, opts ...grpc.CallOption) (*ConfirmTOTPResp, error) {
+	out := new(ConfirmTOTPResp)
+	err := c.cc.Invoke(ctx, "/openim.admin.admin/ConfirmTOTP", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) DisableTOTP(ctx context.Context, in *DisableTOTPReq, opts ...grpc.CallOption) (*DisableTOTPResp, error) {
+	out := new(DisableTOTPResp)
+	err := c.cc.Invoke(ctx, "/openim.admin.admin/DisableTOTP", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) RegenerateRecoveryCodes(ctx context.Context, in *RegenerateRecoveryCodesReq, opts ...grpc.CallOption) (*RegenerateRecoveryCodesResp, error) {
+	out := new(RegenerateRecoveryCodesResp)
+	err := c.cc.Invoke(ctx, "/openim.admin.admin/RegenerateRecoveryCodes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) SetTOTPRequired(ctx context.Context, in *SetTOTPRequiredReq, opts ...grpc.CallOption) (*SetTOTPRequiredResp, error) {
+	out := new(SetTOTPRequiredResp)
+	err := c.cc.Invoke(ctx, "/openim.admin.admin/SetTOTPRequired", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *adminClient) AddDefaultFriend(ctx context.Context, in *AddDefaultFriendReq, opts ...grpc.CallOption) (*AddDefaultFriendResp, error) {
 	out := new(AddDefaultFriendResp)
 	err := c.cc.Invoke(ctx, "/openim.admin.admin/AddDefaultFriend", in, out, opts...)
@@ -7979,9 +12778,18 @@ func (c *adminClient) FindInvitationCode(ctx context.Context, in *FindInvitation
 	return out, nil
 }
 
-func (c *adminClient) UseInvitationCode(ctx context.Context, in *UseInvitationCodeReq, opts ...grpc.CallOption) (*UseInvitationCodeResp, error) {
-	out := new(UseInvitationCodeResp)
-	err := c.cc.Invoke(ctx, "/openim.admin.admin/UseInvitationCode", in, out, opts...)
+func (c *adminClient) UseInvitationCode(ctx context.Context, in *UseInvitationCodeReq, opts ...grpc.CallOption) (*UseInvitationCodeResp, error) {
+	out := new(UseInvitationCodeResp)
+	err := c.cc.Invoke(ctx, "/openim.admin.admin/UseInvitationCode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) ReleaseInvitationCode(ctx context.Context, in *ReleaseInvitationCodeReq, opts ...grpc.CallOption) (*ReleaseInvitationCodeResp, error) {
+	out := new(ReleaseInvitationCodeResp)
+	err := c.cc.Invoke(ctx, "/openim.admin.admin/ReleaseInvitationCode", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -8006,6 +12814,24 @@ func (c *adminClient) SearchInvitationCode(ctx context.Context, in *SearchInvita
 	return out, nil
 }
 
+func (c *adminClient) SearchInvitationUse(ctx context.Context, in *SearchInvitationUseReq, opts ...grpc.CallOption) (*SearchInvitationUseResp, error) {
+	out := new(SearchInvitationUseResp)
+	err := c.cc.Invoke(ctx, "/openim.admin.admin/SearchInvitationUse", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) GetInvitationReport(ctx context.Context, in *GetInvitationReportReq, opts ...grpc.CallOption) (*GetInvitationReportResp, error) {
+	out := new(GetInvitationReportResp)
+	err := c.cc.Invoke(ctx, "/openim.admin.admin/GetInvitationReport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *adminClient) SearchUserIPLimitLogin(ctx context.Context, in *SearchUserIPLimitLoginReq, opts ...grpc.CallOption) (*SearchUserIPLimitLoginResp, error) {
 	out := new(SearchUserIPLimitLoginResp)
 	err := c.cc.Invoke(ctx, "/openim.admin.admin/SearchUserIPLimitLogin", in, out, opts...)
@@ -8105,6 +12931,15 @@ func (c *adminClient) FindUserBlockInfo(ctx context.Context, in *FindUserBlockIn
 	return out, nil
 }
 
+func (c *adminClient) SearchBlockHistory(ctx context.Context, in *SearchBlockHistoryReq, opts ...grpc.CallOption) (*SearchBlockHistoryResp, error) {
+	out := new(SearchBlockHistoryResp)
+	err := c.cc.Invoke(ctx, "/openim.admin.admin/SearchBlockHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *adminClient) CheckRegisterForbidden(ctx context.Context, in *CheckRegisterForbiddenReq, opts ...grpc.CallOption) (*CheckRegisterForbiddenResp, error) {
 	out := new(CheckRegisterForbiddenResp)
 	err := c.cc.Invoke(ctx, "/openim.admin.admin/CheckRegisterForbidden", in, out, opts...)
@@ -8141,6 +12976,24 @@ func (c *adminClient) ParseToken(ctx context.Context, in *ParseTokenReq, opts ..
 	return out, nil
 }
 
+func (c *adminClient) RefreshToken(ctx context.Context, in *RefreshTokenReq, opts ...grpc.CallOption) (*RefreshTokenResp, error) {
+	out := new(RefreshTokenResp)
+	err := c.cc.Invoke(ctx, "/openim.admin.admin/RefreshToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) GetJWKS(ctx context.Context, in *GetJWKSReq, opts ...grpc.CallOption) (*GetJWKSResp, error) {
+	out := new(GetJWKSResp)
+	err := c.cc.Invoke(ctx, "/openim.admin.admin/GetJWKS", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *adminClient) AddApplet(ctx context.Context, in *AddAppletReq, opts ...grpc.CallOption) (*AddAppletResp, error) {
 	out := new(AddAppletResp)
 	err := c.cc.Invoke(ctx, "/openim.admin.admin/AddApplet", in, out, opts...)
@@ -8231,6 +13084,33 @@ func (c *adminClient) InvalidateToken(ctx context.Context, in *InvalidateTokenRe
 	return out, nil
 }
 
+func (c *adminClient) ListSessions(ctx context.Context, in *ListSessionsReq, opts ...grpc.CallOption) (*ListSessionsResp, error) {
+	out := new(ListSessionsResp)
+	err := c.cc.Invoke(ctx, "/openim.admin.admin/ListSessions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) RevokeSession(ctx context.Context, in *RevokeSessionReq, opts ...grpc.CallOption) (*RevokeSessionResp, error) {
+	out := new(RevokeSessionResp)
+	err := c.cc.Invoke(ctx, "/openim.admin.admin/RevokeSession", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) RevokeOtherSessions(ctx context.Context, in *RevokeOtherSessionsReq, opts ...grpc.CallOption) (*RevokeOtherSessionsResp, error) {
+	out := new(RevokeOtherSessionsResp)
+	err := c.cc.Invoke(ctx, "/openim.admin.admin/RevokeOtherSessions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AdminServer is the server API for Admin service.
 type AdminServer interface {
 	// Login
@@ -8243,6 +13123,24 @@ type AdminServer interface {
 	ChangeAdminPassword(context.Context, *ChangeAdminPasswordReq) (*ChangeAdminPasswordResp, error)
 	DelAdminAccount(context.Context, *DelAdminAccountReq) (*DelAdminAccountResp, error)
 	SearchAdminAccount(context.Context, *SearchAdminAccountReq) (*SearchAdminAccountResp, error)
+	// admin role
+	AddAdminRole(context.Context, *AddAdminRoleReq) (*AddAdminRoleResp, error)
+	UpdateAdminRole(context.Context, *UpdateAdminRoleReq) (*UpdateAdminRoleResp, error)
+	DelAdminRole(context.Context, *DelAdminRoleReq) (*DelAdminRoleResp, error)
+	SearchAdminRole(context.Context, *SearchAdminRoleReq) (*SearchAdminRoleResp, error)
+	SetAdminRoles(context.Context, *SetAdminRolesReq) (*SetAdminRolesResp, error)
+	CheckAdminPermission(context.Context, *CheckAdminPermissionReq) (*CheckAdminPermissionResp, error)
+	// audit log
+	SearchAuditLog(context.Context, *SearchAuditLogReq) (*SearchAuditLogResp, error)
+	// writes an admin operation another service audited, only the chat services may call it
+	AddAuditLog(context.Context, *AddAuditLogReq) (*AddAuditLogResp, error)
+	// two-factor authentication
+	VerifyLoginTOTP(context.Context, *VerifyLoginTOTPReq) (*LoginResp, error)
+	EnrollTOTP(context.Context, *EnrollTOTPReq) (*EnrollTOTPResp, error)
+	ConfirmTOTP(context.Context, *ConfirmTOTPReq) (*ConfirmTOTPResp, error)
+	DisableTOTP(context.Context, *DisableTOTPReq) (*DisableTOTPResp, error)
+	RegenerateRecoveryCodes(context.Context, *RegenerateRecoveryCodesReq) (*RegenerateRecoveryCodesResp, error)
+	SetTOTPRequired(context.Context, *SetTOTPRequiredReq) (*SetTOTPRequiredResp, error)
 	// Add Remove Get default friend list on registration
 	AddDefaultFriend(context.Context, *AddDefaultFriendReq) (*AddDefaultFriendResp, error)
 	DelDefaultFriend(context.Context, *DelDefaultFriendReq) (*DelDefaultFriendResp, error)
@@ -8257,8 +13155,12 @@ type AdminServer interface {
 	GenInvitationCode(context.Context, *GenInvitationCodeReq) (*GenInvitationCodeResp, error)
 	FindInvitationCode(context.Context, *FindInvitationCodeReq) (*FindInvitationCodeResp, error)
 	UseInvitationCode(context.Context, *UseInvitationCodeReq) (*UseInvitationCodeResp, error)
+	// ReleaseInvitationCode takes back a use when the registration that took it failed
+	ReleaseInvitationCode(context.Context, *ReleaseInvitationCodeReq) (*ReleaseInvitationCodeResp, error)
 	DelInvitationCode(context.Context, *DelInvitationCodeReq) (*DelInvitationCodeResp, error)
 	SearchInvitationCode(context.Context, *SearchInvitationCodeReq) (*SearchInvitationCodeResp, error)
+	SearchInvitationUse(context.Context, *SearchInvitationUseReq) (*SearchInvitationUseResp, error)
+	GetInvitationReport(context.Context, *GetInvitationReportReq) (*GetInvitationReportResp, error)
 	// User login ip limit Query Add Remove
 	SearchUserIPLimitLogin(context.Context, *SearchUserIPLimitLoginReq) (*SearchUserIPLimitLoginResp, error)
 	AddUserIPLimitLogin(context.Context, *AddUserIPLimitLoginReq) (*AddUserIPLimitLoginResp, error)
@@ -8273,12 +13175,17 @@ type AdminServer interface {
 	UnblockUser(context.Context, *UnblockUserReq) (*UnblockUserResp, error)
 	SearchBlockUser(context.Context, *SearchBlockUserReq) (*SearchBlockUserResp, error)
 	FindUserBlockInfo(context.Context, *FindUserBlockInfoReq) (*FindUserBlockInfoResp, error)
+	SearchBlockHistory(context.Context, *SearchBlockHistoryReq) (*SearchBlockHistoryResp, error)
 	CheckRegisterForbidden(context.Context, *CheckRegisterForbiddenReq) (*CheckRegisterForbiddenResp, error)
 	CheckLoginForbidden(context.Context, *CheckLoginForbiddenReq) (*CheckLoginForbiddenResp, error)
 	// create token
 	CreateToken(context.Context, *CreateTokenReq) (*CreateTokenResp, error)
 	// parse token
 	ParseToken(context.Context, *ParseTokenReq) (*ParseTokenResp, error)
+	// rotates the refresh token, a reused refresh token revokes its session
+	RefreshToken(context.Context, *RefreshTokenReq) (*RefreshTokenResp, error)
+	// the public keys of the signing keys, empty while tokens are signed with the shared secret
+	GetJWKS(context.Context, *GetJWKSReq) (*GetJWKSResp, error)
 	// app
 	AddApplet(context.Context, *AddAppletReq) (*AddAppletResp, error)
 	DelApplet(context.Context, *DelAppletReq) (*DelAppletResp, error)
@@ -8292,6 +13199,10 @@ type AdminServer interface {
 	GetUserToken(context.Context, *GetUserTokenReq) (*GetUserTokenResp, error)
 	// invalidate token
 	InvalidateToken(context.Context, *InvalidateTokenReq) (*InvalidateTokenResp, error)
+	// sessions of a user, each token is one session
+	ListSessions(context.Context, *ListSessionsReq) (*ListSessionsResp, error)
+	RevokeSession(context.Context, *RevokeSessionReq) (*RevokeSessionResp, error)
+	RevokeOtherSessions(context.Context, *RevokeOtherSessionsReq) (*RevokeOtherSessionsResp, error)
 }
 
 // UnimplementedAdminServer can be embedded to have forward compatible implementations.
@@ -8322,6 +13233,48 @@ func (*UnimplementedAdminServer) DelAdminAccount(context.Context, *DelAdminAccou
 func (*UnimplementedAdminServer) SearchAdminAccount(context.Context, *SearchAdminAccountReq) (*SearchAdminAccountResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SearchAdminAccount not implemented")
 }
+func (*UnimplementedAdminServer) AddAdminRole(context.Context, *AddAdminRoleReq) (*AddAdminRoleResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddAdminRole not implemented")
+}
+func (*UnimplementedAdminServer) UpdateAdminRole(context.Context, *UpdateAdminRoleReq) (*UpdateAdminRoleResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAdminRole not implemented")
+}
+func (*UnimplementedAdminServer) DelAdminRole(context.Context, *DelAdminRoleReq) (*DelAdminRoleResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelAdminRole not implemented")
+}
+func (*UnimplementedAdminServer) SearchAdminRole(context.Context, *SearchAdminRoleReq) (*SearchAdminRoleResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchAdminRole not implemented")
+}
+func (*UnimplementedAdminServer) SetAdminRoles(context.Context, *SetAdminRolesReq) (*SetAdminRolesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAdminRoles not implemented")
+}
+func (*UnimplementedAdminServer) CheckAdminPermission(context.Context, *CheckAdminPermissionReq) (*CheckAdminPermissionResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckAdminPermission not implemented")
+}
+func (*UnimplementedAdminServer) SearchAuditLog(context.Context, *SearchAuditLogReq) (*SearchAuditLogResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchAuditLog not implemented")
+}
+func (*UnimplementedAdminServer) AddAuditLog(context.Context, *AddAuditLogReq) (*AddAuditLogResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddAuditLog not implemented")
+}
+func (*UnimplementedAdminServer) VerifyLoginTOTP(context.Context, *VerifyLoginTOTPReq) (*LoginResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyLoginTOTP not implemented")
+}
+func (*UnimplementedAdminServer) EnrollTOTP(context.Context, *EnrollTOTPReq) (*EnrollTOTPResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnrollTOTP not implemented")
+}
+func (*UnimplementedAdminServer) ConfirmTOTP(context.Context, *ConfirmTOTPReq) (*ConfirmTOTPResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmTOTP not implemented")
+}
+func (*UnimplementedAdminServer) DisableTOTP(context.Context, *DisableTOTPReq) (*DisableTOTPResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisableTOTP not implemented")
+}
+func (*UnimplementedAdminServer) RegenerateRecoveryCodes(context.Context, *RegenerateRecoveryCodesReq) (*RegenerateRecoveryCodesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegenerateRecoveryCodes not implemented")
+}
+func (*UnimplementedAdminServer) SetTOTPRequired(context.Context, *SetTOTPRequiredReq) (*SetTOTPRequiredResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetTOTPRequired not implemented")
+}
 func (*UnimplementedAdminServer) AddDefaultFriend(context.Context, *AddDefaultFriendReq) (*AddDefaultFriendResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddDefaultFriend not implemented")
 }
@@ -8358,12 +13311,21 @@ func (*UnimplementedAdminServer) FindInvitationCode(context.Context, *FindInvita
 func (*UnimplementedAdminServer) UseInvitationCode(context.Context, *UseInvitationCodeReq) (*UseInvitationCodeResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UseInvitationCode not implemented")
 }
+func (*UnimplementedAdminServer) ReleaseInvitationCode(context.Context, *ReleaseInvitationCodeReq) (*ReleaseInvitationCodeResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleaseInvitationCode not implemented")
+}
 func (*UnimplementedAdminServer) DelInvitationCode(context.Context, *DelInvitationCodeReq) (*DelInvitationCodeResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DelInvitationCode not implemented")
 }
 func (*UnimplementedAdminServer) SearchInvitationCode(context.Context, *SearchInvitationCodeReq) (*SearchInvitationCodeResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SearchInvitationCode not implemented")
 }
+func (*UnimplementedAdminServer) SearchInvitationUse(context.Context, *SearchInvitationUseReq) (*SearchInvitationUseResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchInvitationUse not implemented")
+}
+func (*UnimplementedAdminServer) GetInvitationReport(context.Context, *GetInvitationReportReq) (*GetInvitationReportResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInvitationReport not implemented")
+}
 func (*UnimplementedAdminServer) SearchUserIPLimitLogin(context.Context, *SearchUserIPLimitLoginReq) (*SearchUserIPLimitLoginResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SearchUserIPLimitLogin not implemented")
 }
@@ -8397,6 +13359,9 @@ func (*UnimplementedAdminServer) SearchBlockUser(context.Context, *SearchBlockUs
 func (*UnimplementedAdminServer) FindUserBlockInfo(context.Context, *FindUserBlockInfoReq) (*FindUserBlockInfoResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FindUserBlockInfo not implemented")
 }
+func (*UnimplementedAdminServer) SearchBlockHistory(context.Context, *SearchBlockHistoryReq) (*SearchBlockHistoryResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchBlockHistory not implemented")
+}
 func (*UnimplementedAdminServer) CheckRegisterForbidden(context.Context, *CheckRegisterForbiddenReq) (*CheckRegisterForbiddenResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CheckRegisterForbidden not implemented")
 }
@@ -8409,6 +13374,12 @@ func (*UnimplementedAdminServer) CreateToken(context.Context, *CreateTokenReq) (
 func (*UnimplementedAdminServer) ParseToken(context.Context, *ParseTokenReq) (*ParseTokenResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ParseToken not implemented")
 }
+func (*UnimplementedAdminServer) RefreshToken(context.Context, *RefreshTokenReq) (*RefreshTokenResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefreshToken not implemented")
+}
+func (*UnimplementedAdminServer) GetJWKS(context.Context, *GetJWKSReq) (*GetJWKSResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetJWKS not implemented")
+}
 func (*UnimplementedAdminServer) AddApplet(context.Context, *AddAppletReq) (*AddAppletResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddApplet not implemented")
 }
@@ -8433,157 +13404,418 @@ func (*UnimplementedAdminServer) SetClientConfig(context.Context, *SetClientConf
 func (*UnimplementedAdminServer) DelClientConfig(context.Context, *DelClientConfigReq) (*DelClientConfigResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DelClientConfig not implemented")
 }
-func (*UnimplementedAdminServer) GetUserToken(context.Context, *GetUserTokenReq) (*GetUserTokenResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetUserToken not implemented")
+func (*UnimplementedAdminServer) GetUserToken(context.Context, *GetUserTokenReq) (*GetUserTokenResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserToken not implemented")
+}
+func (*UnimplementedAdminServer) InvalidateToken(context.Context, *InvalidateTokenReq) (*InvalidateTokenResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InvalidateToken not implemented")
+}
+func (*UnimplementedAdminServer) ListSessions(context.Context, *ListSessionsReq) (*ListSessionsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSessions not implemented")
+}
+func (*UnimplementedAdminServer) RevokeSession(context.Context, *RevokeSessionReq) (*RevokeSessionResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeSession not implemented")
+}
+func (*UnimplementedAdminServer) RevokeOtherSessions(context.Context, *RevokeOtherSessionsReq) (*RevokeOtherSessionsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeOtherSessions not implemented")
+}
+
+func RegisterAdminServer(s *grpc.Server, srv AdminServer) {
+	s.RegisterService(&_Admin_serviceDesc, srv)
+}
+
+func _Admin_Login_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoginReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).Login(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/openim.admin.admin/Login",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).Login(ctx, req.(*LoginReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_ChangePassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangePasswordReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).ChangePassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/openim.admin.admin/ChangePassword",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).ChangePassword(ctx, req.(*ChangePasswordReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_AdminUpdateInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminUpdateInfoReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).AdminUpdateInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/openim.admin.admin/AdminUpdateInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).AdminUpdateInfo(ctx, req.(*AdminUpdateInfoReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_GetAdminInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAdminInfoReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).GetAdminInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/openim.admin.admin/GetAdminInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).GetAdminInfo(ctx, req.(*GetAdminInfoReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_AddAdminAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddAdminAccountReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).AddAdminAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/openim.admin.admin/AddAdminAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).AddAdminAccount(ctx, req.(*AddAdminAccountReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_ChangeAdminPassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangeAdminPasswordReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).ChangeAdminPassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/openim.admin.admin/ChangeAdminPassword",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).ChangeAdminPassword(ctx, req.(*ChangeAdminPasswordReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_DelAdminAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DelAdminAccountReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).DelAdminAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/openim.admin.admin/DelAdminAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).DelAdminAccount(ctx, req.(*DelAdminAccountReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_SearchAdminAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchAdminAccountReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).SearchAdminAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/openim.admin.admin/SearchAdminAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).SearchAdminAccount(ctx, req.(*SearchAdminAccountReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_AddAdminRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddAdminRoleReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).AddAdminRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/openim.admin.admin/AddAdminRole",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).AddAdminRole(ctx, req.(*AddAdminRoleReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_UpdateAdminRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateAdminRoleReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).UpdateAdminRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/openim.admin.admin/UpdateAdminRole",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).UpdateAdminRole(ctx, req.(*UpdateAdminRoleReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_DelAdminRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DelAdminRoleReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).DelAdminRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/openim.admin.admin/DelAdminRole",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).DelAdminRole(ctx, req.(*DelAdminRoleReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_SearchAdminRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchAdminRoleReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).SearchAdminRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/openim.admin.admin/SearchAdminRole",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).SearchAdminRole(ctx, req.(*SearchAdminRoleReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedAdminServer) InvalidateToken(context.Context, *InvalidateTokenReq) (*InvalidateTokenResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method InvalidateToken not implemented")
+
+func _Admin_SetAdminRoles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetAdminRolesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).SetAdminRoles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/openim.admin.admin/SetAdminRoles",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).SetAdminRoles(ctx, req.(*SetAdminRolesReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterAdminServer(s *grpc.Server, srv AdminServer) {
-	s.RegisterService(&_Admin_serviceDesc, srv)
+func _Admin_CheckAdminPermission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckAdminPermissionReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).CheckAdminPermission(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/openim.admin.admin/CheckAdminPermission",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).CheckAdminPermission(ctx, req.(*CheckAdminPermissionReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _Admin_Login_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LoginReq)
+func _Admin_SearchAuditLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchAuditLogReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AdminServer).Login(ctx, in)
+		return srv.(AdminServer).SearchAuditLog(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/openim.admin.admin/Login",
+		FullMethod: "/openim.admin.admin/SearchAuditLog",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminServer).Login(ctx, req.(*LoginReq))
+		return srv.(AdminServer).SearchAuditLog(ctx, req.(*SearchAuditLogReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Admin_ChangePassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ChangePasswordReq)
+func _Admin_AddAuditLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddAuditLogReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AdminServer).ChangePassword(ctx, in)
+		return srv.(AdminServer).AddAuditLog(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/openim.admin.admin/ChangePassword",
+		FullMethod: "/openim.admin.admin/AddAuditLog",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminServer).ChangePassword(ctx, req.(*ChangePasswordReq))
+		return srv.(AdminServer).AddAuditLog(ctx, req.(*AddAuditLogReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Admin_AdminUpdateInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AdminUpdateInfoReq)
+func _Admin_VerifyLoginTOTP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyLoginTOTPReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AdminServer).AdminUpdateInfo(ctx, in)
+		return srv.(AdminServer).VerifyLoginTOTP(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/openim.admin.admin/AdminUpdateInfo",
+		FullMethod: "/openim.admin.admin/VerifyLoginTOTP",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminServer).AdminUpdateInfo(ctx, req.(*AdminUpdateInfoReq))
+		return srv.(AdminServer).VerifyLoginTOTP(ctx, req.(*VerifyLoginTOTPReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Admin_GetAdminInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetAdminInfoReq)
+func _Admin_EnrollTOTP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EnrollTOTPReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AdminServer).GetAdminInfo(ctx, in)
+		return srv.(AdminServer).EnrollTOTP(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/openim.admin.admin/GetAdminInfo",
+		FullMethod: "/openim.admin.admin/EnrollTOTP",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminServer).GetAdminInfo(ctx, req.(*GetAdminInfoReq))
+		return srv.(AdminServer).EnrollTOTP(ctx, req.(*EnrollTOTPReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Admin_AddAdminAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddAdminAccountReq)
+func _Admin_ConfirmTOTP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfirmTOTPReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AdminServer).AddAdminAccount(ctx, in)
+		return srv.(AdminServer).ConfirmTOTP(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/openim.admin.admin/AddAdminAccount",
+		FullMethod: "/openim.admin.admin/ConfirmTOTP",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminServer).AddAdminAccount(ctx, req.(*AddAdminAccountReq))
+		return srv.(AdminServer).ConfirmTOTP(ctx, req.(*ConfirmTOTPReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Admin_ChangeAdminPassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ChangeAdminPasswordReq)
+func _Admin_DisableTOTP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DisableTOTPReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AdminServer).ChangeAdminPassword(ctx, in)
+		return srv.(AdminServer).DisableTOTP(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/openim.admin.admin/ChangeAdminPassword",
+		FullMethod: "/openim.admin.admin/DisableTOTP",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminServer).ChangeAdminPassword(ctx, req.(*ChangeAdminPasswordReq))
+		return srv.(AdminServer).DisableTOTP(ctx, req.(*DisableTOTPReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Admin_DelAdminAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DelAdminAccountReq)
+func _Admin_RegenerateRecoveryCodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegenerateRecoveryCodesReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AdminServer).DelAdminAccount(ctx, in)
+		return srv.(AdminServer).RegenerateRecoveryCodes(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/openim.admin.admin/DelAdminAccount",
+		FullMethod: "/openim.admin.admin/RegenerateRecoveryCodes",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminServer).DelAdminAccount(ctx, req.(*DelAdminAccountReq))
+		return srv.(AdminServer).RegenerateRecoveryCodes(ctx, req.(*RegenerateRecoveryCodesReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Admin_SearchAdminAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SearchAdminAccountReq)
+func _Admin_SetTOTPRequired_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetTOTPRequiredReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AdminServer).SearchAdminAccount(ctx, in)
+		return srv.(AdminServer).SetTOTPRequired(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/openim.admin.admin/SearchAdminAccount",
+		FullMethod: "/openim.admin.admin/SetTOTPRequired",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminServer).SearchAdminAccount(ctx, req.(*SearchAdminAccountReq))
+		return srv.(AdminServer).SetTOTPRequired(ctx, req.(*SetTOTPRequiredReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -8804,6 +14036,24 @@ func _Admin_UseInvitationCode_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Admin_ReleaseInvitationCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReleaseInvitationCodeReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).ReleaseInvitationCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/openim.admin.admin/ReleaseInvitationCode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).ReleaseInvitationCode(ctx, req.(*ReleaseInvitationCodeReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Admin_DelInvitationCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DelInvitationCodeReq)
 	if err := dec(in); err != nil {
@@ -8840,6 +14090,42 @@ func _Admin_SearchInvitationCode_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Admin_SearchInvitationUse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchInvitationUseReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).SearchInvitationUse(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/openim.admin.admin/SearchInvitationUse",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).SearchInvitationUse(ctx, req.(*SearchInvitationUseReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_GetInvitationReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetInvitationReportReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).GetInvitationReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/openim.admin.admin/GetInvitationReport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).GetInvitationReport(ctx, req.(*GetInvitationReportReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Admin_SearchUserIPLimitLogin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SearchUserIPLimitLoginReq)
 	if err := dec(in); err != nil {
@@ -9038,6 +14324,24 @@ func _Admin_FindUserBlockInfo_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Admin_SearchBlockHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchBlockHistoryReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).SearchBlockHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/openim.admin.admin/SearchBlockHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).SearchBlockHistory(ctx, req.(*SearchBlockHistoryReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Admin_CheckRegisterForbidden_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CheckRegisterForbiddenReq)
 	if err := dec(in); err != nil {
@@ -9110,6 +14414,42 @@ func _Admin_ParseToken_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Admin_RefreshToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefreshTokenReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).RefreshToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/openim.admin.admin/RefreshToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).RefreshToken(ctx, req.(*RefreshTokenReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_GetJWKS_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetJWKSReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).GetJWKS(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/openim.admin.admin/GetJWKS",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).GetJWKS(ctx, req.(*GetJWKSReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Admin_AddApplet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(AddAppletReq)
 	if err := dec(in); err != nil {
@@ -9290,6 +14630,60 @@ func _Admin_InvalidateToken_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Admin_ListSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSessionsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).ListSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/openim.admin.admin/ListSessions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).ListSessions(ctx, req.(*ListSessionsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_RevokeSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeSessionReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).RevokeSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/openim.admin.admin/RevokeSession",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).RevokeSession(ctx, req.(*RevokeSessionReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_RevokeOtherSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeOtherSessionsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).RevokeOtherSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/openim.admin.admin/RevokeOtherSessions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).RevokeOtherSessions(ctx, req.(*RevokeOtherSessionsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Admin_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "openim.admin.admin",
 	HandlerType: (*AdminServer)(nil),
@@ -9326,6 +14720,62 @@ var _Admin_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SearchAdminAccount",
 			Handler:    _Admin_SearchAdminAccount_Handler,
 		},
+		{
+			MethodName: "AddAdminRole",
+			Handler:    _Admin_AddAdminRole_Handler,
+		},
+		{
+			MethodName: "UpdateAdminRole",
+			Handler:    _Admin_UpdateAdminRole_Handler,
+		},
+		{
+			MethodName: "DelAdminRole",
+			Handler:    _Admin_DelAdminRole_Handler,
+		},
+		{
+			MethodName: "SearchAdminRole",
+			Handler:    _Admin_SearchAdminRole_Handler,
+		},
+		{
+			MethodName: "SetAdminRoles",
+			Handler:    _Admin_SetAdminRoles_Handler,
+		},
+		{
+			MethodName: "CheckAdminPermission",
+			Handler:    _Admin_CheckAdminPermission_Handler,
+		},
+		{
+			MethodName: "SearchAuditLog",
+			Handler:    _Admin_SearchAuditLog_Handler,
+		},
+		{
+			MethodName: "AddAuditLog",
+			Handler:    _Admin_AddAuditLog_Handler,
+		},
+		{
+			MethodName: "VerifyLoginTOTP",
+			Handler:    _Admin_VerifyLoginTOTP_Handler,
+		},
+		{
+			MethodName: "EnrollTOTP",
+			Handler:    _Admin_EnrollTOTP_Handler,
+		},
+		{
+			MethodName: "ConfirmTOTP",
+			Handler:    _Admin_ConfirmTOTP_Handler,
+		},
+		{
+			MethodName: "DisableTOTP",
+			Handler:    _Admin_DisableTOTP_Handler,
+		},
+		{
+			MethodName: "RegenerateRecoveryCodes",
+			Handler:    _Admin_RegenerateRecoveryCodes_Handler,
+		},
+		{
+			MethodName: "SetTOTPRequired",
+			Handler:    _Admin_SetTOTPRequired_Handler,
+		},
 		{
 			MethodName: "AddDefaultFriend",
 			Handler:    _Admin_AddDefaultFriend_Handler,
@@ -9374,6 +14824,10 @@ var _Admin_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UseInvitationCode",
 			Handler:    _Admin_UseInvitationCode_Handler,
 		},
+		{
+			MethodName: "ReleaseInvitationCode",
+			Handler:    _Admin_ReleaseInvitationCode_Handler,
+		},
 		{
 			MethodName: "DelInvitationCode",
 			Handler:    _Admin_DelInvitationCode_Handler,
@@ -9382,6 +14836,14 @@ var _Admin_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SearchInvitationCode",
 			Handler:    _Admin_SearchInvitationCode_Handler,
 		},
+		{
+			MethodName: "SearchInvitationUse",
+			Handler:    _Admin_SearchInvitationUse_Handler,
+		},
+		{
+			MethodName: "GetInvitationReport",
+			Handler:    _Admin_GetInvitationReport_Handler,
+		},
 		{
 			MethodName: "SearchUserIPLimitLogin",
 			Handler:    _Admin_SearchUserIPLimitLogin_Handler,
@@ -9426,6 +14888,10 @@ var _Admin_serviceDesc = grpc.ServiceDesc{
 			MethodName: "FindUserBlockInfo",
 			Handler:    _Admin_FindUserBlockInfo_Handler,
 		},
+		{
+			MethodName: "SearchBlockHistory",
+			Handler:    _Admin_SearchBlockHistory_Handler,
+		},
 		{
 			MethodName: "CheckRegisterForbidden",
 			Handler:    _Admin_CheckRegisterForbidden_Handler,
@@ -9442,6 +14908,14 @@ var _Admin_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ParseToken",
 			Handler:    _Admin_ParseToken_Handler,
 		},
+		{
+			MethodName: "RefreshToken",
+			Handler:    _Admin_RefreshToken_Handler,
+		},
+		{
+			MethodName: "GetJWKS",
+			Handler:    _Admin_GetJWKS_Handler,
+		},
 		{
 			MethodName: "AddApplet",
 			Handler:    _Admin_AddApplet_Handler,
@@ -9482,6 +14956,18 @@ var _Admin_serviceDesc = grpc.ServiceDesc{
 			MethodName: "InvalidateToken",
 			Handler:    _Admin_InvalidateToken_Handler,
 		},
+		{
+			MethodName: "ListSessions",
+			Handler:    _Admin_ListSessions_Handler,
+		},
+		{
+			MethodName: "RevokeSession",
+			Handler:    _Admin_RevokeSession_Handler,
+		},
+		{
+			MethodName: "RevokeOtherSessions",
+			Handler:    _Admin_RevokeOtherSessions_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "admin/admin.proto",
@@ -101,19 +101,28 @@ func (x *RegisterUserReq) Check() error {
 	//if x.VerifyCode == "" {
 	//	return errs.ErrArgs.WrapMsg("VerifyCode is empty")
 	//}
+	if x.User == nil {
+		return errs.ErrArgs.WrapMsg("user is empty")
+	}
 	if x.User.Nickname == "" {
 		return errs.ErrArgs.WrapMsg("Nickname is nil")
 	}
+	if x.User.Address == "" {
+		return errs.ErrArgs.WrapMsg("Address is empty")
+	}
 	if x.Platform < constantpb.IOSPlatformID || x.Platform > constantpb.AdminPlatformID {
 		return errs.ErrArgs.WrapMsg("platform is invalid")
 	}
-	if x.User == nil {
-		return errs.ErrArgs.WrapMsg("user is empty")
-	}
 	return nil
 }
 
 func (x *LoginReq) Check() error {
+	if x.Address == "" {
+		return errs.ErrArgs.WrapMsg("address is empty")
+	}
+	if x.Signature == "" {
+		return errs.ErrArgs.WrapMsg("signature is empty")
+	}
 	if x.Platform < constantpb.IOSPlatformID || x.Platform > constantpb.AdminPlatformID {
 		return errs.ErrArgs.WrapMsg("platform is invalid")
 	}
@@ -265,6 +274,33 @@ func (x *PublishPostReq) Check() error {
 	if x.AllowForward < 0 || x.AllowForward > 1 {
 		return errs.ErrArgs.WrapMsg("allowForward is invalid")
 	}
+	if x.PublishAt < 0 {
+		return errs.ErrArgs.WrapMsg("publishAt is invalid")
+	}
+	return checkVisibility(x.Visibility, x.AllowUserIDs)
+}
+
+func checkVisibility(visibility int32, allowUserIDs []string) error {
+	if visibility < constant.VisibilityPublic || visibility > constant.VisibilityCustom {
+		return errs.ErrArgs.WrapMsg("visibility is invalid")
+	}
+	if visibility != constant.VisibilityCustom {
+		if len(allowUserIDs) > 0 {
+			return errs.ErrArgs.WrapMsg("allowUserIDs is only used by custom visibility")
+		}
+		return nil
+	}
+	if len(allowUserIDs) == 0 {
+		return errs.ErrArgs.WrapMsg("allowUserIDs is empty")
+	}
+	if len(allowUserIDs) > constant.MaxPostAllowUserNum {
+		return errs.ErrArgs.WrapMsg("too many allowUserIDs")
+	}
+	for _, userID := range allowUserIDs {
+		if userID == "" {
+			return errs.ErrArgs.WrapMsg("allowUserIDs contains empty userID")
+		}
+	}
 	return nil
 }
 
@@ -328,13 +364,75 @@ func (x *CommentPostReq) Check() error {
 	if x.CommentPostID == "" {
 		return errs.ErrArgs.WrapMsg("commentPostID is empty")
 	}
-	return nil
+	return checkVisibility(x.Visibility, x.AllowUserIDs)
 }
 
 func (x *ReferencePostReq) Check() error {
 	if x.RefPostID == "" {
 		return errs.ErrArgs.WrapMsg("refPostID is empty")
 	}
+	return checkVisibility(x.Visibility, x.AllowUserIDs)
+}
+
+func (x *EditPostReq) Check() error {
+	if x.PostID == "" {
+		return errs.ErrArgs.WrapMsg("postID is empty")
+	}
+	if x.Content.GetValue() == "" && len(x.MediaMsgs) == 0 {
+		return errs.ErrArgs.WrapMsg("content is empty")
+	}
+	return nil
+}
+
+func (x *GetPostRevisionsReq) Check() error {
+	if x.PostID == "" {
+		return errs.ErrArgs.WrapMsg("postID is empty")
+	}
+	return nil
+}
+
+func (x *GetNotificationsReq) Check() error {
+	if x.Count <= 0 || x.Count > constant.MaxNotificationPage {
+		return errs.ErrArgs.WrapMsg("count is invalid")
+	}
+	return nil
+}
+
+func (x *MarkNotificationsReadReq) Check() error {
+	if len(x.NotificationIDs) == 0 {
+		return errs.ErrArgs.WrapMsg("notificationIDs is empty")
+	}
+	return nil
+}
+
+func (x *SaveDraftReq) Check() error {
+	if x.AllowComment < 0 || x.AllowComment > 1 {
+		return errs.ErrArgs.WrapMsg("allowComment is invalid")
+	}
+	if x.AllowForward < 0 || x.AllowForward > 1 {
+		return errs.ErrArgs.WrapMsg("allowForward is invalid")
+	}
+	if x.PublishAt < 0 {
+		return errs.ErrArgs.WrapMsg("publishAt is invalid")
+	}
+	// 定时发布的草稿和直接发布一样需要内容
+	if x.PublishAt > 0 && x.Content.GetValue() == "" {
+		return errs.ErrArgs.WrapMsg("content is empty")
+	}
+	return checkVisibility(x.Visibility, x.AllowUserIDs)
+}
+
+func (x *ListDraftsReq) Check() error {
+	if x.Pagination == nil {
+		return errs.ErrArgs.WrapMsg("pagination is empty")
+	}
+	return nil
+}
+
+func (x *DeleteDraftReq) Check() error {
+	if len(x.DraftIDs) == 0 {
+		return errs.ErrArgs.WrapMsg("draftIDs is empty")
+	}
 	return nil
 }
 
@@ -344,3 +442,23 @@ func (x *CheckVersionReq) Check() error {
 	}
 	return nil
 }
+
+func (x *AddWalletReq) Check() error {
+	if x.CurrentAddress == "" || x.CurrentSignature == "" {
+		return errs.ErrArgs.WrapMsg("current wallet address and signature must be set")
+	}
+	if x.Address == "" || x.Signature == "" {
+		return errs.ErrArgs.WrapMsg("address and signature must be set")
+	}
+	if x.CurrentAddress == x.Address {
+		return errs.ErrArgs.WrapMsg("address is already the current wallet")
+	}
+	return nil
+}
+
+func (x *RemoveWalletReq) Check() error {
+	if x.Address == "" {
+		return errs.ErrArgs.WrapMsg("address is empty")
+	}
+	return nil
+}
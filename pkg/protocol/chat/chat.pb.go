@@ -918,6 +918,8 @@ type SendVerifyCodeReq struct {
 	AreaCode       string `protobuf:"bytes,6,opt,name=areaCode,proto3" json:"areaCode"`
 	PhoneNumber    string `protobuf:"bytes,7,opt,name=phoneNumber,proto3" json:"phoneNumber"`
 	Email          string `protobuf:"bytes,8,opt,name=email,proto3" json:"email"`
+	// BCP 47 tag of the email, e.g. en or zh-CN, the API fills it from Accept-Language when empty
+	Language string `protobuf:"bytes,9,opt,name=language,proto3" json:"language"`
 }
 
 func (x *SendVerifyCodeReq) Reset() {
@@ -1008,6 +1010,13 @@ func (x *SendVerifyCodeReq) GetEmail() string {
 	return ""
 }
 
+func (x *SendVerifyCodeReq) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
 type SendVerifyCodeResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1161,6 +1170,8 @@ type ChallengeNonceReq struct {
 	unknownFields protoimpl.UnknownFields
 
 	PublicKey string `protobuf:"bytes,1,opt,name=publicKey,proto3" json:"publicKey"`
+	Address   string `protobuf:"bytes,2,opt,name=address,proto3" json:"address"`
+	DeviceID  string `protobuf:"bytes,3,opt,name=deviceID,proto3" json:"deviceID"`
 }
 
 func (x *ChallengeNonceReq) Reset() {
@@ -1202,12 +1213,29 @@ func (x *ChallengeNonceReq) GetPublicKey() string {
 	return ""
 }
 
+func (x *ChallengeNonceReq) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *ChallengeNonceReq) GetDeviceID() string {
+	if x != nil {
+		return x.DeviceID
+	}
+	return ""
+}
+
 type ChallengeNonceResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Nonce string `protobuf:"bytes,1,opt,name=nonce,proto3" json:"nonce"`
+	Nonce      string `protobuf:"bytes,1,opt,name=nonce,proto3" json:"nonce"`
+	ExpireTime int64  `protobuf:"varint,2,opt,name=expireTime,proto3" json:"expireTime"`
+	// EIP-4361 message to sign, empty when Sign-In with Ethereum is disabled
+	Message string `protobuf:"bytes,3,opt,name=message,proto3" json:"message"`
 }
 
 func (x *ChallengeNonceResp) Reset() {
@@ -1249,6 +1277,20 @@ func (x *ChallengeNonceResp) GetNonce() string {
 	return ""
 }
 
+func (x *ChallengeNonceResp) GetExpireTime() int64 {
+	if x != nil {
+		return x.ExpireTime
+	}
+	return 0
+}
+
+func (x *ChallengeNonceResp) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
 type RegisterUserInfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1356,6 +1398,10 @@ type RegisterUserReq struct {
 	Platform  int32             `protobuf:"varint,3,opt,name=platform,proto3" json:"platform"`
 	AutoLogin bool              `protobuf:"varint,4,opt,name=autoLogin,proto3" json:"autoLogin"`
 	User      *RegisterUserInfo `protobuf:"bytes,5,opt,name=user,proto3" json:"user"`
+	Message   string            `protobuf:"bytes,8,opt,name=message,proto3" json:"message"`
+	UserAgent string            `protobuf:"bytes,9,opt,name=userAgent,proto3" json:"userAgent"`
+	// required when the needInvitationCodeRegister config is on
+	InvitationCode string `protobuf:"bytes,10,opt,name=invitationCode,proto3" json:"invitationCode"`
 }
 
 func (x *RegisterUserReq) Reset() {
@@ -1439,13 +1485,35 @@ func (x *RegisterUserReq) GetUser() *RegisterUserInfo {
 	return nil
 }
 
+func (x *RegisterUserReq) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *RegisterUserReq) GetUserAgent() string {
+	if x != nil {
+		return x.UserAgent
+	}
+	return ""
+}
+
+func (x *RegisterUserReq) GetInvitationCode() string {
+	if x != nil {
+		return x.InvitationCode
+	}
+	return ""
+}
+
 type RegisterUserResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserID    string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
-	ChatToken string `protobuf:"bytes,3,opt,name=chatToken,proto3" json:"chatToken"`
+	UserID       string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
+	ChatToken    string `protobuf:"bytes,3,opt,name=chatToken,proto3" json:"chatToken"`
+	RefreshToken string `protobuf:"bytes,4,opt,name=refreshToken,proto3" json:"refreshToken"`
 }
 
 func (x *RegisterUserResp) Reset() {
@@ -1494,6 +1562,13 @@ func (x *RegisterUserResp) GetChatToken() string {
 	return ""
 }
 
+func (x *RegisterUserResp) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
 type AddUserAccountReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1615,6 +1690,8 @@ type LoginReq struct {
 	Nonce     string `protobuf:"bytes,5,opt,name=nonce,proto3" json:"nonce"`
 	Signature string `protobuf:"bytes,6,opt,name=signature,proto3" json:"signature"`
 	PublicKey string `protobuf:"bytes,7,opt,name=publicKey,proto3" json:"publicKey"`
+	Message   string `protobuf:"bytes,8,opt,name=message,proto3" json:"message"`
+	UserAgent string `protobuf:"bytes,9,opt,name=userAgent,proto3" json:"userAgent"`
 }
 
 func (x *LoginReq) Reset() {
@@ -1698,6 +1775,20 @@ func (x *LoginReq) GetPublicKey() string {
 	return ""
 }
 
+func (x *LoginReq) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *LoginReq) GetUserAgent() string {
+	if x != nil {
+		return x.UserAgent
+	}
+	return ""
+}
+
 type ResetPasswordReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -2821,8 +2912,9 @@ type LoginResp struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ChatToken string `protobuf:"bytes,2,opt,name=chatToken,proto3" json:"chatToken"`
-	UserID    string `protobuf:"bytes,3,opt,name=userID,proto3" json:"userID"`
+	ChatToken    string `protobuf:"bytes,2,opt,name=chatToken,proto3" json:"chatToken"`
+	UserID       string `protobuf:"bytes,3,opt,name=userID,proto3" json:"userID"`
+	RefreshToken string `protobuf:"bytes,4,opt,name=refreshToken,proto3" json:"refreshToken"`
 }
 
 func (x *LoginResp) Reset() {
@@ -2871,19 +2963,26 @@ func (x *LoginResp) GetUserID() string {
 	return ""
 }
 
-type SearchUserInfoReq struct {
+func (x *LoginResp) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+type WalletInfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Keyword    string                    `protobuf:"bytes,1,opt,name=keyword,proto3" json:"keyword"`
-	Pagination *sdkwss.RequestPagination `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination"`
-	Genders    []int32                   `protobuf:"varint,3,rep,packed,name=genders,proto3" json:"genders"`
-	UserIDs    []string                  `protobuf:"bytes,4,rep,name=userIDs,proto3" json:"userIDs"`
+	Address    string `protobuf:"bytes,1,opt,name=address,proto3" json:"address"`
+	PublicKey  string `protobuf:"bytes,2,opt,name=publicKey,proto3" json:"publicKey"`
+	Primary    bool   `protobuf:"varint,3,opt,name=primary,proto3" json:"primary"`
+	CreateTime int64  `protobuf:"varint,4,opt,name=createTime,proto3" json:"createTime"`
 }
 
-func (x *SearchUserInfoReq) Reset() {
-	*x = SearchUserInfoReq{}
+func (x *WalletInfo) Reset() {
+	*x = WalletInfo{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_chat_chat_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2891,13 +2990,13 @@ func (x *SearchUserInfoReq) Reset() {
 	}
 }
 
-func (x *SearchUserInfoReq) String() string {
+func (x *WalletInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SearchUserInfoReq) ProtoMessage() {}
+func (*WalletInfo) ProtoMessage() {}
 
-func (x *SearchUserInfoReq) ProtoReflect() protoreflect.Message {
+func (x *WalletInfo) ProtoReflect() protoreflect.Message {
 	mi := &file_chat_chat_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2909,50 +3008,59 @@ func (x *SearchUserInfoReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SearchUserInfoReq.ProtoReflect.Descriptor instead.
-func (*SearchUserInfoReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use WalletInfo.ProtoReflect.Descriptor instead.
+func (*WalletInfo) Descriptor() ([]byte, []int) {
 	return file_chat_chat_proto_rawDescGZIP(), []int{44}
 }
 
-func (x *SearchUserInfoReq) GetKeyword() string {
+func (x *WalletInfo) GetAddress() string {
 	if x != nil {
-		return x.Keyword
+		return x.Address
 	}
 	return ""
 }
 
-func (x *SearchUserInfoReq) GetPagination() *sdkwss.RequestPagination {
+func (x *WalletInfo) GetPublicKey() string {
 	if x != nil {
-		return x.Pagination
+		return x.PublicKey
 	}
-	return nil
+	return ""
 }
 
-func (x *SearchUserInfoReq) GetGenders() []int32 {
+func (x *WalletInfo) GetPrimary() bool {
 	if x != nil {
-		return x.Genders
+		return x.Primary
 	}
-	return nil
+	return false
 }
 
-func (x *SearchUserInfoReq) GetUserIDs() []string {
+func (x *WalletInfo) GetCreateTime() int64 {
 	if x != nil {
-		return x.UserIDs
+		return x.CreateTime
 	}
-	return nil
+	return 0
 }
 
-type SearchUserInfoResp struct {
+type AddWalletReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Total uint32                 `protobuf:"varint,1,opt,name=total,proto3" json:"total"`
-	Users []*common.UserFullInfo `protobuf:"bytes,2,rep,name=users,proto3" json:"users"`
+	// a wallet already on the account, it signs the same challenge as the new one
+	CurrentAddress   string `protobuf:"bytes,1,opt,name=currentAddress,proto3" json:"currentAddress"`
+	CurrentPublicKey string `protobuf:"bytes,2,opt,name=currentPublicKey,proto3" json:"currentPublicKey"`
+	CurrentSignature string `protobuf:"bytes,3,opt,name=currentSignature,proto3" json:"currentSignature"`
+	// the wallet to link, the nonce must be issued to this address
+	Address   string `protobuf:"bytes,4,opt,name=address,proto3" json:"address"`
+	PublicKey string `protobuf:"bytes,5,opt,name=publicKey,proto3" json:"publicKey"`
+	Signature string `protobuf:"bytes,6,opt,name=signature,proto3" json:"signature"`
+	Nonce     string `protobuf:"bytes,7,opt,name=nonce,proto3" json:"nonce"`
+	Message   string `protobuf:"bytes,8,opt,name=message,proto3" json:"message"`
+	DeviceID  string `protobuf:"bytes,9,opt,name=deviceID,proto3" json:"deviceID"`
 }
 
-func (x *SearchUserInfoResp) Reset() {
-	*x = SearchUserInfoResp{}
+func (x *AddWalletReq) Reset() {
+	*x = AddWalletReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_chat_chat_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2960,13 +3068,13 @@ func (x *SearchUserInfoResp) Reset() {
 	}
 }
 
-func (x *SearchUserInfoResp) String() string {
+func (x *AddWalletReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SearchUserInfoResp) ProtoMessage() {}
+func (*AddWalletReq) ProtoMessage() {}
 
-func (x *SearchUserInfoResp) ProtoReflect() protoreflect.Message {
+func (x *AddWalletReq) ProtoReflect() protoreflect.Message {
 	mi := &file_chat_chat_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2978,36 +3086,82 @@ func (x *SearchUserInfoResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SearchUserInfoResp.ProtoReflect.Descriptor instead.
-func (*SearchUserInfoResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use AddWalletReq.ProtoReflect.Descriptor instead.
+func (*AddWalletReq) Descriptor() ([]byte, []int) {
 	return file_chat_chat_proto_rawDescGZIP(), []int{45}
 }
 
-func (x *SearchUserInfoResp) GetTotal() uint32 {
+func (x *AddWalletReq) GetCurrentAddress() string {
 	if x != nil {
-		return x.Total
+		return x.CurrentAddress
 	}
-	return 0
+	return ""
 }
 
-func (x *SearchUserInfoResp) GetUsers() []*common.UserFullInfo {
+func (x *AddWalletReq) GetCurrentPublicKey() string {
 	if x != nil {
-		return x.Users
+		return x.CurrentPublicKey
 	}
-	return nil
+	return ""
 }
 
-type GetTokenForVideoMeetingReq struct {
+func (x *AddWalletReq) GetCurrentSignature() string {
+	if x != nil {
+		return x.CurrentSignature
+	}
+	return ""
+}
+
+func (x *AddWalletReq) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *AddWalletReq) GetPublicKey() string {
+	if x != nil {
+		return x.PublicKey
+	}
+	return ""
+}
+
+func (x *AddWalletReq) GetSignature() string {
+	if x != nil {
+		return x.Signature
+	}
+	return ""
+}
+
+func (x *AddWalletReq) GetNonce() string {
+	if x != nil {
+		return x.Nonce
+	}
+	return ""
+}
+
+func (x *AddWalletReq) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *AddWalletReq) GetDeviceID() string {
+	if x != nil {
+		return x.DeviceID
+	}
+	return ""
+}
+
+type AddWalletResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Room     string `protobuf:"bytes,1,opt,name=room,proto3" json:"room"`
-	Identity string `protobuf:"bytes,2,opt,name=identity,proto3" json:"identity"`
 }
 
-func (x *GetTokenForVideoMeetingReq) Reset() {
-	*x = GetTokenForVideoMeetingReq{}
+func (x *AddWalletResp) Reset() {
+	*x = AddWalletResp{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_chat_chat_proto_msgTypes[46]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3015,13 +3169,13 @@ func (x *GetTokenForVideoMeetingReq) Reset() {
 	}
 }
 
-func (x *GetTokenForVideoMeetingReq) String() string {
+func (x *AddWalletResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTokenForVideoMeetingReq) ProtoMessage() {}
+func (*AddWalletResp) ProtoMessage() {}
 
-func (x *GetTokenForVideoMeetingReq) ProtoReflect() protoreflect.Message {
+func (x *AddWalletResp) ProtoReflect() protoreflect.Message {
 	mi := &file_chat_chat_proto_msgTypes[46]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3033,36 +3187,21 @@ func (x *GetTokenForVideoMeetingReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTokenForVideoMeetingReq.ProtoReflect.Descriptor instead.
-func (*GetTokenForVideoMeetingReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use AddWalletResp.ProtoReflect.Descriptor instead.
+func (*AddWalletResp) Descriptor() ([]byte, []int) {
 	return file_chat_chat_proto_rawDescGZIP(), []int{46}
 }
 
-func (x *GetTokenForVideoMeetingReq) GetRoom() string {
-	if x != nil {
-		return x.Room
-	}
-	return ""
-}
-
-func (x *GetTokenForVideoMeetingReq) GetIdentity() string {
-	if x != nil {
-		return x.Identity
-	}
-	return ""
-}
-
-type GetTokenForVideoMeetingResp struct {
+type ListWalletsReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ServerUrl string `protobuf:"bytes,1,opt,name=serverUrl,proto3" json:"serverUrl"`
-	Token     string `protobuf:"bytes,2,opt,name=token,proto3" json:"token"`
+	UserID string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
 }
 
-func (x *GetTokenForVideoMeetingResp) Reset() {
-	*x = GetTokenForVideoMeetingResp{}
+func (x *ListWalletsReq) Reset() {
+	*x = ListWalletsReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_chat_chat_proto_msgTypes[47]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3070,13 +3209,13 @@ func (x *GetTokenForVideoMeetingResp) Reset() {
 	}
 }
 
-func (x *GetTokenForVideoMeetingResp) String() string {
+func (x *ListWalletsReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTokenForVideoMeetingResp) ProtoMessage() {}
+func (*ListWalletsReq) ProtoMessage() {}
 
-func (x *GetTokenForVideoMeetingResp) ProtoReflect() protoreflect.Message {
+func (x *ListWalletsReq) ProtoReflect() protoreflect.Message {
 	mi := &file_chat_chat_proto_msgTypes[47]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3088,35 +3227,28 @@ func (x *GetTokenForVideoMeetingResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTokenForVideoMeetingResp.ProtoReflect.Descriptor instead.
-func (*GetTokenForVideoMeetingResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListWalletsReq.ProtoReflect.Descriptor instead.
+func (*ListWalletsReq) Descriptor() ([]byte, []int) {
 	return file_chat_chat_proto_rawDescGZIP(), []int{47}
 }
 
-func (x *GetTokenForVideoMeetingResp) GetServerUrl() string {
-	if x != nil {
-		return x.ServerUrl
-	}
-	return ""
-}
-
-func (x *GetTokenForVideoMeetingResp) GetToken() string {
+func (x *ListWalletsReq) GetUserID() string {
 	if x != nil {
-		return x.Token
+		return x.UserID
 	}
 	return ""
 }
 
-type CheckUserExistReq struct {
+type ListWalletsResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	User *RegisterUserInfo `protobuf:"bytes,1,opt,name=user,proto3" json:"user"`
+	Wallets []*WalletInfo `protobuf:"bytes,1,rep,name=wallets,proto3" json:"wallets"`
 }
 
-func (x *CheckUserExistReq) Reset() {
-	*x = CheckUserExistReq{}
+func (x *ListWalletsResp) Reset() {
+	*x = ListWalletsResp{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_chat_chat_proto_msgTypes[48]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3124,13 +3256,13 @@ func (x *CheckUserExistReq) Reset() {
 	}
 }
 
-func (x *CheckUserExistReq) String() string {
+func (x *ListWalletsResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CheckUserExistReq) ProtoMessage() {}
+func (*ListWalletsResp) ProtoMessage() {}
 
-func (x *CheckUserExistReq) ProtoReflect() protoreflect.Message {
+func (x *ListWalletsResp) ProtoReflect() protoreflect.Message {
 	mi := &file_chat_chat_proto_msgTypes[48]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3142,29 +3274,29 @@ func (x *CheckUserExistReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CheckUserExistReq.ProtoReflect.Descriptor instead.
-func (*CheckUserExistReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListWalletsResp.ProtoReflect.Descriptor instead.
+func (*ListWalletsResp) Descriptor() ([]byte, []int) {
 	return file_chat_chat_proto_rawDescGZIP(), []int{48}
 }
 
-func (x *CheckUserExistReq) GetUser() *RegisterUserInfo {
+func (x *ListWalletsResp) GetWallets() []*WalletInfo {
 	if x != nil {
-		return x.User
+		return x.Wallets
 	}
 	return nil
 }
 
-type CheckUserExistResp struct {
+type RemoveWalletReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Userid       string `protobuf:"bytes,1,opt,name=userid,proto3" json:"userid"`
-	IsRegistered bool   `protobuf:"varint,2,opt,name=isRegistered,proto3" json:"isRegistered"`
+	UserID  string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address"`
 }
 
-func (x *CheckUserExistResp) Reset() {
-	*x = CheckUserExistResp{}
+func (x *RemoveWalletReq) Reset() {
+	*x = RemoveWalletReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_chat_chat_proto_msgTypes[49]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3172,13 +3304,13 @@ func (x *CheckUserExistResp) Reset() {
 	}
 }
 
-func (x *CheckUserExistResp) String() string {
+func (x *RemoveWalletReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CheckUserExistResp) ProtoMessage() {}
+func (*RemoveWalletReq) ProtoMessage() {}
 
-func (x *CheckUserExistResp) ProtoReflect() protoreflect.Message {
+func (x *RemoveWalletReq) ProtoReflect() protoreflect.Message {
 	mi := &file_chat_chat_proto_msgTypes[49]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3190,35 +3322,33 @@ func (x *CheckUserExistResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CheckUserExistResp.ProtoReflect.Descriptor instead.
-func (*CheckUserExistResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use RemoveWalletReq.ProtoReflect.Descriptor instead.
+func (*RemoveWalletReq) Descriptor() ([]byte, []int) {
 	return file_chat_chat_proto_rawDescGZIP(), []int{49}
 }
 
-func (x *CheckUserExistResp) GetUserid() string {
+func (x *RemoveWalletReq) GetUserID() string {
 	if x != nil {
-		return x.Userid
+		return x.UserID
 	}
 	return ""
 }
 
-func (x *CheckUserExistResp) GetIsRegistered() bool {
+func (x *RemoveWalletReq) GetAddress() string {
 	if x != nil {
-		return x.IsRegistered
+		return x.Address
 	}
-	return false
+	return ""
 }
 
-type DelUserAccountReq struct {
+type RemoveWalletResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	UserIDs []string `protobuf:"bytes,1,rep,name=userIDs,proto3" json:"userIDs"`
 }
 
-func (x *DelUserAccountReq) Reset() {
-	*x = DelUserAccountReq{}
+func (x *RemoveWalletResp) Reset() {
+	*x = RemoveWalletResp{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_chat_chat_proto_msgTypes[50]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3226,13 +3356,13 @@ func (x *DelUserAccountReq) Reset() {
 	}
 }
 
-func (x *DelUserAccountReq) String() string {
+func (x *RemoveWalletResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DelUserAccountReq) ProtoMessage() {}
+func (*RemoveWalletResp) ProtoMessage() {}
 
-func (x *DelUserAccountReq) ProtoReflect() protoreflect.Message {
+func (x *RemoveWalletResp) ProtoReflect() protoreflect.Message {
 	mi := &file_chat_chat_proto_msgTypes[50]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3244,40 +3374,38 @@ func (x *DelUserAccountReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DelUserAccountReq.ProtoReflect.Descriptor instead.
-func (*DelUserAccountReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use RemoveWalletResp.ProtoReflect.Descriptor instead.
+func (*RemoveWalletResp) Descriptor() ([]byte, []int) {
 	return file_chat_chat_proto_rawDescGZIP(), []int{50}
 }
 
-func (x *DelUserAccountReq) GetUserIDs() []string {
-	if x != nil {
-		return x.UserIDs
-	}
-	return nil
-}
-
-type DelUserAccountResp struct {
+type SearchUserInfoReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Keyword    string                    `protobuf:"bytes,1,opt,name=keyword,proto3" json:"keyword"`
+	Pagination *sdkwss.RequestPagination `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination"`
+	Genders    []int32                   `protobuf:"varint,3,rep,packed,name=genders,proto3" json:"genders"`
+	UserIDs    []string                  `protobuf:"bytes,4,rep,name=userIDs,proto3" json:"userIDs"`
 }
 
-func (x *DelUserAccountResp) Reset() {
-	*x = DelUserAccountResp{}
-	if protoimpl.UnsafeEnabled {
+func (x *SearchUserInfoReq) Reset() {
+	*x = SearchUserInfoReq{}
+	if protoimpl.UnsafeEnabled {
 		mi := &file_chat_chat_proto_msgTypes[51]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DelUserAccountResp) String() string {
+func (x *SearchUserInfoReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DelUserAccountResp) ProtoMessage() {}
+func (*SearchUserInfoReq) ProtoMessage() {}
 
-func (x *DelUserAccountResp) ProtoReflect() protoreflect.Message {
+func (x *SearchUserInfoReq) ProtoReflect() protoreflect.Message {
 	mi := &file_chat_chat_proto_msgTypes[51]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3289,19 +3417,50 @@ func (x *DelUserAccountResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DelUserAccountResp.ProtoReflect.Descriptor instead.
-func (*DelUserAccountResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use SearchUserInfoReq.ProtoReflect.Descriptor instead.
+func (*SearchUserInfoReq) Descriptor() ([]byte, []int) {
 	return file_chat_chat_proto_rawDescGZIP(), []int{51}
 }
 
-type GetGroupFromContactReq struct {
+func (x *SearchUserInfoReq) GetKeyword() string {
+	if x != nil {
+		return x.Keyword
+	}
+	return ""
+}
+
+func (x *SearchUserInfoReq) GetPagination() *sdkwss.RequestPagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+func (x *SearchUserInfoReq) GetGenders() []int32 {
+	if x != nil {
+		return x.Genders
+	}
+	return nil
+}
+
+func (x *SearchUserInfoReq) GetUserIDs() []string {
+	if x != nil {
+		return x.UserIDs
+	}
+	return nil
+}
+
+type SearchUserInfoResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Total uint32                 `protobuf:"varint,1,opt,name=total,proto3" json:"total"`
+	Users []*common.UserFullInfo `protobuf:"bytes,2,rep,name=users,proto3" json:"users"`
 }
 
-func (x *GetGroupFromContactReq) Reset() {
-	*x = GetGroupFromContactReq{}
+func (x *SearchUserInfoResp) Reset() {
+	*x = SearchUserInfoResp{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_chat_chat_proto_msgTypes[52]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3309,13 +3468,13 @@ func (x *GetGroupFromContactReq) Reset() {
 	}
 }
 
-func (x *GetGroupFromContactReq) String() string {
+func (x *SearchUserInfoResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetGroupFromContactReq) ProtoMessage() {}
+func (*SearchUserInfoResp) ProtoMessage() {}
 
-func (x *GetGroupFromContactReq) ProtoReflect() protoreflect.Message {
+func (x *SearchUserInfoResp) ProtoReflect() protoreflect.Message {
 	mi := &file_chat_chat_proto_msgTypes[52]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3327,21 +3486,36 @@ func (x *GetGroupFromContactReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetGroupFromContactReq.ProtoReflect.Descriptor instead.
-func (*GetGroupFromContactReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use SearchUserInfoResp.ProtoReflect.Descriptor instead.
+func (*SearchUserInfoResp) Descriptor() ([]byte, []int) {
 	return file_chat_chat_proto_rawDescGZIP(), []int{52}
 }
 
-type GetGroupFromContactResp struct {
+func (x *SearchUserInfoResp) GetTotal() uint32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *SearchUserInfoResp) GetUsers() []*common.UserFullInfo {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+type GetTokenForVideoMeetingReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	GroupIDs []string `protobuf:"bytes,1,rep,name=groupIDs,proto3" json:"groupIDs"`
+	Room     string `protobuf:"bytes,1,opt,name=room,proto3" json:"room"`
+	Identity string `protobuf:"bytes,2,opt,name=identity,proto3" json:"identity"`
 }
 
-func (x *GetGroupFromContactResp) Reset() {
-	*x = GetGroupFromContactResp{}
+func (x *GetTokenForVideoMeetingReq) Reset() {
+	*x = GetTokenForVideoMeetingReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_chat_chat_proto_msgTypes[53]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3349,13 +3523,13 @@ func (x *GetGroupFromContactResp) Reset() {
 	}
 }
 
-func (x *GetGroupFromContactResp) String() string {
+func (x *GetTokenForVideoMeetingReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetGroupFromContactResp) ProtoMessage() {}
+func (*GetTokenForVideoMeetingReq) ProtoMessage() {}
 
-func (x *GetGroupFromContactResp) ProtoReflect() protoreflect.Message {
+func (x *GetTokenForVideoMeetingReq) ProtoReflect() protoreflect.Message {
 	mi := &file_chat_chat_proto_msgTypes[53]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3367,28 +3541,36 @@ func (x *GetGroupFromContactResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetGroupFromContactResp.ProtoReflect.Descriptor instead.
-func (*GetGroupFromContactResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetTokenForVideoMeetingReq.ProtoReflect.Descriptor instead.
+func (*GetTokenForVideoMeetingReq) Descriptor() ([]byte, []int) {
 	return file_chat_chat_proto_rawDescGZIP(), []int{53}
 }
 
-func (x *GetGroupFromContactResp) GetGroupIDs() []string {
+func (x *GetTokenForVideoMeetingReq) GetRoom() string {
 	if x != nil {
-		return x.GroupIDs
+		return x.Room
 	}
-	return nil
+	return ""
 }
 
-type SaveGroupToContactReq struct {
+func (x *GetTokenForVideoMeetingReq) GetIdentity() string {
+	if x != nil {
+		return x.Identity
+	}
+	return ""
+}
+
+type GetTokenForVideoMeetingResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	GroupIDs []string `protobuf:"bytes,1,rep,name=groupIDs,proto3" json:"groupIDs"`
+	ServerUrl string `protobuf:"bytes,1,opt,name=serverUrl,proto3" json:"serverUrl"`
+	Token     string `protobuf:"bytes,2,opt,name=token,proto3" json:"token"`
 }
 
-func (x *SaveGroupToContactReq) Reset() {
-	*x = SaveGroupToContactReq{}
+func (x *GetTokenForVideoMeetingResp) Reset() {
+	*x = GetTokenForVideoMeetingResp{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_chat_chat_proto_msgTypes[54]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3396,13 +3578,13 @@ func (x *SaveGroupToContactReq) Reset() {
 	}
 }
 
-func (x *SaveGroupToContactReq) String() string {
+func (x *GetTokenForVideoMeetingResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SaveGroupToContactReq) ProtoMessage() {}
+func (*GetTokenForVideoMeetingResp) ProtoMessage() {}
 
-func (x *SaveGroupToContactReq) ProtoReflect() protoreflect.Message {
+func (x *GetTokenForVideoMeetingResp) ProtoReflect() protoreflect.Message {
 	mi := &file_chat_chat_proto_msgTypes[54]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3414,26 +3596,35 @@ func (x *SaveGroupToContactReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SaveGroupToContactReq.ProtoReflect.Descriptor instead.
-func (*SaveGroupToContactReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetTokenForVideoMeetingResp.ProtoReflect.Descriptor instead.
+func (*GetTokenForVideoMeetingResp) Descriptor() ([]byte, []int) {
 	return file_chat_chat_proto_rawDescGZIP(), []int{54}
 }
 
-func (x *SaveGroupToContactReq) GetGroupIDs() []string {
+func (x *GetTokenForVideoMeetingResp) GetServerUrl() string {
 	if x != nil {
-		return x.GroupIDs
+		return x.ServerUrl
 	}
-	return nil
+	return ""
 }
 
-type SaveGroupToContactResp struct {
+func (x *GetTokenForVideoMeetingResp) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type CheckUserExistReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	User *RegisterUserInfo `protobuf:"bytes,1,opt,name=user,proto3" json:"user"`
 }
 
-func (x *SaveGroupToContactResp) Reset() {
-	*x = SaveGroupToContactResp{}
+func (x *CheckUserExistReq) Reset() {
+	*x = CheckUserExistReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_chat_chat_proto_msgTypes[55]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3441,13 +3632,13 @@ func (x *SaveGroupToContactResp) Reset() {
 	}
 }
 
-func (x *SaveGroupToContactResp) String() string {
+func (x *CheckUserExistReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SaveGroupToContactResp) ProtoMessage() {}
+func (*CheckUserExistReq) ProtoMessage() {}
 
-func (x *SaveGroupToContactResp) ProtoReflect() protoreflect.Message {
+func (x *CheckUserExistReq) ProtoReflect() protoreflect.Message {
 	mi := &file_chat_chat_proto_msgTypes[55]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3459,21 +3650,29 @@ func (x *SaveGroupToContactResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SaveGroupToContactResp.ProtoReflect.Descriptor instead.
-func (*SaveGroupToContactResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use CheckUserExistReq.ProtoReflect.Descriptor instead.
+func (*CheckUserExistReq) Descriptor() ([]byte, []int) {
 	return file_chat_chat_proto_rawDescGZIP(), []int{55}
 }
 
-type DeleteGroupFromContactReq struct {
+func (x *CheckUserExistReq) GetUser() *RegisterUserInfo {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+type CheckUserExistResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	GroupIDs []string `protobuf:"bytes,1,rep,name=groupIDs,proto3" json:"groupIDs"`
+	Userid       string `protobuf:"bytes,1,opt,name=userid,proto3" json:"userid"`
+	IsRegistered bool   `protobuf:"varint,2,opt,name=isRegistered,proto3" json:"isRegistered"`
 }
 
-func (x *DeleteGroupFromContactReq) Reset() {
-	*x = DeleteGroupFromContactReq{}
+func (x *CheckUserExistResp) Reset() {
+	*x = CheckUserExistResp{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_chat_chat_proto_msgTypes[56]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3481,13 +3680,13 @@ func (x *DeleteGroupFromContactReq) Reset() {
 	}
 }
 
-func (x *DeleteGroupFromContactReq) String() string {
+func (x *CheckUserExistResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteGroupFromContactReq) ProtoMessage() {}
+func (*CheckUserExistResp) ProtoMessage() {}
 
-func (x *DeleteGroupFromContactReq) ProtoReflect() protoreflect.Message {
+func (x *CheckUserExistResp) ProtoReflect() protoreflect.Message {
 	mi := &file_chat_chat_proto_msgTypes[56]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3499,26 +3698,35 @@ func (x *DeleteGroupFromContactReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteGroupFromContactReq.ProtoReflect.Descriptor instead.
-func (*DeleteGroupFromContactReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use CheckUserExistResp.ProtoReflect.Descriptor instead.
+func (*CheckUserExistResp) Descriptor() ([]byte, []int) {
 	return file_chat_chat_proto_rawDescGZIP(), []int{56}
 }
 
-func (x *DeleteGroupFromContactReq) GetGroupIDs() []string {
+func (x *CheckUserExistResp) GetUserid() string {
 	if x != nil {
-		return x.GroupIDs
+		return x.Userid
 	}
-	return nil
+	return ""
 }
 
-type DeleteGroupFromContactResp struct {
+func (x *CheckUserExistResp) GetIsRegistered() bool {
+	if x != nil {
+		return x.IsRegistered
+	}
+	return false
+}
+
+type DelUserAccountReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	UserIDs []string `protobuf:"bytes,1,rep,name=userIDs,proto3" json:"userIDs"`
 }
 
-func (x *DeleteGroupFromContactResp) Reset() {
-	*x = DeleteGroupFromContactResp{}
+func (x *DelUserAccountReq) Reset() {
+	*x = DelUserAccountReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_chat_chat_proto_msgTypes[57]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3526,13 +3734,13 @@ func (x *DeleteGroupFromContactResp) Reset() {
 	}
 }
 
-func (x *DeleteGroupFromContactResp) String() string {
+func (x *DelUserAccountReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteGroupFromContactResp) ProtoMessage() {}
+func (*DelUserAccountReq) ProtoMessage() {}
 
-func (x *DeleteGroupFromContactResp) ProtoReflect() protoreflect.Message {
+func (x *DelUserAccountReq) ProtoReflect() protoreflect.Message {
 	mi := &file_chat_chat_proto_msgTypes[57]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3544,19 +3752,26 @@ func (x *DeleteGroupFromContactResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteGroupFromContactResp.ProtoReflect.Descriptor instead.
-func (*DeleteGroupFromContactResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use DelUserAccountReq.ProtoReflect.Descriptor instead.
+func (*DelUserAccountReq) Descriptor() ([]byte, []int) {
 	return file_chat_chat_proto_rawDescGZIP(), []int{57}
 }
 
-type DeleteGroupApplicationFromRecipientReq struct {
+func (x *DelUserAccountReq) GetUserIDs() []string {
+	if x != nil {
+		return x.UserIDs
+	}
+	return nil
+}
+
+type DelUserAccountResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *DeleteGroupApplicationFromRecipientReq) Reset() {
-	*x = DeleteGroupApplicationFromRecipientReq{}
+func (x *DelUserAccountResp) Reset() {
+	*x = DelUserAccountResp{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_chat_chat_proto_msgTypes[58]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3564,13 +3779,13 @@ func (x *DeleteGroupApplicationFromRecipientReq) Reset() {
 	}
 }
 
-func (x *DeleteGroupApplicationFromRecipientReq) String() string {
+func (x *DelUserAccountResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteGroupApplicationFromRecipientReq) ProtoMessage() {}
+func (*DelUserAccountResp) ProtoMessage() {}
 
-func (x *DeleteGroupApplicationFromRecipientReq) ProtoReflect() protoreflect.Message {
+func (x *DelUserAccountResp) ProtoReflect() protoreflect.Message {
 	mi := &file_chat_chat_proto_msgTypes[58]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3582,19 +3797,23 @@ func (x *DeleteGroupApplicationFromRecipientReq) ProtoReflect() protoreflect.Mes
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteGroupApplicationFromRecipientReq.ProtoReflect.Descriptor instead.
-func (*DeleteGroupApplicationFromRecipientReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use DelUserAccountResp.ProtoReflect.Descriptor instead.
+func (*DelUserAccountResp) Descriptor() ([]byte, []int) {
 	return file_chat_chat_proto_rawDescGZIP(), []int{58}
 }
 
-type DeleteGroupApplicationFromRecipientResp struct {
+type ErasedRecord struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Collection string `protobuf:"bytes,1,opt,name=collection,proto3" json:"collection"`
+	Deleted    int64  `protobuf:"varint,2,opt,name=deleted,proto3" json:"deleted"`
+	Anonymized int64  `protobuf:"varint,3,opt,name=anonymized,proto3" json:"anonymized"`
 }
 
-func (x *DeleteGroupApplicationFromRecipientResp) Reset() {
-	*x = DeleteGroupApplicationFromRecipientResp{}
+func (x *ErasedRecord) Reset() {
+	*x = ErasedRecord{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_chat_chat_proto_msgTypes[59]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3602,13 +3821,13 @@ func (x *DeleteGroupApplicationFromRecipientResp) Reset() {
 	}
 }
 
-func (x *DeleteGroupApplicationFromRecipientResp) String() string {
+func (x *ErasedRecord) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteGroupApplicationFromRecipientResp) ProtoMessage() {}
+func (*ErasedRecord) ProtoMessage() {}
 
-func (x *DeleteGroupApplicationFromRecipientResp) ProtoReflect() protoreflect.Message {
+func (x *ErasedRecord) ProtoReflect() protoreflect.Message {
 	mi := &file_chat_chat_proto_msgTypes[59]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3620,33 +3839,65 @@ func (x *DeleteGroupApplicationFromRecipientResp) ProtoReflect() protoreflect.Me
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteGroupApplicationFromRecipientResp.ProtoReflect.Descriptor instead.
-func (*DeleteGroupApplicationFromRecipientResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use ErasedRecord.ProtoReflect.Descriptor instead.
+func (*ErasedRecord) Descriptor() ([]byte, []int) {
 	return file_chat_chat_proto_rawDescGZIP(), []int{59}
 }
 
-type DeleteGroupApplicationFromApplicantReq struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *ErasedRecord) GetCollection() string {
+	if x != nil {
+		return x.Collection
+	}
+	return ""
 }
 
-func (x *DeleteGroupApplicationFromApplicantReq) Reset() {
-	*x = DeleteGroupApplicationFromApplicantReq{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_chat_chat_proto_msgTypes[60]
+func (x *ErasedRecord) GetDeleted() int64 {
+	if x != nil {
+		return x.Deleted
+	}
+	return 0
+}
+
+func (x *ErasedRecord) GetAnonymized() int64 {
+	if x != nil {
+		return x.Anonymized
+	}
+	return 0
+}
+
+// Account deletion request, after it was executed it is kept as the deletion receipt
+type AccountDeletion struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
+	Reason string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason"`
+	// empty when the user requested it
+	OperatorUserID string          `protobuf:"bytes,3,opt,name=operatorUserID,proto3" json:"operatorUserID"`
+	Status         int32           `protobuf:"varint,4,opt,name=status,proto3" json:"status"`
+	RequestTime    int64           `protobuf:"varint,5,opt,name=requestTime,proto3" json:"requestTime"`
+	ExecuteAt      int64           `protobuf:"varint,6,opt,name=executeAt,proto3" json:"executeAt"`
+	FinishTime     int64           `protobuf:"varint,7,opt,name=finishTime,proto3" json:"finishTime"`
+	Records        []*ErasedRecord `protobuf:"bytes,8,rep,name=records,proto3" json:"records"`
+}
+
+func (x *AccountDeletion) Reset() {
+	*x = AccountDeletion{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[60]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DeleteGroupApplicationFromApplicantReq) String() string {
+func (x *AccountDeletion) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteGroupApplicationFromApplicantReq) ProtoMessage() {}
+func (*AccountDeletion) ProtoMessage() {}
 
-func (x *DeleteGroupApplicationFromApplicantReq) ProtoReflect() protoreflect.Message {
+func (x *AccountDeletion) ProtoReflect() protoreflect.Message {
 	mi := &file_chat_chat_proto_msgTypes[60]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3658,19 +3909,81 @@ func (x *DeleteGroupApplicationFromApplicantReq) ProtoReflect() protoreflect.Mes
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteGroupApplicationFromApplicantReq.ProtoReflect.Descriptor instead.
-func (*DeleteGroupApplicationFromApplicantReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use AccountDeletion.ProtoReflect.Descriptor instead.
+func (*AccountDeletion) Descriptor() ([]byte, []int) {
 	return file_chat_chat_proto_rawDescGZIP(), []int{60}
 }
 
-type DeleteGroupApplicationFromApplicantResp struct {
+func (x *AccountDeletion) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *AccountDeletion) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *AccountDeletion) GetOperatorUserID() string {
+	if x != nil {
+		return x.OperatorUserID
+	}
+	return ""
+}
+
+func (x *AccountDeletion) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *AccountDeletion) GetRequestTime() int64 {
+	if x != nil {
+		return x.RequestTime
+	}
+	return 0
+}
+
+func (x *AccountDeletion) GetExecuteAt() int64 {
+	if x != nil {
+		return x.ExecuteAt
+	}
+	return 0
+}
+
+func (x *AccountDeletion) GetFinishTime() int64 {
+	if x != nil {
+		return x.FinishTime
+	}
+	return 0
+}
+
+func (x *AccountDeletion) GetRecords() []*ErasedRecord {
+	if x != nil {
+		return x.Records
+	}
+	return nil
+}
+
+type RequestAccountDeletionReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// admin only, users delete their own account
+	UserID string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
+	Reason string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason"`
+	// admin only, skip the grace period
+	Immediate bool `protobuf:"varint,3,opt,name=immediate,proto3" json:"immediate"`
 }
 
-func (x *DeleteGroupApplicationFromApplicantResp) Reset() {
-	*x = DeleteGroupApplicationFromApplicantResp{}
+func (x *RequestAccountDeletionReq) Reset() {
+	*x = RequestAccountDeletionReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_chat_chat_proto_msgTypes[61]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3678,13 +3991,13 @@ func (x *DeleteGroupApplicationFromApplicantResp) Reset() {
 	}
 }
 
-func (x *DeleteGroupApplicationFromApplicantResp) String() string {
+func (x *RequestAccountDeletionReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteGroupApplicationFromApplicantResp) ProtoMessage() {}
+func (*RequestAccountDeletionReq) ProtoMessage() {}
 
-func (x *DeleteGroupApplicationFromApplicantResp) ProtoReflect() protoreflect.Message {
+func (x *RequestAccountDeletionReq) ProtoReflect() protoreflect.Message {
 	mi := &file_chat_chat_proto_msgTypes[61]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3696,19 +4009,42 @@ func (x *DeleteGroupApplicationFromApplicantResp) ProtoReflect() protoreflect.Me
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteGroupApplicationFromApplicantResp.ProtoReflect.Descriptor instead.
-func (*DeleteGroupApplicationFromApplicantResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use RequestAccountDeletionReq.ProtoReflect.Descriptor instead.
+func (*RequestAccountDeletionReq) Descriptor() ([]byte, []int) {
 	return file_chat_chat_proto_rawDescGZIP(), []int{61}
 }
 
-type DeleteGroupApplicationFromAlltReq struct {
+func (x *RequestAccountDeletionReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *RequestAccountDeletionReq) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *RequestAccountDeletionReq) GetImmediate() bool {
+	if x != nil {
+		return x.Immediate
+	}
+	return false
+}
+
+type RequestAccountDeletionResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Deletion *AccountDeletion `protobuf:"bytes,1,opt,name=deletion,proto3" json:"deletion"`
 }
 
-func (x *DeleteGroupApplicationFromAlltReq) Reset() {
-	*x = DeleteGroupApplicationFromAlltReq{}
+func (x *RequestAccountDeletionResp) Reset() {
+	*x = RequestAccountDeletionResp{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_chat_chat_proto_msgTypes[62]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3716,13 +4052,13 @@ func (x *DeleteGroupApplicationFromAlltReq) Reset() {
 	}
 }
 
-func (x *DeleteGroupApplicationFromAlltReq) String() string {
+func (x *RequestAccountDeletionResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteGroupApplicationFromAlltReq) ProtoMessage() {}
+func (*RequestAccountDeletionResp) ProtoMessage() {}
 
-func (x *DeleteGroupApplicationFromAlltReq) ProtoReflect() protoreflect.Message {
+func (x *RequestAccountDeletionResp) ProtoReflect() protoreflect.Message {
 	mi := &file_chat_chat_proto_msgTypes[62]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3734,19 +4070,28 @@ func (x *DeleteGroupApplicationFromAlltReq) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteGroupApplicationFromAlltReq.ProtoReflect.Descriptor instead.
-func (*DeleteGroupApplicationFromAlltReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use RequestAccountDeletionResp.ProtoReflect.Descriptor instead.
+func (*RequestAccountDeletionResp) Descriptor() ([]byte, []int) {
 	return file_chat_chat_proto_rawDescGZIP(), []int{62}
 }
 
-type DeleteGroupApplicationFromAllResp struct {
+func (x *RequestAccountDeletionResp) GetDeletion() *AccountDeletion {
+	if x != nil {
+		return x.Deletion
+	}
+	return nil
+}
+
+type CancelAccountDeletionReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	UserID string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
 }
 
-func (x *DeleteGroupApplicationFromAllResp) Reset() {
-	*x = DeleteGroupApplicationFromAllResp{}
+func (x *CancelAccountDeletionReq) Reset() {
+	*x = CancelAccountDeletionReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_chat_chat_proto_msgTypes[63]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3754,13 +4099,13 @@ func (x *DeleteGroupApplicationFromAllResp) Reset() {
 	}
 }
 
-func (x *DeleteGroupApplicationFromAllResp) String() string {
+func (x *CancelAccountDeletionReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteGroupApplicationFromAllResp) ProtoMessage() {}
+func (*CancelAccountDeletionReq) ProtoMessage() {}
 
-func (x *DeleteGroupApplicationFromAllResp) ProtoReflect() protoreflect.Message {
+func (x *CancelAccountDeletionReq) ProtoReflect() protoreflect.Message {
 	mi := &file_chat_chat_proto_msgTypes[63]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3772,45 +4117,26 @@ func (x *DeleteGroupApplicationFromAllResp) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteGroupApplicationFromAllResp.ProtoReflect.Descriptor instead.
-func (*DeleteGroupApplicationFromAllResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use CancelAccountDeletionReq.ProtoReflect.Descriptor instead.
+func (*CancelAccountDeletionReq) Descriptor() ([]byte, []int) {
 	return file_chat_chat_proto_rawDescGZIP(), []int{63}
 }
 
-type Post struct {
+func (x *CancelAccountDeletionReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+type CancelAccountDeletionResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	PostID         string                   `protobuf:"bytes,1,opt,name=postID,proto3" json:"postID"`
-	UserID         string                   `protobuf:"bytes,2,opt,name=userID,proto3" json:"userID"`
-	Content        string                   `protobuf:"bytes,3,opt,name=content,proto3" json:"content"`
-	CommentCount   int64                    `protobuf:"varint,4,opt,name=commentCount,proto3" json:"commentCount"`
-	ForwardCount   int64                    `protobuf:"varint,5,opt,name=forwardCount,proto3" json:"forwardCount"`
-	LikeCount      int64                    `protobuf:"varint,6,opt,name=likeCount,proto3" json:"likeCount"`
-	AllowComment   int32                    `protobuf:"varint,7,opt,name=allowComment,proto3" json:"allowComment"`
-	AllowForward   int32                    `protobuf:"varint,8,opt,name=allowForward,proto3" json:"allowForward"`
-	IsLiked        int32                    `protobuf:"varint,9,opt,name=isLiked,proto3" json:"isLiked"`
-	IsCollected    int32                    `protobuf:"varint,10,opt,name=isCollected,proto3" json:"isCollected"`
-	IsForwarded    int32                    `protobuf:"varint,11,opt,name=isForwarded,proto3" json:"isForwarded"`
-	CreateTime     int64                    `protobuf:"varint,12,opt,name=createTime,proto3" json:"createTime"`
-	UpdateTime     int64                    `protobuf:"varint,13,opt,name=updateTime,proto3" json:"updateTime"`
-	AtUserIds      []string                 `protobuf:"bytes,14,rep,name=atUserIds,proto3" json:"atUserIds"`
-	UserInfo       *common.UserPublicInfo   `protobuf:"bytes,15,opt,name=userInfo,proto3" json:"userInfo"`
-	MediaMsgs      []*common.PostMedia      `protobuf:"bytes,16,rep,name=mediaMsgs,proto3" json:"mediaMsgs"`
-	AtUserInfoList []*common.UserPublicInfo `protobuf:"bytes,17,rep,name=atUserInfoList,proto3" json:"atUserInfoList"`
-	ForwardPostID  string                   `protobuf:"bytes,18,opt,name=forwardPostID,proto3" json:"forwardPostID"`
-	CommentPostID  string                   `protobuf:"bytes,19,opt,name=commentPostID,proto3" json:"commentPostID"`
-	RefPostID      string                   `protobuf:"bytes,20,opt,name=refPostID,proto3" json:"refPostID"`
-	ForwardPost    *Post                    `protobuf:"bytes,21,opt,name=forwardPost,proto3" json:"forwardPost"`
-	CommentPost    *Post                    `protobuf:"bytes,22,opt,name=commentPost,proto3" json:"commentPost"`
-	RefPost        *Post                    `protobuf:"bytes,23,opt,name=refPost,proto3" json:"refPost"`
-	IsPinned       int32                    `protobuf:"varint,24,opt,name=isPinned,proto3" json:"isPinned"`
-	IsCommented    int32                    `protobuf:"varint,25,opt,name=isCommented,proto3" json:"isCommented"`
 }
 
-func (x *Post) Reset() {
-	*x = Post{}
+func (x *CancelAccountDeletionResp) Reset() {
+	*x = CancelAccountDeletionResp{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_chat_chat_proto_msgTypes[64]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3818,13 +4144,13 @@ func (x *Post) Reset() {
 	}
 }
 
-func (x *Post) String() string {
+func (x *CancelAccountDeletionResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Post) ProtoMessage() {}
+func (*CancelAccountDeletionResp) ProtoMessage() {}
 
-func (x *Post) ProtoReflect() protoreflect.Message {
+func (x *CancelAccountDeletionResp) ProtoReflect() protoreflect.Message {
 	mi := &file_chat_chat_proto_msgTypes[64]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3836,215 +4162,224 @@ func (x *Post) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Post.ProtoReflect.Descriptor instead.
-func (*Post) Descriptor() ([]byte, []int) {
+// Deprecated: Use CancelAccountDeletionResp.ProtoReflect.Descriptor instead.
+func (*CancelAccountDeletionResp) Descriptor() ([]byte, []int) {
 	return file_chat_chat_proto_rawDescGZIP(), []int{64}
 }
 
-func (x *Post) GetPostID() string {
-	if x != nil {
-		return x.PostID
-	}
-	return ""
-}
+type GetAccountDeletionReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func (x *Post) GetUserID() string {
-	if x != nil {
-		return x.UserID
-	}
-	return ""
+	UserID string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
 }
 
-func (x *Post) GetContent() string {
-	if x != nil {
-		return x.Content
+func (x *GetAccountDeletionReq) Reset() {
+	*x = GetAccountDeletionReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *Post) GetCommentCount() int64 {
-	if x != nil {
-		return x.CommentCount
-	}
-	return 0
+func (x *GetAccountDeletionReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Post) GetForwardCount() int64 {
-	if x != nil {
-		return x.ForwardCount
-	}
-	return 0
-}
+func (*GetAccountDeletionReq) ProtoMessage() {}
 
-func (x *Post) GetLikeCount() int64 {
-	if x != nil {
-		return x.LikeCount
+func (x *GetAccountDeletionReq) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *Post) GetAllowComment() int32 {
-	if x != nil {
-		return x.AllowComment
-	}
-	return 0
+// Deprecated: Use GetAccountDeletionReq.ProtoReflect.Descriptor instead.
+func (*GetAccountDeletionReq) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{65}
 }
 
-func (x *Post) GetAllowForward() int32 {
+func (x *GetAccountDeletionReq) GetUserID() string {
 	if x != nil {
-		return x.AllowForward
+		return x.UserID
 	}
-	return 0
+	return ""
 }
 
-func (x *Post) GetIsLiked() int32 {
-	if x != nil {
-		return x.IsLiked
-	}
-	return 0
+type GetAccountDeletionResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Deletion *AccountDeletion `protobuf:"bytes,1,opt,name=deletion,proto3" json:"deletion"`
 }
 
-func (x *Post) GetIsCollected() int32 {
-	if x != nil {
-		return x.IsCollected
+func (x *GetAccountDeletionResp) Reset() {
+	*x = GetAccountDeletionResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *Post) GetIsForwarded() int32 {
-	if x != nil {
-		return x.IsForwarded
-	}
-	return 0
+func (x *GetAccountDeletionResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Post) GetCreateTime() int64 {
-	if x != nil {
-		return x.CreateTime
+func (*GetAccountDeletionResp) ProtoMessage() {}
+
+func (x *GetAccountDeletionResp) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *Post) GetUpdateTime() int64 {
-	if x != nil {
-		return x.UpdateTime
-	}
-	return 0
+// Deprecated: Use GetAccountDeletionResp.ProtoReflect.Descriptor instead.
+func (*GetAccountDeletionResp) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{66}
 }
 
-func (x *Post) GetAtUserIds() []string {
+func (x *GetAccountDeletionResp) GetDeletion() *AccountDeletion {
 	if x != nil {
-		return x.AtUserIds
+		return x.Deletion
 	}
 	return nil
 }
 
-func (x *Post) GetUserInfo() *common.UserPublicInfo {
-	if x != nil {
-		return x.UserInfo
-	}
-	return nil
+type DataExport struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ExportID   string `protobuf:"bytes,1,opt,name=exportID,proto3" json:"exportID"`
+	Status     int32  `protobuf:"varint,2,opt,name=status,proto3" json:"status"`
+	CreateTime int64  `protobuf:"varint,3,opt,name=createTime,proto3" json:"createTime"`
+	FinishTime int64  `protobuf:"varint,4,opt,name=finishTime,proto3" json:"finishTime"`
+	ExpireAt   int64  `protobuf:"varint,5,opt,name=expireAt,proto3" json:"expireAt"`
+	Size       int64  `protobuf:"varint,6,opt,name=size,proto3" json:"size"`
+	// signed single use link, set while the archive can be downloaded
+	DownloadURL string `protobuf:"bytes,7,opt,name=downloadURL,proto3" json:"downloadURL"`
 }
 
-func (x *Post) GetMediaMsgs() []*common.PostMedia {
-	if x != nil {
-		return x.MediaMsgs
+func (x *DataExport) Reset() {
+	*x = DataExport{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *Post) GetAtUserInfoList() []*common.UserPublicInfo {
-	if x != nil {
-		return x.AtUserInfoList
-	}
-	return nil
+func (x *DataExport) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Post) GetForwardPostID() string {
-	if x != nil {
-		return x.ForwardPostID
+func (*DataExport) ProtoMessage() {}
+
+func (x *DataExport) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *Post) GetCommentPostID() string {
+// Deprecated: Use DataExport.ProtoReflect.Descriptor instead.
+func (*DataExport) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *DataExport) GetExportID() string {
 	if x != nil {
-		return x.CommentPostID
+		return x.ExportID
 	}
 	return ""
 }
 
-func (x *Post) GetRefPostID() string {
+func (x *DataExport) GetStatus() int32 {
 	if x != nil {
-		return x.RefPostID
+		return x.Status
 	}
-	return ""
+	return 0
 }
 
-func (x *Post) GetForwardPost() *Post {
+func (x *DataExport) GetCreateTime() int64 {
 	if x != nil {
-		return x.ForwardPost
+		return x.CreateTime
 	}
-	return nil
+	return 0
 }
 
-func (x *Post) GetCommentPost() *Post {
+func (x *DataExport) GetFinishTime() int64 {
 	if x != nil {
-		return x.CommentPost
+		return x.FinishTime
 	}
-	return nil
+	return 0
 }
 
-func (x *Post) GetRefPost() *Post {
+func (x *DataExport) GetExpireAt() int64 {
 	if x != nil {
-		return x.RefPost
+		return x.ExpireAt
 	}
-	return nil
+	return 0
 }
 
-func (x *Post) GetIsPinned() int32 {
+func (x *DataExport) GetSize() int64 {
 	if x != nil {
-		return x.IsPinned
+		return x.Size
 	}
 	return 0
 }
 
-func (x *Post) GetIsCommented() int32 {
+func (x *DataExport) GetDownloadURL() string {
 	if x != nil {
-		return x.IsCommented
+		return x.DownloadURL
 	}
-	return 0
+	return ""
 }
 
-type PublishPostReq struct {
+type RequestDataExportReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Content      *wrapperspb.StringValue `protobuf:"bytes,1,opt,name=content,proto3" json:"content"`
-	AllowComment int32                   `protobuf:"varint,2,opt,name=allowComment,proto3" json:"allowComment"`
-	AllowForward int32                   `protobuf:"varint,3,opt,name=allowForward,proto3" json:"allowForward"`
-	AtUserIds    []string                `protobuf:"bytes,4,rep,name=atUserIds,proto3" json:"atUserIds"`
-	MediaMsgs    []*common.PostMedia     `protobuf:"bytes,5,rep,name=mediaMsgs,proto3" json:"mediaMsgs"`
 }
 
-func (x *PublishPostReq) Reset() {
-	*x = PublishPostReq{}
+func (x *RequestDataExportReq) Reset() {
+	*x = RequestDataExportReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chat_chat_proto_msgTypes[65]
+		mi := &file_chat_chat_proto_msgTypes[68]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *PublishPostReq) String() string {
+func (x *RequestDataExportReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PublishPostReq) ProtoMessage() {}
+func (*RequestDataExportReq) ProtoMessage() {}
 
-func (x *PublishPostReq) ProtoReflect() protoreflect.Message {
-	mi := &file_chat_chat_proto_msgTypes[65]
+func (x *RequestDataExportReq) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[68]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4055,71 +4390,36 @@ func (x *PublishPostReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PublishPostReq.ProtoReflect.Descriptor instead.
-func (*PublishPostReq) Descriptor() ([]byte, []int) {
-	return file_chat_chat_proto_rawDescGZIP(), []int{65}
-}
-
-func (x *PublishPostReq) GetContent() *wrapperspb.StringValue {
-	if x != nil {
-		return x.Content
-	}
-	return nil
-}
-
-func (x *PublishPostReq) GetAllowComment() int32 {
-	if x != nil {
-		return x.AllowComment
-	}
-	return 0
-}
-
-func (x *PublishPostReq) GetAllowForward() int32 {
-	if x != nil {
-		return x.AllowForward
-	}
-	return 0
-}
-
-func (x *PublishPostReq) GetAtUserIds() []string {
-	if x != nil {
-		return x.AtUserIds
-	}
-	return nil
-}
-
-func (x *PublishPostReq) GetMediaMsgs() []*common.PostMedia {
-	if x != nil {
-		return x.MediaMsgs
-	}
-	return nil
+// Deprecated: Use RequestDataExportReq.ProtoReflect.Descriptor instead.
+func (*RequestDataExportReq) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{68}
 }
 
-type PublishPostResp struct {
+type RequestDataExportResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Post *Post `protobuf:"bytes,1,opt,name=post,proto3" json:"post"`
+	Export *DataExport `protobuf:"bytes,1,opt,name=export,proto3" json:"export"`
 }
 
-func (x *PublishPostResp) Reset() {
-	*x = PublishPostResp{}
+func (x *RequestDataExportResp) Reset() {
+	*x = RequestDataExportResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chat_chat_proto_msgTypes[66]
+		mi := &file_chat_chat_proto_msgTypes[69]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *PublishPostResp) String() string {
+func (x *RequestDataExportResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PublishPostResp) ProtoMessage() {}
+func (*RequestDataExportResp) ProtoMessage() {}
 
-func (x *PublishPostResp) ProtoReflect() protoreflect.Message {
-	mi := &file_chat_chat_proto_msgTypes[66]
+func (x *RequestDataExportResp) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[69]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4130,43 +4430,41 @@ func (x *PublishPostResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PublishPostResp.ProtoReflect.Descriptor instead.
-func (*PublishPostResp) Descriptor() ([]byte, []int) {
-	return file_chat_chat_proto_rawDescGZIP(), []int{66}
+// Deprecated: Use RequestDataExportResp.ProtoReflect.Descriptor instead.
+func (*RequestDataExportResp) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{69}
 }
 
-func (x *PublishPostResp) GetPost() *Post {
+func (x *RequestDataExportResp) GetExport() *DataExport {
 	if x != nil {
-		return x.Post
+		return x.Export
 	}
 	return nil
 }
 
-type GetPostByIDReq struct {
+type GetDataExportReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	PostID string `protobuf:"bytes,1,opt,name=postID,proto3" json:"postID"`
 }
 
-func (x *GetPostByIDReq) Reset() {
-	*x = GetPostByIDReq{}
+func (x *GetDataExportReq) Reset() {
+	*x = GetDataExportReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chat_chat_proto_msgTypes[67]
+		mi := &file_chat_chat_proto_msgTypes[70]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetPostByIDReq) String() string {
+func (x *GetDataExportReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPostByIDReq) ProtoMessage() {}
+func (*GetDataExportReq) ProtoMessage() {}
 
-func (x *GetPostByIDReq) ProtoReflect() protoreflect.Message {
-	mi := &file_chat_chat_proto_msgTypes[67]
+func (x *GetDataExportReq) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[70]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4177,43 +4475,36 @@ func (x *GetPostByIDReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPostByIDReq.ProtoReflect.Descriptor instead.
-func (*GetPostByIDReq) Descriptor() ([]byte, []int) {
-	return file_chat_chat_proto_rawDescGZIP(), []int{67}
-}
-
-func (x *GetPostByIDReq) GetPostID() string {
-	if x != nil {
-		return x.PostID
-	}
-	return ""
+// Deprecated: Use GetDataExportReq.ProtoReflect.Descriptor instead.
+func (*GetDataExportReq) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{70}
 }
 
-type GetPostByIDResp struct {
+type GetDataExportResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Post *Post `protobuf:"bytes,1,opt,name=post,proto3" json:"post"`
+	Export *DataExport `protobuf:"bytes,1,opt,name=export,proto3" json:"export"`
 }
 
-func (x *GetPostByIDResp) Reset() {
-	*x = GetPostByIDResp{}
+func (x *GetDataExportResp) Reset() {
+	*x = GetDataExportResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chat_chat_proto_msgTypes[68]
+		mi := &file_chat_chat_proto_msgTypes[71]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetPostByIDResp) String() string {
+func (x *GetDataExportResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPostByIDResp) ProtoMessage() {}
+func (*GetDataExportResp) ProtoMessage() {}
 
-func (x *GetPostByIDResp) ProtoReflect() protoreflect.Message {
-	mi := &file_chat_chat_proto_msgTypes[68]
+func (x *GetDataExportResp) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[71]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4224,43 +4515,43 @@ func (x *GetPostByIDResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPostByIDResp.ProtoReflect.Descriptor instead.
-func (*GetPostByIDResp) Descriptor() ([]byte, []int) {
-	return file_chat_chat_proto_rawDescGZIP(), []int{68}
+// Deprecated: Use GetDataExportResp.ProtoReflect.Descriptor instead.
+func (*GetDataExportResp) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{71}
 }
 
-func (x *GetPostByIDResp) GetPost() *Post {
+func (x *GetDataExportResp) GetExport() *DataExport {
 	if x != nil {
-		return x.Post
+		return x.Export
 	}
 	return nil
 }
 
-type GetAllTypePostReq struct {
+type OpenDataExportReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Count int32 `protobuf:"varint,1,opt,name=count,proto3" json:"count"`
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token"`
 }
 
-func (x *GetAllTypePostReq) Reset() {
-	*x = GetAllTypePostReq{}
+func (x *OpenDataExportReq) Reset() {
+	*x = OpenDataExportReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chat_chat_proto_msgTypes[69]
+		mi := &file_chat_chat_proto_msgTypes[72]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetAllTypePostReq) String() string {
+func (x *OpenDataExportReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAllTypePostReq) ProtoMessage() {}
+func (*OpenDataExportReq) ProtoMessage() {}
 
-func (x *GetAllTypePostReq) ProtoReflect() protoreflect.Message {
-	mi := &file_chat_chat_proto_msgTypes[69]
+func (x *OpenDataExportReq) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[72]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4271,43 +4562,46 @@ func (x *GetAllTypePostReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAllTypePostReq.ProtoReflect.Descriptor instead.
-func (*GetAllTypePostReq) Descriptor() ([]byte, []int) {
-	return file_chat_chat_proto_rawDescGZIP(), []int{69}
+// Deprecated: Use OpenDataExportReq.ProtoReflect.Descriptor instead.
+func (*OpenDataExportReq) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{72}
 }
 
-func (x *GetAllTypePostReq) GetCount() int32 {
+func (x *OpenDataExportReq) GetToken() string {
 	if x != nil {
-		return x.Count
+		return x.Token
 	}
-	return 0
+	return ""
 }
 
-type GetAllTypePostResp struct {
+type OpenDataExportResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	AllPosts []*TypePosts `protobuf:"bytes,1,rep,name=allPosts,proto3" json:"allPosts"`
+	Key      string `protobuf:"bytes,1,opt,name=key,proto3" json:"key"`
+	FileName string `protobuf:"bytes,2,opt,name=fileName,proto3" json:"fileName"`
+	Size     int64  `protobuf:"varint,3,opt,name=size,proto3" json:"size"`
+	Claim    string `protobuf:"bytes,4,opt,name=claim,proto3" json:"claim"`
 }
 
-func (x *GetAllTypePostResp) Reset() {
-	*x = GetAllTypePostResp{}
+func (x *OpenDataExportResp) Reset() {
+	*x = OpenDataExportResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chat_chat_proto_msgTypes[70]
+		mi := &file_chat_chat_proto_msgTypes[73]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetAllTypePostResp) String() string {
+func (x *OpenDataExportResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAllTypePostResp) ProtoMessage() {}
+func (*OpenDataExportResp) ProtoMessage() {}
 
-func (x *GetAllTypePostResp) ProtoReflect() protoreflect.Message {
-	mi := &file_chat_chat_proto_msgTypes[70]
+func (x *OpenDataExportResp) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[73]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4318,45 +4612,65 @@ func (x *GetAllTypePostResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAllTypePostResp.ProtoReflect.Descriptor instead.
-func (*GetAllTypePostResp) Descriptor() ([]byte, []int) {
-	return file_chat_chat_proto_rawDescGZIP(), []int{70}
+// Deprecated: Use OpenDataExportResp.ProtoReflect.Descriptor instead.
+func (*OpenDataExportResp) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{73}
 }
 
-func (x *GetAllTypePostResp) GetAllPosts() []*TypePosts {
+func (x *OpenDataExportResp) GetKey() string {
 	if x != nil {
-		return x.AllPosts
+		return x.Key
 	}
-	return nil
+	return ""
 }
 
-type TypePosts struct {
+func (x *OpenDataExportResp) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *OpenDataExportResp) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *OpenDataExportResp) GetClaim() string {
+	if x != nil {
+		return x.Claim
+	}
+	return ""
+}
+
+type ConsumeDataExportReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Type       int32   `protobuf:"varint,1,opt,name=type,proto3" json:"type"`
-	NextCursor int64   `protobuf:"varint,2,opt,name=nextCursor,proto3" json:"nextCursor"`
-	Posts      []*Post `protobuf:"bytes,3,rep,name=posts,proto3" json:"posts"`
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token"`
+	Claim string `protobuf:"bytes,2,opt,name=claim,proto3" json:"claim"`
 }
 
-func (x *TypePosts) Reset() {
-	*x = TypePosts{}
+func (x *ConsumeDataExportReq) Reset() {
+	*x = ConsumeDataExportReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chat_chat_proto_msgTypes[71]
+		mi := &file_chat_chat_proto_msgTypes[74]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TypePosts) String() string {
+func (x *ConsumeDataExportReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TypePosts) ProtoMessage() {}
+func (*ConsumeDataExportReq) ProtoMessage() {}
 
-func (x *TypePosts) ProtoReflect() protoreflect.Message {
-	mi := &file_chat_chat_proto_msgTypes[71]
+func (x *ConsumeDataExportReq) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[74]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4367,59 +4681,48 @@ func (x *TypePosts) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TypePosts.ProtoReflect.Descriptor instead.
-func (*TypePosts) Descriptor() ([]byte, []int) {
-	return file_chat_chat_proto_rawDescGZIP(), []int{71}
+// Deprecated: Use ConsumeDataExportReq.ProtoReflect.Descriptor instead.
+func (*ConsumeDataExportReq) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{74}
 }
 
-func (x *TypePosts) GetType() int32 {
+func (x *ConsumeDataExportReq) GetToken() string {
 	if x != nil {
-		return x.Type
+		return x.Token
 	}
-	return 0
+	return ""
 }
 
-func (x *TypePosts) GetNextCursor() int64 {
+func (x *ConsumeDataExportReq) GetClaim() string {
 	if x != nil {
-		return x.NextCursor
-	}
-	return 0
-}
-
-func (x *TypePosts) GetPosts() []*Post {
-	if x != nil {
-		return x.Posts
+		return x.Claim
 	}
-	return nil
+	return ""
 }
 
-type GetPostListReq struct {
+type ConsumeDataExportResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	NextCursor int64 `protobuf:"varint,1,opt,name=nextCursor,proto3" json:"nextCursor"`
-	Count      int32 `protobuf:"varint,2,opt,name=count,proto3" json:"count"`
-	Type       int32 `protobuf:"varint,3,opt,name=type,proto3" json:"type"`
 }
 
-func (x *GetPostListReq) Reset() {
-	*x = GetPostListReq{}
+func (x *ConsumeDataExportResp) Reset() {
+	*x = ConsumeDataExportResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chat_chat_proto_msgTypes[72]
+		mi := &file_chat_chat_proto_msgTypes[75]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetPostListReq) String() string {
+func (x *ConsumeDataExportResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPostListReq) ProtoMessage() {}
+func (*ConsumeDataExportResp) ProtoMessage() {}
 
-func (x *GetPostListReq) ProtoReflect() protoreflect.Message {
-	mi := &file_chat_chat_proto_msgTypes[72]
+func (x *ConsumeDataExportResp) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[75]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4430,58 +4733,37 @@ func (x *GetPostListReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPostListReq.ProtoReflect.Descriptor instead.
-func (*GetPostListReq) Descriptor() ([]byte, []int) {
-	return file_chat_chat_proto_rawDescGZIP(), []int{72}
-}
-
-func (x *GetPostListReq) GetNextCursor() int64 {
-	if x != nil {
-		return x.NextCursor
-	}
-	return 0
-}
-
-func (x *GetPostListReq) GetCount() int32 {
-	if x != nil {
-		return x.Count
-	}
-	return 0
-}
-
-func (x *GetPostListReq) GetType() int32 {
-	if x != nil {
-		return x.Type
-	}
-	return 0
+// Deprecated: Use ConsumeDataExportResp.ProtoReflect.Descriptor instead.
+func (*ConsumeDataExportResp) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{75}
 }
 
-type GetPostListResp struct {
+type ReleaseDataExportReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	NextCursor int64   `protobuf:"varint,1,opt,name=nextCursor,proto3" json:"nextCursor"`
-	Posts      []*Post `protobuf:"bytes,2,rep,name=posts,proto3" json:"posts"`
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token"`
+	Claim string `protobuf:"bytes,2,opt,name=claim,proto3" json:"claim"`
 }
 
-func (x *GetPostListResp) Reset() {
-	*x = GetPostListResp{}
+func (x *ReleaseDataExportReq) Reset() {
+	*x = ReleaseDataExportReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chat_chat_proto_msgTypes[73]
+		mi := &file_chat_chat_proto_msgTypes[76]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetPostListResp) String() string {
+func (x *ReleaseDataExportReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPostListResp) ProtoMessage() {}
+func (*ReleaseDataExportReq) ProtoMessage() {}
 
-func (x *GetPostListResp) ProtoReflect() protoreflect.Message {
-	mi := &file_chat_chat_proto_msgTypes[73]
+func (x *ReleaseDataExportReq) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[76]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4492,52 +4774,48 @@ func (x *GetPostListResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPostListResp.ProtoReflect.Descriptor instead.
-func (*GetPostListResp) Descriptor() ([]byte, []int) {
-	return file_chat_chat_proto_rawDescGZIP(), []int{73}
+// Deprecated: Use ReleaseDataExportReq.ProtoReflect.Descriptor instead.
+func (*ReleaseDataExportReq) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{76}
 }
 
-func (x *GetPostListResp) GetNextCursor() int64 {
+func (x *ReleaseDataExportReq) GetToken() string {
 	if x != nil {
-		return x.NextCursor
+		return x.Token
 	}
-	return 0
+	return ""
 }
 
-func (x *GetPostListResp) GetPosts() []*Post {
+func (x *ReleaseDataExportReq) GetClaim() string {
 	if x != nil {
-		return x.Posts
+		return x.Claim
 	}
-	return nil
+	return ""
 }
 
-type GetPostListByUserReq struct {
+type ReleaseDataExportResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	UserID     string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
-	NextCursor int64  `protobuf:"varint,2,opt,name=nextCursor,proto3" json:"nextCursor"`
-	Count      int32  `protobuf:"varint,3,opt,name=count,proto3" json:"count"`
 }
 
-func (x *GetPostListByUserReq) Reset() {
-	*x = GetPostListByUserReq{}
+func (x *ReleaseDataExportResp) Reset() {
+	*x = ReleaseDataExportResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chat_chat_proto_msgTypes[74]
+		mi := &file_chat_chat_proto_msgTypes[77]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetPostListByUserReq) String() string {
+func (x *ReleaseDataExportResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPostListByUserReq) ProtoMessage() {}
+func (*ReleaseDataExportResp) ProtoMessage() {}
 
-func (x *GetPostListByUserReq) ProtoReflect() protoreflect.Message {
-	mi := &file_chat_chat_proto_msgTypes[74]
+func (x *ReleaseDataExportResp) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[77]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4548,58 +4826,34 @@ func (x *GetPostListByUserReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPostListByUserReq.ProtoReflect.Descriptor instead.
-func (*GetPostListByUserReq) Descriptor() ([]byte, []int) {
-	return file_chat_chat_proto_rawDescGZIP(), []int{74}
-}
-
-func (x *GetPostListByUserReq) GetUserID() string {
-	if x != nil {
-		return x.UserID
-	}
-	return ""
-}
-
-func (x *GetPostListByUserReq) GetNextCursor() int64 {
-	if x != nil {
-		return x.NextCursor
-	}
-	return 0
-}
-
-func (x *GetPostListByUserReq) GetCount() int32 {
-	if x != nil {
-		return x.Count
-	}
-	return 0
+// Deprecated: Use ReleaseDataExportResp.ProtoReflect.Descriptor instead.
+func (*ReleaseDataExportResp) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{77}
 }
 
-type GetPostListByUserResp struct {
+type GetGroupFromContactReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	NextCursor int64   `protobuf:"varint,1,opt,name=nextCursor,proto3" json:"nextCursor"`
-	Posts      []*Post `protobuf:"bytes,2,rep,name=posts,proto3" json:"posts"`
 }
 
-func (x *GetPostListByUserResp) Reset() {
-	*x = GetPostListByUserResp{}
+func (x *GetGroupFromContactReq) Reset() {
+	*x = GetGroupFromContactReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chat_chat_proto_msgTypes[75]
+		mi := &file_chat_chat_proto_msgTypes[78]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetPostListByUserResp) String() string {
+func (x *GetGroupFromContactReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPostListByUserResp) ProtoMessage() {}
+func (*GetGroupFromContactReq) ProtoMessage() {}
 
-func (x *GetPostListByUserResp) ProtoReflect() protoreflect.Message {
-	mi := &file_chat_chat_proto_msgTypes[75]
+func (x *GetGroupFromContactReq) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[78]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4610,52 +4864,36 @@ func (x *GetPostListByUserResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPostListByUserResp.ProtoReflect.Descriptor instead.
-func (*GetPostListByUserResp) Descriptor() ([]byte, []int) {
-	return file_chat_chat_proto_rawDescGZIP(), []int{75}
-}
-
-func (x *GetPostListByUserResp) GetNextCursor() int64 {
-	if x != nil {
-		return x.NextCursor
-	}
-	return 0
-}
-
-func (x *GetPostListByUserResp) GetPosts() []*Post {
-	if x != nil {
-		return x.Posts
-	}
-	return nil
+// Deprecated: Use GetGroupFromContactReq.ProtoReflect.Descriptor instead.
+func (*GetGroupFromContactReq) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{78}
 }
 
-type GetCommentPostListByPostIDReq struct {
+type GetGroupFromContactResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PostID     string `protobuf:"bytes,1,opt,name=postID,proto3" json:"postID"`
-	NextCursor int64  `protobuf:"varint,2,opt,name=nextCursor,proto3" json:"nextCursor"`
-	Count      int32  `protobuf:"varint,3,opt,name=count,proto3" json:"count"`
+	GroupIDs []string `protobuf:"bytes,1,rep,name=groupIDs,proto3" json:"groupIDs"`
 }
 
-func (x *GetCommentPostListByPostIDReq) Reset() {
-	*x = GetCommentPostListByPostIDReq{}
+func (x *GetGroupFromContactResp) Reset() {
+	*x = GetGroupFromContactResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chat_chat_proto_msgTypes[76]
+		mi := &file_chat_chat_proto_msgTypes[79]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetCommentPostListByPostIDReq) String() string {
+func (x *GetGroupFromContactResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCommentPostListByPostIDReq) ProtoMessage() {}
+func (*GetGroupFromContactResp) ProtoMessage() {}
 
-func (x *GetCommentPostListByPostIDReq) ProtoReflect() protoreflect.Message {
-	mi := &file_chat_chat_proto_msgTypes[76]
+func (x *GetGroupFromContactResp) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[79]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4666,58 +4904,43 @@ func (x *GetCommentPostListByPostIDReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCommentPostListByPostIDReq.ProtoReflect.Descriptor instead.
-func (*GetCommentPostListByPostIDReq) Descriptor() ([]byte, []int) {
-	return file_chat_chat_proto_rawDescGZIP(), []int{76}
-}
-
-func (x *GetCommentPostListByPostIDReq) GetPostID() string {
-	if x != nil {
-		return x.PostID
-	}
-	return ""
-}
-
-func (x *GetCommentPostListByPostIDReq) GetNextCursor() int64 {
-	if x != nil {
-		return x.NextCursor
-	}
-	return 0
+// Deprecated: Use GetGroupFromContactResp.ProtoReflect.Descriptor instead.
+func (*GetGroupFromContactResp) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{79}
 }
 
-func (x *GetCommentPostListByPostIDReq) GetCount() int32 {
+func (x *GetGroupFromContactResp) GetGroupIDs() []string {
 	if x != nil {
-		return x.Count
+		return x.GroupIDs
 	}
-	return 0
+	return nil
 }
 
-type GetCommentPostListByPostIDResp struct {
+type SaveGroupToContactReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	NextCursor int64   `protobuf:"varint,1,opt,name=nextCursor,proto3" json:"nextCursor"`
-	Posts      []*Post `protobuf:"bytes,2,rep,name=posts,proto3" json:"posts"`
+	GroupIDs []string `protobuf:"bytes,1,rep,name=groupIDs,proto3" json:"groupIDs"`
 }
 
-func (x *GetCommentPostListByPostIDResp) Reset() {
-	*x = GetCommentPostListByPostIDResp{}
+func (x *SaveGroupToContactReq) Reset() {
+	*x = SaveGroupToContactReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chat_chat_proto_msgTypes[77]
+		mi := &file_chat_chat_proto_msgTypes[80]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetCommentPostListByPostIDResp) String() string {
+func (x *SaveGroupToContactReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCommentPostListByPostIDResp) ProtoMessage() {}
+func (*SaveGroupToContactReq) ProtoMessage() {}
 
-func (x *GetCommentPostListByPostIDResp) ProtoReflect() protoreflect.Message {
-	mi := &file_chat_chat_proto_msgTypes[77]
+func (x *SaveGroupToContactReq) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[80]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4728,50 +4951,41 @@ func (x *GetCommentPostListByPostIDResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCommentPostListByPostIDResp.ProtoReflect.Descriptor instead.
-func (*GetCommentPostListByPostIDResp) Descriptor() ([]byte, []int) {
-	return file_chat_chat_proto_rawDescGZIP(), []int{77}
-}
-
-func (x *GetCommentPostListByPostIDResp) GetNextCursor() int64 {
-	if x != nil {
-		return x.NextCursor
-	}
-	return 0
+// Deprecated: Use SaveGroupToContactReq.ProtoReflect.Descriptor instead.
+func (*SaveGroupToContactReq) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{80}
 }
 
-func (x *GetCommentPostListByPostIDResp) GetPosts() []*Post {
+func (x *SaveGroupToContactReq) GetGroupIDs() []string {
 	if x != nil {
-		return x.Posts
+		return x.GroupIDs
 	}
 	return nil
 }
 
-type DeletePostReq struct {
+type SaveGroupToContactResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	PostID string `protobuf:"bytes,1,opt,name=postID,proto3" json:"postID"`
 }
 
-func (x *DeletePostReq) Reset() {
-	*x = DeletePostReq{}
+func (x *SaveGroupToContactResp) Reset() {
+	*x = SaveGroupToContactResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chat_chat_proto_msgTypes[78]
+		mi := &file_chat_chat_proto_msgTypes[81]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DeletePostReq) String() string {
+func (x *SaveGroupToContactResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeletePostReq) ProtoMessage() {}
+func (*SaveGroupToContactResp) ProtoMessage() {}
 
-func (x *DeletePostReq) ProtoReflect() protoreflect.Message {
-	mi := &file_chat_chat_proto_msgTypes[78]
+func (x *SaveGroupToContactResp) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[81]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4782,41 +4996,36 @@ func (x *DeletePostReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeletePostReq.ProtoReflect.Descriptor instead.
-func (*DeletePostReq) Descriptor() ([]byte, []int) {
-	return file_chat_chat_proto_rawDescGZIP(), []int{78}
-}
-
-func (x *DeletePostReq) GetPostID() string {
-	if x != nil {
-		return x.PostID
-	}
-	return ""
+// Deprecated: Use SaveGroupToContactResp.ProtoReflect.Descriptor instead.
+func (*SaveGroupToContactResp) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{81}
 }
 
-type DeletePostResp struct {
+type DeleteGroupFromContactReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	GroupIDs []string `protobuf:"bytes,1,rep,name=groupIDs,proto3" json:"groupIDs"`
 }
 
-func (x *DeletePostResp) Reset() {
-	*x = DeletePostResp{}
+func (x *DeleteGroupFromContactReq) Reset() {
+	*x = DeleteGroupFromContactReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chat_chat_proto_msgTypes[79]
+		mi := &file_chat_chat_proto_msgTypes[82]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DeletePostResp) String() string {
+func (x *DeleteGroupFromContactReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeletePostResp) ProtoMessage() {}
+func (*DeleteGroupFromContactReq) ProtoMessage() {}
 
-func (x *DeletePostResp) ProtoReflect() protoreflect.Message {
-	mi := &file_chat_chat_proto_msgTypes[79]
+func (x *DeleteGroupFromContactReq) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[82]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4827,36 +5036,41 @@ func (x *DeletePostResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeletePostResp.ProtoReflect.Descriptor instead.
-func (*DeletePostResp) Descriptor() ([]byte, []int) {
-	return file_chat_chat_proto_rawDescGZIP(), []int{79}
+// Deprecated: Use DeleteGroupFromContactReq.ProtoReflect.Descriptor instead.
+func (*DeleteGroupFromContactReq) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{82}
 }
 
-type ChangeAllowCommentPostReq struct {
+func (x *DeleteGroupFromContactReq) GetGroupIDs() []string {
+	if x != nil {
+		return x.GroupIDs
+	}
+	return nil
+}
+
+type DeleteGroupFromContactResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	PostID string `protobuf:"bytes,1,opt,name=postID,proto3" json:"postID"`
 }
 
-func (x *ChangeAllowCommentPostReq) Reset() {
-	*x = ChangeAllowCommentPostReq{}
+func (x *DeleteGroupFromContactResp) Reset() {
+	*x = DeleteGroupFromContactResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chat_chat_proto_msgTypes[80]
+		mi := &file_chat_chat_proto_msgTypes[83]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ChangeAllowCommentPostReq) String() string {
+func (x *DeleteGroupFromContactResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChangeAllowCommentPostReq) ProtoMessage() {}
+func (*DeleteGroupFromContactResp) ProtoMessage() {}
 
-func (x *ChangeAllowCommentPostReq) ProtoReflect() protoreflect.Message {
-	mi := &file_chat_chat_proto_msgTypes[80]
+func (x *DeleteGroupFromContactResp) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[83]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4867,44 +5081,34 @@ func (x *ChangeAllowCommentPostReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChangeAllowCommentPostReq.ProtoReflect.Descriptor instead.
-func (*ChangeAllowCommentPostReq) Descriptor() ([]byte, []int) {
-	return file_chat_chat_proto_rawDescGZIP(), []int{80}
-}
-
-func (x *ChangeAllowCommentPostReq) GetPostID() string {
-	if x != nil {
-		return x.PostID
-	}
-	return ""
+// Deprecated: Use DeleteGroupFromContactResp.ProtoReflect.Descriptor instead.
+func (*DeleteGroupFromContactResp) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{83}
 }
 
-type ChangeAllowCommentPostResp struct {
+type DeleteGroupApplicationFromRecipientReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	PostID       string `protobuf:"bytes,1,opt,name=postID,proto3" json:"postID"`
-	AllowComment int32  `protobuf:"varint,2,opt,name=allowComment,proto3" json:"allowComment"`
 }
 
-func (x *ChangeAllowCommentPostResp) Reset() {
-	*x = ChangeAllowCommentPostResp{}
+func (x *DeleteGroupApplicationFromRecipientReq) Reset() {
+	*x = DeleteGroupApplicationFromRecipientReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chat_chat_proto_msgTypes[81]
+		mi := &file_chat_chat_proto_msgTypes[84]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ChangeAllowCommentPostResp) String() string {
+func (x *DeleteGroupApplicationFromRecipientReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChangeAllowCommentPostResp) ProtoMessage() {}
+func (*DeleteGroupApplicationFromRecipientReq) ProtoMessage() {}
 
-func (x *ChangeAllowCommentPostResp) ProtoReflect() protoreflect.Message {
-	mi := &file_chat_chat_proto_msgTypes[81]
+func (x *DeleteGroupApplicationFromRecipientReq) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[84]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4915,50 +5119,34 @@ func (x *ChangeAllowCommentPostResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChangeAllowCommentPostResp.ProtoReflect.Descriptor instead.
-func (*ChangeAllowCommentPostResp) Descriptor() ([]byte, []int) {
-	return file_chat_chat_proto_rawDescGZIP(), []int{81}
-}
-
-func (x *ChangeAllowCommentPostResp) GetPostID() string {
-	if x != nil {
-		return x.PostID
-	}
-	return ""
-}
-
-func (x *ChangeAllowCommentPostResp) GetAllowComment() int32 {
-	if x != nil {
-		return x.AllowComment
-	}
-	return 0
+// Deprecated: Use DeleteGroupApplicationFromRecipientReq.ProtoReflect.Descriptor instead.
+func (*DeleteGroupApplicationFromRecipientReq) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{84}
 }
 
-type ChangeAllowForwardPostReq struct {
+type DeleteGroupApplicationFromRecipientResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	PostID string `protobuf:"bytes,1,opt,name=postID,proto3" json:"postID"`
 }
 
-func (x *ChangeAllowForwardPostReq) Reset() {
-	*x = ChangeAllowForwardPostReq{}
+func (x *DeleteGroupApplicationFromRecipientResp) Reset() {
+	*x = DeleteGroupApplicationFromRecipientResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chat_chat_proto_msgTypes[82]
+		mi := &file_chat_chat_proto_msgTypes[85]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ChangeAllowForwardPostReq) String() string {
+func (x *DeleteGroupApplicationFromRecipientResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChangeAllowForwardPostReq) ProtoMessage() {}
+func (*DeleteGroupApplicationFromRecipientResp) ProtoMessage() {}
 
-func (x *ChangeAllowForwardPostReq) ProtoReflect() protoreflect.Message {
-	mi := &file_chat_chat_proto_msgTypes[82]
+func (x *DeleteGroupApplicationFromRecipientResp) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[85]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4969,44 +5157,34 @@ func (x *ChangeAllowForwardPostReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChangeAllowForwardPostReq.ProtoReflect.Descriptor instead.
-func (*ChangeAllowForwardPostReq) Descriptor() ([]byte, []int) {
-	return file_chat_chat_proto_rawDescGZIP(), []int{82}
-}
-
-func (x *ChangeAllowForwardPostReq) GetPostID() string {
-	if x != nil {
-		return x.PostID
-	}
-	return ""
+// Deprecated: Use DeleteGroupApplicationFromRecipientResp.ProtoReflect.Descriptor instead.
+func (*DeleteGroupApplicationFromRecipientResp) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{85}
 }
 
-type ChangeAllowForwardPostResp struct {
+type DeleteGroupApplicationFromApplicantReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	PostID       string `protobuf:"bytes,1,opt,name=postID,proto3" json:"postID"`
-	AllowForward int32  `protobuf:"varint,2,opt,name=allowForward,proto3" json:"allowForward"`
 }
 
-func (x *ChangeAllowForwardPostResp) Reset() {
-	*x = ChangeAllowForwardPostResp{}
+func (x *DeleteGroupApplicationFromApplicantReq) Reset() {
+	*x = DeleteGroupApplicationFromApplicantReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chat_chat_proto_msgTypes[83]
+		mi := &file_chat_chat_proto_msgTypes[86]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ChangeAllowForwardPostResp) String() string {
+func (x *DeleteGroupApplicationFromApplicantReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChangeAllowForwardPostResp) ProtoMessage() {}
+func (*DeleteGroupApplicationFromApplicantReq) ProtoMessage() {}
 
-func (x *ChangeAllowForwardPostResp) ProtoReflect() protoreflect.Message {
-	mi := &file_chat_chat_proto_msgTypes[83]
+func (x *DeleteGroupApplicationFromApplicantReq) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[86]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5017,51 +5195,34 @@ func (x *ChangeAllowForwardPostResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChangeAllowForwardPostResp.ProtoReflect.Descriptor instead.
-func (*ChangeAllowForwardPostResp) Descriptor() ([]byte, []int) {
-	return file_chat_chat_proto_rawDescGZIP(), []int{83}
-}
-
-func (x *ChangeAllowForwardPostResp) GetPostID() string {
-	if x != nil {
-		return x.PostID
-	}
-	return ""
-}
-
-func (x *ChangeAllowForwardPostResp) GetAllowForward() int32 {
-	if x != nil {
-		return x.AllowForward
-	}
-	return 0
+// Deprecated: Use DeleteGroupApplicationFromApplicantReq.ProtoReflect.Descriptor instead.
+func (*DeleteGroupApplicationFromApplicantReq) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{86}
 }
 
-type LikePostReq struct {
+type DeleteGroupApplicationFromApplicantResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	PostID  string `protobuf:"bytes,1,opt,name=postID,proto3" json:"postID"`
-	IsLiked int32  `protobuf:"varint,2,opt,name=isLiked,proto3" json:"isLiked"`
 }
 
-func (x *LikePostReq) Reset() {
-	*x = LikePostReq{}
+func (x *DeleteGroupApplicationFromApplicantResp) Reset() {
+	*x = DeleteGroupApplicationFromApplicantResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chat_chat_proto_msgTypes[84]
+		mi := &file_chat_chat_proto_msgTypes[87]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *LikePostReq) String() string {
+func (x *DeleteGroupApplicationFromApplicantResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LikePostReq) ProtoMessage() {}
+func (*DeleteGroupApplicationFromApplicantResp) ProtoMessage() {}
 
-func (x *LikePostReq) ProtoReflect() protoreflect.Message {
-	mi := &file_chat_chat_proto_msgTypes[84]
+func (x *DeleteGroupApplicationFromApplicantResp) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[87]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5072,50 +5233,34 @@ func (x *LikePostReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LikePostReq.ProtoReflect.Descriptor instead.
-func (*LikePostReq) Descriptor() ([]byte, []int) {
-	return file_chat_chat_proto_rawDescGZIP(), []int{84}
-}
-
-func (x *LikePostReq) GetPostID() string {
-	if x != nil {
-		return x.PostID
-	}
-	return ""
-}
-
-func (x *LikePostReq) GetIsLiked() int32 {
-	if x != nil {
-		return x.IsLiked
-	}
-	return 0
+// Deprecated: Use DeleteGroupApplicationFromApplicantResp.ProtoReflect.Descriptor instead.
+func (*DeleteGroupApplicationFromApplicantResp) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{87}
 }
 
-type LikePostResp struct {
+type DeleteGroupApplicationFromAlltReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	IsLiked int32 `protobuf:"varint,1,opt,name=isLiked,proto3" json:"isLiked"`
 }
 
-func (x *LikePostResp) Reset() {
-	*x = LikePostResp{}
+func (x *DeleteGroupApplicationFromAlltReq) Reset() {
+	*x = DeleteGroupApplicationFromAlltReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chat_chat_proto_msgTypes[85]
+		mi := &file_chat_chat_proto_msgTypes[88]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *LikePostResp) String() string {
+func (x *DeleteGroupApplicationFromAlltReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LikePostResp) ProtoMessage() {}
+func (*DeleteGroupApplicationFromAlltReq) ProtoMessage() {}
 
-func (x *LikePostResp) ProtoReflect() protoreflect.Message {
-	mi := &file_chat_chat_proto_msgTypes[85]
+func (x *DeleteGroupApplicationFromAlltReq) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[88]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5126,44 +5271,34 @@ func (x *LikePostResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LikePostResp.ProtoReflect.Descriptor instead.
-func (*LikePostResp) Descriptor() ([]byte, []int) {
-	return file_chat_chat_proto_rawDescGZIP(), []int{85}
-}
-
-func (x *LikePostResp) GetIsLiked() int32 {
-	if x != nil {
-		return x.IsLiked
-	}
-	return 0
+// Deprecated: Use DeleteGroupApplicationFromAlltReq.ProtoReflect.Descriptor instead.
+func (*DeleteGroupApplicationFromAlltReq) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{88}
 }
 
-type CollectPostReq struct {
+type DeleteGroupApplicationFromAllResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	PostID      string `protobuf:"bytes,1,opt,name=postID,proto3" json:"postID"`
-	IsCollected int32  `protobuf:"varint,2,opt,name=isCollected,proto3" json:"isCollected"`
 }
 
-func (x *CollectPostReq) Reset() {
-	*x = CollectPostReq{}
+func (x *DeleteGroupApplicationFromAllResp) Reset() {
+	*x = DeleteGroupApplicationFromAllResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chat_chat_proto_msgTypes[86]
+		mi := &file_chat_chat_proto_msgTypes[89]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CollectPostReq) String() string {
+func (x *DeleteGroupApplicationFromAllResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CollectPostReq) ProtoMessage() {}
+func (*DeleteGroupApplicationFromAllResp) ProtoMessage() {}
 
-func (x *CollectPostReq) ProtoReflect() protoreflect.Message {
-	mi := &file_chat_chat_proto_msgTypes[86]
+func (x *DeleteGroupApplicationFromAllResp) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[89]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5174,50 +5309,66 @@ func (x *CollectPostReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CollectPostReq.ProtoReflect.Descriptor instead.
-func (*CollectPostReq) Descriptor() ([]byte, []int) {
-	return file_chat_chat_proto_rawDescGZIP(), []int{86}
-}
-
-func (x *CollectPostReq) GetPostID() string {
-	if x != nil {
-		return x.PostID
-	}
-	return ""
-}
-
-func (x *CollectPostReq) GetIsCollected() int32 {
-	if x != nil {
-		return x.IsCollected
-	}
-	return 0
+// Deprecated: Use DeleteGroupApplicationFromAllResp.ProtoReflect.Descriptor instead.
+func (*DeleteGroupApplicationFromAllResp) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{89}
 }
 
-type CollectPostResp struct {
+type Post struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	IsCollected int32 `protobuf:"varint,1,opt,name=isCollected,proto3" json:"isCollected"`
+	PostID         string                   `protobuf:"bytes,1,opt,name=postID,proto3" json:"postID"`
+	UserID         string                   `protobuf:"bytes,2,opt,name=userID,proto3" json:"userID"`
+	Content        string                   `protobuf:"bytes,3,opt,name=content,proto3" json:"content"`
+	CommentCount   int64                    `protobuf:"varint,4,opt,name=commentCount,proto3" json:"commentCount"`
+	ForwardCount   int64                    `protobuf:"varint,5,opt,name=forwardCount,proto3" json:"forwardCount"`
+	LikeCount      int64                    `protobuf:"varint,6,opt,name=likeCount,proto3" json:"likeCount"`
+	AllowComment   int32                    `protobuf:"varint,7,opt,name=allowComment,proto3" json:"allowComment"`
+	AllowForward   int32                    `protobuf:"varint,8,opt,name=allowForward,proto3" json:"allowForward"`
+	IsLiked        int32                    `protobuf:"varint,9,opt,name=isLiked,proto3" json:"isLiked"`
+	IsCollected    int32                    `protobuf:"varint,10,opt,name=isCollected,proto3" json:"isCollected"`
+	IsForwarded    int32                    `protobuf:"varint,11,opt,name=isForwarded,proto3" json:"isForwarded"`
+	CreateTime     int64                    `protobuf:"varint,12,opt,name=createTime,proto3" json:"createTime"`
+	UpdateTime     int64                    `protobuf:"varint,13,opt,name=updateTime,proto3" json:"updateTime"`
+	AtUserIds      []string                 `protobuf:"bytes,14,rep,name=atUserIds,proto3" json:"atUserIds"`
+	UserInfo       *common.UserPublicInfo   `protobuf:"bytes,15,opt,name=userInfo,proto3" json:"userInfo"`
+	MediaMsgs      []*common.PostMedia      `protobuf:"bytes,16,rep,name=mediaMsgs,proto3" json:"mediaMsgs"`
+	AtUserInfoList []*common.UserPublicInfo `protobuf:"bytes,17,rep,name=atUserInfoList,proto3" json:"atUserInfoList"`
+	ForwardPostID  string                   `protobuf:"bytes,18,opt,name=forwardPostID,proto3" json:"forwardPostID"`
+	CommentPostID  string                   `protobuf:"bytes,19,opt,name=commentPostID,proto3" json:"commentPostID"`
+	RefPostID      string                   `protobuf:"bytes,20,opt,name=refPostID,proto3" json:"refPostID"`
+	ForwardPost    *Post                    `protobuf:"bytes,21,opt,name=forwardPost,proto3" json:"forwardPost"`
+	CommentPost    *Post                    `protobuf:"bytes,22,opt,name=commentPost,proto3" json:"commentPost"`
+	RefPost        *Post                    `protobuf:"bytes,23,opt,name=refPost,proto3" json:"refPost"`
+	IsPinned       int32                    `protobuf:"varint,24,opt,name=isPinned,proto3" json:"isPinned"`
+	IsCommented    int32                    `protobuf:"varint,25,opt,name=isCommented,proto3" json:"isCommented"`
+	IsEdited       int32                    `protobuf:"varint,26,opt,name=isEdited,proto3" json:"isEdited"`
+	RevisionCount  int64                    `protobuf:"varint,27,opt,name=revisionCount,proto3" json:"revisionCount"`
+	CollectCount   int64                    `protobuf:"varint,28,opt,name=collectCount,proto3" json:"collectCount"`
+	Visibility     int32                    `protobuf:"varint,29,opt,name=visibility,proto3" json:"visibility"`
+	// 只返回给作者
+	AllowUserIDs []string `protobuf:"bytes,30,rep,name=allowUserIDs,proto3" json:"allowUserIDs"`
 }
 
-func (x *CollectPostResp) Reset() {
-	*x = CollectPostResp{}
+func (x *Post) Reset() {
+	*x = Post{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chat_chat_proto_msgTypes[87]
+		mi := &file_chat_chat_proto_msgTypes[90]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CollectPostResp) String() string {
+func (x *Post) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CollectPostResp) ProtoMessage() {}
+func (*Post) ProtoMessage() {}
 
-func (x *CollectPostResp) ProtoReflect() protoreflect.Message {
-	mi := &file_chat_chat_proto_msgTypes[87]
+func (x *Post) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[90]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5228,275 +5379,254 @@ func (x *CollectPostResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CollectPostResp.ProtoReflect.Descriptor instead.
-func (*CollectPostResp) Descriptor() ([]byte, []int) {
-	return file_chat_chat_proto_rawDescGZIP(), []int{87}
+// Deprecated: Use Post.ProtoReflect.Descriptor instead.
+func (*Post) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{90}
 }
 
-func (x *CollectPostResp) GetIsCollected() int32 {
+func (x *Post) GetPostID() string {
 	if x != nil {
-		return x.IsCollected
-	}
-	return 0
-}
-
-type ForwardPostReq struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	ForwardPostID string `protobuf:"bytes,1,opt,name=forwardPostID,proto3" json:"forwardPostID"`
-	IsForwarded   int32  `protobuf:"varint,2,opt,name=isForwarded,proto3" json:"isForwarded"`
-}
-
-func (x *ForwardPostReq) Reset() {
-	*x = ForwardPostReq{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_chat_chat_proto_msgTypes[88]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+		return x.PostID
 	}
+	return ""
 }
 
-func (x *ForwardPostReq) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*ForwardPostReq) ProtoMessage() {}
-
-func (x *ForwardPostReq) ProtoReflect() protoreflect.Message {
-	mi := &file_chat_chat_proto_msgTypes[88]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *Post) GetUserID() string {
+	if x != nil {
+		return x.UserID
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use ForwardPostReq.ProtoReflect.Descriptor instead.
-func (*ForwardPostReq) Descriptor() ([]byte, []int) {
-	return file_chat_chat_proto_rawDescGZIP(), []int{88}
+	return ""
 }
 
-func (x *ForwardPostReq) GetForwardPostID() string {
+func (x *Post) GetContent() string {
 	if x != nil {
-		return x.ForwardPostID
+		return x.Content
 	}
 	return ""
 }
 
-func (x *ForwardPostReq) GetIsForwarded() int32 {
+func (x *Post) GetCommentCount() int64 {
 	if x != nil {
-		return x.IsForwarded
+		return x.CommentCount
 	}
 	return 0
 }
 
-type ForwardPostResp struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	IsForwarded int32 `protobuf:"varint,1,opt,name=isForwarded,proto3" json:"isForwarded"`
-}
-
-func (x *ForwardPostResp) Reset() {
-	*x = ForwardPostResp{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_chat_chat_proto_msgTypes[89]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *Post) GetForwardCount() int64 {
+	if x != nil {
+		return x.ForwardCount
 	}
+	return 0
 }
 
-func (x *ForwardPostResp) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*ForwardPostResp) ProtoMessage() {}
-
-func (x *ForwardPostResp) ProtoReflect() protoreflect.Message {
-	mi := &file_chat_chat_proto_msgTypes[89]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *Post) GetLikeCount() int64 {
+	if x != nil {
+		return x.LikeCount
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use ForwardPostResp.ProtoReflect.Descriptor instead.
-func (*ForwardPostResp) Descriptor() ([]byte, []int) {
-	return file_chat_chat_proto_rawDescGZIP(), []int{89}
+	return 0
 }
 
-func (x *ForwardPostResp) GetIsForwarded() int32 {
+func (x *Post) GetAllowComment() int32 {
 	if x != nil {
-		return x.IsForwarded
+		return x.AllowComment
 	}
 	return 0
 }
 
-type ReferencePostReq struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	RefPostID    string                  `protobuf:"bytes,1,opt,name=refPostID,proto3" json:"refPostID"`
-	Content      *wrapperspb.StringValue `protobuf:"bytes,2,opt,name=content,proto3" json:"content"`
-	AllowComment int32                   `protobuf:"varint,3,opt,name=allowComment,proto3" json:"allowComment"`
-	AllowForward int32                   `protobuf:"varint,4,opt,name=allowForward,proto3" json:"allowForward"`
-	AtUserIds    []string                `protobuf:"bytes,5,rep,name=atUserIds,proto3" json:"atUserIds"`
-	MediaMsgs    []*common.PostMedia     `protobuf:"bytes,6,rep,name=mediaMsgs,proto3" json:"mediaMsgs"`
-}
-
-func (x *ReferencePostReq) Reset() {
-	*x = ReferencePostReq{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_chat_chat_proto_msgTypes[90]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *Post) GetAllowForward() int32 {
+	if x != nil {
+		return x.AllowForward
 	}
+	return 0
 }
 
-func (x *ReferencePostReq) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*ReferencePostReq) ProtoMessage() {}
-
-func (x *ReferencePostReq) ProtoReflect() protoreflect.Message {
-	mi := &file_chat_chat_proto_msgTypes[90]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *Post) GetIsLiked() int32 {
+	if x != nil {
+		return x.IsLiked
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use ReferencePostReq.ProtoReflect.Descriptor instead.
-func (*ReferencePostReq) Descriptor() ([]byte, []int) {
-	return file_chat_chat_proto_rawDescGZIP(), []int{90}
+	return 0
 }
 
-func (x *ReferencePostReq) GetRefPostID() string {
+func (x *Post) GetIsCollected() int32 {
 	if x != nil {
-		return x.RefPostID
+		return x.IsCollected
 	}
-	return ""
+	return 0
 }
 
-func (x *ReferencePostReq) GetContent() *wrapperspb.StringValue {
+func (x *Post) GetIsForwarded() int32 {
 	if x != nil {
-		return x.Content
+		return x.IsForwarded
 	}
-	return nil
+	return 0
 }
 
-func (x *ReferencePostReq) GetAllowComment() int32 {
+func (x *Post) GetCreateTime() int64 {
 	if x != nil {
-		return x.AllowComment
+		return x.CreateTime
 	}
 	return 0
 }
 
-func (x *ReferencePostReq) GetAllowForward() int32 {
+func (x *Post) GetUpdateTime() int64 {
 	if x != nil {
-		return x.AllowForward
+		return x.UpdateTime
 	}
 	return 0
 }
 
-func (x *ReferencePostReq) GetAtUserIds() []string {
+func (x *Post) GetAtUserIds() []string {
 	if x != nil {
 		return x.AtUserIds
 	}
 	return nil
 }
 
-func (x *ReferencePostReq) GetMediaMsgs() []*common.PostMedia {
+func (x *Post) GetUserInfo() *common.UserPublicInfo {
 	if x != nil {
-		return x.MediaMsgs
+		return x.UserInfo
 	}
 	return nil
 }
 
-type ReferencePostResp struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *Post) GetMediaMsgs() []*common.PostMedia {
+	if x != nil {
+		return x.MediaMsgs
+	}
+	return nil
 }
 
-func (x *ReferencePostResp) Reset() {
-	*x = ReferencePostResp{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_chat_chat_proto_msgTypes[91]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *Post) GetAtUserInfoList() []*common.UserPublicInfo {
+	if x != nil {
+		return x.AtUserInfoList
 	}
+	return nil
 }
 
-func (x *ReferencePostResp) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Post) GetForwardPostID() string {
+	if x != nil {
+		return x.ForwardPostID
+	}
+	return ""
 }
 
-func (*ReferencePostResp) ProtoMessage() {}
+func (x *Post) GetCommentPostID() string {
+	if x != nil {
+		return x.CommentPostID
+	}
+	return ""
+}
 
-func (x *ReferencePostResp) ProtoReflect() protoreflect.Message {
-	mi := &file_chat_chat_proto_msgTypes[91]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *Post) GetRefPostID() string {
+	if x != nil {
+		return x.RefPostID
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use ReferencePostResp.ProtoReflect.Descriptor instead.
-func (*ReferencePostResp) Descriptor() ([]byte, []int) {
-	return file_chat_chat_proto_rawDescGZIP(), []int{91}
+func (x *Post) GetForwardPost() *Post {
+	if x != nil {
+		return x.ForwardPost
+	}
+	return nil
 }
 
-type CommentPostReq struct {
+func (x *Post) GetCommentPost() *Post {
+	if x != nil {
+		return x.CommentPost
+	}
+	return nil
+}
+
+func (x *Post) GetRefPost() *Post {
+	if x != nil {
+		return x.RefPost
+	}
+	return nil
+}
+
+func (x *Post) GetIsPinned() int32 {
+	if x != nil {
+		return x.IsPinned
+	}
+	return 0
+}
+
+func (x *Post) GetIsCommented() int32 {
+	if x != nil {
+		return x.IsCommented
+	}
+	return 0
+}
+
+func (x *Post) GetIsEdited() int32 {
+	if x != nil {
+		return x.IsEdited
+	}
+	return 0
+}
+
+func (x *Post) GetRevisionCount() int64 {
+	if x != nil {
+		return x.RevisionCount
+	}
+	return 0
+}
+
+func (x *Post) GetCollectCount() int64 {
+	if x != nil {
+		return x.CollectCount
+	}
+	return 0
+}
+
+func (x *Post) GetVisibility() int32 {
+	if x != nil {
+		return x.Visibility
+	}
+	return 0
+}
+
+func (x *Post) GetAllowUserIDs() []string {
+	if x != nil {
+		return x.AllowUserIDs
+	}
+	return nil
+}
+
+type PublishPostReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CommentPostID string                  `protobuf:"bytes,1,opt,name=commentPostID,proto3" json:"commentPostID"`
-	Content       *wrapperspb.StringValue `protobuf:"bytes,2,opt,name=content,proto3" json:"content"`
-	AllowComment  int32                   `protobuf:"varint,3,opt,name=allowComment,proto3" json:"allowComment"`
-	AllowForward  int32                   `protobuf:"varint,4,opt,name=allowForward,proto3" json:"allowForward"`
-	AtUserIds     []string                `protobuf:"bytes,5,rep,name=atUserIds,proto3" json:"atUserIds"`
-	MediaMsgs     []*common.PostMedia     `protobuf:"bytes,6,rep,name=mediaMsgs,proto3" json:"mediaMsgs"`
+	Content      *wrapperspb.StringValue `protobuf:"bytes,1,opt,name=content,proto3" json:"content"`
+	AllowComment int32                   `protobuf:"varint,2,opt,name=allowComment,proto3" json:"allowComment"`
+	AllowForward int32                   `protobuf:"varint,3,opt,name=allowForward,proto3" json:"allowForward"`
+	AtUserIds    []string                `protobuf:"bytes,4,rep,name=atUserIds,proto3" json:"atUserIds"`
+	MediaMsgs    []*common.PostMedia     `protobuf:"bytes,5,rep,name=mediaMsgs,proto3" json:"mediaMsgs"`
+	Visibility   int32                   `protobuf:"varint,6,opt,name=visibility,proto3" json:"visibility"`
+	AllowUserIDs []string                `protobuf:"bytes,7,rep,name=allowUserIDs,proto3" json:"allowUserIDs"`
+	// 毫秒时间戳，晚于当前时间时保存为定时草稿
+	PublishAt int64 `protobuf:"varint,8,opt,name=publishAt,proto3" json:"publishAt"`
 }
 
-func (x *CommentPostReq) Reset() {
-	*x = CommentPostReq{}
+func (x *PublishPostReq) Reset() {
+	*x = PublishPostReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chat_chat_proto_msgTypes[92]
+		mi := &file_chat_chat_proto_msgTypes[91]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CommentPostReq) String() string {
+func (x *PublishPostReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CommentPostReq) ProtoMessage() {}
+func (*PublishPostReq) ProtoMessage() {}
 
-func (x *CommentPostReq) ProtoReflect() protoreflect.Message {
-	mi := &file_chat_chat_proto_msgTypes[92]
+func (x *PublishPostReq) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[91]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5507,78 +5637,94 @@ func (x *CommentPostReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CommentPostReq.ProtoReflect.Descriptor instead.
-func (*CommentPostReq) Descriptor() ([]byte, []int) {
-	return file_chat_chat_proto_rawDescGZIP(), []int{92}
-}
-
-func (x *CommentPostReq) GetCommentPostID() string {
-	if x != nil {
-		return x.CommentPostID
-	}
-	return ""
+// Deprecated: Use PublishPostReq.ProtoReflect.Descriptor instead.
+func (*PublishPostReq) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{91}
 }
 
-func (x *CommentPostReq) GetContent() *wrapperspb.StringValue {
+func (x *PublishPostReq) GetContent() *wrapperspb.StringValue {
 	if x != nil {
 		return x.Content
 	}
 	return nil
 }
 
-func (x *CommentPostReq) GetAllowComment() int32 {
+func (x *PublishPostReq) GetAllowComment() int32 {
 	if x != nil {
 		return x.AllowComment
 	}
 	return 0
 }
 
-func (x *CommentPostReq) GetAllowForward() int32 {
+func (x *PublishPostReq) GetAllowForward() int32 {
 	if x != nil {
 		return x.AllowForward
 	}
 	return 0
 }
 
-func (x *CommentPostReq) GetAtUserIds() []string {
+func (x *PublishPostReq) GetAtUserIds() []string {
 	if x != nil {
 		return x.AtUserIds
 	}
 	return nil
 }
 
-func (x *CommentPostReq) GetMediaMsgs() []*common.PostMedia {
+func (x *PublishPostReq) GetMediaMsgs() []*common.PostMedia {
 	if x != nil {
 		return x.MediaMsgs
 	}
 	return nil
 }
 
-type CommentPostResp struct {
+func (x *PublishPostReq) GetVisibility() int32 {
+	if x != nil {
+		return x.Visibility
+	}
+	return 0
+}
+
+func (x *PublishPostReq) GetAllowUserIDs() []string {
+	if x != nil {
+		return x.AllowUserIDs
+	}
+	return nil
+}
+
+func (x *PublishPostReq) GetPublishAt() int64 {
+	if x != nil {
+		return x.PublishAt
+	}
+	return 0
+}
+
+type PublishPostResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	Post *Post `protobuf:"bytes,1,opt,name=post,proto3" json:"post"`
+	// 定时发布时返回草稿ID，post 为空
+	DraftID string `protobuf:"bytes,2,opt,name=draftID,proto3" json:"draftID"`
 }
 
-func (x *CommentPostResp) Reset() {
-	*x = CommentPostResp{}
+func (x *PublishPostResp) Reset() {
+	*x = PublishPostResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chat_chat_proto_msgTypes[93]
+		mi := &file_chat_chat_proto_msgTypes[92]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CommentPostResp) String() string {
+func (x *PublishPostResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CommentPostResp) ProtoMessage() {}
+func (*PublishPostResp) ProtoMessage() {}
 
-func (x *CommentPostResp) ProtoReflect() protoreflect.Message {
-	mi := &file_chat_chat_proto_msgTypes[93]
+func (x *PublishPostResp) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[92]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5589,44 +5735,50 @@ func (x *CommentPostResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CommentPostResp.ProtoReflect.Descriptor instead.
-func (*CommentPostResp) Descriptor() ([]byte, []int) {
-	return file_chat_chat_proto_rawDescGZIP(), []int{93}
+// Deprecated: Use PublishPostResp.ProtoReflect.Descriptor instead.
+func (*PublishPostResp) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{92}
 }
 
-func (x *CommentPostResp) GetPost() *Post {
+func (x *PublishPostResp) GetPost() *Post {
 	if x != nil {
 		return x.Post
 	}
 	return nil
 }
 
-type PinPostReq struct {
+func (x *PublishPostResp) GetDraftID() string {
+	if x != nil {
+		return x.DraftID
+	}
+	return ""
+}
+
+type GetPostByIDReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PostID   string `protobuf:"bytes,1,opt,name=postID,proto3" json:"postID"`
-	IsPinned int32  `protobuf:"varint,2,opt,name=isPinned,proto3" json:"isPinned"`
+	PostID string `protobuf:"bytes,1,opt,name=postID,proto3" json:"postID"`
 }
 
-func (x *PinPostReq) Reset() {
-	*x = PinPostReq{}
+func (x *GetPostByIDReq) Reset() {
+	*x = GetPostByIDReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chat_chat_proto_msgTypes[94]
+		mi := &file_chat_chat_proto_msgTypes[93]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *PinPostReq) String() string {
+func (x *GetPostByIDReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PinPostReq) ProtoMessage() {}
+func (*GetPostByIDReq) ProtoMessage() {}
 
-func (x *PinPostReq) ProtoReflect() protoreflect.Message {
-	mi := &file_chat_chat_proto_msgTypes[94]
+func (x *GetPostByIDReq) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[93]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5637,48 +5789,43 @@ func (x *PinPostReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PinPostReq.ProtoReflect.Descriptor instead.
-func (*PinPostReq) Descriptor() ([]byte, []int) {
-	return file_chat_chat_proto_rawDescGZIP(), []int{94}
+// Deprecated: Use GetPostByIDReq.ProtoReflect.Descriptor instead.
+func (*GetPostByIDReq) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{93}
 }
 
-func (x *PinPostReq) GetPostID() string {
+func (x *GetPostByIDReq) GetPostID() string {
 	if x != nil {
 		return x.PostID
 	}
 	return ""
 }
 
-func (x *PinPostReq) GetIsPinned() int32 {
-	if x != nil {
-		return x.IsPinned
-	}
-	return 0
-}
-
-type PinPostResp struct {
+type GetPostByIDResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Post *Post `protobuf:"bytes,1,opt,name=post,proto3" json:"post"`
 }
 
-func (x *PinPostResp) Reset() {
-	*x = PinPostResp{}
+func (x *GetPostByIDResp) Reset() {
+	*x = GetPostByIDResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chat_chat_proto_msgTypes[95]
+		mi := &file_chat_chat_proto_msgTypes[94]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *PinPostResp) String() string {
+func (x *GetPostByIDResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PinPostResp) ProtoMessage() {}
+func (*GetPostByIDResp) ProtoMessage() {}
 
-func (x *PinPostResp) ProtoReflect() protoreflect.Message {
-	mi := &file_chat_chat_proto_msgTypes[95]
+func (x *GetPostByIDResp) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[94]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5689,36 +5836,43 @@ func (x *PinPostResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PinPostResp.ProtoReflect.Descriptor instead.
-func (*PinPostResp) Descriptor() ([]byte, []int) {
-	return file_chat_chat_proto_rawDescGZIP(), []int{95}
+// Deprecated: Use GetPostByIDResp.ProtoReflect.Descriptor instead.
+func (*GetPostByIDResp) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{94}
 }
 
-type CheckVersionReq struct {
+func (x *GetPostByIDResp) GetPost() *Post {
+	if x != nil {
+		return x.Post
+	}
+	return nil
+}
+
+type GetAllTypePostReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Language string `protobuf:"bytes,1,opt,name=language,proto3" json:"language"`
+	Count int32 `protobuf:"varint,1,opt,name=count,proto3" json:"count"`
 }
 
-func (x *CheckVersionReq) Reset() {
-	*x = CheckVersionReq{}
+func (x *GetAllTypePostReq) Reset() {
+	*x = GetAllTypePostReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chat_chat_proto_msgTypes[96]
+		mi := &file_chat_chat_proto_msgTypes[95]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CheckVersionReq) String() string {
+func (x *GetAllTypePostReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CheckVersionReq) ProtoMessage() {}
+func (*GetAllTypePostReq) ProtoMessage() {}
 
-func (x *CheckVersionReq) ProtoReflect() protoreflect.Message {
-	mi := &file_chat_chat_proto_msgTypes[96]
+func (x *GetAllTypePostReq) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[95]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5729,48 +5883,43 @@ func (x *CheckVersionReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CheckVersionReq.ProtoReflect.Descriptor instead.
-func (*CheckVersionReq) Descriptor() ([]byte, []int) {
-	return file_chat_chat_proto_rawDescGZIP(), []int{96}
+// Deprecated: Use GetAllTypePostReq.ProtoReflect.Descriptor instead.
+func (*GetAllTypePostReq) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{95}
 }
 
-func (x *CheckVersionReq) GetLanguage() string {
+func (x *GetAllTypePostReq) GetCount() int32 {
 	if x != nil {
-		return x.Language
+		return x.Count
 	}
-	return ""
+	return 0
 }
 
-type CheckVersionResp struct {
+type GetAllTypePostResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	BuildVersion                string   `protobuf:"bytes,1,opt,name=buildVersion,proto3" json:"buildVersion"`
-	DownloadURL                 string   `protobuf:"bytes,2,opt,name=downloadURL,proto3" json:"downloadURL"`
-	BuildUpdateTitle            string   `protobuf:"bytes,3,opt,name=buildUpdateTitle,proto3" json:"buildUpdateTitle"`
-	BuildUpdateDescriptionTitle string   `protobuf:"bytes,4,opt,name=buildUpdateDescriptionTitle,proto3" json:"buildUpdateDescriptionTitle"`
-	BuildUpdateDescriptions     []string `protobuf:"bytes,5,rep,name=buildUpdateDescriptions,proto3" json:"buildUpdateDescriptions"`
-	NeedForceUpdate             bool     `protobuf:"varint,6,opt,name=needForceUpdate,proto3" json:"needForceUpdate"`
+	AllPosts []*TypePosts `protobuf:"bytes,1,rep,name=allPosts,proto3" json:"allPosts"`
 }
 
-func (x *CheckVersionResp) Reset() {
-	*x = CheckVersionResp{}
+func (x *GetAllTypePostResp) Reset() {
+	*x = GetAllTypePostResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chat_chat_proto_msgTypes[97]
+		mi := &file_chat_chat_proto_msgTypes[96]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CheckVersionResp) String() string {
+func (x *GetAllTypePostResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CheckVersionResp) ProtoMessage() {}
+func (*GetAllTypePostResp) ProtoMessage() {}
 
-func (x *CheckVersionResp) ProtoReflect() protoreflect.Message {
-	mi := &file_chat_chat_proto_msgTypes[97]
+func (x *GetAllTypePostResp) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[96]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5781,61 +5930,93 @@ func (x *CheckVersionResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CheckVersionResp.ProtoReflect.Descriptor instead.
-func (*CheckVersionResp) Descriptor() ([]byte, []int) {
-	return file_chat_chat_proto_rawDescGZIP(), []int{97}
+// Deprecated: Use GetAllTypePostResp.ProtoReflect.Descriptor instead.
+func (*GetAllTypePostResp) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{96}
 }
 
-func (x *CheckVersionResp) GetBuildVersion() string {
+func (x *GetAllTypePostResp) GetAllPosts() []*TypePosts {
 	if x != nil {
-		return x.BuildVersion
+		return x.AllPosts
 	}
-	return ""
+	return nil
 }
 
-func (x *CheckVersionResp) GetDownloadURL() string {
-	if x != nil {
-		return x.DownloadURL
+type TypePosts struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type       int32   `protobuf:"varint,1,opt,name=type,proto3" json:"type"`
+	NextCursor int64   `protobuf:"varint,2,opt,name=nextCursor,proto3" json:"nextCursor"`
+	Posts      []*Post `protobuf:"bytes,3,rep,name=posts,proto3" json:"posts"`
+}
+
+func (x *TypePosts) Reset() {
+	*x = TypePosts{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[97]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *CheckVersionResp) GetBuildUpdateTitle() string {
-	if x != nil {
-		return x.BuildUpdateTitle
+func (x *TypePosts) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TypePosts) ProtoMessage() {}
+
+func (x *TypePosts) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[97]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *CheckVersionResp) GetBuildUpdateDescriptionTitle() string {
+// Deprecated: Use TypePosts.ProtoReflect.Descriptor instead.
+func (*TypePosts) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *TypePosts) GetType() int32 {
 	if x != nil {
-		return x.BuildUpdateDescriptionTitle
+		return x.Type
 	}
-	return ""
+	return 0
 }
 
-func (x *CheckVersionResp) GetBuildUpdateDescriptions() []string {
+func (x *TypePosts) GetNextCursor() int64 {
 	if x != nil {
-		return x.BuildUpdateDescriptions
+		return x.NextCursor
 	}
-	return nil
+	return 0
 }
 
-func (x *CheckVersionResp) GetNeedForceUpdate() bool {
+func (x *TypePosts) GetPosts() []*Post {
 	if x != nil {
-		return x.NeedForceUpdate
+		return x.Posts
 	}
-	return false
+	return nil
 }
 
-type GetFakeUserReq struct {
+type GetPostListReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	NextCursor int64 `protobuf:"varint,1,opt,name=nextCursor,proto3" json:"nextCursor"`
+	Count      int32 `protobuf:"varint,2,opt,name=count,proto3" json:"count"`
+	Type       int32 `protobuf:"varint,3,opt,name=type,proto3" json:"type"`
 }
 
-func (x *GetFakeUserReq) Reset() {
-	*x = GetFakeUserReq{}
+func (x *GetPostListReq) Reset() {
+	*x = GetPostListReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_chat_chat_proto_msgTypes[98]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5843,13 +6024,13 @@ func (x *GetFakeUserReq) Reset() {
 	}
 }
 
-func (x *GetFakeUserReq) String() string {
+func (x *GetPostListReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetFakeUserReq) ProtoMessage() {}
+func (*GetPostListReq) ProtoMessage() {}
 
-func (x *GetFakeUserReq) ProtoReflect() protoreflect.Message {
+func (x *GetPostListReq) ProtoReflect() protoreflect.Message {
 	mi := &file_chat_chat_proto_msgTypes[98]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5861,22 +6042,43 @@ func (x *GetFakeUserReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetFakeUserReq.ProtoReflect.Descriptor instead.
-func (*GetFakeUserReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetPostListReq.ProtoReflect.Descriptor instead.
+func (*GetPostListReq) Descriptor() ([]byte, []int) {
 	return file_chat_chat_proto_rawDescGZIP(), []int{98}
 }
 
-type GetFakeUserResp struct {
+func (x *GetPostListReq) GetNextCursor() int64 {
+	if x != nil {
+		return x.NextCursor
+	}
+	return 0
+}
+
+func (x *GetPostListReq) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *GetPostListReq) GetType() int32 {
+	if x != nil {
+		return x.Type
+	}
+	return 0
+}
+
+type GetPostListResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Online int32 `protobuf:"varint,1,opt,name=online,proto3" json:"online"`
-	Total  int32 `protobuf:"varint,2,opt,name=total,proto3" json:"total"`
+	NextCursor int64   `protobuf:"varint,1,opt,name=nextCursor,proto3" json:"nextCursor"`
+	Posts      []*Post `protobuf:"bytes,2,rep,name=posts,proto3" json:"posts"`
 }
 
-func (x *GetFakeUserResp) Reset() {
-	*x = GetFakeUserResp{}
+func (x *GetPostListResp) Reset() {
+	*x = GetPostListResp{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_chat_chat_proto_msgTypes[99]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5884,13 +6086,13 @@ func (x *GetFakeUserResp) Reset() {
 	}
 }
 
-func (x *GetFakeUserResp) String() string {
+func (x *GetPostListResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetFakeUserResp) ProtoMessage() {}
+func (*GetPostListResp) ProtoMessage() {}
 
-func (x *GetFakeUserResp) ProtoReflect() protoreflect.Message {
+func (x *GetPostListResp) ProtoReflect() protoreflect.Message {
 	mi := &file_chat_chat_proto_msgTypes[99]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5902,1272 +6104,5146 @@ func (x *GetFakeUserResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetFakeUserResp.ProtoReflect.Descriptor instead.
-func (*GetFakeUserResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetPostListResp.ProtoReflect.Descriptor instead.
+func (*GetPostListResp) Descriptor() ([]byte, []int) {
 	return file_chat_chat_proto_rawDescGZIP(), []int{99}
 }
 
-func (x *GetFakeUserResp) GetOnline() int32 {
+func (x *GetPostListResp) GetNextCursor() int64 {
 	if x != nil {
-		return x.Online
+		return x.NextCursor
 	}
 	return 0
 }
 
-func (x *GetFakeUserResp) GetTotal() int32 {
+func (x *GetPostListResp) GetPosts() []*Post {
 	if x != nil {
-		return x.Total
+		return x.Posts
 	}
-	return 0
+	return nil
 }
 
-var File_chat_chat_proto protoreflect.FileDescriptor
+type GetPostListByUserReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-var file_chat_chat_proto_rawDesc = []byte{
-	0x0a, 0x0f, 0x63, 0x68, 0x61, 0x74, 0x2f, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x12, 0x0b, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x1a, 0x13,
-	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x1a, 0x13, 0x73, 0x64, 0x6b, 0x77, 0x73, 0x73, 0x2f, 0x73, 0x64, 0x6b, 0x77,
-	0x73, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65,
-	0x72, 0x73, 0x70, 0x62, 0x2f, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x70, 0x62, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x42, 0x0a, 0x0a, 0x6f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x54,
-	0x69, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x74,
-	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
-	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x2b, 0x0a, 0x0f, 0x67, 0x65, 0x74,
-	0x55, 0x73, 0x65, 0x72, 0x73, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07,
-	0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x75,
-	0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x22, 0x47, 0x0a, 0x10, 0x67, 0x65, 0x74, 0x55, 0x73, 0x65,
-	0x72, 0x73, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x33, 0x0a, 0x08, 0x74, 0x69,
-	0x6d, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6f,
-	0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x6f, 0x6e, 0x6c, 0x69, 0x6e,
-	0x65, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x22,
-	0x7a, 0x0a, 0x0c, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12,
-	0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65, 0x76,
-	0x69, 0x63, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x65, 0x76,
-	0x69, 0x63, 0x65, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72,
-	0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72,
-	0x6d, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x92, 0x05, 0x0a, 0x11,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65,
-	0x71, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x36, 0x0a, 0x07, 0x61, 0x63, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6f, 0x70, 0x65,
-	0x6e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72,
-	0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x12, 0x38, 0x0a, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75,
-	0x65, 0x52, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x36, 0x0a, 0x07, 0x66,
-	0x61, 0x63, 0x65, 0x55, 0x52, 0x4c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6f,
-	0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53,
-	0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x07, 0x66, 0x61, 0x63, 0x65,
-	0x55, 0x52, 0x4c, 0x12, 0x38, 0x0a, 0x08, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x55, 0x52, 0x4c, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61,
-	0x6c, 0x75, 0x65, 0x52, 0x08, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x55, 0x52, 0x4c, 0x12, 0x32, 0x0a,
-	0x05, 0x61, 0x62, 0x6f, 0x75, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6f,
-	0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53,
-	0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x61, 0x62, 0x6f, 0x75,
-	0x74, 0x12, 0x43, 0x0a, 0x0e, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x41, 0x64, 0x64, 0x46, 0x72, 0x69,
-	0x65, 0x6e, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6f, 0x70, 0x65, 0x6e,
-	0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x33,
-	0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0e, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x41, 0x64, 0x64,
-	0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x12, 0x39, 0x0a, 0x09, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x42,
-	0x65, 0x65, 0x70, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6f, 0x70, 0x65, 0x6e,
-	0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x33,
-	0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x09, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x42, 0x65, 0x65,
-	0x70, 0x12, 0x43, 0x0a, 0x0e, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x56, 0x69, 0x62, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6f, 0x70, 0x65, 0x6e,
-	0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x33,
-	0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0e, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x56, 0x69, 0x62,
-	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x47, 0x0a, 0x10, 0x67, 0x6c, 0x6f, 0x62, 0x61, 0x6c,
-	0x52, 0x65, 0x63, 0x76, 0x4d, 0x73, 0x67, 0x4f, 0x70, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1b, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x10, 0x67,
-	0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x52, 0x65, 0x63, 0x76, 0x4d, 0x73, 0x67, 0x4f, 0x70, 0x74, 0x12,
-	0x3f, 0x0a, 0x0c, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x18,
-	0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c,
-	0x75, 0x65, 0x52, 0x0c, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65,
-	0x22, 0xf0, 0x01, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x49,
-	0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x36, 0x0a,
-	0x07, 0x66, 0x61, 0x63, 0x65, 0x55, 0x52, 0x4c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c,
-	0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x07, 0x66, 0x61,
-	0x63, 0x65, 0x55, 0x52, 0x4c, 0x12, 0x38, 0x0a, 0x08, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x55, 0x52,
-	0x4c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
-	0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x08, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x55, 0x52, 0x4c, 0x12,
-	0x32, 0x0a, 0x05, 0x61, 0x62, 0x6f, 0x75, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c,
-	0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x61, 0x62,
-	0x6f, 0x75, 0x74, 0x22, 0x31, 0x0a, 0x15, 0x46, 0x69, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x50,
-	0x75, 0x62, 0x6c, 0x69, 0x63, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07,
-	0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x75,
-	0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x22, 0x50, 0x0a, 0x1b, 0x46, 0x69, 0x6e, 0x64, 0x55, 0x73,
-	0x65, 0x72, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70,
-	0x4f, 0x66, 0x4f, 0x6e, 0x65, 0x12, 0x31, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x6f, 0x6d,
-	0x6d, 0x6f, 0x6e, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x49, 0x6e,
-	0x66, 0x6f, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x22, 0x4d, 0x0a, 0x16, 0x46, 0x69, 0x6e, 0x64,
-	0x55, 0x73, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65,
-	0x73, 0x70, 0x12, 0x33, 0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x1d, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
-	0x6e, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x49, 0x6e, 0x66, 0x6f,
-	0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x22, 0x8f, 0x01, 0x0a, 0x17, 0x53, 0x65, 0x61, 0x72,
-	0x63, 0x68, 0x55, 0x73, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x49, 0x6e, 0x66, 0x6f,
-	0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x40, 0x0a,
-	0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x20, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x73, 0x64, 0x6b, 0x77, 0x73,
-	0x73, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
-	0x18, 0x0a, 0x07, 0x67, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x07, 0x67, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x73, 0x22, 0x65, 0x0a, 0x18, 0x53, 0x65, 0x61,
-	0x72, 0x63, 0x68, 0x55, 0x73, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x49, 0x6e, 0x66,
-	0x6f, 0x52, 0x65, 0x73, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x33, 0x0a, 0x05, 0x75,
-	0x73, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6f, 0x70, 0x65,
-	0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x50,
-	0x75, 0x62, 0x6c, 0x69, 0x63, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73,
-	0x22, 0x2f, 0x0a, 0x13, 0x46, 0x69, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x46, 0x75, 0x6c, 0x6c,
-	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x49,
-	0x44, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44,
-	0x73, 0x22, 0x49, 0x0a, 0x14, 0x46, 0x69, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x46, 0x75, 0x6c,
-	0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x12, 0x31, 0x0a, 0x05, 0x75, 0x73, 0x65,
-	0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69,
-	0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x46, 0x75, 0x6c,
-	0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x22, 0x12, 0x0a, 0x10,
-	0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x52, 0x65, 0x71,
-	0x22, 0x2d, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x18, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x22,
-	0xf1, 0x01, 0x0a, 0x11, 0x53, 0x65, 0x6e, 0x64, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x43, 0x6f,
-	0x64, 0x65, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x64, 0x46, 0x6f, 0x72,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x75, 0x73, 0x65, 0x64, 0x46, 0x6f, 0x72, 0x12,
-	0x0e, 0x0a, 0x02, 0x69, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x70, 0x12,
-	0x26, 0x0a, 0x0e, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64,
-	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65, 0x76, 0x69, 0x63,
-	0x65, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x65, 0x76, 0x69, 0x63,
-	0x65, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x12,
-	0x1a, 0x0a, 0x08, 0x61, 0x72, 0x65, 0x61, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x08, 0x61, 0x72, 0x65, 0x61, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x70,
-	0x68, 0x6f, 0x6e, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0b, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x14, 0x0a,
-	0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d,
-	0x61, 0x69, 0x6c, 0x22, 0x14, 0x0a, 0x12, 0x53, 0x65, 0x6e, 0x64, 0x56, 0x65, 0x72, 0x69, 0x66,
-	0x79, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x22, 0x83, 0x01, 0x0a, 0x0d, 0x56, 0x65,
-	0x72, 0x69, 0x66, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x12, 0x1a, 0x0a, 0x08, 0x61,
-	0x72, 0x65, 0x61, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61,
-	0x72, 0x65, 0x61, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x68, 0x6f, 0x6e, 0x65,
-	0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x68,
-	0x6f, 0x6e, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x76, 0x65, 0x72,
-	0x69, 0x66, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x76,
-	0x65, 0x72, 0x69, 0x66, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61,
-	0x69, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x22,
-	0x10, 0x0a, 0x0e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73,
-	0x70, 0x22, 0x31, 0x0a, 0x11, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x4e, 0x6f,
-	0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63,
-	0x4b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69,
-	0x63, 0x4b, 0x65, 0x79, 0x22, 0x2a, 0x0a, 0x12, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67,
-	0x65, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f,
-	0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65,
-	0x22, 0xd6, 0x01, 0x0a, 0x10, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x55, 0x73, 0x65,
-	0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1a, 0x0a,
-	0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x66, 0x61, 0x63,
-	0x65, 0x55, 0x52, 0x4c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x66, 0x61, 0x63, 0x65,
-	0x55, 0x52, 0x4c, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1c, 0x0a,
-	0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x41,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x41, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65,
-	0x72, 0x54, 0x79, 0x70, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x52, 0x65, 0x67,
-	0x69, 0x73, 0x74, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x22, 0xde, 0x01, 0x0a, 0x0f, 0x52, 0x65,
-	0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x12, 0x14, 0x0a,
-	0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x6f,
-	0x6e, 0x63, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65,
-	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72,
-	0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
-	0x70, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x49, 0x44, 0x12, 0x1a, 0x0a,
-	0x08, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x08, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x75, 0x74,
-	0x6f, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x61, 0x75,
-	0x74, 0x6f, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x31, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63,
-	0x68, 0x61, 0x74, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x55, 0x73, 0x65, 0x72,
-	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x22, 0x48, 0x0a, 0x10, 0x52, 0x65,
-	0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x12, 0x16,
-	0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x68, 0x61, 0x74, 0x54, 0x6f,
-	0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x68, 0x61, 0x74, 0x54,
-	0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x8e, 0x01, 0x0a, 0x11, 0x41, 0x64, 0x64, 0x55, 0x73, 0x65, 0x72,
-	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x70,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x70, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65,
-	0x76, 0x69, 0x63, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x65,
-	0x76, 0x69, 0x63, 0x65, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f,
-	0x72, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f,
-	0x72, 0x6d, 0x12, 0x31, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1d, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x52,
-	0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52,
-	0x04, 0x75, 0x73, 0x65, 0x72, 0x22, 0x14, 0x0a, 0x12, 0x41, 0x64, 0x64, 0x55, 0x73, 0x65, 0x72,
-	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x22, 0xbe, 0x01, 0x0a, 0x08,
-	0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x12, 0x1a,
-	0x0a, 0x08, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x08, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x49, 0x44, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x70,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f,
-	0x6e, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65,
-	0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x1c,
-	0x0a, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x22, 0xa2, 0x01, 0x0a,
-	0x10, 0x52, 0x65, 0x73, 0x65, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65,
-	0x71, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x72, 0x65, 0x61, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x72, 0x65, 0x61, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x20, 0x0a,
-	0x0b, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0b, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12,
-	0x1e, 0x0a, 0x0a, 0x76, 0x65, 0x72, 0x69, 0x66, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0a, 0x76, 0x65, 0x72, 0x69, 0x66, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x12,
-	0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x65,
-	0x6d, 0x61, 0x69, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69,
-	0x6c, 0x22, 0x13, 0x0a, 0x11, 0x52, 0x65, 0x73, 0x65, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f,
-	0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x22, 0x77, 0x0a, 0x11, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65,
-	0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x75,
-	0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65,
-	0x72, 0x49, 0x44, 0x12, 0x28, 0x0a, 0x0f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x50, 0x61,
-	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x75,
-	0x72, 0x72, 0x65, 0x6e, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x20, 0x0a,
-	0x0b, 0x6e, 0x65, 0x77, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0b, 0x6e, 0x65, 0x77, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22,
-	0x14, 0x0a, 0x12, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
-	0x64, 0x52, 0x65, 0x73, 0x70, 0x22, 0x2e, 0x0a, 0x12, 0x46, 0x69, 0x6e, 0x64, 0x55, 0x73, 0x65,
-	0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x75,
-	0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x75, 0x73,
-	0x65, 0x72, 0x49, 0x44, 0x73, 0x22, 0x53, 0x0a, 0x1d, 0x46, 0x69, 0x6e, 0x64, 0x55, 0x73, 0x65,
-	0x72, 0x42, 0x79, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x4f, 0x72, 0x41, 0x63, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x12, 0x18, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xb6, 0x01, 0x0a, 0x13, 0x46,
-	0x69, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65,
-	0x73, 0x70, 0x12, 0x5c, 0x0a, 0x0e, 0x75, 0x73, 0x65, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x4d, 0x61, 0x70, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x6f, 0x70, 0x65,
-	0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x55, 0x73, 0x65,
-	0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x55, 0x73, 0x65,
-	0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x52, 0x0e, 0x75, 0x73, 0x65, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x61, 0x70,
-	0x1a, 0x41, 0x0a, 0x13, 0x55, 0x73, 0x65, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4d,
-	0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
-	0x02, 0x38, 0x01, 0x22, 0x30, 0x0a, 0x12, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x63, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x63, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x61, 0x63, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x73, 0x22, 0xb6, 0x01, 0x0a, 0x13, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x63,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x12, 0x5c, 0x0a,
-	0x0e, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x4d, 0x61, 0x70, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63,
-	0x68, 0x61, 0x74, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x55,
-	0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x55,
-	0x73, 0x65, 0x72, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0e, 0x61, 0x63, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x4d, 0x61, 0x70, 0x1a, 0x41, 0x0a, 0x13, 0x41,
-	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74,
-	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xd7,
-	0x03, 0x0a, 0x0c, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12,
-	0x1a, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6d,
-	0x65, 0x64, 0x69, 0x61, 0x54, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
-	0x6d, 0x65, 0x64, 0x69, 0x61, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x6f, 0x6f,
-	0x6d, 0x54, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x6f, 0x6f,
-	0x6d, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x49,
-	0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x49,
-	0x44, 0x12, 0x26, 0x0a, 0x0e, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x4e, 0x69, 0x63, 0x6b, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x73, 0x65, 0x6e, 0x64, 0x65,
-	0x72, 0x4e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x63,
-	0x76, 0x49, 0x44, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x63, 0x76, 0x49,
-	0x44, 0x12, 0x22, 0x0a, 0x0c, 0x72, 0x65, 0x63, 0x76, 0x4e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x63, 0x76, 0x4e, 0x69, 0x63,
-	0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44,
-	0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x12,
-	0x1c, 0x0a, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x09, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x47, 0x0a,
-	0x0f, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x72, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74,
-	0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e,
-	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6c, 0x69,
-	0x63, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0f, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x72, 0x55, 0x73,
-	0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65,
-	0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69,
-	0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f,
-	0x61, 0x64, 0x55, 0x52, 0x4c, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x6f, 0x77,
-	0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x55, 0x52, 0x4c, 0x22, 0x41, 0x0a, 0x11, 0x4f, 0x70, 0x65, 0x6e,
-	0x49, 0x4d, 0x43, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a,
-	0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
-	0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x22, 0x80, 0x05, 0x0a, 0x12,
-	0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x52, 0x65,
-	0x73, 0x70, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f,
-	0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x72, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x07, 0x65, 0x72, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1a, 0x0a, 0x08,
-	0x6e, 0x65, 0x78, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08,
-	0x6e, 0x65, 0x78, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x4d,
-	0x73, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x65, 0x72, 0x72, 0x4d, 0x73, 0x67,
-	0x12, 0x16, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x44, 0x6c, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x06, 0x65, 0x72, 0x72, 0x44, 0x6c, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64,
-	0x49, 0x44, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x49, 0x44,
-	0x12, 0x28, 0x0a, 0x0f, 0x63, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x43, 0x6f, 0x6d, 0x6d,
-	0x61, 0x6e, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x61, 0x6c, 0x6c, 0x62,
-	0x61, 0x63, 0x6b, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x73, 0x65,
-	0x72, 0x76, 0x65, 0x72, 0x4d, 0x73, 0x67, 0x49, 0x44, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0b, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4d, 0x73, 0x67, 0x49, 0x44, 0x12, 0x20, 0x0a, 0x0b,
-	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4d, 0x73, 0x67, 0x49, 0x44, 0x18, 0x09, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0b, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4d, 0x73, 0x67, 0x49, 0x44, 0x12, 0x2a,
-	0x0a, 0x10, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d,
-	0x49, 0x44, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72,
-	0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x49, 0x44, 0x12, 0x26, 0x0a, 0x0e, 0x73, 0x65,
-	0x6e, 0x64, 0x65, 0x72, 0x4e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x0b, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0e, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x4e, 0x69, 0x63, 0x6b, 0x6e, 0x61,
-	0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70,
-	0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
-	0x54, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x73, 0x67, 0x46, 0x72, 0x6f, 0x6d, 0x18,
-	0x0d, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x6d, 0x73, 0x67, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x20,
-	0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x18, 0x0e, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65,
-	0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x63, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74,
-	0x65, 0x6e, 0x74, 0x18, 0x11, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65,
-	0x6e, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x65, 0x71, 0x18, 0x12, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x03, 0x73, 0x65, 0x71, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x74, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x69,
-	0x73, 0x74, 0x18, 0x13, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x74, 0x55, 0x73, 0x65, 0x72,
-	0x4c, 0x69, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x66, 0x61, 0x63, 0x65, 0x55, 0x52, 0x4c, 0x18,
-	0x14, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x66, 0x61, 0x63, 0x65, 0x55, 0x52, 0x4c, 0x12, 0x0e,
-	0x0a, 0x02, 0x65, 0x78, 0x18, 0x15, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x65, 0x78, 0x22, 0xa5,
-	0x01, 0x0a, 0x15, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x55, 0x73, 0x65, 0x72, 0x46, 0x75, 0x6c,
-	0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x6b, 0x65, 0x79, 0x77,
-	0x6f, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6b, 0x65, 0x79, 0x77, 0x6f,
-	0x72, 0x64, 0x12, 0x40, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e,
-	0x73, 0x64, 0x6b, 0x77, 0x73, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x61,
-	0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x67, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x73, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x67, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x73, 0x12, 0x16,
-	0x0a, 0x06, 0x6e, 0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06,
-	0x6e, 0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x22, 0x61, 0x0a, 0x16, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68,
-	0x55, 0x73, 0x65, 0x72, 0x46, 0x75, 0x6c, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70,
-	0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52,
-	0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x31, 0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18,
-	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63,
-	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x46, 0x75, 0x6c, 0x6c, 0x49, 0x6e,
-	0x66, 0x6f, 0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x22, 0x3b, 0x0a, 0x11, 0x55, 0x73, 0x65,
-	0x72, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x12, 0x14,
-	0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x73,
-	0x74, 0x61, 0x72, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x03, 0x65, 0x6e, 0x64, 0x22, 0xd4, 0x01, 0x0a, 0x12, 0x55, 0x73, 0x65, 0x72, 0x4c,
-	0x6f, 0x67, 0x69, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x1e, 0x0a,
-	0x0a, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x0a, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x22, 0x0a,
-	0x0c, 0x75, 0x6e, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x0c, 0x75, 0x6e, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x43, 0x6f, 0x75, 0x6e,
-	0x74, 0x12, 0x40, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x2a, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x55,
-	0x73, 0x65, 0x72, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73,
-	0x70, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x1a, 0x38, 0x0a, 0x0a, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x45, 0x6e, 0x74, 0x72,
-	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
-	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x41, 0x0a,
-	0x09, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x68,
-	0x61, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63,
-	0x68, 0x61, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72,
-	0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44,
-	0x22, 0xa3, 0x01, 0x0a, 0x11, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x55, 0x73, 0x65, 0x72, 0x49,
-	0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64,
-	0x12, 0x40, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x73, 0x64,
-	0x6b, 0x77, 0x73, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x61, 0x67, 0x69,
-	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x67, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20,
-	0x03, 0x28, 0x05, 0x52, 0x07, 0x67, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x73, 0x12, 0x18, 0x0a, 0x07,
-	0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x75,
-	0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x22, 0x5d, 0x0a, 0x12, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68,
-	0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x12, 0x14, 0x0a, 0x05,
-	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x74, 0x6f, 0x74,
-	0x61, 0x6c, 0x12, 0x31, 0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x1b, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
-	0x6e, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x46, 0x75, 0x6c, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05,
-	0x75, 0x73, 0x65, 0x72, 0x73, 0x22, 0x4c, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x6b, 0x65,
-	0x6e, 0x46, 0x6f, 0x72, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x4d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67,
-	0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x72, 0x6f, 0x6f, 0x6d, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x64, 0x65, 0x6e, 0x74,
-	0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x64, 0x65, 0x6e, 0x74,
-	0x69, 0x74, 0x79, 0x22, 0x51, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x46,
-	0x6f, 0x72, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x4d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65,
-	0x73, 0x70, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x55, 0x72, 0x6c, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x55, 0x72, 0x6c,
-	0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x46, 0x0a, 0x11, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x55,
-	0x73, 0x65, 0x72, 0x45, 0x78, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x12, 0x31, 0x0a, 0x04, 0x75,
-	0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6f, 0x70, 0x65, 0x6e,
-	0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72,
-	0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x22, 0x50,
-	0x0a, 0x12, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x45, 0x78, 0x69, 0x73, 0x74,
-	0x52, 0x65, 0x73, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x69, 0x64, 0x12, 0x22, 0x0a, 0x0c,
-	0x69, 0x73, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x0c, 0x69, 0x73, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64,
-	0x22, 0x2d, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x55, 0x73, 0x65, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x22,
-	0x14, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x55, 0x73, 0x65, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x52, 0x65, 0x73, 0x70, 0x22, 0x18, 0x0a, 0x16, 0x67, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75,
-	0x70, 0x46, 0x72, 0x6f, 0x6d, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x52, 0x65, 0x71, 0x22,
-	0x35, 0x0a, 0x17, 0x67, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x46, 0x72, 0x6f, 0x6d, 0x43,
-	0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x1a, 0x0a, 0x08, 0x67, 0x72,
-	0x6f, 0x75, 0x70, 0x49, 0x44, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x67, 0x72,
-	0x6f, 0x75, 0x70, 0x49, 0x44, 0x73, 0x22, 0x33, 0x0a, 0x15, 0x73, 0x61, 0x76, 0x65, 0x47, 0x72,
-	0x6f, 0x75, 0x70, 0x54, 0x6f, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x52, 0x65, 0x71, 0x12,
-	0x1a, 0x0a, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x09, 0x52, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x73, 0x22, 0x18, 0x0a, 0x16, 0x73,
-	0x61, 0x76, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x54, 0x6f, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63,
-	0x74, 0x52, 0x65, 0x73, 0x70, 0x22, 0x37, 0x0a, 0x19, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x47,
-	0x72, 0x6f, 0x75, 0x70, 0x46, 0x72, 0x6f, 0x6d, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x52,
-	0x65, 0x71, 0x12, 0x1a, 0x0a, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x73, 0x22, 0x1c,
-	0x0a, 0x1a, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x46, 0x72, 0x6f,
-	0x6d, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x22, 0x28, 0x0a, 0x26,
-	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x70, 0x70, 0x6c, 0x69,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x72, 0x6f, 0x6d, 0x52, 0x65, 0x63, 0x69, 0x70, 0x69,
-	0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x22, 0x29, 0x0a, 0x27, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
-	0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x46, 0x72, 0x6f, 0x6d, 0x52, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73,
-	0x70, 0x22, 0x28, 0x0a, 0x26, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70,
-	0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x72, 0x6f, 0x6d, 0x41,
-	0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x22, 0x29, 0x0a, 0x27, 0x44,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x72, 0x6f, 0x6d, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61,
-	0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x22, 0x23, 0x0a, 0x21, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
-	0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x46, 0x72, 0x6f, 0x6d, 0x41, 0x6c, 0x6c, 0x74, 0x52, 0x65, 0x71, 0x22, 0x23, 0x0a, 0x21, 0x44,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x72, 0x6f, 0x6d, 0x41, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70,
-	0x22, 0xb3, 0x07, 0x0a, 0x04, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f, 0x73,
-	0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49,
-	0x44, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e,
-	0x74, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74,
-	0x65, 0x6e, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x6f,
-	0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x63, 0x6f, 0x6d, 0x6d, 0x65,
-	0x6e, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x66, 0x6f, 0x72, 0x77, 0x61,
-	0x72, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x66,
-	0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x6c,
-	0x69, 0x6b, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
-	0x6c, 0x69, 0x6b, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x61, 0x6c, 0x6c,
-	0x6f, 0x77, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x0c, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x22, 0x0a,
-	0x0c, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x18, 0x08, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x0c, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72,
-	0x64, 0x12, 0x18, 0x0a, 0x07, 0x69, 0x73, 0x4c, 0x69, 0x6b, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x07, 0x69, 0x73, 0x4c, 0x69, 0x6b, 0x65, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x69,
-	0x73, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x0b, 0x69, 0x73, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64, 0x12, 0x20, 0x0a,
-	0x0b, 0x69, 0x73, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x65, 0x64, 0x18, 0x0b, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x0b, 0x69, 0x73, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x65, 0x64, 0x12,
-	0x1e, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x0c, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12,
-	0x1e, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x0d, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12,
-	0x1c, 0x0a, 0x09, 0x61, 0x74, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x73, 0x18, 0x0e, 0x20, 0x03,
-	0x28, 0x09, 0x52, 0x09, 0x61, 0x74, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x73, 0x12, 0x39, 0x0a,
-	0x08, 0x75, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1d, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e,
-	0x55, 0x73, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x08,
-	0x75, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x36, 0x0a, 0x09, 0x6d, 0x65, 0x64, 0x69,
-	0x61, 0x4d, 0x73, 0x67, 0x73, 0x18, 0x10, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6f, 0x70,
-	0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x50, 0x6f, 0x73, 0x74,
-	0x4d, 0x65, 0x64, 0x69, 0x61, 0x52, 0x09, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x4d, 0x73, 0x67, 0x73,
-	0x12, 0x45, 0x0a, 0x0e, 0x61, 0x74, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x4c, 0x69,
-	0x73, 0x74, 0x18, 0x11, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69,
-	0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x50, 0x75, 0x62,
-	0x6c, 0x69, 0x63, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0e, 0x61, 0x74, 0x55, 0x73, 0x65, 0x72, 0x49,
-	0x6e, 0x66, 0x6f, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x66, 0x6f, 0x72, 0x77, 0x61,
-	0x72, 0x64, 0x50, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x18, 0x12, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
-	0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x50, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x12, 0x24, 0x0a,
-	0x0d, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x18, 0x13,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x6f, 0x73,
-	0x74, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x66, 0x50, 0x6f, 0x73, 0x74, 0x49, 0x44,
-	0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x66, 0x50, 0x6f, 0x73, 0x74, 0x49,
-	0x44, 0x12, 0x33, 0x0a, 0x0b, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x50, 0x6f, 0x73, 0x74,
-	0x18, 0x15, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e,
-	0x63, 0x68, 0x61, 0x74, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x0b, 0x66, 0x6f, 0x72, 0x77, 0x61,
-	0x72, 0x64, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x33, 0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
-	0x74, 0x50, 0x6f, 0x73, 0x74, 0x18, 0x16, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6f, 0x70,
-	0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x0b,
-	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x2b, 0x0a, 0x07, 0x72,
-	0x65, 0x66, 0x50, 0x6f, 0x73, 0x74, 0x18, 0x17, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6f,
-	0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x52,
-	0x07, 0x72, 0x65, 0x66, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x73, 0x50, 0x69,
-	0x6e, 0x6e, 0x65, 0x64, 0x18, 0x18, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x69, 0x73, 0x50, 0x69,
-	0x6e, 0x6e, 0x65, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x69, 0x73, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
-	0x74, 0x65, 0x64, 0x18, 0x19, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x69, 0x73, 0x43, 0x6f, 0x6d,
-	0x6d, 0x65, 0x6e, 0x74, 0x65, 0x64, 0x22, 0xe6, 0x01, 0x0a, 0x0e, 0x50, 0x75, 0x62, 0x6c, 0x69,
-	0x73, 0x68, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x12, 0x36, 0x0a, 0x07, 0x63, 0x6f, 0x6e,
-	0x74, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6f, 0x70, 0x65,
-	0x6e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72,
-	0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
-	0x74, 0x12, 0x22, 0x0a, 0x0c, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
-	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x43, 0x6f,
-	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x46, 0x6f,
-	0x72, 0x77, 0x61, 0x72, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x61, 0x6c, 0x6c,
-	0x6f, 0x77, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x74, 0x55,
-	0x73, 0x65, 0x72, 0x49, 0x64, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x61, 0x74,
-	0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x73, 0x12, 0x36, 0x0a, 0x09, 0x6d, 0x65, 0x64, 0x69, 0x61,
-	0x4d, 0x73, 0x67, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6f, 0x70, 0x65,
-	0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x4d,
-	0x65, 0x64, 0x69, 0x61, 0x52, 0x09, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x4d, 0x73, 0x67, 0x73, 0x22,
-	0x38, 0x0a, 0x0f, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65,
-	0x73, 0x70, 0x12, 0x25, 0x0a, 0x04, 0x70, 0x6f, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x11, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x50,
-	0x6f, 0x73, 0x74, 0x52, 0x04, 0x70, 0x6f, 0x73, 0x74, 0x22, 0x28, 0x0a, 0x0e, 0x47, 0x65, 0x74,
-	0x50, 0x6f, 0x73, 0x74, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x70,
-	0x6f, 0x73, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6f, 0x73,
-	0x74, 0x49, 0x44, 0x22, 0x38, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x42, 0x79,
-	0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x12, 0x25, 0x0a, 0x04, 0x70, 0x6f, 0x73, 0x74, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68,
-	0x61, 0x74, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x04, 0x70, 0x6f, 0x73, 0x74, 0x22, 0x29, 0x0a,
-	0x11, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52,
-	0x65, 0x71, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x48, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x41,
-	0x6c, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x32,
-	0x0a, 0x08, 0x61, 0x6c, 0x6c, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x16, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x54,
-	0x79, 0x70, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x08, 0x61, 0x6c, 0x6c, 0x50, 0x6f, 0x73,
-	0x74, 0x73, 0x22, 0x68, 0x0a, 0x09, 0x54, 0x79, 0x70, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x12,
-	0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x74,
-	0x79, 0x70, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f,
-	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72,
-	0x73, 0x6f, 0x72, 0x12, 0x27, 0x0a, 0x05, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74,
-	0x2e, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x05, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x22, 0x5a, 0x0a, 0x0e,
-	0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x12, 0x1e,
-	0x0a, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x14,
-	0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x5a, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x50,
-	0x6f, 0x73, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x1e, 0x0a, 0x0a, 0x6e,
-	0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x27, 0x0a, 0x05, 0x70,
-	0x6f, 0x73, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6f, 0x70, 0x65,
-	0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x05, 0x70,
-	0x6f, 0x73, 0x74, 0x73, 0x22, 0x64, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x4c,
-	0x69, 0x73, 0x74, 0x42, 0x79, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06,
-	0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73,
-	0x65, 0x72, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73,
-	0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75,
-	0x72, 0x73, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x60, 0x0a, 0x15, 0x47, 0x65,
-	0x74, 0x50, 0x6f, 0x73, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x79, 0x55, 0x73, 0x65, 0x72, 0x52,
-	0x65, 0x73, 0x70, 0x12, 0x1e, 0x0a, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f,
-	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72,
-	0x73, 0x6f, 0x72, 0x12, 0x27, 0x0a, 0x05, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74,
-	0x2e, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x05, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x22, 0x6d, 0x0a, 0x1d,
-	0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x4c, 0x69,
-	0x73, 0x74, 0x42, 0x79, 0x50, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a,
-	0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70,
-	0x6f, 0x73, 0x74, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72,
-	0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43,
-	0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x69, 0x0a, 0x1e, 0x47,
-	0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x4c, 0x69, 0x73,
-	0x74, 0x42, 0x79, 0x50, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x12, 0x1e, 0x0a,
-	0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x27, 0x0a,
-	0x05, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6f,
-	0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x52,
-	0x05, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x22, 0x27, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
-	0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49,
-	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x22,
-	0x10, 0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73,
-	0x70, 0x22, 0x33, 0x0a, 0x19, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x41, 0x6c, 0x6c, 0x6f, 0x77,
-	0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x12, 0x16,
-	0x0a, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x70, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x22, 0x58, 0x0a, 0x1a, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65,
-	0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x6f, 0x73, 0x74,
-	0x52, 0x65, 0x73, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c,
-	0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x0c, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
-	0x22, 0x33, 0x0a, 0x19, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x46,
-	0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a,
-	0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70,
-	0x6f, 0x73, 0x74, 0x49, 0x44, 0x22, 0x58, 0x0a, 0x1a, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x41,
-	0x6c, 0x6c, 0x6f, 0x77, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x50, 0x6f, 0x73, 0x74, 0x52,
-	0x65, 0x73, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c, 0x61,
-	0x6c, 0x6c, 0x6f, 0x77, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x0c, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x22,
-	0x3f, 0x0a, 0x0b, 0x4c, 0x69, 0x6b, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x12, 0x16,
-	0x0a, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x70, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x69, 0x73, 0x4c, 0x69, 0x6b, 0x65,
-	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x69, 0x73, 0x4c, 0x69, 0x6b, 0x65, 0x64,
-	0x22, 0x28, 0x0a, 0x0c, 0x4c, 0x69, 0x6b, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70,
-	0x12, 0x18, 0x0a, 0x07, 0x69, 0x73, 0x4c, 0x69, 0x6b, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x07, 0x69, 0x73, 0x4c, 0x69, 0x6b, 0x65, 0x64, 0x22, 0x4a, 0x0a, 0x0e, 0x43, 0x6f,
-	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06,
-	0x70, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6f,
-	0x73, 0x74, 0x49, 0x44, 0x12, 0x20, 0x0a, 0x0b, 0x69, 0x73, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
-	0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x69, 0x73, 0x43, 0x6f, 0x6c,
-	0x6c, 0x65, 0x63, 0x74, 0x65, 0x64, 0x22, 0x33, 0x0a, 0x0f, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
-	0x74, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x20, 0x0a, 0x0b, 0x69, 0x73, 0x43,
-	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b,
-	0x69, 0x73, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64, 0x22, 0x58, 0x0a, 0x0e, 0x46,
-	0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x12, 0x24, 0x0a,
-	0x0d, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x50, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x50, 0x6f, 0x73,
-	0x74, 0x49, 0x44, 0x12, 0x20, 0x0a, 0x0b, 0x69, 0x73, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64,
-	0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x69, 0x73, 0x46, 0x6f, 0x72, 0x77,
-	0x61, 0x72, 0x64, 0x65, 0x64, 0x22, 0x33, 0x0a, 0x0f, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64,
-	0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x20, 0x0a, 0x0b, 0x69, 0x73, 0x46, 0x6f,
-	0x72, 0x77, 0x61, 0x72, 0x64, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x69,
-	0x73, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x65, 0x64, 0x22, 0x86, 0x02, 0x0a, 0x10, 0x52,
-	0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x12,
-	0x1c, 0x0a, 0x09, 0x72, 0x65, 0x66, 0x50, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x66, 0x50, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x12, 0x36, 0x0a,
-	0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c,
-	0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x07, 0x63, 0x6f,
-	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x43, 0x6f,
-	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x61, 0x6c, 0x6c,
-	0x6f, 0x77, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x61, 0x6c, 0x6c,
-	0x6f, 0x77, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x0c, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x12, 0x1c, 0x0a,
-	0x09, 0x61, 0x74, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09,
-	0x52, 0x09, 0x61, 0x74, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x73, 0x12, 0x36, 0x0a, 0x09, 0x6d,
-	0x65, 0x64, 0x69, 0x61, 0x4d, 0x73, 0x67, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18,
-	0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x50,
-	0x6f, 0x73, 0x74, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x52, 0x09, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x4d,
-	0x73, 0x67, 0x73, 0x22, 0x13, 0x0a, 0x11, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
-	0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x22, 0x8c, 0x02, 0x0a, 0x0e, 0x43, 0x6f, 0x6d,
-	0x6d, 0x65, 0x6e, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x12, 0x24, 0x0a, 0x0d, 0x63,
-	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0d, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x49,
-	0x44, 0x12, 0x36, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65,
-	0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x61, 0x6c, 0x6c,
-	0x6f, 0x77, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x0c, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x22, 0x0a,
-	0x0c, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x0c, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72,
-	0x64, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x74, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x73, 0x18, 0x05,
-	0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x61, 0x74, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x73, 0x12,
-	0x36, 0x0a, 0x09, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x4d, 0x73, 0x67, 0x73, 0x18, 0x06, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
-	0x6f, 0x6e, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x52, 0x09, 0x6d, 0x65,
-	0x64, 0x69, 0x61, 0x4d, 0x73, 0x67, 0x73, 0x22, 0x38, 0x0a, 0x0f, 0x43, 0x6f, 0x6d, 0x6d, 0x65,
-	0x6e, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x25, 0x0a, 0x04, 0x70, 0x6f,
-	0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69,
-	0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x04, 0x70, 0x6f, 0x73,
-	0x74, 0x22, 0x40, 0x0a, 0x0a, 0x50, 0x69, 0x6e, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x12,
-	0x16, 0x0a, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x73, 0x50, 0x69, 0x6e,
-	0x6e, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x69, 0x73, 0x50, 0x69, 0x6e,
-	0x6e, 0x65, 0x64, 0x22, 0x0d, 0x0a, 0x0b, 0x50, 0x69, 0x6e, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65,
-	0x73, 0x70, 0x22, 0x2d, 0x0a, 0x0f, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x56, 0x65, 0x72, 0x73, 0x69,
-	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67,
-	0x65, 0x22, 0xaa, 0x02, 0x0a, 0x10, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x56, 0x65, 0x72, 0x73, 0x69,
-	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x22, 0x0a, 0x0c, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x56,
-	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x62, 0x75,
-	0x69, 0x6c, 0x64, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x6f,
-	0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x55, 0x52, 0x4c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0b, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x55, 0x52, 0x4c, 0x12, 0x2a, 0x0a, 0x10,
-	0x62, 0x75, 0x69, 0x6c, 0x64, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x69, 0x74, 0x6c, 0x65,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x40, 0x0a, 0x1b, 0x62, 0x75, 0x69, 0x6c,
-	0x64, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x1b, 0x62,
-	0x75, 0x69, 0x6c, 0x64, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x38, 0x0a, 0x17, 0x62, 0x75,
-	0x69, 0x6c, 0x64, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x17, 0x62, 0x75, 0x69,
-	0x6c, 0x64, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x6e, 0x65, 0x65, 0x64, 0x46, 0x6f, 0x72, 0x63,
-	0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x6e,
-	0x65, 0x65, 0x64, 0x46, 0x6f, 0x72, 0x63, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x22, 0x10,
-	0x0a, 0x0e, 0x47, 0x65, 0x74, 0x46, 0x61, 0x6b, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71,
-	0x22, 0x3f, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x46, 0x61, 0x6b, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52,
-	0x65, 0x73, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x06, 0x6f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74,
-	0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61,
-	0x6c, 0x32, 0x8b, 0x20, 0x0a, 0x04, 0x63, 0x68, 0x61, 0x74, 0x12, 0x51, 0x0a, 0x0e, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1e, 0x2e, 0x6f,
-	0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x1a, 0x1f, 0x2e, 0x6f,
-	0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x12, 0x51, 0x0a,
-	0x0e, 0x41, 0x64, 0x64, 0x55, 0x73, 0x65, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12,
-	0x1e, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x41, 0x64,
-	0x64, 0x55, 0x73, 0x65, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x1a,
-	0x1f, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x41, 0x64,
-	0x64, 0x55, 0x73, 0x65, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70,
-	0x12, 0x63, 0x0a, 0x14, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x55, 0x73, 0x65, 0x72, 0x50, 0x75,
-	0x62, 0x6c, 0x69, 0x63, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x24, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69,
-	0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x55, 0x73, 0x65,
-	0x72, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x1a, 0x25,
-	0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x53, 0x65, 0x61,
-	0x72, 0x63, 0x68, 0x55, 0x73, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x49, 0x6e, 0x66,
-	0x6f, 0x52, 0x65, 0x73, 0x70, 0x12, 0x5d, 0x0a, 0x12, 0x46, 0x69, 0x6e, 0x64, 0x55, 0x73, 0x65,
-	0x72, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x22, 0x2e, 0x6f, 0x70,
-	0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x55, 0x73,
-	0x65, 0x72, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x1a,
-	0x23, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x46, 0x69,
-	0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x49, 0x6e, 0x66, 0x6f,
-	0x52, 0x65, 0x73, 0x70, 0x12, 0x72, 0x0a, 0x1a, 0x46, 0x69, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72,
-	0x42, 0x79, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x4f, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x12, 0x2a, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74,
-	0x2e, 0x46, 0x69, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x42, 0x79, 0x41, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x4f, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x28,
-	0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x46, 0x69, 0x6e,
-	0x64, 0x55, 0x73, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x49, 0x6e, 0x66, 0x6f, 0x52,
-	0x65, 0x73, 0x70, 0x4f, 0x66, 0x4f, 0x6e, 0x65, 0x12, 0x5d, 0x0a, 0x12, 0x53, 0x65, 0x61, 0x72,
-	0x63, 0x68, 0x55, 0x73, 0x65, 0x72, 0x46, 0x75, 0x6c, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x22,
-	0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x53, 0x65, 0x61,
-	0x72, 0x63, 0x68, 0x55, 0x73, 0x65, 0x72, 0x46, 0x75, 0x6c, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52,
-	0x65, 0x71, 0x1a, 0x23, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74,
-	0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x55, 0x73, 0x65, 0x72, 0x46, 0x75, 0x6c, 0x6c, 0x49,
-	0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x12, 0x57, 0x0a, 0x10, 0x46, 0x69, 0x6e, 0x64, 0x55,
-	0x73, 0x65, 0x72, 0x46, 0x75, 0x6c, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x20, 0x2e, 0x6f, 0x70,
-	0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x55, 0x73,
-	0x65, 0x72, 0x46, 0x75, 0x6c, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x1a, 0x21, 0x2e,
-	0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x46, 0x69, 0x6e, 0x64,
-	0x55, 0x73, 0x65, 0x72, 0x46, 0x75, 0x6c, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70,
-	0x12, 0x51, 0x0a, 0x0e, 0x53, 0x65, 0x6e, 0x64, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x43, 0x6f,
-	0x64, 0x65, 0x12, 0x1e, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74,
-	0x2e, 0x53, 0x65, 0x6e, 0x64, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x52,
-	0x65, 0x71, 0x1a, 0x1f, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74,
-	0x2e, 0x53, 0x65, 0x6e, 0x64, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x52,
-	0x65, 0x73, 0x70, 0x12, 0x45, 0x0a, 0x0a, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x43, 0x6f, 0x64,
-	0x65, 0x12, 0x1a, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e,
-	0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x1b, 0x2e,
-	0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x56, 0x65, 0x72, 0x69,
-	0x66, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x51, 0x0a, 0x0e, 0x43, 0x68,
-	0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x1e, 0x2e, 0x6f,
-	0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x43, 0x68, 0x61, 0x6c, 0x6c,
-	0x65, 0x6e, 0x67, 0x65, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x1f, 0x2e, 0x6f,
-	0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x43, 0x68, 0x61, 0x6c, 0x6c,
-	0x65, 0x6e, 0x67, 0x65, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x4b, 0x0a,
-	0x0c, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x55, 0x73, 0x65, 0x72, 0x12, 0x1c, 0x2e,
-	0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x52, 0x65, 0x67, 0x69,
-	0x73, 0x74, 0x65, 0x72, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x1d, 0x2e, 0x6f, 0x70,
-	0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74,
-	0x65, 0x72, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x12, 0x36, 0x0a, 0x05, 0x4c, 0x6f,
-	0x67, 0x69, 0x6e, 0x12, 0x15, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61,
-	0x74, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x6f, 0x70, 0x65,
-	0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65,
-	0x73, 0x70, 0x12, 0x4e, 0x0a, 0x0d, 0x52, 0x65, 0x73, 0x65, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77,
-	0x6f, 0x72, 0x64, 0x12, 0x1d, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61,
-	0x74, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52,
-	0x65, 0x71, 0x1a, 0x1e, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74,
-	0x2e, 0x52, 0x65, 0x73, 0x65, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65,
-	0x73, 0x70, 0x12, 0x51, 0x0a, 0x0e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x61, 0x73, 0x73,
-	0x77, 0x6f, 0x72, 0x64, 0x12, 0x1e, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68,
-	0x61, 0x74, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
-	0x64, 0x52, 0x65, 0x71, 0x1a, 0x1f, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68,
-	0x61, 0x74, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
-	0x64, 0x52, 0x65, 0x73, 0x70, 0x12, 0x51, 0x0a, 0x0e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x55, 0x73,
-	0x65, 0x72, 0x45, 0x78, 0x69, 0x73, 0x74, 0x12, 0x1e, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d,
-	0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x45,
-	0x78, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x1f, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d,
-	0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x45,
-	0x78, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x51, 0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x55,
-	0x73, 0x65, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1e, 0x2e, 0x6f, 0x70, 0x65,
-	0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x44, 0x65, 0x6c, 0x55, 0x73, 0x65, 0x72,
-	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x1f, 0x2e, 0x6f, 0x70, 0x65,
-	0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x44, 0x65, 0x6c, 0x55, 0x73, 0x65, 0x72,
-	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x54, 0x0a, 0x0f, 0x46,
-	0x69, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1f,
-	0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x46, 0x69, 0x6e,
-	0x64, 0x55, 0x73, 0x65, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x1a,
-	0x20, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x46, 0x69,
-	0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73,
-	0x70, 0x12, 0x54, 0x0a, 0x0f, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x55, 0x73, 0x65, 0x72, 0x12, 0x1f, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68,
-	0x61, 0x74, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x55, 0x73,
-	0x65, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x20, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63,
-	0x68, 0x61, 0x74, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x55,
-	0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x12, 0x51, 0x0a, 0x0e, 0x4f, 0x70, 0x65, 0x6e, 0x49,
-	0x4d, 0x43, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x12, 0x1e, 0x2e, 0x6f, 0x70, 0x65, 0x6e,
-	0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x61,
-	0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x1a, 0x1f, 0x2e, 0x6f, 0x70, 0x65, 0x6e,
-	0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x61,
-	0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x12, 0x51, 0x0a, 0x0e, 0x55, 0x73,
-	0x65, 0x72, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1e, 0x2e, 0x6f,
-	0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x4c,
-	0x6f, 0x67, 0x69, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x1f, 0x2e, 0x6f,
-	0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x4c,
-	0x6f, 0x67, 0x69, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x51, 0x0a,
-	0x0e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12,
-	0x1e, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x53, 0x65,
-	0x61, 0x72, 0x63, 0x68, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x1a,
-	0x1f, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x53, 0x65,
-	0x61, 0x72, 0x63, 0x68, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70,
-	0x12, 0x6c, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x46, 0x6f, 0x72, 0x56,
-	0x69, 0x64, 0x65, 0x6f, 0x4d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x27, 0x2e, 0x6f, 0x70,
-	0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x6b,
-	0x65, 0x6e, 0x46, 0x6f, 0x72, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x4d, 0x65, 0x65, 0x74, 0x69, 0x6e,
-	0x67, 0x52, 0x65, 0x71, 0x1a, 0x28, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68,
-	0x61, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x46, 0x6f, 0x72, 0x56, 0x69,
-	0x64, 0x65, 0x6f, 0x4d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x12, 0x5d,
-	0x0a, 0x12, 0x73, 0x61, 0x76, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x54, 0x6f, 0x43, 0x6f, 0x6e,
-	0x74, 0x61, 0x63, 0x74, 0x12, 0x22, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68,
-	0x61, 0x74, 0x2e, 0x73, 0x61, 0x76, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x54, 0x6f, 0x43, 0x6f,
-	0x6e, 0x74, 0x61, 0x63, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x23, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69,
-	0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x73, 0x61, 0x76, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70,
-	0x54, 0x6f, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x69, 0x0a,
-	0x16, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x46, 0x72, 0x6f, 0x6d,
-	0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x12, 0x26, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d,
-	0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75,
-	0x70, 0x46, 0x72, 0x6f, 0x6d, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x52, 0x65, 0x71, 0x1a,
-	0x27, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x64, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x46, 0x72, 0x6f, 0x6d, 0x43, 0x6f, 0x6e,
-	0x74, 0x61, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x60, 0x0a, 0x13, 0x67, 0x65, 0x74, 0x47,
-	0x72, 0x6f, 0x75, 0x70, 0x46, 0x72, 0x6f, 0x6d, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x12,
-	0x23, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x67, 0x65,
-	0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x46, 0x72, 0x6f, 0x6d, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63,
-	0x74, 0x52, 0x65, 0x71, 0x1a, 0x24, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68,
-	0x61, 0x74, 0x2e, 0x67, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x46, 0x72, 0x6f, 0x6d, 0x43,
-	0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x94, 0x01, 0x0a, 0x27, 0x64,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x70,
-	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x72, 0x6f, 0x6d, 0x52, 0x65, 0x63,
-	0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x33, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e,
-	0x63, 0x68, 0x61, 0x74, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70,
-	0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x72, 0x6f, 0x6d, 0x52,
-	0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x34, 0x2e, 0x6f, 0x70,
-	0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
-	0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x46, 0x72, 0x6f, 0x6d, 0x52, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73,
-	0x70, 0x12, 0x94, 0x01, 0x0a, 0x27, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72,
-	0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x46, 0x72, 0x6f, 0x6d, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x6e, 0x74, 0x12, 0x33, 0x2e,
-	0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x46, 0x72, 0x6f, 0x6d, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x6e, 0x74, 0x52,
-	0x65, 0x71, 0x1a, 0x34, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74,
-	0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x70, 0x70, 0x6c,
-	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x72, 0x6f, 0x6d, 0x41, 0x70, 0x70, 0x6c, 0x69,
-	0x63, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x83, 0x01, 0x0a, 0x21, 0x64, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x70, 0x70, 0x6c,
-	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x72, 0x6f, 0x6d, 0x41, 0x6c, 0x6c, 0x12, 0x2e,
-	0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x44, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x46, 0x72, 0x6f, 0x6d, 0x41, 0x6c, 0x6c, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x2e,
-	0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x44, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x46, 0x72, 0x6f, 0x6d, 0x41, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x12, 0x4e,
-	0x0a, 0x0d, 0x67, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x12,
-	0x1d, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x47, 0x65,
-	0x74, 0x41, 0x6c, 0x6c, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x1e,
-	0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x47, 0x65, 0x74,
-	0x41, 0x6c, 0x6c, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x48,
-	0x0a, 0x0b, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x1b, 0x2e,
-	0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x50, 0x75, 0x62, 0x6c,
-	0x69, 0x73, 0x68, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x1c, 0x2e, 0x6f, 0x70, 0x65,
-	0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68,
-	0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x51, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x41,
-	0x6c, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x1e, 0x2e, 0x6f, 0x70, 0x65,
-	0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x54,
-	0x79, 0x70, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x1f, 0x2e, 0x6f, 0x70, 0x65,
-	0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x54,
-	0x79, 0x70, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x48, 0x0a, 0x0b, 0x47,
-	0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1b, 0x2e, 0x6f, 0x70, 0x65,
-	0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74,
-	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x1c, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d,
-	0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x4c, 0x69, 0x73,
-	0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x5a, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74,
-	0x4c, 0x69, 0x73, 0x74, 0x42, 0x79, 0x55, 0x73, 0x65, 0x72, 0x12, 0x21, 0x2e, 0x6f, 0x70, 0x65,
-	0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74,
-	0x4c, 0x69, 0x73, 0x74, 0x42, 0x79, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x22, 0x2e,
-	0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x50,
-	0x6f, 0x73, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x79, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73,
-	0x70, 0x12, 0x75, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x50,
-	0x6f, 0x73, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x79, 0x50, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x12,
-	0x2a, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x47, 0x65,
-	0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x4c, 0x69, 0x73, 0x74,
-	0x42, 0x79, 0x50, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x52, 0x65, 0x71, 0x1a, 0x2b, 0x2e, 0x6f, 0x70,
-	0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d,
-	0x6d, 0x65, 0x6e, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x79, 0x50, 0x6f,
-	0x73, 0x74, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x12, 0x48, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x50,
-	0x6f, 0x73, 0x74, 0x42, 0x79, 0x49, 0x44, 0x12, 0x1b, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d,
-	0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x42, 0x79, 0x49,
-	0x44, 0x52, 0x65, 0x71, 0x1a, 0x1c, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68,
-	0x61, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65,
-	0x73, 0x70, 0x12, 0x45, 0x0a, 0x0a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74,
-	0x12, 0x1a, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x44,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x1b, 0x2e, 0x6f,
-	0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x69, 0x0a, 0x16, 0x43, 0x68, 0x61,
-	0x6e, 0x67, 0x65, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x50,
-	0x6f, 0x73, 0x74, 0x12, 0x26, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61,
-	0x74, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x43, 0x6f, 0x6d,
-	0x6d, 0x65, 0x6e, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x27, 0x2e, 0x6f, 0x70,
-	0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65,
-	0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x6f, 0x73, 0x74,
-	0x52, 0x65, 0x73, 0x70, 0x12, 0x69, 0x0a, 0x16, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x41, 0x6c,
-	0x6c, 0x6f, 0x77, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x26,
-	0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x43, 0x68, 0x61,
-	0x6e, 0x67, 0x65, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x50,
-	0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x27, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e,
-	0x63, 0x68, 0x61, 0x74, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x41, 0x6c, 0x6c, 0x6f, 0x77,
-	0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12,
-	0x45, 0x0a, 0x0e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x4c, 0x69, 0x6b, 0x65, 0x50, 0x6f, 0x73,
-	0x74, 0x12, 0x18, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e,
-	0x4c, 0x69, 0x6b, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x19, 0x2e, 0x6f, 0x70,
-	0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x4c, 0x69, 0x6b, 0x65, 0x50, 0x6f,
-	0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x4e, 0x0a, 0x11, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65,
-	0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x1b, 0x2e, 0x6f, 0x70,
-	0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
-	0x74, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x1c, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69,
-	0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x50, 0x6f,
-	0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x3c, 0x0a, 0x07, 0x50, 0x69, 0x6e, 0x50, 0x6f, 0x73,
-	0x74, 0x12, 0x17, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e,
-	0x50, 0x69, 0x6e, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x18, 0x2e, 0x6f, 0x70, 0x65,
-	0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x50, 0x69, 0x6e, 0x50, 0x6f, 0x73, 0x74,
-	0x52, 0x65, 0x73, 0x70, 0x12, 0x48, 0x0a, 0x0b, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x50,
-	0x6f, 0x73, 0x74, 0x12, 0x1b, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61,
-	0x74, 0x2e, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71,
-	0x1a, 0x1c, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x46,
-	0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x48,
-	0x0a, 0x0b, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x1b, 0x2e,
-	0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x43, 0x6f, 0x6d, 0x6d,
-	0x65, 0x6e, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x1c, 0x2e, 0x6f, 0x70, 0x65,
-	0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
-	0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x4e, 0x0a, 0x0d, 0x52, 0x65, 0x66, 0x65,
-	0x72, 0x65, 0x6e, 0x63, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x1d, 0x2e, 0x6f, 0x70, 0x65, 0x6e,
-	0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
-	0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x1e, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69,
-	0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
-	0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x4b, 0x0a, 0x0c, 0x43, 0x68, 0x65, 0x63,
-	0x6b, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69,
-	0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x56, 0x65, 0x72, 0x73,
-	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x1d, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e,
-	0x63, 0x68, 0x61, 0x74, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
-	0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x48, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x46, 0x61, 0x6b, 0x65,
-	0x55, 0x73, 0x65, 0x72, 0x12, 0x1b, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68,
-	0x61, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x61, 0x6b, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65,
-	0x71, 0x1a, 0x1c, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e,
-	0x47, 0x65, 0x74, 0x46, 0x61, 0x6b, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x42,
-	0x2d, 0x5a, 0x2b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6f, 0x70,
-	0x65, 0x6e, 0x69, 0x6d, 0x73, 0x64, 0x6b, 0x2f, 0x63, 0x68, 0x61, 0x74, 0x2f, 0x70, 0x6b, 0x67,
-	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x63, 0x68, 0x61, 0x74, 0x62, 0x06,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	UserID     string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID"`
+	NextCursor int64  `protobuf:"varint,2,opt,name=nextCursor,proto3" json:"nextCursor"`
+	Count      int32  `protobuf:"varint,3,opt,name=count,proto3" json:"count"`
 }
 
-var (
-	file_chat_chat_proto_rawDescOnce sync.Once
-	file_chat_chat_proto_rawDescData = file_chat_chat_proto_rawDesc
-)
-
-func file_chat_chat_proto_rawDescGZIP() []byte {
-	file_chat_chat_proto_rawDescOnce.Do(func() {
-		file_chat_chat_proto_rawDescData = protoimpl.X.CompressGZIP(file_chat_chat_proto_rawDescData)
-	})
-	return file_chat_chat_proto_rawDescData
+func (x *GetPostListByUserReq) Reset() {
+	*x = GetPostListByUserReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[100]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-var file_chat_chat_proto_msgTypes = make([]protoimpl.MessageInfo, 103)
-var file_chat_chat_proto_goTypes = []any{
-	(*OnlineTime)(nil),                              // 0: openim.chat.onlineTime
-	(*GetUsersTimeReq)(nil),                         // 1: openim.chat.getUsersTimeReq
-	(*GetUsersTimeResp)(nil),                        // 2: openim.chat.getUsersTimeResp
-	(*UserIdentity)(nil),                            // 3: openim.chat.UserIdentity
-	(*UpdateUserInfoReq)(nil),                       // 4: openim.chat.UpdateUserInfoReq
-	(*UpdateUserInfoResp)(nil),                      // 5: openim.chat.UpdateUserInfoResp
-	(*FindUserPublicInfoReq)(nil),                   // 6: openim.chat.FindUserPublicInfoReq
-	(*FindUserPublicInfoRespOfOne)(nil),             // 7: openim.chat.FindUserPublicInfoRespOfOne
-	(*FindUserPublicInfoResp)(nil),                  // 8: openim.chat.FindUserPublicInfoResp
-	(*SearchUserPublicInfoReq)(nil),                 // 9: openim.chat.SearchUserPublicInfoReq
-	(*SearchUserPublicInfoResp)(nil),                // 10: openim.chat.SearchUserPublicInfoResp
-	(*FindUserFullInfoReq)(nil),                     // 11: openim.chat.FindUserFullInfoReq
-	(*FindUserFullInfoResp)(nil),                    // 12: openim.chat.FindUserFullInfoResp
-	(*GetAllUserIDsReq)(nil),                        // 13: openim.chat.GetAllUserIDsReq
-	(*GetAllUserIDsResp)(nil),                       // 14: openim.chat.GetAllUserIDsResp
-	(*SendVerifyCodeReq)(nil),                       // 15: openim.chat.SendVerifyCodeReq
-	(*SendVerifyCodeResp)(nil),                      // 16: openim.chat.SendVerifyCodeResp
-	(*VerifyCodeReq)(nil),                           // 17: openim.chat.VerifyCodeReq
-	(*VerifyCodeResp)(nil),                          // 18: openim.chat.VerifyCodeResp
-	(*ChallengeNonceReq)(nil),                       // 19: openim.chat.ChallengeNonceReq
-	(*ChallengeNonceResp)(nil),                      // 20: openim.chat.ChallengeNonceResp
-	(*RegisterUserInfo)(nil),                        // 21: openim.chat.RegisterUserInfo
-	(*RegisterUserReq)(nil),                         // 22: openim.chat.RegisterUserReq
-	(*RegisterUserResp)(nil),                        // 23: openim.chat.RegisterUserResp
-	(*AddUserAccountReq)(nil),                       // 24: openim.chat.AddUserAccountReq
-	(*AddUserAccountResp)(nil),                      // 25: openim.chat.AddUserAccountResp
-	(*LoginReq)(nil),                                // 26: openim.chat.LoginReq
-	(*ResetPasswordReq)(nil),                        // 27: openim.chat.ResetPasswordReq
-	(*ResetPasswordResp)(nil),                       // 28: openim.chat.ResetPasswordResp
-	(*ChangePasswordReq)(nil),                       // 29: openim.chat.ChangePasswordReq
-	(*ChangePasswordResp)(nil),                      // 30: openim.chat.ChangePasswordResp
-	(*FindUserAccountReq)(nil),                      // 31: openim.chat.FindUserAccountReq
-	(*FindUserByAddressOrAccountReq)(nil),           // 32: openim.chat.FindUserByAddressOrAccountReq
-	(*FindUserAccountResp)(nil),                     // 33: openim.chat.FindUserAccountResp
-	(*FindAccountUserReq)(nil),                      // 34: openim.chat.FindAccountUserReq
-	(*FindAccountUserResp)(nil),                     // 35: openim.chat.FindAccountUserResp
-	(*SignalRecord)(nil),                            // 36: openim.chat.SignalRecord
-	(*OpenIMCallbackReq)(nil),                       // 37: openim.chat.OpenIMCallbackReq
-	(*OpenIMCallbackResp)(nil),                      // 38: openim.chat.OpenIMCallbackResp
-	(*SearchUserFullInfoReq)(nil),                   // 39: openim.chat.SearchUserFullInfoReq
-	(*SearchUserFullInfoResp)(nil),                  // 40: openim.chat.SearchUserFullInfoResp
-	(*UserLoginCountReq)(nil),                       // 41: openim.chat.UserLoginCountReq
-	(*UserLoginCountResp)(nil),                      // 42: openim.chat.UserLoginCountResp
-	(*LoginResp)(nil),                               // 43: openim.chat.LoginResp
-	(*SearchUserInfoReq)(nil),                       // 44: openim.chat.SearchUserInfoReq
-	(*SearchUserInfoResp)(nil),                      // 45: openim.chat.SearchUserInfoResp
-	(*GetTokenForVideoMeetingReq)(nil),              // 46: openim.chat.GetTokenForVideoMeetingReq
-	(*GetTokenForVideoMeetingResp)(nil),             // 47: openim.chat.GetTokenForVideoMeetingResp
-	(*CheckUserExistReq)(nil),                       // 48: openim.chat.CheckUserExistReq
-	(*CheckUserExistResp)(nil),                      // 49: openim.chat.CheckUserExistResp
-	(*DelUserAccountReq)(nil),                       // 50: openim.chat.DelUserAccountReq
-	(*DelUserAccountResp)(nil),                      // 51: openim.chat.DelUserAccountResp
-	(*GetGroupFromContactReq)(nil),                  // 52: openim.chat.getGroupFromContactReq
-	(*GetGroupFromContactResp)(nil),                 // 53: openim.chat.getGroupFromContactResp
-	(*SaveGroupToContactReq)(nil),                   // 54: openim.chat.saveGroupToContactReq
-	(*SaveGroupToContactResp)(nil),                  // 55: openim.chat.saveGroupToContactResp
-	(*DeleteGroupFromContactReq)(nil),               // 56: openim.chat.deleteGroupFromContactReq
-	(*DeleteGroupFromContactResp)(nil),              // 57: openim.chat.deleteGroupFromContactResp
-	(*DeleteGroupApplicationFromRecipientReq)(nil),  // 58: openim.chat.DeleteGroupApplicationFromRecipientReq
-	(*DeleteGroupApplicationFromRecipientResp)(nil), // 59: openim.chat.DeleteGroupApplicationFromRecipientResp
-	(*DeleteGroupApplicationFromApplicantReq)(nil),  // 60: openim.chat.DeleteGroupApplicationFromApplicantReq
-	(*DeleteGroupApplicationFromApplicantResp)(nil), // 61: openim.chat.DeleteGroupApplicationFromApplicantResp
-	(*DeleteGroupApplicationFromAlltReq)(nil),       // 62: openim.chat.DeleteGroupApplicationFromAlltReq
-	(*DeleteGroupApplicationFromAllResp)(nil),       // 63: openim.chat.DeleteGroupApplicationFromAllResp
-	(*Post)(nil),                                    // 64: openim.chat.Post
-	(*PublishPostReq)(nil),                          // 65: openim.chat.PublishPostReq
-	(*PublishPostResp)(nil),                         // 66: openim.chat.PublishPostResp
-	(*GetPostByIDReq)(nil),                          // 67: openim.chat.GetPostByIDReq
-	(*GetPostByIDResp)(nil),                         // 68: openim.chat.GetPostByIDResp
-	(*GetAllTypePostReq)(nil),                       // 69: openim.chat.GetAllTypePostReq
-	(*GetAllTypePostResp)(nil),                      // 70: openim.chat.GetAllTypePostResp
-	(*TypePosts)(nil),                               // 71: openim.chat.TypePosts
-	(*GetPostListReq)(nil),                          // 72: openim.chat.GetPostListReq
-	(*GetPostListResp)(nil),                         // 73: openim.chat.GetPostListResp
-	(*GetPostListByUserReq)(nil),                    // 74: openim.chat.GetPostListByUserReq
-	(*GetPostListByUserResp)(nil),                   // 75: openim.chat.GetPostListByUserResp
-	(*GetCommentPostListByPostIDReq)(nil),           // 76: openim.chat.GetCommentPostListByPostIDReq
-	(*GetCommentPostListByPostIDResp)(nil),          // 77: openim.chat.GetCommentPostListByPostIDResp
-	(*DeletePostReq)(nil),                           // 78: openim.chat.DeletePostReq
-	(*DeletePostResp)(nil),                          // 79: openim.chat.DeletePostResp
-	(*ChangeAllowCommentPostReq)(nil),               // 80: openim.chat.ChangeAllowCommentPostReq
-	(*ChangeAllowCommentPostResp)(nil),              // 81: openim.chat.ChangeAllowCommentPostResp
-	(*ChangeAllowForwardPostReq)(nil),               // 82: openim.chat.ChangeAllowForwardPostReq
-	(*ChangeAllowForwardPostResp)(nil),              // 83: openim.chat.ChangeAllowForwardPostResp
-	(*LikePostReq)(nil),                             // 84: openim.chat.LikePostReq
-	(*LikePostResp)(nil),                            // 85: openim.chat.LikePostResp
-	(*CollectPostReq)(nil),                          // 86: openim.chat.CollectPostReq
-	(*CollectPostResp)(nil),                         // 87: openim.chat.CollectPostResp
-	(*ForwardPostReq)(nil),                          // 88: openim.chat.ForwardPostReq
-	(*ForwardPostResp)(nil),                         // 89: openim.chat.ForwardPostResp
-	(*ReferencePostReq)(nil),                        // 90: openim.chat.ReferencePostReq
-	(*ReferencePostResp)(nil),                       // 91: openim.chat.ReferencePostResp
-	(*CommentPostReq)(nil),                          // 92: openim.chat.CommentPostReq
-	(*CommentPostResp)(nil),                         // 93: openim.chat.CommentPostResp
-	(*PinPostReq)(nil),                              // 94: openim.chat.PinPostReq
-	(*PinPostResp)(nil),                             // 95: openim.chat.PinPostResp
-	(*CheckVersionReq)(nil),                         // 96: openim.chat.CheckVersionReq
-	(*CheckVersionResp)(nil),                        // 97: openim.chat.CheckVersionResp
-	(*GetFakeUserReq)(nil),                          // 98: openim.chat.GetFakeUserReq
-	(*GetFakeUserResp)(nil),                         // 99: openim.chat.GetFakeUserResp
-	nil,                                             // 100: openim.chat.FindUserAccountResp.UserAccountMapEntry
-	nil,                                             // 101: openim.chat.FindAccountUserResp.AccountUserMapEntry
-	nil,                                             // 102: openim.chat.UserLoginCountResp.CountEntry
-	(*wrapperspb.StringValue)(nil),                  // 103: openim.protobuf.StringValue
-	(*wrapperspb.Int32Value)(nil),                   // 104: openim.protobuf.Int32Value
-	(*common.UserPublicInfo)(nil),                   // 105: openim.common.UserPublicInfo
-	(*sdkwss.RequestPagination)(nil),                // 106: openim.sdkwss.RequestPagination
-	(*common.UserFullInfo)(nil),                     // 107: openim.common.UserFullInfo
-	(*common.PostMedia)(nil),                        // 108: openim.common.PostMedia
-}
-var file_chat_chat_proto_depIdxs = []int32{
-	0,   // 0: openim.chat.getUsersTimeResp.timeList:type_name -> openim.chat.onlineTime
-	103, // 1: openim.chat.UpdateUserInfoReq.account:type_name -> openim.protobuf.StringValue
-	103, // 2: openim.chat.UpdateUserInfoReq.nickname:type_name -> openim.protobuf.StringValue
-	103, // 3: openim.chat.UpdateUserInfoReq.faceURL:type_name -> openim.protobuf.StringValue
-	103, // 4: openim.chat.UpdateUserInfoReq.coverURL:type_name -> openim.protobuf.StringValue
-	103, // 5: openim.chat.UpdateUserInfoReq.about:type_name -> openim.protobuf.StringValue
-	104, // 6: openim.chat.UpdateUserInfoReq.allowAddFriend:type_name -> openim.protobuf.Int32Value
-	104, // 7: openim.chat.UpdateUserInfoReq.allowBeep:type_name -> openim.protobuf.Int32Value
-	104, // 8: openim.chat.UpdateUserInfoReq.allowVibration:type_name -> openim.protobuf.Int32Value
-	104, // 9: openim.chat.UpdateUserInfoReq.globalRecvMsgOpt:type_name -> openim.protobuf.Int32Value
-	104, // 10: openim.chat.UpdateUserInfoReq.RegisterType:type_name -> openim.protobuf.Int32Value
-	103, // 11: openim.chat.UpdateUserInfoResp.faceURL:type_name -> openim.protobuf.StringValue
-	103, // 12: openim.chat.UpdateUserInfoResp.coverURL:type_name -> openim.protobuf.StringValue
-	103, // 13: openim.chat.UpdateUserInfoResp.about:type_name -> openim.protobuf.StringValue
-	105, // 14: openim.chat.FindUserPublicInfoRespOfOne.user:type_name -> openim.common.UserPublicInfo
-	105, // 15: openim.chat.FindUserPublicInfoResp.users:type_name -> openim.common.UserPublicInfo
-	106, // 16: openim.chat.SearchUserPublicInfoReq.pagination:type_name -> openim.sdkwss.RequestPagination
-	105, // 17: openim.chat.SearchUserPublicInfoResp.users:type_name -> openim.common.UserPublicInfo
-	107, // 18: openim.chat.FindUserFullInfoResp.users:type_name -> openim.common.UserFullInfo
-	21,  // 19: openim.chat.RegisterUserReq.user:type_name -> openim.chat.RegisterUserInfo
-	21,  // 20: openim.chat.AddUserAccountReq.user:type_name -> openim.chat.RegisterUserInfo
-	100, // 21: openim.chat.FindUserAccountResp.userAccountMap:type_name -> openim.chat.FindUserAccountResp.UserAccountMapEntry
-	101, // 22: openim.chat.FindAccountUserResp.accountUserMap:type_name -> openim.chat.FindAccountUserResp.AccountUserMapEntry
-	105, // 23: openim.chat.SignalRecord.inviterUserList:type_name -> openim.common.UserPublicInfo
-	106, // 24: openim.chat.SearchUserFullInfoReq.pagination:type_name -> openim.sdkwss.RequestPagination
-	107, // 25: openim.chat.SearchUserFullInfoResp.users:type_name -> openim.common.UserFullInfo
-	102, // 26: openim.chat.UserLoginCountResp.count:type_name -> openim.chat.UserLoginCountResp.CountEntry
-	106, // 27: openim.chat.SearchUserInfoReq.pagination:type_name -> openim.sdkwss.RequestPagination
-	107, // 28: openim.chat.SearchUserInfoResp.users:type_name -> openim.common.UserFullInfo
-	21,  // 29: openim.chat.CheckUserExistReq.user:type_name -> openim.chat.RegisterUserInfo
-	105, // 30: openim.chat.Post.userInfo:type_name -> openim.common.UserPublicInfo
-	108, // 31: openim.chat.Post.mediaMsgs:type_name -> openim.common.PostMedia
-	105, // 32: openim.chat.Post.atUserInfoList:type_name -> openim.common.UserPublicInfo
-	64,  // 33: openim.chat.Post.forwardPost:type_name -> openim.chat.Post
-	64,  // 34: openim.chat.Post.commentPost:type_name -> openim.chat.Post
-	64,  // 35: openim.chat.Post.refPost:type_name -> openim.chat.Post
-	103, // 36: openim.chat.PublishPostReq.content:type_name -> openim.protobuf.StringValue
-	108, // 37: openim.chat.PublishPostReq.mediaMsgs:type_name -> openim.common.PostMedia
-	64,  // 38: openim.chat.PublishPostResp.post:type_name -> openim.chat.Post
-	64,  // 39: openim.chat.GetPostByIDResp.post:type_name -> openim.chat.Post
-	71,  // 40: openim.chat.GetAllTypePostResp.allPosts:type_name -> openim.chat.TypePosts
-	64,  // 41: openim.chat.TypePosts.posts:type_name -> openim.chat.Post
-	64,  // 42: openim.chat.GetPostListResp.posts:type_name -> openim.chat.Post
-	64,  // 43: openim.chat.GetPostListByUserResp.posts:type_name -> openim.chat.Post
-	64,  // 44: openim.chat.GetCommentPostListByPostIDResp.posts:type_name -> openim.chat.Post
-	103, // 45: openim.chat.ReferencePostReq.content:type_name -> openim.protobuf.StringValue
-	108, // 46: openim.chat.ReferencePostReq.mediaMsgs:type_name -> openim.common.PostMedia
-	103, // 47: openim.chat.CommentPostReq.content:type_name -> openim.protobuf.StringValue
-	108, // 48: openim.chat.CommentPostReq.mediaMsgs:type_name -> openim.common.PostMedia
-	64,  // 49: openim.chat.CommentPostResp.post:type_name -> openim.chat.Post
-	4,   // 50: openim.chat.chat.UpdateUserInfo:input_type -> openim.chat.UpdateUserInfoReq
-	24,  // 51: openim.chat.chat.AddUserAccount:input_type -> openim.chat.AddUserAccountReq
-	9,   // 52: openim.chat.chat.SearchUserPublicInfo:input_type -> openim.chat.SearchUserPublicInfoReq
-	6,   // 53: openim.chat.chat.FindUserPublicInfo:input_type -> openim.chat.FindUserPublicInfoReq
-	32,  // 54: openim.chat.chat.FindUserByAddressOrAccount:input_type -> openim.chat.FindUserByAddressOrAccountReq
-	39,  // 55: openim.chat.chat.SearchUserFullInfo:input_type -> openim.chat.SearchUserFullInfoReq
-	11,  // 56: openim.chat.chat.FindUserFullInfo:input_type -> openim.chat.FindUserFullInfoReq
-	15,  // 57: openim.chat.chat.SendVerifyCode:input_type -> openim.chat.SendVerifyCodeReq
-	17,  // 58: openim.chat.chat.VerifyCode:input_type -> openim.chat.VerifyCodeReq
-	19,  // 59: openim.chat.chat.ChallengeNonce:input_type -> openim.chat.ChallengeNonceReq
-	22,  // 60: openim.chat.chat.RegisterUser:input_type -> openim.chat.RegisterUserReq
-	26,  // 61: openim.chat.chat.Login:input_type -> openim.chat.LoginReq
-	27,  // 62: openim.chat.chat.ResetPassword:input_type -> openim.chat.ResetPasswordReq
-	29,  // 63: openim.chat.chat.ChangePassword:input_type -> openim.chat.ChangePasswordReq
-	48,  // 64: openim.chat.chat.CheckUserExist:input_type -> openim.chat.CheckUserExistReq
-	50,  // 65: openim.chat.chat.DelUserAccount:input_type -> openim.chat.DelUserAccountReq
-	31,  // 66: openim.chat.chat.FindUserAccount:input_type -> openim.chat.FindUserAccountReq
-	34,  // 67: openim.chat.chat.FindAccountUser:input_type -> openim.chat.FindAccountUserReq
-	37,  // 68: openim.chat.chat.OpenIMCallback:input_type -> openim.chat.OpenIMCallbackReq
-	41,  // 69: openim.chat.chat.UserLoginCount:input_type -> openim.chat.UserLoginCountReq
-	44,  // 70: openim.chat.chat.SearchUserInfo:input_type -> openim.chat.SearchUserInfoReq
-	46,  // 71: openim.chat.chat.GetTokenForVideoMeeting:input_type -> openim.chat.GetTokenForVideoMeetingReq
-	54,  // 72: openim.chat.chat.saveGroupToContact:input_type -> openim.chat.saveGroupToContactReq
-	56,  // 73: openim.chat.chat.deleteGroupFromContact:input_type -> openim.chat.deleteGroupFromContactReq
-	52,  // 74: openim.chat.chat.getGroupFromContact:input_type -> openim.chat.getGroupFromContactReq
-	58,  // 75: openim.chat.chat.deleteUserGroupApplicationFromRecipient:input_type -> openim.chat.DeleteGroupApplicationFromRecipientReq
-	60,  // 76: openim.chat.chat.deleteUserGroupApplicationFromApplicant:input_type -> openim.chat.DeleteGroupApplicationFromApplicantReq
-	62,  // 77: openim.chat.chat.deleteUserGroupApplicationFromAll:input_type -> openim.chat.DeleteGroupApplicationFromAlltReq
-	13,  // 78: openim.chat.chat.getAllUserIDs:input_type -> openim.chat.GetAllUserIDsReq
-	65,  // 79: openim.chat.chat.PublishPost:input_type -> openim.chat.PublishPostReq
-	69,  // 80: openim.chat.chat.GetAllTypePost:input_type -> openim.chat.GetAllTypePostReq
-	72,  // 81: openim.chat.chat.GetPostList:input_type -> openim.chat.GetPostListReq
-	74,  // 82: openim.chat.chat.GetPostListByUser:input_type -> openim.chat.GetPostListByUserReq
-	76,  // 83: openim.chat.chat.GetCommentPostListByPostID:input_type -> openim.chat.GetCommentPostListByPostIDReq
-	67,  // 84: openim.chat.chat.GetPostByID:input_type -> openim.chat.GetPostByIDReq
-	78,  // 85: openim.chat.chat.DeletePost:input_type -> openim.chat.DeletePostReq
-	80,  // 86: openim.chat.chat.ChangeAllowCommentPost:input_type -> openim.chat.ChangeAllowCommentPostReq
-	82,  // 87: openim.chat.chat.ChangeAllowForwardPost:input_type -> openim.chat.ChangeAllowForwardPostReq
-	84,  // 88: openim.chat.chat.ChangeLikePost:input_type -> openim.chat.LikePostReq
-	86,  // 89: openim.chat.chat.ChangeCollectPost:input_type -> openim.chat.CollectPostReq
-	94,  // 90: openim.chat.chat.PinPost:input_type -> openim.chat.PinPostReq
-	88,  // 91: openim.chat.chat.ForwardPost:input_type -> openim.chat.ForwardPostReq
-	92,  // 92: openim.chat.chat.CommentPost:input_type -> openim.chat.CommentPostReq
-	90,  // 93: openim.chat.chat.ReferencePost:input_type -> openim.chat.ReferencePostReq
-	96,  // 94: openim.chat.chat.CheckVersion:input_type -> openim.chat.CheckVersionReq
-	98,  // 95: openim.chat.chat.GetFakeUser:input_type -> openim.chat.GetFakeUserReq
-	5,   // 96: openim.chat.chat.UpdateUserInfo:output_type -> openim.chat.UpdateUserInfoResp
-	25,  // 97: openim.chat.chat.AddUserAccount:output_type -> openim.chat.AddUserAccountResp
-	10,  // 98: openim.chat.chat.SearchUserPublicInfo:output_type -> openim.chat.SearchUserPublicInfoResp
-	8,   // 99: openim.chat.chat.FindUserPublicInfo:output_type -> openim.chat.FindUserPublicInfoResp
-	7,   // 100: openim.chat.chat.FindUserByAddressOrAccount:output_type -> openim.chat.FindUserPublicInfoRespOfOne
-	40,  // 101: openim.chat.chat.SearchUserFullInfo:output_type -> openim.chat.SearchUserFullInfoResp
-	12,  // 102: openim.chat.chat.FindUserFullInfo:output_type -> openim.chat.FindUserFullInfoResp
-	16,  // 103: openim.chat.chat.SendVerifyCode:output_type -> openim.chat.SendVerifyCodeResp
-	18,  // 104: openim.chat.chat.VerifyCode:output_type -> openim.chat.VerifyCodeResp
-	20,  // 105: openim.chat.chat.ChallengeNonce:output_type -> openim.chat.ChallengeNonceResp
-	23,  // 106: openim.chat.chat.RegisterUser:output_type -> openim.chat.RegisterUserResp
-	43,  // 107: openim.chat.chat.Login:output_type -> openim.chat.LoginResp
-	28,  // 108: openim.chat.chat.ResetPassword:output_type -> openim.chat.ResetPasswordResp
-	30,  // 109: openim.chat.chat.ChangePassword:output_type -> openim.chat.ChangePasswordResp
-	49,  // 110: openim.chat.chat.CheckUserExist:output_type -> openim.chat.CheckUserExistResp
-	51,  // 111: openim.chat.chat.DelUserAccount:output_type -> openim.chat.DelUserAccountResp
-	33,  // 112: openim.chat.chat.FindUserAccount:output_type -> openim.chat.FindUserAccountResp
-	35,  // 113: openim.chat.chat.FindAccountUser:output_type -> openim.chat.FindAccountUserResp
-	38,  // 114: openim.chat.chat.OpenIMCallback:output_type -> openim.chat.OpenIMCallbackResp
-	42,  // 115: openim.chat.chat.UserLoginCount:output_type -> openim.chat.UserLoginCountResp
-	45,  // 116: openim.chat.chat.SearchUserInfo:output_type -> openim.chat.SearchUserInfoResp
-	47,  // 117: openim.chat.chat.GetTokenForVideoMeeting:output_type -> openim.chat.GetTokenForVideoMeetingResp
-	55,  // 118: openim.chat.chat.saveGroupToContact:output_type -> openim.chat.saveGroupToContactResp
-	57,  // 119: openim.chat.chat.deleteGroupFromContact:output_type -> openim.chat.deleteGroupFromContactResp
-	53,  // 120: openim.chat.chat.getGroupFromContact:output_type -> openim.chat.getGroupFromContactResp
-	59,  // 121: openim.chat.chat.deleteUserGroupApplicationFromRecipient:output_type -> openim.chat.DeleteGroupApplicationFromRecipientResp
-	61,  // 122: openim.chat.chat.deleteUserGroupApplicationFromApplicant:output_type -> openim.chat.DeleteGroupApplicationFromApplicantResp
-	63,  // 123: openim.chat.chat.deleteUserGroupApplicationFromAll:output_type -> openim.chat.DeleteGroupApplicationFromAllResp
-	14,  // 124: openim.chat.chat.getAllUserIDs:output_type -> openim.chat.GetAllUserIDsResp
-	66,  // 125: openim.chat.chat.PublishPost:output_type -> openim.chat.PublishPostResp
-	70,  // 126: openim.chat.chat.GetAllTypePost:output_type -> openim.chat.GetAllTypePostResp
-	73,  // 127: openim.chat.chat.GetPostList:output_type -> openim.chat.GetPostListResp
-	75,  // 128: openim.chat.chat.GetPostListByUser:output_type -> openim.chat.GetPostListByUserResp
-	77,  // 129: openim.chat.chat.GetCommentPostListByPostID:output_type -> openim.chat.GetCommentPostListByPostIDResp
-	68,  // 130: openim.chat.chat.GetPostByID:output_type -> openim.chat.GetPostByIDResp
-	79,  // 131: openim.chat.chat.DeletePost:output_type -> openim.chat.DeletePostResp
-	81,  // 132: openim.chat.chat.ChangeAllowCommentPost:output_type -> openim.chat.ChangeAllowCommentPostResp
-	83,  // 133: openim.chat.chat.ChangeAllowForwardPost:output_type -> openim.chat.ChangeAllowForwardPostResp
-	85,  // 134: openim.chat.chat.ChangeLikePost:output_type -> openim.chat.LikePostResp
-	87,  // 135: openim.chat.chat.ChangeCollectPost:output_type -> openim.chat.CollectPostResp
-	95,  // 136: openim.chat.chat.PinPost:output_type -> openim.chat.PinPostResp
-	89,  // 137: openim.chat.chat.ForwardPost:output_type -> openim.chat.ForwardPostResp
-	93,  // 138: openim.chat.chat.CommentPost:output_type -> openim.chat.CommentPostResp
-	91,  // 139: openim.chat.chat.ReferencePost:output_type -> openim.chat.ReferencePostResp
-	97,  // 140: openim.chat.chat.CheckVersion:output_type -> openim.chat.CheckVersionResp
-	99,  // 141: openim.chat.chat.GetFakeUser:output_type -> openim.chat.GetFakeUserResp
-	96,  // [96:142] is the sub-list for method output_type
-	50,  // [50:96] is the sub-list for method input_type
-	50,  // [50:50] is the sub-list for extension type_name
-	50,  // [50:50] is the sub-list for extension extendee
-	0,   // [0:50] is the sub-list for field type_name
+func (x *GetPostListByUserReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func init() { file_chat_chat_proto_init() }
-func file_chat_chat_proto_init() {
-	if File_chat_chat_proto != nil {
-		return
+func (*GetPostListByUserReq) ProtoMessage() {}
+
+func (x *GetPostListByUserReq) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[100]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_chat_chat_proto_msgTypes[0].Exporter = func(v any, i int) any {
-			switch v := v.(*OnlineTime); i {
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPostListByUserReq.ProtoReflect.Descriptor instead.
+func (*GetPostListByUserReq) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *GetPostListByUserReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *GetPostListByUserReq) GetNextCursor() int64 {
+	if x != nil {
+		return x.NextCursor
+	}
+	return 0
+}
+
+func (x *GetPostListByUserReq) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type GetPostListByUserResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NextCursor int64   `protobuf:"varint,1,opt,name=nextCursor,proto3" json:"nextCursor"`
+	Posts      []*Post `protobuf:"bytes,2,rep,name=posts,proto3" json:"posts"`
+}
+
+func (x *GetPostListByUserResp) Reset() {
+	*x = GetPostListByUserResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[101]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPostListByUserResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPostListByUserResp) ProtoMessage() {}
+
+func (x *GetPostListByUserResp) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[101]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPostListByUserResp.ProtoReflect.Descriptor instead.
+func (*GetPostListByUserResp) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *GetPostListByUserResp) GetNextCursor() int64 {
+	if x != nil {
+		return x.NextCursor
+	}
+	return 0
+}
+
+func (x *GetPostListByUserResp) GetPosts() []*Post {
+	if x != nil {
+		return x.Posts
+	}
+	return nil
+}
+
+type GetCommentPostListByPostIDReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PostID     string `protobuf:"bytes,1,opt,name=postID,proto3" json:"postID"`
+	NextCursor int64  `protobuf:"varint,2,opt,name=nextCursor,proto3" json:"nextCursor"`
+	Count      int32  `protobuf:"varint,3,opt,name=count,proto3" json:"count"`
+}
+
+func (x *GetCommentPostListByPostIDReq) Reset() {
+	*x = GetCommentPostListByPostIDReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[102]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCommentPostListByPostIDReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCommentPostListByPostIDReq) ProtoMessage() {}
+
+func (x *GetCommentPostListByPostIDReq) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[102]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCommentPostListByPostIDReq.ProtoReflect.Descriptor instead.
+func (*GetCommentPostListByPostIDReq) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *GetCommentPostListByPostIDReq) GetPostID() string {
+	if x != nil {
+		return x.PostID
+	}
+	return ""
+}
+
+func (x *GetCommentPostListByPostIDReq) GetNextCursor() int64 {
+	if x != nil {
+		return x.NextCursor
+	}
+	return 0
+}
+
+func (x *GetCommentPostListByPostIDReq) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type GetCommentPostListByPostIDResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NextCursor int64   `protobuf:"varint,1,opt,name=nextCursor,proto3" json:"nextCursor"`
+	Posts      []*Post `protobuf:"bytes,2,rep,name=posts,proto3" json:"posts"`
+}
+
+func (x *GetCommentPostListByPostIDResp) Reset() {
+	*x = GetCommentPostListByPostIDResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[103]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCommentPostListByPostIDResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCommentPostListByPostIDResp) ProtoMessage() {}
+
+func (x *GetCommentPostListByPostIDResp) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[103]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCommentPostListByPostIDResp.ProtoReflect.Descriptor instead.
+func (*GetCommentPostListByPostIDResp) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *GetCommentPostListByPostIDResp) GetNextCursor() int64 {
+	if x != nil {
+		return x.NextCursor
+	}
+	return 0
+}
+
+func (x *GetCommentPostListByPostIDResp) GetPosts() []*Post {
+	if x != nil {
+		return x.Posts
+	}
+	return nil
+}
+
+type DeletePostReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PostID string `protobuf:"bytes,1,opt,name=postID,proto3" json:"postID"`
+}
+
+func (x *DeletePostReq) Reset() {
+	*x = DeletePostReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[104]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeletePostReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePostReq) ProtoMessage() {}
+
+func (x *DeletePostReq) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[104]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePostReq.ProtoReflect.Descriptor instead.
+func (*DeletePostReq) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *DeletePostReq) GetPostID() string {
+	if x != nil {
+		return x.PostID
+	}
+	return ""
+}
+
+type DeletePostResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DeletePostResp) Reset() {
+	*x = DeletePostResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[105]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeletePostResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePostResp) ProtoMessage() {}
+
+func (x *DeletePostResp) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[105]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePostResp.ProtoReflect.Descriptor instead.
+func (*DeletePostResp) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{105}
+}
+
+type ChangeAllowCommentPostReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PostID string `protobuf:"bytes,1,opt,name=postID,proto3" json:"postID"`
+}
+
+func (x *ChangeAllowCommentPostReq) Reset() {
+	*x = ChangeAllowCommentPostReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[106]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChangeAllowCommentPostReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeAllowCommentPostReq) ProtoMessage() {}
+
+func (x *ChangeAllowCommentPostReq) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[106]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeAllowCommentPostReq.ProtoReflect.Descriptor instead.
+func (*ChangeAllowCommentPostReq) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *ChangeAllowCommentPostReq) GetPostID() string {
+	if x != nil {
+		return x.PostID
+	}
+	return ""
+}
+
+type ChangeAllowCommentPostResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PostID       string `protobuf:"bytes,1,opt,name=postID,proto3" json:"postID"`
+	AllowComment int32  `protobuf:"varint,2,opt,name=allowComment,proto3" json:"allowComment"`
+}
+
+func (x *ChangeAllowCommentPostResp) Reset() {
+	*x = ChangeAllowCommentPostResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[107]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChangeAllowCommentPostResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeAllowCommentPostResp) ProtoMessage() {}
+
+func (x *ChangeAllowCommentPostResp) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[107]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeAllowCommentPostResp.ProtoReflect.Descriptor instead.
+func (*ChangeAllowCommentPostResp) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *ChangeAllowCommentPostResp) GetPostID() string {
+	if x != nil {
+		return x.PostID
+	}
+	return ""
+}
+
+func (x *ChangeAllowCommentPostResp) GetAllowComment() int32 {
+	if x != nil {
+		return x.AllowComment
+	}
+	return 0
+}
+
+type ChangeAllowForwardPostReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PostID string `protobuf:"bytes,1,opt,name=postID,proto3" json:"postID"`
+}
+
+func (x *ChangeAllowForwardPostReq) Reset() {
+	*x = ChangeAllowForwardPostReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[108]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChangeAllowForwardPostReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeAllowForwardPostReq) ProtoMessage() {}
+
+func (x *ChangeAllowForwardPostReq) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[108]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeAllowForwardPostReq.ProtoReflect.Descriptor instead.
+func (*ChangeAllowForwardPostReq) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *ChangeAllowForwardPostReq) GetPostID() string {
+	if x != nil {
+		return x.PostID
+	}
+	return ""
+}
+
+type ChangeAllowForwardPostResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PostID       string `protobuf:"bytes,1,opt,name=postID,proto3" json:"postID"`
+	AllowForward int32  `protobuf:"varint,2,opt,name=allowForward,proto3" json:"allowForward"`
+}
+
+func (x *ChangeAllowForwardPostResp) Reset() {
+	*x = ChangeAllowForwardPostResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[109]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChangeAllowForwardPostResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeAllowForwardPostResp) ProtoMessage() {}
+
+func (x *ChangeAllowForwardPostResp) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[109]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeAllowForwardPostResp.ProtoReflect.Descriptor instead.
+func (*ChangeAllowForwardPostResp) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *ChangeAllowForwardPostResp) GetPostID() string {
+	if x != nil {
+		return x.PostID
+	}
+	return ""
+}
+
+func (x *ChangeAllowForwardPostResp) GetAllowForward() int32 {
+	if x != nil {
+		return x.AllowForward
+	}
+	return 0
+}
+
+type LikePostReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PostID  string `protobuf:"bytes,1,opt,name=postID,proto3" json:"postID"`
+	IsLiked int32  `protobuf:"varint,2,opt,name=isLiked,proto3" json:"isLiked"`
+}
+
+func (x *LikePostReq) Reset() {
+	*x = LikePostReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[110]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LikePostReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LikePostReq) ProtoMessage() {}
+
+func (x *LikePostReq) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[110]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LikePostReq.ProtoReflect.Descriptor instead.
+func (*LikePostReq) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *LikePostReq) GetPostID() string {
+	if x != nil {
+		return x.PostID
+	}
+	return ""
+}
+
+func (x *LikePostReq) GetIsLiked() int32 {
+	if x != nil {
+		return x.IsLiked
+	}
+	return 0
+}
+
+type LikePostResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	IsLiked int32 `protobuf:"varint,1,opt,name=isLiked,proto3" json:"isLiked"`
+}
+
+func (x *LikePostResp) Reset() {
+	*x = LikePostResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[111]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LikePostResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LikePostResp) ProtoMessage() {}
+
+func (x *LikePostResp) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[111]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LikePostResp.ProtoReflect.Descriptor instead.
+func (*LikePostResp) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *LikePostResp) GetIsLiked() int32 {
+	if x != nil {
+		return x.IsLiked
+	}
+	return 0
+}
+
+type CollectPostReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PostID      string `protobuf:"bytes,1,opt,name=postID,proto3" json:"postID"`
+	IsCollected int32  `protobuf:"varint,2,opt,name=isCollected,proto3" json:"isCollected"`
+}
+
+func (x *CollectPostReq) Reset() {
+	*x = CollectPostReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[112]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CollectPostReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CollectPostReq) ProtoMessage() {}
+
+func (x *CollectPostReq) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[112]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CollectPostReq.ProtoReflect.Descriptor instead.
+func (*CollectPostReq) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{112}
+}
+
+func (x *CollectPostReq) GetPostID() string {
+	if x != nil {
+		return x.PostID
+	}
+	return ""
+}
+
+func (x *CollectPostReq) GetIsCollected() int32 {
+	if x != nil {
+		return x.IsCollected
+	}
+	return 0
+}
+
+type CollectPostResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	IsCollected int32 `protobuf:"varint,1,opt,name=isCollected,proto3" json:"isCollected"`
+}
+
+func (x *CollectPostResp) Reset() {
+	*x = CollectPostResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[113]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CollectPostResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CollectPostResp) ProtoMessage() {}
+
+func (x *CollectPostResp) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[113]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CollectPostResp.ProtoReflect.Descriptor instead.
+func (*CollectPostResp) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{113}
+}
+
+func (x *CollectPostResp) GetIsCollected() int32 {
+	if x != nil {
+		return x.IsCollected
+	}
+	return 0
+}
+
+type ForwardPostReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ForwardPostID string `protobuf:"bytes,1,opt,name=forwardPostID,proto3" json:"forwardPostID"`
+	IsForwarded   int32  `protobuf:"varint,2,opt,name=isForwarded,proto3" json:"isForwarded"`
+}
+
+func (x *ForwardPostReq) Reset() {
+	*x = ForwardPostReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[114]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ForwardPostReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForwardPostReq) ProtoMessage() {}
+
+func (x *ForwardPostReq) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[114]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForwardPostReq.ProtoReflect.Descriptor instead.
+func (*ForwardPostReq) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{114}
+}
+
+func (x *ForwardPostReq) GetForwardPostID() string {
+	if x != nil {
+		return x.ForwardPostID
+	}
+	return ""
+}
+
+func (x *ForwardPostReq) GetIsForwarded() int32 {
+	if x != nil {
+		return x.IsForwarded
+	}
+	return 0
+}
+
+type ForwardPostResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	IsForwarded int32 `protobuf:"varint,1,opt,name=isForwarded,proto3" json:"isForwarded"`
+}
+
+func (x *ForwardPostResp) Reset() {
+	*x = ForwardPostResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[115]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ForwardPostResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForwardPostResp) ProtoMessage() {}
+
+func (x *ForwardPostResp) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[115]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForwardPostResp.ProtoReflect.Descriptor instead.
+func (*ForwardPostResp) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{115}
+}
+
+func (x *ForwardPostResp) GetIsForwarded() int32 {
+	if x != nil {
+		return x.IsForwarded
+	}
+	return 0
+}
+
+type ReferencePostReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RefPostID    string                  `protobuf:"bytes,1,opt,name=refPostID,proto3" json:"refPostID"`
+	Content      *wrapperspb.StringValue `protobuf:"bytes,2,opt,name=content,proto3" json:"content"`
+	AllowComment int32                   `protobuf:"varint,3,opt,name=allowComment,proto3" json:"allowComment"`
+	AllowForward int32                   `protobuf:"varint,4,opt,name=allowForward,proto3" json:"allowForward"`
+	AtUserIds    []string                `protobuf:"bytes,5,rep,name=atUserIds,proto3" json:"atUserIds"`
+	MediaMsgs    []*common.PostMedia     `protobuf:"bytes,6,rep,name=mediaMsgs,proto3" json:"mediaMsgs"`
+	Visibility   int32                   `protobuf:"varint,7,opt,name=visibility,proto3" json:"visibility"`
+	AllowUserIDs []string                `protobuf:"bytes,8,rep,name=allowUserIDs,proto3" json:"allowUserIDs"`
+}
+
+func (x *ReferencePostReq) Reset() {
+	*x = ReferencePostReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[116]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReferencePostReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReferencePostReq) ProtoMessage() {}
+
+func (x *ReferencePostReq) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[116]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReferencePostReq.ProtoReflect.Descriptor instead.
+func (*ReferencePostReq) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{116}
+}
+
+func (x *ReferencePostReq) GetRefPostID() string {
+	if x != nil {
+		return x.RefPostID
+	}
+	return ""
+}
+
+func (x *ReferencePostReq) GetContent() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+func (x *ReferencePostReq) GetAllowComment() int32 {
+	if x != nil {
+		return x.AllowComment
+	}
+	return 0
+}
+
+func (x *ReferencePostReq) GetAllowForward() int32 {
+	if x != nil {
+		return x.AllowForward
+	}
+	return 0
+}
+
+func (x *ReferencePostReq) GetAtUserIds() []string {
+	if x != nil {
+		return x.AtUserIds
+	}
+	return nil
+}
+
+func (x *ReferencePostReq) GetMediaMsgs() []*common.PostMedia {
+	if x != nil {
+		return x.MediaMsgs
+	}
+	return nil
+}
+
+func (x *ReferencePostReq) GetVisibility() int32 {
+	if x != nil {
+		return x.Visibility
+	}
+	return 0
+}
+
+func (x *ReferencePostReq) GetAllowUserIDs() []string {
+	if x != nil {
+		return x.AllowUserIDs
+	}
+	return nil
+}
+
+type ReferencePostResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ReferencePostResp) Reset() {
+	*x = ReferencePostResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[117]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReferencePostResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReferencePostResp) ProtoMessage() {}
+
+func (x *ReferencePostResp) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[117]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReferencePostResp.ProtoReflect.Descriptor instead.
+func (*ReferencePostResp) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{117}
+}
+
+type CommentPostReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CommentPostID string                  `protobuf:"bytes,1,opt,name=commentPostID,proto3" json:"commentPostID"`
+	Content       *wrapperspb.StringValue `protobuf:"bytes,2,opt,name=content,proto3" json:"content"`
+	AllowComment  int32                   `protobuf:"varint,3,opt,name=allowComment,proto3" json:"allowComment"`
+	AllowForward  int32                   `protobuf:"varint,4,opt,name=allowForward,proto3" json:"allowForward"`
+	AtUserIds     []string                `protobuf:"bytes,5,rep,name=atUserIds,proto3" json:"atUserIds"`
+	MediaMsgs     []*common.PostMedia     `protobuf:"bytes,6,rep,name=mediaMsgs,proto3" json:"mediaMsgs"`
+	Visibility    int32                   `protobuf:"varint,7,opt,name=visibility,proto3" json:"visibility"`
+	AllowUserIDs  []string                `protobuf:"bytes,8,rep,name=allowUserIDs,proto3" json:"allowUserIDs"`
+}
+
+func (x *CommentPostReq) Reset() {
+	*x = CommentPostReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[118]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CommentPostReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommentPostReq) ProtoMessage() {}
+
+func (x *CommentPostReq) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[118]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommentPostReq.ProtoReflect.Descriptor instead.
+func (*CommentPostReq) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{118}
+}
+
+func (x *CommentPostReq) GetCommentPostID() string {
+	if x != nil {
+		return x.CommentPostID
+	}
+	return ""
+}
+
+func (x *CommentPostReq) GetContent() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+func (x *CommentPostReq) GetAllowComment() int32 {
+	if x != nil {
+		return x.AllowComment
+	}
+	return 0
+}
+
+func (x *CommentPostReq) GetAllowForward() int32 {
+	if x != nil {
+		return x.AllowForward
+	}
+	return 0
+}
+
+func (x *CommentPostReq) GetAtUserIds() []string {
+	if x != nil {
+		return x.AtUserIds
+	}
+	return nil
+}
+
+func (x *CommentPostReq) GetMediaMsgs() []*common.PostMedia {
+	if x != nil {
+		return x.MediaMsgs
+	}
+	return nil
+}
+
+func (x *CommentPostReq) GetVisibility() int32 {
+	if x != nil {
+		return x.Visibility
+	}
+	return 0
+}
+
+func (x *CommentPostReq) GetAllowUserIDs() []string {
+	if x != nil {
+		return x.AllowUserIDs
+	}
+	return nil
+}
+
+type CommentPostResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Post *Post `protobuf:"bytes,1,opt,name=post,proto3" json:"post"`
+}
+
+func (x *CommentPostResp) Reset() {
+	*x = CommentPostResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[119]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CommentPostResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommentPostResp) ProtoMessage() {}
+
+func (x *CommentPostResp) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[119]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommentPostResp.ProtoReflect.Descriptor instead.
+func (*CommentPostResp) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{119}
+}
+
+func (x *CommentPostResp) GetPost() *Post {
+	if x != nil {
+		return x.Post
+	}
+	return nil
+}
+
+type PinPostReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PostID   string `protobuf:"bytes,1,opt,name=postID,proto3" json:"postID"`
+	IsPinned int32  `protobuf:"varint,2,opt,name=isPinned,proto3" json:"isPinned"`
+}
+
+func (x *PinPostReq) Reset() {
+	*x = PinPostReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[120]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PinPostReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PinPostReq) ProtoMessage() {}
+
+func (x *PinPostReq) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[120]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PinPostReq.ProtoReflect.Descriptor instead.
+func (*PinPostReq) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{120}
+}
+
+func (x *PinPostReq) GetPostID() string {
+	if x != nil {
+		return x.PostID
+	}
+	return ""
+}
+
+func (x *PinPostReq) GetIsPinned() int32 {
+	if x != nil {
+		return x.IsPinned
+	}
+	return 0
+}
+
+type PinPostResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *PinPostResp) Reset() {
+	*x = PinPostResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[121]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PinPostResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PinPostResp) ProtoMessage() {}
+
+func (x *PinPostResp) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[121]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PinPostResp.ProtoReflect.Descriptor instead.
+func (*PinPostResp) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{121}
+}
+
+type EditPostReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PostID    string                  `protobuf:"bytes,1,opt,name=postID,proto3" json:"postID"`
+	Content   *wrapperspb.StringValue `protobuf:"bytes,2,opt,name=content,proto3" json:"content"`
+	AtUserIds []string                `protobuf:"bytes,3,rep,name=atUserIds,proto3" json:"atUserIds"`
+	MediaMsgs []*common.PostMedia     `protobuf:"bytes,4,rep,name=mediaMsgs,proto3" json:"mediaMsgs"`
+}
+
+func (x *EditPostReq) Reset() {
+	*x = EditPostReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[122]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EditPostReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EditPostReq) ProtoMessage() {}
+
+func (x *EditPostReq) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[122]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EditPostReq.ProtoReflect.Descriptor instead.
+func (*EditPostReq) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{122}
+}
+
+func (x *EditPostReq) GetPostID() string {
+	if x != nil {
+		return x.PostID
+	}
+	return ""
+}
+
+func (x *EditPostReq) GetContent() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+func (x *EditPostReq) GetAtUserIds() []string {
+	if x != nil {
+		return x.AtUserIds
+	}
+	return nil
+}
+
+func (x *EditPostReq) GetMediaMsgs() []*common.PostMedia {
+	if x != nil {
+		return x.MediaMsgs
+	}
+	return nil
+}
+
+type EditPostResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Post *Post `protobuf:"bytes,1,opt,name=post,proto3" json:"post"`
+}
+
+func (x *EditPostResp) Reset() {
+	*x = EditPostResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[123]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EditPostResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EditPostResp) ProtoMessage() {}
+
+func (x *EditPostResp) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[123]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EditPostResp.ProtoReflect.Descriptor instead.
+func (*EditPostResp) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{123}
+}
+
+func (x *EditPostResp) GetPost() *Post {
+	if x != nil {
+		return x.Post
+	}
+	return nil
+}
+
+type PostRevision struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PostID     string              `protobuf:"bytes,1,opt,name=postID,proto3" json:"postID"`
+	Revision   int64               `protobuf:"varint,2,opt,name=revision,proto3" json:"revision"`
+	Content    string              `protobuf:"bytes,3,opt,name=content,proto3" json:"content"`
+	AtUserIds  []string            `protobuf:"bytes,4,rep,name=atUserIds,proto3" json:"atUserIds"`
+	MediaMsgs  []*common.PostMedia `protobuf:"bytes,5,rep,name=mediaMsgs,proto3" json:"mediaMsgs"`
+	CreateTime int64               `protobuf:"varint,6,opt,name=createTime,proto3" json:"createTime"`
+}
+
+func (x *PostRevision) Reset() {
+	*x = PostRevision{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[124]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PostRevision) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PostRevision) ProtoMessage() {}
+
+func (x *PostRevision) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[124]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PostRevision.ProtoReflect.Descriptor instead.
+func (*PostRevision) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{124}
+}
+
+func (x *PostRevision) GetPostID() string {
+	if x != nil {
+		return x.PostID
+	}
+	return ""
+}
+
+func (x *PostRevision) GetRevision() int64 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+func (x *PostRevision) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *PostRevision) GetAtUserIds() []string {
+	if x != nil {
+		return x.AtUserIds
+	}
+	return nil
+}
+
+func (x *PostRevision) GetMediaMsgs() []*common.PostMedia {
+	if x != nil {
+		return x.MediaMsgs
+	}
+	return nil
+}
+
+func (x *PostRevision) GetCreateTime() int64 {
+	if x != nil {
+		return x.CreateTime
+	}
+	return 0
+}
+
+type GetPostRevisionsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PostID string `protobuf:"bytes,1,opt,name=postID,proto3" json:"postID"`
+}
+
+func (x *GetPostRevisionsReq) Reset() {
+	*x = GetPostRevisionsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[125]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPostRevisionsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPostRevisionsReq) ProtoMessage() {}
+
+func (x *GetPostRevisionsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[125]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPostRevisionsReq.ProtoReflect.Descriptor instead.
+func (*GetPostRevisionsReq) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{125}
+}
+
+func (x *GetPostRevisionsReq) GetPostID() string {
+	if x != nil {
+		return x.PostID
+	}
+	return ""
+}
+
+type GetPostRevisionsResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Revisions []*PostRevision `protobuf:"bytes,1,rep,name=revisions,proto3" json:"revisions"`
+}
+
+func (x *GetPostRevisionsResp) Reset() {
+	*x = GetPostRevisionsResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[126]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPostRevisionsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPostRevisionsResp) ProtoMessage() {}
+
+func (x *GetPostRevisionsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[126]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPostRevisionsResp.ProtoReflect.Descriptor instead.
+func (*GetPostRevisionsResp) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{126}
+}
+
+func (x *GetPostRevisionsResp) GetRevisions() []*PostRevision {
+	if x != nil {
+		return x.Revisions
+	}
+	return nil
+}
+
+type ReconcilePostCountsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// empty means every post
+	PostIDs []string `protobuf:"bytes,1,rep,name=postIDs,proto3" json:"postIDs"`
+}
+
+func (x *ReconcilePostCountsReq) Reset() {
+	*x = ReconcilePostCountsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[127]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReconcilePostCountsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReconcilePostCountsReq) ProtoMessage() {}
+
+func (x *ReconcilePostCountsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[127]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReconcilePostCountsReq.ProtoReflect.Descriptor instead.
+func (*ReconcilePostCountsReq) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{127}
+}
+
+func (x *ReconcilePostCountsReq) GetPostIDs() []string {
+	if x != nil {
+		return x.PostIDs
+	}
+	return nil
+}
+
+type ReconcilePostCountsResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Updated int64 `protobuf:"varint,1,opt,name=updated,proto3" json:"updated"`
+}
+
+func (x *ReconcilePostCountsResp) Reset() {
+	*x = ReconcilePostCountsResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[128]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReconcilePostCountsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReconcilePostCountsResp) ProtoMessage() {}
+
+func (x *ReconcilePostCountsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[128]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReconcilePostCountsResp.ProtoReflect.Descriptor instead.
+func (*ReconcilePostCountsResp) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{128}
+}
+
+func (x *ReconcilePostCountsResp) GetUpdated() int64 {
+	if x != nil {
+		return x.Updated
+	}
+	return 0
+}
+
+type PostDraft struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DraftID      string              `protobuf:"bytes,1,opt,name=draftID,proto3" json:"draftID"`
+	Content      string              `protobuf:"bytes,2,opt,name=content,proto3" json:"content"`
+	AllowComment int32               `protobuf:"varint,3,opt,name=allowComment,proto3" json:"allowComment"`
+	AllowForward int32               `protobuf:"varint,4,opt,name=allowForward,proto3" json:"allowForward"`
+	AtUserIds    []string            `protobuf:"bytes,5,rep,name=atUserIds,proto3" json:"atUserIds"`
+	MediaMsgs    []*common.PostMedia `protobuf:"bytes,6,rep,name=mediaMsgs,proto3" json:"mediaMsgs"`
+	Visibility   int32               `protobuf:"varint,7,opt,name=visibility,proto3" json:"visibility"`
+	AllowUserIDs []string            `protobuf:"bytes,8,rep,name=allowUserIDs,proto3" json:"allowUserIDs"`
+	// 0 means not scheduled
+	PublishAt  int64 `protobuf:"varint,9,opt,name=publishAt,proto3" json:"publishAt"`
+	CreateTime int64 `protobuf:"varint,10,opt,name=createTime,proto3" json:"createTime"`
+	UpdateTime int64 `protobuf:"varint,11,opt,name=updateTime,proto3" json:"updateTime"`
+}
+
+func (x *PostDraft) Reset() {
+	*x = PostDraft{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[129]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PostDraft) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PostDraft) ProtoMessage() {}
+
+func (x *PostDraft) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[129]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PostDraft.ProtoReflect.Descriptor instead.
+func (*PostDraft) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{129}
+}
+
+func (x *PostDraft) GetDraftID() string {
+	if x != nil {
+		return x.DraftID
+	}
+	return ""
+}
+
+func (x *PostDraft) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *PostDraft) GetAllowComment() int32 {
+	if x != nil {
+		return x.AllowComment
+	}
+	return 0
+}
+
+func (x *PostDraft) GetAllowForward() int32 {
+	if x != nil {
+		return x.AllowForward
+	}
+	return 0
+}
+
+func (x *PostDraft) GetAtUserIds() []string {
+	if x != nil {
+		return x.AtUserIds
+	}
+	return nil
+}
+
+func (x *PostDraft) GetMediaMsgs() []*common.PostMedia {
+	if x != nil {
+		return x.MediaMsgs
+	}
+	return nil
+}
+
+func (x *PostDraft) GetVisibility() int32 {
+	if x != nil {
+		return x.Visibility
+	}
+	return 0
+}
+
+func (x *PostDraft) GetAllowUserIDs() []string {
+	if x != nil {
+		return x.AllowUserIDs
+	}
+	return nil
+}
+
+func (x *PostDraft) GetPublishAt() int64 {
+	if x != nil {
+		return x.PublishAt
+	}
+	return 0
+}
+
+func (x *PostDraft) GetCreateTime() int64 {
+	if x != nil {
+		return x.CreateTime
+	}
+	return 0
+}
+
+func (x *PostDraft) GetUpdateTime() int64 {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return 0
+}
+
+type SaveDraftReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// empty creates a new draft
+	DraftID      string                  `protobuf:"bytes,1,opt,name=draftID,proto3" json:"draftID"`
+	Content      *wrapperspb.StringValue `protobuf:"bytes,2,opt,name=content,proto3" json:"content"`
+	AllowComment int32                   `protobuf:"varint,3,opt,name=allowComment,proto3" json:"allowComment"`
+	AllowForward int32                   `protobuf:"varint,4,opt,name=allowForward,proto3" json:"allowForward"`
+	AtUserIds    []string                `protobuf:"bytes,5,rep,name=atUserIds,proto3" json:"atUserIds"`
+	MediaMsgs    []*common.PostMedia     `protobuf:"bytes,6,rep,name=mediaMsgs,proto3" json:"mediaMsgs"`
+	Visibility   int32                   `protobuf:"varint,7,opt,name=visibility,proto3" json:"visibility"`
+	AllowUserIDs []string                `protobuf:"bytes,8,rep,name=allowUserIDs,proto3" json:"allowUserIDs"`
+	// unix milliseconds to publish at, 0 keeps it as a draft
+	PublishAt int64 `protobuf:"varint,9,opt,name=publishAt,proto3" json:"publishAt"`
+}
+
+func (x *SaveDraftReq) Reset() {
+	*x = SaveDraftReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[130]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SaveDraftReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveDraftReq) ProtoMessage() {}
+
+func (x *SaveDraftReq) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[130]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveDraftReq.ProtoReflect.Descriptor instead.
+func (*SaveDraftReq) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{130}
+}
+
+func (x *SaveDraftReq) GetDraftID() string {
+	if x != nil {
+		return x.DraftID
+	}
+	return ""
+}
+
+func (x *SaveDraftReq) GetContent() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+func (x *SaveDraftReq) GetAllowComment() int32 {
+	if x != nil {
+		return x.AllowComment
+	}
+	return 0
+}
+
+func (x *SaveDraftReq) GetAllowForward() int32 {
+	if x != nil {
+		return x.AllowForward
+	}
+	return 0
+}
+
+func (x *SaveDraftReq) GetAtUserIds() []string {
+	if x != nil {
+		return x.AtUserIds
+	}
+	return nil
+}
+
+func (x *SaveDraftReq) GetMediaMsgs() []*common.PostMedia {
+	if x != nil {
+		return x.MediaMsgs
+	}
+	return nil
+}
+
+func (x *SaveDraftReq) GetVisibility() int32 {
+	if x != nil {
+		return x.Visibility
+	}
+	return 0
+}
+
+func (x *SaveDraftReq) GetAllowUserIDs() []string {
+	if x != nil {
+		return x.AllowUserIDs
+	}
+	return nil
+}
+
+func (x *SaveDraftReq) GetPublishAt() int64 {
+	if x != nil {
+		return x.PublishAt
+	}
+	return 0
+}
+
+type SaveDraftResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Draft *PostDraft `protobuf:"bytes,1,opt,name=draft,proto3" json:"draft"`
+}
+
+func (x *SaveDraftResp) Reset() {
+	*x = SaveDraftResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[131]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SaveDraftResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveDraftResp) ProtoMessage() {}
+
+func (x *SaveDraftResp) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[131]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveDraftResp.ProtoReflect.Descriptor instead.
+func (*SaveDraftResp) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{131}
+}
+
+func (x *SaveDraftResp) GetDraft() *PostDraft {
+	if x != nil {
+		return x.Draft
+	}
+	return nil
+}
+
+type ListDraftsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pagination *sdkwss.RequestPagination `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination"`
+}
+
+func (x *ListDraftsReq) Reset() {
+	*x = ListDraftsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[132]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListDraftsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDraftsReq) ProtoMessage() {}
+
+func (x *ListDraftsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[132]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDraftsReq.ProtoReflect.Descriptor instead.
+func (*ListDraftsReq) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{132}
+}
+
+func (x *ListDraftsReq) GetPagination() *sdkwss.RequestPagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type ListDraftsResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Total  uint32       `protobuf:"varint,1,opt,name=total,proto3" json:"total"`
+	Drafts []*PostDraft `protobuf:"bytes,2,rep,name=drafts,proto3" json:"drafts"`
+}
+
+func (x *ListDraftsResp) Reset() {
+	*x = ListDraftsResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[133]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListDraftsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDraftsResp) ProtoMessage() {}
+
+func (x *ListDraftsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[133]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDraftsResp.ProtoReflect.Descriptor instead.
+func (*ListDraftsResp) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{133}
+}
+
+func (x *ListDraftsResp) GetTotal() uint32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListDraftsResp) GetDrafts() []*PostDraft {
+	if x != nil {
+		return x.Drafts
+	}
+	return nil
+}
+
+type DeleteDraftReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DraftIDs []string `protobuf:"bytes,1,rep,name=draftIDs,proto3" json:"draftIDs"`
+}
+
+func (x *DeleteDraftReq) Reset() {
+	*x = DeleteDraftReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[134]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteDraftReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteDraftReq) ProtoMessage() {}
+
+func (x *DeleteDraftReq) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[134]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteDraftReq.ProtoReflect.Descriptor instead.
+func (*DeleteDraftReq) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{134}
+}
+
+func (x *DeleteDraftReq) GetDraftIDs() []string {
+	if x != nil {
+		return x.DraftIDs
+	}
+	return nil
+}
+
+type DeleteDraftResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DeleteDraftResp) Reset() {
+	*x = DeleteDraftResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[135]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteDraftResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteDraftResp) ProtoMessage() {}
+
+func (x *DeleteDraftResp) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[135]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteDraftResp.ProtoReflect.Descriptor instead.
+func (*DeleteDraftResp) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{135}
+}
+
+type Notification struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NotificationID string `protobuf:"bytes,1,opt,name=notificationID,proto3" json:"notificationID"`
+	Type           int32  `protobuf:"varint,2,opt,name=type,proto3" json:"type"`
+	PostID         string `protobuf:"bytes,3,opt,name=postID,proto3" json:"postID"`
+	SourcePostID   string `protobuf:"bytes,4,opt,name=sourcePostID,proto3" json:"sourcePostID"`
+	// latest operators first, opCount is the total
+	OpUsers    []*common.UserPublicInfo `protobuf:"bytes,5,rep,name=opUsers,proto3" json:"opUsers"`
+	OpCount    int64                    `protobuf:"varint,6,opt,name=opCount,proto3" json:"opCount"`
+	IsRead     int32                    `protobuf:"varint,7,opt,name=isRead,proto3" json:"isRead"`
+	CreateTime int64                    `protobuf:"varint,8,opt,name=createTime,proto3" json:"createTime"`
+	UpdateTime int64                    `protobuf:"varint,9,opt,name=updateTime,proto3" json:"updateTime"`
+}
+
+func (x *Notification) Reset() {
+	*x = Notification{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[136]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Notification) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Notification) ProtoMessage() {}
+
+func (x *Notification) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[136]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Notification.ProtoReflect.Descriptor instead.
+func (*Notification) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{136}
+}
+
+func (x *Notification) GetNotificationID() string {
+	if x != nil {
+		return x.NotificationID
+	}
+	return ""
+}
+
+func (x *Notification) GetType() int32 {
+	if x != nil {
+		return x.Type
+	}
+	return 0
+}
+
+func (x *Notification) GetPostID() string {
+	if x != nil {
+		return x.PostID
+	}
+	return ""
+}
+
+func (x *Notification) GetSourcePostID() string {
+	if x != nil {
+		return x.SourcePostID
+	}
+	return ""
+}
+
+func (x *Notification) GetOpUsers() []*common.UserPublicInfo {
+	if x != nil {
+		return x.OpUsers
+	}
+	return nil
+}
+
+func (x *Notification) GetOpCount() int64 {
+	if x != nil {
+		return x.OpCount
+	}
+	return 0
+}
+
+func (x *Notification) GetIsRead() int32 {
+	if x != nil {
+		return x.IsRead
+	}
+	return 0
+}
+
+func (x *Notification) GetCreateTime() int64 {
+	if x != nil {
+		return x.CreateTime
+	}
+	return 0
+}
+
+func (x *Notification) GetUpdateTime() int64 {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return 0
+}
+
+type GetNotificationsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NextCursor int64 `protobuf:"varint,1,opt,name=nextCursor,proto3" json:"nextCursor"`
+	Count      int32 `protobuf:"varint,2,opt,name=count,proto3" json:"count"`
+}
+
+func (x *GetNotificationsReq) Reset() {
+	*x = GetNotificationsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[137]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetNotificationsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNotificationsReq) ProtoMessage() {}
+
+func (x *GetNotificationsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[137]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNotificationsReq.ProtoReflect.Descriptor instead.
+func (*GetNotificationsReq) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{137}
+}
+
+func (x *GetNotificationsReq) GetNextCursor() int64 {
+	if x != nil {
+		return x.NextCursor
+	}
+	return 0
+}
+
+func (x *GetNotificationsReq) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type GetNotificationsResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NextCursor    int64           `protobuf:"varint,1,opt,name=nextCursor,proto3" json:"nextCursor"`
+	Notifications []*Notification `protobuf:"bytes,2,rep,name=notifications,proto3" json:"notifications"`
+}
+
+func (x *GetNotificationsResp) Reset() {
+	*x = GetNotificationsResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[138]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetNotificationsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNotificationsResp) ProtoMessage() {}
+
+func (x *GetNotificationsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[138]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNotificationsResp.ProtoReflect.Descriptor instead.
+func (*GetNotificationsResp) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{138}
+}
+
+func (x *GetNotificationsResp) GetNextCursor() int64 {
+	if x != nil {
+		return x.NextCursor
+	}
+	return 0
+}
+
+func (x *GetNotificationsResp) GetNotifications() []*Notification {
+	if x != nil {
+		return x.Notifications
+	}
+	return nil
+}
+
+type GetUnreadNotificationCountReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetUnreadNotificationCountReq) Reset() {
+	*x = GetUnreadNotificationCountReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[139]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetUnreadNotificationCountReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUnreadNotificationCountReq) ProtoMessage() {}
+
+func (x *GetUnreadNotificationCountReq) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[139]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUnreadNotificationCountReq.ProtoReflect.Descriptor instead.
+func (*GetUnreadNotificationCountReq) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{139}
+}
+
+type GetUnreadNotificationCountResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Count int64 `protobuf:"varint,1,opt,name=count,proto3" json:"count"`
+}
+
+func (x *GetUnreadNotificationCountResp) Reset() {
+	*x = GetUnreadNotificationCountResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[140]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetUnreadNotificationCountResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUnreadNotificationCountResp) ProtoMessage() {}
+
+func (x *GetUnreadNotificationCountResp) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[140]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUnreadNotificationCountResp.ProtoReflect.Descriptor instead.
+func (*GetUnreadNotificationCountResp) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{140}
+}
+
+func (x *GetUnreadNotificationCountResp) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type MarkNotificationsReadReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NotificationIDs []string `protobuf:"bytes,1,rep,name=notificationIDs,proto3" json:"notificationIDs"`
+}
+
+func (x *MarkNotificationsReadReq) Reset() {
+	*x = MarkNotificationsReadReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[141]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MarkNotificationsReadReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkNotificationsReadReq) ProtoMessage() {}
+
+func (x *MarkNotificationsReadReq) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[141]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkNotificationsReadReq.ProtoReflect.Descriptor instead.
+func (*MarkNotificationsReadReq) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{141}
+}
+
+func (x *MarkNotificationsReadReq) GetNotificationIDs() []string {
+	if x != nil {
+		return x.NotificationIDs
+	}
+	return nil
+}
+
+type MarkNotificationsReadResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *MarkNotificationsReadResp) Reset() {
+	*x = MarkNotificationsReadResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[142]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MarkNotificationsReadResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkNotificationsReadResp) ProtoMessage() {}
+
+func (x *MarkNotificationsReadResp) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[142]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkNotificationsReadResp.ProtoReflect.Descriptor instead.
+func (*MarkNotificationsReadResp) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{142}
+}
+
+type MarkAllNotificationsReadReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *MarkAllNotificationsReadReq) Reset() {
+	*x = MarkAllNotificationsReadReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[143]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MarkAllNotificationsReadReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkAllNotificationsReadReq) ProtoMessage() {}
+
+func (x *MarkAllNotificationsReadReq) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[143]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkAllNotificationsReadReq.ProtoReflect.Descriptor instead.
+func (*MarkAllNotificationsReadReq) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{143}
+}
+
+type MarkAllNotificationsReadResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *MarkAllNotificationsReadResp) Reset() {
+	*x = MarkAllNotificationsReadResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[144]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MarkAllNotificationsReadResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkAllNotificationsReadResp) ProtoMessage() {}
+
+func (x *MarkAllNotificationsReadResp) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[144]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkAllNotificationsReadResp.ProtoReflect.Descriptor instead.
+func (*MarkAllNotificationsReadResp) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{144}
+}
+
+type CheckVersionReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Language string `protobuf:"bytes,1,opt,name=language,proto3" json:"language"`
+}
+
+func (x *CheckVersionReq) Reset() {
+	*x = CheckVersionReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[145]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CheckVersionReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckVersionReq) ProtoMessage() {}
+
+func (x *CheckVersionReq) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[145]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckVersionReq.ProtoReflect.Descriptor instead.
+func (*CheckVersionReq) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{145}
+}
+
+func (x *CheckVersionReq) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+type CheckVersionResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BuildVersion                string   `protobuf:"bytes,1,opt,name=buildVersion,proto3" json:"buildVersion"`
+	DownloadURL                 string   `protobuf:"bytes,2,opt,name=downloadURL,proto3" json:"downloadURL"`
+	BuildUpdateTitle            string   `protobuf:"bytes,3,opt,name=buildUpdateTitle,proto3" json:"buildUpdateTitle"`
+	BuildUpdateDescriptionTitle string   `protobuf:"bytes,4,opt,name=buildUpdateDescriptionTitle,proto3" json:"buildUpdateDescriptionTitle"`
+	BuildUpdateDescriptions     []string `protobuf:"bytes,5,rep,name=buildUpdateDescriptions,proto3" json:"buildUpdateDescriptions"`
+	NeedForceUpdate             bool     `protobuf:"varint,6,opt,name=needForceUpdate,proto3" json:"needForceUpdate"`
+}
+
+func (x *CheckVersionResp) Reset() {
+	*x = CheckVersionResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[146]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CheckVersionResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckVersionResp) ProtoMessage() {}
+
+func (x *CheckVersionResp) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[146]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckVersionResp.ProtoReflect.Descriptor instead.
+func (*CheckVersionResp) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{146}
+}
+
+func (x *CheckVersionResp) GetBuildVersion() string {
+	if x != nil {
+		return x.BuildVersion
+	}
+	return ""
+}
+
+func (x *CheckVersionResp) GetDownloadURL() string {
+	if x != nil {
+		return x.DownloadURL
+	}
+	return ""
+}
+
+func (x *CheckVersionResp) GetBuildUpdateTitle() string {
+	if x != nil {
+		return x.BuildUpdateTitle
+	}
+	return ""
+}
+
+func (x *CheckVersionResp) GetBuildUpdateDescriptionTitle() string {
+	if x != nil {
+		return x.BuildUpdateDescriptionTitle
+	}
+	return ""
+}
+
+func (x *CheckVersionResp) GetBuildUpdateDescriptions() []string {
+	if x != nil {
+		return x.BuildUpdateDescriptions
+	}
+	return nil
+}
+
+func (x *CheckVersionResp) GetNeedForceUpdate() bool {
+	if x != nil {
+		return x.NeedForceUpdate
+	}
+	return false
+}
+
+type GetFakeUserReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetFakeUserReq) Reset() {
+	*x = GetFakeUserReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[147]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetFakeUserReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFakeUserReq) ProtoMessage() {}
+
+func (x *GetFakeUserReq) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[147]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFakeUserReq.ProtoReflect.Descriptor instead.
+func (*GetFakeUserReq) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{147}
+}
+
+type GetFakeUserResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Online int32 `protobuf:"varint,1,opt,name=online,proto3" json:"online"`
+	Total  int32 `protobuf:"varint,2,opt,name=total,proto3" json:"total"`
+}
+
+func (x *GetFakeUserResp) Reset() {
+	*x = GetFakeUserResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chat_chat_proto_msgTypes[148]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetFakeUserResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFakeUserResp) ProtoMessage() {}
+
+func (x *GetFakeUserResp) ProtoReflect() protoreflect.Message {
+	mi := &file_chat_chat_proto_msgTypes[148]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFakeUserResp.ProtoReflect.Descriptor instead.
+func (*GetFakeUserResp) Descriptor() ([]byte, []int) {
+	return file_chat_chat_proto_rawDescGZIP(), []int{148}
+}
+
+func (x *GetFakeUserResp) GetOnline() int32 {
+	if x != nil {
+		return x.Online
+	}
+	return 0
+}
+
+func (x *GetFakeUserResp) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+var File_chat_chat_proto protoreflect.FileDescriptor
+
+var file_chat_chat_proto_rawDesc = []byte{
+	0x0a, 0x0f, 0x63, 0x68, 0x61, 0x74, 0x2f, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x12, 0x0b, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x1a, 0x13,
+	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x1a, 0x13, 0x73, 0x64, 0x6b, 0x77, 0x73, 0x73, 0x2f, 0x73, 0x64, 0x6b, 0x77,
+	0x73, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65,
+	0x72, 0x73, 0x70, 0x62, 0x2f, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x70, 0x62, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x42, 0x0a, 0x0a, 0x6f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x54,
+	0x69, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
+	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x2b, 0x0a, 0x0f, 0x67, 0x65, 0x74,
+	0x55, 0x73, 0x65, 0x72, 0x73, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x22, 0x47, 0x0a, 0x10, 0x67, 0x65, 0x74, 0x55, 0x73, 0x65,
+	0x72, 0x73, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x33, 0x0a, 0x08, 0x74, 0x69,
+	0x6d, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6f,
+	0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x6f, 0x6e, 0x6c, 0x69, 0x6e,
+	0x65, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x22,
+	0x7a, 0x0a, 0x0c, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12,
+	0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65, 0x76,
+	0x69, 0x63, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x65, 0x76,
+	0x69, 0x63, 0x65, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72,
+	0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72,
+	0x6d, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x92, 0x05, 0x0a, 0x11,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65,
+	0x71, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x36, 0x0a, 0x07, 0x61, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6f, 0x70, 0x65,
+	0x6e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x38, 0x0a, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x52, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x36, 0x0a, 0x07, 0x66,
+	0x61, 0x63, 0x65, 0x55, 0x52, 0x4c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6f,
+	0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x07, 0x66, 0x61, 0x63, 0x65,
+	0x55, 0x52, 0x4c, 0x12, 0x38, 0x0a, 0x08, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x55, 0x52, 0x4c, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x52, 0x08, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x55, 0x52, 0x4c, 0x12, 0x32, 0x0a,
+	0x05, 0x61, 0x62, 0x6f, 0x75, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6f,
+	0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x61, 0x62, 0x6f, 0x75,
+	0x74, 0x12, 0x43, 0x0a, 0x0e, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x41, 0x64, 0x64, 0x46, 0x72, 0x69,
+	0x65, 0x6e, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6f, 0x70, 0x65, 0x6e,
+	0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x33,
+	0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0e, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x41, 0x64, 0x64,
+	0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x12, 0x39, 0x0a, 0x09, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x42,
+	0x65, 0x65, 0x70, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6f, 0x70, 0x65, 0x6e,
+	0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x33,
+	0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x09, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x42, 0x65, 0x65,
+	0x70, 0x12, 0x43, 0x0a, 0x0e, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x56, 0x69, 0x62, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6f, 0x70, 0x65, 0x6e,
+	0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x33,
+	0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0e, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x56, 0x69, 0x62,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x47, 0x0a, 0x10, 0x67, 0x6c, 0x6f, 0x62, 0x61, 0x6c,
+	0x52, 0x65, 0x63, 0x76, 0x4d, 0x73, 0x67, 0x4f, 0x70, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1b, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x10, 0x67,
+	0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x52, 0x65, 0x63, 0x76, 0x4d, 0x73, 0x67, 0x4f, 0x70, 0x74, 0x12,
+	0x3f, 0x0a, 0x0c, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x52, 0x0c, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65,
+	0x22, 0xf0, 0x01, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x49,
+	0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x36, 0x0a,
+	0x07, 0x66, 0x61, 0x63, 0x65, 0x55, 0x52, 0x4c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c,
+	0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x07, 0x66, 0x61,
+	0x63, 0x65, 0x55, 0x52, 0x4c, 0x12, 0x38, 0x0a, 0x08, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x55, 0x52,
+	0x4c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x08, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x55, 0x52, 0x4c, 0x12,
+	0x32, 0x0a, 0x05, 0x61, 0x62, 0x6f, 0x75, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c,
+	0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x61, 0x62,
+	0x6f, 0x75, 0x74, 0x22, 0x31, 0x0a, 0x15, 0x46, 0x69, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x50,
+	0x75, 0x62, 0x6c, 0x69, 0x63, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x22, 0x50, 0x0a, 0x1b, 0x46, 0x69, 0x6e, 0x64, 0x55, 0x73,
+	0x65, 0x72, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70,
+	0x4f, 0x66, 0x4f, 0x6e, 0x65, 0x12, 0x31, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x6f, 0x6d,
+	0x6d, 0x6f, 0x6e, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x49, 0x6e,
+	0x66, 0x6f, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x22, 0x4d, 0x0a, 0x16, 0x46, 0x69, 0x6e, 0x64,
+	0x55, 0x73, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65,
+	0x73, 0x70, 0x12, 0x33, 0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1d, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
+	0x6e, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x49, 0x6e, 0x66, 0x6f,
+	0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x22, 0x8f, 0x01, 0x0a, 0x17, 0x53, 0x65, 0x61, 0x72,
+	0x63, 0x68, 0x55, 0x73, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x49, 0x6e, 0x66, 0x6f,
+	0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x40, 0x0a,
+	0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x20, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x73, 0x64, 0x6b, 0x77, 0x73,
+	0x73, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x18, 0x0a, 0x07, 0x67, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x07, 0x67, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x73, 0x22, 0x65, 0x0a, 0x18, 0x53, 0x65, 0x61,
+	0x72, 0x63, 0x68, 0x55, 0x73, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x49, 0x6e, 0x66,
+	0x6f, 0x52, 0x65, 0x73, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x33, 0x0a, 0x05, 0x75,
+	0x73, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6f, 0x70, 0x65,
+	0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x50,
+	0x75, 0x62, 0x6c, 0x69, 0x63, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73,
+	0x22, 0x2f, 0x0a, 0x13, 0x46, 0x69, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x46, 0x75, 0x6c, 0x6c,
+	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x44, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44,
+	0x73, 0x22, 0x49, 0x0a, 0x14, 0x46, 0x69, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x46, 0x75, 0x6c,
+	0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x12, 0x31, 0x0a, 0x05, 0x75, 0x73, 0x65,
+	0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69,
+	0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x46, 0x75, 0x6c,
+	0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x22, 0x12, 0x0a, 0x10,
+	0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x52, 0x65, 0x71,
+	0x22, 0x2d, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x18, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x22,
+	0x8d, 0x02, 0x0a, 0x11, 0x53, 0x65, 0x6e, 0x64, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x43, 0x6f,
+	0x64, 0x65, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x64, 0x46, 0x6f, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x75, 0x73, 0x65, 0x64, 0x46, 0x6f, 0x72, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x70, 0x12,
+	0x26, 0x0a, 0x0e, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65, 0x76, 0x69, 0x63,
+	0x65, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x65, 0x76, 0x69, 0x63,
+	0x65, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x12,
+	0x1a, 0x0a, 0x08, 0x61, 0x72, 0x65, 0x61, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x61, 0x72, 0x65, 0x61, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x70,
+	0x68, 0x6f, 0x6e, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x14, 0x0a,
+	0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d,
+	0x61, 0x69, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x22,
+	0x14, 0x0a, 0x12, 0x53, 0x65, 0x6e, 0x64, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x43, 0x6f, 0x64,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x22, 0x83, 0x01, 0x0a, 0x0d, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79,
+	0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x72, 0x65, 0x61, 0x43,
+	0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x72, 0x65, 0x61, 0x43,
+	0x6f, 0x64, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x4e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x4e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x76, 0x65, 0x72, 0x69, 0x66, 0x79, 0x43,
+	0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x76, 0x65, 0x72, 0x69, 0x66,
+	0x79, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x22, 0x10, 0x0a, 0x0e, 0x56,
+	0x65, 0x72, 0x69, 0x66, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x22, 0x67, 0x0a,
+	0x11, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x52,
+	0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79,
+	0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65,
+	0x76, 0x69, 0x63, 0x65, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x65,
+	0x76, 0x69, 0x63, 0x65, 0x49, 0x44, 0x22, 0x64, 0x0a, 0x12, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65,
+	0x6e, 0x67, 0x65, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x14, 0x0a, 0x05,
+	0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x6f, 0x6e,
+	0x63, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x54, 0x69, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x54, 0x69,
+	0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0xd6, 0x01, 0x0a,
+	0x10, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66,
+	0x6f, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x69, 0x63,
+	0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x69, 0x63,
+	0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x66, 0x61, 0x63, 0x65, 0x55, 0x52, 0x4c,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x66, 0x61, 0x63, 0x65, 0x55, 0x52, 0x4c, 0x12,
+	0x18, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x75, 0x62,
+	0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x75,
+	0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x12, 0x22, 0x0a, 0x0c, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x54, 0x79, 0x70,
+	0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65,
+	0x72, 0x54, 0x79, 0x70, 0x65, 0x22, 0xbe, 0x02, 0x0a, 0x0f, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74,
+	0x65, 0x72, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x6e,
+	0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x12,
+	0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x70, 0x12, 0x1a, 0x0a,
+	0x08, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6c, 0x61,
+	0x74, 0x66, 0x6f, 0x72, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x6c, 0x61,
+	0x74, 0x66, 0x6f, 0x72, 0x6d, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x6f, 0x4c, 0x6f, 0x67,
+	0x69, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x61, 0x75, 0x74, 0x6f, 0x4c, 0x6f,
+	0x67, 0x69, 0x6e, 0x12, 0x31, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1d, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e,
+	0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f,
+	0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x12, 0x1c, 0x0a, 0x09, 0x75, 0x73, 0x65, 0x72, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x73, 0x65, 0x72, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x12, 0x26,
+	0x0a, 0x0e, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x22, 0x6c, 0x0a, 0x10, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74,
+	0x65, 0x72, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x68, 0x61, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x68, 0x61, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x12, 0x22, 0x0a, 0x0c, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x8e, 0x01, 0x0a, 0x11, 0x41, 0x64, 0x64, 0x55, 0x73, 0x65, 0x72,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x70,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x70, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65,
+	0x76, 0x69, 0x63, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x65,
+	0x76, 0x69, 0x63, 0x65, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f,
+	0x72, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f,
+	0x72, 0x6d, 0x12, 0x31, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1d, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x52,
+	0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52,
+	0x04, 0x75, 0x73, 0x65, 0x72, 0x22, 0x14, 0x0a, 0x12, 0x41, 0x64, 0x64, 0x55, 0x73, 0x65, 0x72,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x22, 0xf6, 0x01, 0x0a, 0x08,
+	0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x12, 0x1a,
+	0x0a, 0x08, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x49, 0x44, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x70,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f,
+	0x6e, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65,
+	0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x1c,
+	0x0a, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x18, 0x0a, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x75, 0x73, 0x65, 0x72, 0x41, 0x67,
+	0x65, 0x6e, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x73, 0x65, 0x72, 0x41,
+	0x67, 0x65, 0x6e, 0x74, 0x22, 0xa2, 0x01, 0x0a, 0x10, 0x52, 0x65, 0x73, 0x65, 0x74, 0x50, 0x61,
+	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x72, 0x65,
+	0x61, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x72, 0x65,
+	0x61, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x4e, 0x75,
+	0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x68, 0x6f, 0x6e,
+	0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x76, 0x65, 0x72, 0x69, 0x66,
+	0x79, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x76, 0x65, 0x72,
+	0x69, 0x66, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77,
+	0x6f, 0x72, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77,
+	0x6f, 0x72, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x22, 0x13, 0x0a, 0x11, 0x52, 0x65, 0x73,
+	0x65, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x22, 0x77,
+	0x0a, 0x11, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64,
+	0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x28, 0x0a, 0x0f, 0x63,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x50, 0x61, 0x73,
+	0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x6e, 0x65, 0x77, 0x50, 0x61, 0x73, 0x73,
+	0x77, 0x6f, 0x72, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6e, 0x65, 0x77, 0x50,
+	0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x14, 0x0a, 0x12, 0x43, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x22, 0x2e, 0x0a,
+	0x12, 0x46, 0x69, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x22, 0x53, 0x0a,
+	0x1d, 0x46, 0x69, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x42, 0x79, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x4f, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x12, 0x18,
+	0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x22, 0xb6, 0x01, 0x0a, 0x13, 0x46, 0x69, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x5c, 0x0a, 0x0e, 0x75, 0x73,
+	0x65, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x61, 0x70, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x34, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74,
+	0x2e, 0x46, 0x69, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0e, 0x75, 0x73, 0x65, 0x72, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x61, 0x70, 0x1a, 0x41, 0x0a, 0x13, 0x55, 0x73, 0x65, 0x72,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x30, 0x0a, 0x12, 0x46,
+	0x69, 0x6e, 0x64, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65,
+	0x71, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x08, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x22, 0xb6, 0x01,
+	0x0a, 0x13, 0x46, 0x69, 0x6e, 0x64, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x55, 0x73, 0x65,
+	0x72, 0x52, 0x65, 0x73, 0x70, 0x12, 0x5c, 0x0a, 0x0e, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x55, 0x73, 0x65, 0x72, 0x4d, 0x61, 0x70, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x34, 0x2e,
+	0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x46, 0x69, 0x6e, 0x64,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x2e,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x4d, 0x61, 0x70, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x52, 0x0e, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72,
+	0x4d, 0x61, 0x70, 0x1a, 0x41, 0x0a, 0x13, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x55, 0x73,
+	0x65, 0x72, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xd7, 0x03, 0x0a, 0x0c, 0x53, 0x69, 0x67, 0x6e, 0x61,
+	0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x4e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x54, 0x79, 0x70, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x6f, 0x6f, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x6f, 0x6f, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a,
+	0x08, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x49, 0x44, 0x12, 0x26, 0x0a, 0x0e, 0x73, 0x65, 0x6e,
+	0x64, 0x65, 0x72, 0x4e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0e, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x4e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x63, 0x76, 0x49, 0x44, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x72, 0x65, 0x63, 0x76, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c, 0x72, 0x65, 0x63,
+	0x76, 0x4e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0c, 0x72, 0x65, 0x63, 0x76, 0x4e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a,
+	0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x4e, 0x61, 0x6d, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x47, 0x0a, 0x0f, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x72,
+	0x55, 0x73, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d,
+	0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x55,
+	0x73, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0f, 0x69,
+	0x6e, 0x76, 0x69, 0x74, 0x65, 0x72, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1a,
+	0x0a, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69,
+	0x7a, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x20,
+	0x0a, 0x0b, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x55, 0x52, 0x4c, 0x18, 0x0e, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x55, 0x52, 0x4c,
+	0x22, 0x41, 0x0a, 0x11, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x61, 0x6c, 0x6c, 0x62, 0x61,
+	0x63, 0x6b, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12,
+	0x12, 0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x62,
+	0x6f, 0x64, 0x79, 0x22, 0x80, 0x05, 0x0a, 0x12, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x61,
+	0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x72,
+	0x72, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x65, 0x72, 0x72,
+	0x43, 0x6f, 0x64, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x6f, 0x64, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x6f, 0x64, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x4d, 0x73, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x65, 0x72, 0x72, 0x4d, 0x73, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x44,
+	0x6c, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x65, 0x72, 0x72, 0x44, 0x6c, 0x74,
+	0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x49, 0x44, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x49, 0x44, 0x12, 0x28, 0x0a, 0x0f, 0x63, 0x61, 0x6c, 0x6c,
+	0x62, 0x61, 0x63, 0x6b, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0f, 0x63, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x43, 0x6f, 0x6d, 0x6d, 0x61,
+	0x6e, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4d, 0x73, 0x67, 0x49,
+	0x44, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4d,
+	0x73, 0x67, 0x49, 0x44, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4d, 0x73,
+	0x67, 0x49, 0x44, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x4d, 0x73, 0x67, 0x49, 0x44, 0x12, 0x2a, 0x0a, 0x10, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72,
+	0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x49, 0x44, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x10, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d,
+	0x49, 0x44, 0x12, 0x26, 0x0a, 0x0e, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x4e, 0x69, 0x63, 0x6b,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x73, 0x65, 0x6e, 0x64,
+	0x65, 0x72, 0x4e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x73, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0b, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x6d, 0x73, 0x67, 0x46, 0x72, 0x6f, 0x6d, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x6d,
+	0x73, 0x67, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
+	0x74, 0x54, 0x79, 0x70, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x63, 0x6f, 0x6e,
+	0x74, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x10,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x11, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x65,
+	0x71, 0x18, 0x12, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x73, 0x65, 0x71, 0x12, 0x1e, 0x0a, 0x0a,
+	0x61, 0x74, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x13, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x0a, 0x61, 0x74, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07,
+	0x66, 0x61, 0x63, 0x65, 0x55, 0x52, 0x4c, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x66,
+	0x61, 0x63, 0x65, 0x55, 0x52, 0x4c, 0x12, 0x0e, 0x0a, 0x02, 0x65, 0x78, 0x18, 0x15, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x65, 0x78, 0x22, 0xa5, 0x01, 0x0a, 0x15, 0x53, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x55, 0x73, 0x65, 0x72, 0x46, 0x75, 0x6c, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71,
+	0x12, 0x18, 0x0a, 0x07, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x40, 0x0a, 0x0a, 0x70, 0x61,
+	0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20,
+	0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x73, 0x64, 0x6b, 0x77, 0x73, 0x73, 0x2e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07,
+	0x67, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x67,
+	0x65, 0x6e, 0x64, 0x65, 0x72, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x6f, 0x72, 0x6d, 0x61, 0x6c,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6e, 0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x22, 0x61,
+	0x0a, 0x16, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x55, 0x73, 0x65, 0x72, 0x46, 0x75, 0x6c, 0x6c,
+	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x31,
+	0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
+	0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x55, 0x73,
+	0x65, 0x72, 0x46, 0x75, 0x6c, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x75, 0x73, 0x65, 0x72,
+	0x73, 0x22, 0x3b, 0x0a, 0x11, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x10, 0x0a, 0x03,
+	0x65, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x65, 0x6e, 0x64, 0x22, 0xd4,
+	0x01, 0x0a, 0x12, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x1e, 0x0a, 0x0a, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6c, 0x6f, 0x67, 0x69, 0x6e,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x75, 0x6e, 0x6c, 0x6f, 0x67, 0x69, 0x6e,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x75, 0x6e, 0x6c,
+	0x6f, 0x67, 0x69, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x40, 0x0a, 0x05, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69,
+	0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x6f, 0x67, 0x69, 0x6e,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x1a, 0x38, 0x0a, 0x0a, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x65, 0x0a, 0x09, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x68, 0x61, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x68, 0x61, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c, 0x72, 0x65, 0x66, 0x72,
+	0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
+	0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x7e, 0x0a, 0x0a,
+	0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65,
+	0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b,
+	0x65, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x07, 0x70, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x1e, 0x0a, 0x0a,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x22, 0xb0, 0x02, 0x0a,
+	0x0c, 0x41, 0x64, 0x64, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x71, 0x12, 0x26, 0x0a,
+	0x0e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x2a, 0x0a, 0x10, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74,
+	0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x10, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65,
+	0x79, 0x12, 0x2a, 0x0a, 0x10, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x53, 0x69, 0x67, 0x6e,
+	0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x63, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x18, 0x0a,
+	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69,
+	0x63, 0x4b, 0x65, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x75, 0x62, 0x6c,
+	0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74,
+	0x75, 0x72, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x49, 0x44, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x49, 0x44, 0x22,
+	0x0f, 0x0a, 0x0d, 0x41, 0x64, 0x64, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x22, 0x28, 0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x73, 0x52,
+	0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x22, 0x44, 0x0a, 0x0f, 0x4c, 0x69,
+	0x73, 0x74, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x31, 0x0a,
+	0x07, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17,
+	0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x57, 0x61, 0x6c,
+	0x6c, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x73,
+	0x22, 0x43, 0x0a, 0x0f, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74,
+	0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x12, 0x0a, 0x10, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x57,
+	0x61, 0x6c, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x22, 0xa3, 0x01, 0x0a, 0x11, 0x53, 0x65,
+	0x61, 0x72, 0x63, 0x68, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x12,
+	0x18, 0x0a, 0x07, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x40, 0x0a, 0x0a, 0x70, 0x61, 0x67,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e,
+	0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x73, 0x64, 0x6b, 0x77, 0x73, 0x73, 0x2e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x67,
+	0x65, 0x6e, 0x64, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x05, 0x52, 0x07, 0x67, 0x65,
+	0x6e, 0x64, 0x65, 0x72, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73,
+	0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x22,
+	0x5d, 0x0a, 0x12, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66,
+	0x6f, 0x52, 0x65, 0x73, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x31, 0x0a, 0x05, 0x75,
+	0x73, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6f, 0x70, 0x65,
+	0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x46,
+	0x75, 0x6c, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x22, 0x4c,
+	0x0a, 0x1a, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x46, 0x6f, 0x72, 0x56, 0x69, 0x64,
+	0x65, 0x6f, 0x4d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04,
+	0x72, 0x6f, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x6f, 0x6f, 0x6d,
+	0x12, 0x1a, 0x0a, 0x08, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x22, 0x51, 0x0a, 0x1b,
+	0x47, 0x65, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x46, 0x6f, 0x72, 0x56, 0x69, 0x64, 0x65, 0x6f,
+	0x4d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x12, 0x1c, 0x0a, 0x09, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x55, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x55, 0x72, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22,
+	0x46, 0x0a, 0x11, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x45, 0x78, 0x69, 0x73,
+	0x74, 0x52, 0x65, 0x71, 0x12, 0x31, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74,
+	0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66,
+	0x6f, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x22, 0x50, 0x0a, 0x12, 0x43, 0x68, 0x65, 0x63, 0x6b,
+	0x55, 0x73, 0x65, 0x72, 0x45, 0x78, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x16, 0x0a,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x69, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x69, 0x73, 0x52, 0x65, 0x67, 0x69, 0x73,
+	0x74, 0x65, 0x72, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x69, 0x73, 0x52,
+	0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x22, 0x2d, 0x0a, 0x11, 0x44, 0x65, 0x6c,
+	0x55, 0x73, 0x65, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x12, 0x18,
+	0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x07, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x22, 0x14, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x55,
+	0x73, 0x65, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x22, 0x68,
+	0x0a, 0x0c, 0x45, 0x72, 0x61, 0x73, 0x65, 0x64, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x1e,
+	0x0a, 0x0a, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18,
+	0x0a, 0x07, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x07, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x6e, 0x6f, 0x6e,
+	0x79, 0x6d, 0x69, 0x7a, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x61, 0x6e,
+	0x6f, 0x6e, 0x79, 0x6d, 0x69, 0x7a, 0x65, 0x64, 0x22, 0x96, 0x02, 0x0a, 0x0f, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x26, 0x0a, 0x0e,
+	0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x55, 0x73,
+	0x65, 0x72, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x20, 0x0a, 0x0b,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0b, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1c,
+	0x0a, 0x09, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x41, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x09, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x41, 0x74, 0x12, 0x1e, 0x0a, 0x0a,
+	0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0a, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x33, 0x0a, 0x07,
+	0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x45, 0x72, 0x61, 0x73,
+	0x65, 0x64, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x07, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64,
+	0x73, 0x22, 0x69, 0x0a, 0x19, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x16,
+	0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x1c,
+	0x0a, 0x09, 0x69, 0x6d, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x09, 0x69, 0x6d, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x74, 0x65, 0x22, 0x56, 0x0a, 0x1a,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x38, 0x0a, 0x08, 0x64, 0x65,
+	0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6f,
+	0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x64, 0x65, 0x6c, 0x65,
+	0x74, 0x69, 0x6f, 0x6e, 0x22, 0x32, 0x0a, 0x18, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+	0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x22, 0x1b, 0x0a, 0x19, 0x43, 0x61, 0x6e, 0x63,
+	0x65, 0x6c, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x22, 0x2f, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x16,
+	0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x22, 0x52, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x12, 0x38, 0x0a, 0x08, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74,
+	0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x08, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xd2, 0x01, 0x0a, 0x0a, 0x44,
+	0x61, 0x74, 0x61, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x78, 0x70,
+	0x6f, 0x72, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x78, 0x70,
+	0x6f, 0x72, 0x74, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1e, 0x0a,
+	0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1e, 0x0a,
+	0x0a, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0a, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1a, 0x0a,
+	0x08, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x41, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x08, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x41, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a,
+	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x20, 0x0a,
+	0x0b, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x55, 0x52, 0x4c, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x55, 0x52, 0x4c, 0x22,
+	0x16, 0x0a, 0x14, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x44, 0x61, 0x74, 0x61, 0x45, 0x78,
+	0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x22, 0x48, 0x0a, 0x15, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x44, 0x61, 0x74, 0x61, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x12, 0x2f, 0x0a, 0x06, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x17, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x44,
+	0x61, 0x74, 0x61, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x06, 0x65, 0x78, 0x70, 0x6f, 0x72,
+	0x74, 0x22, 0x12, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x45, 0x78, 0x70, 0x6f,
+	0x72, 0x74, 0x52, 0x65, 0x71, 0x22, 0x44, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61,
+	0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x2f, 0x0a, 0x06, 0x65, 0x78,
+	0x70, 0x6f, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6f, 0x70, 0x65,
+	0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x45, 0x78, 0x70,
+	0x6f, 0x72, 0x74, 0x52, 0x06, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x22, 0x29, 0x0a, 0x11, 0x4f,
+	0x70, 0x65, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71,
+	0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x6c, 0x0a, 0x12, 0x4f, 0x70, 0x65, 0x6e, 0x44, 0x61,
+	0x74, 0x61, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x1a,
+	0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69,
+	0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x63,
+	0x6c, 0x61, 0x69, 0x6d, 0x22, 0x42, 0x0a, 0x14, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x44,
+	0x61, 0x74, 0x61, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x12, 0x14, 0x0a, 0x05,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x22, 0x17, 0x0a, 0x15, 0x43, 0x6f, 0x6e, 0x73,
+	0x75, 0x6d, 0x65, 0x44, 0x61, 0x74, 0x61, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x22, 0x42, 0x0a, 0x14, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x44, 0x61, 0x74, 0x61,
+	0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12,
+	0x14, 0x0a, 0x05, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x63, 0x6c, 0x61, 0x69, 0x6d, 0x22, 0x17, 0x0a, 0x15, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65,
+	0x44, 0x61, 0x74, 0x61, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x22, 0x18,
+	0x0a, 0x16, 0x67, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x46, 0x72, 0x6f, 0x6d, 0x43, 0x6f,
+	0x6e, 0x74, 0x61, 0x63, 0x74, 0x52, 0x65, 0x71, 0x22, 0x35, 0x0a, 0x17, 0x67, 0x65, 0x74, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x46, 0x72, 0x6f, 0x6d, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x12, 0x1a, 0x0a, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x73, 0x22,
+	0x33, 0x0a, 0x15, 0x73, 0x61, 0x76, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x54, 0x6f, 0x43, 0x6f,
+	0x6e, 0x74, 0x61, 0x63, 0x74, 0x52, 0x65, 0x71, 0x12, 0x1a, 0x0a, 0x08, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x49, 0x44, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x49, 0x44, 0x73, 0x22, 0x18, 0x0a, 0x16, 0x73, 0x61, 0x76, 0x65, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x54, 0x6f, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x22, 0x37,
+	0x0a, 0x19, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x46, 0x72, 0x6f,
+	0x6d, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x52, 0x65, 0x71, 0x12, 0x1a, 0x0a, 0x08, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x73, 0x22, 0x1c, 0x0a, 0x1a, 0x64, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x46, 0x72, 0x6f, 0x6d, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x22, 0x28, 0x0a, 0x26, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46,
+	0x72, 0x6f, 0x6d, 0x52, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x22,
+	0x29, 0x0a, 0x27, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x70,
+	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x72, 0x6f, 0x6d, 0x52, 0x65, 0x63,
+	0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x22, 0x28, 0x0a, 0x26, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x46, 0x72, 0x6f, 0x6d, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x6e,
+	0x74, 0x52, 0x65, 0x71, 0x22, 0x29, 0x0a, 0x27, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x72,
+	0x6f, 0x6d, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x22,
+	0x23, 0x0a, 0x21, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x70,
+	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x72, 0x6f, 0x6d, 0x41, 0x6c, 0x6c,
+	0x74, 0x52, 0x65, 0x71, 0x22, 0x23, 0x0a, 0x21, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x72,
+	0x6f, 0x6d, 0x41, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x22, 0xdd, 0x08, 0x0a, 0x04, 0x50, 0x6f,
+	0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x22, 0x0a, 0x0c,
+	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0c, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x22, 0x0a, 0x0c, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x69, 0x6b, 0x65, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x6c, 0x69, 0x6b, 0x65, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x43, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x43,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x46,
+	0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x61, 0x6c,
+	0x6c, 0x6f, 0x77, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x69, 0x73,
+	0x4c, 0x69, 0x6b, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x69, 0x73, 0x4c,
+	0x69, 0x6b, 0x65, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x69, 0x73, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
+	0x74, 0x65, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x69, 0x73, 0x43, 0x6f, 0x6c,
+	0x6c, 0x65, 0x63, 0x74, 0x65, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x69, 0x73, 0x46, 0x6f, 0x72, 0x77,
+	0x61, 0x72, 0x64, 0x65, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x69, 0x73, 0x46,
+	0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x65, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x75, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x74, 0x55, 0x73,
+	0x65, 0x72, 0x49, 0x64, 0x73, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x61, 0x74, 0x55,
+	0x73, 0x65, 0x72, 0x49, 0x64, 0x73, 0x12, 0x39, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x49, 0x6e,
+	0x66, 0x6f, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69,
+	0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x50, 0x75, 0x62,
+	0x6c, 0x69, 0x63, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66,
+	0x6f, 0x12, 0x36, 0x0a, 0x09, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x4d, 0x73, 0x67, 0x73, 0x18, 0x10,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x6f,
+	0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x52, 0x09,
+	0x6d, 0x65, 0x64, 0x69, 0x61, 0x4d, 0x73, 0x67, 0x73, 0x12, 0x45, 0x0a, 0x0e, 0x61, 0x74, 0x55,
+	0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x11, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1d, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
+	0x6e, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x49, 0x6e, 0x66, 0x6f,
+	0x52, 0x0e, 0x61, 0x74, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x4c, 0x69, 0x73, 0x74,
+	0x12, 0x24, 0x0a, 0x0d, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x50, 0x6f, 0x73, 0x74, 0x49,
+	0x44, 0x18, 0x12, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64,
+	0x50, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x12, 0x24, 0x0a, 0x0d, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x74, 0x50, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x18, 0x13, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09,
+	0x72, 0x65, 0x66, 0x50, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x72, 0x65, 0x66, 0x50, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x12, 0x33, 0x0a, 0x0b, 0x66, 0x6f,
+	0x72, 0x77, 0x61, 0x72, 0x64, 0x50, 0x6f, 0x73, 0x74, 0x18, 0x15, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x11, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x50, 0x6f,
+	0x73, 0x74, 0x52, 0x0b, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x50, 0x6f, 0x73, 0x74, 0x12,
+	0x33, 0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x18, 0x16,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68,
+	0x61, 0x74, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+	0x50, 0x6f, 0x73, 0x74, 0x12, 0x2b, 0x0a, 0x07, 0x72, 0x65, 0x66, 0x50, 0x6f, 0x73, 0x74, 0x18,
+	0x17, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63,
+	0x68, 0x61, 0x74, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x07, 0x72, 0x65, 0x66, 0x50, 0x6f, 0x73,
+	0x74, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x73, 0x50, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x18, 0x18, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x08, 0x69, 0x73, 0x50, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x12, 0x20, 0x0a,
+	0x0b, 0x69, 0x73, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x65, 0x64, 0x18, 0x19, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0b, 0x69, 0x73, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x65, 0x64, 0x12,
+	0x1a, 0x0a, 0x08, 0x69, 0x73, 0x45, 0x64, 0x69, 0x74, 0x65, 0x64, 0x18, 0x1a, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x08, 0x69, 0x73, 0x45, 0x64, 0x69, 0x74, 0x65, 0x64, 0x12, 0x24, 0x0a, 0x0d, 0x72,
+	0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x1b, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0d, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x22, 0x0a, 0x0c, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x1c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c,
+	0x69, 0x74, 0x79, 0x18, 0x1d, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x76, 0x69, 0x73, 0x69, 0x62,
+	0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x22, 0x0a, 0x0c, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x55, 0x73,
+	0x65, 0x72, 0x49, 0x44, 0x73, 0x18, 0x1e, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x61, 0x6c, 0x6c,
+	0x6f, 0x77, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x22, 0xc8, 0x02, 0x0a, 0x0e, 0x50, 0x75,
+	0x62, 0x6c, 0x69, 0x73, 0x68, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x12, 0x36, 0x0a, 0x07,
+	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x07, 0x63, 0x6f, 0x6e,
+	0x74, 0x65, 0x6e, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x43, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x61, 0x6c, 0x6c, 0x6f,
+	0x77, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x61, 0x6c, 0x6c, 0x6f,
+	0x77, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c,
+	0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x12, 0x1c, 0x0a, 0x09,
+	0x61, 0x74, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x09, 0x61, 0x74, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x73, 0x12, 0x36, 0x0a, 0x09, 0x6d, 0x65,
+	0x64, 0x69, 0x61, 0x4d, 0x73, 0x67, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e,
+	0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x50, 0x6f,
+	0x73, 0x74, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x52, 0x09, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x4d, 0x73,
+	0x67, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69,
+	0x74, 0x79, 0x12, 0x22, 0x0a, 0x0c, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x55, 0x73, 0x65, 0x72, 0x49,
+	0x44, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x55,
+	0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73,
+	0x68, 0x41, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69,
+	0x73, 0x68, 0x41, 0x74, 0x22, 0x52, 0x0a, 0x0f, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x50,
+	0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x25, 0x0a, 0x04, 0x70, 0x6f, 0x73, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63,
+	0x68, 0x61, 0x74, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x04, 0x70, 0x6f, 0x73, 0x74, 0x12, 0x18,
+	0x0a, 0x07, 0x64, 0x72, 0x61, 0x66, 0x74, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x64, 0x72, 0x61, 0x66, 0x74, 0x49, 0x44, 0x22, 0x28, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x50,
+	0x6f, 0x73, 0x74, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f,
+	0x73, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74,
+	0x49, 0x44, 0x22, 0x38, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x42, 0x79, 0x49,
+	0x44, 0x52, 0x65, 0x73, 0x70, 0x12, 0x25, 0x0a, 0x04, 0x70, 0x6f, 0x73, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61,
+	0x74, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x04, 0x70, 0x6f, 0x73, 0x74, 0x22, 0x29, 0x0a, 0x11,
+	0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65,
+	0x71, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x48, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x41, 0x6c,
+	0x6c, 0x54, 0x79, 0x70, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x32, 0x0a,
+	0x08, 0x61, 0x6c, 0x6c, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x16, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x54, 0x79,
+	0x70, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x52, 0x08, 0x61, 0x6c, 0x6c, 0x50, 0x6f, 0x73, 0x74,
+	0x73, 0x22, 0x68, 0x0a, 0x09, 0x54, 0x79, 0x70, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x73, 0x12, 0x12,
+	0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73,
+	0x6f, 0x72, 0x12, 0x27, 0x0a, 0x05, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x11, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e,
+	0x50, 0x6f, 0x73, 0x74, 0x52, 0x05, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x22, 0x5a, 0x0a, 0x0e, 0x47,
+	0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x12, 0x1e, 0x0a,
+	0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x14, 0x0a,
+	0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x5a, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x50, 0x6f,
+	0x73, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x1e, 0x0a, 0x0a, 0x6e, 0x65,
+	0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a,
+	0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x27, 0x0a, 0x05, 0x70, 0x6f,
+	0x73, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6f, 0x70, 0x65, 0x6e,
+	0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x05, 0x70, 0x6f,
+	0x73, 0x74, 0x73, 0x22, 0x64, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x4c, 0x69,
+	0x73, 0x74, 0x42, 0x79, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72,
+	0x73, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x60, 0x0a, 0x15, 0x47, 0x65, 0x74,
+	0x50, 0x6f, 0x73, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x79, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65,
+	0x73, 0x70, 0x12, 0x1e, 0x0a, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73,
+	0x6f, 0x72, 0x12, 0x27, 0x0a, 0x05, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x11, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e,
+	0x50, 0x6f, 0x73, 0x74, 0x52, 0x05, 0x70, 0x6f, 0x73, 0x74, 0x73, 0x22, 0x6d, 0x0a, 0x1d, 0x47,
+	0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x4c, 0x69, 0x73,
+	0x74, 0x42, 0x79, 0x50, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06,
+	0x70, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6f,
+	0x73, 0x74, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73,
+	0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75,
+	0x72, 0x73, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x69, 0x0a, 0x1e, 0x47, 0x65,
+	0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x4c, 0x69, 0x73, 0x74,
+	0x42, 0x79, 0x50, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x12, 0x1e, 0x0a, 0x0a,
+	0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x27, 0x0a, 0x05,
+	0x70, 0x6f, 0x73, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6f, 0x70,
+	0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x05,
+	0x70, 0x6f, 0x73, 0x74, 0x73, 0x22, 0x27, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50,
+	0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x44,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x22, 0x10,
+	0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x22, 0x33, 0x0a, 0x19, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x43,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a,
+	0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70,
+	0x6f, 0x73, 0x74, 0x49, 0x44, 0x22, 0x58, 0x0a, 0x1a, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x41,
+	0x6c, 0x6c, 0x6f, 0x77, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c, 0x61,
+	0x6c, 0x6c, 0x6f, 0x77, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0c, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x22,
+	0x33, 0x0a, 0x19, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x46, 0x6f,
+	0x72, 0x77, 0x61, 0x72, 0x64, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06,
+	0x70, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6f,
+	0x73, 0x74, 0x49, 0x44, 0x22, 0x58, 0x0a, 0x1a, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x41, 0x6c,
+	0x6c, 0x6f, 0x77, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c, 0x61, 0x6c,
+	0x6c, 0x6f, 0x77, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0c, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x22, 0x3f,
+	0x0a, 0x0b, 0x4c, 0x69, 0x6b, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a,
+	0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70,
+	0x6f, 0x73, 0x74, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x69, 0x73, 0x4c, 0x69, 0x6b, 0x65, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x69, 0x73, 0x4c, 0x69, 0x6b, 0x65, 0x64, 0x22,
+	0x28, 0x0a, 0x0c, 0x4c, 0x69, 0x6b, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12,
+	0x18, 0x0a, 0x07, 0x69, 0x73, 0x4c, 0x69, 0x6b, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x07, 0x69, 0x73, 0x4c, 0x69, 0x6b, 0x65, 0x64, 0x22, 0x4a, 0x0a, 0x0e, 0x43, 0x6f, 0x6c,
+	0x6c, 0x65, 0x63, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x70,
+	0x6f, 0x73, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6f, 0x73,
+	0x74, 0x49, 0x44, 0x12, 0x20, 0x0a, 0x0b, 0x69, 0x73, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
+	0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x69, 0x73, 0x43, 0x6f, 0x6c, 0x6c,
+	0x65, 0x63, 0x74, 0x65, 0x64, 0x22, 0x33, 0x0a, 0x0f, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
+	0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x20, 0x0a, 0x0b, 0x69, 0x73, 0x43, 0x6f,
+	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x69,
+	0x73, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64, 0x22, 0x58, 0x0a, 0x0e, 0x46, 0x6f,
+	0x72, 0x77, 0x61, 0x72, 0x64, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x12, 0x24, 0x0a, 0x0d,
+	0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x50, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x50, 0x6f, 0x73, 0x74,
+	0x49, 0x44, 0x12, 0x20, 0x0a, 0x0b, 0x69, 0x73, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x65,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x69, 0x73, 0x46, 0x6f, 0x72, 0x77, 0x61,
+	0x72, 0x64, 0x65, 0x64, 0x22, 0x33, 0x0a, 0x0f, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x50,
+	0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x20, 0x0a, 0x0b, 0x69, 0x73, 0x46, 0x6f, 0x72,
+	0x77, 0x61, 0x72, 0x64, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x69, 0x73,
+	0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x65, 0x64, 0x22, 0xca, 0x02, 0x0a, 0x10, 0x52, 0x65,
+	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x12, 0x1c,
+	0x0a, 0x09, 0x72, 0x65, 0x66, 0x50, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x72, 0x65, 0x66, 0x50, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x12, 0x36, 0x0a, 0x07,
+	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x07, 0x63, 0x6f, 0x6e,
+	0x74, 0x65, 0x6e, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x43, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x61, 0x6c, 0x6c, 0x6f,
+	0x77, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x61, 0x6c, 0x6c, 0x6f,
+	0x77, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c,
+	0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x12, 0x1c, 0x0a, 0x09,
+	0x61, 0x74, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x09, 0x61, 0x74, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x73, 0x12, 0x36, 0x0a, 0x09, 0x6d, 0x65,
+	0x64, 0x69, 0x61, 0x4d, 0x73, 0x67, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e,
+	0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x50, 0x6f,
+	0x73, 0x74, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x52, 0x09, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x4d, 0x73,
+	0x67, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69,
+	0x74, 0x79, 0x12, 0x22, 0x0a, 0x0c, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x55, 0x73, 0x65, 0x72, 0x49,
+	0x44, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x55,
+	0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x22, 0x13, 0x0a, 0x11, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x22, 0xd0, 0x02, 0x0a, 0x0e,
+	0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x12, 0x24,
+	0x0a, 0x0d, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x6f,
+	0x73, 0x74, 0x49, 0x44, 0x12, 0x36, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x22, 0x0a, 0x0c,
+	0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0c, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+	0x12, 0x22, 0x0a, 0x0c, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x46, 0x6f, 0x72,
+	0x77, 0x61, 0x72, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x74, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64,
+	0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x61, 0x74, 0x55, 0x73, 0x65, 0x72, 0x49,
+	0x64, 0x73, 0x12, 0x36, 0x0a, 0x09, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x4d, 0x73, 0x67, 0x73, 0x18,
+	0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63,
+	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x52,
+	0x09, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x4d, 0x73, 0x67, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x76, 0x69,
+	0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a,
+	0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x22, 0x0a, 0x0c, 0x61, 0x6c,
+	0x6c, 0x6f, 0x77, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x0c, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x22, 0x38,
+	0x0a, 0x0f, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x12, 0x25, 0x0a, 0x04, 0x70, 0x6f, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x11, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x50, 0x6f,
+	0x73, 0x74, 0x52, 0x04, 0x70, 0x6f, 0x73, 0x74, 0x22, 0x40, 0x0a, 0x0a, 0x50, 0x69, 0x6e, 0x50,
+	0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x44,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x12, 0x1a,
+	0x0a, 0x08, 0x69, 0x73, 0x50, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x08, 0x69, 0x73, 0x50, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x22, 0x0d, 0x0a, 0x0b, 0x50, 0x69,
+	0x6e, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x22, 0xb3, 0x01, 0x0a, 0x0b, 0x45, 0x64,
+	0x69, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f, 0x73,
+	0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49,
+	0x44, 0x12, 0x36, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x74, 0x55,
+	0x73, 0x65, 0x72, 0x49, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x61, 0x74,
+	0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x73, 0x12, 0x36, 0x0a, 0x09, 0x6d, 0x65, 0x64, 0x69, 0x61,
+	0x4d, 0x73, 0x67, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6f, 0x70, 0x65,
+	0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x4d,
+	0x65, 0x64, 0x69, 0x61, 0x52, 0x09, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x4d, 0x73, 0x67, 0x73, 0x22,
+	0x35, 0x0a, 0x0c, 0x45, 0x64, 0x69, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12,
+	0x25, 0x0a, 0x04, 0x70, 0x6f, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e,
+	0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x50, 0x6f, 0x73, 0x74,
+	0x52, 0x04, 0x70, 0x6f, 0x73, 0x74, 0x22, 0xd2, 0x01, 0x0a, 0x0c, 0x50, 0x6f, 0x73, 0x74, 0x52,
+	0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x12,
+	0x1a, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x63,
+	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f,
+	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x74, 0x55, 0x73, 0x65, 0x72, 0x49,
+	0x64, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x61, 0x74, 0x55, 0x73, 0x65, 0x72,
+	0x49, 0x64, 0x73, 0x12, 0x36, 0x0a, 0x09, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x4d, 0x73, 0x67, 0x73,
+	0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e,
+	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x4d, 0x65, 0x64, 0x69, 0x61,
+	0x52, 0x09, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x4d, 0x73, 0x67, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x2d, 0x0a, 0x13, 0x47,
+	0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52,
+	0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x22, 0x4f, 0x0a, 0x14, 0x47, 0x65,
+	0x74, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x12, 0x37, 0x0a, 0x09, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63,
+	0x68, 0x61, 0x74, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e,
+	0x52, 0x09, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x32, 0x0a, 0x16, 0x52,
+	0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x73, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x73, 0x22,
+	0x33, 0x0a, 0x17, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x50, 0x6f, 0x73, 0x74,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x18, 0x0a, 0x07, 0x75, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x64, 0x22, 0xff, 0x02, 0x0a, 0x09, 0x50, 0x6f, 0x73, 0x74, 0x44, 0x72, 0x61,
+	0x66, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x72, 0x61, 0x66, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x64, 0x72, 0x61, 0x66, 0x74, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07,
+	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63,
+	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x43,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x61, 0x6c,
+	0x6c, 0x6f, 0x77, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x61, 0x6c,
+	0x6c, 0x6f, 0x77, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0c, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x12, 0x1c,
+	0x0a, 0x09, 0x61, 0x74, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x09, 0x61, 0x74, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x73, 0x12, 0x36, 0x0a, 0x09,
+	0x6d, 0x65, 0x64, 0x69, 0x61, 0x4d, 0x73, 0x67, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x18, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e,
+	0x50, 0x6f, 0x73, 0x74, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x52, 0x09, 0x6d, 0x65, 0x64, 0x69, 0x61,
+	0x4d, 0x73, 0x67, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x76, 0x69, 0x73, 0x69, 0x62, 0x69, 0x6c, 0x69,
+	0x74, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x76, 0x69, 0x73, 0x69, 0x62, 0x69,
+	0x6c, 0x69, 0x74, 0x79, 0x12, 0x22, 0x0a, 0x0c, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x55, 0x73, 0x65,
+	0x72, 0x49, 0x44, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x61, 0x6c, 0x6c, 0x6f,
+	0x77, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x75, 0x62, 0x6c,
+	0x69, 0x73, 0x68, 0x41, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x70, 0x75, 0x62,
+	0x6c, 0x69, 0x73, 0x68, 0x41, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x54, 0x69, 0x6d, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x54, 0x69, 0x6d, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x22, 0xe0, 0x02, 0x0a, 0x0c, 0x53, 0x61, 0x76, 0x65, 0x44,
+	0x72, 0x61, 0x66, 0x74, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x72, 0x61, 0x66, 0x74,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x64, 0x72, 0x61, 0x66, 0x74, 0x49,
+	0x44, 0x12, 0x36, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x61, 0x6c, 0x6c,
+	0x6f, 0x77, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0c, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x22, 0x0a,
+	0x0c, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0c, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72,
+	0x64, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x74, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x73, 0x18, 0x05,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x61, 0x74, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x73, 0x12,
+	0x36, 0x0a, 0x09, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x4d, 0x73, 0x67, 0x73, 0x18, 0x06, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
+	0x6f, 0x6e, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x52, 0x09, 0x6d, 0x65,
+	0x64, 0x69, 0x61, 0x4d, 0x73, 0x67, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x76, 0x69, 0x73, 0x69, 0x62,
+	0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x76, 0x69, 0x73,
+	0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x22, 0x0a, 0x0c, 0x61, 0x6c, 0x6c, 0x6f, 0x77,
+	0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x61,
+	0x6c, 0x6c, 0x6f, 0x77, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x70,
+	0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x41, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
+	0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x41, 0x74, 0x22, 0x3d, 0x0a, 0x0d, 0x53, 0x61, 0x76,
+	0x65, 0x44, 0x72, 0x61, 0x66, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x2c, 0x0a, 0x05, 0x64, 0x72,
+	0x61, 0x66, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6f, 0x70, 0x65, 0x6e,
+	0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x44, 0x72, 0x61, 0x66,
+	0x74, 0x52, 0x05, 0x64, 0x72, 0x61, 0x66, 0x74, 0x22, 0x51, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74,
+	0x44, 0x72, 0x61, 0x66, 0x74, 0x73, 0x52, 0x65, 0x71, 0x12, 0x40, 0x0a, 0x0a, 0x70, 0x61, 0x67,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e,
+	0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x73, 0x64, 0x6b, 0x77, 0x73, 0x73, 0x2e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x56, 0x0a, 0x0e, 0x4c,
+	0x69, 0x73, 0x74, 0x44, 0x72, 0x61, 0x66, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x14, 0x0a,
+	0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x12, 0x2e, 0x0a, 0x06, 0x64, 0x72, 0x61, 0x66, 0x74, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61,
+	0x74, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x44, 0x72, 0x61, 0x66, 0x74, 0x52, 0x06, 0x64, 0x72, 0x61,
+	0x66, 0x74, 0x73, 0x22, 0x2c, 0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x72, 0x61,
+	0x66, 0x74, 0x52, 0x65, 0x71, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x72, 0x61, 0x66, 0x74, 0x49, 0x44,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x64, 0x72, 0x61, 0x66, 0x74, 0x49, 0x44,
+	0x73, 0x22, 0x11, 0x0a, 0x0f, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x72, 0x61, 0x66, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x22, 0xb1, 0x02, 0x0a, 0x0c, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x26, 0x0a, 0x0e, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6e,
+	0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x12, 0x0a,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0c, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x12, 0x37, 0x0a,
+	0x07, 0x6f, 0x70, 0x55, 0x73, 0x65, 0x72, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d,
+	0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x55,
+	0x73, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07, 0x6f,
+	0x70, 0x55, 0x73, 0x65, 0x72, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x70, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x6f, 0x70, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x16, 0x0a, 0x06, 0x69, 0x73, 0x52, 0x65, 0x61, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x06, 0x69, 0x73, 0x52, 0x65, 0x61, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x75, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x4b, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x4e,
+	0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x12,
+	0x1e, 0x0a, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12,
+	0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x77, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x1e, 0x0a,
+	0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x3f, 0x0a,
+	0x0d, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68,
+	0x61, 0x74, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x0d, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x1f,
+	0x0a, 0x1d, 0x47, 0x65, 0x74, 0x55, 0x6e, 0x72, 0x65, 0x61, 0x64, 0x4e, 0x6f, 0x74, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x22,
+	0x36, 0x0a, 0x1e, 0x47, 0x65, 0x74, 0x55, 0x6e, 0x72, 0x65, 0x61, 0x64, 0x4e, 0x6f, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x44, 0x0a, 0x18, 0x4d, 0x61, 0x72, 0x6b, 0x4e,
+	0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x61, 0x64,
+	0x52, 0x65, 0x71, 0x12, 0x28, 0x0a, 0x0f, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x49, 0x44, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x6e, 0x6f,
+	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x73, 0x22, 0x1b, 0x0a,
+	0x19, 0x4d, 0x61, 0x72, 0x6b, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x22, 0x1d, 0x0a, 0x1b, 0x4d, 0x61,
+	0x72, 0x6b, 0x41, 0x6c, 0x6c, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x22, 0x1e, 0x0a, 0x1c, 0x4d, 0x61, 0x72,
+	0x6b, 0x41, 0x6c, 0x6c, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x22, 0x2d, 0x0a, 0x0f, 0x43, 0x68, 0x65,
+	0x63, 0x6b, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x1a, 0x0a, 0x08,
+	0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x22, 0xaa, 0x02, 0x0a, 0x10, 0x43, 0x68, 0x65,
+	0x63, 0x6b, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x22, 0x0a,
+	0x0c, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0c, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x55, 0x52, 0x4c,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64,
+	0x55, 0x52, 0x4c, 0x12, 0x2a, 0x0a, 0x10, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x62,
+	0x75, 0x69, 0x6c, 0x64, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x12,
+	0x40, 0x0a, 0x1b, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x1b, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x74, 0x6c,
+	0x65, 0x12, 0x38, 0x0a, 0x17, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x17, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x6e,
+	0x65, 0x65, 0x64, 0x46, 0x6f, 0x72, 0x63, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x6e, 0x65, 0x65, 0x64, 0x46, 0x6f, 0x72, 0x63, 0x65, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x22, 0x10, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x46, 0x61, 0x6b, 0x65,
+	0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x22, 0x3f, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x46, 0x61,
+	0x6b, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x6e,
+	0x6c, 0x69, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6f, 0x6e, 0x6c, 0x69,
+	0x6e, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x32, 0xc9, 0x2e, 0x0a, 0x04, 0x63, 0x68, 0x61,
+	0x74, 0x12, 0x51, 0x0a, 0x0e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x49,
+	0x6e, 0x66, 0x6f, 0x12, 0x1e, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61,
+	0x74, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f,
+	0x52, 0x65, 0x71, 0x1a, 0x1f, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61,
+	0x74, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f,
+	0x52, 0x65, 0x73, 0x70, 0x12, 0x51, 0x0a, 0x0e, 0x41, 0x64, 0x64, 0x55, 0x73, 0x65, 0x72, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1e, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e,
+	0x63, 0x68, 0x61, 0x74, 0x2e, 0x41, 0x64, 0x64, 0x55, 0x73, 0x65, 0x72, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x1f, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e,
+	0x63, 0x68, 0x61, 0x74, 0x2e, 0x41, 0x64, 0x64, 0x55, 0x73, 0x65, 0x72, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x63, 0x0a, 0x14, 0x53, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x55, 0x73, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x49, 0x6e, 0x66, 0x6f, 0x12,
+	0x24, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x53, 0x65,
+	0x61, 0x72, 0x63, 0x68, 0x55, 0x73, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x49, 0x6e,
+	0x66, 0x6f, 0x52, 0x65, 0x71, 0x1a, 0x25, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63,
+	0x68, 0x61, 0x74, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x55, 0x73, 0x65, 0x72, 0x50, 0x75,
+	0x62, 0x6c, 0x69, 0x63, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x12, 0x5d, 0x0a, 0x12,
+	0x46, 0x69, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x49, 0x6e,
+	0x66, 0x6f, 0x12, 0x22, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74,
+	0x2e, 0x46, 0x69, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x49,
+	0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x1a, 0x23, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e,
+	0x63, 0x68, 0x61, 0x74, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x50, 0x75, 0x62,
+	0x6c, 0x69, 0x63, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x12, 0x72, 0x0a, 0x1a, 0x46,
+	0x69, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x42, 0x79, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x4f, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2a, 0x2e, 0x6f, 0x70, 0x65, 0x6e,
+	0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72,
+	0x42, 0x79, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x4f, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x28, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63,
+	0x68, 0x61, 0x74, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6c,
+	0x69, 0x63, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x4f, 0x66, 0x4f, 0x6e, 0x65, 0x12,
+	0x5d, 0x0a, 0x12, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x55, 0x73, 0x65, 0x72, 0x46, 0x75, 0x6c,
+	0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x22, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63,
+	0x68, 0x61, 0x74, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x55, 0x73, 0x65, 0x72, 0x46, 0x75,
+	0x6c, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x1a, 0x23, 0x2e, 0x6f, 0x70, 0x65, 0x6e,
+	0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x55, 0x73,
+	0x65, 0x72, 0x46, 0x75, 0x6c, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x12, 0x57,
+	0x0a, 0x10, 0x46, 0x69, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x46, 0x75, 0x6c, 0x6c, 0x49, 0x6e,
+	0x66, 0x6f, 0x12, 0x20, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74,
+	0x2e, 0x46, 0x69, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x46, 0x75, 0x6c, 0x6c, 0x49, 0x6e, 0x66,
+	0x6f, 0x52, 0x65, 0x71, 0x1a, 0x21, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68,
+	0x61, 0x74, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x46, 0x75, 0x6c, 0x6c, 0x49,
+	0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x12, 0x51, 0x0a, 0x0e, 0x53, 0x65, 0x6e, 0x64, 0x56,
+	0x65, 0x72, 0x69, 0x66, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1e, 0x2e, 0x6f, 0x70, 0x65, 0x6e,
+	0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x56, 0x65, 0x72, 0x69,
+	0x66, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x1f, 0x2e, 0x6f, 0x70, 0x65, 0x6e,
+	0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x56, 0x65, 0x72, 0x69,
+	0x66, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x45, 0x0a, 0x0a, 0x56, 0x65,
+	0x72, 0x69, 0x66, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1a, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69,
+	0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x43, 0x6f, 0x64,
+	0x65, 0x52, 0x65, 0x71, 0x1a, 0x1b, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68,
+	0x61, 0x74, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x12, 0x51, 0x0a, 0x0e, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x4e, 0x6f,
+	0x6e, 0x63, 0x65, 0x12, 0x1e, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61,
+	0x74, 0x2e, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x4e, 0x6f, 0x6e, 0x63, 0x65,
+	0x52, 0x65, 0x71, 0x1a, 0x1f, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61,
+	0x74, 0x2e, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x4e, 0x6f, 0x6e, 0x63, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x12, 0x4b, 0x0a, 0x0c, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72,
+	0x55, 0x73, 0x65, 0x72, 0x12, 0x1c, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68,
+	0x61, 0x74, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x55, 0x73, 0x65, 0x72, 0x52,
+	0x65, 0x71, 0x1a, 0x1d, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74,
+	0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73,
+	0x70, 0x12, 0x36, 0x0a, 0x05, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x15, 0x2e, 0x6f, 0x70, 0x65,
+	0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65,
+	0x71, 0x1a, 0x16, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e,
+	0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x42, 0x0a, 0x09, 0x41, 0x64, 0x64,
+	0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x12, 0x19, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e,
+	0x63, 0x68, 0x61, 0x74, 0x2e, 0x41, 0x64, 0x64, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x52, 0x65,
+	0x71, 0x1a, 0x1a, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e,
+	0x41, 0x64, 0x64, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x48, 0x0a,
+	0x0b, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x73, 0x12, 0x1b, 0x2e, 0x6f,
+	0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x57,
+	0x61, 0x6c, 0x6c, 0x65, 0x74, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x1c, 0x2e, 0x6f, 0x70, 0x65, 0x6e,
+	0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x61, 0x6c, 0x6c,
+	0x65, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x4b, 0x0a, 0x0c, 0x52, 0x65, 0x6d, 0x6f, 0x76,
+	0x65, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x12, 0x1c, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d,
+	0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x57, 0x61, 0x6c, 0x6c,
+	0x65, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x1d, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63,
+	0x68, 0x61, 0x74, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x12, 0x4e, 0x0a, 0x0d, 0x52, 0x65, 0x73, 0x65, 0x74, 0x50, 0x61, 0x73,
+	0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x1d, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63,
+	0x68, 0x61, 0x74, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
+	0x64, 0x52, 0x65, 0x71, 0x1a, 0x1e, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68,
+	0x61, 0x74, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64,
+	0x52, 0x65, 0x73, 0x70, 0x12, 0x51, 0x0a, 0x0e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x61,
+	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x1e, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e,
+	0x63, 0x68, 0x61, 0x74, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x61, 0x73, 0x73, 0x77,
+	0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x1a, 0x1f, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e,
+	0x63, 0x68, 0x61, 0x74, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x61, 0x73, 0x73, 0x77,
+	0x6f, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x12, 0x51, 0x0a, 0x0e, 0x43, 0x68, 0x65, 0x63, 0x6b,
+	0x55, 0x73, 0x65, 0x72, 0x45, 0x78, 0x69, 0x73, 0x74, 0x12, 0x1e, 0x2e, 0x6f, 0x70, 0x65, 0x6e,
+	0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x55, 0x73, 0x65,
+	0x72, 0x45, 0x78, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x1f, 0x2e, 0x6f, 0x70, 0x65, 0x6e,
+	0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x55, 0x73, 0x65,
+	0x72, 0x45, 0x78, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x51, 0x0a, 0x0e, 0x44, 0x65,
+	0x6c, 0x55, 0x73, 0x65, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1e, 0x2e, 0x6f,
+	0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x44, 0x65, 0x6c, 0x55, 0x73,
+	0x65, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x1f, 0x2e, 0x6f,
+	0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x44, 0x65, 0x6c, 0x55, 0x73,
+	0x65, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x69, 0x0a,
+	0x16, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x26, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d,
+	0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x1a,
+	0x27, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x66, 0x0a, 0x15, 0x43, 0x61, 0x6e, 0x63,
+	0x65, 0x6c, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x25, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e,
+	0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x26, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69,
+	0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x12, 0x5d, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x22, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e,
+	0x63, 0x68, 0x61, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x23, 0x2e, 0x6f, 0x70, 0x65,
+	0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12,
+	0x5a, 0x0a, 0x11, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x44, 0x61, 0x74, 0x61, 0x45, 0x78,
+	0x70, 0x6f, 0x72, 0x74, 0x12, 0x21, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68,
+	0x61, 0x74, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x44, 0x61, 0x74, 0x61, 0x45, 0x78,
+	0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x22, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d,
+	0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x44, 0x61, 0x74,
+	0x61, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x4e, 0x0a, 0x0d, 0x47,
+	0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x1d, 0x2e, 0x6f,
+	0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x61,
+	0x74, 0x61, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x1e, 0x2e, 0x6f, 0x70,
+	0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x61, 0x74,
+	0x61, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x51, 0x0a, 0x0e, 0x4f,
+	0x70, 0x65, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x1e, 0x2e,
+	0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x4f, 0x70, 0x65, 0x6e,
+	0x44, 0x61, 0x74, 0x61, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x1f, 0x2e,
+	0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x4f, 0x70, 0x65, 0x6e,
+	0x44, 0x61, 0x74, 0x61, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x5a,
+	0x0a, 0x11, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x44, 0x61, 0x74, 0x61, 0x45, 0x78, 0x70,
+	0x6f, 0x72, 0x74, 0x12, 0x21, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61,
+	0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x44, 0x61, 0x74, 0x61, 0x45, 0x78, 0x70,
+	0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x22, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e,
+	0x63, 0x68, 0x61, 0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x44, 0x61, 0x74, 0x61,
+	0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x5a, 0x0a, 0x11, 0x52, 0x65,
+	0x6c, 0x65, 0x61, 0x73, 0x65, 0x44, 0x61, 0x74, 0x61, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x12,
+	0x21, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x52, 0x65,
+	0x6c, 0x65, 0x61, 0x73, 0x65, 0x44, 0x61, 0x74, 0x61, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52,
+	0x65, 0x71, 0x1a, 0x22, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74,
+	0x2e, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x44, 0x61, 0x74, 0x61, 0x45, 0x78, 0x70, 0x6f,
+	0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x54, 0x0a, 0x0f, 0x46, 0x69, 0x6e, 0x64, 0x55, 0x73,
+	0x65, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1f, 0x2e, 0x6f, 0x70, 0x65, 0x6e,
+	0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x20, 0x2e, 0x6f, 0x70, 0x65,
+	0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x55, 0x73, 0x65,
+	0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x54, 0x0a, 0x0f,
+	0x46, 0x69, 0x6e, 0x64, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x12,
+	0x1f, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x46, 0x69,
+	0x6e, 0x64, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71,
+	0x1a, 0x20, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x46,
+	0x69, 0x6e, 0x64, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65,
+	0x73, 0x70, 0x12, 0x51, 0x0a, 0x0e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x61, 0x6c, 0x6c,
+	0x62, 0x61, 0x63, 0x6b, 0x12, 0x1e, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68,
+	0x61, 0x74, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63,
+	0x6b, 0x52, 0x65, 0x71, 0x1a, 0x1f, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68,
+	0x61, 0x74, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x43, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63,
+	0x6b, 0x52, 0x65, 0x73, 0x70, 0x12, 0x51, 0x0a, 0x0e, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x6f, 0x67,
+	0x69, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1e, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d,
+	0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x1f, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d,
+	0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x51, 0x0a, 0x0e, 0x53, 0x65, 0x61, 0x72,
+	0x63, 0x68, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1e, 0x2e, 0x6f, 0x70, 0x65,
+	0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x55,
+	0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x1a, 0x1f, 0x2e, 0x6f, 0x70, 0x65,
+	0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x55,
+	0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x12, 0x6c, 0x0a, 0x17, 0x47,
+	0x65, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x46, 0x6f, 0x72, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x4d,
+	0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x27, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e,
+	0x63, 0x68, 0x61, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x46, 0x6f, 0x72,
+	0x56, 0x69, 0x64, 0x65, 0x6f, 0x4d, 0x65, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x1a,
+	0x28, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x47, 0x65,
+	0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x46, 0x6f, 0x72, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x4d, 0x65,
+	0x65, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x12, 0x5d, 0x0a, 0x12, 0x73, 0x61, 0x76,
+	0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x54, 0x6f, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x12,
+	0x22, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x73, 0x61,
+	0x76, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x54, 0x6f, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74,
+	0x52, 0x65, 0x71, 0x1a, 0x23, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61,
+	0x74, 0x2e, 0x73, 0x61, 0x76, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x54, 0x6f, 0x43, 0x6f, 0x6e,
+	0x74, 0x61, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x69, 0x0a, 0x16, 0x64, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x46, 0x72, 0x6f, 0x6d, 0x43, 0x6f, 0x6e, 0x74, 0x61,
+	0x63, 0x74, 0x12, 0x26, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74,
+	0x2e, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x46, 0x72, 0x6f, 0x6d,
+	0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x27, 0x2e, 0x6f, 0x70, 0x65,
+	0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x46, 0x72, 0x6f, 0x6d, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x12, 0x60, 0x0a, 0x13, 0x67, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x46,
+	0x72, 0x6f, 0x6d, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x12, 0x23, 0x2e, 0x6f, 0x70, 0x65,
+	0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x67, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x46, 0x72, 0x6f, 0x6d, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x52, 0x65, 0x71, 0x1a,
+	0x24, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x67, 0x65,
+	0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x46, 0x72, 0x6f, 0x6d, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x94, 0x01, 0x0a, 0x27, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x55, 0x73, 0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x46, 0x72, 0x6f, 0x6d, 0x52, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e,
+	0x74, 0x12, 0x33, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x70, 0x70, 0x6c, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x72, 0x6f, 0x6d, 0x52, 0x65, 0x63, 0x69, 0x70, 0x69,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x34, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e,
+	0x63, 0x68, 0x61, 0x74, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x72, 0x6f, 0x6d, 0x52,
+	0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x94, 0x01, 0x0a,
+	0x27, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x72, 0x6f, 0x6d, 0x41,
+	0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x6e, 0x74, 0x12, 0x33, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69,
+	0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x72, 0x6f,
+	0x6d, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x34, 0x2e,
+	0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x46, 0x72, 0x6f, 0x6d, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x6e, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x12, 0x83, 0x01, 0x0a, 0x21, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73,
+	0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x46, 0x72, 0x6f, 0x6d, 0x41, 0x6c, 0x6c, 0x12, 0x2e, 0x2e, 0x6f, 0x70, 0x65, 0x6e,
+	0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x72,
+	0x6f, 0x6d, 0x41, 0x6c, 0x6c, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x2e, 0x2e, 0x6f, 0x70, 0x65, 0x6e,
+	0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x72,
+	0x6f, 0x6d, 0x41, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x12, 0x4e, 0x0a, 0x0d, 0x67, 0x65, 0x74,
+	0x41, 0x6c, 0x6c, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x12, 0x1d, 0x2e, 0x6f, 0x70, 0x65,
+	0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x55,
+	0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x1e, 0x2e, 0x6f, 0x70, 0x65, 0x6e,
+	0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x55, 0x73,
+	0x65, 0x72, 0x49, 0x44, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x48, 0x0a, 0x0b, 0x50, 0x75, 0x62,
+	0x6c, 0x69, 0x73, 0x68, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x1b, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69,
+	0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x50, 0x6f,
+	0x73, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x1c, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63,
+	0x68, 0x61, 0x74, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x50, 0x6f, 0x73, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x12, 0x51, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x54, 0x79, 0x70,
+	0x65, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x1e, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63,
+	0x68, 0x61, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x50, 0x6f,
+	0x73, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x1f, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63,
+	0x68, 0x61, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x50, 0x6f,
+	0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x48, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73,
+	0x74, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1b, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63,
+	0x68, 0x61, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x52,
+	0x65, 0x71, 0x1a, 0x1c, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74,
+	0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x12, 0x5a, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x42,
+	0x79, 0x55, 0x73, 0x65, 0x72, 0x12, 0x21, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63,
+	0x68, 0x61, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x42,
+	0x79, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x22, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69,
+	0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x4c, 0x69,
+	0x73, 0x74, 0x42, 0x79, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x12, 0x75, 0x0a, 0x1a,
+	0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x4c, 0x69,
+	0x73, 0x74, 0x42, 0x79, 0x50, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x12, 0x2a, 0x2e, 0x6f, 0x70, 0x65,
+	0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x79, 0x50, 0x6f, 0x73,
+	0x74, 0x49, 0x44, 0x52, 0x65, 0x71, 0x1a, 0x2b, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e,
+	0x63, 0x68, 0x61, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x50,
+	0x6f, 0x73, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x79, 0x50, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x52,
+	0x65, 0x73, 0x70, 0x12, 0x48, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x42, 0x79,
+	0x49, 0x44, 0x12, 0x1b, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74,
+	0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x71, 0x1a,
+	0x1c, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x47, 0x65,
+	0x74, 0x50, 0x6f, 0x73, 0x74, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x12, 0x45, 0x0a,
+	0x0a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x1a, 0x2e, 0x6f, 0x70,
+	0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x1b, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d,
+	0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x6f, 0x73, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x12, 0x69, 0x0a, 0x16, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x41, 0x6c,
+	0x6c, 0x6f, 0x77, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x26,
+	0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x43, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x50,
+	0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x27, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e,
+	0x63, 0x68, 0x61, 0x74, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x41, 0x6c, 0x6c, 0x6f, 0x77,
+	0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12,
+	0x69, 0x0a, 0x16, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x46, 0x6f,
+	0x72, 0x77, 0x61, 0x72, 0x64, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x26, 0x2e, 0x6f, 0x70, 0x65, 0x6e,
+	0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x41, 0x6c,
+	0x6c, 0x6f, 0x77, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65,
+	0x71, 0x1a, 0x27, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e,
+	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x46, 0x6f, 0x72, 0x77, 0x61,
+	0x72, 0x64, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x45, 0x0a, 0x0e, 0x43, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x4c, 0x69, 0x6b, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x18, 0x2e, 0x6f,
+	0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x4c, 0x69, 0x6b, 0x65, 0x50,
+	0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x19, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e,
+	0x63, 0x68, 0x61, 0x74, 0x2e, 0x4c, 0x69, 0x6b, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x12, 0x4e, 0x0a, 0x11, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65,
+	0x63, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x1b, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e,
+	0x63, 0x68, 0x61, 0x74, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x50, 0x6f, 0x73, 0x74,
+	0x52, 0x65, 0x71, 0x1a, 0x1c, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61,
+	0x74, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x12, 0x3c, 0x0a, 0x07, 0x50, 0x69, 0x6e, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x17, 0x2e, 0x6f,
+	0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x50, 0x69, 0x6e, 0x50, 0x6f,
+	0x73, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x18, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63,
+	0x68, 0x61, 0x74, 0x2e, 0x50, 0x69, 0x6e, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12,
+	0x48, 0x0a, 0x0b, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x1b,
+	0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x46, 0x6f, 0x72,
+	0x77, 0x61, 0x72, 0x64, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x1c, 0x2e, 0x6f, 0x70,
+	0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72,
+	0x64, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x48, 0x0a, 0x0b, 0x43, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x12, 0x1b, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69,
+	0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x6f,
+	0x73, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x1c, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63,
+	0x68, 0x61, 0x74, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x12, 0x4e, 0x0a, 0x0d, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0x50, 0x6f, 0x73, 0x74, 0x12, 0x1d, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68,
+	0x61, 0x74, 0x2e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x50, 0x6f, 0x73, 0x74,
+	0x52, 0x65, 0x71, 0x1a, 0x1e, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61,
+	0x74, 0x2e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x12, 0x3f, 0x0a, 0x08, 0x45, 0x64, 0x69, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x12,
+	0x18, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x45, 0x64,
+	0x69, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x19, 0x2e, 0x6f, 0x70, 0x65, 0x6e,
+	0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x45, 0x64, 0x69, 0x74, 0x50, 0x6f, 0x73, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x12, 0x57, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x52,
+	0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x20, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69,
+	0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65,
+	0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x21, 0x2e, 0x6f, 0x70, 0x65,
+	0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x73, 0x74,
+	0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x60, 0x0a,
+	0x13, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x50, 0x6f, 0x73, 0x74, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x73, 0x12, 0x23, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68,
+	0x61, 0x74, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c, 0x65, 0x50, 0x6f, 0x73, 0x74,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x24, 0x2e, 0x6f, 0x70, 0x65, 0x6e,
+	0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x63, 0x69, 0x6c,
+	0x65, 0x50, 0x6f, 0x73, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12,
+	0x42, 0x0a, 0x09, 0x53, 0x61, 0x76, 0x65, 0x44, 0x72, 0x61, 0x66, 0x74, 0x12, 0x19, 0x2e, 0x6f,
+	0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x53, 0x61, 0x76, 0x65, 0x44,
+	0x72, 0x61, 0x66, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x1a, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d,
+	0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x53, 0x61, 0x76, 0x65, 0x44, 0x72, 0x61, 0x66, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x12, 0x45, 0x0a, 0x0a, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x72, 0x61, 0x66, 0x74,
+	0x73, 0x12, 0x1a, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x44, 0x72, 0x61, 0x66, 0x74, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x1b, 0x2e,
+	0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x44, 0x72, 0x61, 0x66, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x48, 0x0a, 0x0b, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x44, 0x72, 0x61, 0x66, 0x74, 0x12, 0x1b, 0x2e, 0x6f, 0x70, 0x65, 0x6e,
+	0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x72,
+	0x61, 0x66, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x1c, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e,
+	0x63, 0x68, 0x61, 0x74, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x72, 0x61, 0x66, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x12, 0x57, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x20, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69,
+	0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x21, 0x2e, 0x6f, 0x70, 0x65,
+	0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x75, 0x0a,
+	0x1a, 0x47, 0x65, 0x74, 0x55, 0x6e, 0x72, 0x65, 0x61, 0x64, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2a, 0x2e, 0x6f, 0x70,
+	0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x6e, 0x72,
+	0x65, 0x61, 0x64, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x2b, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d,
+	0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x6e, 0x72, 0x65, 0x61, 0x64, 0x4e,
+	0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x12, 0x66, 0x0a, 0x15, 0x4d, 0x61, 0x72, 0x6b, 0x4e, 0x6f, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x61, 0x64, 0x12, 0x25, 0x2e,
+	0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x4d, 0x61, 0x72, 0x6b,
+	0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x61,
+	0x64, 0x52, 0x65, 0x71, 0x1a, 0x26, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68,
+	0x61, 0x74, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x12, 0x6f, 0x0a, 0x18,
+	0x4d, 0x61, 0x72, 0x6b, 0x41, 0x6c, 0x6c, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x61, 0x64, 0x12, 0x28, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69,
+	0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x41, 0x6c, 0x6c, 0x4e, 0x6f,
+	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x61, 0x64, 0x52,
+	0x65, 0x71, 0x1a, 0x29, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74,
+	0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x41, 0x6c, 0x6c, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x12, 0x4b, 0x0a,
+	0x0c, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x2e,
+	0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x43, 0x68, 0x65, 0x63,
+	0x6b, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x1d, 0x2e, 0x6f, 0x70,
+	0x65, 0x6e, 0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x56,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x48, 0x0a, 0x0b, 0x47, 0x65,
+	0x74, 0x46, 0x61, 0x6b, 0x65, 0x55, 0x73, 0x65, 0x72, 0x12, 0x1b, 0x2e, 0x6f, 0x70, 0x65, 0x6e,
+	0x69, 0x6d, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x61, 0x6b, 0x65, 0x55,
+	0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x1c, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x2e,
+	0x63, 0x68, 0x61, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x61, 0x6b, 0x65, 0x55, 0x73, 0x65, 0x72,
+	0x52, 0x65, 0x73, 0x70, 0x42, 0x2d, 0x5a, 0x2b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x6f, 0x70, 0x65, 0x6e, 0x69, 0x6d, 0x73, 0x64, 0x6b, 0x2f, 0x63, 0x68, 0x61,
+	0x74, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x63,
+	0x68, 0x61, 0x74, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_chat_chat_proto_rawDescOnce sync.Once
+	file_chat_chat_proto_rawDescData = file_chat_chat_proto_rawDesc
+)
+
+func file_chat_chat_proto_rawDescGZIP() []byte {
+	file_chat_chat_proto_rawDescOnce.Do(func() {
+		file_chat_chat_proto_rawDescData = protoimpl.X.CompressGZIP(file_chat_chat_proto_rawDescData)
+	})
+	return file_chat_chat_proto_rawDescData
+}
+
+var file_chat_chat_proto_msgTypes = make([]protoimpl.MessageInfo, 152)
+var file_chat_chat_proto_goTypes = []any{
+	(*OnlineTime)(nil),                              // 0: openim.chat.onlineTime
+	(*GetUsersTimeReq)(nil),                         // 1: openim.chat.getUsersTimeReq
+	(*GetUsersTimeResp)(nil),                        // 2: openim.chat.getUsersTimeResp
+	(*UserIdentity)(nil),                            // 3: openim.chat.UserIdentity
+	(*UpdateUserInfoReq)(nil),                       // 4: openim.chat.UpdateUserInfoReq
+	(*UpdateUserInfoResp)(nil),                      // 5: openim.chat.UpdateUserInfoResp
+	(*FindUserPublicInfoReq)(nil),                   // 6: openim.chat.FindUserPublicInfoReq
+	(*FindUserPublicInfoRespOfOne)(nil),             // 7: openim.chat.FindUserPublicInfoRespOfOne
+	(*FindUserPublicInfoResp)(nil),                  // 8: openim.chat.FindUserPublicInfoResp
+	(*SearchUserPublicInfoReq)(nil),                 // 9: openim.chat.SearchUserPublicInfoReq
+	(*SearchUserPublicInfoResp)(nil),                // 10: openim.chat.SearchUserPublicInfoResp
+	(*FindUserFullInfoReq)(nil),                     // 11: openim.chat.FindUserFullInfoReq
+	(*FindUserFullInfoResp)(nil),                    // 12: openim.chat.FindUserFullInfoResp
+	(*GetAllUserIDsReq)(nil),                        // 13: openim.chat.GetAllUserIDsReq
+	(*GetAllUserIDsResp)(nil),                       // 14: openim.chat.GetAllUserIDsResp
+	(*SendVerifyCodeReq)(nil),                       // 15: openim.chat.SendVerifyCodeReq
+	(*SendVerifyCodeResp)(nil),                      // 16: openim.chat.SendVerifyCodeResp
+	(*VerifyCodeReq)(nil),                           // 17: openim.chat.VerifyCodeReq
+	(*VerifyCodeResp)(nil),                          // 18: openim.chat.VerifyCodeResp
+	(*ChallengeNonceReq)(nil),                       // 19: openim.chat.ChallengeNonceReq
+	(*ChallengeNonceResp)(nil),                      // 20: openim.chat.ChallengeNonceResp
+	(*RegisterUserInfo)(nil),                        // 21: openim.chat.RegisterUserInfo
+	(*RegisterUserReq)(nil),                         // 22: openim.chat.RegisterUserReq
+	(*RegisterUserResp)(nil),                        // 23: openim.chat.RegisterUserResp
+	(*AddUserAccountReq)(nil),                       // 24: openim.chat.AddUserAccountReq
+	(*AddUserAccountResp)(nil),                      // 25: openim.chat.AddUserAccountResp
+	(*LoginReq)(nil),                                // 26: openim.chat.LoginReq
+	(*ResetPasswordReq)(nil),                        // 27: openim.chat.ResetPasswordReq
+	(*ResetPasswordResp)(nil),                       // 28: openim.chat.ResetPasswordResp
+	(*ChangePasswordReq)(nil),                       // 29: openim.chat.ChangePasswordReq
+	(*ChangePasswordResp)(nil),                      // 30: openim.chat.ChangePasswordResp
+	(*FindUserAccountReq)(nil),                      // 31: openim.chat.FindUserAccountReq
+	(*FindUserByAddressOrAccountReq)(nil),           // 32: openim.chat.FindUserByAddressOrAccountReq
+	(*FindUserAccountResp)(nil),                     // 33: openim.chat.FindUserAccountResp
+	(*FindAccountUserReq)(nil),                      // 34: openim.chat.FindAccountUserReq
+	(*FindAccountUserResp)(nil),                     // 35: openim.chat.FindAccountUserResp
+	(*SignalRecord)(nil),                            // 36: openim.chat.SignalRecord
+	(*OpenIMCallbackReq)(nil),                       // 37: openim.chat.OpenIMCallbackReq
+	(*OpenIMCallbackResp)(nil),                      // 38: openim.chat.OpenIMCallbackResp
+	(*SearchUserFullInfoReq)(nil),                   // 39: openim.chat.SearchUserFullInfoReq
+	(*SearchUserFullInfoResp)(nil),                  // 40: openim.chat.SearchUserFullInfoResp
+	(*UserLoginCountReq)(nil),                       // 41: openim.chat.UserLoginCountReq
+	(*UserLoginCountResp)(nil),                      // 42: openim.chat.UserLoginCountResp
+	(*LoginResp)(nil),                               // 43: openim.chat.LoginResp
+	(*WalletInfo)(nil),                              // 44: openim.chat.WalletInfo
+	(*AddWalletReq)(nil),                            // 45: openim.chat.AddWalletReq
+	(*AddWalletResp)(nil),                           // 46: openim.chat.AddWalletResp
+	(*ListWalletsReq)(nil),                          // 47: openim.chat.ListWalletsReq
+	(*ListWalletsResp)(nil),                         // 48: openim.chat.ListWalletsResp
+	(*RemoveWalletReq)(nil),                         // 49: openim.chat.RemoveWalletReq
+	(*RemoveWalletResp)(nil),                        // 50: openim.chat.RemoveWalletResp
+	(*SearchUserInfoReq)(nil),                       // 51: openim.chat.SearchUserInfoReq
+	(*SearchUserInfoResp)(nil),                      // 52: openim.chat.SearchUserInfoResp
+	(*GetTokenForVideoMeetingReq)(nil),              // 53: openim.chat.GetTokenForVideoMeetingReq
+	(*GetTokenForVideoMeetingResp)(nil),             // 54: openim.chat.GetTokenForVideoMeetingResp
+	(*CheckUserExistReq)(nil),                       // 55: openim.chat.CheckUserExistReq
+	(*CheckUserExistResp)(nil),                      // 56: openim.chat.CheckUserExistResp
+	(*DelUserAccountReq)(nil),                       // 57: openim.chat.DelUserAccountReq
+	(*DelUserAccountResp)(nil),                      // 58: openim.chat.DelUserAccountResp
+	(*ErasedRecord)(nil),                            // 59: openim.chat.ErasedRecord
+	(*AccountDeletion)(nil),                         // 60: openim.chat.AccountDeletion
+	(*RequestAccountDeletionReq)(nil),               // 61: openim.chat.RequestAccountDeletionReq
+	(*RequestAccountDeletionResp)(nil),              // 62: openim.chat.RequestAccountDeletionResp
+	(*CancelAccountDeletionReq)(nil),                // 63: openim.chat.CancelAccountDeletionReq
+	(*CancelAccountDeletionResp)(nil),               // 64: openim.chat.CancelAccountDeletionResp
+	(*GetAccountDeletionReq)(nil),                   // 65: openim.chat.GetAccountDeletionReq
+	(*GetAccountDeletionResp)(nil),                  // 66: openim.chat.GetAccountDeletionResp
+	(*DataExport)(nil),                              // 67: openim.chat.DataExport
+	(*RequestDataExportReq)(nil),                    // 68: openim.chat.RequestDataExportReq
+	(*RequestDataExportResp)(nil),                   // 69: openim.chat.RequestDataExportResp
+	(*GetDataExportReq)(nil),                        // 70: openim.chat.GetDataExportReq
+	(*GetDataExportResp)(nil),                       // 71: openim.chat.GetDataExportResp
+	(*OpenDataExportReq)(nil),                       // 72: openim.chat.OpenDataExportReq
+	(*OpenDataExportResp)(nil),                      // 73: openim.chat.OpenDataExportResp
+	(*ConsumeDataExportReq)(nil),                    // 74: openim.chat.ConsumeDataExportReq
+	(*ConsumeDataExportResp)(nil),                   // 75: openim.chat.ConsumeDataExportResp
+	(*ReleaseDataExportReq)(nil),                    // 76: openim.chat.ReleaseDataExportReq
+	(*ReleaseDataExportResp)(nil),                   // 77: openim.chat.ReleaseDataExportResp
+	(*GetGroupFromContactReq)(nil),                  // 78: openim.chat.getGroupFromContactReq
+	(*GetGroupFromContactResp)(nil),                 // 79: openim.chat.getGroupFromContactResp
+	(*SaveGroupToContactReq)(nil),                   // 80: openim.chat.saveGroupToContactReq
+	(*SaveGroupToContactResp)(nil),                  // 81: openim.chat.saveGroupToContactResp
+	(*DeleteGroupFromContactReq)(nil),               // 82: openim.chat.deleteGroupFromContactReq
+	(*DeleteGroupFromContactResp)(nil),              // 83: openim.chat.deleteGroupFromContactResp
+	(*DeleteGroupApplicationFromRecipientReq)(nil),  // 84: openim.chat.DeleteGroupApplicationFromRecipientReq
+	(*DeleteGroupApplicationFromRecipientResp)(nil), // 85: openim.chat.DeleteGroupApplicationFromRecipientResp
+	(*DeleteGroupApplicationFromApplicantReq)(nil),  // 86: openim.chat.DeleteGroupApplicationFromApplicantReq
+	(*DeleteGroupApplicationFromApplicantResp)(nil), // 87: openim.chat.DeleteGroupApplicationFromApplicantResp
+	(*DeleteGroupApplicationFromAlltReq)(nil),       // 88: openim.chat.DeleteGroupApplicationFromAlltReq
+	(*DeleteGroupApplicationFromAllResp)(nil),       // 89: openim.chat.DeleteGroupApplicationFromAllResp
+	(*Post)(nil),                                    // 90: openim.chat.Post
+	(*PublishPostReq)(nil),                          // 91: openim.chat.PublishPostReq
+	(*PublishPostResp)(nil),                         // 92: openim.chat.PublishPostResp
+	(*GetPostByIDReq)(nil),                          // 93: openim.chat.GetPostByIDReq
+	(*GetPostByIDResp)(nil),                         // 94: openim.chat.GetPostByIDResp
+	(*GetAllTypePostReq)(nil),                       // 95: openim.chat.GetAllTypePostReq
+	(*GetAllTypePostResp)(nil),                      // 96: openim.chat.GetAllTypePostResp
+	(*TypePosts)(nil),                               // 97: openim.chat.TypePosts
+	(*GetPostListReq)(nil),                          // 98: openim.chat.GetPostListReq
+	(*GetPostListResp)(nil),                         // 99: openim.chat.GetPostListResp
+	(*GetPostListByUserReq)(nil),                    // 100: openim.chat.GetPostListByUserReq
+	(*GetPostListByUserResp)(nil),                   // 101: openim.chat.GetPostListByUserResp
+	(*GetCommentPostListByPostIDReq)(nil),           // 102: openim.chat.GetCommentPostListByPostIDReq
+	(*GetCommentPostListByPostIDResp)(nil),          // 103: openim.chat.GetCommentPostListByPostIDResp
+	(*DeletePostReq)(nil),                           // 104: openim.chat.DeletePostReq
+	(*DeletePostResp)(nil),                          // 105: openim.chat.DeletePostResp
+	(*ChangeAllowCommentPostReq)(nil),               // 106: openim.chat.ChangeAllowCommentPostReq
+	(*ChangeAllowCommentPostResp)(nil),              // 107: openim.chat.ChangeAllowCommentPostResp
+	(*ChangeAllowForwardPostReq)(nil),               // 108: openim.chat.ChangeAllowForwardPostReq
+	(*ChangeAllowForwardPostResp)(nil),              // 109: openim.chat.ChangeAllowForwardPostResp
+	(*LikePostReq)(nil),                             // 110: openim.chat.LikePostReq
+	(*LikePostResp)(nil),                            // 111: openim.chat.LikePostResp
+	(*CollectPostReq)(nil),                          // 112: openim.chat.CollectPostReq
+	(*CollectPostResp)(nil),                         // 113: openim.chat.CollectPostResp
+	(*ForwardPostReq)(nil),                          // 114: openim.chat.ForwardPostReq
+	(*ForwardPostResp)(nil),                         // 115: openim.chat.ForwardPostResp
+	(*ReferencePostReq)(nil),                        // 116: openim.chat.ReferencePostReq
+	(*ReferencePostResp)(nil),                       // 117: openim.chat.ReferencePostResp
+	(*CommentPostReq)(nil),                          // 118: openim.chat.CommentPostReq
+	(*CommentPostResp)(nil),                         // 119: openim.chat.CommentPostResp
+	(*PinPostReq)(nil),                              // 120: openim.chat.PinPostReq
+	(*PinPostResp)(nil),                             // 121: openim.chat.PinPostResp
+	(*EditPostReq)(nil),                             // 122: openim.chat.EditPostReq
+	(*EditPostResp)(nil),                            // 123: openim.chat.EditPostResp
+	(*PostRevision)(nil),                            // 124: openim.chat.PostRevision
+	(*GetPostRevisionsReq)(nil),                     // 125: openim.chat.GetPostRevisionsReq
+	(*GetPostRevisionsResp)(nil),                    // 126: openim.chat.GetPostRevisionsResp
+	(*ReconcilePostCountsReq)(nil),                  // 127: openim.chat.ReconcilePostCountsReq
+	(*ReconcilePostCountsResp)(nil),                 // 128: openim.chat.ReconcilePostCountsResp
+	(*PostDraft)(nil),                               // 129: openim.chat.PostDraft
+	(*SaveDraftReq)(nil),                            // 130: openim.chat.SaveDraftReq
+	(*SaveDraftResp)(nil),                           // 131: openim.chat.SaveDraftResp
+	(*ListDraftsReq)(nil),                           // 132: openim.chat.ListDraftsReq
+	(*ListDraftsResp)(nil),                          // 133: openim.chat.ListDraftsResp
+	(*DeleteDraftReq)(nil),                          // 134: openim.chat.DeleteDraftReq
+	(*DeleteDraftResp)(nil),                         // 135: openim.chat.DeleteDraftResp
+	(*Notification)(nil),                            // 136: openim.chat.Notification
+	(*GetNotificationsReq)(nil),                     // 137: openim.chat.GetNotificationsReq
+	(*GetNotificationsResp)(nil),                    // 138: openim.chat.GetNotificationsResp
+	(*GetUnreadNotificationCountReq)(nil),           // 139: openim.chat.GetUnreadNotificationCountReq
+	(*GetUnreadNotificationCountResp)(nil),          // 140: openim.chat.GetUnreadNotificationCountResp
+	(*MarkNotificationsReadReq)(nil),                // 141: openim.chat.MarkNotificationsReadReq
+	(*MarkNotificationsReadResp)(nil),               // 142: openim.chat.MarkNotificationsReadResp
+	(*MarkAllNotificationsReadReq)(nil),             // 143: openim.chat.MarkAllNotificationsReadReq
+	(*MarkAllNotificationsReadResp)(nil),            // 144: openim.chat.MarkAllNotificationsReadResp
+	(*CheckVersionReq)(nil),                         // 145: openim.chat.CheckVersionReq
+	(*CheckVersionResp)(nil),                        // 146: openim.chat.CheckVersionResp
+	(*GetFakeUserReq)(nil),                          // 147: openim.chat.GetFakeUserReq
+	(*GetFakeUserResp)(nil),                         // 148: openim.chat.GetFakeUserResp
+	nil,                                             // 149: openim.chat.FindUserAccountResp.UserAccountMapEntry
+	nil,                                             // 150: openim.chat.FindAccountUserResp.AccountUserMapEntry
+	nil,                                             // 151: openim.chat.UserLoginCountResp.CountEntry
+	(*wrapperspb.StringValue)(nil),                  // 152: openim.protobuf.StringValue
+	(*wrapperspb.Int32Value)(nil),                   // 153: openim.protobuf.Int32Value
+	(*common.UserPublicInfo)(nil),                   // 154: openim.common.UserPublicInfo
+	(*sdkwss.RequestPagination)(nil),                // 155: openim.sdkwss.RequestPagination
+	(*common.UserFullInfo)(nil),                     // 156: openim.common.UserFullInfo
+	(*common.PostMedia)(nil),                        // 157: openim.common.PostMedia
+}
+var file_chat_chat_proto_depIdxs = []int32{
+	0,   // 0: openim.chat.getUsersTimeResp.timeList:type_name -> openim.chat.onlineTime
+	152, // 1: openim.chat.UpdateUserInfoReq.account:type_name -> openim.protobuf.StringValue
+	152, // 2: openim.chat.UpdateUserInfoReq.nickname:type_name -> openim.protobuf.StringValue
+	152, // 3: openim.chat.UpdateUserInfoReq.faceURL:type_name -> openim.protobuf.StringValue
+	152, // 4: openim.chat.UpdateUserInfoReq.coverURL:type_name -> openim.protobuf.StringValue
+	152, // 5: openim.chat.UpdateUserInfoReq.about:type_name -> openim.protobuf.StringValue
+	153, // 6: openim.chat.UpdateUserInfoReq.allowAddFriend:type_name -> openim.protobuf.Int32Value
+	153, // 7: openim.chat.UpdateUserInfoReq.allowBeep:type_name -> openim.protobuf.Int32Value
+	153, // 8: openim.chat.UpdateUserInfoReq.allowVibration:type_name -> openim.protobuf.Int32Value
+	153, // 9: openim.chat.UpdateUserInfoReq.globalRecvMsgOpt:type_name -> openim.protobuf.Int32Value
+	153, // 10: openim.chat.UpdateUserInfoReq.RegisterType:type_name -> openim.protobuf.Int32Value
+	152, // 11: openim.chat.UpdateUserInfoResp.faceURL:type_name -> openim.protobuf.StringValue
+	152, // 12: openim.chat.UpdateUserInfoResp.coverURL:type_name -> openim.protobuf.StringValue
+	152, // 13: openim.chat.UpdateUserInfoResp.about:type_name -> openim.protobuf.StringValue
+	154, // 14: openim.chat.FindUserPublicInfoRespOfOne.user:type_name -> openim.common.UserPublicInfo
+	154, // 15: openim.chat.FindUserPublicInfoResp.users:type_name -> openim.common.UserPublicInfo
+	155, // 16: openim.chat.SearchUserPublicInfoReq.pagination:type_name -> openim.sdkwss.RequestPagination
+	154, // 17: openim.chat.SearchUserPublicInfoResp.users:type_name -> openim.common.UserPublicInfo
+	156, // 18: openim.chat.FindUserFullInfoResp.users:type_name -> openim.common.UserFullInfo
+	21,  // 19: openim.chat.RegisterUserReq.user:type_name -> openim.chat.RegisterUserInfo
+	21,  // 20: openim.chat.AddUserAccountReq.user:type_name -> openim.chat.RegisterUserInfo
+	149, // 21: openim.chat.FindUserAccountResp.userAccountMap:type_name -> openim.chat.FindUserAccountResp.UserAccountMapEntry
+	150, // 22: openim.chat.FindAccountUserResp.accountUserMap:type_name -> openim.chat.FindAccountUserResp.AccountUserMapEntry
+	154, // 23: openim.chat.SignalRecord.inviterUserList:type_name -> openim.common.UserPublicInfo
+	155, // 24: openim.chat.SearchUserFullInfoReq.pagination:type_name -> openim.sdkwss.RequestPagination
+	156, // 25: openim.chat.SearchUserFullInfoResp.users:type_name -> openim.common.UserFullInfo
+	151, // 26: openim.chat.UserLoginCountResp.count:type_name -> openim.chat.UserLoginCountResp.CountEntry
+	44,  // 27: openim.chat.ListWalletsResp.wallets:type_name -> openim.chat.WalletInfo
+	155, // 28: openim.chat.SearchUserInfoReq.pagination:type_name -> openim.sdkwss.RequestPagination
+	156, // 29: openim.chat.SearchUserInfoResp.users:type_name -> openim.common.UserFullInfo
+	21,  // 30: openim.chat.CheckUserExistReq.user:type_name -> openim.chat.RegisterUserInfo
+	59,  // 31: openim.chat.AccountDeletion.records:type_name -> openim.chat.ErasedRecord
+	60,  // 32: openim.chat.RequestAccountDeletionResp.deletion:type_name -> openim.chat.AccountDeletion
+	60,  // 33: openim.chat.GetAccountDeletionResp.deletion:type_name -> openim.chat.AccountDeletion
+	67,  // 34: openim.chat.RequestDataExportResp.export:type_name -> openim.chat.DataExport
+	67,  // 35: openim.chat.GetDataExportResp.export:type_name -> openim.chat.DataExport
+	154, // 36: openim.chat.Post.userInfo:type_name -> openim.common.UserPublicInfo
+	157, // 37: openim.chat.Post.mediaMsgs:type_name -> openim.common.PostMedia
+	154, // 38: openim.chat.Post.atUserInfoList:type_name -> openim.common.UserPublicInfo
+	90,  // 39: openim.chat.Post.forwardPost:type_name -> openim.chat.Post
+	90,  // 40: openim.chat.Post.commentPost:type_name -> openim.chat.Post
+	90,  // 41: openim.chat.Post.refPost:type_name -> openim.chat.Post
+	152, // 42: openim.chat.PublishPostReq.content:type_name -> openim.protobuf.StringValue
+	157, // 43: openim.chat.PublishPostReq.mediaMsgs:type_name -> openim.common.PostMedia
+	90,  // 44: openim.chat.PublishPostResp.post:type_name -> openim.chat.Post
+	90,  // 45: openim.chat.GetPostByIDResp.post:type_name -> openim.chat.Post
+	97,  // 46: openim.chat.GetAllTypePostResp.allPosts:type_name -> openim.chat.TypePosts
+	90,  // 47: openim.chat.TypePosts.posts:type_name -> openim.chat.Post
+	90,  // 48: openim.chat.GetPostListResp.posts:type_name -> openim.chat.Post
+	90,  // 49: openim.chat.GetPostListByUserResp.posts:type_name -> openim.chat.Post
+	90,  // 50: openim.chat.GetCommentPostListByPostIDResp.posts:type_name -> openim.chat.Post
+	152, // 51: openim.chat.ReferencePostReq.content:type_name -> openim.protobuf.StringValue
+	157, // 52: openim.chat.ReferencePostReq.mediaMsgs:type_name -> openim.common.PostMedia
+	152, // 53: openim.chat.CommentPostReq.content:type_name -> openim.protobuf.StringValue
+	157, // 54: openim.chat.CommentPostReq.mediaMsgs:type_name -> openim.common.PostMedia
+	90,  // 55: openim.chat.CommentPostResp.post:type_name -> openim.chat.Post
+	152, // 56: openim.chat.EditPostReq.content:type_name -> openim.protobuf.StringValue
+	157, // 57: openim.chat.EditPostReq.mediaMsgs:type_name -> openim.common.PostMedia
+	90,  // 58: openim.chat.EditPostResp.post:type_name -> openim.chat.Post
+	157, // 59: openim.chat.PostRevision.mediaMsgs:type_name -> openim.common.PostMedia
+	124, // 60: openim.chat.GetPostRevisionsResp.revisions:type_name -> openim.chat.PostRevision
+	157, // 61: openim.chat.PostDraft.mediaMsgs:type_name -> openim.common.PostMedia
+	152, // 62: openim.chat.SaveDraftReq.content:type_name -> openim.protobuf.StringValue
+	157, // 63: openim.chat.SaveDraftReq.mediaMsgs:type_name -> openim.common.PostMedia
+	129, // 64: openim.chat.SaveDraftResp.draft:type_name -> openim.chat.PostDraft
+	155, // 65: openim.chat.ListDraftsReq.pagination:type_name -> openim.sdkwss.RequestPagination
+	129, // 66: openim.chat.ListDraftsResp.drafts:type_name -> openim.chat.PostDraft
+	154, // 67: openim.chat.Notification.opUsers:type_name -> openim.common.UserPublicInfo
+	136, // 68: openim.chat.GetNotificationsResp.notifications:type_name -> openim.chat.Notification
+	4,   // 69: openim.chat.chat.UpdateUserInfo:input_type -> openim.chat.UpdateUserInfoReq
+	24,  // 70: openim.chat.chat.AddUserAccount:input_type -> openim.chat.AddUserAccountReq
+	9,   // 71: openim.chat.chat.SearchUserPublicInfo:input_type -> openim.chat.SearchUserPublicInfoReq
+	6,   // 72: openim.chat.chat.FindUserPublicInfo:input_type -> openim.chat.FindUserPublicInfoReq
+	32,  // 73: openim.chat.chat.FindUserByAddressOrAccount:input_type -> openim.chat.FindUserByAddressOrAccountReq
+	39,  // 74: openim.chat.chat.SearchUserFullInfo:input_type -> openim.chat.SearchUserFullInfoReq
+	11,  // 75: openim.chat.chat.FindUserFullInfo:input_type -> openim.chat.FindUserFullInfoReq
+	15,  // 76: openim.chat.chat.SendVerifyCode:input_type -> openim.chat.SendVerifyCodeReq
+	17,  // 77: openim.chat.chat.VerifyCode:input_type -> openim.chat.VerifyCodeReq
+	19,  // 78: openim.chat.chat.ChallengeNonce:input_type -> openim.chat.ChallengeNonceReq
+	22,  // 79: openim.chat.chat.RegisterUser:input_type -> openim.chat.RegisterUserReq
+	26,  // 80: openim.chat.chat.Login:input_type -> openim.chat.LoginReq
+	45,  // 81: openim.chat.chat.AddWallet:input_type -> openim.chat.AddWalletReq
+	47,  // 82: openim.chat.chat.ListWallets:input_type -> openim.chat.ListWalletsReq
+	49,  // 83: openim.chat.chat.RemoveWallet:input_type -> openim.chat.RemoveWalletReq
+	27,  // 84: openim.chat.chat.ResetPassword:input_type -> openim.chat.ResetPasswordReq
+	29,  // 85: openim.chat.chat.ChangePassword:input_type -> openim.chat.ChangePasswordReq
+	55,  // 86: openim.chat.chat.CheckUserExist:input_type -> openim.chat.CheckUserExistReq
+	57,  // 87: openim.chat.chat.DelUserAccount:input_type -> openim.chat.DelUserAccountReq
+	61,  // 88: openim.chat.chat.RequestAccountDeletion:input_type -> openim.chat.RequestAccountDeletionReq
+	63,  // 89: openim.chat.chat.CancelAccountDeletion:input_type -> openim.chat.CancelAccountDeletionReq
+	65,  // 90: openim.chat.chat.GetAccountDeletion:input_type -> openim.chat.GetAccountDeletionReq
+	68,  // 91: openim.chat.chat.RequestDataExport:input_type -> openim.chat.RequestDataExportReq
+	70,  // 92: openim.chat.chat.GetDataExport:input_type -> openim.chat.GetDataExportReq
+	72,  // 93: openim.chat.chat.OpenDataExport:input_type -> openim.chat.OpenDataExportReq
+	74,  // 94: openim.chat.chat.ConsumeDataExport:input_type -> openim.chat.ConsumeDataExportReq
+	76,  // 95: openim.chat.chat.ReleaseDataExport:input_type -> openim.chat.ReleaseDataExportReq
+	31,  // 96: openim.chat.chat.FindUserAccount:input_type -> openim.chat.FindUserAccountReq
+	34,  // 97: openim.chat.chat.FindAccountUser:input_type -> openim.chat.FindAccountUserReq
+	37,  // 98: openim.chat.chat.OpenIMCallback:input_type -> openim.chat.OpenIMCallbackReq
+	41,  // 99: openim.chat.chat.UserLoginCount:input_type -> openim.chat.UserLoginCountReq
+	51,  // 100: openim.chat.chat.SearchUserInfo:input_type -> openim.chat.SearchUserInfoReq
+	53,  // 101: openim.chat.chat.GetTokenForVideoMeeting:input_type -> openim.chat.GetTokenForVideoMeetingReq
+	80,  // 102: openim.chat.chat.saveGroupToContact:input_type -> openim.chat.saveGroupToContactReq
+	82,  // 103: openim.chat.chat.deleteGroupFromContact:input_type -> openim.chat.deleteGroupFromContactReq
+	78,  // 104: openim.chat.chat.getGroupFromContact:input_type -> openim.chat.getGroupFromContactReq
+	84,  // 105: openim.chat.chat.deleteUserGroupApplicationFromRecipient:input_type -> openim.chat.DeleteGroupApplicationFromRecipientReq
+	86,  // 106: openim.chat.chat.deleteUserGroupApplicationFromApplicant:input_type -> openim.chat.DeleteGroupApplicationFromApplicantReq
+	88,  // 107: openim.chat.chat.deleteUserGroupApplicationFromAll:input_type -> openim.chat.DeleteGroupApplicationFromAlltReq
+	13,  // 108: openim.chat.chat.getAllUserIDs:input_type -> openim.chat.GetAllUserIDsReq
+	91,  // 109: openim.chat.chat.PublishPost:input_type -> openim.chat.PublishPostReq
+	95,  // 110: openim.chat.chat.GetAllTypePost:input_type -> openim.chat.GetAllTypePostReq
+	98,  // 111: openim.chat.chat.GetPostList:input_type -> openim.chat.GetPostListReq
+	100, // 112: openim.chat.chat.GetPostListByUser:input_type -> openim.chat.GetPostListByUserReq
+	102, // 113: openim.chat.chat.GetCommentPostListByPostID:input_type -> openim.chat.GetCommentPostListByPostIDReq
+	93,  // 114: openim.chat.chat.GetPostByID:input_type -> openim.chat.GetPostByIDReq
+	104, // 115: openim.chat.chat.DeletePost:input_type -> openim.chat.DeletePostReq
+	106, // 116: openim.chat.chat.ChangeAllowCommentPost:input_type -> openim.chat.ChangeAllowCommentPostReq
+	108, // 117: openim.chat.chat.ChangeAllowForwardPost:input_type -> openim.chat.ChangeAllowForwardPostReq
+	110, // 118: openim.chat.chat.ChangeLikePost:input_type -> openim.chat.LikePostReq
+	112, // 119: openim.chat.chat.ChangeCollectPost:input_type -> openim.chat.CollectPostReq
+	120, // 120: openim.chat.chat.PinPost:input_type -> openim.chat.PinPostReq
+	114, // 121: openim.chat.chat.ForwardPost:input_type -> openim.chat.ForwardPostReq
+	118, // 122: openim.chat.chat.CommentPost:input_type -> openim.chat.CommentPostReq
+	116, // 123: openim.chat.chat.ReferencePost:input_type -> openim.chat.ReferencePostReq
+	122, // 124: openim.chat.chat.EditPost:input_type -> openim.chat.EditPostReq
+	125, // 125: openim.chat.chat.GetPostRevisions:input_type -> openim.chat.GetPostRevisionsReq
+	127, // 126: openim.chat.chat.ReconcilePostCounts:input_type -> openim.chat.ReconcilePostCountsReq
+	130, // 127: openim.chat.chat.SaveDraft:input_type -> openim.chat.SaveDraftReq
+	132, // 128: openim.chat.chat.ListDrafts:input_type -> openim.chat.ListDraftsReq
+	134, // 129: openim.chat.chat.DeleteDraft:input_type -> openim.chat.DeleteDraftReq
+	137, // 130: openim.chat.chat.GetNotifications:input_type -> openim.chat.GetNotificationsReq
+	139, // 131: openim.chat.chat.GetUnreadNotificationCount:input_type -> openim.chat.GetUnreadNotificationCountReq
+	141, // 132: openim.chat.chat.MarkNotificationsRead:input_type -> openim.chat.MarkNotificationsReadReq
+	143, // 133: openim.chat.chat.MarkAllNotificationsRead:input_type -> openim.chat.MarkAllNotificationsReadReq
+	145, // 134: openim.chat.chat.CheckVersion:input_type -> openim.chat.CheckVersionReq
+	147, // 135: openim.chat.chat.GetFakeUser:input_type -> openim.chat.GetFakeUserReq
+	5,   // 136: openim.chat.chat.UpdateUserInfo:output_type -> openim.chat.UpdateUserInfoResp
+	25,  // 137: openim.chat.chat.AddUserAccount:output_type -> openim.chat.AddUserAccountResp
+	10,  // 138: openim.chat.chat.SearchUserPublicInfo:output_type -> openim.chat.SearchUserPublicInfoResp
+	8,   // 139: openim.chat.chat.FindUserPublicInfo:output_type -> openim.chat.FindUserPublicInfoResp
+	7,   // 140: openim.chat.chat.FindUserByAddressOrAccount:output_type -> openim.chat.FindUserPublicInfoRespOfOne
+	40,  // 141: openim.chat.chat.SearchUserFullInfo:output_type -> openim.chat.SearchUserFullInfoResp
+	12,  // 142: openim.chat.chat.FindUserFullInfo:output_type -> openim.chat.FindUserFullInfoResp
+	16,  // 143: openim.chat.chat.SendVerifyCode:output_type -> openim.chat.SendVerifyCodeResp
+	18,  // 144: openim.chat.chat.VerifyCode:output_type -> openim.chat.VerifyCodeResp
+	20,  // 145: openim.chat.chat.ChallengeNonce:output_type -> openim.chat.ChallengeNonceResp
+	23,  // 146: openim.chat.chat.RegisterUser:output_type -> openim.chat.RegisterUserResp
+	43,  // 147: openim.chat.chat.Login:output_type -> openim.chat.LoginResp
+	46,  // 148: openim.chat.chat.AddWallet:output_type -> openim.chat.AddWalletResp
+	48,  // 149: openim.chat.chat.ListWallets:output_type -> openim.chat.ListWalletsResp
+	50,  // 150: openim.chat.chat.RemoveWallet:output_type -> openim.chat.RemoveWalletResp
+	28,  // 151: openim.chat.chat.ResetPassword:output_type -> openim.chat.ResetPasswordResp
+	30,  // 152: openim.chat.chat.ChangePassword:output_type -> openim.chat.ChangePasswordResp
+	56,  // 153: openim.chat.chat.CheckUserExist:output_type -> openim.chat.CheckUserExistResp
+	58,  // 154: openim.chat.chat.DelUserAccount:output_type -> openim.chat.DelUserAccountResp
+	62,  // 155: openim.chat.chat.RequestAccountDeletion:output_type -> openim.chat.RequestAccountDeletionResp
+	64,  // 156: openim.chat.chat.CancelAccountDeletion:output_type -> openim.chat.CancelAccountDeletionResp
+	66,  // 157: openim.chat.chat.GetAccountDeletion:output_type -> openim.chat.GetAccountDeletionResp
+	69,  // 158: openim.chat.chat.RequestDataExport:output_type -> openim.chat.RequestDataExportResp
+	71,  // 159: openim.chat.chat.GetDataExport:output_type -> openim.chat.GetDataExportResp
+	73,  // 160: openim.chat.chat.OpenDataExport:output_type -> openim.chat.OpenDataExportResp
+	75,  // 161: openim.chat.chat.ConsumeDataExport:output_type -> openim.chat.ConsumeDataExportResp
+	77,  // 162: openim.chat.chat.ReleaseDataExport:output_type -> openim.chat.ReleaseDataExportResp
+	33,  // 163: openim.chat.chat.FindUserAccount:output_type -> openim.chat.FindUserAccountResp
+	35,  // 164: openim.chat.chat.FindAccountUser:output_type -> openim.chat.FindAccountUserResp
+	38,  // 165: openim.chat.chat.OpenIMCallback:output_type -> openim.chat.OpenIMCallbackResp
+	42,  // 166: openim.chat.chat.UserLoginCount:output_type -> openim.chat.UserLoginCountResp
+	52,  // 167: openim.chat.chat.SearchUserInfo:output_type -> openim.chat.SearchUserInfoResp
+	54,  // 168: openim.chat.chat.GetTokenForVideoMeeting:output_type -> openim.chat.GetTokenForVideoMeetingResp
+	81,  // 169: openim.chat.chat.saveGroupToContact:output_type -> openim.chat.saveGroupToContactResp
+	83,  // 170: openim.chat.chat.deleteGroupFromContact:output_type -> openim.chat.deleteGroupFromContactResp
+	79,  // 171: openim.chat.chat.getGroupFromContact:output_type -> openim.chat.getGroupFromContactResp
+	85,  // 172: openim.chat.chat.deleteUserGroupApplicationFromRecipient:output_type -> openim.chat.DeleteGroupApplicationFromRecipientResp
+	87,  // 173: openim.chat.chat.deleteUserGroupApplicationFromApplicant:output_type -> openim.chat.DeleteGroupApplicationFromApplicantResp
+	89,  // 174: openim.chat.chat.deleteUserGroupApplicationFromAll:output_type -> openim.chat.DeleteGroupApplicationFromAllResp
+	14,  // 175: openim.chat.chat.getAllUserIDs:output_type -> openim.chat.GetAllUserIDsResp
+	92,  // 176: openim.chat.chat.PublishPost:output_type -> openim.chat.PublishPostResp
+	96,  // 177: openim.chat.chat.GetAllTypePost:output_type -> openim.chat.GetAllTypePostResp
+	99,  // 178: openim.chat.chat.GetPostList:output_type -> openim.chat.GetPostListResp
+	101, // 179: openim.chat.chat.GetPostListByUser:output_type -> openim.chat.GetPostListByUserResp
+	103, // 180: openim.chat.chat.GetCommentPostListByPostID:output_type -> openim.chat.GetCommentPostListByPostIDResp
+	94,  // 181: openim.chat.chat.GetPostByID:output_type -> openim.chat.GetPostByIDResp
+	105, // 182: openim.chat.chat.DeletePost:output_type -> openim.chat.DeletePostResp
+	107, // 183: openim.chat.chat.ChangeAllowCommentPost:output_type -> openim.chat.ChangeAllowCommentPostResp
+	109, // 184: openim.chat.chat.ChangeAllowForwardPost:output_type -> openim.chat.ChangeAllowForwardPostResp
+	111, // 185: openim.chat.chat.ChangeLikePost:output_type -> openim.chat.LikePostResp
+	113, // 186: openim.chat.chat.ChangeCollectPost:output_type -> openim.chat.CollectPostResp
+	121, // 187: openim.chat.chat.PinPost:output_type -> openim.chat.PinPostResp
+	115, // 188: openim.chat.chat.ForwardPost:output_type -> openim.chat.ForwardPostResp
+	119, // 189: openim.chat.chat.CommentPost:output_type -> openim.chat.CommentPostResp
+	117, // 190: openim.chat.chat.ReferencePost:output_type -> openim.chat.ReferencePostResp
+	123, // 191: openim.chat.chat.EditPost:output_type -> openim.chat.EditPostResp
+	126, // 192: openim.chat.chat.GetPostRevisions:output_type -> openim.chat.GetPostRevisionsResp
+	128, // 193: openim.chat.chat.ReconcilePostCounts:output_type -> openim.chat.ReconcilePostCountsResp
+	131, // 194: openim.chat.chat.SaveDraft:output_type -> openim.chat.SaveDraftResp
+	133, // 195: openim.chat.chat.ListDrafts:output_type -> openim.chat.ListDraftsResp
+	135, // 196: openim.chat.chat.DeleteDraft:output_type -> openim.chat.DeleteDraftResp
+	138, // 197: openim.chat.chat.GetNotifications:output_type -> openim.chat.GetNotificationsResp
+	140, // 198: openim.chat.chat.GetUnreadNotificationCount:output_type -> openim.chat.GetUnreadNotificationCountResp
+	142, // 199: openim.chat.chat.MarkNotificationsRead:output_type -> openim.chat.MarkNotificationsReadResp
+	144, // 200: openim.chat.chat.MarkAllNotificationsRead:output_type -> openim.chat.MarkAllNotificationsReadResp
+	146, // 201: openim.chat.chat.CheckVersion:output_type -> openim.chat.CheckVersionResp
+	148, // 202: openim.chat.chat.GetFakeUser:output_type -> openim.chat.GetFakeUserResp
+	136, // [136:203] is the sub-list for method output_type
+	69,  // [69:136] is the sub-list for method input_type
+	69,  // [69:69] is the sub-list for extension type_name
+	69,  // [69:69] is the sub-list for extension extendee
+	0,   // [0:69] is the sub-list for field type_name
+}
+
+func init() { file_chat_chat_proto_init() }
+func file_chat_chat_proto_init() {
+	if File_chat_chat_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_chat_chat_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*OnlineTime); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_chat_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*GetUsersTimeReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_chat_proto_msgTypes[2].Exporter = func(v any, i int) any {
+			switch v := v.(*GetUsersTimeResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_chat_proto_msgTypes[3].Exporter = func(v any, i int) any {
+			switch v := v.(*UserIdentity); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_chat_proto_msgTypes[4].Exporter = func(v any, i int) any {
+			switch v := v.(*UpdateUserInfoReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_chat_proto_msgTypes[5].Exporter = func(v any, i int) any {
+			switch v := v.(*UpdateUserInfoResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_chat_proto_msgTypes[6].Exporter = func(v any, i int) any {
+			switch v := v.(*FindUserPublicInfoReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_chat_proto_msgTypes[7].Exporter = func(v any, i int) any {
+			switch v := v.(*FindUserPublicInfoRespOfOne); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_chat_proto_msgTypes[8].Exporter = func(v any, i int) any {
+			switch v := v.(*FindUserPublicInfoResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_chat_proto_msgTypes[9].Exporter = func(v any, i int) any {
+			switch v := v.(*SearchUserPublicInfoReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_chat_proto_msgTypes[10].Exporter = func(v any, i int) any {
+			switch v := v.(*SearchUserPublicInfoResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_chat_proto_msgTypes[11].Exporter = func(v any, i int) any {
+			switch v := v.(*FindUserFullInfoReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_chat_proto_msgTypes[12].Exporter = func(v any, i int) any {
+			switch v := v.(*FindUserFullInfoResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_chat_proto_msgTypes[13].Exporter = func(v any, i int) any {
+			switch v := v.(*GetAllUserIDsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_chat_proto_msgTypes[14].Exporter = func(v any, i int) any {
+			switch v := v.(*GetAllUserIDsResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_chat_proto_msgTypes[15].Exporter = func(v any, i int) any {
+			switch v := v.(*SendVerifyCodeReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_chat_proto_msgTypes[16].Exporter = func(v any, i int) any {
+			switch v := v.(*SendVerifyCodeResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_chat_proto_msgTypes[17].Exporter = func(v any, i int) any {
+			switch v := v.(*VerifyCodeReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_chat_proto_msgTypes[18].Exporter = func(v any, i int) any {
+			switch v := v.(*VerifyCodeResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_chat_proto_msgTypes[19].Exporter = func(v any, i int) any {
+			switch v := v.(*ChallengeNonceReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_chat_proto_msgTypes[20].Exporter = func(v any, i int) any {
+			switch v := v.(*ChallengeNonceResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_chat_proto_msgTypes[21].Exporter = func(v any, i int) any {
+			switch v := v.(*RegisterUserInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_chat_proto_msgTypes[22].Exporter = func(v any, i int) any {
+			switch v := v.(*RegisterUserReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_chat_proto_msgTypes[23].Exporter = func(v any, i int) any {
+			switch v := v.(*RegisterUserResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_chat_proto_msgTypes[24].Exporter = func(v any, i int) any {
+			switch v := v.(*AddUserAccountReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_chat_proto_msgTypes[25].Exporter = func(v any, i int) any {
+			switch v := v.(*AddUserAccountResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_chat_proto_msgTypes[26].Exporter = func(v any, i int) any {
+			switch v := v.(*LoginReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_chat_proto_msgTypes[27].Exporter = func(v any, i int) any {
+			switch v := v.(*ResetPasswordReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_chat_proto_msgTypes[28].Exporter = func(v any, i int) any {
+			switch v := v.(*ResetPasswordResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_chat_proto_msgTypes[29].Exporter = func(v any, i int) any {
+			switch v := v.(*ChangePasswordReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_chat_proto_msgTypes[30].Exporter = func(v any, i int) any {
+			switch v := v.(*ChangePasswordResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_chat_proto_msgTypes[31].Exporter = func(v any, i int) any {
+			switch v := v.(*FindUserAccountReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_chat_proto_msgTypes[32].Exporter = func(v any, i int) any {
+			switch v := v.(*FindUserByAddressOrAccountReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_chat_proto_msgTypes[33].Exporter = func(v any, i int) any {
+			switch v := v.(*FindUserAccountResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_chat_proto_msgTypes[34].Exporter = func(v any, i int) any {
+			switch v := v.(*FindAccountUserReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_chat_proto_msgTypes[35].Exporter = func(v any, i int) any {
+			switch v := v.(*FindAccountUserResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_chat_proto_msgTypes[36].Exporter = func(v any, i int) any {
+			switch v := v.(*SignalRecord); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_chat_proto_msgTypes[37].Exporter = func(v any, i int) any {
+			switch v := v.(*OpenIMCallbackReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_chat_proto_msgTypes[38].Exporter = func(v any, i int) any {
+			switch v := v.(*OpenIMCallbackResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_chat_proto_msgTypes[39].Exporter = func(v any, i int) any {
+			switch v := v.(*SearchUserFullInfoReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_chat_proto_msgTypes[40].Exporter = func(v any, i int) any {
+			switch v := v.(*SearchUserFullInfoResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_chat_proto_msgTypes[41].Exporter = func(v any, i int) any {
+			switch v := v.(*UserLoginCountReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_chat_proto_msgTypes[42].Exporter = func(v any, i int) any {
+			switch v := v.(*UserLoginCountResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_chat_proto_msgTypes[43].Exporter = func(v any, i int) any {
+			switch v := v.(*LoginResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_chat_proto_msgTypes[44].Exporter = func(v any, i int) any {
+			switch v := v.(*WalletInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7178,8 +11254,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[1].Exporter = func(v any, i int) any {
-			switch v := v.(*GetUsersTimeReq); i {
+		file_chat_chat_proto_msgTypes[45].Exporter = func(v any, i int) any {
+			switch v := v.(*AddWalletReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7190,8 +11266,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[2].Exporter = func(v any, i int) any {
-			switch v := v.(*GetUsersTimeResp); i {
+		file_chat_chat_proto_msgTypes[46].Exporter = func(v any, i int) any {
+			switch v := v.(*AddWalletResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7202,8 +11278,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[3].Exporter = func(v any, i int) any {
-			switch v := v.(*UserIdentity); i {
+		file_chat_chat_proto_msgTypes[47].Exporter = func(v any, i int) any {
+			switch v := v.(*ListWalletsReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7214,8 +11290,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[4].Exporter = func(v any, i int) any {
-			switch v := v.(*UpdateUserInfoReq); i {
+		file_chat_chat_proto_msgTypes[48].Exporter = func(v any, i int) any {
+			switch v := v.(*ListWalletsResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7226,8 +11302,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[5].Exporter = func(v any, i int) any {
-			switch v := v.(*UpdateUserInfoResp); i {
+		file_chat_chat_proto_msgTypes[49].Exporter = func(v any, i int) any {
+			switch v := v.(*RemoveWalletReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7238,8 +11314,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[6].Exporter = func(v any, i int) any {
-			switch v := v.(*FindUserPublicInfoReq); i {
+		file_chat_chat_proto_msgTypes[50].Exporter = func(v any, i int) any {
+			switch v := v.(*RemoveWalletResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7250,8 +11326,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[7].Exporter = func(v any, i int) any {
-			switch v := v.(*FindUserPublicInfoRespOfOne); i {
+		file_chat_chat_proto_msgTypes[51].Exporter = func(v any, i int) any {
+			switch v := v.(*SearchUserInfoReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7262,8 +11338,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[8].Exporter = func(v any, i int) any {
-			switch v := v.(*FindUserPublicInfoResp); i {
+		file_chat_chat_proto_msgTypes[52].Exporter = func(v any, i int) any {
+			switch v := v.(*SearchUserInfoResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7274,8 +11350,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[9].Exporter = func(v any, i int) any {
-			switch v := v.(*SearchUserPublicInfoReq); i {
+		file_chat_chat_proto_msgTypes[53].Exporter = func(v any, i int) any {
+			switch v := v.(*GetTokenForVideoMeetingReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7286,8 +11362,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[10].Exporter = func(v any, i int) any {
-			switch v := v.(*SearchUserPublicInfoResp); i {
+		file_chat_chat_proto_msgTypes[54].Exporter = func(v any, i int) any {
+			switch v := v.(*GetTokenForVideoMeetingResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7298,8 +11374,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[11].Exporter = func(v any, i int) any {
-			switch v := v.(*FindUserFullInfoReq); i {
+		file_chat_chat_proto_msgTypes[55].Exporter = func(v any, i int) any {
+			switch v := v.(*CheckUserExistReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7310,8 +11386,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[12].Exporter = func(v any, i int) any {
-			switch v := v.(*FindUserFullInfoResp); i {
+		file_chat_chat_proto_msgTypes[56].Exporter = func(v any, i int) any {
+			switch v := v.(*CheckUserExistResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7322,8 +11398,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[13].Exporter = func(v any, i int) any {
-			switch v := v.(*GetAllUserIDsReq); i {
+		file_chat_chat_proto_msgTypes[57].Exporter = func(v any, i int) any {
+			switch v := v.(*DelUserAccountReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7334,8 +11410,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[14].Exporter = func(v any, i int) any {
-			switch v := v.(*GetAllUserIDsResp); i {
+		file_chat_chat_proto_msgTypes[58].Exporter = func(v any, i int) any {
+			switch v := v.(*DelUserAccountResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7346,8 +11422,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[15].Exporter = func(v any, i int) any {
-			switch v := v.(*SendVerifyCodeReq); i {
+		file_chat_chat_proto_msgTypes[59].Exporter = func(v any, i int) any {
+			switch v := v.(*ErasedRecord); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7358,8 +11434,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[16].Exporter = func(v any, i int) any {
-			switch v := v.(*SendVerifyCodeResp); i {
+		file_chat_chat_proto_msgTypes[60].Exporter = func(v any, i int) any {
+			switch v := v.(*AccountDeletion); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7370,8 +11446,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[17].Exporter = func(v any, i int) any {
-			switch v := v.(*VerifyCodeReq); i {
+		file_chat_chat_proto_msgTypes[61].Exporter = func(v any, i int) any {
+			switch v := v.(*RequestAccountDeletionReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7382,8 +11458,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[18].Exporter = func(v any, i int) any {
-			switch v := v.(*VerifyCodeResp); i {
+		file_chat_chat_proto_msgTypes[62].Exporter = func(v any, i int) any {
+			switch v := v.(*RequestAccountDeletionResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7394,8 +11470,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[19].Exporter = func(v any, i int) any {
-			switch v := v.(*ChallengeNonceReq); i {
+		file_chat_chat_proto_msgTypes[63].Exporter = func(v any, i int) any {
+			switch v := v.(*CancelAccountDeletionReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7406,8 +11482,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[20].Exporter = func(v any, i int) any {
-			switch v := v.(*ChallengeNonceResp); i {
+		file_chat_chat_proto_msgTypes[64].Exporter = func(v any, i int) any {
+			switch v := v.(*CancelAccountDeletionResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7418,8 +11494,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[21].Exporter = func(v any, i int) any {
-			switch v := v.(*RegisterUserInfo); i {
+		file_chat_chat_proto_msgTypes[65].Exporter = func(v any, i int) any {
+			switch v := v.(*GetAccountDeletionReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7430,8 +11506,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[22].Exporter = func(v any, i int) any {
-			switch v := v.(*RegisterUserReq); i {
+		file_chat_chat_proto_msgTypes[66].Exporter = func(v any, i int) any {
+			switch v := v.(*GetAccountDeletionResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7442,8 +11518,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[23].Exporter = func(v any, i int) any {
-			switch v := v.(*RegisterUserResp); i {
+		file_chat_chat_proto_msgTypes[67].Exporter = func(v any, i int) any {
+			switch v := v.(*DataExport); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7454,8 +11530,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[24].Exporter = func(v any, i int) any {
-			switch v := v.(*AddUserAccountReq); i {
+		file_chat_chat_proto_msgTypes[68].Exporter = func(v any, i int) any {
+			switch v := v.(*RequestDataExportReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7466,8 +11542,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[25].Exporter = func(v any, i int) any {
-			switch v := v.(*AddUserAccountResp); i {
+		file_chat_chat_proto_msgTypes[69].Exporter = func(v any, i int) any {
+			switch v := v.(*RequestDataExportResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7478,8 +11554,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[26].Exporter = func(v any, i int) any {
-			switch v := v.(*LoginReq); i {
+		file_chat_chat_proto_msgTypes[70].Exporter = func(v any, i int) any {
+			switch v := v.(*GetDataExportReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7490,8 +11566,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[27].Exporter = func(v any, i int) any {
-			switch v := v.(*ResetPasswordReq); i {
+		file_chat_chat_proto_msgTypes[71].Exporter = func(v any, i int) any {
+			switch v := v.(*GetDataExportResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7502,8 +11578,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[28].Exporter = func(v any, i int) any {
-			switch v := v.(*ResetPasswordResp); i {
+		file_chat_chat_proto_msgTypes[72].Exporter = func(v any, i int) any {
+			switch v := v.(*OpenDataExportReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7514,8 +11590,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[29].Exporter = func(v any, i int) any {
-			switch v := v.(*ChangePasswordReq); i {
+		file_chat_chat_proto_msgTypes[73].Exporter = func(v any, i int) any {
+			switch v := v.(*OpenDataExportResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7526,8 +11602,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[30].Exporter = func(v any, i int) any {
-			switch v := v.(*ChangePasswordResp); i {
+		file_chat_chat_proto_msgTypes[74].Exporter = func(v any, i int) any {
+			switch v := v.(*ConsumeDataExportReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7538,8 +11614,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[31].Exporter = func(v any, i int) any {
-			switch v := v.(*FindUserAccountReq); i {
+		file_chat_chat_proto_msgTypes[75].Exporter = func(v any, i int) any {
+			switch v := v.(*ConsumeDataExportResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7550,8 +11626,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[32].Exporter = func(v any, i int) any {
-			switch v := v.(*FindUserByAddressOrAccountReq); i {
+		file_chat_chat_proto_msgTypes[76].Exporter = func(v any, i int) any {
+			switch v := v.(*ReleaseDataExportReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7562,8 +11638,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[33].Exporter = func(v any, i int) any {
-			switch v := v.(*FindUserAccountResp); i {
+		file_chat_chat_proto_msgTypes[77].Exporter = func(v any, i int) any {
+			switch v := v.(*ReleaseDataExportResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7574,8 +11650,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[34].Exporter = func(v any, i int) any {
-			switch v := v.(*FindAccountUserReq); i {
+		file_chat_chat_proto_msgTypes[78].Exporter = func(v any, i int) any {
+			switch v := v.(*GetGroupFromContactReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7586,8 +11662,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[35].Exporter = func(v any, i int) any {
-			switch v := v.(*FindAccountUserResp); i {
+		file_chat_chat_proto_msgTypes[79].Exporter = func(v any, i int) any {
+			switch v := v.(*GetGroupFromContactResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7598,8 +11674,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[36].Exporter = func(v any, i int) any {
-			switch v := v.(*SignalRecord); i {
+		file_chat_chat_proto_msgTypes[80].Exporter = func(v any, i int) any {
+			switch v := v.(*SaveGroupToContactReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7610,8 +11686,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[37].Exporter = func(v any, i int) any {
-			switch v := v.(*OpenIMCallbackReq); i {
+		file_chat_chat_proto_msgTypes[81].Exporter = func(v any, i int) any {
+			switch v := v.(*SaveGroupToContactResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7622,8 +11698,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[38].Exporter = func(v any, i int) any {
-			switch v := v.(*OpenIMCallbackResp); i {
+		file_chat_chat_proto_msgTypes[82].Exporter = func(v any, i int) any {
+			switch v := v.(*DeleteGroupFromContactReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7634,8 +11710,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[39].Exporter = func(v any, i int) any {
-			switch v := v.(*SearchUserFullInfoReq); i {
+		file_chat_chat_proto_msgTypes[83].Exporter = func(v any, i int) any {
+			switch v := v.(*DeleteGroupFromContactResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7646,8 +11722,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[40].Exporter = func(v any, i int) any {
-			switch v := v.(*SearchUserFullInfoResp); i {
+		file_chat_chat_proto_msgTypes[84].Exporter = func(v any, i int) any {
+			switch v := v.(*DeleteGroupApplicationFromRecipientReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7658,8 +11734,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[41].Exporter = func(v any, i int) any {
-			switch v := v.(*UserLoginCountReq); i {
+		file_chat_chat_proto_msgTypes[85].Exporter = func(v any, i int) any {
+			switch v := v.(*DeleteGroupApplicationFromRecipientResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7670,8 +11746,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[42].Exporter = func(v any, i int) any {
-			switch v := v.(*UserLoginCountResp); i {
+		file_chat_chat_proto_msgTypes[86].Exporter = func(v any, i int) any {
+			switch v := v.(*DeleteGroupApplicationFromApplicantReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7682,8 +11758,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[43].Exporter = func(v any, i int) any {
-			switch v := v.(*LoginResp); i {
+		file_chat_chat_proto_msgTypes[87].Exporter = func(v any, i int) any {
+			switch v := v.(*DeleteGroupApplicationFromApplicantResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7694,8 +11770,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[44].Exporter = func(v any, i int) any {
-			switch v := v.(*SearchUserInfoReq); i {
+		file_chat_chat_proto_msgTypes[88].Exporter = func(v any, i int) any {
+			switch v := v.(*DeleteGroupApplicationFromAlltReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7706,8 +11782,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[45].Exporter = func(v any, i int) any {
-			switch v := v.(*SearchUserInfoResp); i {
+		file_chat_chat_proto_msgTypes[89].Exporter = func(v any, i int) any {
+			switch v := v.(*DeleteGroupApplicationFromAllResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7718,8 +11794,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[46].Exporter = func(v any, i int) any {
-			switch v := v.(*GetTokenForVideoMeetingReq); i {
+		file_chat_chat_proto_msgTypes[90].Exporter = func(v any, i int) any {
+			switch v := v.(*Post); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7730,8 +11806,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[47].Exporter = func(v any, i int) any {
-			switch v := v.(*GetTokenForVideoMeetingResp); i {
+		file_chat_chat_proto_msgTypes[91].Exporter = func(v any, i int) any {
+			switch v := v.(*PublishPostReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7742,8 +11818,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[48].Exporter = func(v any, i int) any {
-			switch v := v.(*CheckUserExistReq); i {
+		file_chat_chat_proto_msgTypes[92].Exporter = func(v any, i int) any {
+			switch v := v.(*PublishPostResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7754,8 +11830,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[49].Exporter = func(v any, i int) any {
-			switch v := v.(*CheckUserExistResp); i {
+		file_chat_chat_proto_msgTypes[93].Exporter = func(v any, i int) any {
+			switch v := v.(*GetPostByIDReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7766,8 +11842,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[50].Exporter = func(v any, i int) any {
-			switch v := v.(*DelUserAccountReq); i {
+		file_chat_chat_proto_msgTypes[94].Exporter = func(v any, i int) any {
+			switch v := v.(*GetPostByIDResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7778,8 +11854,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[51].Exporter = func(v any, i int) any {
-			switch v := v.(*DelUserAccountResp); i {
+		file_chat_chat_proto_msgTypes[95].Exporter = func(v any, i int) any {
+			switch v := v.(*GetAllTypePostReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7790,8 +11866,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[52].Exporter = func(v any, i int) any {
-			switch v := v.(*GetGroupFromContactReq); i {
+		file_chat_chat_proto_msgTypes[96].Exporter = func(v any, i int) any {
+			switch v := v.(*GetAllTypePostResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7802,8 +11878,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[53].Exporter = func(v any, i int) any {
-			switch v := v.(*GetGroupFromContactResp); i {
+		file_chat_chat_proto_msgTypes[97].Exporter = func(v any, i int) any {
+			switch v := v.(*TypePosts); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7814,8 +11890,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[54].Exporter = func(v any, i int) any {
-			switch v := v.(*SaveGroupToContactReq); i {
+		file_chat_chat_proto_msgTypes[98].Exporter = func(v any, i int) any {
+			switch v := v.(*GetPostListReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7826,8 +11902,68 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[55].Exporter = func(v any, i int) any {
-			switch v := v.(*SaveGroupToContactResp); i {
+		file_chat_chat_proto_msgTypes[99].Exporter = func(v any, i int) any {
+			switch v := v.(*GetPostListResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_chat_proto_msgTypes[100].Exporter = func(v any, i int) any {
+			switch v := v.(*GetPostListByUserReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_chat_proto_msgTypes[101].Exporter = func(v any, i int) any {
+			switch v := v.(*GetPostListByUserResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_chat_proto_msgTypes[102].Exporter = func(v any, i int) any {
+			switch v := v.(*GetCommentPostListByPostIDReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_chat_proto_msgTypes[103].Exporter = func(v any, i int) any {
+			switch v := v.(*GetCommentPostListByPostIDResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chat_chat_proto_msgTypes[104].Exporter = func(v any, i int) any {
+			switch v := v.(*DeletePostReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7838,8 +11974,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[56].Exporter = func(v any, i int) any {
-			switch v := v.(*DeleteGroupFromContactReq); i {
+		file_chat_chat_proto_msgTypes[105].Exporter = func(v any, i int) any {
+			switch v := v.(*DeletePostResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7850,8 +11986,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[57].Exporter = func(v any, i int) any {
-			switch v := v.(*DeleteGroupFromContactResp); i {
+		file_chat_chat_proto_msgTypes[106].Exporter = func(v any, i int) any {
+			switch v := v.(*ChangeAllowCommentPostReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7862,8 +11998,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[58].Exporter = func(v any, i int) any {
-			switch v := v.(*DeleteGroupApplicationFromRecipientReq); i {
+		file_chat_chat_proto_msgTypes[107].Exporter = func(v any, i int) any {
+			switch v := v.(*ChangeAllowCommentPostResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7874,8 +12010,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[59].Exporter = func(v any, i int) any {
-			switch v := v.(*DeleteGroupApplicationFromRecipientResp); i {
+		file_chat_chat_proto_msgTypes[108].Exporter = func(v any, i int) any {
+			switch v := v.(*ChangeAllowForwardPostReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7886,8 +12022,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[60].Exporter = func(v any, i int) any {
-			switch v := v.(*DeleteGroupApplicationFromApplicantReq); i {
+		file_chat_chat_proto_msgTypes[109].Exporter = func(v any, i int) any {
+			switch v := v.(*ChangeAllowForwardPostResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7898,8 +12034,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[61].Exporter = func(v any, i int) any {
-			switch v := v.(*DeleteGroupApplicationFromApplicantResp); i {
+		file_chat_chat_proto_msgTypes[110].Exporter = func(v any, i int) any {
+			switch v := v.(*LikePostReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7910,8 +12046,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[62].Exporter = func(v any, i int) any {
-			switch v := v.(*DeleteGroupApplicationFromAlltReq); i {
+		file_chat_chat_proto_msgTypes[111].Exporter = func(v any, i int) any {
+			switch v := v.(*LikePostResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7922,8 +12058,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[63].Exporter = func(v any, i int) any {
-			switch v := v.(*DeleteGroupApplicationFromAllResp); i {
+		file_chat_chat_proto_msgTypes[112].Exporter = func(v any, i int) any {
+			switch v := v.(*CollectPostReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7934,8 +12070,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[64].Exporter = func(v any, i int) any {
-			switch v := v.(*Post); i {
+		file_chat_chat_proto_msgTypes[113].Exporter = func(v any, i int) any {
+			switch v := v.(*CollectPostResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7946,8 +12082,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[65].Exporter = func(v any, i int) any {
-			switch v := v.(*PublishPostReq); i {
+		file_chat_chat_proto_msgTypes[114].Exporter = func(v any, i int) any {
+			switch v := v.(*ForwardPostReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7958,8 +12094,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[66].Exporter = func(v any, i int) any {
-			switch v := v.(*PublishPostResp); i {
+		file_chat_chat_proto_msgTypes[115].Exporter = func(v any, i int) any {
+			switch v := v.(*ForwardPostResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7970,8 +12106,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[67].Exporter = func(v any, i int) any {
-			switch v := v.(*GetPostByIDReq); i {
+		file_chat_chat_proto_msgTypes[116].Exporter = func(v any, i int) any {
+			switch v := v.(*ReferencePostReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7982,8 +12118,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[68].Exporter = func(v any, i int) any {
-			switch v := v.(*GetPostByIDResp); i {
+		file_chat_chat_proto_msgTypes[117].Exporter = func(v any, i int) any {
+			switch v := v.(*ReferencePostResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7994,8 +12130,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[69].Exporter = func(v any, i int) any {
-			switch v := v.(*GetAllTypePostReq); i {
+		file_chat_chat_proto_msgTypes[118].Exporter = func(v any, i int) any {
+			switch v := v.(*CommentPostReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8006,8 +12142,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[70].Exporter = func(v any, i int) any {
-			switch v := v.(*GetAllTypePostResp); i {
+		file_chat_chat_proto_msgTypes[119].Exporter = func(v any, i int) any {
+			switch v := v.(*CommentPostResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8018,8 +12154,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[71].Exporter = func(v any, i int) any {
-			switch v := v.(*TypePosts); i {
+		file_chat_chat_proto_msgTypes[120].Exporter = func(v any, i int) any {
+			switch v := v.(*PinPostReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8030,8 +12166,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[72].Exporter = func(v any, i int) any {
-			switch v := v.(*GetPostListReq); i {
+		file_chat_chat_proto_msgTypes[121].Exporter = func(v any, i int) any {
+			switch v := v.(*PinPostResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8042,8 +12178,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[73].Exporter = func(v any, i int) any {
-			switch v := v.(*GetPostListResp); i {
+		file_chat_chat_proto_msgTypes[122].Exporter = func(v any, i int) any {
+			switch v := v.(*EditPostReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8054,8 +12190,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[74].Exporter = func(v any, i int) any {
-			switch v := v.(*GetPostListByUserReq); i {
+		file_chat_chat_proto_msgTypes[123].Exporter = func(v any, i int) any {
+			switch v := v.(*EditPostResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8066,8 +12202,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[75].Exporter = func(v any, i int) any {
-			switch v := v.(*GetPostListByUserResp); i {
+		file_chat_chat_proto_msgTypes[124].Exporter = func(v any, i int) any {
+			switch v := v.(*PostRevision); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8078,8 +12214,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[76].Exporter = func(v any, i int) any {
-			switch v := v.(*GetCommentPostListByPostIDReq); i {
+		file_chat_chat_proto_msgTypes[125].Exporter = func(v any, i int) any {
+			switch v := v.(*GetPostRevisionsReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8090,8 +12226,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[77].Exporter = func(v any, i int) any {
-			switch v := v.(*GetCommentPostListByPostIDResp); i {
+		file_chat_chat_proto_msgTypes[126].Exporter = func(v any, i int) any {
+			switch v := v.(*GetPostRevisionsResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8102,8 +12238,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[78].Exporter = func(v any, i int) any {
-			switch v := v.(*DeletePostReq); i {
+		file_chat_chat_proto_msgTypes[127].Exporter = func(v any, i int) any {
+			switch v := v.(*ReconcilePostCountsReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8114,8 +12250,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[79].Exporter = func(v any, i int) any {
-			switch v := v.(*DeletePostResp); i {
+		file_chat_chat_proto_msgTypes[128].Exporter = func(v any, i int) any {
+			switch v := v.(*ReconcilePostCountsResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8126,8 +12262,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[80].Exporter = func(v any, i int) any {
-			switch v := v.(*ChangeAllowCommentPostReq); i {
+		file_chat_chat_proto_msgTypes[129].Exporter = func(v any, i int) any {
+			switch v := v.(*PostDraft); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8138,8 +12274,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[81].Exporter = func(v any, i int) any {
-			switch v := v.(*ChangeAllowCommentPostResp); i {
+		file_chat_chat_proto_msgTypes[130].Exporter = func(v any, i int) any {
+			switch v := v.(*SaveDraftReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8150,8 +12286,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[82].Exporter = func(v any, i int) any {
-			switch v := v.(*ChangeAllowForwardPostReq); i {
+		file_chat_chat_proto_msgTypes[131].Exporter = func(v any, i int) any {
+			switch v := v.(*SaveDraftResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8162,8 +12298,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[83].Exporter = func(v any, i int) any {
-			switch v := v.(*ChangeAllowForwardPostResp); i {
+		file_chat_chat_proto_msgTypes[132].Exporter = func(v any, i int) any {
+			switch v := v.(*ListDraftsReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8174,8 +12310,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[84].Exporter = func(v any, i int) any {
-			switch v := v.(*LikePostReq); i {
+		file_chat_chat_proto_msgTypes[133].Exporter = func(v any, i int) any {
+			switch v := v.(*ListDraftsResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8186,8 +12322,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[85].Exporter = func(v any, i int) any {
-			switch v := v.(*LikePostResp); i {
+		file_chat_chat_proto_msgTypes[134].Exporter = func(v any, i int) any {
+			switch v := v.(*DeleteDraftReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8198,8 +12334,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[86].Exporter = func(v any, i int) any {
-			switch v := v.(*CollectPostReq); i {
+		file_chat_chat_proto_msgTypes[135].Exporter = func(v any, i int) any {
+			switch v := v.(*DeleteDraftResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8210,8 +12346,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[87].Exporter = func(v any, i int) any {
-			switch v := v.(*CollectPostResp); i {
+		file_chat_chat_proto_msgTypes[136].Exporter = func(v any, i int) any {
+			switch v := v.(*Notification); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8222,8 +12358,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[88].Exporter = func(v any, i int) any {
-			switch v := v.(*ForwardPostReq); i {
+		file_chat_chat_proto_msgTypes[137].Exporter = func(v any, i int) any {
+			switch v := v.(*GetNotificationsReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8234,8 +12370,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[89].Exporter = func(v any, i int) any {
-			switch v := v.(*ForwardPostResp); i {
+		file_chat_chat_proto_msgTypes[138].Exporter = func(v any, i int) any {
+			switch v := v.(*GetNotificationsResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8246,8 +12382,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[90].Exporter = func(v any, i int) any {
-			switch v := v.(*ReferencePostReq); i {
+		file_chat_chat_proto_msgTypes[139].Exporter = func(v any, i int) any {
+			switch v := v.(*GetUnreadNotificationCountReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8258,8 +12394,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[91].Exporter = func(v any, i int) any {
-			switch v := v.(*ReferencePostResp); i {
+		file_chat_chat_proto_msgTypes[140].Exporter = func(v any, i int) any {
+			switch v := v.(*GetUnreadNotificationCountResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8270,8 +12406,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[92].Exporter = func(v any, i int) any {
-			switch v := v.(*CommentPostReq); i {
+		file_chat_chat_proto_msgTypes[141].Exporter = func(v any, i int) any {
+			switch v := v.(*MarkNotificationsReadReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8282,8 +12418,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[93].Exporter = func(v any, i int) any {
-			switch v := v.(*CommentPostResp); i {
+		file_chat_chat_proto_msgTypes[142].Exporter = func(v any, i int) any {
+			switch v := v.(*MarkNotificationsReadResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8294,8 +12430,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[94].Exporter = func(v any, i int) any {
-			switch v := v.(*PinPostReq); i {
+		file_chat_chat_proto_msgTypes[143].Exporter = func(v any, i int) any {
+			switch v := v.(*MarkAllNotificationsReadReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8306,8 +12442,8 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[95].Exporter = func(v any, i int) any {
-			switch v := v.(*PinPostResp); i {
+		file_chat_chat_proto_msgTypes[144].Exporter = func(v any, i int) any {
+			switch v := v.(*MarkAllNotificationsReadResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8318,7 +12454,7 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[96].Exporter = func(v any, i int) any {
+		file_chat_chat_proto_msgTypes[145].Exporter = func(v any, i int) any {
 			switch v := v.(*CheckVersionReq); i {
 			case 0:
 				return &v.state
@@ -8330,7 +12466,7 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[97].Exporter = func(v any, i int) any {
+		file_chat_chat_proto_msgTypes[146].Exporter = func(v any, i int) any {
 			switch v := v.(*CheckVersionResp); i {
 			case 0:
 				return &v.state
@@ -8342,7 +12478,7 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[98].Exporter = func(v any, i int) any {
+		file_chat_chat_proto_msgTypes[147].Exporter = func(v any, i int) any {
 			switch v := v.(*GetFakeUserReq); i {
 			case 0:
 				return &v.state
@@ -8354,7 +12490,7 @@ func file_chat_chat_proto_init() {
 				return nil
 			}
 		}
-		file_chat_chat_proto_msgTypes[99].Exporter = func(v any, i int) any {
+		file_chat_chat_proto_msgTypes[148].Exporter = func(v any, i int) any {
 			switch v := v.(*GetFakeUserResp); i {
 			case 0:
 				return &v.state
@@ -8373,7 +12509,7 @@ func file_chat_chat_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_chat_chat_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   103,
+			NumMessages:   152,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -8414,10 +12550,26 @@ type ChatClient interface {
 	ChallengeNonce(ctx context.Context, in *ChallengeNonceReq, opts ...grpc.CallOption) (*ChallengeNonceResp, error)
 	RegisterUser(ctx context.Context, in *RegisterUserReq, opts ...grpc.CallOption) (*RegisterUserResp, error)
 	Login(ctx context.Context, in *LoginReq, opts ...grpc.CallOption) (*LoginResp, error)
+	// Wallets linked to an account, any of them can be used to login
+	AddWallet(ctx context.Context, in *AddWalletReq, opts ...grpc.CallOption) (*AddWalletResp, error)
+	ListWallets(ctx context.Context, in *ListWalletsReq, opts ...grpc.CallOption) (*ListWalletsResp, error)
+	RemoveWallet(ctx context.Context, in *RemoveWalletReq, opts ...grpc.CallOption) (*RemoveWalletResp, error)
 	ResetPassword(ctx context.Context, in *ResetPasswordReq, opts ...grpc.CallOption) (*ResetPasswordResp, error)
 	ChangePassword(ctx context.Context, in *ChangePasswordReq, opts ...grpc.CallOption) (*ChangePasswordResp, error)
 	CheckUserExist(ctx context.Context, in *CheckUserExistReq, opts ...grpc.CallOption) (*CheckUserExistResp, error)
 	DelUserAccount(ctx context.Context, in *DelUserAccountReq, opts ...grpc.CallOption) (*DelUserAccountResp, error)
+	// Account deletion, the user data is erased after the grace period
+	RequestAccountDeletion(ctx context.Context, in *RequestAccountDeletionReq, opts ...grpc.CallOption) (*RequestAccountDeletionResp, error)
+	CancelAccountDeletion(ctx context.Context, in *CancelAccountDeletionReq, opts ...grpc.CallOption) (*CancelAccountDeletionResp, error)
+	GetAccountDeletion(ctx context.Context, in *GetAccountDeletionReq, opts ...grpc.CallOption) (*GetAccountDeletionResp, error)
+	RequestDataExport(ctx context.Context, in *RequestDataExportReq, opts ...grpc.CallOption) (*RequestDataExportResp, error)
+	GetDataExport(ctx context.Context, in *GetDataExportReq, opts ...grpc.CallOption) (*GetDataExportResp, error)
+	// checks the signed link and claims the export for one download, other downloads fail until the claim is released or its lease ends
+	OpenDataExport(ctx context.Context, in *OpenDataExportReq, opts ...grpc.CallOption) (*OpenDataExportResp, error)
+	// marks the export downloaded once the archive was sent completely, the link can not be used again
+	ConsumeDataExport(ctx context.Context, in *ConsumeDataExportReq, opts ...grpc.CallOption) (*ConsumeDataExportResp, error)
+	// gives the claim back after a failed transfer so the link can be retried
+	ReleaseDataExport(ctx context.Context, in *ReleaseDataExportReq, opts ...grpc.CallOption) (*ReleaseDataExportResp, error)
 	FindUserAccount(ctx context.Context, in *FindUserAccountReq, opts ...grpc.CallOption) (*FindUserAccountResp, error)
 	FindAccountUser(ctx context.Context, in *FindAccountUserReq, opts ...grpc.CallOption) (*FindAccountUserResp, error)
 	OpenIMCallback(ctx context.Context, in *OpenIMCallbackReq, opts ...grpc.CallOption) (*OpenIMCallbackResp, error)
@@ -8470,6 +12622,26 @@ type ChatClient interface {
 	CommentPost(ctx context.Context, in *CommentPostReq, opts ...grpc.CallOption) (*CommentPostResp, error)
 	// 引用帖子
 	ReferencePost(ctx context.Context, in *ReferencePostReq, opts ...grpc.CallOption) (*ReferencePostResp, error)
+	// 编辑帖子
+	EditPost(ctx context.Context, in *EditPostReq, opts ...grpc.CallOption) (*EditPostResp, error)
+	// 获取帖子历史版本
+	GetPostRevisions(ctx context.Context, in *GetPostRevisionsReq, opts ...grpc.CallOption) (*GetPostRevisionsResp, error)
+	// 重新计算帖子计数（管理员）
+	ReconcilePostCounts(ctx context.Context, in *ReconcilePostCountsReq, opts ...grpc.CallOption) (*ReconcilePostCountsResp, error)
+	// 保存草稿，可设置定时发布
+	SaveDraft(ctx context.Context, in *SaveDraftReq, opts ...grpc.CallOption) (*SaveDraftResp, error)
+	// 获取草稿列表
+	ListDrafts(ctx context.Context, in *ListDraftsReq, opts ...grpc.CallOption) (*ListDraftsResp, error)
+	// 删除草稿
+	DeleteDraft(ctx context.Context, in *DeleteDraftReq, opts ...grpc.CallOption) (*DeleteDraftResp, error)
+	// 获取通知列表
+	GetNotifications(ctx context.Context, in *GetNotificationsReq, opts ...grpc.CallOption) (*GetNotificationsResp, error)
+	// 获取未读通知数
+	GetUnreadNotificationCount(ctx context.Context, in *GetUnreadNotificationCountReq, opts ...grpc.CallOption) (*GetUnreadNotificationCountResp, error)
+	// 标记通知已读
+	MarkNotificationsRead(ctx context.Context, in *MarkNotificationsReadReq, opts ...grpc.CallOption) (*MarkNotificationsReadResp, error)
+	// 标记全部通知已读
+	MarkAllNotificationsRead(ctx context.Context, in *MarkAllNotificationsReadReq, opts ...grpc.CallOption) (*MarkAllNotificationsReadResp, error)
 	// 检查版本
 	CheckVersion(ctx context.Context, in *CheckVersionReq, opts ...grpc.CallOption) (*CheckVersionResp, error)
 	// 获取假用户
@@ -8592,6 +12764,33 @@ func (c *chatClient) Login(ctx context.Context, in *LoginReq, opts ...grpc.CallO
 	return out, nil
 }
 
+func (c *chatClient) AddWallet(ctx context.Context, in *AddWalletReq, opts ...grpc.CallOption) (*AddWalletResp, error) {
+	out := new(AddWalletResp)
+	err := c.cc.Invoke(ctx, "/openim.chat.chat/AddWallet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatClient) ListWallets(ctx context.Context, in *ListWalletsReq, opts ...grpc.CallOption) (*ListWalletsResp, error) {
+	out := new(ListWalletsResp)
+	err := c.cc.Invoke(ctx, "/openim.chat.chat/ListWallets", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatClient) RemoveWallet(ctx context.Context, in *RemoveWalletReq, opts ...grpc.CallOption) (*RemoveWalletResp, error) {
+	out := new(RemoveWalletResp)
+	err := c.cc.Invoke(ctx, "/openim.chat.chat/RemoveWallet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *chatClient) ResetPassword(ctx context.Context, in *ResetPasswordReq, opts ...grpc.CallOption) (*ResetPasswordResp, error) {
 	out := new(ResetPasswordResp)
 	err := c.cc.Invoke(ctx, "/openim.chat.chat/ResetPassword", in, out, opts...)
@@ -8628,6 +12827,78 @@ func (c *chatClient) DelUserAccount(ctx context.Context, in *DelUserAccountReq,
 	return out, nil
 }
 
+func (c *chatClient) RequestAccountDeletion(ctx context.Context, in *RequestAccountDeletionReq, opts ...grpc.CallOption) (*RequestAccountDeletionResp, error) {
+	out := new(RequestAccountDeletionResp)
+	err := c.cc.Invoke(ctx, "/openim.chat.chat/RequestAccountDeletion", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatClient) CancelAccountDeletion(ctx context.Context, in *CancelAccountDeletionReq, opts ...grpc.CallOption) (*CancelAccountDeletionResp, error) {
+	out := new(CancelAccountDeletionResp)
+	err := c.cc.Invoke(ctx, "/openim.chat.chat/CancelAccountDeletion", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatClient) GetAccountDeletion(ctx context.Context, in *GetAccountDeletionReq, opts ...grpc.CallOption) (*GetAccountDeletionResp, error) {
+	out := new(GetAccountDeletionResp)
+	err := c.cc.Invoke(ctx, "/openim.chat.chat/GetAccountDeletion", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatClient) RequestDataExport(ctx context.Context, in *RequestDataExportReq, opts ...grpc.CallOption) (*RequestDataExportResp, error) {
+	out := new(RequestDataExportResp)
+	err := c.cc.Invoke(ctx, "/openim.chat.chat/RequestDataExport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatClient) GetDataExport(ctx context.Context, in *GetDataExportReq, opts ...grpc.CallOption) (*GetDataExportResp, error) {
+	out := new(GetDataExportResp)
+	err := c.cc.Invoke(ctx, "/openim.chat.chat/GetDataExport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatClient) OpenDataExport(ctx context.Context, in *OpenDataExportReq, opts ...grpc.CallOption) (*OpenDataExportResp, error) {
+	out := new(OpenDataExportResp)
+	err := c.cc.Invoke(ctx, "/openim.chat.chat/OpenDataExport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatClient) ConsumeDataExport(ctx context.Context, in *ConsumeDataExportReq, opts ...grpc.CallOption) (*ConsumeDataExportResp, error) {
+	out := new(ConsumeDataExportResp)
+	err := c.cc.Invoke(ctx, "/openim.chat.chat/ConsumeDataExport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatClient) ReleaseDataExport(ctx context.Context, in *ReleaseDataExportReq, opts ...grpc.CallOption) (*ReleaseDataExportResp, error) {
+	out := new(ReleaseDataExportResp)
+	err := c.cc.Invoke(ctx, "/openim.chat.chat/ReleaseDataExport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *chatClient) FindUserAccount(ctx context.Context, in *FindUserAccountReq, opts ...grpc.CallOption) (*FindUserAccountResp, error) {
 	out := new(FindUserAccountResp)
 	err := c.cc.Invoke(ctx, "/openim.chat.chat/FindUserAccount", in, out, opts...)
@@ -8763,117 +13034,207 @@ func (c *chatClient) GetAllTypePost(ctx context.Context, in *GetAllTypePostReq,
 	return out, nil
 }
 
-func (c *chatClient) GetPostList(ctx context.Context, in *GetPostListReq, opts ...grpc.CallOption) (*GetPostListResp, error) {
-	out := new(GetPostListResp)
-	err := c.cc.Invoke(ctx, "/openim.chat.chat/GetPostList", in, out, opts...)
+func (c *chatClient) GetPostList(ctx context.Context, in *GetPostListReq, opts ...grpc.CallOption) (*GetPostListResp, error) {
+	out := new(GetPostListResp)
+	err := c.cc.Invoke(ctx, "/openim.chat.chat/GetPostList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatClient) GetPostListByUser(ctx context.Context, in *GetPostListByUserReq, opts ...grpc.CallOption) (*GetPostListByUserResp, error) {
+	out := new(GetPostListByUserResp)
+	err := c.cc.Invoke(ctx, "/openim.chat.chat/GetPostListByUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatClient) GetCommentPostListByPostID(ctx context.Context, in *GetCommentPostListByPostIDReq, opts ...grpc.CallOption) (*GetCommentPostListByPostIDResp, error) {
+	out := new(GetCommentPostListByPostIDResp)
+	err := c.cc.Invoke(ctx, "/openim.chat.chat/GetCommentPostListByPostID", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatClient) GetPostByID(ctx context.Context, in *GetPostByIDReq, opts ...grpc.CallOption) (*GetPostByIDResp, error) {
+	out := new(GetPostByIDResp)
+	err := c.cc.Invoke(ctx, "/openim.chat.chat/GetPostByID", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatClient) DeletePost(ctx context.Context, in *DeletePostReq, opts ...grpc.CallOption) (*DeletePostResp, error) {
+	out := new(DeletePostResp)
+	err := c.cc.Invoke(ctx, "/openim.chat.chat/DeletePost", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatClient) ChangeAllowCommentPost(ctx context.Context, in *ChangeAllowCommentPostReq, opts ...grpc.CallOption) (*ChangeAllowCommentPostResp, error) {
+	out := new(ChangeAllowCommentPostResp)
+	err := c.cc.Invoke(ctx, "/openim.chat.chat/ChangeAllowCommentPost", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatClient) ChangeAllowForwardPost(ctx context.Context, in *ChangeAllowForwardPostReq, opts ...grpc.CallOption) (*ChangeAllowForwardPostResp, error) {
+	out := new(ChangeAllowForwardPostResp)
+	err := c.cc.Invoke(ctx, "/openim.chat.chat/ChangeAllowForwardPost", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatClient) ChangeLikePost(ctx context.Context, in *LikePostReq, opts ...grpc.CallOption) (*LikePostResp, error) {
+	out := new(LikePostResp)
+	err := c.cc.Invoke(ctx, "/openim.chat.chat/ChangeLikePost", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatClient) ChangeCollectPost(ctx context.Context, in *CollectPostReq, opts ...grpc.CallOption) (*CollectPostResp, error) {
+	out := new(CollectPostResp)
+	err := c.cc.Invoke(ctx, "/openim.chat.chat/ChangeCollectPost", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatClient) PinPost(ctx context.Context, in *PinPostReq, opts ...grpc.CallOption) (*PinPostResp, error) {
+	out := new(PinPostResp)
+	err := c.cc.Invoke(ctx, "/openim.chat.chat/PinPost", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatClient) ForwardPost(ctx context.Context, in *ForwardPostReq, opts ...grpc.CallOption) (*ForwardPostResp, error) {
+	out := new(ForwardPostResp)
+	err := c.cc.Invoke(ctx, "/openim.chat.chat/ForwardPost", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *chatClient) GetPostListByUser(ctx context.Context, in *GetPostListByUserReq, opts ...grpc.CallOption) (*GetPostListByUserResp, error) {
-	out := new(GetPostListByUserResp)
-	err := c.cc.Invoke(ctx, "/openim.chat.chat/GetPostListByUser", in, out, opts...)
+func (c *chatClient) CommentPost(ctx context.Context, in *CommentPostReq, opts ...grpc.CallOption) (*CommentPostResp, error) {
+	out := new(CommentPostResp)
+	err := c.cc.Invoke(ctx, "/openim.chat.chat/CommentPost", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *chatClient) GetCommentPostListByPostID(ctx context.Context, in *GetCommentPostListByPostIDReq, opts ...grpc.CallOption) (*GetCommentPostListByPostIDResp, error) {
-	out := new(GetCommentPostListByPostIDResp)
-	err := c.cc.Invoke(ctx, "/openim.chat.chat/GetCommentPostListByPostID", in, out, opts...)
+func (c *chatClient) ReferencePost(ctx context.Context, in *ReferencePostReq, opts ...grpc.CallOption) (*ReferencePostResp, error) {
+	out := new(ReferencePostResp)
+	err := c.cc.Invoke(ctx, "/openim.chat.chat/ReferencePost", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *chatClient) GetPostByID(ctx context.Context, in *GetPostByIDReq, opts ...grpc.CallOption) (*GetPostByIDResp, error) {
-	out := new(GetPostByIDResp)
-	err := c.cc.Invoke(ctx, "/openim.chat.chat/GetPostByID", in, out, opts...)
+func (c *chatClient) EditPost(ctx context.Context, in *EditPostReq, opts ...grpc.CallOption) (*EditPostResp, error) {
+	out := new(EditPostResp)
+	err := c.cc.Invoke(ctx, "/openim.chat.chat/EditPost", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *chatClient) DeletePost(ctx context.Context, in *DeletePostReq, opts ...grpc.CallOption) (*DeletePostResp, error) {
-	out := new(DeletePostResp)
-	err := c.cc.Invoke(ctx, "/openim.chat.chat/DeletePost", in, out, opts...)
+func (c *chatClient) GetPostRevisions(ctx context.Context, in *GetPostRevisionsReq, opts ...grpc.CallOption) (*GetPostRevisionsResp, error) {
+	out := new(GetPostRevisionsResp)
+	err := c.cc.Invoke(ctx, "/openim.chat.chat/GetPostRevisions", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *chatClient) ChangeAllowCommentPost(ctx context.Context, in *ChangeAllowCommentPostReq, opts ...grpc.CallOption) (*ChangeAllowCommentPostResp, error) {
-	out := new(ChangeAllowCommentPostResp)
-	err := c.cc.Invoke(ctx, "/openim.chat.chat/ChangeAllowCommentPost", in, out, opts...)
+func (c *chatClient) ReconcilePostCounts(ctx context.Context, in *ReconcilePostCountsReq, opts ...grpc.CallOption) (*ReconcilePostCountsResp, error) {
+	out := new(ReconcilePostCountsResp)
+	err := c.cc.Invoke(ctx, "/openim.chat.chat/ReconcilePostCounts", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *chatClient) ChangeAllowForwardPost(ctx context.Context, in *ChangeAllowForwardPostReq, opts ...grpc.CallOption) (*ChangeAllowForwardPostResp, error) {
-	out := new(ChangeAllowForwardPostResp)
-	err := c.cc.Invoke(ctx, "/openim.chat.chat/ChangeAllowForwardPost", in, out, opts...)
+func (c *chatClient) SaveDraft(ctx context.Context, in *SaveDraftReq, opts ...grpc.CallOption) (*SaveDraftResp, error) {
+	out := new(SaveDraftResp)
+	err := c.cc.Invoke(ctx, "/openim.chat.chat/SaveDraft", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *chatClient) ChangeLikePost(ctx context.Context, in *LikePostReq, opts ...grpc.CallOption) (*LikePostResp, error) {
-	out := new(LikePostResp)
-	err := c.cc.Invoke(ctx, "/openim.chat.chat/ChangeLikePost", in, out, opts...)
+func (c *chatClient) ListDrafts(ctx context.Context, in *ListDraftsReq, opts ...grpc.CallOption) (*ListDraftsResp, error) {
+	out := new(ListDraftsResp)
+	err := c.cc.Invoke(ctx, "/openim.chat.chat/ListDrafts", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *chatClient) ChangeCollectPost(ctx context.Context, in *CollectPostReq, opts ...grpc.CallOption) (*CollectPostResp, error) {
-	out := new(CollectPostResp)
-	err := c.cc.Invoke(ctx, "/openim.chat.chat/ChangeCollectPost", in, out, opts...)
+func (c *chatClient) DeleteDraft(ctx context.Context, in *DeleteDraftReq, opts ...grpc.CallOption) (*DeleteDraftResp, error) {
+	out := new(DeleteDraftResp)
+	err := c.cc.Invoke(ctx, "/openim.chat.chat/DeleteDraft", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *chatClient) PinPost(ctx context.Context, in *PinPostReq, opts ...grpc.CallOption) (*PinPostResp, error) {
-	out := new(PinPostResp)
-	err := c.cc.Invoke(ctx, "/openim.chat.chat/PinPost", in, out, opts...)
+func (c *chatClient) GetNotifications(ctx context.Context, in *GetNotificationsReq, opts ...grpc.CallOption) (*GetNotificationsResp, error) {
+	out := new(GetNotificationsResp)
+	err := c.cc.Invoke(ctx, "/openim.chat.chat/GetNotifications", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *chatClient) ForwardPost(ctx context.Context, in *ForwardPostReq, opts ...grpc.CallOption) (*ForwardPostResp, error) {
-	out := new(ForwardPostResp)
-	err := c.cc.Invoke(ctx, "/openim.chat.chat/ForwardPost", in, out, opts...)
+func (c *chatClient) GetUnreadNotificationCount(ctx context.Context, in *GetUnreadNotificationCountReq, opts ...grpc.CallOption) (*GetUnreadNotificationCountResp, error) {
+	out := new(GetUnreadNotificationCountResp)
+	err := c.cc.Invoke(ctx, "/openim.chat.chat/GetUnreadNotificationCount", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *chatClient) CommentPost(ctx context.Context, in *CommentPostReq, opts ...grpc.CallOption) (*CommentPostResp, error) {
-	out := new(CommentPostResp)
-	err := c.cc.Invoke(ctx, "/openim.chat.chat/CommentPost", in, out, opts...)
+func (c *chatClient) MarkNotificationsRead(ctx context.Context, in *MarkNotificationsReadReq, opts ...grpc.CallOption) (*MarkNotificationsReadResp, error) {
+	out := new(MarkNotificationsReadResp)
+	err := c.cc.Invoke(ctx, "/openim.chat.chat/MarkNotificationsRead", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *chatClient) ReferencePost(ctx context.Context, in *ReferencePostReq, opts ...grpc.CallOption) (*ReferencePostResp, error) {
-	out := new(ReferencePostResp)
-	err := c.cc.Invoke(ctx, "/openim.chat.chat/ReferencePost", in, out, opts...)
+func (c *chatClient) MarkAllNotificationsRead(ctx context.Context, in *MarkAllNotificationsReadReq, opts ...grpc.CallOption) (*MarkAllNotificationsReadResp, error) {
+	out := new(MarkAllNotificationsReadResp)
+	err := c.cc.Invoke(ctx, "/openim.chat.chat/MarkAllNotificationsRead", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -8915,10 +13276,26 @@ type ChatServer interface {
 	ChallengeNonce(context.Context, *ChallengeNonceReq) (*ChallengeNonceResp, error)
 	RegisterUser(context.Context, *RegisterUserReq) (*RegisterUserResp, error)
 	Login(context.Context, *LoginReq) (*LoginResp, error)
+	// Wallets linked to an account, any of them can be used to login
+	AddWallet(context.Context, *AddWalletReq) (*AddWalletResp, error)
+	ListWallets(context.Context, *ListWalletsReq) (*ListWalletsResp, error)
+	RemoveWallet(context.Context, *RemoveWalletReq) (*RemoveWalletResp, error)
 	ResetPassword(context.Context, *ResetPasswordReq) (*ResetPasswordResp, error)
 	ChangePassword(context.Context, *ChangePasswordReq) (*ChangePasswordResp, error)
 	CheckUserExist(context.Context, *CheckUserExistReq) (*CheckUserExistResp, error)
 	DelUserAccount(context.Context, *DelUserAccountReq) (*DelUserAccountResp, error)
+	// Account deletion, the user data is erased after the grace period
+	RequestAccountDeletion(context.Context, *RequestAccountDeletionReq) (*RequestAccountDeletionResp, error)
+	CancelAccountDeletion(context.Context, *CancelAccountDeletionReq) (*CancelAccountDeletionResp, error)
+	GetAccountDeletion(context.Context, *GetAccountDeletionReq) (*GetAccountDeletionResp, error)
+	RequestDataExport(context.Context, *RequestDataExportReq) (*RequestDataExportResp, error)
+	GetDataExport(context.Context, *GetDataExportReq) (*GetDataExportResp, error)
+	// checks the signed link and claims the export for one download, other downloads fail until the claim is released or its lease ends
+	OpenDataExport(context.Context, *OpenDataExportReq) (*OpenDataExportResp, error)
+	// marks the export downloaded once the archive was sent completely, the link can not be used again
+	ConsumeDataExport(context.Context, *ConsumeDataExportReq) (*ConsumeDataExportResp, error)
+	// gives the claim back after a failed transfer so the link can be retried
+	ReleaseDataExport(context.Context, *ReleaseDataExportReq) (*ReleaseDataExportResp, error)
 	FindUserAccount(context.Context, *FindUserAccountReq) (*FindUserAccountResp, error)
 	FindAccountUser(context.Context, *FindAccountUserReq) (*FindAccountUserResp, error)
 	OpenIMCallback(context.Context, *OpenIMCallbackReq) (*OpenIMCallbackResp, error)
@@ -8971,6 +13348,26 @@ type ChatServer interface {
 	CommentPost(context.Context, *CommentPostReq) (*CommentPostResp, error)
 	// 引用帖子
 	ReferencePost(context.Context, *ReferencePostReq) (*ReferencePostResp, error)
+	// 编辑帖子
+	EditPost(context.Context, *EditPostReq) (*EditPostResp, error)
+	// 获取帖子历史版本
+	GetPostRevisions(context.Context, *GetPostRevisionsReq) (*GetPostRevisionsResp, error)
+	// 重新计算帖子计数（管理员）
+	ReconcilePostCounts(context.Context, *ReconcilePostCountsReq) (*ReconcilePostCountsResp, error)
+	// 保存草稿，可设置定时发布
+	SaveDraft(context.Context, *SaveDraftReq) (*SaveDraftResp, error)
+	// 获取草稿列表
+	ListDrafts(context.Context, *ListDraftsReq) (*ListDraftsResp, error)
+	// 删除草稿
+	DeleteDraft(context.Context, *DeleteDraftReq) (*DeleteDraftResp, error)
+	// 获取通知列表
+	GetNotifications(context.Context, *GetNotificationsReq) (*GetNotificationsResp, error)
+	// 获取未读通知数
+	GetUnreadNotificationCount(context.Context, *GetUnreadNotificationCountReq) (*GetUnreadNotificationCountResp, error)
+	// 标记通知已读
+	MarkNotificationsRead(context.Context, *MarkNotificationsReadReq) (*MarkNotificationsReadResp, error)
+	// 标记全部通知已读
+	MarkAllNotificationsRead(context.Context, *MarkAllNotificationsReadReq) (*MarkAllNotificationsReadResp, error)
 	// 检查版本
 	CheckVersion(context.Context, *CheckVersionReq) (*CheckVersionResp, error)
 	// 获取假用户
@@ -9017,6 +13414,15 @@ func (*UnimplementedChatServer) RegisterUser(context.Context, *RegisterUserReq)
 func (*UnimplementedChatServer) Login(context.Context, *LoginReq) (*LoginResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Login not implemented")
 }
+func (*UnimplementedChatServer) AddWallet(context.Context, *AddWalletReq) (*AddWalletResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddWallet not implemented")
+}
+func (*UnimplementedChatServer) ListWallets(context.Context, *ListWalletsReq) (*ListWalletsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWallets not implemented")
+}
+func (*UnimplementedChatServer) RemoveWallet(context.Context, *RemoveWalletReq) (*RemoveWalletResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveWallet not implemented")
+}
 func (*UnimplementedChatServer) ResetPassword(context.Context, *ResetPasswordReq) (*ResetPasswordResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ResetPassword not implemented")
 }
@@ -9029,6 +13435,30 @@ func (*UnimplementedChatServer) CheckUserExist(context.Context, *CheckUserExistR
 func (*UnimplementedChatServer) DelUserAccount(context.Context, *DelUserAccountReq) (*DelUserAccountResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DelUserAccount not implemented")
 }
+func (*UnimplementedChatServer) RequestAccountDeletion(context.Context, *RequestAccountDeletionReq) (*RequestAccountDeletionResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestAccountDeletion not implemented")
+}
+func (*UnimplementedChatServer) CancelAccountDeletion(context.Context, *CancelAccountDeletionReq) (*CancelAccountDeletionResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelAccountDeletion not implemented")
+}
+func (*UnimplementedChatServer) GetAccountDeletion(context.Context, *GetAccountDeletionReq) (*GetAccountDeletionResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAccountDeletion not implemented")
+}
+func (*UnimplementedChatServer) RequestDataExport(context.Context, *RequestDataExportReq) (*RequestDataExportResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestDataExport not implemented")
+}
+func (*UnimplementedChatServer) GetDataExport(context.Context, *GetDataExportReq) (*GetDataExportResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDataExport not implemented")
+}
+func (*UnimplementedChatServer) OpenDataExport(context.Context, *OpenDataExportReq) (*OpenDataExportResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OpenDataExport not implemented")
+}
+func (*UnimplementedChatServer) ConsumeDataExport(context.Context, *ConsumeDataExportReq) (*ConsumeDataExportResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConsumeDataExport not implemented")
+}
+func (*UnimplementedChatServer) ReleaseDataExport(context.Context, *ReleaseDataExportReq) (*ReleaseDataExportResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleaseDataExport not implemented")
+}
 func (*UnimplementedChatServer) FindUserAccount(context.Context, *FindUserAccountReq) (*FindUserAccountResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FindUserAccount not implemented")
 }
@@ -9113,6 +13543,36 @@ func (*UnimplementedChatServer) CommentPost(context.Context, *CommentPostReq) (*
 func (*UnimplementedChatServer) ReferencePost(context.Context, *ReferencePostReq) (*ReferencePostResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ReferencePost not implemented")
 }
+func (*UnimplementedChatServer) EditPost(context.Context, *EditPostReq) (*EditPostResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EditPost not implemented")
+}
+func (*UnimplementedChatServer) GetPostRevisions(context.Context, *GetPostRevisionsReq) (*GetPostRevisionsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPostRevisions not implemented")
+}
+func (*UnimplementedChatServer) ReconcilePostCounts(context.Context, *ReconcilePostCountsReq) (*ReconcilePostCountsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReconcilePostCounts not implemented")
+}
+func (*UnimplementedChatServer) SaveDraft(context.Context, *SaveDraftReq) (*SaveDraftResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SaveDraft not implemented")
+}
+func (*UnimplementedChatServer) ListDrafts(context.Context, *ListDraftsReq) (*ListDraftsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDrafts not implemented")
+}
+func (*UnimplementedChatServer) DeleteDraft(context.Context, *DeleteDraftReq) (*DeleteDraftResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteDraft not implemented")
+}
+func (*UnimplementedChatServer) GetNotifications(context.Context, *GetNotificationsReq) (*GetNotificationsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNotifications not implemented")
+}
+func (*UnimplementedChatServer) GetUnreadNotificationCount(context.Context, *GetUnreadNotificationCountReq) (*GetUnreadNotificationCountResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUnreadNotificationCount not implemented")
+}
+func (*UnimplementedChatServer) MarkNotificationsRead(context.Context, *MarkNotificationsReadReq) (*MarkNotificationsReadResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarkNotificationsRead not implemented")
+}
+func (*UnimplementedChatServer) MarkAllNotificationsRead(context.Context, *MarkAllNotificationsReadReq) (*MarkAllNotificationsReadResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarkAllNotificationsRead not implemented")
+}
 func (*UnimplementedChatServer) CheckVersion(context.Context, *CheckVersionReq) (*CheckVersionResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CheckVersion not implemented")
 }
@@ -9184,230 +13644,428 @@ func _Chat_FindUserPublicInfo_Handler(srv interface{}, ctx context.Context, dec
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ChatServer).FindUserPublicInfo(ctx, in)
+		return srv.(ChatServer).FindUserPublicInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/openim.chat.chat/FindUserPublicInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServer).FindUserPublicInfo(ctx, req.(*FindUserPublicInfoReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chat_FindUserByAddressOrAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindUserByAddressOrAccountReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServer).FindUserByAddressOrAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/openim.chat.chat/FindUserByAddressOrAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServer).FindUserByAddressOrAccount(ctx, req.(*FindUserByAddressOrAccountReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chat_SearchUserFullInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchUserFullInfoReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServer).SearchUserFullInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/openim.chat.chat/SearchUserFullInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServer).SearchUserFullInfo(ctx, req.(*SearchUserFullInfoReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chat_FindUserFullInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindUserFullInfoReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServer).FindUserFullInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/openim.chat.chat/FindUserFullInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServer).FindUserFullInfo(ctx, req.(*FindUserFullInfoReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chat_SendVerifyCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendVerifyCodeReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServer).SendVerifyCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/openim.chat.chat/SendVerifyCode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServer).SendVerifyCode(ctx, req.(*SendVerifyCodeReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chat_VerifyCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyCodeReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServer).VerifyCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/openim.chat.chat/VerifyCode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServer).VerifyCode(ctx, req.(*VerifyCodeReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chat_ChallengeNonce_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChallengeNonceReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServer).ChallengeNonce(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/openim.chat.chat/ChallengeNonce",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServer).ChallengeNonce(ctx, req.(*ChallengeNonceReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chat_RegisterUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterUserReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServer).RegisterUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/openim.chat.chat/RegisterUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServer).RegisterUser(ctx, req.(*RegisterUserReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chat_Login_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoginReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServer).Login(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/openim.chat.chat/Login",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServer).Login(ctx, req.(*LoginReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chat_AddWallet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddWalletReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServer).AddWallet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/openim.chat.chat/AddWallet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServer).AddWallet(ctx, req.(*AddWalletReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chat_ListWallets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWalletsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServer).ListWallets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/openim.chat.chat/ListWallets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServer).ListWallets(ctx, req.(*ListWalletsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chat_RemoveWallet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveWalletReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServer).RemoveWallet(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/openim.chat.chat/FindUserPublicInfo",
+		FullMethod: "/openim.chat.chat/RemoveWallet",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ChatServer).FindUserPublicInfo(ctx, req.(*FindUserPublicInfoReq))
+		return srv.(ChatServer).RemoveWallet(ctx, req.(*RemoveWalletReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Chat_FindUserByAddressOrAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(FindUserByAddressOrAccountReq)
+func _Chat_ResetPassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResetPasswordReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ChatServer).FindUserByAddressOrAccount(ctx, in)
+		return srv.(ChatServer).ResetPassword(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/openim.chat.chat/FindUserByAddressOrAccount",
+		FullMethod: "/openim.chat.chat/ResetPassword",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ChatServer).FindUserByAddressOrAccount(ctx, req.(*FindUserByAddressOrAccountReq))
+		return srv.(ChatServer).ResetPassword(ctx, req.(*ResetPasswordReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Chat_SearchUserFullInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SearchUserFullInfoReq)
+func _Chat_ChangePassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangePasswordReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ChatServer).SearchUserFullInfo(ctx, in)
+		return srv.(ChatServer).ChangePassword(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/openim.chat.chat/SearchUserFullInfo",
+		FullMethod: "/openim.chat.chat/ChangePassword",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ChatServer).SearchUserFullInfo(ctx, req.(*SearchUserFullInfoReq))
+		return srv.(ChatServer).ChangePassword(ctx, req.(*ChangePasswordReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Chat_FindUserFullInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(FindUserFullInfoReq)
+func _Chat_CheckUserExist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckUserExistReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ChatServer).FindUserFullInfo(ctx, in)
+		return srv.(ChatServer).CheckUserExist(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/openim.chat.chat/FindUserFullInfo",
+		FullMethod: "/openim.chat.chat/CheckUserExist",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ChatServer).FindUserFullInfo(ctx, req.(*FindUserFullInfoReq))
+		return srv.(ChatServer).CheckUserExist(ctx, req.(*CheckUserExistReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Chat_SendVerifyCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SendVerifyCodeReq)
+func _Chat_DelUserAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DelUserAccountReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ChatServer).SendVerifyCode(ctx, in)
+		return srv.(ChatServer).DelUserAccount(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/openim.chat.chat/SendVerifyCode",
+		FullMethod: "/openim.chat.chat/DelUserAccount",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ChatServer).SendVerifyCode(ctx, req.(*SendVerifyCodeReq))
+		return srv.(ChatServer).DelUserAccount(ctx, req.(*DelUserAccountReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Chat_VerifyCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(VerifyCodeReq)
+func _Chat_RequestAccountDeletion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestAccountDeletionReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ChatServer).VerifyCode(ctx, in)
+		return srv.(ChatServer).RequestAccountDeletion(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/openim.chat.chat/VerifyCode",
+		FullMethod: "/openim.chat.chat/RequestAccountDeletion",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ChatServer).VerifyCode(ctx, req.(*VerifyCodeReq))
+		return srv.(ChatServer).RequestAccountDeletion(ctx, req.(*RequestAccountDeletionReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Chat_ChallengeNonce_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ChallengeNonceReq)
+func _Chat_CancelAccountDeletion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelAccountDeletionReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ChatServer).ChallengeNonce(ctx, in)
+		return srv.(ChatServer).CancelAccountDeletion(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/openim.chat.chat/ChallengeNonce",
+		FullMethod: "/openim.chat.chat/CancelAccountDeletion",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ChatServer).ChallengeNonce(ctx, req.(*ChallengeNonceReq))
+		return srv.(ChatServer).CancelAccountDeletion(ctx, req.(*CancelAccountDeletionReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Chat_RegisterUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RegisterUserReq)
+func _Chat_GetAccountDeletion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAccountDeletionReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ChatServer).RegisterUser(ctx, in)
+		return srv.(ChatServer).GetAccountDeletion(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/openim.chat.chat/RegisterUser",
+		FullMethod: "/openim.chat.chat/GetAccountDeletion",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ChatServer).RegisterUser(ctx, req.(*RegisterUserReq))
+		return srv.(ChatServer).GetAccountDeletion(ctx, req.(*GetAccountDeletionReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Chat_Login_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LoginReq)
+func _Chat_RequestDataExport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestDataExportReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ChatServer).Login(ctx, in)
+		return srv.(ChatServer).RequestDataExport(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/openim.chat.chat/Login",
+		FullMethod: "/openim.chat.chat/RequestDataExport",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ChatServer).Login(ctx, req.(*LoginReq))
+		return srv.(ChatServer).RequestDataExport(ctx, req.(*RequestDataExportReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Chat_ResetPassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ResetPasswordReq)
+func _Chat_GetDataExport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDataExportReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ChatServer).ResetPassword(ctx, in)
+		return srv.(ChatServer).GetDataExport(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/openim.chat.chat/ResetPassword",
+		FullMethod: "/openim.chat.chat/GetDataExport",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ChatServer).ResetPassword(ctx, req.(*ResetPasswordReq))
+		return srv.(ChatServer).GetDataExport(ctx, req.(*GetDataExportReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Chat_ChangePassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ChangePasswordReq)
+func _Chat_OpenDataExport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OpenDataExportReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ChatServer).ChangePassword(ctx, in)
+		return srv.(ChatServer).OpenDataExport(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/openim.chat.chat/ChangePassword",
+		FullMethod: "/openim.chat.chat/OpenDataExport",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ChatServer).ChangePassword(ctx, req.(*ChangePasswordReq))
+		return srv.(ChatServer).OpenDataExport(ctx, req.(*OpenDataExportReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Chat_CheckUserExist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CheckUserExistReq)
+func _Chat_ConsumeDataExport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConsumeDataExportReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ChatServer).CheckUserExist(ctx, in)
+		return srv.(ChatServer).ConsumeDataExport(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/openim.chat.chat/CheckUserExist",
+		FullMethod: "/openim.chat.chat/ConsumeDataExport",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ChatServer).CheckUserExist(ctx, req.(*CheckUserExistReq))
+		return srv.(ChatServer).ConsumeDataExport(ctx, req.(*ConsumeDataExportReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Chat_DelUserAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DelUserAccountReq)
+func _Chat_ReleaseDataExport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReleaseDataExportReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ChatServer).DelUserAccount(ctx, in)
+		return srv.(ChatServer).ReleaseDataExport(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/openim.chat.chat/DelUserAccount",
+		FullMethod: "/openim.chat.chat/ReleaseDataExport",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ChatServer).DelUserAccount(ctx, req.(*DelUserAccountReq))
+		return srv.(ChatServer).ReleaseDataExport(ctx, req.(*ReleaseDataExportReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -9916,6 +14574,186 @@ func _Chat_ReferencePost_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Chat_EditPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EditPostReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServer).EditPost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/openim.chat.chat/EditPost",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServer).EditPost(ctx, req.(*EditPostReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chat_GetPostRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPostRevisionsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServer).GetPostRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/openim.chat.chat/GetPostRevisions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServer).GetPostRevisions(ctx, req.(*GetPostRevisionsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chat_ReconcilePostCounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReconcilePostCountsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServer).ReconcilePostCounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/openim.chat.chat/ReconcilePostCounts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServer).ReconcilePostCounts(ctx, req.(*ReconcilePostCountsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chat_SaveDraft_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SaveDraftReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServer).SaveDraft(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/openim.chat.chat/SaveDraft",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServer).SaveDraft(ctx, req.(*SaveDraftReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chat_ListDrafts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDraftsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServer).ListDrafts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/openim.chat.chat/ListDrafts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServer).ListDrafts(ctx, req.(*ListDraftsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chat_DeleteDraft_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteDraftReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServer).DeleteDraft(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/openim.chat.chat/DeleteDraft",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServer).DeleteDraft(ctx, req.(*DeleteDraftReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chat_GetNotifications_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNotificationsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServer).GetNotifications(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/openim.chat.chat/GetNotifications",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServer).GetNotifications(ctx, req.(*GetNotificationsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chat_GetUnreadNotificationCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUnreadNotificationCountReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServer).GetUnreadNotificationCount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/openim.chat.chat/GetUnreadNotificationCount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServer).GetUnreadNotificationCount(ctx, req.(*GetUnreadNotificationCountReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chat_MarkNotificationsRead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarkNotificationsReadReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServer).MarkNotificationsRead(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/openim.chat.chat/MarkNotificationsRead",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServer).MarkNotificationsRead(ctx, req.(*MarkNotificationsReadReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Chat_MarkAllNotificationsRead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarkAllNotificationsReadReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServer).MarkAllNotificationsRead(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/openim.chat.chat/MarkAllNotificationsRead",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServer).MarkAllNotificationsRead(ctx, req.(*MarkAllNotificationsReadReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Chat_CheckVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CheckVersionReq)
 	if err := dec(in); err != nil {
@@ -10004,6 +14842,18 @@ var _Chat_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Login",
 			Handler:    _Chat_Login_Handler,
 		},
+		{
+			MethodName: "AddWallet",
+			Handler:    _Chat_AddWallet_Handler,
+		},
+		{
+			MethodName: "ListWallets",
+			Handler:    _Chat_ListWallets_Handler,
+		},
+		{
+			MethodName: "RemoveWallet",
+			Handler:    _Chat_RemoveWallet_Handler,
+		},
 		{
 			MethodName: "ResetPassword",
 			Handler:    _Chat_ResetPassword_Handler,
@@ -10020,6 +14870,38 @@ var _Chat_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DelUserAccount",
 			Handler:    _Chat_DelUserAccount_Handler,
 		},
+		{
+			MethodName: "RequestAccountDeletion",
+			Handler:    _Chat_RequestAccountDeletion_Handler,
+		},
+		{
+			MethodName: "CancelAccountDeletion",
+			Handler:    _Chat_CancelAccountDeletion_Handler,
+		},
+		{
+			MethodName: "GetAccountDeletion",
+			Handler:    _Chat_GetAccountDeletion_Handler,
+		},
+		{
+			MethodName: "RequestDataExport",
+			Handler:    _Chat_RequestDataExport_Handler,
+		},
+		{
+			MethodName: "GetDataExport",
+			Handler:    _Chat_GetDataExport_Handler,
+		},
+		{
+			MethodName: "OpenDataExport",
+			Handler:    _Chat_OpenDataExport_Handler,
+		},
+		{
+			MethodName: "ConsumeDataExport",
+			Handler:    _Chat_ConsumeDataExport_Handler,
+		},
+		{
+			MethodName: "ReleaseDataExport",
+			Handler:    _Chat_ReleaseDataExport_Handler,
+		},
 		{
 			MethodName: "FindUserAccount",
 			Handler:    _Chat_FindUserAccount_Handler,
@@ -10132,6 +15014,46 @@ var _Chat_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ReferencePost",
 			Handler:    _Chat_ReferencePost_Handler,
 		},
+		{
+			MethodName: "EditPost",
+			Handler:    _Chat_EditPost_Handler,
+		},
+		{
+			MethodName: "GetPostRevisions",
+			Handler:    _Chat_GetPostRevisions_Handler,
+		},
+		{
+			MethodName: "ReconcilePostCounts",
+			Handler:    _Chat_ReconcilePostCounts_Handler,
+		},
+		{
+			MethodName: "SaveDraft",
+			Handler:    _Chat_SaveDraft_Handler,
+		},
+		{
+			MethodName: "ListDrafts",
+			Handler:    _Chat_ListDrafts_Handler,
+		},
+		{
+			MethodName: "DeleteDraft",
+			Handler:    _Chat_DeleteDraft_Handler,
+		},
+		{
+			MethodName: "GetNotifications",
+			Handler:    _Chat_GetNotifications_Handler,
+		},
+		{
+			MethodName: "GetUnreadNotificationCount",
+			Handler:    _Chat_GetUnreadNotificationCount_Handler,
+		},
+		{
+			MethodName: "MarkNotificationsRead",
+			Handler:    _Chat_MarkNotificationsRead_Handler,
+		},
+		{
+			MethodName: "MarkAllNotificationsRead",
+			Handler:    _Chat_MarkAllNotificationsRead_Handler,
+		},
 		{
 			MethodName: "CheckVersion",
 			Handler:    _Chat_CheckVersion_Handler,
@@ -16,6 +16,7 @@ package chat
 
 import (
 	"context"
+	"time"
 
 	"github.com/openimsdk/chat/pkg/common/mctx"
 	"github.com/openimsdk/chat/pkg/eerrs"
@@ -51,7 +52,11 @@ func (o *AdminClient) CheckInvitationCode(ctx context.Context, invitationCode st
 	if len(resp.Codes) == 0 {
 		return eerrs.ErrInvitationNotFound.Wrap()
 	}
-	if resp.Codes[0].UsedUserID != "" {
+	code := resp.Codes[0]
+	if code.ExpireTime > 0 && code.ExpireTime <= time.Now().UnixMilli() {
+		return eerrs.ErrInvitationCodeExpired.Wrap()
+	}
+	if code.UsedCount >= code.MaxUses {
 		return eerrs.ErrInvitationCodeUsed.Wrap()
 	}
 	return nil
@@ -72,6 +77,18 @@ func (o *AdminClient) UseInvitationCode(ctx context.Context, userID string, invi
 	return err
 }
 
+// ReleaseInvitationCode takes back the use UseInvitationCode took for userID.
+func (o *AdminClient) ReleaseInvitationCode(ctx context.Context, userID string, invitationCode string) error {
+	_, err := o.client.ReleaseInvitationCode(ctx, &admin.ReleaseInvitationCodeReq{UserID: userID, Code: invitationCode})
+	return err
+}
+
+// AddAuditLog writes an admin operation to the audit log, ctx must carry the service admin identity.
+func (o *AdminClient) AddAuditLog(ctx context.Context, entry *admin.AuditLog) error {
+	_, err := o.client.AddAuditLog(ctx, &admin.AddAuditLogReq{Log: entry})
+	return err
+}
+
 func (o *AdminClient) CheckNilOrAdmin(ctx context.Context) (bool, error) {
 	if !mctx.HaveOpUser(ctx) {
 		return false, nil
@@ -83,8 +100,8 @@ func (o *AdminClient) CheckNilOrAdmin(ctx context.Context) (bool, error) {
 	return true, nil
 }
 
-func (o *AdminClient) CreateToken(ctx context.Context, userID string, userType int32) (*admin.CreateTokenResp, error) {
-	return o.client.CreateToken(ctx, &admin.CreateTokenReq{UserID: userID, UserType: userType})
+func (o *AdminClient) CreateToken(ctx context.Context, req *admin.CreateTokenReq) (*admin.CreateTokenResp, error) {
+	return o.client.CreateToken(ctx, req)
 }
 
 func (o *AdminClient) GetDefaultFriendUserID(ctx context.Context) ([]string, error) {
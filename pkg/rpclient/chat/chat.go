@@ -113,3 +113,11 @@ func (o *ChatClient) DelUserAccount(ctx context.Context, req *chat.DelUserAccoun
 	resp, err = o.client.DelUserAccount(ctx, req)
 	return resp, err
 }
+
+func (o *ChatClient) RequestAccountDeletion(ctx context.Context, req *chat.RequestAccountDeletionReq) (*chat.AccountDeletion, error) {
+	resp, err := o.client.RequestAccountDeletion(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Deletion, nil
+}
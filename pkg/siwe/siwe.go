@@ -0,0 +1,229 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package siwe implements the EIP-4361 "Sign-In with Ethereum" message format.
+package siwe
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+const (
+	headerSuffix = " wants you to sign in with your Ethereum account:"
+	Version      = "1"
+)
+
+var (
+	ErrDomainMismatch  = errors.New("siwe: domain mismatch")
+	ErrAddressMismatch = errors.New("siwe: address mismatch")
+	ErrChainMismatch   = errors.New("siwe: chain id mismatch")
+	ErrExpired         = errors.New("siwe: message expired")
+	ErrNotYetValid     = errors.New("siwe: message not yet valid")
+
+	nonceRegexp = regexp.MustCompile(`^[a-zA-Z0-9]{8,}$`)
+)
+
+type Message struct {
+	Scheme         string
+	Domain         string
+	Address        string
+	Statement      string
+	URI            string
+	Version        string
+	ChainID        int64
+	Nonce          string
+	IssuedAt       time.Time
+	ExpirationTime time.Time // zero if absent
+	NotBefore      time.Time // zero if absent
+	RequestID      string
+	Resources      []string
+}
+
+// String renders the message in the EIP-4361 text form that wallets display and sign.
+func (m *Message) String() string {
+	var b strings.Builder
+	if m.Scheme != "" {
+		b.WriteString(m.Scheme + "://")
+	}
+	b.WriteString(m.Domain + headerSuffix + "\n")
+	b.WriteString(common.HexToAddress(m.Address).Hex() + "\n\n")
+	if m.Statement != "" {
+		b.WriteString(m.Statement + "\n")
+	}
+	b.WriteString("\n")
+	b.WriteString("URI: " + m.URI + "\n")
+	version := m.Version
+	if version == "" {
+		version = Version
+	}
+	b.WriteString("Version: " + version + "\n")
+	b.WriteString("Chain ID: " + strconv.FormatInt(m.ChainID, 10) + "\n")
+	b.WriteString("Nonce: " + m.Nonce + "\n")
+	b.WriteString("Issued At: " + m.IssuedAt.UTC().Format(time.RFC3339))
+	if !m.ExpirationTime.IsZero() {
+		b.WriteString("\nExpiration Time: " + m.ExpirationTime.UTC().Format(time.RFC3339))
+	}
+	if !m.NotBefore.IsZero() {
+		b.WriteString("\nNot Before: " + m.NotBefore.UTC().Format(time.RFC3339))
+	}
+	if m.RequestID != "" {
+		b.WriteString("\nRequest ID: " + m.RequestID)
+	}
+	if len(m.Resources) > 0 {
+		b.WriteString("\nResources:")
+		for _, r := range m.Resources {
+			b.WriteString("\n- " + r)
+		}
+	}
+	return b.String()
+}
+
+// Parse reads an EIP-4361 message.
+func Parse(s string) (*Message, error) {
+	lines := strings.Split(strings.ReplaceAll(s, "\r\n", "\n"), "\n")
+	if len(lines) < 9 {
+		return nil, errors.New("siwe: message too short")
+	}
+	var m Message
+	header, ok := strings.CutSuffix(lines[0], headerSuffix)
+	if !ok {
+		return nil, errors.New("siwe: invalid header")
+	}
+	if scheme, domain, ok := strings.Cut(header, "://"); ok {
+		m.Scheme, m.Domain = scheme, domain
+	} else {
+		m.Domain = header
+	}
+	if m.Domain == "" {
+		return nil, errors.New("siwe: domain is empty")
+	}
+	if !common.IsHexAddress(lines[1]) || !strings.HasPrefix(lines[1], "0x") {
+		return nil, errors.New("siwe: invalid address")
+	}
+	m.Address = lines[1]
+	if lines[2] != "" {
+		return nil, errors.New("siwe: expected empty line after address")
+	}
+	i := 3
+	if lines[i] != "" {
+		m.Statement = lines[i]
+		i++
+	}
+	if lines[i] != "" {
+		return nil, errors.New("siwe: expected empty line after statement")
+	}
+	i++
+
+	field := func(name string, required bool) (string, error) {
+		if i < len(lines) {
+			if v, ok := strings.CutPrefix(lines[i], name+": "); ok {
+				i++
+				return v, nil
+			}
+		}
+		if required {
+			return "", fmt.Errorf("siwe: missing %s", name)
+		}
+		return "", nil
+	}
+	timeField := func(name string, required bool) (time.Time, error) {
+		v, err := field(name, required)
+		if err != nil || v == "" {
+			return time.Time{}, err
+		}
+		t, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("siwe: invalid %s", name)
+		}
+		return t, nil
+	}
+
+	var err error
+	if m.URI, err = field("URI", true); err != nil {
+		return nil, err
+	}
+	if m.Version, err = field("Version", true); err != nil {
+		return nil, err
+	}
+	if m.Version != Version {
+		return nil, errors.New("siwe: unsupported version")
+	}
+	chainID, err := field("Chain ID", true)
+	if err != nil {
+		return nil, err
+	}
+	if m.ChainID, err = strconv.ParseInt(chainID, 10, 64); err != nil {
+		return nil, errors.New("siwe: invalid Chain ID")
+	}
+	if m.Nonce, err = field("Nonce", true); err != nil {
+		return nil, err
+	}
+	if !nonceRegexp.MatchString(m.Nonce) {
+		return nil, errors.New("siwe: invalid Nonce")
+	}
+	if m.IssuedAt, err = timeField("Issued At", true); err != nil {
+		return nil, err
+	}
+	if m.ExpirationTime, err = timeField("Expiration Time", false); err != nil {
+		return nil, err
+	}
+	if m.NotBefore, err = timeField("Not Before", false); err != nil {
+		return nil, err
+	}
+	if m.RequestID, err = field("Request ID", false); err != nil {
+		return nil, err
+	}
+	if i < len(lines) && lines[i] == "Resources:" {
+		for i++; i < len(lines) && strings.HasPrefix(lines[i], "- "); i++ {
+			m.Resources = append(m.Resources, lines[i][2:])
+		}
+	}
+	if i != len(lines) {
+		return nil, fmt.Errorf("siwe: unexpected line %q", lines[i])
+	}
+	return &m, nil
+}
+
+// Validate checks the message was issued for domain, address and chainID and is valid at now.
+func (m *Message) Validate(domain string, address string, chainID int64, now time.Time) error {
+	if !strings.EqualFold(m.Domain, domain) {
+		return ErrDomainMismatch
+	}
+	if !common.IsHexAddress(address) || common.HexToAddress(m.Address) != common.HexToAddress(address) {
+		return ErrAddressMismatch
+	}
+	if m.ChainID != chainID {
+		return ErrChainMismatch
+	}
+	if !m.ExpirationTime.IsZero() && !now.Before(m.ExpirationTime) {
+		return ErrExpired
+	}
+	if !m.NotBefore.IsZero() && now.Before(m.NotBefore) {
+		return ErrNotYetValid
+	}
+	return nil
+}
+
+// Hash returns the personal_sign (EIP-191) digest of the message text.
+func Hash(message string) []byte {
+	return crypto.Keccak256([]byte(fmt.Sprintf("\x19Ethereum Signed Message:\n%d%s", len(message), message)))
+}
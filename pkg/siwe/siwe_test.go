@@ -0,0 +1,135 @@
+package siwe
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+const specMessage = `service.org wants you to sign in with your Ethereum account:
+0xC02aaA39b223FE8D0A0e5C4F27eAD9083C756Cc2
+
+I accept the ServiceOrg Terms of Service: https://service.org/tos
+
+URI: https://service.org/login
+Version: 1
+Chain ID: 1
+Nonce: 32891756
+Issued At: 2021-09-30T16:25:24Z
+Resources:
+- ipfs://bafybeiemxf5abjwjbikoz4mc3a3dla6ual3jsgpdr4cjr3oz3evfyavhwq/
+- https://example.com/my-web2-claim.json`
+
+func TestParseSpecExample(t *testing.T) {
+	m, err := Parse(specMessage)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if m.Domain != "service.org" || m.ChainID != 1 || m.Nonce != "32891756" || len(m.Resources) != 2 {
+		t.Fatalf("unexpected message: %+v", m)
+	}
+	if m.Statement != "I accept the ServiceOrg Terms of Service: https://service.org/tos" {
+		t.Fatalf("unexpected statement: %q", m.Statement)
+	}
+	if got := m.String(); got != specMessage {
+		t.Fatalf("round trip mismatch:\n%s\n---\n%s", got, specMessage)
+	}
+}
+
+func TestRoundTripWithoutStatement(t *testing.T) {
+	now := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	m := &Message{
+		Scheme:         "https",
+		Domain:         "chat.example.com",
+		Address:        "0xc02aaa39b223fe8d0a0e5c4f27ead9083c756cc2",
+		URI:            "https://chat.example.com/account/login",
+		ChainID:        137,
+		Nonce:          "0xa1b2c3d4e5f6a7b8",
+		IssuedAt:       now,
+		ExpirationTime: now.Add(5 * time.Minute),
+	}
+	parsed, err := Parse(m.String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if parsed.Scheme != "https" || parsed.Statement != "" || !parsed.ExpirationTime.Equal(m.ExpirationTime) {
+		t.Fatalf("unexpected message: %+v", parsed)
+	}
+	if parsed.String() != m.String() {
+		t.Fatal("round trip mismatch")
+	}
+}
+
+func TestValidate(t *testing.T) {
+	issued := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	address := "0xC02aaA39b223FE8D0A0e5C4F27eAD9083C756Cc2"
+	m := &Message{
+		Domain:         "chat.example.com",
+		Address:        address,
+		URI:            "https://chat.example.com",
+		ChainID:        1,
+		Nonce:          "abcdefgh12",
+		IssuedAt:       issued,
+		ExpirationTime: issued.Add(time.Minute),
+		NotBefore:      issued,
+	}
+	tests := []struct {
+		name    string
+		domain  string
+		address string
+		chainID int64
+		now     time.Time
+		want    error
+	}{
+		{"ok", "chat.example.com", address, 1, issued.Add(time.Second), nil},
+		{"domain", "evil.example.com", address, 1, issued.Add(time.Second), ErrDomainMismatch},
+		{"address", "chat.example.com", "0x0000000000000000000000000000000000000001", 1, issued.Add(time.Second), ErrAddressMismatch},
+		{"chain", "chat.example.com", address, 5, issued.Add(time.Second), ErrChainMismatch},
+		{"expired", "chat.example.com", address, 1, issued.Add(time.Minute), ErrExpired},
+		{"not before", "chat.example.com", address, 1, issued.Add(-time.Second), ErrNotYetValid},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := m.Validate(tt.domain, tt.address, tt.chainID, tt.now); err != tt.want {
+				t.Errorf("want %v, got %v", tt.want, err)
+			}
+		})
+	}
+}
+
+func TestParseRejects(t *testing.T) {
+	for name, msg := range map[string]string{
+		"header":  "service.org wants you to sign in:\n0xC02aaA39b223FE8D0A0e5C4F27eAD9083C756Cc2\n\n\nURI: a\nVersion: 1\nChain ID: 1\nNonce: 32891756\nIssued At: 2021-09-30T16:25:24Z",
+		"version": "service.org wants you to sign in with your Ethereum account:\n0xC02aaA39b223FE8D0A0e5C4F27eAD9083C756Cc2\n\n\nURI: a\nVersion: 2\nChain ID: 1\nNonce: 32891756\nIssued At: 2021-09-30T16:25:24Z",
+		"nonce":   "service.org wants you to sign in with your Ethereum account:\n0xC02aaA39b223FE8D0A0e5C4F27eAD9083C756Cc2\n\n\nURI: a\nVersion: 1\nChain ID: 1\nNonce: short\nIssued At: 2021-09-30T16:25:24Z",
+		"trailer": "service.org wants you to sign in with your Ethereum account:\n0xC02aaA39b223FE8D0A0e5C4F27eAD9083C756Cc2\n\n\nURI: a\nVersion: 1\nChain ID: 1\nNonce: 32891756\nIssued At: 2021-09-30T16:25:24Z\nFoo: bar",
+	} {
+		if _, err := Parse(msg); err == nil {
+			t.Errorf("%s: expected parse error", name)
+		}
+	}
+}
+
+func TestHashMatchesPersonalSign(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	sig, err := crypto.Sign(Hash(specMessage), key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pub, err := crypto.SigToPub(Hash(specMessage), sig)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if crypto.PubkeyToAddress(*pub) != crypto.PubkeyToAddress(key.PublicKey) {
+		t.Fatal("recovered address mismatch")
+	}
+	// known vector: personal_sign digest of "hello"
+	if got := hexutil.Encode(Hash("hello")); got != "0x50b2c43fd39106bafbba0da34fc430e1f91e3c96ea2acee2bc34119f92b37750" {
+		t.Fatalf("unexpected digest %s", got)
+	}
+}
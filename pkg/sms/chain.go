@@ -0,0 +1,95 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sms
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/openimsdk/tools/errs"
+	"github.com/openimsdk/tools/log"
+)
+
+// NewFallback tries the providers in order until one sends the code.
+func NewFallback(providers ...SMS) SMS {
+	if len(providers) == 1 {
+		return providers[0]
+	}
+	return fallback(providers)
+}
+
+type fallback []SMS
+
+func (f fallback) Name() string {
+	names := make([]string, 0, len(f))
+	for _, provider := range f {
+		names = append(names, provider.Name())
+	}
+	return strings.Join(names, ",")
+}
+
+func (f fallback) SendCode(ctx context.Context, areaCode string, phoneNumber string, verifyCode string) error {
+	var errList []error
+	for _, provider := range f {
+		err := provider.SendCode(ctx, areaCode, phoneNumber, verifyCode)
+		if err == nil {
+			return nil
+		}
+		log.ZWarn(ctx, "sms provider failed", err, "provider", provider.Name(), "areaCode", areaCode)
+		errList = append(errList, err)
+		if ctx.Err() != nil {
+			break
+		}
+	}
+	return errs.WrapMsg(errors.Join(errList...), "all sms providers failed")
+}
+
+// NewRouter sends the codes of the area codes in routes with their provider, the others with def.
+// Area codes are matched in any of the forms NormalizeAreaCode accepts, def may be nil.
+func NewRouter(def SMS, routes map[string]SMS) SMS {
+	r := &router{
+		def:    def,
+		routes: make(map[string]SMS, len(routes)),
+	}
+	for areaCode, provider := range routes {
+		r.routes[NormalizeAreaCode(areaCode)] = provider
+	}
+	return r
+}
+
+type router struct {
+	def    SMS
+	routes map[string]SMS
+}
+
+func (r *router) Name() string {
+	return "router"
+}
+
+func (r *router) provider(areaCode string) SMS {
+	if provider, ok := r.routes[NormalizeAreaCode(areaCode)]; ok {
+		return provider
+	}
+	return r.def
+}
+
+func (r *router) SendCode(ctx context.Context, areaCode string, phoneNumber string, verifyCode string) error {
+	provider := r.provider(areaCode)
+	if provider == nil {
+		return errs.ErrArgs.WrapMsg("no sms provider for area code " + areaCode)
+	}
+	return provider.SendCode(ctx, areaCode, phoneNumber, verifyCode)
+}
@@ -0,0 +1,100 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sms
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"text/template"
+
+	"github.com/openimsdk/tools/errs"
+)
+
+// NewHTTP sends the code with a request built from templates, for gateways without a dedicated provider.
+// The url, the header values and the body are rendered with Message, the templates may use
+// urlquery and the json function to escape values.
+// Any 2xx status is a success.
+func NewHTTP(method, rawURL string, header map[string]string, body string) (SMS, error) {
+	if method == "" {
+		method = http.MethodPost
+	}
+	if rawURL == "" {
+		return nil, errs.New("http sms: url is empty").Wrap()
+	}
+	h := &httpSMS{
+		method: strings.ToUpper(method),
+		header: make(map[string]*template.Template, len(header)),
+	}
+	var err error
+	if h.url, err = parseTemplate("url", rawURL); err != nil {
+		return nil, err
+	}
+	if body != "" {
+		if h.body, err = parseTemplate("body", body); err != nil {
+			return nil, err
+		}
+	}
+	for key, value := range header {
+		if h.header[key], err = parseTemplate(key, value); err != nil {
+			return nil, err
+		}
+	}
+	return h, nil
+}
+
+type httpSMS struct {
+	method string
+	url    *template.Template
+	header map[string]*template.Template
+	body   *template.Template
+}
+
+func (h *httpSMS) Name() string {
+	return "http-sms"
+}
+
+func (h *httpSMS) SendCode(ctx context.Context, areaCode string, phoneNumber string, verifyCode string) error {
+	msg := newMessage(areaCode, phoneNumber, verifyCode)
+	rawURL, err := render(h.url, msg)
+	if err != nil {
+		return err
+	}
+	var body string
+	if h.body != nil {
+		if body, err = render(h.body, msg); err != nil {
+			return err
+		}
+	}
+	req, err := http.NewRequestWithContext(ctx, h.method, rawURL, strings.NewReader(body))
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	for key, tmpl := range h.header {
+		value, err := render(tmpl, msg)
+		if err != nil {
+			return err
+		}
+		req.Header.Set(key, value)
+	}
+	status, resp, err := do(req)
+	if err != nil {
+		return err
+	}
+	if !isSuccess(status) {
+		return statusError("http sms", status, resp)
+	}
+	return nil
+}
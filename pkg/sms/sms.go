@@ -1,8 +1,102 @@
 package sms
 
-import "context"
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/openimsdk/tools/errs"
+)
 
 type SMS interface {
 	Name() string
 	SendCode(ctx context.Context, areaCode string, phoneNumber string, verifyCode string) error
 }
+
+// DefaultMessage is the text sent by providers that take the whole message rather than a template code.
+const DefaultMessage = "Your verification code is: {{.Code}}. This code is valid for 5 minutes and should not be shared with others"
+
+var client = &http.Client{
+	Timeout: time.Second * 10,
+}
+
+// Message is the data of message templates.
+type Message struct {
+	AreaCode    string
+	PhoneNumber string
+	// E164 is the number with the area code in the +<digits> form most providers expect
+	E164 string
+	Code string
+}
+
+func newMessage(areaCode string, phoneNumber string, verifyCode string) *Message {
+	return &Message{
+		AreaCode:    areaCode,
+		PhoneNumber: phoneNumber,
+		E164:        "+" + NormalizeAreaCode(areaCode) + phoneNumber,
+		Code:        verifyCode,
+	}
+}
+
+// NormalizeAreaCode returns the digits of an area code given as "+86", "0086" or "86".
+func NormalizeAreaCode(areaCode string) string {
+	areaCode = strings.TrimSpace(areaCode)
+	if strings.HasPrefix(areaCode, "+") {
+		return areaCode[1:]
+	}
+	return strings.TrimPrefix(areaCode, "00")
+}
+
+func parseTemplate(name string, text string) (*template.Template, error) {
+	tmpl, err := template.New(name).Funcs(template.FuncMap{"json": jsonString}).Option("missingkey=error").Parse(text)
+	if err != nil {
+		return nil, errs.WrapMsg(err, "invalid sms template", "name", name)
+	}
+	return tmpl, nil
+}
+
+// jsonString renders a value as a JSON string literal including the quotes.
+func jsonString(s string) (string, error) {
+	data, err := json.Marshal(s)
+	return string(data), err
+}
+
+func render(tmpl *template.Template, msg *Message) (string, error) {
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, msg); err != nil {
+		return "", errs.Wrap(err)
+	}
+	return buf.String(), nil
+}
+
+// do sends the request and returns the response body, a status outside 2xx is an error.
+func do(req *http.Request) (int, []byte, error) {
+	resp, err := client.Do(req)
+	if err != nil {
+		return 0, nil, errs.Wrap(err)
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return resp.StatusCode, nil, errs.Wrap(err)
+	}
+	return resp.StatusCode, body, nil
+}
+
+func statusError(provider string, status int, body []byte) error {
+	const limit = 512
+	if len(body) > limit {
+		body = body[:limit]
+	}
+	return errs.New(fmt.Sprintf("%s: unexpected status %d", provider, status), "body", string(body)).Wrap()
+}
+
+func isSuccess(status int) bool {
+	return status >= http.StatusOK && status < http.StatusMultipleChoices
+}
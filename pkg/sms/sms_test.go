@@ -0,0 +1,207 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sms
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+)
+
+// stub records the requests it receives and answers with status and body.
+type stub struct {
+	*httptest.Server
+	reqs   []*http.Request
+	bodies []string
+}
+
+func newStub(t *testing.T, status int, body string) *stub {
+	s := &stub{}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		data, _ := io.ReadAll(r.Body)
+		s.reqs = append(s.reqs, r)
+		s.bodies = append(s.bodies, string(data))
+		w.WriteHeader(status)
+		_, _ = io.WriteString(w, body)
+	}))
+	t.Cleanup(s.Close)
+	return s
+}
+
+func (s *stub) form(t *testing.T, i int) url.Values {
+	form, err := url.ParseQuery(s.bodies[i])
+	if err != nil {
+		t.Fatal(err)
+	}
+	return form
+}
+
+func TestTwilio(t *testing.T) {
+	s := newStub(t, http.StatusCreated, `{"sid":"SM1"}`)
+	provider, err := NewTwilio(s.URL, "AC1", "token", "+15550001", "code {{.Code}}")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := provider.SendCode(context.Background(), "+44", "7700900123", "123456"); err != nil {
+		t.Fatal(err)
+	}
+	req, form := s.reqs[0], s.form(t, 0)
+	if req.URL.Path != "/2010-04-01/Accounts/AC1/Messages.json" {
+		t.Fatalf("path %s", req.URL.Path)
+	}
+	if user, pass, _ := req.BasicAuth(); user != "AC1" || pass != "token" {
+		t.Fatalf("basic auth %s:%s", user, pass)
+	}
+	if form.Get("To") != "+447700900123" || form.Get("From") != "+15550001" || form.Get("Body") != "code 123456" {
+		t.Fatalf("form %v", form)
+	}
+
+	s = newStub(t, http.StatusBadRequest, `{"code":21211,"message":"invalid To"}`)
+	provider, _ = NewTwilio(s.URL, "AC1", "token", "MG1", "")
+	err = provider.SendCode(context.Background(), "44", "1", "123456")
+	if err == nil || !strings.Contains(err.Error(), "invalid To") {
+		t.Fatalf("want the twilio error, got %v", err)
+	}
+	if s.form(t, 0).Get("MessagingServiceSid") != "MG1" {
+		t.Fatalf("form %v", s.form(t, 0))
+	}
+}
+
+func TestVonage(t *testing.T) {
+	s := newStub(t, http.StatusOK, `{"messages":[{"status":"0"}]}`)
+	provider, err := NewVonage(s.URL, "key", "secret", "Chat", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := provider.SendCode(context.Background(), "0049", "15112345678", "654321"); err != nil {
+		t.Fatal(err)
+	}
+	form := s.form(t, 0)
+	if s.reqs[0].URL.Path != "/sms/json" || form.Get("api_key") != "key" || form.Get("to") != "4915112345678" || !strings.Contains(form.Get("text"), "654321") {
+		t.Fatalf("path %s, form %v", s.reqs[0].URL.Path, form)
+	}
+
+	// errors are reported with status 200
+	s = newStub(t, http.StatusOK, `{"messages":[{"status":"4","error-text":"Bad Credentials"}]}`)
+	provider, _ = NewVonage(s.URL, "key", "wrong", "Chat", "")
+	if err := provider.SendCode(context.Background(), "49", "1", "654321"); err == nil || !strings.Contains(err.Error(), "Bad Credentials") {
+		t.Fatalf("want the vonage error, got %v", err)
+	}
+}
+
+func TestSNS(t *testing.T) {
+	s := newStub(t, http.StatusOK, `<PublishResponse><PublishResult><MessageId>1</MessageId></PublishResult></PublishResponse>`)
+	provider, err := NewSNS(s.URL, "eu-west-1", "AKID", "secret", "Chat", "", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := provider.SendCode(context.Background(), "+33", "612345678", "111222"); err != nil {
+		t.Fatal(err)
+	}
+	form := s.form(t, 0)
+	if form.Get("Action") != "Publish" || form.Get("PhoneNumber") != "+33612345678" || !strings.Contains(form.Get("Message"), "111222") {
+		t.Fatalf("form %v", form)
+	}
+	if form.Get("MessageAttributes.entry.1.Value.StringValue") != "Transactional" || form.Get("MessageAttributes.entry.2.Value.StringValue") != "Chat" {
+		t.Fatalf("attributes %v", form)
+	}
+	auth := s.reqs[0].Header.Get("Authorization")
+	if !strings.HasPrefix(auth, "AWS4-HMAC-SHA256 Credential=AKID/") || !strings.Contains(auth, "/eu-west-1/sns/aws4_request, SignedHeaders=content-type;host;x-amz-date, Signature=") {
+		t.Fatalf("authorization %s", auth)
+	}
+
+	s = newStub(t, http.StatusForbidden, `<ErrorResponse><Error><Code>InvalidClientTokenId</Code><Message>bad key</Message></Error></ErrorResponse>`)
+	provider, _ = NewSNS(s.URL, "eu-west-1", "AKID", "secret", "", "", "")
+	if err := provider.SendCode(context.Background(), "+33", "1", "111222"); err == nil || !strings.Contains(err.Error(), "bad key") {
+		t.Fatalf("want the sns error, got %v", err)
+	}
+}
+
+// get-vanilla of the AWS Signature Version 4 test suite
+func TestSignV4(t *testing.T) {
+	req, err := http.NewRequest(http.MethodGet, "https://example.amazonaws.com/", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	signV4(req, nil, "service", "us-east-1", "AKIDEXAMPLE", "wJalrXUtnFEMI/K7MDENG+bPxRfiCYEXAMPLEKEY", time.Date(2015, 8, 30, 12, 36, 0, 0, time.UTC))
+	want := "AWS4-HMAC-SHA256 Credential=AKIDEXAMPLE/20150830/us-east-1/service/aws4_request, SignedHeaders=host;x-amz-date, Signature=5fa00fa31553b73ebf1942676e86291e8372ff2a2260956d9b8aae1d763fbf31"
+	if got := req.Header.Get("Authorization"); got != want {
+		t.Fatalf("got %s\nwant %s", got, want)
+	}
+}
+
+func TestHTTP(t *testing.T) {
+	s := newStub(t, http.StatusAccepted, "")
+	provider, err := NewHTTP("post", s.URL+"/send?to={{urlquery .E164}}", map[string]string{"Content-Type": "application/json", "X-Code": "{{.Code}}"}, `{"to":{{json .E164}},"text":{{json .Code}}}`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := provider.SendCode(context.Background(), "+1", "5550100", "999000"); err != nil {
+		t.Fatal(err)
+	}
+	req := s.reqs[0]
+	if req.Method != http.MethodPost || req.URL.Query().Get("to") != "+15550100" || req.Header.Get("X-Code") != "999000" {
+		t.Fatalf("request %s %s %v", req.Method, req.URL, req.Header)
+	}
+	var body map[string]string
+	if err := json.Unmarshal([]byte(s.bodies[0]), &body); err != nil || body["to"] != "+15550100" || body["text"] != "999000" {
+		t.Fatalf("body %s", s.bodies[0])
+	}
+
+	s = newStub(t, http.StatusInternalServerError, "down")
+	provider, _ = NewHTTP("", s.URL, nil, "")
+	if err := provider.SendCode(context.Background(), "+1", "5550100", "999000"); err == nil {
+		t.Fatal("want an error for status 500")
+	}
+	if _, err := NewHTTP("", s.URL+"/{{.Unknown", nil, ""); err == nil {
+		t.Fatal("want an error for an invalid template")
+	}
+}
+
+func TestFallbackRouter(t *testing.T) {
+	down := newStub(t, http.StatusServiceUnavailable, "")
+	up := newStub(t, http.StatusOK, "")
+	china := newStub(t, http.StatusOK, "")
+	first, _ := NewHTTP("", down.URL, nil, "")
+	second, _ := NewHTTP("", up.URL, nil, "")
+	third, _ := NewHTTP("", china.URL, nil, "")
+
+	provider := NewRouter(NewFallback(first, second), map[string]SMS{"+86": third})
+	if err := provider.SendCode(context.Background(), "+1", "5550100", "1"); err != nil {
+		t.Fatal(err)
+	}
+	if len(down.reqs) != 1 || len(up.reqs) != 1 || len(china.reqs) != 0 {
+		t.Fatalf("requests %d, %d, %d", len(down.reqs), len(up.reqs), len(china.reqs))
+	}
+	if err := provider.SendCode(context.Background(), "0086", "13800000000", "1"); err != nil {
+		t.Fatal(err)
+	}
+	if len(down.reqs) != 1 || len(up.reqs) != 1 || len(china.reqs) != 1 {
+		t.Fatalf("requests %d, %d, %d", len(down.reqs), len(up.reqs), len(china.reqs))
+	}
+
+	if err := NewFallback(first, first).SendCode(context.Background(), "+1", "5550100", "1"); err == nil {
+		t.Fatal("want an error when every provider fails")
+	}
+	if err := NewRouter(nil, map[string]SMS{"86": third}).SendCode(context.Background(), "+1", "5550100", "1"); err == nil {
+		t.Fatal("want an error without a provider for the area code")
+	}
+}
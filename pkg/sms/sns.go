@@ -0,0 +1,183 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sms
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/xml"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/openimsdk/tools/errs"
+)
+
+// NewSNS publishes the message to the phone number through AWS SNS.
+// endpoint defaults to the regional endpoint, smsType is Transactional or Promotional.
+func NewSNS(endpoint, region, accessKeyID, secretAccessKey, senderID, smsType, message string) (SMS, error) {
+	if region == "" {
+		return nil, errs.New("sns: region is empty").Wrap()
+	}
+	if endpoint == "" {
+		endpoint = "https://sns." + region + ".amazonaws.com"
+	}
+	if smsType == "" {
+		smsType = "Transactional"
+	}
+	if message == "" {
+		message = DefaultMessage
+	}
+	tmpl, err := parseTemplate("sns", message)
+	if err != nil {
+		return nil, err
+	}
+	return &sns{
+		url:             strings.TrimRight(endpoint, "/") + "/",
+		region:          region,
+		accessKeyID:     accessKeyID,
+		secretAccessKey: secretAccessKey,
+		senderID:        senderID,
+		smsType:         smsType,
+		message:         tmpl,
+	}, nil
+}
+
+type sns struct {
+	url             string
+	region          string
+	accessKeyID     string
+	secretAccessKey string
+	senderID        string
+	smsType         string
+	message         *template.Template
+}
+
+func (s *sns) Name() string {
+	return "sns-sms"
+}
+
+func (s *sns) SendCode(ctx context.Context, areaCode string, phoneNumber string, verifyCode string) error {
+	msg := newMessage(areaCode, phoneNumber, verifyCode)
+	text, err := render(s.message, msg)
+	if err != nil {
+		return err
+	}
+	form := url.Values{}
+	form.Set("Action", "Publish")
+	form.Set("Version", "2010-03-31")
+	form.Set("PhoneNumber", msg.E164)
+	form.Set("Message", text)
+	attrs := [][2]string{{"AWS.SNS.SMS.SMSType", s.smsType}}
+	if s.senderID != "" {
+		attrs = append(attrs, [2]string{"AWS.SNS.SMS.SenderID", s.senderID})
+	}
+	for i, attr := range attrs {
+		prefix := "MessageAttributes.entry." + strconv.Itoa(i+1) + "."
+		form.Set(prefix+"Name", attr[0])
+		form.Set(prefix+"Value.DataType", "String")
+		form.Set(prefix+"Value.StringValue", attr[1])
+	}
+	body := []byte(form.Encode())
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, strings.NewReader(string(body)))
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded; charset=utf-8")
+	signV4(req, body, "sns", s.region, s.accessKeyID, s.secretAccessKey, time.Now())
+	status, resp, err := do(req)
+	if err != nil {
+		return err
+	}
+	if !isSuccess(status) {
+		var res struct {
+			Error struct {
+				Code    string `xml:"Code"`
+				Message string `xml:"Message"`
+			} `xml:"Error"`
+		}
+		if xml.Unmarshal(resp, &res) == nil && res.Error.Code != "" {
+			return errs.New("sns: "+res.Error.Message, "status", status, "code", res.Error.Code).Wrap()
+		}
+		return statusError("sns", status, resp)
+	}
+	return nil
+}
+
+// signV4 adds an AWS Signature Version 4 Authorization header signing the host, the x-amz-date
+// and the content type of the request.
+func signV4(req *http.Request, body []byte, service, region, accessKeyID, secretAccessKey string, now time.Time) {
+	now = now.UTC()
+	amzDate := now.Format("20060102T150405Z")
+	date := now.Format("20060102")
+	req.Header.Set("X-Amz-Date", amzDate)
+
+	headers := map[string]string{
+		"host":       req.URL.Host,
+		"x-amz-date": amzDate,
+	}
+	if contentType := req.Header.Get("Content-Type"); contentType != "" {
+		headers["content-type"] = contentType
+	}
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	var canonicalHeaders strings.Builder
+	for _, name := range names {
+		canonicalHeaders.WriteString(name + ":" + strings.TrimSpace(headers[name]) + "\n")
+	}
+	signedHeaders := strings.Join(names, ";")
+	path := req.URL.EscapedPath()
+	if path == "" {
+		path = "/"
+	}
+	// url.Values.Encode sorts by key, AWS wants spaces as %20
+	query := strings.ReplaceAll(req.URL.Query().Encode(), "+", "%20")
+	bodyHash := sha256.Sum256(body)
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		path,
+		query,
+		canonicalHeaders.String(),
+		signedHeaders,
+		hex.EncodeToString(bodyHash[:]),
+	}, "\n")
+
+	scope := date + "/" + region + "/" + service + "/aws4_request"
+	requestHash := sha256.Sum256([]byte(canonicalRequest))
+	stringToSign := "AWS4-HMAC-SHA256\n" + amzDate + "\n" + scope + "\n" + hex.EncodeToString(requestHash[:])
+
+	key := hmacSHA256([]byte("AWS4"+secretAccessKey), date)
+	key = hmacSHA256(key, region)
+	key = hmacSHA256(key, service)
+	key = hmacSHA256(key, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+
+	req.Header.Set("Authorization", "AWS4-HMAC-SHA256 Credential="+accessKeyID+"/"+scope+", SignedHeaders="+signedHeaders+", Signature="+signature)
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
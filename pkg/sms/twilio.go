@@ -0,0 +1,99 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sms
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strings"
+	"text/template"
+
+	"github.com/openimsdk/tools/errs"
+)
+
+const twilioEndpoint = "https://api.twilio.com"
+
+// NewTwilio sends the message through the Twilio Messages API.
+// from is a sender number, or a messaging service SID starting with MG.
+func NewTwilio(endpoint, accountSID, authToken, from, message string) (SMS, error) {
+	if endpoint == "" {
+		endpoint = twilioEndpoint
+	}
+	if message == "" {
+		message = DefaultMessage
+	}
+	tmpl, err := parseTemplate("twilio", message)
+	if err != nil {
+		return nil, err
+	}
+	return &twilio{
+		url:        strings.TrimRight(endpoint, "/") + "/2010-04-01/Accounts/" + url.PathEscape(accountSID) + "/Messages.json",
+		accountSID: accountSID,
+		authToken:  authToken,
+		from:       from,
+		message:    tmpl,
+	}, nil
+}
+
+type twilio struct {
+	url        string
+	accountSID string
+	authToken  string
+	from       string
+	message    *template.Template
+}
+
+func (t *twilio) Name() string {
+	return "twilio-sms"
+}
+
+func (t *twilio) SendCode(ctx context.Context, areaCode string, phoneNumber string, verifyCode string) error {
+	msg := newMessage(areaCode, phoneNumber, verifyCode)
+	text, err := render(t.message, msg)
+	if err != nil {
+		return err
+	}
+	form := url.Values{}
+	form.Set("To", msg.E164)
+	if strings.HasPrefix(t.from, "MG") {
+		form.Set("MessagingServiceSid", t.from)
+	} else {
+		form.Set("From", t.from)
+	}
+	form.Set("Body", text)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, t.url, strings.NewReader(form.Encode()))
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.SetBasicAuth(t.accountSID, t.authToken)
+	status, body, err := do(req)
+	if err != nil {
+		return err
+	}
+	if !isSuccess(status) {
+		var res struct {
+			Code    int    `json:"code"`
+			Message string `json:"message"`
+		}
+		if json.Unmarshal(body, &res) == nil && res.Message != "" {
+			return errs.New("twilio: "+res.Message, "status", status, "code", res.Code).Wrap()
+		}
+		return statusError("twilio", status, body)
+	}
+	return nil
+}
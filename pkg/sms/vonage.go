@@ -0,0 +1,106 @@
+// Copyright © 2023 OpenIM open source community. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sms
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strings"
+	"text/template"
+
+	"github.com/openimsdk/tools/errs"
+)
+
+const vonageEndpoint = "https://rest.nexmo.com"
+
+// NewVonage sends the message through the Vonage SMS API.
+func NewVonage(endpoint, apiKey, apiSecret, from, message string) (SMS, error) {
+	if endpoint == "" {
+		endpoint = vonageEndpoint
+	}
+	if message == "" {
+		message = DefaultMessage
+	}
+	tmpl, err := parseTemplate("vonage", message)
+	if err != nil {
+		return nil, err
+	}
+	return &vonage{
+		url:       strings.TrimRight(endpoint, "/") + "/sms/json",
+		apiKey:    apiKey,
+		apiSecret: apiSecret,
+		from:      from,
+		message:   tmpl,
+	}, nil
+}
+
+type vonage struct {
+	url       string
+	apiKey    string
+	apiSecret string
+	from      string
+	message   *template.Template
+}
+
+func (v *vonage) Name() string {
+	return "vonage-sms"
+}
+
+func (v *vonage) SendCode(ctx context.Context, areaCode string, phoneNumber string, verifyCode string) error {
+	msg := newMessage(areaCode, phoneNumber, verifyCode)
+	text, err := render(v.message, msg)
+	if err != nil {
+		return err
+	}
+	form := url.Values{}
+	form.Set("api_key", v.apiKey)
+	form.Set("api_secret", v.apiSecret)
+	form.Set("from", v.from)
+	form.Set("to", strings.TrimPrefix(msg.E164, "+"))
+	form.Set("text", text)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, v.url, strings.NewReader(form.Encode()))
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	status, body, err := do(req)
+	if err != nil {
+		return err
+	}
+	if !isSuccess(status) {
+		return statusError("vonage", status, body)
+	}
+	// the API answers 200 and reports the result of each message part
+	var res struct {
+		Messages []struct {
+			Status    string `json:"status"`
+			ErrorText string `json:"error-text"`
+		} `json:"messages"`
+	}
+	if err := json.Unmarshal(body, &res); err != nil {
+		return errs.WrapMsg(err, "vonage: invalid response", "body", string(body))
+	}
+	if len(res.Messages) == 0 {
+		return errs.New("vonage: no message status", "body", string(body)).Wrap()
+	}
+	for _, m := range res.Messages {
+		if m.Status != "0" {
+			return errs.New("vonage: "+m.ErrorText, "status", m.Status).Wrap()
+		}
+	}
+	return nil
+}
@@ -0,0 +1,85 @@
+package storage
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/openimsdk/tools/errs"
+)
+
+// NewLocal 文件保存在 dir 下，多个实例需要挂载同一个目录
+func NewLocal(dir string) (Storage, error) {
+	if dir == "" {
+		return nil, errs.New("local storage dir must be set")
+	}
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return &local{dir: dir}, nil
+}
+
+type local struct {
+	dir string
+}
+
+func (l *local) Name() string {
+	return "local"
+}
+
+func (l *local) path(key string) (string, error) {
+	path := filepath.Join(l.dir, filepath.FromSlash(key))
+	if !strings.HasPrefix(path, filepath.Clean(l.dir)+string(filepath.Separator)) {
+		return "", errs.ErrArgs.WrapMsg("invalid storage key", "key", key)
+	}
+	return path, nil
+}
+
+// Put 先写临时文件再改名，读取时不会读到写了一半的文件
+func (l *local) Put(ctx context.Context, key string, r io.Reader, size int64) error {
+	path, err := l.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return errs.Wrap(err)
+	}
+	f, err := os.CreateTemp(filepath.Dir(path), ".upload-*")
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	defer os.Remove(f.Name())
+	if _, err := io.Copy(f, r); err != nil {
+		f.Close()
+		return errs.Wrap(err)
+	}
+	if err := f.Close(); err != nil {
+		return errs.Wrap(err)
+	}
+	return errs.Wrap(os.Rename(f.Name(), path))
+}
+
+func (l *local) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	path, err := l.path(key)
+	if err != nil {
+		return nil, err
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	return f, nil
+}
+
+func (l *local) Delete(ctx context.Context, key string) error {
+	path, err := l.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		return errs.Wrap(err)
+	}
+	return nil
+}
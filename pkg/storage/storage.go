@@ -0,0 +1,34 @@
+package storage
+
+import (
+	"context"
+	"io"
+
+	"github.com/openimsdk/tools/errs"
+)
+
+// Storage 保存用户数据导出等文件，本地磁盘或 S3 兼容的对象存储
+type Storage interface {
+	Name() string
+	Put(ctx context.Context, key string, r io.Reader, size int64) error
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+	// 删除不存在的文件不返回错误
+	Delete(ctx context.Context, key string) error
+}
+
+type Config struct {
+	Use string
+	Dir string
+	S3  S3Config
+}
+
+func New(conf *Config) (Storage, error) {
+	switch conf.Use {
+	case "", "local":
+		return NewLocal(conf.Dir)
+	case "s3":
+		return NewS3(conf.S3)
+	default:
+		return nil, errs.New("unknown storage", "use", conf.Use)
+	}
+}